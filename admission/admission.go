@@ -0,0 +1,30 @@
+// Package admission defines the admission of containers into namespaces.
+//
+// Plugins of the AdmissionPlugin type returning a Controller are consulted
+// when a container is created or its spec is updated, which is rejected
+// when any of them returns an error.
+package admission
+
+import (
+	"github.com/containerd/containerd/containers"
+	"golang.org/x/net/context"
+)
+
+// Controller admits or rejects containers
+type Controller interface {
+	// Admit returns an error, such as errdefs.ErrResourceExhausted, when
+	// the container is rejected
+	Admit(ctx context.Context, r *Request) error
+}
+
+// Request is a container to admit into its namespace
+type Request struct {
+	// Namespace of the container
+	Namespace string
+	// Container is the container being created or the container with its
+	// updated spec
+	Container *containers.Container
+	// Containers are the other containers of the namespace, excluding the
+	// container being updated
+	Containers []containers.Container
+}
@@ -0,0 +1,156 @@
+// Package quota implements an admission plugin limiting the containers of
+// each namespace and the memory and pids they may use.
+package quota
+
+import (
+	"github.com/containerd/containerd/admission"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/typeurl"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type:   plugin.AdmissionPlugin,
+		ID:     "quota",
+		Config: &Config{},
+		Init:   New,
+	})
+}
+
+// Config of the quotas
+type Config struct {
+	// Default are the limits of the namespaces that are not listed
+	Default Limits `toml:"default"`
+	// Namespaces are the limits of namespaces, replacing the default limits
+	Namespaces map[string]Limits `toml:"namespaces"`
+}
+
+// Limits of a namespace. A zero limit does not limit the namespace.
+type Limits struct {
+	// Containers is the number of containers of the namespace
+	Containers int `toml:"containers"`
+	// Memory is the sum in bytes of the memory reserved by the containers
+	// of the namespace
+	Memory int64 `toml:"memory"`
+	// Pids is the sum of the pids limits of the containers of the namespace
+	Pids int64 `toml:"pids"`
+}
+
+func (l Limits) isZero() bool {
+	return l.Containers == 0 && l.Memory == 0 && l.Pids == 0
+}
+
+func (l Limits) validate() error {
+	if l.Containers < 0 || l.Memory < 0 || l.Pids < 0 {
+		return errors.Wrap(errdefs.ErrInvalidArgument, "limits must not be negative")
+	}
+	return nil
+}
+
+// New returns the quotas of the configuration. The plugin is skipped when no
+// namespace is limited.
+func New(ic *plugin.InitContext) (interface{}, error) {
+	config := ic.Config.(*Config)
+	if err := config.Default.validate(); err != nil {
+		return nil, errors.Wrap(err, "default")
+	}
+	limited := !config.Default.isZero()
+	for ns, l := range config.Namespaces {
+		if err := l.validate(); err != nil {
+			return nil, errors.Wrapf(err, "namespace %s", ns)
+		}
+		limited = limited || !l.isZero()
+	}
+	if !limited {
+		return nil, plugin.SkipPlugin
+	}
+	return &Quota{config: config}, nil
+}
+
+// Quota rejects the containers that would take their namespace over its
+// limits with errdefs.ErrResourceExhausted
+type Quota struct {
+	config *Config
+}
+
+var _ = (admission.Controller)(&Quota{})
+
+// Admit rejects the container when its namespace would exceed its limits.
+// Containers of a namespace limiting memory or pids must set these limits
+// in their spec.
+func (q *Quota) Admit(ctx context.Context, r *admission.Request) error {
+	limits, ok := q.config.Namespaces[r.Namespace]
+	if !ok {
+		limits = q.config.Default
+	}
+	if limits.isZero() {
+		return nil
+	}
+	if limits.Containers > 0 && len(r.Containers) >= limits.Containers {
+		return errors.Wrapf(errdefs.ErrResourceExhausted, "namespace %s is limited to %d containers", r.Namespace, limits.Containers)
+	}
+	if limits.Memory == 0 && limits.Pids == 0 {
+		return nil
+	}
+	memory, pids, err := usage(r.Container)
+	if err != nil {
+		return err
+	}
+	if limits.Memory > 0 && memory == 0 {
+		return errors.Wrapf(errdefs.ErrResourceExhausted, "container %s sets no memory limit, required by the memory quota of namespace %s", r.Container.ID, r.Namespace)
+	}
+	if limits.Pids > 0 && pids == 0 {
+		return errors.Wrapf(errdefs.ErrResourceExhausted, "container %s sets no pids limit, required by the pids quota of namespace %s", r.Container.ID, r.Namespace)
+	}
+	for i := range r.Containers {
+		// containers whose spec cannot be read, or created before the
+		// namespace was limited, only count with the limits they set
+		m, p, _ := usage(&r.Containers[i])
+		memory += m
+		pids += p
+	}
+	if limits.Memory > 0 && memory > limits.Memory {
+		return errors.Wrapf(errdefs.ErrResourceExhausted, "namespace %s is limited to %d bytes of memory, %d requested", r.Namespace, limits.Memory, memory)
+	}
+	if limits.Pids > 0 && pids > limits.Pids {
+		return errors.Wrapf(errdefs.ErrResourceExhausted, "namespace %s is limited to %d pids, %d requested", r.Namespace, limits.Pids, pids)
+	}
+	return nil
+}
+
+// usage returns the memory reserved by the container, the larger of its
+// memory limit and reservation, and its pids limit
+func usage(c *containers.Container) (memory, pids int64, err error) {
+	if c.Spec == nil {
+		return 0, 0, nil
+	}
+	v, err := typeurl.UnmarshalAny(c.Spec)
+	if err != nil {
+		return 0, 0, err
+	}
+	spec, ok := v.(*specs.Spec)
+	if !ok {
+		return 0, 0, errors.Wrapf(errdefs.ErrInvalidArgument, "spec of container %s is not a runtime spec", c.ID)
+	}
+	if spec.Linux == nil || spec.Linux.Resources == nil {
+		return 0, 0, nil
+	}
+	res := spec.Linux.Resources
+	if res.Memory != nil {
+		if res.Memory.Limit != nil && *res.Memory.Limit > 0 {
+			memory = *res.Memory.Limit
+		}
+		if res.Memory.Reservation != nil && *res.Memory.Reservation > memory {
+			memory = *res.Memory.Reservation
+		}
+	}
+	if res.Pids != nil && res.Pids.Limit > 0 {
+		pids = res.Pids.Limit
+	}
+	return memory, pids, nil
+}
@@ -0,0 +1,111 @@
+package quota
+
+import (
+	"testing"
+
+	"github.com/containerd/containerd/admission"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/typeurl"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/net/context"
+)
+
+func init() {
+	typeurl.Register(&specs.Spec{}, "opencontainers/runtime-spec", "v1", "Spec")
+}
+
+func container(t *testing.T, id string, memory, pids int64) containers.Container {
+	spec := &specs.Spec{Linux: &specs.Linux{Resources: &specs.LinuxResources{}}}
+	if memory > 0 {
+		spec.Linux.Resources.Memory = &specs.LinuxMemory{Limit: &memory}
+	}
+	if pids > 0 {
+		spec.Linux.Resources.Pids = &specs.LinuxPids{Limit: pids}
+	}
+	any, err := typeurl.MarshalAny(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return containers.Container{ID: id, Spec: any}
+}
+
+func TestAdmit(t *testing.T) {
+	q := &Quota{config: &Config{
+		Default: Limits{Containers: 2},
+		Namespaces: map[string]Limits{
+			"ci":        {Memory: 1 << 30, Pids: 100},
+			"unlimited": {},
+		},
+	}}
+	for _, tc := range []struct {
+		name      string
+		namespace string
+		container containers.Container
+		others    []containers.Container
+		exhausted bool
+	}{
+		{
+			name:      "under default",
+			namespace: "default",
+			container: container(t, "c2", 0, 0),
+			others:    []containers.Container{container(t, "c1", 0, 0)},
+		},
+		{
+			name:      "over default",
+			namespace: "default",
+			container: container(t, "c3", 0, 0),
+			others:    []containers.Container{container(t, "c1", 0, 0), container(t, "c2", 0, 0)},
+			exhausted: true,
+		},
+		{
+			name:      "unlimited",
+			namespace: "unlimited",
+			container: container(t, "c3", 0, 0),
+			others:    []containers.Container{container(t, "c1", 0, 0), container(t, "c2", 0, 0)},
+		},
+		{
+			name:      "under memory and pids",
+			namespace: "ci",
+			container: container(t, "c2", 512<<20, 50),
+			others:    []containers.Container{container(t, "c1", 512<<20, 50)},
+		},
+		{
+			name:      "over memory",
+			namespace: "ci",
+			container: container(t, "c2", 512<<20+1, 50),
+			others:    []containers.Container{container(t, "c1", 512<<20, 10)},
+			exhausted: true,
+		},
+		{
+			name:      "over pids",
+			namespace: "ci",
+			container: container(t, "c2", 1<<20, 51),
+			others:    []containers.Container{container(t, "c1", 1<<20, 50)},
+			exhausted: true,
+		},
+		{
+			name:      "no memory limit",
+			namespace: "ci",
+			container: container(t, "c1", 0, 10),
+			exhausted: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := q.Admit(context.Background(), &admission.Request{
+				Namespace:  tc.namespace,
+				Container:  &tc.container,
+				Containers: tc.others,
+			})
+			if tc.exhausted {
+				if !errdefs.IsResourceExhausted(err) {
+					t.Fatalf("expected resource exhausted error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
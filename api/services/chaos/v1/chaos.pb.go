@@ -0,0 +1,870 @@
+// Code generated by protoc-gen-gogo.
+// source: github.com/containerd/containerd/api/services/chaos/v1/chaos.proto
+// DO NOT EDIT!
+
+/*
+	Package chaos is a generated protocol buffer package.
+
+	It is generated from these files:
+		github.com/containerd/containerd/api/services/chaos/v1/chaos.proto
+
+	It has these top-level messages:
+		Fault
+		SetFaultsRequest
+		GetFaultsResponse
+*/
+package chaos
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import google_protobuf1 "github.com/golang/protobuf/ptypes/empty"
+import _ "github.com/golang/protobuf/ptypes/duration"
+
+import time "time"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+
+import strings "strings"
+import reflect "reflect"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type Fault struct {
+	// Operation is the task operation the fault applies to, one of "create",
+	// "start", "kill", "exec", "pause", "resume" or "delete", or "exit" for
+	// the exit events of the processes of the tasks, which are dropped at
+	// the error rate of the fault.
+	Operation string `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Delay is added before the operation is performed.
+	Delay time.Duration `protobuf:"bytes,2,opt,name=delay,stdduration" json:"delay"`
+	// ErrorRate is the probability, between 0 and 1, that the operation
+	// fails with an unavailable error instead of being performed.
+	ErrorRate float64 `protobuf:"fixed64,3,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+	// ShimCrashRate is the probability, between 0 and 1, that the shim of
+	// the task is killed after the operation is performed.
+	ShimCrashRate float64 `protobuf:"fixed64,4,opt,name=shim_crash_rate,json=shimCrashRate,proto3" json:"shim_crash_rate,omitempty"`
+}
+
+func (m *Fault) Reset()                    { *m = Fault{} }
+func (*Fault) ProtoMessage()               {}
+func (*Fault) Descriptor() ([]byte, []int) { return fileDescriptorChaos, []int{0} }
+
+type SetFaultsRequest struct {
+	Faults []Fault `protobuf:"bytes,1,rep,name=faults" json:"faults"`
+}
+
+func (m *SetFaultsRequest) Reset()                    { *m = SetFaultsRequest{} }
+func (*SetFaultsRequest) ProtoMessage()               {}
+func (*SetFaultsRequest) Descriptor() ([]byte, []int) { return fileDescriptorChaos, []int{1} }
+
+type GetFaultsResponse struct {
+	Faults []Fault `protobuf:"bytes,1,rep,name=faults" json:"faults"`
+}
+
+func (m *GetFaultsResponse) Reset()                    { *m = GetFaultsResponse{} }
+func (*GetFaultsResponse) ProtoMessage()               {}
+func (*GetFaultsResponse) Descriptor() ([]byte, []int) { return fileDescriptorChaos, []int{2} }
+
+func init() {
+	proto.RegisterType((*Fault)(nil), "containerd.services.chaos.v1.Fault")
+	proto.RegisterType((*SetFaultsRequest)(nil), "containerd.services.chaos.v1.SetFaultsRequest")
+	proto.RegisterType((*GetFaultsResponse)(nil), "containerd.services.chaos.v1.GetFaultsResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Chaos service
+
+type ChaosClient interface {
+	// Set replaces the faults injected by the chaos runtime.
+	Set(ctx context.Context, in *SetFaultsRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// Get returns the faults injected by the chaos runtime.
+	Get(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*GetFaultsResponse, error)
+}
+
+type chaosClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewChaosClient(cc *grpc.ClientConn) ChaosClient {
+	return &chaosClient{cc}
+}
+
+func (c *chaosClient) Set(ctx context.Context, in *SetFaultsRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/containerd.services.chaos.v1.Chaos/Set", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosClient) Get(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*GetFaultsResponse, error) {
+	out := new(GetFaultsResponse)
+	err := grpc.Invoke(ctx, "/containerd.services.chaos.v1.Chaos/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Chaos service
+
+type ChaosServer interface {
+	// Set replaces the faults injected by the chaos runtime.
+	Set(context.Context, *SetFaultsRequest) (*google_protobuf1.Empty, error)
+	// Get returns the faults injected by the chaos runtime.
+	Get(context.Context, *google_protobuf1.Empty) (*GetFaultsResponse, error)
+}
+
+func RegisterChaosServer(s *grpc.Server, srv ChaosServer) {
+	s.RegisterService(&_Chaos_serviceDesc, srv)
+}
+
+func _Chaos_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.services.chaos.v1.Chaos/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosServer).Set(ctx, req.(*SetFaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chaos_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf1.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.services.chaos.v1.Chaos/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosServer).Get(ctx, req.(*google_protobuf1.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Chaos_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "containerd.services.chaos.v1.Chaos",
+	HandlerType: (*ChaosServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Set",
+			Handler:    _Chaos_Set_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Chaos_Get_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/containerd/containerd/api/services/chaos/v1/chaos.proto",
+}
+
+func (m *Fault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fault) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operation) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintChaos(dAtA, i, uint64(len(m.Operation)))
+		i += copy(dAtA[i:], m.Operation)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintChaos(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay)))
+	n1, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Delay, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	if m.ErrorRate != 0 {
+		dAtA[i] = 0x19
+		i++
+		i = encodeFixed64Chaos(dAtA, i, uint64(math.Float64bits(float64(m.ErrorRate))))
+	}
+	if m.ShimCrashRate != 0 {
+		dAtA[i] = 0x21
+		i++
+		i = encodeFixed64Chaos(dAtA, i, uint64(math.Float64bits(float64(m.ShimCrashRate))))
+	}
+	return i, nil
+}
+
+func (m *SetFaultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetFaultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Faults) > 0 {
+		for _, msg := range m.Faults {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintChaos(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GetFaultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFaultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Faults) > 0 {
+		for _, msg := range m.Faults {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintChaos(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeFixed64Chaos(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Chaos(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintChaos(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Fault) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovChaos(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovChaos(uint64(l))
+	if m.ErrorRate != 0 {
+		n += 9
+	}
+	if m.ShimCrashRate != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *SetFaultsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Faults) > 0 {
+		for _, e := range m.Faults {
+			l = e.Size()
+			n += 1 + l + sovChaos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetFaultsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Faults) > 0 {
+		for _, e := range m.Faults {
+			l = e.Size()
+			n += 1 + l + sovChaos(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovChaos(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozChaos(x uint64) (n int) {
+	return sovChaos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Fault) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Fault{`,
+		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
+		`Delay:` + strings.Replace(strings.Replace(this.Delay.String(), "Duration", "google_protobuf2.Duration", 1), `&`, ``, 1) + `,`,
+		`ErrorRate:` + fmt.Sprintf("%v", this.ErrorRate) + `,`,
+		`ShimCrashRate:` + fmt.Sprintf("%v", this.ShimCrashRate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetFaultsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetFaultsRequest{`,
+		`Faults:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Faults), "Fault", "Fault", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetFaultsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetFaultsResponse{`,
+		`Faults:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Faults), "Fault", "Fault", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringChaos(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Fault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.ErrorRate = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShimCrashRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.ShimCrashRate = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetFaultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetFaultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetFaultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Faults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Faults = append(m.Faults, Fault{})
+			if err := m.Faults[len(m.Faults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFaultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFaultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Faults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Faults = append(m.Faults, Fault{})
+			if err := m.Faults[len(m.Faults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipChaos(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowChaos
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthChaos
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowChaos
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipChaos(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthChaos = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowChaos   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() {
+	proto.RegisterFile("github.com/containerd/containerd/api/services/chaos/v1/chaos.proto", fileDescriptorChaos)
+}
+
+var fileDescriptorChaos = []byte{
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xb1, 0xae, 0xd3, 0x30,
+	0x18, 0x85, 0x6b, 0x72, 0x7b, 0x45, 0x7d, 0x85, 0x00, 0x0b, 0xa1, 0x10, 0x2e, 0xb9, 0x51, 0x91,
+	0x50, 0x26, 0x5b, 0xb7, 0x4c, 0x08, 0x16, 0x52, 0xa0, 0x13, 0x0c, 0xa9, 0xe8, 0xc0, 0x52, 0xb9,
+	0xe9, 0xdf, 0x24, 0x52, 0x1b, 0x07, 0xdb, 0xa9, 0xd4, 0x8d, 0xc7, 0xe0, 0x09, 0xe0, 0x55, 0x3a,
+	0x32, 0x32, 0x01, 0xcd, 0x93, 0xa0, 0xd8, 0xad, 0x8a, 0x5a, 0xd1, 0x01, 0xb1, 0xfd, 0x39, 0xe7,
+	0x3b, 0x27, 0xbf, 0x9d, 0xe0, 0x28, 0xcd, 0x75, 0x56, 0x4d, 0x68, 0x22, 0x16, 0x2c, 0x11, 0x85,
+	0xe6, 0x79, 0x01, 0x72, 0xfa, 0xe7, 0xc8, 0xcb, 0x9c, 0x29, 0x90, 0xcb, 0x3c, 0x01, 0xc5, 0x92,
+	0x8c, 0x0b, 0xc5, 0x96, 0xd7, 0x76, 0xa0, 0xa5, 0x14, 0x5a, 0x90, 0xcb, 0x3d, 0x4d, 0x77, 0x24,
+	0xb5, 0xc0, 0xf2, 0xda, 0xbb, 0x97, 0x8a, 0x54, 0x18, 0x90, 0x35, 0x93, 0xcd, 0x78, 0x0f, 0x53,
+	0x21, 0xd2, 0x39, 0x30, 0xf3, 0x34, 0xa9, 0x66, 0x0c, 0x16, 0xa5, 0x5e, 0x6d, 0x4d, 0xff, 0xd0,
+	0x9c, 0x56, 0x92, 0xeb, 0x5c, 0x14, 0xd6, 0xef, 0x7e, 0x45, 0xb8, 0xfd, 0x86, 0x57, 0x73, 0x4d,
+	0x2e, 0x71, 0x47, 0x94, 0x60, 0x4d, 0x17, 0x05, 0x28, 0xec, 0xc4, 0x7b, 0x81, 0x3c, 0xc3, 0xed,
+	0x29, 0xcc, 0xf9, 0xca, 0xbd, 0x11, 0xa0, 0xf0, 0xa2, 0xf7, 0x80, 0xda, 0x5e, 0xba, 0xeb, 0xa5,
+	0xaf, 0xb6, 0xbd, 0xd1, 0xcd, 0xf5, 0x8f, 0xab, 0xd6, 0xe7, 0x9f, 0x57, 0x28, 0xb6, 0x09, 0xf2,
+	0x08, 0x63, 0x90, 0x52, 0xc8, 0xb1, 0xe4, 0x1a, 0x5c, 0x27, 0x40, 0x21, 0x8a, 0x3b, 0x46, 0x89,
+	0xb9, 0x06, 0xf2, 0x04, 0xdf, 0x56, 0x59, 0xbe, 0x18, 0x27, 0x92, 0xab, 0xcc, 0x32, 0x67, 0x86,
+	0xb9, 0xd5, 0xc8, 0xfd, 0x46, 0x6d, 0xb8, 0xee, 0x7b, 0x7c, 0x67, 0x08, 0xda, 0xec, 0xaa, 0x62,
+	0xf8, 0x58, 0x81, 0xd2, 0xe4, 0x25, 0x3e, 0x9f, 0x19, 0xc1, 0x45, 0x81, 0x13, 0x5e, 0xf4, 0x1e,
+	0xd3, 0x53, 0xf7, 0x47, 0x4d, 0x38, 0x3a, 0x6b, 0x16, 0x8c, 0xb7, 0xc1, 0xee, 0x08, 0xdf, 0x1d,
+	0xec, 0x6b, 0x55, 0x29, 0x0a, 0x05, 0xff, 0xa1, 0xb7, 0xf7, 0x05, 0xe1, 0x76, 0xbf, 0x01, 0xc8,
+	0x5b, 0xec, 0x0c, 0x41, 0x13, 0x7a, 0xba, 0xe3, 0xf0, 0x6c, 0xde, 0xfd, 0xa3, 0x2b, 0x7e, 0xdd,
+	0x7c, 0x57, 0xf2, 0x0e, 0x3b, 0x03, 0xd0, 0xe4, 0x2f, 0xb6, 0xc7, 0x4e, 0xbf, 0xe6, 0xe8, 0xac,
+	0xd1, 0x68, 0xbd, 0xf1, 0x5b, 0xdf, 0x37, 0x7e, 0xeb, 0x53, 0xed, 0xa3, 0x75, 0xed, 0xa3, 0x6f,
+	0xb5, 0x8f, 0x7e, 0xd5, 0x3e, 0xfa, 0xf0, 0xe2, 0xdf, 0x7e, 0xe8, 0xe7, 0x66, 0x98, 0x9c, 0x9b,
+	0xc5, 0x9e, 0xfe, 0x1e, 0x00, 0xc0, 0xdc, 0x3d, 0x3b, 0x17, 0x03, 0x00, 0x00,
+}
@@ -91,7 +91,28 @@ type Container struct {
 	// CreatedAt is the time the container was first created.
 	CreatedAt time.Time `protobuf:"bytes,8,opt,name=created_at,json=createdAt,stdtime" json:"created_at"`
 	// UpdatedAt is the last time the container was mutated.
+	//
+	// If set on an update, the update fails with an aborted error if the
+	// container has been mutated since this time.
 	UpdatedAt time.Time `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,stdtime" json:"updated_at"`
+	// Extensions allow clients to provide zero or more blobs that are directly
+	// associated with the container. One may provide protobuf, json, or other
+	// encoding formats. The primary use of this is to further decorate the
+	// container object with fields that may be specific to a client.
+	//
+	// Extensions may be updated individually with the field path
+	// "extensions.<name>".
+	Extensions map[string]google_protobuf1.Any `protobuf:"bytes,10,rep,name=extensions" json:"extensions" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	// Revision is incremented each time the container is mutated.
+	//
+	// If set on an update, the update fails with an aborted error if the
+	// container is no longer at this revision.
+	Revision uint64 `protobuf:"varint,11,opt,name=revision,proto3" json:"revision,omitempty"`
+	// SandboxID is the id of the sandbox whose namespaces and cgroup parent
+	// the container shares.
+	//
+	// This field may not be updated.
+	SandboxID string `protobuf:"bytes,12,opt,name=sandbox_id,json=sandboxId,proto3" json:"sandbox_id,omitempty"`
 }
 
 func (m *Container) Reset()                    { *m = Container{} }
@@ -181,6 +202,10 @@ type UpdateContainerRequest struct {
 	Container Container `protobuf:"bytes,1,opt,name=container" json:"container"`
 	// UpdateMask specifies which fields to perform the update on. If empty,
 	// the operation applies to all fields.
+	//
+	// Labels and extensions may be updated individually using the paths
+	// "labels.<key>" and "extensions.<name>". The spec may only be changed
+	// while the container has no task.
 	UpdateMask *google_protobuf3.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask" json:"update_mask,omitempty"`
 }
 
@@ -516,6 +541,43 @@ func (m *Container) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n4
+	if len(m.Extensions) > 0 {
+		for k, _ := range m.Extensions {
+			dAtA[i] = 0x52
+			i++
+			v := m.Extensions[k]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovContainers(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovContainers(uint64(len(k))) + msgSize
+			i = encodeVarintContainers(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintContainers(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintContainers(dAtA, i, uint64((&v).Size()))
+			n5, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n5
+		}
+	}
+	if m.Revision != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintContainers(dAtA, i, uint64(m.Revision))
+	}
+	if len(m.SandboxID) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintContainers(dAtA, i, uint64(len(m.SandboxID)))
+		i += copy(dAtA[i:], m.SandboxID)
+	}
 	return i, nil
 }
 
@@ -544,11 +606,11 @@ func (m *Container_Runtime) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintContainers(dAtA, i, uint64(m.Options.Size()))
-		n5, err := m.Options.MarshalTo(dAtA[i:])
+		n6, err := m.Options.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
 	return i, nil
 }
@@ -595,11 +657,11 @@ func (m *GetContainerResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintContainers(dAtA, i, uint64(m.Container.Size()))
-	n6, err := m.Container.MarshalTo(dAtA[i:])
+	n7, err := m.Container.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n7
 	return i, nil
 }
 
@@ -684,11 +746,11 @@ func (m *CreateContainerRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintContainers(dAtA, i, uint64(m.Container.Size()))
-	n7, err := m.Container.MarshalTo(dAtA[i:])
+	n8, err := m.Container.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n7
+	i += n8
 	return i, nil
 }
 
@@ -710,11 +772,11 @@ func (m *CreateContainerResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintContainers(dAtA, i, uint64(m.Container.Size()))
-	n8, err := m.Container.MarshalTo(dAtA[i:])
+	n9, err := m.Container.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n9
 	return i, nil
 }
 
@@ -736,20 +798,20 @@ func (m *UpdateContainerRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintContainers(dAtA, i, uint64(m.Container.Size()))
-	n9, err := m.Container.MarshalTo(dAtA[i:])
+	n10, err := m.Container.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n9
+	i += n10
 	if m.UpdateMask != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintContainers(dAtA, i, uint64(m.UpdateMask.Size()))
-		n10, err := m.UpdateMask.MarshalTo(dAtA[i:])
+		n11, err := m.UpdateMask.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n11
 	}
 	return i, nil
 }
@@ -772,11 +834,11 @@ func (m *UpdateContainerResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintContainers(dAtA, i, uint64(m.Container.Size()))
-	n11, err := m.Container.MarshalTo(dAtA[i:])
+	n12, err := m.Container.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n12
 	return i, nil
 }
 
@@ -870,6 +932,22 @@ func (m *Container) Size() (n int) {
 	n += 1 + l + sovContainers(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
 	n += 1 + l + sovContainers(uint64(l))
+	if len(m.Extensions) > 0 {
+		for k, v := range m.Extensions {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovContainers(uint64(len(k))) + 1 + l + sovContainers(uint64(l))
+			n += mapEntrySize + 1 + sovContainers(uint64(mapEntrySize))
+		}
+	}
+	if m.Revision != 0 {
+		n += 1 + sovContainers(uint64(m.Revision))
+	}
+	l = len(m.SandboxID)
+	if l > 0 {
+		n += 1 + l + sovContainers(uint64(l))
+	}
 	return n
 }
 
@@ -1002,6 +1080,16 @@ func (this *Container) String() string {
 		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
 	}
 	mapStringForLabels += "}"
+	keysForExtensions := make([]string, 0, len(this.Extensions))
+	for k, _ := range this.Extensions {
+		keysForExtensions = append(keysForExtensions, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForExtensions)
+	mapStringForExtensions := "map[string]google_protobuf1.Any{"
+	for _, k := range keysForExtensions {
+		mapStringForExtensions += fmt.Sprintf("%v: %v,", k, this.Extensions[k])
+	}
+	mapStringForExtensions += "}"
 	s := strings.Join([]string{`&Container{`,
 		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
 		`Labels:` + mapStringForLabels + `,`,
@@ -1012,6 +1100,9 @@ func (this *Container) String() string {
 		`RootFS:` + fmt.Sprintf("%v", this.RootFS) + `,`,
 		`CreatedAt:` + strings.Replace(strings.Replace(this.CreatedAt.String(), "Timestamp", "google_protobuf4.Timestamp", 1), `&`, ``, 1) + `,`,
 		`UpdatedAt:` + strings.Replace(strings.Replace(this.UpdatedAt.String(), "Timestamp", "google_protobuf4.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Extensions:` + mapStringForExtensions + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`SandboxID:` + fmt.Sprintf("%v", this.SandboxID) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1513,6 +1604,175 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContainers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContainers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContainers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContainers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthContainers
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Extensions == nil {
+				m.Extensions = make(map[string]google_protobuf1.Any)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowContainers
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowContainers
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthContainers
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthContainers
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &google_protobuf1.Any{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.Extensions[mapkey] = *mapvalue
+			} else {
+				var mapvalue google_protobuf1.Any
+				m.Extensions[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContainers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SandboxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContainers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContainers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SandboxID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipContainers(dAtA[iNdEx:])
@@ -2507,52 +2767,57 @@ func init() {
 }
 
 var fileDescriptorContainers = []byte{
-	// 738 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcb, 0x92, 0xd2, 0x40,
-	0x14, 0x9d, 0x40, 0x26, 0x0c, 0x97, 0x8d, 0xd5, 0x22, 0xc6, 0x58, 0x05, 0x98, 0x15, 0x0b, 0x0d,
-	0x0e, 0x5a, 0x3a, 0x8f, 0xd5, 0x30, 0xaf, 0xb2, 0x6a, 0xc6, 0x9a, 0x6a, 0x75, 0xa3, 0x8b, 0x31,
-	0x40, 0xc3, 0x44, 0x92, 0x74, 0x4c, 0x37, 0x54, 0x51, 0x2e, 0xf4, 0x13, 0xfc, 0x0b, 0x7f, 0x65,
-	0x96, 0x2e, 0x5d, 0xcd, 0x83, 0x2f, 0xb1, 0xd2, 0x49, 0x26, 0xc8, 0xa3, 0x84, 0x51, 0x76, 0x7d,
-	0xe9, 0x7b, 0xee, 0x3d, 0x39, 0xf7, 0xdc, 0x10, 0x38, 0xea, 0x58, 0xfc, 0xac, 0xd7, 0x30, 0x9a,
-	0xd4, 0xa9, 0x36, 0xa9, 0xcb, 0x4d, 0xcb, 0x25, 0x7e, 0x6b, 0xf4, 0x68, 0x7a, 0x56, 0x95, 0x11,
-	0xbf, 0x6f, 0x35, 0x09, 0x4b, 0x7e, 0x67, 0xd5, 0xfe, 0xfa, 0x48, 0x64, 0x78, 0x3e, 0xe5, 0x14,
-	0x3d, 0x4a, 0x70, 0x46, 0x8c, 0x31, 0x46, 0xb2, 0xfa, 0xeb, 0x5a, 0xbe, 0x43, 0x3b, 0x54, 0x64,
-	0x57, 0x83, 0x53, 0x08, 0xd4, 0x1e, 0x74, 0x28, 0xed, 0xd8, 0xa4, 0x2a, 0xa2, 0x46, 0xaf, 0x5d,
-	0x35, 0xdd, 0x41, 0x74, 0xf5, 0x70, 0xfc, 0x8a, 0x38, 0x1e, 0x8f, 0x2f, 0xcb, 0xe3, 0x97, 0x6d,
-	0x8b, 0xd8, 0xad, 0x53, 0xc7, 0x64, 0xdd, 0x28, 0xa3, 0x34, 0x9e, 0xc1, 0x2d, 0x87, 0x30, 0x6e,
-	0x3a, 0x5e, 0x98, 0xa0, 0x5f, 0xca, 0x90, 0xdd, 0x8d, 0x29, 0xa2, 0x02, 0xa4, 0xac, 0x96, 0x2a,
-	0x95, 0xa5, 0x4a, 0xb6, 0xae, 0x0c, 0x2f, 0x4a, 0xa9, 0x57, 0x7b, 0x38, 0x65, 0xb5, 0xd0, 0x09,
-	0x28, 0xb6, 0xd9, 0x20, 0x36, 0x53, 0x53, 0xe5, 0x74, 0x25, 0x57, 0xdb, 0x30, 0xfe, 0xfa, 0xa8,
-	0xc6, 0x4d, 0x55, 0xe3, 0x48, 0x40, 0xf7, 0x5d, 0xee, 0x0f, 0x70, 0x54, 0x07, 0xe5, 0x61, 0xd5,
-	0x72, 0xcc, 0x0e, 0x51, 0xd3, 0x41, 0x33, 0x1c, 0x06, 0xe8, 0x35, 0x64, 0xfc, 0x9e, 0x1b, 0x70,
-	0x54, 0xe5, 0xb2, 0x54, 0xc9, 0xd5, 0x9e, 0x2f, 0xd4, 0x08, 0x87, 0x58, 0x1c, 0x17, 0x41, 0x15,
-	0x90, 0x99, 0x47, 0x9a, 0xea, 0xaa, 0x28, 0x96, 0x37, 0x42, 0x35, 0x8c, 0x58, 0x0d, 0x63, 0xc7,
-	0x1d, 0x60, 0x91, 0x81, 0xca, 0x90, 0x63, 0xae, 0xe9, 0xb1, 0x33, 0xca, 0x39, 0xf1, 0x55, 0x45,
-	0xb0, 0x1a, 0xfd, 0x09, 0xe9, 0xa0, 0xf8, 0x94, 0xf2, 0x36, 0x53, 0x33, 0x42, 0x1f, 0x18, 0x5e,
-	0x94, 0x14, 0x4c, 0x29, 0x3f, 0x78, 0x83, 0xa3, 0x1b, 0xb4, 0x0b, 0xd0, 0xf4, 0x89, 0xc9, 0x49,
-	0xeb, 0xd4, 0xe4, 0xea, 0x9a, 0xe8, 0xaa, 0x4d, 0x74, 0x7d, 0x1b, 0xcf, 0xa0, 0xbe, 0x76, 0x7e,
-	0x51, 0x5a, 0xf9, 0x7e, 0x59, 0x92, 0x70, 0x36, 0xc2, 0xed, 0xf0, 0xa0, 0x48, 0xcf, 0x6b, 0xc5,
-	0x45, 0xb2, 0x8b, 0x14, 0x89, 0x70, 0x3b, 0x5c, 0xdb, 0x84, 0xdc, 0x88, 0xec, 0xe8, 0x0e, 0xa4,
-	0xbb, 0x64, 0x10, 0x4e, 0x16, 0x07, 0xc7, 0x60, 0x00, 0x7d, 0xd3, 0xee, 0x11, 0x35, 0x15, 0x0e,
-	0x40, 0x04, 0x5b, 0xa9, 0x0d, 0x49, 0x3b, 0x86, 0x4c, 0x24, 0x24, 0x42, 0x20, 0xbb, 0xa6, 0x43,
-	0x22, 0x9c, 0x38, 0x23, 0x03, 0x32, 0xd4, 0xe3, 0x16, 0x75, 0x99, 0x80, 0xce, 0x92, 0x35, 0x4e,
-	0xd2, 0x9f, 0xc0, 0xdd, 0x43, 0xc2, 0x6f, 0x86, 0x84, 0xc9, 0xe7, 0x1e, 0x61, 0x7c, 0x96, 0xd5,
-	0xf4, 0x33, 0xc8, 0xff, 0x99, 0xce, 0x3c, 0xea, 0x32, 0x82, 0x4e, 0x20, 0x7b, 0x33, 0x76, 0x01,
-	0xcb, 0xd5, 0x1e, 0x2f, 0x62, 0x8e, 0xba, 0x1c, 0xc8, 0x84, 0x93, 0x22, 0xfa, 0x3a, 0xdc, 0x3b,
-	0xb2, 0x58, 0xd2, 0x8a, 0xc5, 0xd4, 0x54, 0xc8, 0xb4, 0x2d, 0x9b, 0x13, 0x9f, 0xa9, 0x52, 0x39,
-	0x5d, 0xc9, 0xe2, 0x38, 0xd4, 0x6d, 0x28, 0x8c, 0x43, 0x22, 0x7a, 0x18, 0x20, 0x69, 0x2c, 0x60,
-	0xb7, 0xe3, 0x37, 0x52, 0x45, 0xff, 0x04, 0x85, 0x5d, 0xe1, 0x8a, 0x09, 0xf1, 0xfe, 0xbf, 0x18,
-	0x5d, 0xb8, 0x3f, 0xd1, 0x6b, 0x69, 0xca, 0xff, 0x90, 0xa0, 0xf0, 0x4e, 0x58, 0x75, 0xf9, 0x4f,
-	0x86, 0xb6, 0x21, 0x17, 0xae, 0x85, 0x78, 0x2f, 0x46, 0x9e, 0x9d, 0xdc, 0xa7, 0x83, 0xe0, 0xd5,
-	0x79, 0x6c, 0xb2, 0x2e, 0x8e, 0xb6, 0x2f, 0x38, 0x07, 0xb2, 0x4c, 0x10, 0x5d, 0x9a, 0x2c, 0x4f,
-	0xa1, 0xb0, 0x47, 0x6c, 0x32, 0x45, 0x95, 0x19, 0xcb, 0x52, 0xbb, 0x92, 0x01, 0x12, 0x33, 0xa2,
-	0x3e, 0xa4, 0x0f, 0x09, 0x47, 0x2f, 0xe6, 0xa0, 0x31, 0x65, 0x25, 0xb5, 0x97, 0x0b, 0xe3, 0x22,
-	0x29, 0xbe, 0x80, 0x1c, 0xac, 0x05, 0x9a, 0xe7, 0x6f, 0x61, 0xea, 0xca, 0x69, 0x9b, 0xb7, 0x40,
-	0x46, 0xcd, 0xbf, 0x82, 0x12, 0x3a, 0x17, 0xcd, 0x53, 0x64, 0xfa, 0x42, 0x69, 0x5b, 0xb7, 0x81,
-	0x26, 0x04, 0x42, 0x8f, 0xcc, 0x45, 0x60, 0xba, 0xef, 0xe7, 0x22, 0x30, 0xcb, 0x89, 0x1f, 0x40,
-	0x09, 0x7d, 0x33, 0x17, 0x81, 0xe9, 0x16, 0xd3, 0x0a, 0x13, 0x1b, 0xb1, 0x1f, 0x7c, 0x69, 0xd4,
-	0x3f, 0x9e, 0x5f, 0x17, 0x57, 0x7e, 0x5d, 0x17, 0x57, 0xbe, 0x0d, 0x8b, 0xd2, 0xf9, 0xb0, 0x28,
-	0xfd, 0x1c, 0x16, 0xa5, 0xab, 0x61, 0x51, 0x7a, 0x7f, 0xf0, 0x0f, 0x1f, 0x4f, 0xdb, 0x49, 0xd4,
-	0x50, 0x44, 0xc7, 0x67, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xef, 0xc2, 0x41, 0x7b, 0x8d, 0x09,
-	0x00, 0x00,
+	// 822 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xc3, 0x44,
+	0x10, 0xae, 0x9d, 0xd4, 0xa9, 0x27, 0x20, 0xd0, 0x12, 0x82, 0x31, 0x52, 0x12, 0x72, 0x8a, 0x50,
+	0x71, 0x68, 0x40, 0xd0, 0x1f, 0x2e, 0x4d, 0xff, 0x54, 0xa9, 0x45, 0xd5, 0x16, 0x2e, 0x70, 0x28,
+	0x4e, 0xbc, 0x49, 0x4d, 0x1c, 0xaf, 0xf1, 0x6e, 0xa2, 0x46, 0x1c, 0xe0, 0x11, 0x78, 0x0b, 0x5e,
+	0xa5, 0x47, 0x8e, 0x9c, 0x42, 0xeb, 0x27, 0x41, 0x5e, 0xdb, 0xb1, 0x49, 0x52, 0x48, 0x02, 0xbd,
+	0xed, 0x78, 0xe7, 0x9b, 0xf9, 0xf6, 0x9b, 0x99, 0x5d, 0xc3, 0x55, 0xdf, 0xe6, 0xf7, 0xa3, 0x8e,
+	0xd1, 0xa5, 0xc3, 0x66, 0x97, 0xba, 0xdc, 0xb4, 0x5d, 0xe2, 0x5b, 0xd9, 0xa5, 0xe9, 0xd9, 0x4d,
+	0x46, 0xfc, 0xb1, 0xdd, 0x25, 0x2c, 0xfd, 0xce, 0x9a, 0xe3, 0xbd, 0x8c, 0x65, 0x78, 0x3e, 0xe5,
+	0x14, 0x7d, 0x98, 0xe2, 0x8c, 0x04, 0x63, 0x64, 0xbc, 0xc6, 0x7b, 0x7a, 0xa9, 0x4f, 0xfb, 0x54,
+	0x78, 0x37, 0xc3, 0x55, 0x04, 0xd4, 0xdf, 0xef, 0x53, 0xda, 0x77, 0x48, 0x53, 0x58, 0x9d, 0x51,
+	0xaf, 0x69, 0xba, 0x93, 0x78, 0xeb, 0x83, 0xf9, 0x2d, 0x32, 0xf4, 0x78, 0xb2, 0x59, 0x9b, 0xdf,
+	0xec, 0xd9, 0xc4, 0xb1, 0xee, 0x86, 0x26, 0x1b, 0xc4, 0x1e, 0xd5, 0x79, 0x0f, 0x6e, 0x0f, 0x09,
+	0xe3, 0xe6, 0xd0, 0x8b, 0x1c, 0xea, 0x81, 0x02, 0xea, 0x49, 0x42, 0x11, 0x95, 0x41, 0xb6, 0x2d,
+	0x4d, 0xaa, 0x49, 0x0d, 0xb5, 0xad, 0x04, 0xd3, 0xaa, 0x7c, 0x79, 0x8a, 0x65, 0xdb, 0x42, 0x37,
+	0xa0, 0x38, 0x66, 0x87, 0x38, 0x4c, 0x93, 0x6b, 0xb9, 0x46, 0xb1, 0xb5, 0x6f, 0xfc, 0xeb, 0x51,
+	0x8d, 0x59, 0x54, 0xe3, 0x4a, 0x40, 0xcf, 0x5c, 0xee, 0x4f, 0x70, 0x1c, 0x07, 0x95, 0x60, 0xdb,
+	0x1e, 0x9a, 0x7d, 0xa2, 0xe5, 0xc2, 0x64, 0x38, 0x32, 0xd0, 0x57, 0x50, 0xf0, 0x47, 0x6e, 0xc8,
+	0x51, 0xcb, 0xd7, 0xa4, 0x46, 0xb1, 0xf5, 0xd9, 0x5a, 0x89, 0x70, 0x84, 0xc5, 0x49, 0x10, 0xd4,
+	0x80, 0x3c, 0xf3, 0x48, 0x57, 0xdb, 0x16, 0xc1, 0x4a, 0x46, 0xa4, 0x86, 0x91, 0xa8, 0x61, 0x1c,
+	0xbb, 0x13, 0x2c, 0x3c, 0x50, 0x0d, 0x8a, 0xcc, 0x35, 0x3d, 0x76, 0x4f, 0x39, 0x27, 0xbe, 0xa6,
+	0x08, 0x56, 0xd9, 0x4f, 0xa8, 0x0e, 0x8a, 0x4f, 0x29, 0xef, 0x31, 0xad, 0x20, 0xf4, 0x81, 0x60,
+	0x5a, 0x55, 0x30, 0xa5, 0xfc, 0xfc, 0x16, 0xc7, 0x3b, 0xe8, 0x04, 0xa0, 0xeb, 0x13, 0x93, 0x13,
+	0xeb, 0xce, 0xe4, 0xda, 0x8e, 0xc8, 0xaa, 0x2f, 0x64, 0xfd, 0x3a, 0xa9, 0x41, 0x7b, 0xe7, 0x71,
+	0x5a, 0xdd, 0xfa, 0xf5, 0xcf, 0xaa, 0x84, 0xd5, 0x18, 0x77, 0xcc, 0xc3, 0x20, 0x23, 0xcf, 0x4a,
+	0x82, 0xa8, 0xeb, 0x04, 0x89, 0x71, 0xc7, 0x1c, 0x75, 0x00, 0xc8, 0x03, 0x27, 0x2e, 0xb3, 0xa9,
+	0xcb, 0x34, 0x10, 0x55, 0xfb, 0x72, 0x2d, 0x31, 0xcf, 0x66, 0x70, 0x51, 0xb9, 0x76, 0x3e, 0x4c,
+	0x83, 0x33, 0x51, 0x91, 0x0e, 0x3b, 0x3e, 0x19, 0xdb, 0xa1, 0xa1, 0x15, 0x6b, 0x52, 0x23, 0x8f,
+	0x67, 0x36, 0xda, 0x05, 0x60, 0xa6, 0x6b, 0x75, 0xe8, 0xc3, 0x9d, 0x6d, 0x69, 0x6f, 0x08, 0xc5,
+	0xde, 0x0c, 0xa6, 0x55, 0xf5, 0x36, 0xfa, 0x7a, 0x79, 0x8a, 0xd5, 0xd8, 0xe1, 0xd2, 0xd2, 0x0f,
+	0xa0, 0x98, 0x69, 0x12, 0xf4, 0x36, 0xe4, 0x06, 0x64, 0x12, 0xf5, 0x21, 0x0e, 0x97, 0x61, 0xbb,
+	0x8c, 0x4d, 0x67, 0x44, 0x34, 0x39, 0x6a, 0x17, 0x61, 0x1c, 0xca, 0xfb, 0x92, 0x7e, 0x0d, 0x85,
+	0xb8, 0xec, 0x08, 0x41, 0xde, 0x35, 0x87, 0x24, 0xc6, 0x89, 0x35, 0x32, 0xa0, 0x40, 0x3d, 0x2e,
+	0x44, 0x90, 0xff, 0xa1, 0x09, 0x12, 0x27, 0xfd, 0x16, 0xde, 0x9a, 0x3b, 0xf8, 0x12, 0x36, 0x1f,
+	0x65, 0xd9, 0xbc, 0x14, 0x32, 0xe5, 0x58, 0xff, 0x18, 0xde, 0xb9, 0x20, 0x7c, 0x26, 0x2d, 0x26,
+	0x3f, 0x8e, 0x08, 0xe3, 0x2f, 0x4d, 0x5b, 0xfd, 0x1e, 0x4a, 0x7f, 0x77, 0x67, 0x1e, 0x75, 0x19,
+	0x41, 0x37, 0xa0, 0xce, 0x8a, 0x25, 0x60, 0xc5, 0xd6, 0xee, 0x3a, 0x25, 0x8d, 0x4b, 0x98, 0x06,
+	0xa9, 0xef, 0xc1, 0xbb, 0x57, 0x36, 0x4b, 0x53, 0xb1, 0x84, 0x9a, 0x06, 0x85, 0x9e, 0xed, 0x70,
+	0xe2, 0x33, 0x4d, 0xaa, 0xe5, 0x1a, 0x2a, 0x4e, 0xcc, 0xba, 0x03, 0xe5, 0x79, 0x48, 0x4c, 0x0f,
+	0x03, 0xa4, 0x89, 0x05, 0x6c, 0x33, 0x7e, 0x99, 0x28, 0xf5, 0x1f, 0xa0, 0x7c, 0x22, 0x06, 0x63,
+	0x41, 0xbc, 0xff, 0x5f, 0x8c, 0x01, 0xbc, 0xb7, 0x90, 0xeb, 0xd5, 0x94, 0xff, 0x4d, 0x82, 0xf2,
+	0x37, 0x62, 0x5a, 0x5f, 0xff, 0x64, 0xe8, 0x08, 0x8a, 0xd1, 0xcd, 0x20, 0x9e, 0x06, 0x4d, 0x7e,
+	0xe1, 0x4a, 0x39, 0x0f, 0x5f, 0x8f, 0x6b, 0x93, 0x0d, 0x70, 0x7c, 0x01, 0x85, 0xeb, 0x50, 0x96,
+	0x05, 0xa2, 0xaf, 0x26, 0xcb, 0x27, 0x50, 0x3e, 0x25, 0x0e, 0xe1, 0x64, 0xd5, 0x61, 0x69, 0x3d,
+	0xe5, 0x01, 0x66, 0xce, 0x0c, 0x8d, 0x21, 0x77, 0x41, 0x38, 0xfa, 0x7c, 0x05, 0x1a, 0x4b, 0x46,
+	0x52, 0xff, 0x62, 0x6d, 0x5c, 0x2c, 0xc5, 0x4f, 0x90, 0x0f, 0xc7, 0x02, 0xad, 0xf2, 0x32, 0x2e,
+	0x1d, 0x39, 0xfd, 0x60, 0x03, 0x64, 0x9c, 0xfc, 0x67, 0x50, 0xa2, 0xce, 0x45, 0xab, 0x04, 0x59,
+	0x3e, 0x50, 0xfa, 0xe1, 0x26, 0xd0, 0x94, 0x40, 0xd4, 0x23, 0x2b, 0x11, 0x58, 0xde, 0xf7, 0xfa,
+	0xe1, 0x26, 0xd0, 0x98, 0xc0, 0x77, 0xa0, 0x44, 0x7d, 0xb3, 0x12, 0x81, 0xe5, 0x2d, 0xa6, 0x97,
+	0x17, 0x26, 0xe2, 0x2c, 0xfc, 0xd9, 0x6a, 0x7f, 0xff, 0xf8, 0x5c, 0xd9, 0xfa, 0xe3, 0xb9, 0xb2,
+	0xf5, 0x4b, 0x50, 0x91, 0x1e, 0x83, 0x8a, 0xf4, 0x7b, 0x50, 0x91, 0x9e, 0x82, 0x8a, 0xf4, 0xed,
+	0xf9, 0x7f, 0xf8, 0x7f, 0x3c, 0x4a, 0xad, 0x8e, 0x22, 0x32, 0x7e, 0xfa, 0xd7, 0x00, 0xc4, 0x1f,
+	0x72, 0xeb, 0x90, 0x0a, 0x00, 0x00,
 }
@@ -0,0 +1,747 @@
+// Code generated by protoc-gen-gogo.
+// source: github.com/containerd/containerd/api/services/daemon/v1/daemon.proto
+// DO NOT EDIT!
+
+/*
+	Package daemon is a generated protocol buffer package.
+
+	It is generated from these files:
+		github.com/containerd/containerd/api/services/daemon/v1/daemon.proto
+
+	It has these top-level messages:
+		ReloadRequest
+		ReloadResponse
+*/
+package daemon
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import strings "strings"
+import reflect "reflect"
+import github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type ReloadRequest struct {
+}
+
+func (m *ReloadRequest) Reset()                    { *m = ReloadRequest{} }
+func (*ReloadRequest) ProtoMessage()               {}
+func (*ReloadRequest) Descriptor() ([]byte, []int) { return fileDescriptorDaemon, []int{0} }
+
+type ReloadResponse struct {
+	// Applied are the settings that changed and were applied, such as
+	// "debug.level" or "plugins.images".
+	Applied []string `protobuf:"bytes,1,rep,name=applied" json:"applied,omitempty"`
+	// RestartRequired are the settings that changed but are only applied
+	// by a restart of the daemon.
+	RestartRequired []string `protobuf:"bytes,2,rep,name=restart_required,json=restartRequired" json:"restart_required,omitempty"`
+	// Failed are the settings whose new value could not be applied, with
+	// the error, the previous value being kept.
+	Failed map[string]string `protobuf:"bytes,3,rep,name=failed" json:"failed,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *ReloadResponse) Reset()                    { *m = ReloadResponse{} }
+func (*ReloadResponse) ProtoMessage()               {}
+func (*ReloadResponse) Descriptor() ([]byte, []int) { return fileDescriptorDaemon, []int{1} }
+
+func init() {
+	proto.RegisterType((*ReloadRequest)(nil), "containerd.services.daemon.v1.ReloadRequest")
+	proto.RegisterType((*ReloadResponse)(nil), "containerd.services.daemon.v1.ReloadResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Daemon service
+
+type DaemonClient interface {
+	// Reload reads the configuration file of the daemon again and applies
+	// the settings that can be changed while it runs, as on SIGHUP, without
+	// disturbing the running containers.
+	Reload(ctx context.Context, in *ReloadRequest, opts ...grpc.CallOption) (*ReloadResponse, error)
+}
+
+type daemonClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDaemonClient(cc *grpc.ClientConn) DaemonClient {
+	return &daemonClient{cc}
+}
+
+func (c *daemonClient) Reload(ctx context.Context, in *ReloadRequest, opts ...grpc.CallOption) (*ReloadResponse, error) {
+	out := new(ReloadResponse)
+	err := grpc.Invoke(ctx, "/containerd.services.daemon.v1.Daemon/Reload", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Daemon service
+
+type DaemonServer interface {
+	// Reload reads the configuration file of the daemon again and applies
+	// the settings that can be changed while it runs, as on SIGHUP, without
+	// disturbing the running containers.
+	Reload(context.Context, *ReloadRequest) (*ReloadResponse, error)
+}
+
+func RegisterDaemonServer(s *grpc.Server, srv DaemonServer) {
+	s.RegisterService(&_Daemon_serviceDesc, srv)
+}
+
+func _Daemon_Reload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Reload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.services.daemon.v1.Daemon/Reload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Reload(ctx, req.(*ReloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Daemon_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "containerd.services.daemon.v1.Daemon",
+	HandlerType: (*DaemonServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Reload",
+			Handler:    _Daemon_Reload_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/containerd/containerd/api/services/daemon/v1/daemon.proto",
+}
+
+func (m *ReloadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReloadRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ReloadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReloadResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Applied) > 0 {
+		for _, s := range m.Applied {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.RestartRequired) > 0 {
+		for _, s := range m.RestartRequired {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Failed) > 0 {
+		for k, _ := range m.Failed {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Failed[k]
+			mapSize := 1 + len(k) + sovDaemon(uint64(len(k))) + 1 + len(v) + sovDaemon(uint64(len(v)))
+			i = encodeVarintDaemon(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintDaemon(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintDaemon(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func encodeFixed64Daemon(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Daemon(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintDaemon(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ReloadRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ReloadResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Applied) > 0 {
+		for _, s := range m.Applied {
+			l = len(s)
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if len(m.RestartRequired) > 0 {
+		for _, s := range m.RestartRequired {
+			l = len(s)
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if len(m.Failed) > 0 {
+		for k, v := range m.Failed {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDaemon(uint64(len(k))) + 1 + len(v) + sovDaemon(uint64(len(v)))
+			n += mapEntrySize + 1 + sovDaemon(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func sovDaemon(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozDaemon(x uint64) (n int) {
+	return sovDaemon(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ReloadRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReloadRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReloadResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForFailed := make([]string, 0, len(this.Failed))
+	for k, _ := range this.Failed {
+		keysForFailed = append(keysForFailed, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFailed)
+	mapStringForFailed := "map[string]string{"
+	for _, k := range keysForFailed {
+		mapStringForFailed += fmt.Sprintf("%v: %v,", k, this.Failed[k])
+	}
+	mapStringForFailed += "}"
+	s := strings.Join([]string{`&ReloadResponse{`,
+		`Applied:` + fmt.Sprintf("%v", this.Applied) + `,`,
+		`RestartRequired:` + fmt.Sprintf("%v", this.RestartRequired) + `,`,
+		`Failed:` + mapStringForFailed + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDaemon(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ReloadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReloadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applied = append(m.Applied, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartRequired", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestartRequired = append(m.RestartRequired, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Failed == nil {
+				m.Failed = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDaemon
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDaemon
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthDaemon
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Failed[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Failed[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDaemon(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthDaemon
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowDaemon
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipDaemon(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthDaemon = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDaemon   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() {
+	proto.RegisterFile("github.com/containerd/containerd/api/services/daemon/v1/daemon.proto", fileDescriptorDaemon)
+}
+
+var fileDescriptorDaemon = []byte{
+	// 304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xb1, 0x4b, 0x03, 0x31,
+	0x14, 0xc6, 0x9b, 0x1e, 0x9e, 0x34, 0x45, 0x5b, 0x82, 0xc3, 0x51, 0xf0, 0x28, 0x9d, 0x2a, 0x68,
+	0x8e, 0xd6, 0xc5, 0x2a, 0x38, 0x48, 0x75, 0x37, 0x93, 0xb8, 0x48, 0xda, 0x3c, 0x35, 0x78, 0xbd,
+	0x5c, 0x93, 0xdc, 0x41, 0x37, 0xff, 0xbc, 0x8e, 0x8e, 0x8e, 0xf6, 0xfc, 0x47, 0xc4, 0xf4, 0x0e,
+	0xeb, 0x22, 0xea, 0xf6, 0xbd, 0x1f, 0x5f, 0xf2, 0x3e, 0xde, 0x87, 0xc7, 0x0f, 0xd2, 0x3e, 0x66,
+	0x13, 0x3a, 0x55, 0xb3, 0x68, 0xaa, 0x12, 0xcb, 0x65, 0x02, 0x5a, 0x6c, 0x4a, 0x9e, 0xca, 0xc8,
+	0x80, 0xce, 0xe5, 0x14, 0x4c, 0x24, 0x38, 0xcc, 0x54, 0x12, 0xe5, 0x83, 0x52, 0xd1, 0x54, 0x2b,
+	0xab, 0xc8, 0xfe, 0x97, 0x9f, 0x56, 0x5e, 0x5a, 0x3a, 0xf2, 0x41, 0xaf, 0x85, 0x77, 0x18, 0xc4,
+	0x8a, 0x0b, 0x06, 0xf3, 0x0c, 0x8c, 0xed, 0xbd, 0x23, 0xbc, 0x5b, 0x11, 0x93, 0xaa, 0xc4, 0x00,
+	0x09, 0xf0, 0x36, 0x4f, 0xd3, 0x58, 0x82, 0x08, 0x50, 0xd7, 0xeb, 0x37, 0x58, 0x35, 0x92, 0x03,
+	0xdc, 0xd6, 0x60, 0x2c, 0xd7, 0xf6, 0x4e, 0xc3, 0x3c, 0x93, 0x1a, 0x44, 0x50, 0x77, 0x96, 0x56,
+	0xc9, 0x59, 0x89, 0xc9, 0x35, 0xf6, 0xef, 0xb9, 0x8c, 0x41, 0x04, 0x5e, 0xd7, 0xeb, 0x37, 0x87,
+	0x23, 0xfa, 0x63, 0x30, 0xfa, 0x3d, 0x03, 0xbd, 0x72, 0x6f, 0x2f, 0x13, 0xab, 0x17, 0xac, 0xfc,
+	0xa8, 0x33, 0xc2, 0xcd, 0x0d, 0x4c, 0xda, 0xd8, 0x7b, 0x82, 0x45, 0x80, 0xba, 0xa8, 0xdf, 0x60,
+	0x9f, 0x92, 0xec, 0xe1, 0xad, 0x9c, 0xc7, 0x19, 0x04, 0x75, 0xc7, 0xd6, 0xc3, 0x69, 0xfd, 0x04,
+	0x0d, 0x15, 0xf6, 0xc7, 0x6e, 0x15, 0x01, 0xec, 0xaf, 0x57, 0x91, 0xc3, 0x5f, 0x26, 0x72, 0x77,
+	0xea, 0x1c, 0xfd, 0x29, 0xff, 0xc5, 0xcd, 0x72, 0x15, 0xd6, 0x5e, 0x57, 0x61, 0xed, 0xb9, 0x08,
+	0xd1, 0xb2, 0x08, 0xd1, 0x4b, 0x11, 0xa2, 0xb7, 0x22, 0x44, 0xb7, 0xe7, 0xff, 0xac, 0xf9, 0x6c,
+	0xad, 0x26, 0xbe, 0xeb, 0xf9, 0xf8, 0x63, 0x00, 0x6a, 0x30, 0x04, 0xb0, 0x2f, 0x02, 0x00, 0x00,
+}
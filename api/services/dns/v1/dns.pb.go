@@ -0,0 +1,1707 @@
+// Code generated by protoc-gen-gogo.
+// source: github.com/containerd/containerd/api/services/dns/v1/dns.proto
+// DO NOT EDIT!
+
+/*
+	Package dns is a generated protocol buffer package.
+
+	It is generated from these files:
+		github.com/containerd/containerd/api/services/dns/v1/dns.proto
+
+	It has these top-level messages:
+		HostEntry
+		DNSConfig
+		UpdateDNSRequest
+		UpdateDNSResponse
+		GetDNSRequest
+		GetDNSResponse
+		DeleteDNSRequest
+*/
+package dns
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import google_protobuf1 "github.com/golang/protobuf/ptypes/empty"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import strings "strings"
+import reflect "reflect"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type HostEntry struct {
+	IP        string   `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Hostnames []string `protobuf:"bytes,2,rep,name=hostnames" json:"hostnames,omitempty"`
+}
+
+func (m *HostEntry) Reset()                    { *m = HostEntry{} }
+func (*HostEntry) ProtoMessage()               {}
+func (*HostEntry) Descriptor() ([]byte, []int) { return fileDescriptorDns, []int{0} }
+
+type DNSConfig struct {
+	// Hosts are the entries of the hosts file, following the entries of
+	// localhost.
+	Hosts []HostEntry `protobuf:"bytes,1,rep,name=hosts" json:"hosts"`
+	// Nameservers, Search and Options are the nameserver, search and options
+	// lines of the resolv.conf file.
+	Nameservers []string `protobuf:"bytes,2,rep,name=nameservers" json:"nameservers,omitempty"`
+	Search      []string `protobuf:"bytes,3,rep,name=search" json:"search,omitempty"`
+	Options     []string `protobuf:"bytes,4,rep,name=options" json:"options,omitempty"`
+}
+
+func (m *DNSConfig) Reset()                    { *m = DNSConfig{} }
+func (*DNSConfig) ProtoMessage()               {}
+func (*DNSConfig) Descriptor() ([]byte, []int) { return fileDescriptorDns, []int{1} }
+
+type UpdateDNSRequest struct {
+	ContainerID string    `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Config      DNSConfig `protobuf:"bytes,2,opt,name=config" json:"config"`
+}
+
+func (m *UpdateDNSRequest) Reset()                    { *m = UpdateDNSRequest{} }
+func (*UpdateDNSRequest) ProtoMessage()               {}
+func (*UpdateDNSRequest) Descriptor() ([]byte, []int) { return fileDescriptorDns, []int{2} }
+
+type UpdateDNSResponse struct {
+	// HostsPath and ResolvConfPath are the paths of the files on the host,
+	// to be bind mounted at /etc/hosts and /etc/resolv.conf.
+	HostsPath      string `protobuf:"bytes,1,opt,name=hosts_path,json=hostsPath,proto3" json:"hosts_path,omitempty"`
+	ResolvConfPath string `protobuf:"bytes,2,opt,name=resolv_conf_path,json=resolvConfPath,proto3" json:"resolv_conf_path,omitempty"`
+}
+
+func (m *UpdateDNSResponse) Reset()                    { *m = UpdateDNSResponse{} }
+func (*UpdateDNSResponse) ProtoMessage()               {}
+func (*UpdateDNSResponse) Descriptor() ([]byte, []int) { return fileDescriptorDns, []int{3} }
+
+type GetDNSRequest struct {
+	ContainerID string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+}
+
+func (m *GetDNSRequest) Reset()                    { *m = GetDNSRequest{} }
+func (*GetDNSRequest) ProtoMessage()               {}
+func (*GetDNSRequest) Descriptor() ([]byte, []int) { return fileDescriptorDns, []int{4} }
+
+type GetDNSResponse struct {
+	Config         DNSConfig `protobuf:"bytes,1,opt,name=config" json:"config"`
+	HostsPath      string    `protobuf:"bytes,2,opt,name=hosts_path,json=hostsPath,proto3" json:"hosts_path,omitempty"`
+	ResolvConfPath string    `protobuf:"bytes,3,opt,name=resolv_conf_path,json=resolvConfPath,proto3" json:"resolv_conf_path,omitempty"`
+}
+
+func (m *GetDNSResponse) Reset()                    { *m = GetDNSResponse{} }
+func (*GetDNSResponse) ProtoMessage()               {}
+func (*GetDNSResponse) Descriptor() ([]byte, []int) { return fileDescriptorDns, []int{5} }
+
+type DeleteDNSRequest struct {
+	ContainerID string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+}
+
+func (m *DeleteDNSRequest) Reset()                    { *m = DeleteDNSRequest{} }
+func (*DeleteDNSRequest) ProtoMessage()               {}
+func (*DeleteDNSRequest) Descriptor() ([]byte, []int) { return fileDescriptorDns, []int{6} }
+
+func init() {
+	proto.RegisterType((*HostEntry)(nil), "containerd.services.dns.v1.HostEntry")
+	proto.RegisterType((*DNSConfig)(nil), "containerd.services.dns.v1.DNSConfig")
+	proto.RegisterType((*UpdateDNSRequest)(nil), "containerd.services.dns.v1.UpdateDNSRequest")
+	proto.RegisterType((*UpdateDNSResponse)(nil), "containerd.services.dns.v1.UpdateDNSResponse")
+	proto.RegisterType((*GetDNSRequest)(nil), "containerd.services.dns.v1.GetDNSRequest")
+	proto.RegisterType((*GetDNSResponse)(nil), "containerd.services.dns.v1.GetDNSResponse")
+	proto.RegisterType((*DeleteDNSRequest)(nil), "containerd.services.dns.v1.DeleteDNSRequest")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for DNS service
+
+type DNSClient interface {
+	// Update replaces the DNS configuration of the container and rewrites its
+	// files, creating them when the container has none.
+	Update(ctx context.Context, in *UpdateDNSRequest, opts ...grpc.CallOption) (*UpdateDNSResponse, error)
+	// Get returns the DNS configuration of the container.
+	Get(ctx context.Context, in *GetDNSRequest, opts ...grpc.CallOption) (*GetDNSResponse, error)
+	// Delete removes the files of the container. They are also removed when
+	// the container is deleted.
+	Delete(ctx context.Context, in *DeleteDNSRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+}
+
+type dNSClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDNSClient(cc *grpc.ClientConn) DNSClient {
+	return &dNSClient{cc}
+}
+
+func (c *dNSClient) Update(ctx context.Context, in *UpdateDNSRequest, opts ...grpc.CallOption) (*UpdateDNSResponse, error) {
+	out := new(UpdateDNSResponse)
+	err := grpc.Invoke(ctx, "/containerd.services.dns.v1.DNS/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dNSClient) Get(ctx context.Context, in *GetDNSRequest, opts ...grpc.CallOption) (*GetDNSResponse, error) {
+	out := new(GetDNSResponse)
+	err := grpc.Invoke(ctx, "/containerd.services.dns.v1.DNS/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dNSClient) Delete(ctx context.Context, in *DeleteDNSRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/containerd.services.dns.v1.DNS/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for DNS service
+
+type DNSServer interface {
+	// Update replaces the DNS configuration of the container and rewrites its
+	// files, creating them when the container has none.
+	Update(context.Context, *UpdateDNSRequest) (*UpdateDNSResponse, error)
+	// Get returns the DNS configuration of the container.
+	Get(context.Context, *GetDNSRequest) (*GetDNSResponse, error)
+	// Delete removes the files of the container. They are also removed when
+	// the container is deleted.
+	Delete(context.Context, *DeleteDNSRequest) (*google_protobuf1.Empty, error)
+}
+
+func RegisterDNSServer(s *grpc.Server, srv DNSServer) {
+	s.RegisterService(&_DNS_serviceDesc, srv)
+}
+
+func _DNS_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDNSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DNSServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.services.dns.v1.DNS/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DNSServer).Update(ctx, req.(*UpdateDNSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DNS_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDNSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DNSServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.services.dns.v1.DNS/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DNSServer).Get(ctx, req.(*GetDNSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DNS_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDNSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DNSServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.services.dns.v1.DNS/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DNSServer).Delete(ctx, req.(*DeleteDNSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DNS_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "containerd.services.dns.v1.DNS",
+	HandlerType: (*DNSServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Update",
+			Handler:    _DNS_Update_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _DNS_Get_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _DNS_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/containerd/containerd/api/services/dns/v1/dns.proto",
+}
+
+func (m *HostEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.IP) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDns(dAtA, i, uint64(len(m.IP)))
+		i += copy(dAtA[i:], m.IP)
+	}
+	if len(m.Hostnames) > 0 {
+		for _, s := range m.Hostnames {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *DNSConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DNSConfig) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hosts) > 0 {
+		for _, msg := range m.Hosts {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintDns(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Nameservers) > 0 {
+		for _, s := range m.Nameservers {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Search) > 0 {
+		for _, s := range m.Search {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Options) > 0 {
+		for _, s := range m.Options {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *UpdateDNSRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDNSRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDns(dAtA, i, uint64(len(m.ContainerID)))
+		i += copy(dAtA[i:], m.ContainerID)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintDns(dAtA, i, uint64(m.Config.Size()))
+	n1, err := m.Config.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	return i, nil
+}
+
+func (m *UpdateDNSResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDNSResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostsPath) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDns(dAtA, i, uint64(len(m.HostsPath)))
+		i += copy(dAtA[i:], m.HostsPath)
+	}
+	if len(m.ResolvConfPath) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDns(dAtA, i, uint64(len(m.ResolvConfPath)))
+		i += copy(dAtA[i:], m.ResolvConfPath)
+	}
+	return i, nil
+}
+
+func (m *GetDNSRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDNSRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDns(dAtA, i, uint64(len(m.ContainerID)))
+		i += copy(dAtA[i:], m.ContainerID)
+	}
+	return i, nil
+}
+
+func (m *GetDNSResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDNSResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintDns(dAtA, i, uint64(m.Config.Size()))
+	n2, err := m.Config.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	if len(m.HostsPath) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDns(dAtA, i, uint64(len(m.HostsPath)))
+		i += copy(dAtA[i:], m.HostsPath)
+	}
+	if len(m.ResolvConfPath) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDns(dAtA, i, uint64(len(m.ResolvConfPath)))
+		i += copy(dAtA[i:], m.ResolvConfPath)
+	}
+	return i, nil
+}
+
+func (m *DeleteDNSRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteDNSRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDns(dAtA, i, uint64(len(m.ContainerID)))
+		i += copy(dAtA[i:], m.ContainerID)
+	}
+	return i, nil
+}
+
+func encodeFixed64Dns(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Dns(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintDns(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *HostEntry) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.IP)
+	if l > 0 {
+		n += 1 + l + sovDns(uint64(l))
+	}
+	if len(m.Hostnames) > 0 {
+		for _, s := range m.Hostnames {
+			l = len(s)
+			n += 1 + l + sovDns(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DNSConfig) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Hosts) > 0 {
+		for _, e := range m.Hosts {
+			l = e.Size()
+			n += 1 + l + sovDns(uint64(l))
+		}
+	}
+	if len(m.Nameservers) > 0 {
+		for _, s := range m.Nameservers {
+			l = len(s)
+			n += 1 + l + sovDns(uint64(l))
+		}
+	}
+	if len(m.Search) > 0 {
+		for _, s := range m.Search {
+			l = len(s)
+			n += 1 + l + sovDns(uint64(l))
+		}
+	}
+	if len(m.Options) > 0 {
+		for _, s := range m.Options {
+			l = len(s)
+			n += 1 + l + sovDns(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateDNSRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerID)
+	if l > 0 {
+		n += 1 + l + sovDns(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovDns(uint64(l))
+	return n
+}
+
+func (m *UpdateDNSResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.HostsPath)
+	if l > 0 {
+		n += 1 + l + sovDns(uint64(l))
+	}
+	l = len(m.ResolvConfPath)
+	if l > 0 {
+		n += 1 + l + sovDns(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDNSRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerID)
+	if l > 0 {
+		n += 1 + l + sovDns(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDNSResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovDns(uint64(l))
+	l = len(m.HostsPath)
+	if l > 0 {
+		n += 1 + l + sovDns(uint64(l))
+	}
+	l = len(m.ResolvConfPath)
+	if l > 0 {
+		n += 1 + l + sovDns(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteDNSRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerID)
+	if l > 0 {
+		n += 1 + l + sovDns(uint64(l))
+	}
+	return n
+}
+
+func sovDns(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozDns(x uint64) (n int) {
+	return sovDns(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *HostEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HostEntry{`,
+		`IP:` + fmt.Sprintf("%v", this.IP) + `,`,
+		`Hostnames:` + fmt.Sprintf("%v", this.Hostnames) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DNSConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DNSConfig{`,
+		`Hosts:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Hosts), "HostEntry", "HostEntry", 1), `&`, ``, 1) + `,`,
+		`Nameservers:` + fmt.Sprintf("%v", this.Nameservers) + `,`,
+		`Search:` + fmt.Sprintf("%v", this.Search) + `,`,
+		`Options:` + fmt.Sprintf("%v", this.Options) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateDNSRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateDNSRequest{`,
+		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
+		`Config:` + strings.Replace(strings.Replace(this.Config.String(), "DNSConfig", "DNSConfig", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateDNSResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateDNSResponse{`,
+		`HostsPath:` + fmt.Sprintf("%v", this.HostsPath) + `,`,
+		`ResolvConfPath:` + fmt.Sprintf("%v", this.ResolvConfPath) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDNSRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetDNSRequest{`,
+		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDNSResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetDNSResponse{`,
+		`Config:` + strings.Replace(strings.Replace(this.Config.String(), "DNSConfig", "DNSConfig", 1), `&`, ``, 1) + `,`,
+		`HostsPath:` + fmt.Sprintf("%v", this.HostsPath) + `,`,
+		`ResolvConfPath:` + fmt.Sprintf("%v", this.ResolvConfPath) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteDNSRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteDNSRequest{`,
+		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDns(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *HostEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDns
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDns
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDns
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostnames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDns
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDns
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hostnames = append(m.Hostnames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDns(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDns
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DNSConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDns
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DNSConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DNSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDns
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDns
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hosts = append(m.Hosts, HostEntry{})
+			if err := m.Hosts[len(m.Hosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nameservers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDns
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDns
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nameservers = append(m.Nameservers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDns
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDns
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Search = append(m.Search, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDns
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDns
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDns(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDns
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateDNSRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDns
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDNSRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDNSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDns
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDns
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDns
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDns
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDns(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDns
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateDNSResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDns
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDNSResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDNSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostsPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDns
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDns
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostsPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvConfPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDns
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDns
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolvConfPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDns(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDns
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDNSRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDns
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDNSRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDNSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDns
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDns
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDns(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDns
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDNSResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDns
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDNSResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDNSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDns
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDns
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostsPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDns
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDns
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostsPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvConfPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDns
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDns
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolvConfPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDns(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDns
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteDNSRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDns
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteDNSRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteDNSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDns
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDns
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDns(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDns
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDns(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDns
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDns
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDns
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthDns
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowDns
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipDns(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthDns = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDns   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() {
+	proto.RegisterFile("github.com/containerd/containerd/api/services/dns/v1/dns.proto", fileDescriptorDns)
+}
+
+var fileDescriptorDns = []byte{
+	// 532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x3b, 0xc9, 0x1a, 0xe9, 0xab, 0xae, 0x75, 0x90, 0x12, 0xa2, 0xa6, 0x25, 0x20, 0x54,
+	0xd1, 0x84, 0xad, 0x17, 0x41, 0x10, 0xb6, 0xed, 0xba, 0xf6, 0x52, 0x96, 0x14, 0x2f, 0xb2, 0x50,
+	0xd2, 0x66, 0x36, 0x0d, 0xb4, 0x99, 0x98, 0x99, 0x06, 0xf6, 0xe6, 0xdd, 0xff, 0xc0, 0x83, 0x07,
+	0xff, 0x9a, 0x1e, 0x3d, 0x7a, 0x2a, 0x6e, 0xfe, 0x12, 0x49, 0x26, 0x69, 0x6b, 0xd1, 0x6e, 0xd9,
+	0x3d, 0x65, 0xe6, 0xbd, 0xef, 0xfb, 0xf1, 0x79, 0x13, 0x1e, 0xbc, 0xf3, 0x7c, 0x3e, 0x99, 0x8f,
+	0xcc, 0x31, 0x9d, 0x59, 0x63, 0x1a, 0x70, 0xc7, 0x0f, 0x48, 0xe4, 0x6e, 0x1e, 0x9d, 0xd0, 0xb7,
+	0x18, 0x89, 0x62, 0x7f, 0x4c, 0x98, 0xe5, 0x06, 0xcc, 0x8a, 0x8f, 0xd2, 0x8f, 0x19, 0x46, 0x94,
+	0x53, 0xac, 0xad, 0x95, 0x66, 0xa1, 0x32, 0x53, 0x77, 0x7c, 0xa4, 0x3d, 0xf2, 0xa8, 0x47, 0x33,
+	0x99, 0x95, 0x9e, 0x44, 0x84, 0xf6, 0xd8, 0xa3, 0xd4, 0x9b, 0x12, 0x2b, 0xbb, 0x8d, 0xe6, 0x17,
+	0x16, 0x99, 0x85, 0xfc, 0x52, 0x38, 0x8d, 0x63, 0x28, 0x7f, 0xa0, 0x8c, 0x9f, 0x04, 0x3c, 0xba,
+	0xc4, 0x35, 0x90, 0xfc, 0x50, 0x45, 0x0d, 0xd4, 0x2c, 0xb7, 0x95, 0x64, 0x59, 0x97, 0x7a, 0x67,
+	0xb6, 0xe4, 0x87, 0xf8, 0x09, 0x94, 0x27, 0x94, 0xf1, 0xc0, 0x99, 0x11, 0xa6, 0x4a, 0x0d, 0xb9,
+	0x59, 0xb6, 0xd7, 0x06, 0xe3, 0x07, 0x82, 0x72, 0xb7, 0x3f, 0xe8, 0xd0, 0xe0, 0xc2, 0xf7, 0xf0,
+	0x31, 0xdc, 0x49, 0x5d, 0x4c, 0x45, 0x0d, 0xb9, 0x59, 0x69, 0x3d, 0x33, 0xff, 0xdf, 0xaf, 0xb9,
+	0xaa, 0xdc, 0x3e, 0x58, 0x2c, 0xeb, 0x25, 0x5b, 0x44, 0xe2, 0x06, 0x54, 0xb2, 0xcc, 0x24, 0x8a,
+	0x49, 0x54, 0x14, 0xdc, 0x34, 0xe1, 0x1a, 0x28, 0x8c, 0x38, 0xd1, 0x78, 0xa2, 0xca, 0x99, 0x33,
+	0xbf, 0x61, 0x15, 0xee, 0xd2, 0x90, 0xfb, 0x34, 0x60, 0xea, 0x41, 0xe6, 0x28, 0xae, 0xc6, 0x57,
+	0x04, 0xd5, 0x8f, 0xa1, 0xeb, 0x70, 0xd2, 0xed, 0x0f, 0x6c, 0xf2, 0x79, 0x4e, 0x18, 0xc7, 0x2d,
+	0xb8, 0xb7, 0xea, 0x6e, 0xe8, 0xbb, 0x39, 0xf9, 0x83, 0x64, 0x59, 0xaf, 0x74, 0x0a, 0x7b, 0xaf,
+	0x6b, 0x57, 0x56, 0xa2, 0x9e, 0x8b, 0x3b, 0xa0, 0x8c, 0x33, 0x52, 0x55, 0x6a, 0xa0, 0xeb, 0x00,
+	0x57, 0x63, 0xc9, 0x01, 0xf3, 0x50, 0xe3, 0x1c, 0x1e, 0x6e, 0x34, 0xc3, 0x42, 0x1a, 0x30, 0x82,
+	0x9f, 0x02, 0x64, 0xfc, 0xc3, 0xd0, 0xe1, 0x13, 0xd1, 0x8b, 0x18, 0x33, 0x3b, 0x73, 0xf8, 0x04,
+	0x37, 0xa1, 0x1a, 0x11, 0x46, 0xa7, 0xf1, 0x30, 0x4d, 0x22, 0x44, 0x52, 0x26, 0x3a, 0x14, 0xf6,
+	0xb4, 0x52, 0xaa, 0x34, 0x3a, 0x70, 0xff, 0x94, 0xf0, 0xdb, 0x71, 0x1a, 0xdf, 0x11, 0x1c, 0x16,
+	0x59, 0xf2, 0x06, 0xd7, 0xe8, 0xe8, 0xc6, 0xe8, 0x5b, 0x94, 0xd2, 0x3e, 0x94, 0xf2, 0x3f, 0x29,
+	0xdf, 0x43, 0xb5, 0x4b, 0xa6, 0xe4, 0xb6, 0x0f, 0xda, 0xfa, 0x26, 0x81, 0xdc, 0xed, 0x0f, 0x30,
+	0x01, 0x45, 0xbc, 0x09, 0x7e, 0xb9, 0x8b, 0x6b, 0xfb, 0x27, 0xd2, 0x5e, 0xed, 0xa9, 0xce, 0x87,
+	0x78, 0x0e, 0xf2, 0x29, 0xe1, 0xf8, 0xf9, 0xae, 0xa8, 0xbf, 0x5e, 0x4f, 0x7b, 0xb1, 0x8f, 0x34,
+	0xcf, 0xde, 0x07, 0x45, 0x0c, 0x65, 0x37, 0xc4, 0xf6, 0xe0, 0xb4, 0x9a, 0x29, 0x96, 0x84, 0x59,
+	0x2c, 0x09, 0xf3, 0x24, 0x5d, 0x12, 0x6d, 0x7b, 0x71, 0xa5, 0x97, 0x7e, 0x5d, 0xe9, 0xa5, 0x2f,
+	0x89, 0x8e, 0x16, 0x89, 0x8e, 0x7e, 0x26, 0x3a, 0xfa, 0x9d, 0xe8, 0xe8, 0xd3, 0x9b, 0x9b, 0xec,
+	0xb1, 0xb7, 0x6e, 0xc0, 0x46, 0x4a, 0x56, 0xe3, 0xf5, 0x9f, 0x01, 0x00, 0x47, 0xd3, 0xae, 0xda,
+	0x0a, 0x05, 0x00, 0x00,
+}
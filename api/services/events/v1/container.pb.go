@@ -2,48 +2,6 @@
 // source: github.com/containerd/containerd/api/services/events/v1/container.proto
 // DO NOT EDIT!
 
-/*
-	Package events is a generated protocol buffer package.
-
-	It is generated from these files:
-		github.com/containerd/containerd/api/services/events/v1/container.proto
-		github.com/containerd/containerd/api/services/events/v1/content.proto
-		github.com/containerd/containerd/api/services/events/v1/events.proto
-		github.com/containerd/containerd/api/services/events/v1/image.proto
-		github.com/containerd/containerd/api/services/events/v1/namespace.proto
-		github.com/containerd/containerd/api/services/events/v1/snapshot.proto
-		github.com/containerd/containerd/api/services/events/v1/task.proto
-
-	It has these top-level messages:
-		ContainerCreate
-		ContainerUpdate
-		ContainerDelete
-		ContentDelete
-		PublishRequest
-		ForwardRequest
-		SubscribeRequest
-		Envelope
-		ImageCreate
-		ImageUpdate
-		ImageDelete
-		NamespaceCreate
-		NamespaceUpdate
-		NamespaceDelete
-		SnapshotPrepare
-		SnapshotCommit
-		SnapshotRemove
-		TaskCreate
-		TaskStart
-		TaskDelete
-		TaskIO
-		TaskExit
-		TaskOOM
-		TaskExecAdded
-		TaskExecStarted
-		TaskPaused
-		TaskResumed
-		TaskCheckpointed
-*/
 package events
 
 import proto "github.com/gogo/protobuf/proto"
@@ -66,12 +24,6 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
-
 type ContainerCreate struct {
 	ID      string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Image   string                   `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
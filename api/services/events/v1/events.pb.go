@@ -54,27 +54,60 @@ func (*ForwardRequest) Descriptor() ([]byte, []int) { return fileDescriptorEvent
 
 type SubscribeRequest struct {
 	Filters []string `protobuf:"bytes,1,rep,name=filters" json:"filters,omitempty"`
+	// Durable names a subscription that retains events until they are
+	// acknowledged. Subscribing again with the same name redelivers any
+	// unacknowledged events before new ones, providing at-least-once
+	// delivery. The filters of the first request for a name are used for
+	// the lifetime of the subscription.
+	Durable string `protobuf:"bytes,2,opt,name=durable,proto3" json:"durable,omitempty"`
 }
 
 func (m *SubscribeRequest) Reset()                    { *m = SubscribeRequest{} }
 func (*SubscribeRequest) ProtoMessage()               {}
 func (*SubscribeRequest) Descriptor() ([]byte, []int) { return fileDescriptorEvents, []int{2} }
 
+type AckRequest struct {
+	Durable  string `protobuf:"bytes,1,opt,name=durable,proto3" json:"durable,omitempty"`
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *AckRequest) Reset()                    { *m = AckRequest{} }
+func (*AckRequest) ProtoMessage()               {}
+func (*AckRequest) Descriptor() ([]byte, []int) { return fileDescriptorEvents, []int{3} }
+
 type Envelope struct {
 	Timestamp time.Time             `protobuf:"bytes,1,opt,name=timestamp,stdtime" json:"timestamp"`
 	Namespace string                `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	Topic     string                `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
 	Event     *google_protobuf1.Any `protobuf:"bytes,4,opt,name=event" json:"event,omitempty"`
+	// Sequence is set on events delivered to durable subscriptions and is
+	// used to acknowledge them.
+	Sequence uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Epoch is incremented each time the daemon starts and persisted across
+	// restarts. Together with the counter, which increases for each event
+	// published by the daemon in an epoch, it orders events independently
+	// of the wall clock used for the timestamp.
+	Epoch   uint64 `protobuf:"varint,6,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Counter uint64 `protobuf:"varint,7,opt,name=counter,proto3" json:"counter,omitempty"`
+	// WallTime is the time of the system clock when the event was published,
+	// set even when the timestamp is derived from the monotonic clock so that
+	// events can be correlated with the logs of other components.
+	WallTime time.Time `protobuf:"bytes,8,opt,name=wall_time,json=wallTime,stdtime" json:"wall_time"`
+	// Dropped is the number of events dropped for the subscriber before this
+	// one because its buffer was full, so that it knows to resynchronize its
+	// state from the daemon.
+	Dropped uint64 `protobuf:"varint,9,opt,name=dropped,proto3" json:"dropped,omitempty"`
 }
 
 func (m *Envelope) Reset()                    { *m = Envelope{} }
 func (*Envelope) ProtoMessage()               {}
-func (*Envelope) Descriptor() ([]byte, []int) { return fileDescriptorEvents, []int{3} }
+func (*Envelope) Descriptor() ([]byte, []int) { return fileDescriptorEvents, []int{4} }
 
 func init() {
 	proto.RegisterType((*PublishRequest)(nil), "containerd.services.events.v1.PublishRequest")
 	proto.RegisterType((*ForwardRequest)(nil), "containerd.services.events.v1.ForwardRequest")
 	proto.RegisterType((*SubscribeRequest)(nil), "containerd.services.events.v1.SubscribeRequest")
+	proto.RegisterType((*AckRequest)(nil), "containerd.services.events.v1.AckRequest")
 	proto.RegisterType((*Envelope)(nil), "containerd.services.events.v1.Envelope")
 }
 
@@ -87,6 +120,11 @@ func (m *Envelope) Field(fieldpath []string) (string, bool) {
 
 	switch fieldpath[0] {
 	// unhandled: timestamp
+	// unhandled: sequence
+	// unhandled: epoch
+	// unhandled: counter
+	// unhandled: wall_time
+	// unhandled: dropped
 	case "namespace":
 		return string(m.Namespace), len(m.Namespace) > 0
 	case "topic":
@@ -138,6 +176,10 @@ type EventsClient interface {
 	// a filter can be provided in the format 'namespace==<namespace>' to
 	// restrict the received events.
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Events_SubscribeClient, error)
+	// Ack acknowledges the events of a durable subscription up to and
+	// including the provided sequence number. Acknowledged events are not
+	// redelivered when the subscriber reconnects.
+	Ack(ctx context.Context, in *AckRequest, opts ...grpc.CallOption) (*google_protobuf2.Empty, error)
 }
 
 type eventsClient struct {
@@ -198,6 +240,15 @@ func (x *eventsSubscribeClient) Recv() (*Envelope, error) {
 	return m, nil
 }
 
+func (c *eventsClient) Ack(ctx context.Context, in *AckRequest, opts ...grpc.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc.Invoke(ctx, "/containerd.services.events.v1.Events/Ack", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Events service
 
 type EventsServer interface {
@@ -220,6 +271,10 @@ type EventsServer interface {
 	// a filter can be provided in the format 'namespace==<namespace>' to
 	// restrict the received events.
 	Subscribe(*SubscribeRequest, Events_SubscribeServer) error
+	// Ack acknowledges the events of a durable subscription up to and
+	// including the provided sequence number. Acknowledged events are not
+	// redelivered when the subscriber reconnects.
+	Ack(context.Context, *AckRequest) (*google_protobuf2.Empty, error)
 }
 
 func RegisterEventsServer(s *grpc.Server, srv EventsServer) {
@@ -283,6 +338,24 @@ func (x *eventsSubscribeServer) Send(m *Envelope) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Events_Ack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServer).Ack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.services.events.v1.Events/Ack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServer).Ack(ctx, req.(*AckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Events_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "containerd.services.events.v1.Events",
 	HandlerType: (*EventsServer)(nil),
@@ -295,6 +368,10 @@ var _Events_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Forward",
 			Handler:    _Events_Forward_Handler,
 		},
+		{
+			MethodName: "Ack",
+			Handler:    _Events_Ack_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -398,6 +475,41 @@ func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.Durable) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Durable)))
+		i += copy(dAtA[i:], m.Durable)
+	}
+	return i, nil
+}
+
+func (m *AckRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AckRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Durable) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Durable)))
+		i += copy(dAtA[i:], m.Durable)
+	}
+	if m.Sequence != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEvents(dAtA, i, uint64(m.Sequence))
+	}
 	return i, nil
 }
 
@@ -446,6 +558,34 @@ func (m *Envelope) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n4
 	}
+	if m.Sequence != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintEvents(dAtA, i, uint64(m.Sequence))
+	}
+	if m.Epoch != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintEvents(dAtA, i, uint64(m.Epoch))
+	}
+	if m.Counter != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintEvents(dAtA, i, uint64(m.Counter))
+	}
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintEvents(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.WallTime)))
+	n5, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.WallTime, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	if m.Dropped != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintEvents(dAtA, i, uint64(m.Dropped))
+	}
 	return i, nil
 }
 
@@ -509,6 +649,23 @@ func (m *SubscribeRequest) Size() (n int) {
 			n += 1 + l + sovEvents(uint64(l))
 		}
 	}
+	l = len(m.Durable)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *AckRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Durable)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvents(uint64(m.Sequence))
+	}
 	return n
 }
 
@@ -529,6 +686,20 @@ func (m *Envelope) Size() (n int) {
 		l = m.Event.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvents(uint64(m.Sequence))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovEvents(uint64(m.Epoch))
+	}
+	if m.Counter != 0 {
+		n += 1 + sovEvents(uint64(m.Counter))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.WallTime)
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Dropped != 0 {
+		n += 1 + sovEvents(uint64(m.Dropped))
+	}
 	return n
 }
 
@@ -572,6 +743,18 @@ func (this *SubscribeRequest) String() string {
 	}
 	s := strings.Join([]string{`&SubscribeRequest{`,
 		`Filters:` + fmt.Sprintf("%v", this.Filters) + `,`,
+		`Durable:` + fmt.Sprintf("%v", this.Durable) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AckRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AckRequest{`,
+		`Durable:` + fmt.Sprintf("%v", this.Durable) + `,`,
+		`Sequence:` + fmt.Sprintf("%v", this.Sequence) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -585,6 +768,11 @@ func (this *Envelope) String() string {
 		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
 		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
 		`Event:` + strings.Replace(fmt.Sprintf("%v", this.Event), "Any", "google_protobuf1.Any", 1) + `,`,
+		`Sequence:` + fmt.Sprintf("%v", this.Sequence) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`Counter:` + fmt.Sprintf("%v", this.Counter) + `,`,
+		`WallTime:` + strings.Replace(strings.Replace(this.WallTime.String(), "Timestamp", "google_protobuf3.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Dropped:` + fmt.Sprintf("%v", this.Dropped) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -850,6 +1038,133 @@ func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Filters = append(m.Filters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Durable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Durable = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AckRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AckRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Durable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Durable = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1021,6 +1336,112 @@ func (m *Envelope) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+			}
+			m.Counter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Counter |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WallTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.WallTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dropped", wireType)
+			}
+			m.Dropped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dropped |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1152,34 +1573,41 @@ func init() {
 }
 
 var fileDescriptorEvents = []byte{
-	// 462 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcd, 0x8e, 0xd3, 0x30,
-	0x14, 0x85, 0xeb, 0xf9, 0x6d, 0x3c, 0xd2, 0x08, 0x45, 0x15, 0x2a, 0x01, 0xd2, 0xaa, 0x1b, 0x2a,
-	0x04, 0x0e, 0x53, 0x76, 0x20, 0x21, 0x28, 0x94, 0xf5, 0x28, 0x80, 0x84, 0xd8, 0x25, 0xe9, 0x6d,
-	0x6a, 0x29, 0xb1, 0x4d, 0xec, 0x04, 0xcd, 0x6e, 0x1e, 0x81, 0x0d, 0x6f, 0xc2, 0x86, 0x37, 0xe8,
-	0x92, 0x25, 0x2b, 0x60, 0xfa, 0x24, 0xa8, 0x89, 0xdd, 0x30, 0x2d, 0x10, 0x34, 0xbb, 0x6b, 0xdf,
-	0xe3, 0xcf, 0xb9, 0xe7, 0x38, 0xf8, 0x45, 0x4c, 0xd5, 0x3c, 0x0f, 0x49, 0xc4, 0x53, 0x2f, 0xe2,
-	0x4c, 0x05, 0x94, 0x41, 0x36, 0xfd, 0xbd, 0x0c, 0x04, 0xf5, 0x24, 0x64, 0x05, 0x8d, 0x40, 0x7a,
-	0x50, 0x00, 0x53, 0xd2, 0x2b, 0x4e, 0x74, 0x45, 0x44, 0xc6, 0x15, 0xb7, 0x6f, 0xd7, 0x7a, 0x62,
-	0xb4, 0x44, 0x2b, 0x8a, 0x13, 0xe7, 0x69, 0xe3, 0x25, 0x25, 0x26, 0xcc, 0x67, 0x9e, 0x48, 0xf2,
-	0x98, 0x32, 0x6f, 0x46, 0x21, 0x99, 0x8a, 0x40, 0xcd, 0xab, 0x0b, 0x9c, 0x4e, 0xcc, 0x63, 0x5e,
-	0x96, 0xde, 0xaa, 0xd2, 0xbb, 0x37, 0x62, 0xce, 0xe3, 0x04, 0xea, 0xd3, 0x01, 0x3b, 0xd3, 0xad,
-	0x9b, 0x9b, 0x2d, 0x48, 0x85, 0x32, 0xcd, 0xde, 0x66, 0x53, 0xd1, 0x14, 0xa4, 0x0a, 0x52, 0x51,
-	0x09, 0x06, 0x3e, 0x3e, 0x3e, 0xcd, 0xc3, 0x84, 0xca, 0xb9, 0x0f, 0xef, 0x73, 0x90, 0xca, 0xee,
-	0xe0, 0x7d, 0xc5, 0x05, 0x8d, 0xba, 0xa8, 0x8f, 0x86, 0x96, 0x5f, 0x2d, 0xec, 0xbb, 0x78, 0xbf,
-	0x9c, 0xb2, 0xbb, 0xd3, 0x47, 0xc3, 0xa3, 0x51, 0x87, 0x54, 0x60, 0x62, 0xc0, 0xe4, 0x19, 0x3b,
-	0xf3, 0x2b, 0xc9, 0xe0, 0x0d, 0x3e, 0x7e, 0xc9, 0xb3, 0x0f, 0x41, 0x36, 0x35, 0xcc, 0xe7, 0xb8,
-	0x0d, 0xac, 0x80, 0x84, 0x0b, 0x28, 0xb1, 0x47, 0xa3, 0x3b, 0xe4, 0x9f, 0x46, 0x92, 0x89, 0x96,
-	0xfb, 0xeb, 0x83, 0x83, 0x7b, 0xf8, 0xda, 0xab, 0x3c, 0x94, 0x51, 0x46, 0x43, 0x30, 0xe0, 0x2e,
-	0x3e, 0x9c, 0xd1, 0x44, 0x41, 0x26, 0xbb, 0xa8, 0xbf, 0x3b, 0xb4, 0x7c, 0xb3, 0x1c, 0x7c, 0x46,
-	0xb8, 0x6d, 0x20, 0xf6, 0x18, 0x5b, 0xeb, 0xc1, 0xf5, 0x07, 0x38, 0x5b, 0x13, 0xbc, 0x36, 0x8a,
-	0x71, 0x7b, 0xf1, 0xbd, 0xd7, 0xfa, 0xf8, 0xa3, 0x87, 0xfc, 0xfa, 0x98, 0x7d, 0x0b, 0x5b, 0x2c,
-	0x48, 0x41, 0x8a, 0x20, 0x82, 0xd2, 0x05, 0xcb, 0xaf, 0x37, 0x6a, 0xd7, 0x76, 0xff, 0xe8, 0xda,
-	0x5e, 0xa3, 0x6b, 0x8f, 0xf6, 0xce, 0xbf, 0xf4, 0xd0, 0xe8, 0xd3, 0x0e, 0x3e, 0x98, 0x94, 0x2e,
-	0xd8, 0xa7, 0xf8, 0x50, 0x47, 0x63, 0xdf, 0x6f, 0x70, 0xeb, 0x72, 0x84, 0xce, 0xf5, 0xad, 0x7b,
-	0x26, 0xab, 0x37, 0xb1, 0x22, 0xea, 0x60, 0x1a, 0x89, 0x97, 0x03, 0xfc, 0x2b, 0x31, 0xc6, 0xd6,
-	0x3a, 0x13, 0xdb, 0x6b, 0x60, 0x6e, 0xa6, 0xe7, 0xfc, 0xef, 0x23, 0x78, 0x80, 0xc6, 0x6f, 0x17,
-	0x17, 0x6e, 0xeb, 0xdb, 0x85, 0xdb, 0x3a, 0x5f, 0xba, 0x68, 0xb1, 0x74, 0xd1, 0xd7, 0xa5, 0x8b,
-	0x7e, 0x2e, 0x5d, 0xf4, 0xee, 0xc9, 0x15, 0xff, 0xeb, 0xc7, 0x55, 0x15, 0x1e, 0x94, 0x23, 0x3d,
-	0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x38, 0x37, 0x72, 0x20, 0x04, 0x00, 0x00,
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0xe6, 0xd7, 0xde, 0x4a, 0x15, 0x5a, 0x45, 0xc8, 0x18, 0x70, 0xa2, 0x5c, 0x08, 0x48,
+	0xd8, 0x34, 0xdc, 0x40, 0x42, 0x24, 0x90, 0x8a, 0x63, 0x65, 0x40, 0x42, 0x5c, 0x90, 0xbd, 0xd9,
+	0x38, 0xab, 0x3a, 0xde, 0xc5, 0x5e, 0xa7, 0xea, 0xad, 0x8f, 0xc0, 0xeb, 0xf0, 0x06, 0x39, 0x72,
+	0xe4, 0x02, 0xa5, 0x79, 0x12, 0xe4, 0x9f, 0x8d, 0xeb, 0x94, 0x62, 0xe8, 0x6d, 0x66, 0xbf, 0x6f,
+	0xbe, 0x9d, 0x99, 0xfd, 0x6c, 0xf8, 0xda, 0xa3, 0x62, 0x11, 0xbb, 0x26, 0x66, 0x4b, 0x0b, 0xb3,
+	0x40, 0x38, 0x34, 0x20, 0xe1, 0xec, 0x72, 0xe8, 0x70, 0x6a, 0x45, 0x24, 0x5c, 0x51, 0x4c, 0x22,
+	0x8b, 0xac, 0x48, 0x20, 0x22, 0x6b, 0x75, 0x90, 0x47, 0x26, 0x0f, 0x99, 0x60, 0xe8, 0x7e, 0xc1,
+	0x37, 0x25, 0xd7, 0xcc, 0x19, 0xab, 0x03, 0xfd, 0x65, 0xe5, 0x25, 0xa9, 0x8c, 0x1b, 0xcf, 0x2d,
+	0xee, 0xc7, 0x1e, 0x0d, 0xac, 0x39, 0x25, 0xfe, 0x8c, 0x3b, 0x62, 0x91, 0x5d, 0xa0, 0x77, 0x3d,
+	0xe6, 0xb1, 0x34, 0xb4, 0x92, 0x28, 0x3f, 0xbd, 0xe3, 0x31, 0xe6, 0xf9, 0xa4, 0xa8, 0x76, 0x82,
+	0xd3, 0x1c, 0xba, 0xbb, 0x0b, 0x91, 0x25, 0x17, 0x12, 0xec, 0xed, 0x82, 0x82, 0x2e, 0x49, 0x24,
+	0x9c, 0x25, 0xcf, 0x08, 0x03, 0x1b, 0xee, 0x1f, 0xc5, 0xae, 0x4f, 0xa3, 0x85, 0x4d, 0x3e, 0xc7,
+	0x24, 0x12, 0xa8, 0x0b, 0x5b, 0x82, 0x71, 0x8a, 0x35, 0xd0, 0x07, 0x43, 0xd5, 0xce, 0x12, 0xf4,
+	0x08, 0xb6, 0xd2, 0x29, 0xb5, 0x7a, 0x1f, 0x0c, 0xf7, 0x46, 0x5d, 0x33, 0x13, 0x36, 0xa5, 0xb0,
+	0x39, 0x0e, 0x4e, 0xed, 0x8c, 0x32, 0x78, 0x0f, 0xf7, 0x0f, 0x59, 0x78, 0xe2, 0x84, 0x33, 0xa9,
+	0xf9, 0x0a, 0x2a, 0x24, 0x58, 0x11, 0x9f, 0x71, 0x92, 0xca, 0xee, 0x8d, 0x1e, 0x98, 0x7f, 0x5d,
+	0xa4, 0x39, 0xcd, 0xe9, 0xf6, 0xb6, 0x70, 0x70, 0x08, 0x6f, 0xbd, 0x8d, 0xdd, 0x08, 0x87, 0xd4,
+	0x25, 0x52, 0x58, 0x83, 0x9d, 0x39, 0xf5, 0x05, 0x09, 0x23, 0x0d, 0xf4, 0x1b, 0x43, 0xd5, 0x96,
+	0x69, 0x82, 0xcc, 0xe2, 0xd0, 0x71, 0x7d, 0x92, 0xb6, 0xac, 0xda, 0x32, 0x1d, 0x4c, 0x20, 0x1c,
+	0xe3, 0xe3, 0x4b, 0x0a, 0x92, 0x07, 0x4a, 0x3c, 0xa4, 0x43, 0x25, 0x4a, 0x48, 0x01, 0xce, 0x24,
+	0x9a, 0xf6, 0x36, 0x1f, 0xfc, 0xa8, 0x43, 0x45, 0xb6, 0x88, 0x26, 0x50, 0xdd, 0xae, 0x35, 0x1f,
+	0x4f, 0xbf, 0xb2, 0x9f, 0x77, 0x92, 0x31, 0x51, 0xd6, 0x3f, 0x7b, 0xb5, 0x2f, 0xe7, 0x3d, 0x60,
+	0x17, 0x65, 0xe8, 0x1e, 0x54, 0x03, 0x67, 0x49, 0x22, 0xee, 0x60, 0xd9, 0x70, 0x71, 0x50, 0xbc,
+	0x49, 0xe3, 0x8f, 0x6f, 0xd2, 0xac, 0x7c, 0x93, 0xd2, 0x30, 0xad, 0xf2, 0x30, 0x89, 0x3a, 0xe1,
+	0x0c, 0x2f, 0xb4, 0x76, 0x0a, 0x64, 0x49, 0xb2, 0x18, 0xcc, 0xe2, 0x40, 0x90, 0x50, 0xeb, 0xa4,
+	0xe7, 0x32, 0x45, 0x63, 0xa8, 0x9e, 0x38, 0xbe, 0xff, 0x29, 0xe9, 0x5e, 0x53, 0xfe, 0x63, 0x5e,
+	0x25, 0x29, 0x4b, 0x80, 0x74, 0xeb, 0x21, 0xe3, 0x9c, 0xcc, 0x34, 0x35, 0x13, 0xcf, 0xd3, 0x67,
+	0xcd, 0xb3, 0xaf, 0x3d, 0x30, 0x3a, 0xaf, 0xc3, 0xf6, 0x34, 0x35, 0x03, 0x3a, 0x82, 0x9d, 0xdc,
+	0xa1, 0xe8, 0x71, 0x85, 0x69, 0xca, 0x4e, 0xd6, 0x6f, 0x5f, 0x69, 0x6a, 0x9a, 0x7c, 0x1a, 0x89,
+	0x62, 0xee, 0xcf, 0x4a, 0xc5, 0xb2, 0x8f, 0xaf, 0x55, 0xf4, 0xa0, 0xba, 0xb5, 0x26, 0xb2, 0x2a,
+	0x34, 0x77, 0x4d, 0xac, 0xff, 0xeb, 0xb7, 0xf0, 0x04, 0xa0, 0x37, 0xb0, 0x31, 0xc6, 0xc7, 0xe8,
+	0x61, 0x45, 0x45, 0xe1, 0xef, 0xeb, 0x5a, 0x9e, 0x7c, 0x58, 0x5f, 0x18, 0xb5, 0xef, 0x17, 0x46,
+	0xed, 0x6c, 0x63, 0x80, 0xf5, 0xc6, 0x00, 0xdf, 0x36, 0x06, 0xf8, 0xb5, 0x31, 0xc0, 0xc7, 0x17,
+	0x37, 0xfc, 0x51, 0x3e, 0xcf, 0x22, 0xb7, 0x9d, 0xde, 0xf4, 0xf4, 0xf7, 0x00, 0x5b, 0x60, 0xd9,
+	0x93, 0x71, 0x05, 0x00, 0x00,
 }
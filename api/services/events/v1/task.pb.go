@@ -54,6 +54,9 @@ type TaskDelete struct {
 	Pid         uint32    `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
 	ExitStatus  uint32    `protobuf:"varint,3,opt,name=exit_status,json=exitStatus,proto3" json:"exit_status,omitempty"`
 	ExitedAt    time.Time `protobuf:"bytes,4,opt,name=exited_at,json=exitedAt,stdtime" json:"exited_at"`
+	// ExitReason is set by runtimes translating the exit status of their
+	// tasks, such as "GuestPanic" for a VM runtime
+	ExitReason string `protobuf:"bytes,5,opt,name=exit_reason,json=exitReason,proto3" json:"exit_reason,omitempty"`
 }
 
 func (m *TaskDelete) Reset()                    { *m = TaskDelete{} }
@@ -77,6 +80,9 @@ type TaskExit struct {
 	Pid         uint32    `protobuf:"varint,3,opt,name=pid,proto3" json:"pid,omitempty"`
 	ExitStatus  uint32    `protobuf:"varint,4,opt,name=exit_status,json=exitStatus,proto3" json:"exit_status,omitempty"`
 	ExitedAt    time.Time `protobuf:"bytes,5,opt,name=exited_at,json=exitedAt,stdtime" json:"exited_at"`
+	// ExitReason is set by runtimes translating the exit status of their
+	// tasks, such as "GuestPanic" for a VM runtime
+	ExitReason string `protobuf:"bytes,6,opt,name=exit_reason,json=exitReason,proto3" json:"exit_reason,omitempty"`
 }
 
 func (m *TaskExit) Reset()                    { *m = TaskExit{} }
@@ -85,6 +91,19 @@ func (*TaskExit) Descriptor() ([]byte, []int) { return fileDescriptorTask, []int
 
 type TaskOOM struct {
 	ContainerID string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// OccurredAt is the time the OOM was reported by the cgroup of the task.
+	OccurredAt time.Time `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,stdtime" json:"occurred_at"`
+	// The memory usage, maximum usage, limit and number of times the limit
+	// was hit of the cgroup of the task when the OOM was reported, so that
+	// consumers can tell how the task ran out of memory without querying
+	// its metrics, which are gone once the task exits.
+	MemoryUsage    uint64 `protobuf:"varint,3,opt,name=memory_usage,json=memoryUsage,proto3" json:"memory_usage,omitempty"`
+	MaxMemoryUsage uint64 `protobuf:"varint,4,opt,name=max_memory_usage,json=maxMemoryUsage,proto3" json:"max_memory_usage,omitempty"`
+	MemoryLimit    uint64 `protobuf:"varint,5,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	MemoryFailcnt  uint64 `protobuf:"varint,6,opt,name=memory_failcnt,json=memoryFailcnt,proto3" json:"memory_failcnt,omitempty"`
+	// OOMCount is the number of OOMs reported for the task so far, including
+	// this one.
+	OOMCount uint64 `protobuf:"varint,7,opt,name=oom_count,json=oomCount,proto3" json:"oom_count,omitempty"`
 }
 
 func (m *TaskOOM) Reset()                    { *m = TaskOOM{} }
@@ -135,6 +154,25 @@ func (m *TaskCheckpointed) Reset()                    { *m = TaskCheckpointed{}
 func (*TaskCheckpointed) ProtoMessage()               {}
 func (*TaskCheckpointed) Descriptor() ([]byte, []int) { return fileDescriptorTask, []int{10} }
 
+// TaskStuck is published when a task has been creating, starting or deleting
+// for longer than the timeout of the watchdog of the tasks service.
+type TaskStuck struct {
+	ContainerID string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// ExecID is set when an exec process is stuck starting.
+	ExecID string `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+	// State is the transition the task is stuck in, "creating", "starting"
+	// or "deleting".
+	State     string    `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	StartedAt time.Time `protobuf:"bytes,4,opt,name=started_at,json=startedAt,stdtime" json:"started_at"`
+	// Forced is set when the transition is canceled and the shim of the
+	// task killed to clean it up.
+	Forced bool `protobuf:"varint,5,opt,name=forced,proto3" json:"forced,omitempty"`
+}
+
+func (m *TaskStuck) Reset()                    { *m = TaskStuck{} }
+func (*TaskStuck) ProtoMessage()               {}
+func (*TaskStuck) Descriptor() ([]byte, []int) { return fileDescriptorTask, []int{11} }
+
 func init() {
 	proto.RegisterType((*TaskCreate)(nil), "containerd.services.events.v1.TaskCreate")
 	proto.RegisterType((*TaskStart)(nil), "containerd.services.events.v1.TaskStart")
@@ -147,6 +185,7 @@ func init() {
 	proto.RegisterType((*TaskPaused)(nil), "containerd.services.events.v1.TaskPaused")
 	proto.RegisterType((*TaskResumed)(nil), "containerd.services.events.v1.TaskResumed")
 	proto.RegisterType((*TaskCheckpointed)(nil), "containerd.services.events.v1.TaskCheckpointed")
+	proto.RegisterType((*TaskStuck)(nil), "containerd.services.events.v1.TaskStuck")
 }
 
 // Field returns the value for the given fieldpath as a string, if defined.
@@ -209,6 +248,8 @@ func (m *TaskDelete) Field(fieldpath []string) (string, bool) {
 	// unhandled: exited_at
 	case "container_id":
 		return string(m.ContainerID), len(m.ContainerID) > 0
+	case "exit_reason":
+		return string(m.ExitReason), len(m.ExitReason) > 0
 	}
 	return "", false
 }
@@ -248,6 +289,8 @@ func (m *TaskExit) Field(fieldpath []string) (string, bool) {
 		return string(m.ContainerID), len(m.ContainerID) > 0
 	case "id":
 		return string(m.ID), len(m.ID) > 0
+	case "exit_reason":
+		return string(m.ExitReason), len(m.ExitReason) > 0
 	}
 	return "", false
 }
@@ -260,6 +303,12 @@ func (m *TaskOOM) Field(fieldpath []string) (string, bool) {
 	}
 
 	switch fieldpath[0] {
+	// unhandled: occurred_at
+	// unhandled: memory_usage
+	// unhandled: max_memory_usage
+	// unhandled: memory_limit
+	// unhandled: memory_failcnt
+	// unhandled: oom_count
 	case "container_id":
 		return string(m.ContainerID), len(m.ContainerID) > 0
 	}
@@ -342,6 +391,27 @@ func (m *TaskCheckpointed) Field(fieldpath []string) (string, bool) {
 	}
 	return "", false
 }
+
+// Field returns the value for the given fieldpath as a string, if defined.
+// If the value is not defined, the second value will be false.
+func (m *TaskStuck) Field(fieldpath []string) (string, bool) {
+	if len(fieldpath) == 0 {
+		return "", false
+	}
+
+	switch fieldpath[0] {
+	// unhandled: started_at
+	case "container_id":
+		return string(m.ContainerID), len(m.ContainerID) > 0
+	case "exec_id":
+		return string(m.ExecID), len(m.ExecID) > 0
+	case "state":
+		return string(m.State), len(m.State) > 0
+	case "forced":
+		return fmt.Sprint(m.Forced), true
+	}
+	return "", false
+}
 func (m *TaskCreate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -473,6 +543,12 @@ func (m *TaskDelete) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n2
+	if len(m.ExitReason) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.ExitReason)))
+		i += copy(dAtA[i:], m.ExitReason)
+	}
 	return i, nil
 }
 
@@ -567,6 +643,12 @@ func (m *TaskExit) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n3
+	if len(m.ExitReason) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.ExitReason)))
+		i += copy(dAtA[i:], m.ExitReason)
+	}
 	return i, nil
 }
 
@@ -591,6 +673,39 @@ func (m *TaskOOM) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintTask(dAtA, i, uint64(len(m.ContainerID)))
 		i += copy(dAtA[i:], m.ContainerID)
 	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintTask(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.OccurredAt)))
+	n4, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.OccurredAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	if m.MemoryUsage != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(m.MemoryUsage))
+	}
+	if m.MaxMemoryUsage != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(m.MaxMemoryUsage))
+	}
+	if m.MemoryLimit != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(m.MemoryLimit))
+	}
+	if m.MemoryFailcnt != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(m.MemoryFailcnt))
+	}
+	if m.OOMCount != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(m.OOMCount))
+	}
 	return i, nil
 }
 
@@ -737,6 +852,60 @@ func (m *TaskCheckpointed) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *TaskStuck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskStuck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.ContainerID)))
+		i += copy(dAtA[i:], m.ContainerID)
+	}
+	if len(m.ExecID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.ExecID)))
+		i += copy(dAtA[i:], m.ExecID)
+	}
+	if len(m.State) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintTask(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)))
+	n5, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartedAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	if m.Forced {
+		dAtA[i] = 0x28
+		i++
+		if m.Forced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
 func encodeFixed64Task(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	dAtA[offset+1] = uint8(v >> 8)
@@ -823,6 +992,10 @@ func (m *TaskDelete) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExitedAt)
 	n += 1 + l + sovTask(uint64(l))
+	l = len(m.ExitReason)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
 	return n
 }
 
@@ -866,6 +1039,10 @@ func (m *TaskExit) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExitedAt)
 	n += 1 + l + sovTask(uint64(l))
+	l = len(m.ExitReason)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
 	return n
 }
 
@@ -876,6 +1053,23 @@ func (m *TaskOOM) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTask(uint64(l))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.OccurredAt)
+	n += 1 + l + sovTask(uint64(l))
+	if m.MemoryUsage != 0 {
+		n += 1 + sovTask(uint64(m.MemoryUsage))
+	}
+	if m.MaxMemoryUsage != 0 {
+		n += 1 + sovTask(uint64(m.MaxMemoryUsage))
+	}
+	if m.MemoryLimit != 0 {
+		n += 1 + sovTask(uint64(m.MemoryLimit))
+	}
+	if m.MemoryFailcnt != 0 {
+		n += 1 + sovTask(uint64(m.MemoryFailcnt))
+	}
+	if m.OOMCount != 0 {
+		n += 1 + sovTask(uint64(m.OOMCount))
+	}
 	return n
 }
 
@@ -944,6 +1138,29 @@ func (m *TaskCheckpointed) Size() (n int) {
 	return n
 }
 
+func (m *TaskStuck) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerID)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.ExecID)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)
+	n += 1 + l + sovTask(uint64(l))
+	if m.Forced {
+		n += 2
+	}
+	return n
+}
+
 func sovTask(x uint64) (n int) {
 	for {
 		n++
@@ -992,6 +1209,7 @@ func (this *TaskDelete) String() string {
 		`Pid:` + fmt.Sprintf("%v", this.Pid) + `,`,
 		`ExitStatus:` + fmt.Sprintf("%v", this.ExitStatus) + `,`,
 		`ExitedAt:` + strings.Replace(strings.Replace(this.ExitedAt.String(), "Timestamp", "google_protobuf3.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ExitReason:` + fmt.Sprintf("%v", this.ExitReason) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1019,6 +1237,7 @@ func (this *TaskExit) String() string {
 		`Pid:` + fmt.Sprintf("%v", this.Pid) + `,`,
 		`ExitStatus:` + fmt.Sprintf("%v", this.ExitStatus) + `,`,
 		`ExitedAt:` + strings.Replace(strings.Replace(this.ExitedAt.String(), "Timestamp", "google_protobuf3.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ExitReason:` + fmt.Sprintf("%v", this.ExitReason) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1029,6 +1248,12 @@ func (this *TaskOOM) String() string {
 	}
 	s := strings.Join([]string{`&TaskOOM{`,
 		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
+		`OccurredAt:` + strings.Replace(strings.Replace(this.OccurredAt.String(), "Timestamp", "google_protobuf3.Timestamp", 1), `&`, ``, 1) + `,`,
+		`MemoryUsage:` + fmt.Sprintf("%v", this.MemoryUsage) + `,`,
+		`MaxMemoryUsage:` + fmt.Sprintf("%v", this.MaxMemoryUsage) + `,`,
+		`MemoryLimit:` + fmt.Sprintf("%v", this.MemoryLimit) + `,`,
+		`MemoryFailcnt:` + fmt.Sprintf("%v", this.MemoryFailcnt) + `,`,
+		`OOMCount:` + fmt.Sprintf("%v", this.OOMCount) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1087,6 +1312,20 @@ func (this *TaskCheckpointed) String() string {
 	}, "")
 	return s
 }
+func (this *TaskStuck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskStuck{`,
+		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
+		`ExecID:` + fmt.Sprintf("%v", this.ExecID) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(this.StartedAt.String(), "Timestamp", "google_protobuf3.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Forced:` + fmt.Sprintf("%v", this.Forced) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringTask(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1539,6 +1778,35 @@ func (m *TaskDelete) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -1872,6 +2140,35 @@ func (m *TaskExit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -1951,6 +2248,131 @@ func (m *TaskOOM) Unmarshal(dAtA []byte) error {
 			}
 			m.ContainerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OccurredAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.OccurredAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryUsage", wireType)
+			}
+			m.MemoryUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryUsage |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoryUsage", wireType)
+			}
+			m.MaxMemoryUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoryUsage |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimit", wireType)
+			}
+			m.MemoryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryLimit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryFailcnt", wireType)
+			}
+			m.MemoryFailcnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryFailcnt |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OOMCount", wireType)
+			}
+			m.OOMCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OOMCount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -2473,6 +2895,193 @@ func (m *TaskCheckpointed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TaskStuck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskStuck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskStuck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Forced = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTask(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2583,46 +3192,57 @@ func init() {
 }
 
 var fileDescriptorTask = []byte{
-	// 648 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x6b, 0xa7, 0x75, 0x93, 0x0d, 0x55, 0x2b, 0xab, 0x82, 0x28, 0x12, 0x76, 0x64, 0x84,
-	0x94, 0x93, 0xad, 0x16, 0x89, 0x0b, 0x2a, 0x6a, 0xd2, 0x70, 0xc8, 0xa1, 0x0a, 0xb8, 0x3d, 0x21,
-	0xa4, 0xc8, 0xb1, 0x27, 0xc9, 0xd2, 0xc4, 0x6b, 0x79, 0xc7, 0x51, 0x91, 0x38, 0xf0, 0x08, 0x3c,
-	0x02, 0x4f, 0xc1, 0x33, 0xf4, 0xc0, 0x81, 0x23, 0xa7, 0x40, 0xfd, 0x0c, 0x9c, 0x38, 0xa1, 0xf5,
-	0x3a, 0x6e, 0xa1, 0xa2, 0x20, 0x4b, 0xdc, 0x76, 0xc6, 0x33, 0xff, 0xcc, 0x7c, 0x3b, 0xd9, 0x90,
-	0xee, 0x84, 0xe2, 0x34, 0x19, 0xd9, 0x3e, 0x9b, 0x3b, 0x3e, 0x0b, 0xd1, 0xa3, 0x21, 0xc4, 0xc1,
-	0xf5, 0xa3, 0x17, 0x51, 0x87, 0x43, 0xbc, 0xa0, 0x3e, 0x70, 0x07, 0x16, 0x10, 0x22, 0x77, 0x16,
-	0x7b, 0x0e, 0x7a, 0xfc, 0xcc, 0x8e, 0x62, 0x86, 0x4c, 0xbf, 0x7f, 0x15, 0x6d, 0xaf, 0x22, 0x6d,
-	0x19, 0x69, 0x2f, 0xf6, 0x9a, 0xbb, 0x13, 0x36, 0x61, 0x59, 0xa4, 0x23, 0x4e, 0x32, 0xa9, 0x69,
-	0x4e, 0x18, 0x9b, 0xcc, 0xc0, 0xc9, 0xac, 0x51, 0x32, 0x76, 0x90, 0xce, 0x81, 0xa3, 0x37, 0x8f,
-	0xf2, 0x80, 0xc7, 0xff, 0xd4, 0x19, 0xbe, 0x89, 0x80, 0x3b, 0x73, 0x96, 0x84, 0x98, 0xe7, 0x1d,
-	0xfe, 0x35, 0xaf, 0x28, 0x19, 0xcd, 0x92, 0x09, 0x0d, 0x9d, 0x31, 0x85, 0x59, 0x10, 0x79, 0x38,
-	0x95, 0x0a, 0xd6, 0x0f, 0x85, 0x90, 0x53, 0x8f, 0x9f, 0x1d, 0xc5, 0xe0, 0x21, 0xe8, 0xfb, 0xe4,
-	0x4e, 0x91, 0x3c, 0xa4, 0x41, 0x43, 0x69, 0x29, 0xed, 0x5a, 0x77, 0x3b, 0x5d, 0x9a, 0xf5, 0xa3,
-	0x95, 0xbf, 0xdf, 0x73, 0xeb, 0x45, 0x50, 0x3f, 0xd0, 0xef, 0x12, 0x6d, 0x94, 0x84, 0xc1, 0x0c,
-	0x1a, 0xaa, 0x88, 0x76, 0x73, 0x4b, 0x77, 0x88, 0x16, 0x33, 0x86, 0x63, 0xde, 0xa8, 0xb4, 0x2a,
-	0xed, 0xfa, 0xfe, 0x3d, 0xfb, 0x1a, 0xbb, 0x6c, 0x16, 0xfb, 0x58, 0xcc, 0xe2, 0xe6, 0x61, 0xfa,
-	0x01, 0x51, 0x29, 0x6b, 0xac, 0xb7, 0x94, 0x76, 0x7d, 0xff, 0xa1, 0x7d, 0x2b, 0x68, 0x5b, 0xf4,
-	0xdc, 0x1f, 0x74, 0xb5, 0x74, 0x69, 0xaa, 0xfd, 0x81, 0xab, 0x52, 0xa6, 0x1b, 0x84, 0xf8, 0x53,
-	0xf0, 0xcf, 0x22, 0x46, 0x43, 0x6c, 0x6c, 0x64, 0xbd, 0x5c, 0xf3, 0xe8, 0x3b, 0xa4, 0x12, 0xd1,
-	0xa0, 0xa1, 0xb5, 0x94, 0xf6, 0x96, 0x2b, 0x8e, 0xd6, 0x0b, 0x52, 0x13, 0x3a, 0x27, 0xe8, 0xc5,
-	0x58, 0x6a, 0xf4, 0x5c, 0x52, 0xbd, 0x92, 0xfc, 0x98, 0xf3, 0xec, 0xc1, 0x0c, 0x4a, 0xf2, 0xbc,
-	0x21, 0xaa, 0x9b, 0xa4, 0x0e, 0xe7, 0x14, 0x87, 0x1c, 0x3d, 0x4c, 0x04, 0x4e, 0xf1, 0x85, 0x08,
-	0xd7, 0x49, 0xe6, 0xd1, 0x3b, 0xa4, 0x26, 0x2c, 0x08, 0x86, 0x1e, 0xe6, 0x00, 0x9b, 0xb6, 0x5c,
-	0x3a, 0x7b, 0xb5, 0x01, 0xf6, 0xe9, 0x6a, 0xe9, 0xba, 0xd5, 0x8b, 0xa5, 0xb9, 0xf6, 0xfe, 0xab,
-	0xa9, 0xb8, 0x55, 0x99, 0xd6, 0x41, 0xeb, 0x35, 0xd1, 0x24, 0x53, 0x7d, 0x97, 0x6c, 0x70, 0x0c,
-	0x68, 0x28, 0x9b, 0x75, 0xa5, 0x21, 0x6e, 0x99, 0x63, 0xc0, 0x12, 0x5c, 0xdd, 0xb2, 0xb4, 0x72,
-	0x3f, 0xc4, 0x71, 0xd6, 0x96, 0xf4, 0x43, 0x1c, 0xeb, 0x4d, 0x52, 0x45, 0x88, 0xe7, 0x34, 0xf4,
-	0x66, 0x59, 0x47, 0x55, 0xb7, 0xb0, 0xad, 0x4f, 0x0a, 0xa9, 0x8a, 0x62, 0xcf, 0xce, 0x29, 0x96,
-	0x5c, 0x39, 0x35, 0x27, 0x54, 0xcb, 0x57, 0xa0, 0xe7, 0xaa, 0xb4, 0x40, 0x57, 0xf9, 0x23, 0xba,
-	0xf5, 0xdb, 0xd1, 0x6d, 0x94, 0x42, 0x77, 0x40, 0x36, 0xc5, 0x34, 0x83, 0xc1, 0x71, 0x99, 0x61,
-	0xac, 0x29, 0xd9, 0x92, 0x30, 0xc0, 0xef, 0x04, 0x01, 0x04, 0xa5, 0x88, 0x3c, 0x20, 0x9b, 0x70,
-	0x0e, 0xfe, 0xb0, 0xc0, 0x42, 0xd2, 0xa5, 0xa9, 0x09, 0xcd, 0x7e, 0xcf, 0xd5, 0xc4, 0xa7, 0x7e,
-	0x60, 0xbd, 0x25, 0xdb, 0xab, 0x4a, 0xd9, 0xce, 0xff, 0xc7, 0x5a, 0x37, 0xaf, 0xc2, 0x3a, 0x94,
-	0xbf, 0x8c, 0xe7, 0x5e, 0xc2, 0xcb, 0x15, 0xb6, 0x3a, 0xa4, 0x2e, 0x14, 0x5c, 0xe0, 0xc9, 0xbc,
-	0xa4, 0xc4, 0x98, 0xec, 0x64, 0xcf, 0x5d, 0xf1, 0x2c, 0x94, 0x64, 0xf0, 0xeb, 0x63, 0xa3, 0xfe,
-	0xfe, 0xd8, 0x74, 0x5f, 0x5d, 0x5c, 0x1a, 0x6b, 0x5f, 0x2e, 0x8d, 0xb5, 0x77, 0xa9, 0xa1, 0x5c,
-	0xa4, 0x86, 0xf2, 0x39, 0x35, 0x94, 0x6f, 0xa9, 0xa1, 0x7c, 0xf8, 0x6e, 0x28, 0x2f, 0x9f, 0x96,
-	0xfc, 0x27, 0x7a, 0x22, 0x4f, 0x23, 0x2d, 0xdb, 0xcc, 0x47, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x76, 0xdf, 0xe7, 0xaa, 0xd2, 0x06, 0x00, 0x00,
+	// 821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xae, 0x9d, 0x8d, 0xfd, 0x9c, 0xb4, 0xd1, 0xa8, 0x02, 0x2b, 0x12, 0x76, 0x30, 0xaa,
+	0x64, 0x2e, 0xbb, 0x6a, 0x90, 0xb8, 0x20, 0x50, 0xed, 0xa4, 0x48, 0x96, 0x88, 0x0c, 0xd3, 0x72,
+	0x41, 0x48, 0xd6, 0x64, 0x77, 0xec, 0x0c, 0xd9, 0xdd, 0x59, 0xcd, 0xcc, 0x46, 0xae, 0xc4, 0x81,
+	0x3f, 0x81, 0x3f, 0x81, 0xbf, 0x06, 0xe5, 0xc8, 0x0d, 0x4e, 0x86, 0xee, 0x9d, 0x1b, 0x27, 0x4e,
+	0x68, 0x66, 0x76, 0x37, 0x2e, 0x15, 0xa5, 0x5d, 0xb5, 0xb7, 0x79, 0xdf, 0x7e, 0xef, 0xd7, 0x97,
+	0x97, 0x2f, 0x81, 0xe9, 0x8a, 0xa9, 0xcb, 0xfc, 0xc2, 0x0f, 0x79, 0x12, 0x84, 0x3c, 0x55, 0x84,
+	0xa5, 0x54, 0x44, 0xdb, 0x4f, 0x92, 0xb1, 0x40, 0x52, 0x71, 0xcd, 0x42, 0x2a, 0x03, 0x7a, 0x4d,
+	0x53, 0x25, 0x83, 0xeb, 0x07, 0x81, 0x22, 0xf2, 0xca, 0xcf, 0x04, 0x57, 0x1c, 0xbd, 0x77, 0xcb,
+	0xf6, 0x2b, 0xa6, 0x6f, 0x99, 0xfe, 0xf5, 0x83, 0xa3, 0x7b, 0x2b, 0xbe, 0xe2, 0x86, 0x19, 0xe8,
+	0x97, 0x4d, 0x3a, 0x1a, 0xae, 0x38, 0x5f, 0xc5, 0x34, 0x30, 0xd1, 0x45, 0xbe, 0x0c, 0x14, 0x4b,
+	0xa8, 0x54, 0x24, 0xc9, 0x4a, 0xc2, 0xc7, 0xaf, 0x34, 0x99, 0x7a, 0x9a, 0x51, 0x19, 0x24, 0x3c,
+	0x4f, 0x55, 0x99, 0xf7, 0xf0, 0x7f, 0xf3, 0xea, 0x96, 0x59, 0x9c, 0xaf, 0x58, 0x1a, 0x2c, 0x19,
+	0x8d, 0xa3, 0x8c, 0xa8, 0x4b, 0x5b, 0x61, 0xf4, 0xb7, 0x03, 0xf0, 0x84, 0xc8, 0xab, 0x53, 0x41,
+	0x89, 0xa2, 0xe8, 0x04, 0xf6, 0xeb, 0xe4, 0x05, 0x8b, 0xfa, 0xce, 0xb1, 0x33, 0xee, 0x4e, 0xef,
+	0x16, 0x9b, 0x61, 0xef, 0xb4, 0xc2, 0x67, 0x67, 0xb8, 0x57, 0x93, 0x66, 0x11, 0x7a, 0x07, 0xbc,
+	0x8b, 0x3c, 0x8d, 0x62, 0xda, 0x77, 0x35, 0x1b, 0x97, 0x11, 0x0a, 0xc0, 0x13, 0x9c, 0xab, 0xa5,
+	0xec, 0xb7, 0x8e, 0x5b, 0xe3, 0xde, 0xc9, 0xbb, 0xfe, 0x96, 0x76, 0x66, 0x17, 0xff, 0x5c, 0xef,
+	0x82, 0x4b, 0x1a, 0xfa, 0x14, 0x5c, 0xc6, 0xfb, 0xed, 0x63, 0x67, 0xdc, 0x3b, 0xb9, 0xef, 0xbf,
+	0x54, 0x68, 0x5f, 0xcf, 0x3c, 0x9b, 0x4f, 0xbd, 0x62, 0x33, 0x74, 0x67, 0x73, 0xec, 0x32, 0x8e,
+	0x06, 0x00, 0xe1, 0x25, 0x0d, 0xaf, 0x32, 0xce, 0x52, 0xd5, 0xdf, 0x35, 0xb3, 0x6c, 0x21, 0xe8,
+	0x10, 0x5a, 0x19, 0x8b, 0xfa, 0xde, 0xb1, 0x33, 0x3e, 0xc0, 0xfa, 0x39, 0xfa, 0x0a, 0xba, 0xba,
+	0xce, 0x63, 0x45, 0x84, 0x6a, 0xb4, 0x7a, 0x59, 0xd2, 0xbd, 0x2d, 0xf9, 0x6b, 0xa9, 0xe7, 0x19,
+	0x8d, 0xa9, 0xa2, 0x6f, 0xa6, 0x28, 0x1a, 0x42, 0x8f, 0xae, 0x99, 0x5a, 0x48, 0x45, 0x54, 0xae,
+	0xe5, 0xd4, 0x5f, 0x40, 0x43, 0x8f, 0x0d, 0x82, 0x26, 0xd0, 0xd5, 0x11, 0x8d, 0x16, 0x44, 0x95,
+	0x02, 0x1e, 0xf9, 0xf6, 0xe8, 0xfc, 0xea, 0x02, 0xfc, 0x27, 0xd5, 0xd1, 0x4d, 0x3b, 0x37, 0x9b,
+	0xe1, 0xce, 0x8f, 0xbf, 0x0f, 0x1d, 0xdc, 0xb1, 0x69, 0x13, 0x55, 0xf7, 0x10, 0x94, 0x48, 0x9e,
+	0x56, 0xf2, 0x69, 0x08, 0x1b, 0x64, 0xf4, 0x1d, 0x78, 0x56, 0x74, 0x74, 0x0f, 0x76, 0xa5, 0x8a,
+	0x58, 0x6a, 0xb7, 0xc1, 0x36, 0xd0, 0x67, 0x20, 0x55, 0xc4, 0x73, 0x55, 0x9d, 0x81, 0x8d, 0x4a,
+	0x9c, 0x0a, 0xd1, 0x6f, 0xd5, 0x38, 0x15, 0x02, 0x1d, 0x41, 0x47, 0x51, 0x91, 0xb0, 0x94, 0xc4,
+	0x66, 0xe4, 0x0e, 0xae, 0xe3, 0xd1, 0x9f, 0x0e, 0x74, 0x74, 0xb3, 0x47, 0x6b, 0xa6, 0x1a, 0xde,
+	0xa4, 0x5b, 0x4a, 0xd8, 0x2d, 0x6f, 0xe4, 0x0c, 0xbb, 0xac, 0xd6, 0xb6, 0xf5, 0x9f, 0xda, 0xb6,
+	0x5f, 0xae, 0xed, 0xee, 0x9b, 0xd0, 0xd6, 0x7b, 0x41, 0xdb, 0x9f, 0x5d, 0xd8, 0xd3, 0xfb, 0xce,
+	0xe7, 0xe7, 0x8d, 0xd6, 0x7d, 0x04, 0x3d, 0x1e, 0x86, 0xb9, 0x10, 0x76, 0x4a, 0xf7, 0x35, 0xa6,
+	0x84, 0x2a, 0x71, 0xa2, 0xd0, 0xfb, 0xb0, 0x9f, 0xd0, 0x84, 0x8b, 0xa7, 0x8b, 0x5c, 0x92, 0x15,
+	0x35, 0x32, 0xb5, 0x71, 0xcf, 0x62, 0x5f, 0x6b, 0x08, 0x8d, 0xe1, 0x30, 0x21, 0xeb, 0xc5, 0x73,
+	0xb4, 0xb6, 0xa1, 0xdd, 0x49, 0xc8, 0xfa, 0x7c, 0x8b, 0x79, 0x5b, 0x2c, 0x66, 0x09, 0xb3, 0xd2,
+	0xd5, 0xc5, 0xbe, 0xd0, 0x10, 0xba, 0x0f, 0x77, 0x4a, 0xca, 0x92, 0xb0, 0x38, 0x4c, 0x95, 0x91,
+	0xa6, 0x8d, 0x0f, 0x2c, 0xfa, 0xb9, 0x05, 0xd1, 0x87, 0xd0, 0xe5, 0x3c, 0x59, 0x84, 0xda, 0x2c,
+	0xfa, 0x7b, 0x9a, 0x31, 0xdd, 0x2f, 0x36, 0xc3, 0xce, 0x7c, 0x7e, 0x7e, 0xaa, 0x31, 0xdc, 0xe1,
+	0x3c, 0x31, 0xaf, 0xd1, 0x25, 0x1c, 0xd8, 0xbb, 0xa1, 0xe1, 0x24, 0x8a, 0x68, 0xd4, 0x48, 0xcd,
+	0x0f, 0x60, 0x8f, 0xae, 0x69, 0xb8, 0xa8, 0x2f, 0x08, 0x8a, 0xcd, 0xd0, 0xd3, 0x35, 0x67, 0x67,
+	0xd8, 0xd3, 0x9f, 0x66, 0xd1, 0xe8, 0x7b, 0xb8, 0x5b, 0x75, 0x32, 0xfe, 0xf1, 0x16, 0x7b, 0xbd,
+	0x78, 0xb5, 0xa3, 0x87, 0xd6, 0x65, 0xbe, 0x24, 0xb9, 0x6c, 0xd6, 0x78, 0x34, 0x81, 0x9e, 0xae,
+	0x80, 0xa9, 0xcc, 0x93, 0x86, 0x25, 0x96, 0x70, 0x68, 0xfe, 0x74, 0xd4, 0x16, 0xdb, 0x50, 0x83,
+	0xe7, 0x8d, 0xdb, 0xfd, 0xb7, 0x71, 0x6b, 0x4f, 0x2d, 0x7d, 0x3a, 0x0f, 0xaf, 0xde, 0x9e, 0xca,
+	0xc6, 0xd6, 0x88, 0xa2, 0xa5, 0x4f, 0xd9, 0x00, 0x9d, 0x02, 0x48, 0xfb, 0xf3, 0x7d, 0x5d, 0x6f,
+	0xed, 0x96, 0x79, 0x13, 0xe3, 0x81, 0x4b, 0x2e, 0x42, 0x1a, 0x99, 0xdf, 0x82, 0x0e, 0x2e, 0xa3,
+	0xe9, 0xb7, 0x37, 0xcf, 0x06, 0x3b, 0xbf, 0x3d, 0x1b, 0xec, 0xfc, 0x50, 0x0c, 0x9c, 0x9b, 0x62,
+	0xe0, 0xfc, 0x52, 0x0c, 0x9c, 0x3f, 0x8a, 0x81, 0xf3, 0xd3, 0x5f, 0x03, 0xe7, 0x9b, 0xcf, 0x1a,
+	0xfe, 0xbf, 0xf2, 0x89, 0x7d, 0x5d, 0x78, 0x66, 0xbc, 0x8f, 0xfe, 0x19, 0x00, 0xf5, 0x0b, 0x57,
+	0x91, 0xf8, 0x08, 0x00, 0x00,
 }
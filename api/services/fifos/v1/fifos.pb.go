@@ -0,0 +1,1607 @@
+// Code generated by protoc-gen-gogo.
+// source: github.com/containerd/containerd/api/services/fifos/v1/fifos.proto
+// DO NOT EDIT!
+
+/*
+	Package fifos is a generated protocol buffer package.
+
+	It is generated from these files:
+		github.com/containerd/containerd/api/services/fifos/v1/fifos.proto
+
+	It has these top-level messages:
+		FifoSet
+		CreateFifosRequest
+		CreateFifosResponse
+		ListFifosRequest
+		ListFifosResponse
+		DeleteFifosRequest
+*/
+package fifos
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import google_protobuf1 "github.com/golang/protobuf/ptypes/empty"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import strings "strings"
+import reflect "reflect"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type FifoSet struct {
+	// ContainerID is the container owning the set, whose deletion removes it.
+	ContainerID string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// ID names the set within the container, usually the id of the container
+	// itself or of an exec process.
+	ID string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Stdin, Stdout and Stderr are the paths of the fifos, stdin is empty
+	// when the process has no stdin.
+	Stdin  string `protobuf:"bytes,3,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	Stdout string `protobuf:"bytes,4,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr string `protobuf:"bytes,5,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	// UID and GID own the fifos and their directory.
+	UID uint32 `protobuf:"varint,6,opt,name=uid,proto3" json:"uid,omitempty"`
+	GID uint32 `protobuf:"varint,7,opt,name=gid,proto3" json:"gid,omitempty"`
+}
+
+func (m *FifoSet) Reset()                    { *m = FifoSet{} }
+func (*FifoSet) ProtoMessage()               {}
+func (*FifoSet) Descriptor() ([]byte, []int) { return fileDescriptorFifos, []int{0} }
+
+type CreateFifosRequest struct {
+	ContainerID string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// ID names the set within the container, the id of the container is
+	// used when empty.
+	ID string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Stdin creates a fifo for the stdin of the process.
+	Stdin bool `protobuf:"varint,3,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	// UID and GID own the fifos when set. Otherwise the fifos of containers
+	// with a user namespace are owned by the host ids of the root of the
+	// namespace, and by the daemon for other containers.
+	UID uint32 `protobuf:"varint,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	GID uint32 `protobuf:"varint,5,opt,name=gid,proto3" json:"gid,omitempty"`
+}
+
+func (m *CreateFifosRequest) Reset()                    { *m = CreateFifosRequest{} }
+func (*CreateFifosRequest) ProtoMessage()               {}
+func (*CreateFifosRequest) Descriptor() ([]byte, []int) { return fileDescriptorFifos, []int{1} }
+
+type CreateFifosResponse struct {
+	Fifos *FifoSet `protobuf:"bytes,1,opt,name=fifos" json:"fifos,omitempty"`
+}
+
+func (m *CreateFifosResponse) Reset()                    { *m = CreateFifosResponse{} }
+func (*CreateFifosResponse) ProtoMessage()               {}
+func (*CreateFifosResponse) Descriptor() ([]byte, []int) { return fileDescriptorFifos, []int{2} }
+
+type ListFifosRequest struct {
+	ContainerID string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+}
+
+func (m *ListFifosRequest) Reset()                    { *m = ListFifosRequest{} }
+func (*ListFifosRequest) ProtoMessage()               {}
+func (*ListFifosRequest) Descriptor() ([]byte, []int) { return fileDescriptorFifos, []int{3} }
+
+type ListFifosResponse struct {
+	Fifos []FifoSet `protobuf:"bytes,1,rep,name=fifos" json:"fifos"`
+}
+
+func (m *ListFifosResponse) Reset()                    { *m = ListFifosResponse{} }
+func (*ListFifosResponse) ProtoMessage()               {}
+func (*ListFifosResponse) Descriptor() ([]byte, []int) { return fileDescriptorFifos, []int{4} }
+
+type DeleteFifosRequest struct {
+	ContainerID string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ID          string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *DeleteFifosRequest) Reset()                    { *m = DeleteFifosRequest{} }
+func (*DeleteFifosRequest) ProtoMessage()               {}
+func (*DeleteFifosRequest) Descriptor() ([]byte, []int) { return fileDescriptorFifos, []int{5} }
+
+func init() {
+	proto.RegisterType((*FifoSet)(nil), "containerd.services.fifos.v1.FifoSet")
+	proto.RegisterType((*CreateFifosRequest)(nil), "containerd.services.fifos.v1.CreateFifosRequest")
+	proto.RegisterType((*CreateFifosResponse)(nil), "containerd.services.fifos.v1.CreateFifosResponse")
+	proto.RegisterType((*ListFifosRequest)(nil), "containerd.services.fifos.v1.ListFifosRequest")
+	proto.RegisterType((*ListFifosResponse)(nil), "containerd.services.fifos.v1.ListFifosResponse")
+	proto.RegisterType((*DeleteFifosRequest)(nil), "containerd.services.fifos.v1.DeleteFifosRequest")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Fifos service
+
+type FifosClient interface {
+	// Create creates a set of fifos for a process of a container. Their
+	// paths are passed to the create of the task or the exec of the process.
+	Create(ctx context.Context, in *CreateFifosRequest, opts ...grpc.CallOption) (*CreateFifosResponse, error)
+	// List lists the sets of fifos of a container, or of all containers
+	// when no container is given.
+	List(ctx context.Context, in *ListFifosRequest, opts ...grpc.CallOption) (*ListFifosResponse, error)
+	// Delete removes a set of fifos.
+	Delete(ctx context.Context, in *DeleteFifosRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+}
+
+type fifosClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewFifosClient(cc *grpc.ClientConn) FifosClient {
+	return &fifosClient{cc}
+}
+
+func (c *fifosClient) Create(ctx context.Context, in *CreateFifosRequest, opts ...grpc.CallOption) (*CreateFifosResponse, error) {
+	out := new(CreateFifosResponse)
+	err := grpc.Invoke(ctx, "/containerd.services.fifos.v1.Fifos/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fifosClient) List(ctx context.Context, in *ListFifosRequest, opts ...grpc.CallOption) (*ListFifosResponse, error) {
+	out := new(ListFifosResponse)
+	err := grpc.Invoke(ctx, "/containerd.services.fifos.v1.Fifos/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fifosClient) Delete(ctx context.Context, in *DeleteFifosRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/containerd.services.fifos.v1.Fifos/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Fifos service
+
+type FifosServer interface {
+	// Create creates a set of fifos for a process of a container. Their
+	// paths are passed to the create of the task or the exec of the process.
+	Create(context.Context, *CreateFifosRequest) (*CreateFifosResponse, error)
+	// List lists the sets of fifos of a container, or of all containers
+	// when no container is given.
+	List(context.Context, *ListFifosRequest) (*ListFifosResponse, error)
+	// Delete removes a set of fifos.
+	Delete(context.Context, *DeleteFifosRequest) (*google_protobuf1.Empty, error)
+}
+
+func RegisterFifosServer(s *grpc.Server, srv FifosServer) {
+	s.RegisterService(&_Fifos_serviceDesc, srv)
+}
+
+func _Fifos_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFifosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FifosServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.services.fifos.v1.Fifos/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FifosServer).Create(ctx, req.(*CreateFifosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fifos_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFifosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FifosServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.services.fifos.v1.Fifos/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FifosServer).List(ctx, req.(*ListFifosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fifos_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFifosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FifosServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.services.fifos.v1.Fifos/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FifosServer).Delete(ctx, req.(*DeleteFifosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Fifos_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "containerd.services.fifos.v1.Fifos",
+	HandlerType: (*FifosServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Fifos_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Fifos_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Fifos_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/containerd/containerd/api/services/fifos/v1/fifos.proto",
+}
+
+func (m *FifoSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FifoSet) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFifos(dAtA, i, uint64(len(m.ContainerID)))
+		i += copy(dAtA[i:], m.ContainerID)
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFifos(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Stdin) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFifos(dAtA, i, uint64(len(m.Stdin)))
+		i += copy(dAtA[i:], m.Stdin)
+	}
+	if len(m.Stdout) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintFifos(dAtA, i, uint64(len(m.Stdout)))
+		i += copy(dAtA[i:], m.Stdout)
+	}
+	if len(m.Stderr) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintFifos(dAtA, i, uint64(len(m.Stderr)))
+		i += copy(dAtA[i:], m.Stderr)
+	}
+	if m.UID != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintFifos(dAtA, i, uint64(m.UID))
+	}
+	if m.GID != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintFifos(dAtA, i, uint64(m.GID))
+	}
+	return i, nil
+}
+
+func (m *CreateFifosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateFifosRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFifos(dAtA, i, uint64(len(m.ContainerID)))
+		i += copy(dAtA[i:], m.ContainerID)
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFifos(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Stdin {
+		dAtA[i] = 0x18
+		i++
+		if m.Stdin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.UID != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintFifos(dAtA, i, uint64(m.UID))
+	}
+	if m.GID != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintFifos(dAtA, i, uint64(m.GID))
+	}
+	return i, nil
+}
+
+func (m *CreateFifosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateFifosResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Fifos != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFifos(dAtA, i, uint64(m.Fifos.Size()))
+		n1, err := m.Fifos.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	return i, nil
+}
+
+func (m *ListFifosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListFifosRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFifos(dAtA, i, uint64(len(m.ContainerID)))
+		i += copy(dAtA[i:], m.ContainerID)
+	}
+	return i, nil
+}
+
+func (m *ListFifosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListFifosResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fifos) > 0 {
+		for _, msg := range m.Fifos {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintFifos(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DeleteFifosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteFifosRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFifos(dAtA, i, uint64(len(m.ContainerID)))
+		i += copy(dAtA[i:], m.ContainerID)
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFifos(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	return i, nil
+}
+
+func encodeFixed64Fifos(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Fifos(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintFifos(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *FifoSet) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerID)
+	if l > 0 {
+		n += 1 + l + sovFifos(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovFifos(uint64(l))
+	}
+	l = len(m.Stdin)
+	if l > 0 {
+		n += 1 + l + sovFifos(uint64(l))
+	}
+	l = len(m.Stdout)
+	if l > 0 {
+		n += 1 + l + sovFifos(uint64(l))
+	}
+	l = len(m.Stderr)
+	if l > 0 {
+		n += 1 + l + sovFifos(uint64(l))
+	}
+	if m.UID != 0 {
+		n += 1 + sovFifos(uint64(m.UID))
+	}
+	if m.GID != 0 {
+		n += 1 + sovFifos(uint64(m.GID))
+	}
+	return n
+}
+
+func (m *CreateFifosRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerID)
+	if l > 0 {
+		n += 1 + l + sovFifos(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovFifos(uint64(l))
+	}
+	if m.Stdin {
+		n += 2
+	}
+	if m.UID != 0 {
+		n += 1 + sovFifos(uint64(m.UID))
+	}
+	if m.GID != 0 {
+		n += 1 + sovFifos(uint64(m.GID))
+	}
+	return n
+}
+
+func (m *CreateFifosResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Fifos != nil {
+		l = m.Fifos.Size()
+		n += 1 + l + sovFifos(uint64(l))
+	}
+	return n
+}
+
+func (m *ListFifosRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerID)
+	if l > 0 {
+		n += 1 + l + sovFifos(uint64(l))
+	}
+	return n
+}
+
+func (m *ListFifosResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Fifos) > 0 {
+		for _, e := range m.Fifos {
+			l = e.Size()
+			n += 1 + l + sovFifos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteFifosRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerID)
+	if l > 0 {
+		n += 1 + l + sovFifos(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovFifos(uint64(l))
+	}
+	return n
+}
+
+func sovFifos(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozFifos(x uint64) (n int) {
+	return sovFifos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *FifoSet) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FifoSet{`,
+		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Stdin:` + fmt.Sprintf("%v", this.Stdin) + `,`,
+		`Stdout:` + fmt.Sprintf("%v", this.Stdout) + `,`,
+		`Stderr:` + fmt.Sprintf("%v", this.Stderr) + `,`,
+		`UID:` + fmt.Sprintf("%v", this.UID) + `,`,
+		`GID:` + fmt.Sprintf("%v", this.GID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateFifosRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateFifosRequest{`,
+		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Stdin:` + fmt.Sprintf("%v", this.Stdin) + `,`,
+		`UID:` + fmt.Sprintf("%v", this.UID) + `,`,
+		`GID:` + fmt.Sprintf("%v", this.GID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateFifosResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateFifosResponse{`,
+		`Fifos:` + strings.Replace(fmt.Sprintf("%v", this.Fifos), "FifoSet", "FifoSet", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListFifosRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListFifosRequest{`,
+		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListFifosResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListFifosResponse{`,
+		`Fifos:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Fifos), "FifoSet", "FifoSet", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteFifosRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteFifosRequest{`,
+		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringFifos(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *FifoSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFifos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FifoSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FifoSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFifos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFifos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFifos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFifos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFifos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stderr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			m.UID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UID |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GID", wireType)
+			}
+			m.GID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GID |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFifos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFifos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateFifosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFifos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateFifosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateFifosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFifos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFifos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stdin = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			m.UID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UID |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GID", wireType)
+			}
+			m.GID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GID |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFifos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFifos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateFifosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFifos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateFifosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateFifosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fifos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFifos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fifos == nil {
+				m.Fifos = &FifoSet{}
+			}
+			if err := m.Fifos.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFifos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFifos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListFifosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFifos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListFifosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListFifosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFifos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFifos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFifos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListFifosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFifos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListFifosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListFifosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fifos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFifos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fifos = append(m.Fifos, FifoSet{})
+			if err := m.Fifos[len(m.Fifos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFifos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFifos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteFifosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFifos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteFifosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteFifosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFifos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFifos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFifos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFifos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFifos(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFifos
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFifos
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthFifos
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowFifos
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipFifos(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthFifos = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFifos   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() {
+	proto.RegisterFile("github.com/containerd/containerd/api/services/fifos/v1/fifos.proto", fileDescriptorFifos)
+}
+
+var fileDescriptorFifos = []byte{
+	// 477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0x3a, 0xb1, 0x03, 0x13, 0x10, 0xb0, 0x54, 0x91, 0x31, 0xc8, 0xa9, 0x22, 0x21, 0xf5,
+	0xb4, 0x26, 0xe1, 0x58, 0x2e, 0xb8, 0xa1, 0x28, 0x12, 0x27, 0x23, 0x7a, 0xe0, 0x02, 0x49, 0x76,
+	0x63, 0x56, 0x6a, 0xb2, 0xc1, 0xbb, 0x8e, 0xc4, 0x8d, 0x07, 0xe2, 0xc6, 0x4b, 0xe4, 0x08, 0x37,
+	0x4e, 0x16, 0xf5, 0x93, 0x20, 0xef, 0xda, 0x49, 0xa1, 0x10, 0x0a, 0x55, 0x6e, 0xf3, 0xf3, 0xcd,
+	0xec, 0x37, 0xf3, 0xad, 0x06, 0xc2, 0x98, 0xab, 0x77, 0xe9, 0x98, 0x4c, 0xc4, 0x2c, 0x98, 0x88,
+	0xb9, 0x1a, 0xf1, 0x39, 0x4b, 0xe8, 0x79, 0x73, 0xb4, 0xe0, 0x81, 0x64, 0xc9, 0x92, 0x4f, 0x98,
+	0x0c, 0xa6, 0x7c, 0x2a, 0x64, 0xb0, 0xec, 0x19, 0x83, 0x2c, 0x12, 0xa1, 0x04, 0x7e, 0xb0, 0x41,
+	0x93, 0x0a, 0x49, 0x0c, 0x60, 0xd9, 0xf3, 0xf6, 0x62, 0x11, 0x0b, 0x0d, 0x0c, 0x0a, 0xcb, 0xd4,
+	0x78, 0xf7, 0x63, 0x21, 0xe2, 0x53, 0x16, 0x68, 0x6f, 0x9c, 0x4e, 0x03, 0x36, 0x5b, 0xa8, 0x0f,
+	0x26, 0xd9, 0xfd, 0x8a, 0xa0, 0x79, 0xcc, 0xa7, 0xe2, 0x25, 0x53, 0xb8, 0x0f, 0x37, 0xd6, 0xed,
+	0xdf, 0x70, 0xea, 0xa2, 0x7d, 0x74, 0x70, 0x3d, 0xbc, 0x95, 0x67, 0x9d, 0xd6, 0x51, 0x15, 0x1f,
+	0x0e, 0xa2, 0xd6, 0x1a, 0x34, 0xa4, 0xb8, 0x0d, 0x16, 0xa7, 0xae, 0xa5, 0x91, 0x4e, 0x9e, 0x75,
+	0xac, 0xe1, 0x20, 0xb2, 0x38, 0xc5, 0x7b, 0x60, 0x4b, 0x45, 0xf9, 0xdc, 0xad, 0x17, 0xa9, 0xc8,
+	0x38, 0xb8, 0x0d, 0x8e, 0x54, 0x54, 0xa4, 0xca, 0x6d, 0xe8, 0x70, 0xe9, 0x95, 0x71, 0x96, 0x24,
+	0xae, 0xbd, 0x8e, 0xb3, 0x24, 0xc1, 0xf7, 0xa0, 0x9e, 0x72, 0xea, 0x3a, 0xfb, 0xe8, 0xe0, 0x66,
+	0xd8, 0xcc, 0xb3, 0x4e, 0xfd, 0xd5, 0x70, 0x10, 0x15, 0xb1, 0x22, 0x15, 0x73, 0xea, 0x36, 0x37,
+	0xa9, 0xe7, 0x45, 0x2a, 0xe6, 0xb4, 0xfb, 0x09, 0x01, 0x3e, 0x4a, 0xd8, 0x48, 0xb1, 0x62, 0x32,
+	0x19, 0xb1, 0xf7, 0x29, 0x93, 0x3b, 0x1c, 0xef, 0x5a, 0x35, 0x5e, 0x49, 0xb7, 0xf1, 0x67, 0xba,
+	0xf6, 0x6f, 0xe8, 0x46, 0x70, 0xf7, 0x27, 0xb6, 0x72, 0x21, 0xe6, 0x92, 0xe1, 0x43, 0xb0, 0xb5,
+	0xb0, 0x9a, 0x67, 0xab, 0xff, 0x90, 0x6c, 0x93, 0x9e, 0x94, 0x1a, 0x46, 0xa6, 0xa6, 0x7b, 0x0c,
+	0xb7, 0x5f, 0x70, 0xa9, 0xae, 0x3a, 0x7f, 0xf7, 0x04, 0xee, 0x9c, 0xeb, 0x53, 0x32, 0x7b, 0xba,
+	0x61, 0x56, 0xbf, 0x34, 0xb3, 0xb0, 0xb1, 0xca, 0x3a, 0xb5, 0x8a, 0xdf, 0x5b, 0xc0, 0x03, 0x76,
+	0xca, 0x76, 0xa7, 0x50, 0xff, 0xb3, 0x05, 0xb6, 0x6e, 0x8e, 0x67, 0xe0, 0x98, 0xfd, 0xe2, 0x47,
+	0xdb, 0x99, 0x5e, 0xfc, 0x33, 0x5e, 0xef, 0x1f, 0x2a, 0xca, 0xed, 0xc4, 0xd0, 0x28, 0x56, 0x86,
+	0xc9, 0xf6, 0xd2, 0x5f, 0xe5, 0xf1, 0x82, 0x4b, 0xe3, 0xcb, 0x87, 0x22, 0x70, 0xcc, 0x0e, 0xff,
+	0x36, 0xd7, 0xc5, 0x4d, 0x7b, 0x6d, 0x62, 0x8e, 0x02, 0xa9, 0x8e, 0x02, 0x79, 0x56, 0x1c, 0x85,
+	0xf0, 0x64, 0x75, 0xe6, 0xd7, 0xbe, 0x9d, 0xf9, 0xb5, 0x8f, 0xb9, 0x8f, 0x56, 0xb9, 0x8f, 0xbe,
+	0xe4, 0x3e, 0xfa, 0x9e, 0xfb, 0xe8, 0xf5, 0x93, 0xff, 0xbb, 0x5e, 0x87, 0xda, 0x18, 0x3b, 0xfa,
+	0x9d, 0xc7, 0x3f, 0x06, 0x00, 0xf9, 0x84, 0x21, 0x37, 0x04, 0x05, 0x00, 0x00,
+}
@@ -0,0 +1,621 @@
+// Code generated by protoc-gen-gogo.
+// source: github.com/containerd/containerd/api/services/metadata/v1/metadata.proto
+// DO NOT EDIT!
+
+/*
+	Package metadata is a generated protocol buffer package.
+
+	It is generated from these files:
+		github.com/containerd/containerd/api/services/metadata/v1/metadata.proto
+
+	It has these top-level messages:
+		ExportMetadataRequest
+		ExportMetadataResponse
+*/
+package metadata
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import strings "strings"
+import reflect "reflect"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type ExportMetadataRequest struct {
+}
+
+func (m *ExportMetadataRequest) Reset()                    { *m = ExportMetadataRequest{} }
+func (*ExportMetadataRequest) ProtoMessage()               {}
+func (*ExportMetadataRequest) Descriptor() ([]byte, []int) { return fileDescriptorMetadata, []int{0} }
+
+type ExportMetadataResponse struct {
+	// Schema is the version of the bucket layout of the copy, such as "v1".
+	// Only set on the first message.
+	Schema string `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	// Length of the copy in bytes. Only set on the first message.
+	Length int64  `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	Data   []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ExportMetadataResponse) Reset()                    { *m = ExportMetadataResponse{} }
+func (*ExportMetadataResponse) ProtoMessage()               {}
+func (*ExportMetadataResponse) Descriptor() ([]byte, []int) { return fileDescriptorMetadata, []int{1} }
+
+func init() {
+	proto.RegisterType((*ExportMetadataRequest)(nil), "containerd.services.metadata.v1.ExportMetadataRequest")
+	proto.RegisterType((*ExportMetadataResponse)(nil), "containerd.services.metadata.v1.ExportMetadataResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Metadata service
+
+type MetadataClient interface {
+	// Export streams a copy of the metadata store as of a single read
+	// transaction. The first message holds the schema and length of the copy.
+	Export(ctx context.Context, in *ExportMetadataRequest, opts ...grpc.CallOption) (Metadata_ExportClient, error)
+}
+
+type metadataClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMetadataClient(cc *grpc.ClientConn) MetadataClient {
+	return &metadataClient{cc}
+}
+
+func (c *metadataClient) Export(ctx context.Context, in *ExportMetadataRequest, opts ...grpc.CallOption) (Metadata_ExportClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Metadata_serviceDesc.Streams[0], c.cc, "/containerd.services.metadata.v1.Metadata/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &metadataExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Metadata_ExportClient interface {
+	Recv() (*ExportMetadataResponse, error)
+	grpc.ClientStream
+}
+
+type metadataExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *metadataExportClient) Recv() (*ExportMetadataResponse, error) {
+	m := new(ExportMetadataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for Metadata service
+
+type MetadataServer interface {
+	// Export streams a copy of the metadata store as of a single read
+	// transaction. The first message holds the schema and length of the copy.
+	Export(*ExportMetadataRequest, Metadata_ExportServer) error
+}
+
+func RegisterMetadataServer(s *grpc.Server, srv MetadataServer) {
+	s.RegisterService(&_Metadata_serviceDesc, srv)
+}
+
+func _Metadata_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportMetadataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetadataServer).Export(m, &metadataExportServer{stream})
+}
+
+type Metadata_ExportServer interface {
+	Send(*ExportMetadataResponse) error
+	grpc.ServerStream
+}
+
+type metadataExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *metadataExportServer) Send(m *ExportMetadataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Metadata_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "containerd.services.metadata.v1.Metadata",
+	HandlerType: (*MetadataServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _Metadata_Export_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "github.com/containerd/containerd/api/services/metadata/v1/metadata.proto",
+}
+
+func (m *ExportMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ExportMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schema) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Schema)))
+		i += copy(dAtA[i:], m.Schema)
+	}
+	if m.Length != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMetadata(dAtA, i, uint64(m.Length))
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func encodeFixed64Metadata(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Metadata(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintMetadata(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ExportMetadataRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExportMetadataResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	if m.Length != 0 {
+		n += 1 + sovMetadata(uint64(m.Length))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	return n
+}
+
+func sovMetadata(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozMetadata(x uint64) (n int) {
+	return sovMetadata(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ExportMetadataRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExportMetadataRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExportMetadataResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExportMetadataResponse{`,
+		`Schema:` + fmt.Sprintf("%v", this.Schema) + `,`,
+		`Length:` + fmt.Sprintf("%v", this.Length) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMetadata(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ExportMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMetadata(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthMetadata
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowMetadata
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipMetadata(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthMetadata = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMetadata   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() {
+	proto.RegisterFile("github.com/containerd/containerd/api/services/metadata/v1/metadata.proto", fileDescriptorMetadata)
+}
+
+var fileDescriptorMetadata = []byte{
+	// 243 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xf2, 0x48, 0xcf, 0x2c, 0xc9,
+	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xce, 0xcf, 0x2b, 0x49, 0xcc, 0xcc, 0x4b, 0x2d,
+	0x4a, 0x41, 0x66, 0x26, 0x16, 0x64, 0xea, 0x17, 0xa7, 0x16, 0x95, 0x65, 0x26, 0xa7, 0x16, 0xeb,
+	0xe7, 0xa6, 0x96, 0x24, 0xa6, 0x24, 0x96, 0x24, 0xea, 0x97, 0x19, 0xc2, 0xd9, 0x7a, 0x05, 0x45,
+	0xf9, 0x25, 0xf9, 0x42, 0xf2, 0x08, 0x3d, 0x7a, 0x30, 0xf5, 0x7a, 0x70, 0x35, 0x65, 0x86, 0x4a,
+	0xe2, 0x5c, 0xa2, 0xae, 0x15, 0x05, 0xf9, 0x45, 0x25, 0xbe, 0x50, 0xc1, 0xa0, 0xd4, 0xc2, 0xd2,
+	0xd4, 0xe2, 0x12, 0xa5, 0x18, 0x2e, 0x31, 0x74, 0x89, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0x21,
+	0x31, 0x2e, 0xb6, 0xe2, 0xe4, 0x8c, 0xd4, 0xdc, 0x44, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20,
+	0x28, 0x0f, 0x24, 0x9e, 0x93, 0x9a, 0x97, 0x5e, 0x92, 0x21, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x1c,
+	0x04, 0xe5, 0x09, 0x09, 0x71, 0xb1, 0x80, 0xf4, 0x4b, 0x30, 0x2b, 0x30, 0x6a, 0xf0, 0x04, 0x81,
+	0xd9, 0x46, 0xed, 0x8c, 0x5c, 0x1c, 0x30, 0x83, 0x85, 0xaa, 0xb9, 0xd8, 0x20, 0x56, 0x09, 0x99,
+	0xe9, 0x11, 0x70, 0xaf, 0x1e, 0x56, 0xc7, 0x4a, 0x99, 0x93, 0xac, 0x0f, 0xe2, 0x17, 0x03, 0x46,
+	0xa7, 0x98, 0x13, 0x0f, 0xe5, 0x18, 0x6e, 0x3c, 0x94, 0x63, 0x68, 0x78, 0x24, 0xc7, 0x78, 0xe2,
+	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x46, 0x39, 0x91, 0x1d, 0x0b,
+	0xd6, 0x30, 0x76, 0x12, 0x1b, 0x38, 0x1a, 0x8c, 0x01, 0x03, 0x00, 0xa1, 0x24, 0x4e, 0x50, 0xd2,
+	0x01, 0x00, 0x00,
+}
@@ -0,0 +1,633 @@
+// Code generated by protoc-gen-gogo.
+// source: github.com/containerd/containerd/api/services/migration/v1/migration.proto
+// DO NOT EDIT!
+
+/*
+	Package migration is a generated protocol buffer package.
+
+	It is generated from these files:
+		github.com/containerd/containerd/api/services/migration/v1/migration.proto
+
+	It has these top-level messages:
+		SendRequest
+		SendResponse
+*/
+package migration
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import containerd_types "github.com/containerd/containerd/api/types"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import strings "strings"
+import reflect "reflect"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type SendRequest struct {
+	Target containerd_types.Descriptor `protobuf:"bytes,1,opt,name=target" json:"target"`
+	// Address is the GRPC address of the remote daemon, either a unix
+	// socket or a TCP address prefixed with tcp://. TCP connections are
+	// secured with the TLS configuration of the migration plugin.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *SendRequest) Reset()                    { *m = SendRequest{} }
+func (*SendRequest) ProtoMessage()               {}
+func (*SendRequest) Descriptor() ([]byte, []int) { return fileDescriptorMigration, []int{0} }
+
+type SendResponse struct {
+	// Blobs and Size are the number and total size of the blobs copied,
+	// excluding those the remote daemon already had.
+	Blobs int64 `protobuf:"varint,1,opt,name=blobs,proto3" json:"blobs,omitempty"`
+	Size_ int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (m *SendResponse) Reset()                    { *m = SendResponse{} }
+func (*SendResponse) ProtoMessage()               {}
+func (*SendResponse) Descriptor() ([]byte, []int) { return fileDescriptorMigration, []int{1} }
+
+func init() {
+	proto.RegisterType((*SendRequest)(nil), "containerd.services.migration.v1.SendRequest")
+	proto.RegisterType((*SendResponse)(nil), "containerd.services.migration.v1.SendResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Migration service
+
+type MigrationClient interface {
+	// Send copies the blob of the descriptor and the blobs it references
+	// from the content store of the namespace of the request to the same
+	// namespace of the remote daemon. Blobs already in the remote content
+	// store are skipped.
+	Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error)
+}
+
+type migrationClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMigrationClient(cc *grpc.ClientConn) MigrationClient {
+	return &migrationClient{cc}
+}
+
+func (c *migrationClient) Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error) {
+	out := new(SendResponse)
+	err := grpc.Invoke(ctx, "/containerd.services.migration.v1.Migration/Send", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Migration service
+
+type MigrationServer interface {
+	// Send copies the blob of the descriptor and the blobs it references
+	// from the content store of the namespace of the request to the same
+	// namespace of the remote daemon. Blobs already in the remote content
+	// store are skipped.
+	Send(context.Context, *SendRequest) (*SendResponse, error)
+}
+
+func RegisterMigrationServer(s *grpc.Server, srv MigrationServer) {
+	s.RegisterService(&_Migration_serviceDesc, srv)
+}
+
+func _Migration_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MigrationServer).Send(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.services.migration.v1.Migration/Send",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MigrationServer).Send(ctx, req.(*SendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Migration_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "containerd.services.migration.v1.Migration",
+	HandlerType: (*MigrationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Send",
+			Handler:    _Migration_Send_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/containerd/containerd/api/services/migration/v1/migration.proto",
+}
+
+func (m *SendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMigration(dAtA, i, uint64(m.Target.Size()))
+	n1, err := m.Target.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMigration(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	return i, nil
+}
+
+func (m *SendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Blobs != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMigration(dAtA, i, uint64(m.Blobs))
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMigration(dAtA, i, uint64(m.Size_))
+	}
+	return i, nil
+}
+
+func encodeFixed64Migration(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Migration(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintMigration(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *SendRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Target.Size()
+	n += 1 + l + sovMigration(uint64(l))
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMigration(uint64(l))
+	}
+	return n
+}
+
+func (m *SendResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Blobs != 0 {
+		n += 1 + sovMigration(uint64(m.Blobs))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovMigration(uint64(m.Size_))
+	}
+	return n
+}
+
+func sovMigration(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozMigration(x uint64) (n int) {
+	return sovMigration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *SendRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SendRequest{`,
+		`Target:` + strings.Replace(strings.Replace(this.Target.String(), "Descriptor", "containerd_types.Descriptor", 1), `&`, ``, 1) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SendResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SendResponse{`,
+		`Blobs:` + fmt.Sprintf("%v", this.Blobs) + `,`,
+		`Size_:` + fmt.Sprintf("%v", this.Size_) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMigration(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *SendRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMigration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			m.Blobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blobs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMigration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMigration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthMigration
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowMigration
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipMigration(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthMigration = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMigration   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() {
+	proto.RegisterFile("github.com/containerd/containerd/api/services/migration/v1/migration.proto", fileDescriptorMigration)
+}
+
+var fileDescriptorMigration = []byte{
+	// 301 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xbf, 0x4e, 0xf3, 0x30,
+	0x14, 0xc5, 0xe3, 0xaf, 0xf9, 0x8a, 0xea, 0x32, 0x59, 0x1d, 0xa2, 0x08, 0x99, 0xa8, 0x53, 0x17,
+	0x6c, 0xb5, 0x2c, 0x88, 0x6e, 0x55, 0x27, 0x24, 0x96, 0xb0, 0x31, 0x20, 0xe5, 0xcf, 0x55, 0xb0,
+	0x44, 0xe3, 0x60, 0xbb, 0x91, 0x60, 0xe2, 0xf1, 0x32, 0x32, 0x32, 0x21, 0x9a, 0x27, 0x41, 0x75,
+	0x48, 0x93, 0x8d, 0x8a, 0xed, 0x1e, 0xe9, 0xfe, 0xce, 0x3d, 0x3e, 0xc6, 0x37, 0x99, 0x30, 0x8f,
+	0xdb, 0x98, 0x25, 0x72, 0xc3, 0x13, 0x99, 0x9b, 0x48, 0xe4, 0xa0, 0xd2, 0xfe, 0x18, 0x15, 0x82,
+	0x6b, 0x50, 0xa5, 0x48, 0x40, 0xf3, 0x8d, 0xc8, 0x54, 0x64, 0x84, 0xcc, 0x79, 0x39, 0xef, 0x04,
+	0x2b, 0x94, 0x34, 0x92, 0x04, 0x1d, 0xc5, 0x5a, 0x82, 0x75, 0x4b, 0xe5, 0xdc, 0x9f, 0x64, 0x32,
+	0x93, 0x76, 0x99, 0xef, 0xa7, 0x86, 0xf3, 0x97, 0x47, 0x65, 0x30, 0x2f, 0x05, 0x68, 0x9e, 0x82,
+	0x4e, 0x94, 0x28, 0x8c, 0x54, 0x0d, 0x3c, 0x4d, 0xf0, 0xf8, 0x0e, 0xf2, 0x34, 0x84, 0xe7, 0x2d,
+	0x68, 0x43, 0xae, 0xf1, 0xd0, 0x44, 0x2a, 0x03, 0xe3, 0xa1, 0x00, 0xcd, 0xc6, 0x8b, 0x33, 0xd6,
+	0x0b, 0x65, 0x2d, 0xd8, 0xfa, 0x60, 0xb1, 0x72, 0xab, 0xcf, 0x73, 0x27, 0xfc, 0x21, 0x88, 0x87,
+	0x4f, 0xa2, 0x34, 0x55, 0xa0, 0xb5, 0xf7, 0x2f, 0x40, 0xb3, 0x51, 0xd8, 0xca, 0xe9, 0x15, 0x3e,
+	0x6d, 0x8e, 0xe8, 0x42, 0xe6, 0x1a, 0xc8, 0x04, 0xff, 0x8f, 0x9f, 0x64, 0xac, 0xed, 0x91, 0x41,
+	0xd8, 0x08, 0x42, 0xb0, 0xab, 0xc5, 0x2b, 0x58, 0x78, 0x10, 0xda, 0x79, 0xa1, 0xf0, 0xe8, 0xb6,
+	0x6d, 0x80, 0x00, 0x76, 0xf7, 0x36, 0xe4, 0x82, 0xfd, 0xd6, 0x14, 0xeb, 0xbd, 0xc9, 0x67, 0xc7,
+	0xae, 0x37, 0xe9, 0x56, 0x0f, 0xd5, 0x8e, 0x3a, 0x1f, 0x3b, 0xea, 0xbc, 0xd5, 0x14, 0x55, 0x35,
+	0x45, 0xef, 0x35, 0x45, 0x5f, 0x35, 0x45, 0xf7, 0xeb, 0xbf, 0xff, 0xf6, 0xf2, 0x20, 0xe2, 0xa1,
+	0x6d, 0xfe, 0xf2, 0x7b, 0x00, 0xb1, 0x8d, 0x4d, 0x09, 0x3c, 0x02, 0x00, 0x00,
+}
@@ -0,0 +1,1146 @@
+// Code generated by protoc-gen-gogo.
+// source: github.com/containerd/containerd/api/services/stats/v1/stats.proto
+// DO NOT EDIT!
+
+/*
+	Package stats is a generated protocol buffer package.
+
+	It is generated from these files:
+		github.com/containerd/containerd/api/services/stats/v1/stats.proto
+
+	It has these top-level messages:
+		AggregateRequest
+		Usage
+		AggregateResponse
+*/
+package stats
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import _ "github.com/gogo/protobuf/types"
+
+import time "time"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+
+import strings "strings"
+import reflect "reflect"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type AggregateRequest struct {
+	// Filters select the containers with the syntax of the filters of the
+	// containers service, for example labels."io.kubernetes.pod.uid"==abc.
+	// A container is selected if it matches any of the filters.
+	Filters []string `protobuf:"bytes,1,rep,name=filters" json:"filters,omitempty"`
+}
+
+func (m *AggregateRequest) Reset()                    { *m = AggregateRequest{} }
+func (*AggregateRequest) ProtoMessage()               {}
+func (*AggregateRequest) Descriptor() ([]byte, []int) { return fileDescriptorStats, []int{0} }
+
+type Usage struct {
+	// CPU time in nanoseconds.
+	CPUUsageTotal       uint64 `protobuf:"varint,1,opt,name=cpu_usage_total,json=cpuUsageTotal,proto3" json:"cpu_usage_total,omitempty"`
+	CPUUsageKernel      uint64 `protobuf:"varint,2,opt,name=cpu_usage_kernel,json=cpuUsageKernel,proto3" json:"cpu_usage_kernel,omitempty"`
+	CPUUsageUser        uint64 `protobuf:"varint,3,opt,name=cpu_usage_user,json=cpuUsageUser,proto3" json:"cpu_usage_user,omitempty"`
+	CPUThrottledPeriods uint64 `protobuf:"varint,4,opt,name=cpu_throttled_periods,json=cpuThrottledPeriods,proto3" json:"cpu_throttled_periods,omitempty"`
+	CPUThrottledTime    uint64 `protobuf:"varint,5,opt,name=cpu_throttled_time,json=cpuThrottledTime,proto3" json:"cpu_throttled_time,omitempty"`
+	// Memory in bytes.
+	MemoryUsage uint64 `protobuf:"varint,6,opt,name=memory_usage,json=memoryUsage,proto3" json:"memory_usage,omitempty"`
+	MemoryRSS   uint64 `protobuf:"varint,7,opt,name=memory_rss,json=memoryRss,proto3" json:"memory_rss,omitempty"`
+	MemoryCache uint64 `protobuf:"varint,8,opt,name=memory_cache,json=memoryCache,proto3" json:"memory_cache,omitempty"`
+	MemorySwap  uint64 `protobuf:"varint,9,opt,name=memory_swap,json=memorySwap,proto3" json:"memory_swap,omitempty"`
+	Pids        uint64 `protobuf:"varint,10,opt,name=pids,proto3" json:"pids,omitempty"`
+	// Bytes read from and written to block devices.
+	BlkioReadBytes  uint64 `protobuf:"varint,11,opt,name=blkio_read_bytes,json=blkioReadBytes,proto3" json:"blkio_read_bytes,omitempty"`
+	BlkioWriteBytes uint64 `protobuf:"varint,12,opt,name=blkio_write_bytes,json=blkioWriteBytes,proto3" json:"blkio_write_bytes,omitempty"`
+}
+
+func (m *Usage) Reset()                    { *m = Usage{} }
+func (*Usage) ProtoMessage()               {}
+func (*Usage) Descriptor() ([]byte, []int) { return fileDescriptorStats, []int{1} }
+
+type AggregateResponse struct {
+	Usage Usage `protobuf:"bytes,1,opt,name=usage" json:"usage"`
+	// Containers are the ids of the containers whose usage was combined,
+	// the selected containers that have a running task.
+	Containers []string `protobuf:"bytes,2,rep,name=containers" json:"containers,omitempty"`
+	// OldestSample is the time of the oldest of the samples combined.
+	OldestSample time.Time `protobuf:"bytes,3,opt,name=oldest_sample,json=oldestSample,stdtime" json:"oldest_sample"`
+}
+
+func (m *AggregateResponse) Reset()                    { *m = AggregateResponse{} }
+func (*AggregateResponse) ProtoMessage()               {}
+func (*AggregateResponse) Descriptor() ([]byte, []int) { return fileDescriptorStats, []int{2} }
+
+func init() {
+	proto.RegisterType((*AggregateRequest)(nil), "containerd.services.stats.v1.AggregateRequest")
+	proto.RegisterType((*Usage)(nil), "containerd.services.stats.v1.Usage")
+	proto.RegisterType((*AggregateResponse)(nil), "containerd.services.stats.v1.AggregateResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Stats service
+
+type StatsClient interface {
+	// Aggregate returns the combined resource usage of the running tasks of
+	// the containers matching the filters.
+	Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateResponse, error)
+}
+
+type statsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewStatsClient(cc *grpc.ClientConn) StatsClient {
+	return &statsClient{cc}
+}
+
+func (c *statsClient) Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateResponse, error) {
+	out := new(AggregateResponse)
+	err := grpc.Invoke(ctx, "/containerd.services.stats.v1.Stats/Aggregate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Stats service
+
+type StatsServer interface {
+	// Aggregate returns the combined resource usage of the running tasks of
+	// the containers matching the filters.
+	Aggregate(context.Context, *AggregateRequest) (*AggregateResponse, error)
+}
+
+func RegisterStatsServer(s *grpc.Server, srv StatsServer) {
+	s.RegisterService(&_Stats_serviceDesc, srv)
+}
+
+func _Stats_Aggregate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatsServer).Aggregate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.services.stats.v1.Stats/Aggregate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatsServer).Aggregate(ctx, req.(*AggregateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Stats_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "containerd.services.stats.v1.Stats",
+	HandlerType: (*StatsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Aggregate",
+			Handler:    _Stats_Aggregate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/containerd/containerd/api/services/stats/v1/stats.proto",
+}
+
+func (m *AggregateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Filters) > 0 {
+		for _, s := range m.Filters {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *Usage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Usage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.CPUUsageTotal != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintStats(dAtA, i, uint64(m.CPUUsageTotal))
+	}
+	if m.CPUUsageKernel != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintStats(dAtA, i, uint64(m.CPUUsageKernel))
+	}
+	if m.CPUUsageUser != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintStats(dAtA, i, uint64(m.CPUUsageUser))
+	}
+	if m.CPUThrottledPeriods != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintStats(dAtA, i, uint64(m.CPUThrottledPeriods))
+	}
+	if m.CPUThrottledTime != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintStats(dAtA, i, uint64(m.CPUThrottledTime))
+	}
+	if m.MemoryUsage != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintStats(dAtA, i, uint64(m.MemoryUsage))
+	}
+	if m.MemoryRSS != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintStats(dAtA, i, uint64(m.MemoryRSS))
+	}
+	if m.MemoryCache != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintStats(dAtA, i, uint64(m.MemoryCache))
+	}
+	if m.MemorySwap != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintStats(dAtA, i, uint64(m.MemorySwap))
+	}
+	if m.Pids != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintStats(dAtA, i, uint64(m.Pids))
+	}
+	if m.BlkioReadBytes != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintStats(dAtA, i, uint64(m.BlkioReadBytes))
+	}
+	if m.BlkioWriteBytes != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintStats(dAtA, i, uint64(m.BlkioWriteBytes))
+	}
+	return i, nil
+}
+
+func (m *AggregateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintStats(dAtA, i, uint64(m.Usage.Size()))
+	n1, err := m.Usage.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	if len(m.Containers) > 0 {
+		for _, s := range m.Containers {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintStats(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.OldestSample)))
+	n2, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.OldestSample, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	return i, nil
+}
+
+func encodeFixed64Stats(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Stats(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintStats(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *AggregateRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Filters) > 0 {
+		for _, s := range m.Filters {
+			l = len(s)
+			n += 1 + l + sovStats(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Usage) Size() (n int) {
+	var l int
+	_ = l
+	if m.CPUUsageTotal != 0 {
+		n += 1 + sovStats(uint64(m.CPUUsageTotal))
+	}
+	if m.CPUUsageKernel != 0 {
+		n += 1 + sovStats(uint64(m.CPUUsageKernel))
+	}
+	if m.CPUUsageUser != 0 {
+		n += 1 + sovStats(uint64(m.CPUUsageUser))
+	}
+	if m.CPUThrottledPeriods != 0 {
+		n += 1 + sovStats(uint64(m.CPUThrottledPeriods))
+	}
+	if m.CPUThrottledTime != 0 {
+		n += 1 + sovStats(uint64(m.CPUThrottledTime))
+	}
+	if m.MemoryUsage != 0 {
+		n += 1 + sovStats(uint64(m.MemoryUsage))
+	}
+	if m.MemoryRSS != 0 {
+		n += 1 + sovStats(uint64(m.MemoryRSS))
+	}
+	if m.MemoryCache != 0 {
+		n += 1 + sovStats(uint64(m.MemoryCache))
+	}
+	if m.MemorySwap != 0 {
+		n += 1 + sovStats(uint64(m.MemorySwap))
+	}
+	if m.Pids != 0 {
+		n += 1 + sovStats(uint64(m.Pids))
+	}
+	if m.BlkioReadBytes != 0 {
+		n += 1 + sovStats(uint64(m.BlkioReadBytes))
+	}
+	if m.BlkioWriteBytes != 0 {
+		n += 1 + sovStats(uint64(m.BlkioWriteBytes))
+	}
+	return n
+}
+
+func (m *AggregateResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovStats(uint64(l))
+	if len(m.Containers) > 0 {
+		for _, s := range m.Containers {
+			l = len(s)
+			n += 1 + l + sovStats(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.OldestSample)
+	n += 1 + l + sovStats(uint64(l))
+	return n
+}
+
+func sovStats(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozStats(x uint64) (n int) {
+	return sovStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AggregateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AggregateRequest{`,
+		`Filters:` + fmt.Sprintf("%v", this.Filters) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Usage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Usage{`,
+		`CPUUsageTotal:` + fmt.Sprintf("%v", this.CPUUsageTotal) + `,`,
+		`CPUUsageKernel:` + fmt.Sprintf("%v", this.CPUUsageKernel) + `,`,
+		`CPUUsageUser:` + fmt.Sprintf("%v", this.CPUUsageUser) + `,`,
+		`CPUThrottledPeriods:` + fmt.Sprintf("%v", this.CPUThrottledPeriods) + `,`,
+		`CPUThrottledTime:` + fmt.Sprintf("%v", this.CPUThrottledTime) + `,`,
+		`MemoryUsage:` + fmt.Sprintf("%v", this.MemoryUsage) + `,`,
+		`MemoryRSS:` + fmt.Sprintf("%v", this.MemoryRSS) + `,`,
+		`MemoryCache:` + fmt.Sprintf("%v", this.MemoryCache) + `,`,
+		`MemorySwap:` + fmt.Sprintf("%v", this.MemorySwap) + `,`,
+		`Pids:` + fmt.Sprintf("%v", this.Pids) + `,`,
+		`BlkioReadBytes:` + fmt.Sprintf("%v", this.BlkioReadBytes) + `,`,
+		`BlkioWriteBytes:` + fmt.Sprintf("%v", this.BlkioWriteBytes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AggregateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AggregateResponse{`,
+		`Usage:` + strings.Replace(strings.Replace(this.Usage.String(), "Usage", "Usage", 1), `&`, ``, 1) + `,`,
+		`Containers:` + fmt.Sprintf("%v", this.Containers) + `,`,
+		`OldestSample:` + strings.Replace(strings.Replace(this.OldestSample.String(), "Timestamp", "google_protobuf1.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringStats(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AggregateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Usage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Usage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Usage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUUsageTotal", wireType)
+			}
+			m.CPUUsageTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CPUUsageTotal |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUUsageKernel", wireType)
+			}
+			m.CPUUsageKernel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CPUUsageKernel |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUUsageUser", wireType)
+			}
+			m.CPUUsageUser = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CPUUsageUser |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUThrottledPeriods", wireType)
+			}
+			m.CPUThrottledPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CPUThrottledPeriods |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUThrottledTime", wireType)
+			}
+			m.CPUThrottledTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CPUThrottledTime |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryUsage", wireType)
+			}
+			m.MemoryUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryUsage |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryRSS", wireType)
+			}
+			m.MemoryRSS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryRSS |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryCache", wireType)
+			}
+			m.MemoryCache = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryCache |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemorySwap", wireType)
+			}
+			m.MemorySwap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemorySwap |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pids", wireType)
+			}
+			m.Pids = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pids |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlkioReadBytes", wireType)
+			}
+			m.BlkioReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlkioReadBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlkioWriteBytes", wireType)
+			}
+			m.BlkioWriteBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlkioWriteBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Containers = append(m.Containers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestSample", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.OldestSample, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthStats
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowStats
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipStats(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthStats = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStats   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() {
+	proto.RegisterFile("github.com/containerd/containerd/api/services/stats/v1/stats.proto", fileDescriptorStats)
+}
+
+var fileDescriptorStats = []byte{
+	// 627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x4f, 0xdb, 0x4c,
+	0x10, 0xc6, 0x31, 0x24, 0x40, 0x36, 0x7f, 0x08, 0x0b, 0xaf, 0xde, 0x55, 0x54, 0xc5, 0x94, 0x5e,
+	0x50, 0x85, 0x6c, 0x41, 0xa5, 0x4a, 0x55, 0x91, 0xaa, 0x86, 0x53, 0x85, 0x2a, 0xa1, 0x4d, 0xd2,
+	0x4a, 0xbd, 0x44, 0x8e, 0x3d, 0x18, 0x0b, 0x3b, 0xeb, 0xee, 0xae, 0x41, 0xdc, 0xfa, 0x11, 0xfa,
+	0x8d, 0x7a, 0xcd, 0xb1, 0xc7, 0x9e, 0xdc, 0xe2, 0x4f, 0x52, 0xed, 0x6e, 0x4c, 0x5c, 0x0e, 0xa8,
+	0xea, 0x6d, 0xe6, 0x99, 0xe7, 0x37, 0xf1, 0x8e, 0x66, 0x82, 0x06, 0x61, 0x24, 0x2f, 0xb3, 0xa9,
+	0xe3, 0xb3, 0xc4, 0xf5, 0xd9, 0x4c, 0x7a, 0xd1, 0x0c, 0x78, 0x50, 0x0d, 0xbd, 0x34, 0x72, 0x05,
+	0xf0, 0xeb, 0xc8, 0x07, 0xe1, 0x0a, 0xe9, 0x49, 0xe1, 0x5e, 0x1f, 0x99, 0xc0, 0x49, 0x39, 0x93,
+	0x0c, 0x3f, 0x59, 0xba, 0x9d, 0xd2, 0xe9, 0x18, 0xc3, 0xf5, 0x51, 0x6f, 0x37, 0x64, 0x21, 0xd3,
+	0x46, 0x57, 0x45, 0x86, 0xe9, 0xd9, 0x21, 0x63, 0x61, 0x0c, 0xae, 0xce, 0xa6, 0xd9, 0x85, 0x2b,
+	0xa3, 0x04, 0x84, 0xf4, 0x92, 0xd4, 0x18, 0xf6, 0x0f, 0x51, 0xf7, 0x6d, 0x18, 0x72, 0x08, 0x3d,
+	0x09, 0x14, 0x3e, 0x67, 0x20, 0x24, 0x26, 0x68, 0xe3, 0x22, 0x8a, 0x25, 0x70, 0x41, 0xac, 0xbd,
+	0xb5, 0x83, 0x06, 0x2d, 0xd3, 0xfd, 0x79, 0x0d, 0xd5, 0xc7, 0xc2, 0x0b, 0x01, 0xbf, 0x42, 0x5b,
+	0x7e, 0x9a, 0x4d, 0x32, 0x95, 0x4c, 0x24, 0x93, 0x5e, 0x4c, 0xac, 0x3d, 0xeb, 0xa0, 0x36, 0xd8,
+	0x2e, 0x72, 0xbb, 0x7d, 0x7a, 0x3e, 0xd6, 0xb6, 0x91, 0x2a, 0xd0, 0xb6, 0x9f, 0x66, 0xcb, 0x14,
+	0x9f, 0xa0, 0xee, 0x12, 0xbd, 0x02, 0x3e, 0x83, 0x98, 0xac, 0x6a, 0x16, 0x17, 0xb9, 0xdd, 0x29,
+	0xd9, 0x33, 0x5d, 0xa1, 0x9d, 0x12, 0x36, 0x39, 0x7e, 0x89, 0x3a, 0x4b, 0x3a, 0x13, 0xc0, 0xc9,
+	0x9a, 0x66, 0xbb, 0x45, 0x6e, 0xb7, 0x4a, 0x76, 0x2c, 0x80, 0xd3, 0x56, 0x49, 0xaa, 0x0c, 0x9f,
+	0xa1, 0xff, 0x14, 0x27, 0x2f, 0x39, 0x93, 0x32, 0x86, 0x60, 0x92, 0x02, 0x8f, 0x58, 0x20, 0x48,
+	0x4d, 0xe3, 0xff, 0x17, 0xb9, 0xbd, 0x73, 0x7a, 0x3e, 0x1e, 0x95, 0xf5, 0x73, 0x53, 0xa6, 0x3b,
+	0x7e, 0x9a, 0x3d, 0x14, 0xf1, 0x00, 0xe1, 0x3f, 0x9b, 0xa9, 0xb1, 0x92, 0xba, 0xee, 0xb4, 0x5b,
+	0xe4, 0x76, 0xb7, 0xda, 0x69, 0x14, 0x25, 0x40, 0xbb, 0xd5, 0x36, 0x4a, 0xc1, 0x4f, 0x51, 0x2b,
+	0x81, 0x84, 0xf1, 0x5b, 0xf3, 0x16, 0xb2, 0xae, 0x68, 0xda, 0x34, 0x9a, 0x19, 0xf2, 0x21, 0x42,
+	0x0b, 0x0b, 0x17, 0x82, 0x6c, 0xe8, 0xf6, 0xed, 0x22, 0xb7, 0x1b, 0xef, 0xb5, 0x4a, 0x87, 0x43,
+	0xda, 0x30, 0x06, 0x2a, 0x44, 0xa5, 0xa1, 0xef, 0xf9, 0x97, 0x40, 0x36, 0xab, 0x0d, 0x4f, 0x95,
+	0x84, 0x6d, 0xb4, 0x48, 0x27, 0xe2, 0xc6, 0x4b, 0x49, 0x43, 0x3b, 0x16, 0xbf, 0x31, 0xbc, 0xf1,
+	0x52, 0x8c, 0x51, 0x2d, 0x8d, 0x02, 0x41, 0x90, 0xae, 0xe8, 0x18, 0x1f, 0xa0, 0xee, 0x34, 0xbe,
+	0x8a, 0xd8, 0x84, 0x83, 0x17, 0x4c, 0xa6, 0xb7, 0x12, 0x04, 0x69, 0xea, 0x7a, 0x47, 0xeb, 0x14,
+	0xbc, 0x60, 0xa0, 0x54, 0xfc, 0x1c, 0x6d, 0x1b, 0xe7, 0x0d, 0x8f, 0x24, 0x2c, 0xac, 0x2d, 0x6d,
+	0xdd, 0xd2, 0x85, 0x8f, 0x4a, 0xd7, 0xde, 0xfd, 0x6f, 0x16, 0xda, 0xae, 0x6c, 0x9e, 0x48, 0xd9,
+	0x4c, 0x00, 0x7e, 0x83, 0xea, 0x66, 0x1a, 0x6a, 0x99, 0x9a, 0xc7, 0xcf, 0x9c, 0xc7, 0x76, 0xde,
+	0xd1, 0x53, 0x1a, 0xd4, 0xe6, 0xb9, 0xbd, 0x42, 0x0d, 0x87, 0xfb, 0x08, 0xdd, 0x23, 0x82, 0xac,
+	0xea, 0xf5, 0xad, 0x28, 0xf8, 0x1d, 0x6a, 0xb3, 0x38, 0x00, 0x21, 0x27, 0xc2, 0x4b, 0xd2, 0x18,
+	0xf4, 0xf6, 0x34, 0x8f, 0x7b, 0x8e, 0x39, 0x14, 0xa7, 0x3c, 0x14, 0x67, 0x54, 0x1e, 0xca, 0x60,
+	0x53, 0xf5, 0xff, 0xfa, 0xd3, 0xb6, 0x68, 0xcb, 0xa0, 0x43, 0x4d, 0x1e, 0x67, 0xa8, 0x3e, 0x54,
+	0x5f, 0x82, 0x63, 0xd4, 0xb8, 0x7f, 0x09, 0x76, 0x1e, 0xff, 0xe4, 0x87, 0xc7, 0xd6, 0x73, 0xff,
+	0xda, 0x6f, 0x46, 0x34, 0xf8, 0x30, 0xbf, 0xeb, 0xaf, 0xfc, 0xb8, 0xeb, 0xaf, 0x7c, 0x29, 0xfa,
+	0xd6, 0xbc, 0xe8, 0x5b, 0xdf, 0x8b, 0xbe, 0xf5, 0xab, 0xe8, 0x5b, 0x9f, 0x4e, 0xfe, 0xed, 0x4f,
+	0xe6, 0xb5, 0x0e, 0xa6, 0xeb, 0xfa, 0xe9, 0x2f, 0x7e, 0x0f, 0x00, 0xf9, 0x9a, 0x7a, 0x95, 0xab,
+	0x04, 0x00, 0x00,
+}
@@ -0,0 +1,2154 @@
+// Code generated by protoc-gen-gogo.
+// source: github.com/containerd/containerd/api/services/stdio/v1/stdio.proto
+// DO NOT EDIT!
+
+/*
+	Package stdio is a generated protocol buffer package.
+
+	It is generated from these files:
+		github.com/containerd/containerd/api/services/stdio/v1/stdio.proto
+
+	It has these top-level messages:
+		CreateStdioRequest
+		CreateStdioResponse
+		AttachRequest
+		AttachResponse
+		DeleteStdioRequest
+		LogsRequest
+		LogsResponse
+*/
+package stdio
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import google_protobuf1 "github.com/golang/protobuf/ptypes/empty"
+import _ "github.com/gogo/protobuf/types"
+
+import time "time"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+
+import strings "strings"
+import reflect "reflect"
+import github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type Stream int32
+
+const (
+	StreamStdout Stream = 0
+	StreamStderr Stream = 1
+)
+
+var Stream_name = map[int32]string{
+	0: "STDOUT",
+	1: "STDERR",
+}
+var Stream_value = map[string]int32{
+	"STDOUT": 0,
+	"STDERR": 1,
+}
+
+func (x Stream) String() string {
+	return proto.EnumName(Stream_name, int32(x))
+}
+func (Stream) EnumDescriptor() ([]byte, []int) { return fileDescriptorStdio, []int{0} }
+
+type CreateStdioRequest struct {
+	// ID names the set of fifos, usually the id of the container or of the
+	// exec process.
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Stdin creates a fifo for the stdin of the process.
+	Stdin bool `protobuf:"varint,2,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	// Terminal is set when the process has a terminal, its output is all
+	// written to stdout.
+	Terminal bool `protobuf:"varint,3,opt,name=terminal,proto3" json:"terminal,omitempty"`
+	// LogDriver logs the output of the process to a driver, such as
+	// json-file, journald, syslog or binary, whether or not a client is
+	// attached.
+	LogDriver string `protobuf:"bytes,4,opt,name=log_driver,json=logDriver,proto3" json:"log_driver,omitempty"`
+	// LogOptions configure the log driver.
+	LogOptions map[string]string `protobuf:"bytes,5,rep,name=log_options,json=logOptions" json:"log_options,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// MaxLogLine is the maximum length of a logged line, longer lines are
+	// logged as partial messages.
+	MaxLogLine uint32 `protobuf:"varint,6,opt,name=max_log_line,json=maxLogLine,proto3" json:"max_log_line,omitempty"`
+}
+
+func (m *CreateStdioRequest) Reset()                    { *m = CreateStdioRequest{} }
+func (*CreateStdioRequest) ProtoMessage()               {}
+func (*CreateStdioRequest) Descriptor() ([]byte, []int) { return fileDescriptorStdio, []int{0} }
+
+type CreateStdioResponse struct {
+	Stdin  string `protobuf:"bytes,1,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	Stdout string `protobuf:"bytes,2,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr string `protobuf:"bytes,3,opt,name=stderr,proto3" json:"stderr,omitempty"`
+}
+
+func (m *CreateStdioResponse) Reset()                    { *m = CreateStdioResponse{} }
+func (*CreateStdioResponse) ProtoMessage()               {}
+func (*CreateStdioResponse) Descriptor() ([]byte, []int) { return fileDescriptorStdio, []int{1} }
+
+type AttachRequest struct {
+	ID    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Stdin []byte `protobuf:"bytes,2,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	// CloseStdin closes the stdin of the process once the data of the
+	// request is written.
+	CloseStdin bool `protobuf:"varint,3,opt,name=close_stdin,json=closeStdin,proto3" json:"close_stdin,omitempty"`
+}
+
+func (m *AttachRequest) Reset()                    { *m = AttachRequest{} }
+func (*AttachRequest) ProtoMessage()               {}
+func (*AttachRequest) Descriptor() ([]byte, []int) { return fileDescriptorStdio, []int{2} }
+
+type AttachResponse struct {
+	Stream Stream `protobuf:"varint,1,opt,name=stream,proto3,enum=containerd.services.stdio.v1.Stream" json:"stream,omitempty"`
+	Data   []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *AttachResponse) Reset()                    { *m = AttachResponse{} }
+func (*AttachResponse) ProtoMessage()               {}
+func (*AttachResponse) Descriptor() ([]byte, []int) { return fileDescriptorStdio, []int{3} }
+
+type DeleteStdioRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *DeleteStdioRequest) Reset()                    { *m = DeleteStdioRequest{} }
+func (*DeleteStdioRequest) ProtoMessage()               {}
+func (*DeleteStdioRequest) Descriptor() ([]byte, []int) { return fileDescriptorStdio, []int{4} }
+
+type LogsRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Follow keeps streaming the output logged after the request until the
+	// output of the process ends.
+	Follow bool `protobuf:"varint,2,opt,name=follow,proto3" json:"follow,omitempty"`
+	// Tail limits the output to its last lines, all lines are returned when
+	// it is zero.
+	Tail int64 `protobuf:"varint,3,opt,name=tail,proto3" json:"tail,omitempty"`
+	// Since limits the output to the lines logged at or after the time.
+	Since *time.Time `protobuf:"bytes,4,opt,name=since,stdtime" json:"since,omitempty"`
+}
+
+func (m *LogsRequest) Reset()                    { *m = LogsRequest{} }
+func (*LogsRequest) ProtoMessage()               {}
+func (*LogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorStdio, []int{5} }
+
+type LogsResponse struct {
+	Stream Stream `protobuf:"varint,1,opt,name=stream,proto3,enum=containerd.services.stdio.v1.Stream" json:"stream,omitempty"`
+	// Data is a line of output with its newline, without it when the line
+	// continues in the next response.
+	Data      []byte    `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp time.Time `protobuf:"bytes,3,opt,name=timestamp,stdtime" json:"timestamp"`
+}
+
+func (m *LogsResponse) Reset()                    { *m = LogsResponse{} }
+func (*LogsResponse) ProtoMessage()               {}
+func (*LogsResponse) Descriptor() ([]byte, []int) { return fileDescriptorStdio, []int{6} }
+
+func init() {
+	proto.RegisterType((*CreateStdioRequest)(nil), "containerd.services.stdio.v1.CreateStdioRequest")
+	proto.RegisterType((*CreateStdioResponse)(nil), "containerd.services.stdio.v1.CreateStdioResponse")
+	proto.RegisterType((*AttachRequest)(nil), "containerd.services.stdio.v1.AttachRequest")
+	proto.RegisterType((*AttachResponse)(nil), "containerd.services.stdio.v1.AttachResponse")
+	proto.RegisterType((*DeleteStdioRequest)(nil), "containerd.services.stdio.v1.DeleteStdioRequest")
+	proto.RegisterType((*LogsRequest)(nil), "containerd.services.stdio.v1.LogsRequest")
+	proto.RegisterType((*LogsResponse)(nil), "containerd.services.stdio.v1.LogsResponse")
+	proto.RegisterEnum("containerd.services.stdio.v1.Stream", Stream_name, Stream_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Stdio service
+
+type StdioClient interface {
+	// Create creates fifos for the stdio of a process in the daemon. Their
+	// paths are passed to the create of the task or the exec of the process.
+	Create(ctx context.Context, in *CreateStdioRequest, opts ...grpc.CallOption) (*CreateStdioResponse, error)
+	// Attach streams the output of the process of a set of fifos to the
+	// client and writes the stdin sent by the client to the process. The
+	// first request names the set. Output produced while no client is
+	// attached is discarded, so clients attach before starting the process.
+	Attach(ctx context.Context, opts ...grpc.CallOption) (Stdio_AttachClient, error)
+	// Delete closes and removes the fifos of a set. The fifos of a container
+	// are also removed when the container is deleted.
+	Delete(ctx context.Context, in *DeleteStdioRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// Logs streams back the output of the process logged by the log driver
+	// of a set, for drivers whose logs can be read back such as json-file.
+	// The logs are kept after the set is deleted, until the container is
+	// deleted.
+	Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (Stdio_LogsClient, error)
+}
+
+type stdioClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewStdioClient(cc *grpc.ClientConn) StdioClient {
+	return &stdioClient{cc}
+}
+
+func (c *stdioClient) Create(ctx context.Context, in *CreateStdioRequest, opts ...grpc.CallOption) (*CreateStdioResponse, error) {
+	out := new(CreateStdioResponse)
+	err := grpc.Invoke(ctx, "/containerd.services.stdio.v1.Stdio/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stdioClient) Attach(ctx context.Context, opts ...grpc.CallOption) (Stdio_AttachClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Stdio_serviceDesc.Streams[0], c.cc, "/containerd.services.stdio.v1.Stdio/Attach", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stdioAttachClient{stream}
+	return x, nil
+}
+
+type Stdio_AttachClient interface {
+	Send(*AttachRequest) error
+	Recv() (*AttachResponse, error)
+	grpc.ClientStream
+}
+
+type stdioAttachClient struct {
+	grpc.ClientStream
+}
+
+func (x *stdioAttachClient) Send(m *AttachRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *stdioAttachClient) Recv() (*AttachResponse, error) {
+	m := new(AttachResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *stdioClient) Delete(ctx context.Context, in *DeleteStdioRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/containerd.services.stdio.v1.Stdio/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stdioClient) Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (Stdio_LogsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Stdio_serviceDesc.Streams[1], c.cc, "/containerd.services.stdio.v1.Stdio/Logs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stdioLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Stdio_LogsClient interface {
+	Recv() (*LogsResponse, error)
+	grpc.ClientStream
+}
+
+type stdioLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *stdioLogsClient) Recv() (*LogsResponse, error) {
+	m := new(LogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for Stdio service
+
+type StdioServer interface {
+	// Create creates fifos for the stdio of a process in the daemon. Their
+	// paths are passed to the create of the task or the exec of the process.
+	Create(context.Context, *CreateStdioRequest) (*CreateStdioResponse, error)
+	// Attach streams the output of the process of a set of fifos to the
+	// client and writes the stdin sent by the client to the process. The
+	// first request names the set. Output produced while no client is
+	// attached is discarded, so clients attach before starting the process.
+	Attach(Stdio_AttachServer) error
+	// Delete closes and removes the fifos of a set. The fifos of a container
+	// are also removed when the container is deleted.
+	Delete(context.Context, *DeleteStdioRequest) (*google_protobuf1.Empty, error)
+	// Logs streams back the output of the process logged by the log driver
+	// of a set, for drivers whose logs can be read back such as json-file.
+	// The logs are kept after the set is deleted, until the container is
+	// deleted.
+	Logs(*LogsRequest, Stdio_LogsServer) error
+}
+
+func RegisterStdioServer(s *grpc.Server, srv StdioServer) {
+	s.RegisterService(&_Stdio_serviceDesc, srv)
+}
+
+func _Stdio_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStdioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StdioServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.services.stdio.v1.Stdio/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StdioServer).Create(ctx, req.(*CreateStdioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stdio_Attach_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StdioServer).Attach(&stdioAttachServer{stream})
+}
+
+type Stdio_AttachServer interface {
+	Send(*AttachResponse) error
+	Recv() (*AttachRequest, error)
+	grpc.ServerStream
+}
+
+type stdioAttachServer struct {
+	grpc.ServerStream
+}
+
+func (x *stdioAttachServer) Send(m *AttachResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *stdioAttachServer) Recv() (*AttachRequest, error) {
+	m := new(AttachRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Stdio_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStdioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StdioServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.services.stdio.v1.Stdio/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StdioServer).Delete(ctx, req.(*DeleteStdioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stdio_Logs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StdioServer).Logs(m, &stdioLogsServer{stream})
+}
+
+type Stdio_LogsServer interface {
+	Send(*LogsResponse) error
+	grpc.ServerStream
+}
+
+type stdioLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *stdioLogsServer) Send(m *LogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Stdio_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "containerd.services.stdio.v1.Stdio",
+	HandlerType: (*StdioServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Stdio_Create_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Stdio_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Attach",
+			Handler:       _Stdio_Attach_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Logs",
+			Handler:       _Stdio_Logs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "github.com/containerd/containerd/api/services/stdio/v1/stdio.proto",
+}
+
+func (m *CreateStdioRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateStdioRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintStdio(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Stdin {
+		dAtA[i] = 0x10
+		i++
+		if m.Stdin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Terminal {
+		dAtA[i] = 0x18
+		i++
+		if m.Terminal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.LogDriver) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintStdio(dAtA, i, uint64(len(m.LogDriver)))
+		i += copy(dAtA[i:], m.LogDriver)
+	}
+	if len(m.LogOptions) > 0 {
+		for k, _ := range m.LogOptions {
+			dAtA[i] = 0x2a
+			i++
+			v := m.LogOptions[k]
+			mapSize := 1 + len(k) + sovStdio(uint64(len(k))) + 1 + len(v) + sovStdio(uint64(len(v)))
+			i = encodeVarintStdio(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintStdio(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintStdio(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.MaxLogLine != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintStdio(dAtA, i, uint64(m.MaxLogLine))
+	}
+	return i, nil
+}
+
+func (m *CreateStdioResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateStdioResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stdin) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintStdio(dAtA, i, uint64(len(m.Stdin)))
+		i += copy(dAtA[i:], m.Stdin)
+	}
+	if len(m.Stdout) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintStdio(dAtA, i, uint64(len(m.Stdout)))
+		i += copy(dAtA[i:], m.Stdout)
+	}
+	if len(m.Stderr) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintStdio(dAtA, i, uint64(len(m.Stderr)))
+		i += copy(dAtA[i:], m.Stderr)
+	}
+	return i, nil
+}
+
+func (m *AttachRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttachRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintStdio(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Stdin) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintStdio(dAtA, i, uint64(len(m.Stdin)))
+		i += copy(dAtA[i:], m.Stdin)
+	}
+	if m.CloseStdin {
+		dAtA[i] = 0x18
+		i++
+		if m.CloseStdin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *AttachResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttachResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Stream != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintStdio(dAtA, i, uint64(m.Stream))
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintStdio(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *DeleteStdioRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteStdioRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintStdio(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	return i, nil
+}
+
+func (m *LogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintStdio(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Follow {
+		dAtA[i] = 0x10
+		i++
+		if m.Follow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Tail != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintStdio(dAtA, i, uint64(m.Tail))
+	}
+	if m.Since != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintStdio(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.Since)))
+		n1, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Since, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	return i, nil
+}
+
+func (m *LogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Stream != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintStdio(dAtA, i, uint64(m.Stream))
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintStdio(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintStdio(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)))
+	n2, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	return i, nil
+}
+
+func encodeFixed64Stdio(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Stdio(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintStdio(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *CreateStdioRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovStdio(uint64(l))
+	}
+	if m.Stdin {
+		n += 2
+	}
+	if m.Terminal {
+		n += 2
+	}
+	l = len(m.LogDriver)
+	if l > 0 {
+		n += 1 + l + sovStdio(uint64(l))
+	}
+	if len(m.LogOptions) > 0 {
+		for k, v := range m.LogOptions {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovStdio(uint64(len(k))) + 1 + len(v) + sovStdio(uint64(len(v)))
+			n += mapEntrySize + 1 + sovStdio(uint64(mapEntrySize))
+		}
+	}
+	if m.MaxLogLine != 0 {
+		n += 1 + sovStdio(uint64(m.MaxLogLine))
+	}
+	return n
+}
+
+func (m *CreateStdioResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Stdin)
+	if l > 0 {
+		n += 1 + l + sovStdio(uint64(l))
+	}
+	l = len(m.Stdout)
+	if l > 0 {
+		n += 1 + l + sovStdio(uint64(l))
+	}
+	l = len(m.Stderr)
+	if l > 0 {
+		n += 1 + l + sovStdio(uint64(l))
+	}
+	return n
+}
+
+func (m *AttachRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovStdio(uint64(l))
+	}
+	l = len(m.Stdin)
+	if l > 0 {
+		n += 1 + l + sovStdio(uint64(l))
+	}
+	if m.CloseStdin {
+		n += 2
+	}
+	return n
+}
+
+func (m *AttachResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Stream != 0 {
+		n += 1 + sovStdio(uint64(m.Stream))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovStdio(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteStdioRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovStdio(uint64(l))
+	}
+	return n
+}
+
+func (m *LogsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovStdio(uint64(l))
+	}
+	if m.Follow {
+		n += 2
+	}
+	if m.Tail != 0 {
+		n += 1 + sovStdio(uint64(m.Tail))
+	}
+	if m.Since != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Since)
+		n += 1 + l + sovStdio(uint64(l))
+	}
+	return n
+}
+
+func (m *LogsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Stream != 0 {
+		n += 1 + sovStdio(uint64(m.Stream))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovStdio(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovStdio(uint64(l))
+	return n
+}
+
+func sovStdio(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozStdio(x uint64) (n int) {
+	return sovStdio(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *CreateStdioRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLogOptions := make([]string, 0, len(this.LogOptions))
+	for k, _ := range this.LogOptions {
+		keysForLogOptions = append(keysForLogOptions, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLogOptions)
+	mapStringForLogOptions := "map[string]string{"
+	for _, k := range keysForLogOptions {
+		mapStringForLogOptions += fmt.Sprintf("%v: %v,", k, this.LogOptions[k])
+	}
+	mapStringForLogOptions += "}"
+	s := strings.Join([]string{`&CreateStdioRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Stdin:` + fmt.Sprintf("%v", this.Stdin) + `,`,
+		`Terminal:` + fmt.Sprintf("%v", this.Terminal) + `,`,
+		`LogDriver:` + fmt.Sprintf("%v", this.LogDriver) + `,`,
+		`LogOptions:` + mapStringForLogOptions + `,`,
+		`MaxLogLine:` + fmt.Sprintf("%v", this.MaxLogLine) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateStdioResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateStdioResponse{`,
+		`Stdin:` + fmt.Sprintf("%v", this.Stdin) + `,`,
+		`Stdout:` + fmt.Sprintf("%v", this.Stdout) + `,`,
+		`Stderr:` + fmt.Sprintf("%v", this.Stderr) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AttachRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AttachRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Stdin:` + fmt.Sprintf("%v", this.Stdin) + `,`,
+		`CloseStdin:` + fmt.Sprintf("%v", this.CloseStdin) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AttachResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AttachResponse{`,
+		`Stream:` + fmt.Sprintf("%v", this.Stream) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteStdioRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteStdioRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LogsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LogsRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Follow:` + fmt.Sprintf("%v", this.Follow) + `,`,
+		`Tail:` + fmt.Sprintf("%v", this.Tail) + `,`,
+		`Since:` + strings.Replace(fmt.Sprintf("%v", this.Since), "Timestamp", "google_protobuf2.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LogsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LogsResponse{`,
+		`Stream:` + fmt.Sprintf("%v", this.Stream) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Timestamp:` + strings.Replace(strings.Replace(this.Timestamp.String(), "Timestamp", "google_protobuf2.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringStdio(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *CreateStdioRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStdio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateStdioRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateStdioRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStdio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stdin = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Terminal = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogDriver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStdio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogDriver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStdio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthStdio
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.LogOptions == nil {
+				m.LogOptions = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStdio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStdio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthStdio
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.LogOptions[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.LogOptions[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLogLine", wireType)
+			}
+			m.MaxLogLine = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLogLine |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStdio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStdio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateStdioResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStdio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateStdioResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateStdioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStdio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStdio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStdio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stderr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStdio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStdio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStdio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttachRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttachRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStdio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStdio
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdin = append(m.Stdin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Stdin == nil {
+				m.Stdin = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseStdin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CloseStdin = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStdio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStdio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStdio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttachResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttachResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			m.Stream = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stream |= (Stream(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStdio
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStdio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStdio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteStdioRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStdio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteStdioRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteStdioRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStdio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStdio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStdio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStdio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStdio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Follow = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
+			}
+			m.Tail = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tail |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStdio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Since == nil {
+				m.Since = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Since, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStdio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStdio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStdio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			m.Stream = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stream |= (Stream(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStdio
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStdio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStdio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStdio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStdio(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStdio
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStdio
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthStdio
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowStdio
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipStdio(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthStdio = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStdio   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() {
+	proto.RegisterFile("github.com/containerd/containerd/api/services/stdio/v1/stdio.proto", fileDescriptorStdio)
+}
+
+var fileDescriptorStdio = []byte{
+	// 719 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6f, 0xd3, 0x4a,
+	0x10, 0xcf, 0xe6, 0xc3, 0x6a, 0x26, 0x69, 0x5f, 0xb4, 0xaf, 0x8a, 0x2c, 0xbf, 0x3e, 0x27, 0x8a,
+	0x38, 0x84, 0x52, 0x39, 0x6d, 0x90, 0x10, 0x82, 0x22, 0x41, 0x48, 0x0f, 0x48, 0x91, 0x2a, 0x39,
+	0x81, 0x03, 0x48, 0x44, 0x4e, 0xb2, 0x75, 0x57, 0xd8, 0xde, 0xb0, 0xde, 0x84, 0xf6, 0xc6, 0x11,
+	0x38, 0x71, 0xe0, 0xca, 0x89, 0xfe, 0x15, 0xdc, 0xb8, 0xf5, 0xc8, 0x91, 0x53, 0xa1, 0xf9, 0x4b,
+	0x90, 0x77, 0x9d, 0xf4, 0x4b, 0x4a, 0x5b, 0x24, 0x6e, 0xf3, 0xb5, 0x33, 0xbf, 0x99, 0xf9, 0x8d,
+	0x0d, 0x0d, 0x97, 0x8a, 0xdd, 0x51, 0xcf, 0xea, 0x33, 0xbf, 0xd6, 0x67, 0x81, 0x70, 0x68, 0x40,
+	0xf8, 0xe0, 0xb4, 0xe8, 0x0c, 0x69, 0x2d, 0x24, 0x7c, 0x4c, 0xfb, 0x24, 0xac, 0x85, 0x62, 0x40,
+	0x59, 0x6d, 0xbc, 0xa1, 0x04, 0x6b, 0xc8, 0x99, 0x60, 0x78, 0xe5, 0x24, 0xda, 0x9a, 0x46, 0x5a,
+	0x2a, 0x60, 0xbc, 0x61, 0x2c, 0xbb, 0xcc, 0x65, 0x32, 0xb0, 0x16, 0x49, 0xea, 0x8d, 0xf1, 0x9f,
+	0xcb, 0x98, 0xeb, 0x91, 0x9a, 0xd4, 0x7a, 0xa3, 0x9d, 0x1a, 0xf1, 0x87, 0x62, 0x3f, 0x76, 0x96,
+	0xce, 0x3b, 0x05, 0xf5, 0x49, 0x28, 0x1c, 0x7f, 0xa8, 0x02, 0x2a, 0xdf, 0x92, 0x80, 0x1f, 0x73,
+	0xe2, 0x08, 0xd2, 0x8e, 0xca, 0xd8, 0xe4, 0xf5, 0x88, 0x84, 0x02, 0x17, 0x21, 0x49, 0x07, 0x3a,
+	0x2a, 0xa3, 0x6a, 0xb6, 0xa1, 0x4d, 0x8e, 0x4a, 0xc9, 0x27, 0x4d, 0x3b, 0x49, 0x07, 0x78, 0x19,
+	0x32, 0x11, 0x9c, 0x40, 0x4f, 0x96, 0x51, 0x75, 0xc1, 0x56, 0x0a, 0x36, 0x60, 0x41, 0x10, 0xee,
+	0xd3, 0xc0, 0xf1, 0xf4, 0x94, 0x74, 0xcc, 0x74, 0xfc, 0x3f, 0x80, 0xc7, 0xdc, 0xee, 0x80, 0xd3,
+	0x31, 0xe1, 0x7a, 0x3a, 0xca, 0x68, 0x67, 0x3d, 0xe6, 0x36, 0xa5, 0x01, 0x3b, 0x90, 0x8b, 0xdc,
+	0x6c, 0x28, 0x28, 0x0b, 0x42, 0x3d, 0x53, 0x4e, 0x55, 0x73, 0xf5, 0x87, 0xd6, 0xbc, 0x39, 0x58,
+	0x17, 0xf1, 0x5a, 0x2d, 0xe6, 0x6e, 0xab, 0x14, 0x5b, 0x81, 0xe0, 0xfb, 0x36, 0x78, 0x33, 0x03,
+	0x2e, 0x43, 0xde, 0x77, 0xf6, 0xba, 0x51, 0x19, 0x8f, 0x06, 0x44, 0xd7, 0xca, 0xa8, 0xba, 0x68,
+	0x83, 0xef, 0xec, 0xb5, 0x98, 0xdb, 0xa2, 0x01, 0x31, 0x1e, 0xc0, 0x3f, 0xe7, 0x12, 0xe0, 0x02,
+	0xa4, 0x5e, 0x91, 0x7d, 0x35, 0x01, 0x3b, 0x12, 0xa3, 0xd6, 0xc7, 0x8e, 0x37, 0x22, 0xb2, 0xf5,
+	0xac, 0xad, 0x94, 0x7b, 0xc9, 0xbb, 0xa8, 0xf2, 0x02, 0xfe, 0x3d, 0x03, 0x29, 0x1c, 0xb2, 0x20,
+	0x24, 0x27, 0xb3, 0x52, 0x49, 0x94, 0x82, 0x8b, 0xa0, 0x85, 0x62, 0xc0, 0x46, 0x22, 0xce, 0x13,
+	0x6b, 0xb1, 0x9d, 0x70, 0xae, 0xa7, 0x66, 0x76, 0xc2, 0x79, 0xe5, 0x25, 0x2c, 0x3e, 0x12, 0xc2,
+	0xe9, 0xef, 0x5e, 0x6b, 0x35, 0xf9, 0x69, 0xb9, 0x12, 0xe4, 0xfa, 0x1e, 0x0b, 0x49, 0x57, 0xf9,
+	0xd4, 0x76, 0x40, 0x9a, 0x22, 0xb4, 0x41, 0xa5, 0x07, 0x4b, 0xd3, 0xfc, 0x31, 0xee, 0xcd, 0x08,
+	0x09, 0x27, 0x8e, 0x2f, 0x8b, 0x2c, 0xd5, 0x6f, 0xcc, 0xdf, 0x46, 0x5b, 0xc6, 0xda, 0xf1, 0x1b,
+	0x8c, 0x21, 0x3d, 0x70, 0x84, 0x13, 0xa3, 0x90, 0x72, 0x65, 0x0d, 0x70, 0x93, 0x78, 0xe4, 0x6a,
+	0x1c, 0xab, 0xbc, 0x47, 0x90, 0x6b, 0x31, 0x37, 0xbc, 0xac, 0xe1, 0x22, 0x68, 0x3b, 0xcc, 0xf3,
+	0xd8, 0x9b, 0x98, 0x8c, 0xb1, 0x16, 0x21, 0x10, 0x0e, 0x55, 0x4c, 0x4c, 0xd9, 0x52, 0xc6, 0x77,
+	0x20, 0x13, 0xd2, 0xa0, 0x4f, 0x24, 0x01, 0x73, 0x75, 0xc3, 0x52, 0x77, 0x61, 0x4d, 0xef, 0xc2,
+	0xea, 0x4c, 0xef, 0xa2, 0x91, 0xfe, 0xf8, 0xb3, 0x84, 0x6c, 0x15, 0x5e, 0x39, 0x40, 0x90, 0x57,
+	0x58, 0xfe, 0xd6, 0x70, 0x70, 0x03, 0xb2, 0xb3, 0xa3, 0xd4, 0x53, 0x97, 0xc2, 0x5b, 0x38, 0x3c,
+	0x2a, 0x25, 0x24, 0xc4, 0x93, 0x67, 0xab, 0x1d, 0xd0, 0x54, 0x25, 0xbc, 0x02, 0x5a, 0xbb, 0xd3,
+	0xdc, 0x7e, 0xda, 0x29, 0x24, 0x8c, 0xc2, 0x87, 0xcf, 0xe5, 0xbc, 0xb2, 0xb7, 0x15, 0xc9, 0x94,
+	0x77, 0xcb, 0xb6, 0x0b, 0xe8, 0x9c, 0x97, 0x70, 0x6e, 0x2c, 0xbd, 0xfb, 0x62, 0x26, 0xbe, 0x1e,
+	0x98, 0x71, 0xae, 0xfa, 0xa7, 0x14, 0x64, 0xe4, 0xc6, 0xb0, 0x0f, 0x9a, 0x62, 0x38, 0x5e, 0xbf,
+	0xee, 0x69, 0x1a, 0x1b, 0xd7, 0x78, 0x11, 0x0f, 0xd9, 0x05, 0x4d, 0x71, 0x12, 0xdf, 0x9a, 0xff,
+	0xf8, 0xcc, 0x65, 0x18, 0x6b, 0x57, 0x0b, 0x56, 0x45, 0xaa, 0x68, 0x1d, 0x61, 0x1b, 0x34, 0x45,
+	0xcc, 0xcb, 0xfa, 0xba, 0x48, 0x5f, 0xa3, 0x78, 0x61, 0x49, 0x5b, 0xd1, 0x87, 0x17, 0x77, 0x21,
+	0x1d, 0x31, 0x06, 0xdf, 0x9c, 0x9f, 0xf1, 0x14, 0xc3, 0x8d, 0xd5, 0xab, 0x84, 0x2a, 0xd8, 0xeb,
+	0xa8, 0xf1, 0xec, 0xf0, 0xd8, 0x4c, 0xfc, 0x38, 0x36, 0x13, 0x6f, 0x27, 0x26, 0x3a, 0x9c, 0x98,
+	0xe8, 0xfb, 0xc4, 0x44, 0xbf, 0x26, 0x26, 0x7a, 0xbe, 0xf9, 0x67, 0xbf, 0xa0, 0xfb, 0x52, 0xe8,
+	0x69, 0xb2, 0x91, 0xdb, 0xbf, 0x07, 0x00, 0xb1, 0xe6, 0x0b, 0x6f, 0xc9, 0x06, 0x00, 0x00,
+}
@@ -43,6 +43,11 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type VersionResponse struct {
 	Version  string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
 	Revision string `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Runtimes are the ids of the runtimes tasks can be created with.
+	Runtimes []string `protobuf:"bytes,3,rep,name=runtimes" json:"runtimes,omitempty"`
+	// APIVersion is the version of the GRPC API served by the daemon, as
+	// major.minor, for clients to negotiate the calls they use.
+	APIVersion string `protobuf:"bytes,4,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
 }
 
 func (m *VersionResponse) Reset()                    { *m = VersionResponse{} }
@@ -152,6 +157,27 @@ func (m *VersionResponse) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintVersion(dAtA, i, uint64(len(m.Revision)))
 		i += copy(dAtA[i:], m.Revision)
 	}
+	if len(m.Runtimes) > 0 {
+		for _, s := range m.Runtimes {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.APIVersion) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintVersion(dAtA, i, uint64(len(m.APIVersion)))
+		i += copy(dAtA[i:], m.APIVersion)
+	}
 	return i, nil
 }
 
@@ -193,6 +219,16 @@ func (m *VersionResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovVersion(uint64(l))
 	}
+	if len(m.Runtimes) > 0 {
+		for _, s := range m.Runtimes {
+			l = len(s)
+			n += 1 + l + sovVersion(uint64(l))
+		}
+	}
+	l = len(m.APIVersion)
+	if l > 0 {
+		n += 1 + l + sovVersion(uint64(l))
+	}
 	return n
 }
 
@@ -216,6 +252,8 @@ func (this *VersionResponse) String() string {
 	s := strings.Join([]string{`&VersionResponse{`,
 		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`Runtimes:` + fmt.Sprintf("%v", this.Runtimes) + `,`,
+		`APIVersion:` + fmt.Sprintf("%v", this.APIVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -315,6 +353,64 @@ func (m *VersionResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtimes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtimes = append(m.Runtimes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVersion(dAtA[iNdEx:])
@@ -446,7 +542,7 @@ func init() {
 }
 
 var fileDescriptorVersion = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
+	// 286 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x72, 0x4b, 0xcf, 0x2c, 0xc9,
 	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xce, 0xcf, 0x2b, 0x49, 0xcc, 0xcc, 0x4b, 0x2d,
 	0x4a, 0x41, 0x66, 0x26, 0x16, 0x64, 0xea, 0x17, 0xa7, 0x16, 0x95, 0x65, 0x26, 0xa7, 0x16, 0xeb,
@@ -454,13 +550,15 @@ var fileDescriptorVersion = []byte{
 	0x25, 0xf9, 0x42, 0x72, 0x08, 0x1d, 0x7a, 0x30, 0xd5, 0x7a, 0x30, 0x25, 0x65, 0x86, 0x52, 0xd2,
 	0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x60, 0xd5, 0x49, 0xa5, 0x69, 0xfa, 0xa9, 0xb9, 0x05,
 	0x25, 0x95, 0x10, 0xcd, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11,
-	0x55, 0x72, 0xe7, 0xe2, 0x0f, 0x83, 0x18, 0x10, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x2a,
-	0x24, 0xc1, 0xc5, 0x0e, 0x35, 0x53, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0xc6, 0x15, 0x92,
-	0xe2, 0xe2, 0x28, 0x4a, 0x2d, 0xcb, 0x04, 0x4b, 0x31, 0x81, 0xa5, 0xe0, 0x7c, 0xa3, 0x58, 0x2e,
-	0x76, 0xa8, 0x41, 0x42, 0x41, 0x08, 0xa6, 0x98, 0x1e, 0xc4, 0x49, 0x7a, 0x30, 0x27, 0xe9, 0xb9,
-	0x82, 0x9c, 0x24, 0xa5, 0xaf, 0x87, 0xdf, 0x2b, 0x7a, 0x68, 0x8e, 0x72, 0x8a, 0x3a, 0xf1, 0x50,
-	0x8e, 0xe1, 0xc6, 0x43, 0x39, 0x86, 0x86, 0x47, 0x72, 0x8c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0x63, 0x94, 0x03, 0xb9, 0x81, 0x6b, 0x0d, 0x65, 0x26, 0xb1,
-	0x81, 0x1d, 0x67, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x37, 0xd8, 0xc6, 0xa7, 0x01, 0x00,
-	0x00,
+	0x55, 0x9a, 0xc2, 0xc8, 0xc5, 0x1f, 0x06, 0x31, 0x21, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x38,
+	0x55, 0x48, 0x82, 0x8b, 0x1d, 0x6a, 0xa8, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x8c, 0x2b,
+	0x24, 0xc5, 0xc5, 0x51, 0x94, 0x5a, 0x96, 0x09, 0x96, 0x62, 0x02, 0x4b, 0xc1, 0xf9, 0x60, 0xb9,
+	0xd2, 0xbc, 0x92, 0xcc, 0xdc, 0xd4, 0x62, 0x09, 0x66, 0x05, 0x66, 0xb0, 0x1c, 0x94, 0x2f, 0xa4,
+	0xcf, 0xc5, 0x9d, 0x58, 0x90, 0x19, 0x0f, 0x33, 0x95, 0x05, 0xa4, 0xd5, 0x89, 0xef, 0xd1, 0x3d,
+	0x79, 0x2e, 0xc7, 0x00, 0x4f, 0x98, 0xf5, 0x5c, 0x89, 0x05, 0x99, 0x50, 0xb6, 0x51, 0x2c, 0x17,
+	0x3b, 0x94, 0x29, 0x14, 0x84, 0x60, 0x8a, 0xe9, 0x41, 0x3c, 0xa8, 0x07, 0xf3, 0xa0, 0x9e, 0x2b,
+	0xc8, 0x83, 0x52, 0xfa, 0x7a, 0xf8, 0x03, 0x46, 0x0f, 0xcd, 0x87, 0x4e, 0x51, 0x27, 0x1e, 0xca,
+	0x31, 0xdc, 0x78, 0x28, 0xc7, 0xd0, 0xf0, 0x48, 0x8e, 0xf1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
+	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x8c, 0x72, 0x20, 0x37, 0xaa, 0xac, 0xa1, 0xcc, 0x24, 0x36,
+	0xb0, 0xe3, 0x8c, 0x01, 0x03, 0x00, 0x9e, 0xeb, 0x61, 0xb7, 0xf5, 0x01, 0x00, 0x00,
 }
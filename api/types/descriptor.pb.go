@@ -7,10 +7,14 @@
 
 	It is generated from these files:
 		github.com/containerd/containerd/api/types/descriptor.proto
+		github.com/containerd/containerd/api/types/error.proto
+		github.com/containerd/containerd/api/types/hint.proto
 		github.com/containerd/containerd/api/types/mount.proto
 
 	It has these top-level messages:
 		Descriptor
+		ErrorDetail
+		Hint
 		Mount
 */
 package types
@@ -10,6 +10,7 @@
 
 	It has these top-level messages:
 		Process
+		ProcessInfo
 */
 package task
 
@@ -49,6 +50,9 @@ const (
 	StatusStopped Status = 3
 	StatusPaused  Status = 4
 	StatusPausing Status = 5
+	// BROKEN tasks could not be restored from their state, they can only
+	// be deleted.
+	StatusBroken Status = 6
 )
 
 var Status_name = map[int32]string{
@@ -58,6 +62,7 @@ var Status_name = map[int32]string{
 	3: "STOPPED",
 	4: "PAUSED",
 	5: "PAUSING",
+	6: "BROKEN",
 }
 var Status_value = map[string]int32{
 	"UNKNOWN": 0,
@@ -66,6 +71,7 @@ var Status_value = map[string]int32{
 	"STOPPED": 3,
 	"PAUSED":  4,
 	"PAUSING": 5,
+	"BROKEN":  6,
 }
 
 func (x Status) String() string {
@@ -84,14 +90,31 @@ type Process struct {
 	Terminal    bool      `protobuf:"varint,8,opt,name=terminal,proto3" json:"terminal,omitempty"`
 	ExitStatus  uint32    `protobuf:"varint,9,opt,name=exit_status,json=exitStatus,proto3" json:"exit_status,omitempty"`
 	ExitedAt    time.Time `protobuf:"bytes,10,opt,name=exited_at,json=exitedAt,stdtime" json:"exited_at"`
+	StartedAt   time.Time `protobuf:"bytes,11,opt,name=started_at,json=startedAt,stdtime" json:"started_at"`
+	// ExitReason is set by runtimes translating the exit status of their
+	// tasks, such as "GuestPanic" for a VM runtime
+	ExitReason string `protobuf:"bytes,12,opt,name=exit_reason,json=exitReason,proto3" json:"exit_reason,omitempty"`
 }
 
 func (m *Process) Reset()                    { *m = Process{} }
 func (*Process) ProtoMessage()               {}
 func (*Process) Descriptor() ([]byte, []int) { return fileDescriptorTask, []int{0} }
 
+type ProcessInfo struct {
+	// Pid is the process ID
+	Pid uint32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	// ExecID is the ID of the exec process that started the pid, empty
+	// if the pid belongs to the task's init process or is unknown
+	ExecID string `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+}
+
+func (m *ProcessInfo) Reset()                    { *m = ProcessInfo{} }
+func (*ProcessInfo) ProtoMessage()               {}
+func (*ProcessInfo) Descriptor() ([]byte, []int) { return fileDescriptorTask, []int{1} }
+
 func init() {
 	proto.RegisterType((*Process)(nil), "containerd.v1.types.Process")
+	proto.RegisterType((*ProcessInfo)(nil), "containerd.v1.types.ProcessInfo")
 	proto.RegisterEnum("containerd.v1.types.Status", Status_name, Status_value)
 }
 func (m *Process) Marshal() (dAtA []byte, err error) {
@@ -172,6 +195,49 @@ func (m *Process) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n1
+	dAtA[i] = 0x5a
+	i++
+	i = encodeVarintTask(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)))
+	n2, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartedAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	if len(m.ExitReason) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.ExitReason)))
+		i += copy(dAtA[i:], m.ExitReason)
+	}
+	return i, nil
+}
+
+func (m *ProcessInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcessInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pid != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(m.Pid))
+	}
+	if len(m.ExecID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.ExecID)))
+		i += copy(dAtA[i:], m.ExecID)
+	}
 	return i, nil
 }
 
@@ -239,6 +305,25 @@ func (m *Process) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExitedAt)
 	n += 1 + l + sovTask(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)
+	n += 1 + l + sovTask(uint64(l))
+	l = len(m.ExitReason)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	return n
+}
+
+func (m *ProcessInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pid != 0 {
+		n += 1 + sovTask(uint64(m.Pid))
+	}
+	l = len(m.ExecID)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
 	return n
 }
 
@@ -270,6 +355,19 @@ func (this *Process) String() string {
 		`Terminal:` + fmt.Sprintf("%v", this.Terminal) + `,`,
 		`ExitStatus:` + fmt.Sprintf("%v", this.ExitStatus) + `,`,
 		`ExitedAt:` + strings.Replace(strings.Replace(this.ExitedAt.String(), "Timestamp", "google_protobuf1.Timestamp", 1), `&`, ``, 1) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(this.StartedAt.String(), "Timestamp", "google_protobuf1.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ExitReason:` + fmt.Sprintf("%v", this.ExitReason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProcessInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProcessInfo{`,
+		`Pid:` + fmt.Sprintf("%v", this.Pid) + `,`,
+		`ExecID:` + fmt.Sprintf("%v", this.ExecID) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -563,6 +661,163 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			m.Pid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pid |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -694,37 +949,42 @@ func init() {
 }
 
 var fileDescriptorTask = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x3d, 0x6f, 0xd3, 0x40,
-	0x1c, 0xc6, 0x73, 0x6e, 0xe3, 0x24, 0x97, 0xb6, 0x98, 0xa3, 0xaa, 0x2c, 0x83, 0x6c, 0x8b, 0x29,
-	0x62, 0xb0, 0x45, 0xba, 0xb1, 0xe5, 0x4d, 0x28, 0x42, 0x72, 0xa3, 0x4b, 0x22, 0xc6, 0xc8, 0xc9,
-	0x1d, 0xe6, 0xd4, 0xe6, 0xce, 0xb2, 0xcf, 0xbc, 0x6c, 0x8c, 0xa8, 0x13, 0x5f, 0xa0, 0x13, 0x7c,
-	0x0a, 0x3e, 0x41, 0x46, 0x26, 0xc4, 0x14, 0xa8, 0x3f, 0x09, 0x3a, 0xdb, 0x09, 0x19, 0x58, 0xac,
-	0x7b, 0x9e, 0xdf, 0x73, 0x8f, 0xff, 0xf7, 0x87, 0x2f, 0x22, 0x26, 0xdf, 0x66, 0x4b, 0x6f, 0x25,
-	0xd6, 0xfe, 0x4a, 0x70, 0x19, 0x32, 0x4e, 0x13, 0x72, 0x78, 0x0c, 0x63, 0xe6, 0xcb, 0x8f, 0x31,
-	0x4d, 0x7d, 0x19, 0xa6, 0xd7, 0xc5, 0xc7, 0x8b, 0x13, 0x21, 0x05, 0x7a, 0xf4, 0x2f, 0xe5, 0xbd,
-	0x7b, 0xee, 0x15, 0x21, 0xeb, 0x3c, 0x12, 0x91, 0x28, 0xb8, 0xaf, 0x4e, 0x65, 0xd4, 0x72, 0x22,
-	0x21, 0xa2, 0x1b, 0xea, 0x17, 0x6a, 0x99, 0xbd, 0xf1, 0x25, 0x5b, 0xd3, 0x54, 0x86, 0xeb, 0xb8,
-	0x0c, 0x3c, 0xcd, 0x35, 0xd8, 0x98, 0x24, 0x62, 0x45, 0xd3, 0x14, 0x75, 0xe1, 0xc9, 0xbe, 0x79,
-	0xc1, 0x88, 0x09, 0x5c, 0xd0, 0x69, 0xf5, 0x1f, 0xe4, 0x5b, 0xa7, 0x3d, 0xd8, 0xf9, 0xe3, 0x21,
-	0x6e, 0xef, 0x43, 0x63, 0x82, 0x2e, 0xa0, 0xc6, 0x88, 0xa9, 0x15, 0x49, 0x3d, 0xdf, 0x3a, 0xda,
-	0x78, 0x88, 0x35, 0x46, 0x90, 0x01, 0x8f, 0x62, 0x46, 0xcc, 0x23, 0x17, 0x74, 0x4e, 0xb1, 0x3a,
-	0xa2, 0x4b, 0xa8, 0xa7, 0x32, 0x94, 0x59, 0x6a, 0x1e, 0xbb, 0xa0, 0x73, 0xd6, 0x7d, 0xec, 0xfd,
-	0xe7, 0x19, 0xde, 0xb4, 0x88, 0xe0, 0x2a, 0x8a, 0xce, 0x61, 0x3d, 0x95, 0x84, 0x71, 0xb3, 0xae,
-	0xfe, 0x80, 0x4b, 0x81, 0x2e, 0x54, 0x15, 0x11, 0x99, 0x34, 0xf5, 0xc2, 0xae, 0x54, 0xe5, 0xd3,
-	0x24, 0x31, 0x1b, 0x7b, 0x9f, 0x26, 0x09, 0xb2, 0x60, 0x53, 0xd2, 0x64, 0xcd, 0x78, 0x78, 0x63,
-	0x36, 0x5d, 0xd0, 0x69, 0xe2, 0xbd, 0x46, 0x0e, 0x6c, 0xd3, 0x0f, 0x4c, 0x2e, 0xaa, 0xd9, 0x5a,
-	0xc5, 0xc0, 0x50, 0x59, 0xe5, 0x28, 0xa8, 0x07, 0x5b, 0x4a, 0x51, 0xb2, 0x08, 0xa5, 0x09, 0x5d,
-	0xd0, 0x69, 0x77, 0x2d, 0xaf, 0x5c, 0xab, 0xb7, 0x5b, 0xab, 0x37, 0xdb, 0xad, 0xb5, 0xdf, 0xdc,
-	0x6c, 0x9d, 0xda, 0x97, 0xdf, 0x0e, 0xc0, 0xcd, 0xf2, 0x5a, 0x4f, 0x3e, 0xfb, 0x09, 0xa0, 0x5e,
-	0xb5, 0xd9, 0xb0, 0x31, 0x0f, 0x5e, 0x05, 0x57, 0xaf, 0x03, 0xa3, 0x66, 0x3d, 0xbc, 0xbd, 0x73,
-	0x4f, 0x4b, 0x30, 0xe7, 0xd7, 0x5c, 0xbc, 0xe7, 0x8a, 0x0f, 0xf0, 0xa8, 0x37, 0x1b, 0x0d, 0x0d,
-	0x70, 0xc8, 0x07, 0x09, 0x0d, 0x25, 0x25, 0x8a, 0xe3, 0x79, 0x10, 0x8c, 0x83, 0x97, 0x86, 0x76,
-	0xc8, 0x71, 0xc6, 0x39, 0xe3, 0x91, 0xe2, 0xd3, 0xd9, 0xd5, 0x64, 0x32, 0x1a, 0x1a, 0x47, 0x87,
-	0x7c, 0x2a, 0x45, 0x1c, 0x53, 0x82, 0x9e, 0x40, 0x7d, 0xd2, 0x9b, 0x4f, 0x47, 0x43, 0xe3, 0xd8,
-	0x32, 0x6e, 0xef, 0xdc, 0x93, 0x12, 0x4f, 0xc2, 0x2c, 0x2d, 0xdb, 0x15, 0x55, 0xed, 0xf5, 0xc3,
-	0xdb, 0x0a, 0x33, 0x1e, 0x59, 0x67, 0x9f, 0xbf, 0xda, 0xb5, 0xef, 0xdf, 0xec, 0xea, 0x35, 0x7d,
-	0x73, 0x73, 0x6f, 0xd7, 0x7e, 0xdd, 0xdb, 0xb5, 0x4f, 0xb9, 0x0d, 0x36, 0xb9, 0x0d, 0x7e, 0xe4,
-	0x36, 0xf8, 0x93, 0xdb, 0x60, 0xa9, 0x17, 0xab, 0xb9, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x14,
-	0xf3, 0xee, 0x85, 0xe8, 0x02, 0x00, 0x00,
+	// 585 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x73, 0x49, 0xeb, 0x24, 0x97, 0xb6, 0x18, 0x53, 0x55, 0x96, 0xa9, 0x6c, 0xab, 0x2c,
+	0x11, 0x83, 0x23, 0xda, 0x8d, 0x2d, 0x89, 0x23, 0x64, 0x55, 0x72, 0xa3, 0x4b, 0x23, 0xc6, 0xc8,
+	0x8d, 0xaf, 0xe1, 0xd4, 0xe6, 0xce, 0x3a, 0x5f, 0x20, 0x6c, 0x8c, 0xa8, 0x13, 0x5f, 0xa0, 0x13,
+	0x7c, 0x0a, 0x3e, 0x41, 0x46, 0x46, 0xa6, 0x40, 0xfd, 0x19, 0xf8, 0x00, 0xe8, 0xce, 0x4e, 0xea,
+	0x81, 0x85, 0xc5, 0xba, 0xf7, 0xfd, 0x3d, 0xef, 0xe3, 0xf7, 0x0f, 0x7c, 0x3d, 0x23, 0xe2, 0xdd,
+	0xe2, 0xca, 0x9b, 0xb2, 0x79, 0x67, 0xca, 0xa8, 0x88, 0x08, 0xc5, 0x3c, 0x2e, 0x3f, 0xa3, 0x84,
+	0x74, 0xc4, 0xc7, 0x04, 0xa7, 0x1d, 0x11, 0xa5, 0x37, 0xea, 0xe3, 0x25, 0x9c, 0x09, 0x66, 0x3c,
+	0x7b, 0x54, 0x79, 0xef, 0x5f, 0x79, 0x4a, 0x64, 0x1d, 0xce, 0xd8, 0x8c, 0x29, 0xde, 0x91, 0xaf,
+	0x5c, 0x6a, 0x39, 0x33, 0xc6, 0x66, 0xb7, 0xb8, 0xa3, 0xa2, 0xab, 0xc5, 0x75, 0x47, 0x90, 0x39,
+	0x4e, 0x45, 0x34, 0x4f, 0x72, 0xc1, 0xc9, 0xaa, 0x06, 0xeb, 0x43, 0xce, 0xa6, 0x38, 0x4d, 0x8d,
+	0x53, 0xb8, 0xb7, 0x75, 0x9e, 0x90, 0xd8, 0x04, 0x2e, 0x68, 0x37, 0x7b, 0x4f, 0xb2, 0xb5, 0xd3,
+	0xea, 0x6f, 0xf2, 0x81, 0x8f, 0x5a, 0x5b, 0x51, 0x10, 0x1b, 0x47, 0xb0, 0x4a, 0x62, 0xb3, 0xaa,
+	0x94, 0x5a, 0xb6, 0x76, 0xaa, 0x81, 0x8f, 0xaa, 0x24, 0x36, 0x74, 0x58, 0x4b, 0x48, 0x6c, 0xd6,
+	0x5c, 0xd0, 0xde, 0x47, 0xf2, 0x69, 0x9c, 0x41, 0x2d, 0x15, 0x91, 0x58, 0xa4, 0xe6, 0x8e, 0x0b,
+	0xda, 0x07, 0xa7, 0xcf, 0xbd, 0x7f, 0x8c, 0xe1, 0x8d, 0x94, 0x04, 0x15, 0x52, 0xe3, 0x10, 0xee,
+	0xa6, 0x22, 0x26, 0xd4, 0xdc, 0x95, 0x7f, 0x40, 0x79, 0x60, 0x1c, 0x49, 0xab, 0x98, 0x2d, 0x84,
+	0xa9, 0xa9, 0x74, 0x11, 0x15, 0x79, 0xcc, 0xb9, 0x59, 0xdf, 0xe6, 0x31, 0xe7, 0x86, 0x05, 0x1b,
+	0x02, 0xf3, 0x39, 0xa1, 0xd1, 0xad, 0xd9, 0x70, 0x41, 0xbb, 0x81, 0xb6, 0xb1, 0xe1, 0xc0, 0x16,
+	0x5e, 0x12, 0x31, 0x29, 0x7a, 0x6b, 0xaa, 0x86, 0xa1, 0x4c, 0xe5, 0xad, 0x18, 0x5d, 0xd8, 0x94,
+	0x11, 0x8e, 0x27, 0x91, 0x30, 0xa1, 0x0b, 0xda, 0xad, 0x53, 0xcb, 0xcb, 0xd7, 0xea, 0x6d, 0xd6,
+	0xea, 0x5d, 0x6e, 0xd6, 0xda, 0x6b, 0xac, 0xd6, 0x4e, 0xe5, 0xcb, 0x2f, 0x07, 0xa0, 0x46, 0x5e,
+	0xd6, 0x15, 0x46, 0x1f, 0xc2, 0x54, 0x44, 0xbc, 0xf0, 0x68, 0xfd, 0x87, 0x47, 0xb3, 0xa8, 0xeb,
+	0x8a, 0x6d, 0xa3, 0x1c, 0x47, 0x29, 0xa3, 0xe6, 0x9e, 0x9a, 0x50, 0x35, 0x8a, 0x54, 0xe6, 0xc4,
+	0x87, 0xad, 0xe2, 0x92, 0x01, 0xbd, 0x66, 0x9b, 0x0b, 0x80, 0xc7, 0x0b, 0xbc, 0x80, 0x75, 0xbc,
+	0xc4, 0xd3, 0xc9, 0xf6, 0x60, 0x30, 0x5b, 0x3b, 0xda, 0x60, 0x89, 0xa7, 0x81, 0x8f, 0x34, 0x89,
+	0x82, 0xf8, 0xe5, 0x1f, 0x00, 0xb5, 0x62, 0x72, 0x1b, 0xd6, 0xc7, 0xe1, 0x79, 0x78, 0xf1, 0x36,
+	0xd4, 0x2b, 0xd6, 0xd3, 0xbb, 0x7b, 0x77, 0x3f, 0x07, 0x63, 0x7a, 0x43, 0xd9, 0x07, 0x2a, 0x79,
+	0x1f, 0x0d, 0xba, 0x97, 0x03, 0x5f, 0x07, 0x65, 0xde, 0xe7, 0x38, 0x12, 0x38, 0x96, 0x1c, 0x8d,
+	0xc3, 0x30, 0x08, 0xdf, 0xe8, 0xd5, 0x32, 0x47, 0x0b, 0x4a, 0x09, 0x9d, 0x49, 0x3e, 0xba, 0xbc,
+	0x18, 0x0e, 0x07, 0xbe, 0x5e, 0x2b, 0xf3, 0x91, 0x60, 0x49, 0x82, 0x63, 0xe3, 0x18, 0x6a, 0xc3,
+	0xee, 0x78, 0x34, 0xf0, 0xf5, 0x1d, 0x4b, 0xbf, 0xbb, 0x77, 0xf7, 0x72, 0x3c, 0x8c, 0x16, 0x69,
+	0xee, 0x2e, 0xa9, 0x74, 0xdf, 0x2d, 0x57, 0x4b, 0x2c, 0xdd, 0x8f, 0xa1, 0xd6, 0x43, 0x17, 0xe7,
+	0x83, 0x50, 0xd7, 0xca, 0xd5, 0x3d, 0xce, 0x6e, 0x30, 0xb5, 0x0e, 0x3e, 0x7f, 0xb5, 0x2b, 0xdf,
+	0xbf, 0xd9, 0xc5, 0xac, 0x3d, 0x73, 0xf5, 0x60, 0x57, 0x7e, 0x3e, 0xd8, 0x95, 0x4f, 0x99, 0x0d,
+	0x56, 0x99, 0x0d, 0x7e, 0x64, 0x36, 0xf8, 0x9d, 0xd9, 0xe0, 0x4a, 0x53, 0x07, 0x3a, 0xfb, 0x3b,
+	0x00, 0xd2, 0x13, 0x3d, 0x4e, 0xb2, 0x03, 0x00, 0x00,
 }
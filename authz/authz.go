@@ -0,0 +1,113 @@
+// Package authz defines the authorization of the calls to the GRPC API.
+//
+// Plugins of the AuthzPlugin type returning an Authorizer are consulted for
+// each call, which is denied when any of them returns an error.
+package authz
+
+import (
+	"crypto/x509"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// Authorizer allows or denies calls to the GRPC API
+type Authorizer interface {
+	// Authorize returns an error, such as errdefs.ErrPermissionDenied, when
+	// the call is denied
+	Authorize(ctx context.Context, r *Request) error
+}
+
+// Request is a call to the GRPC API
+type Request struct {
+	// Method is the full name of the GRPC method, such as
+	// "/containerd.services.tasks.v1.Tasks/Create"
+	Method string
+	// Namespace of the call, empty when none is set
+	Namespace string
+	// Message is the request of the call, nil for streaming methods
+	Message interface{}
+	Peer    Peer
+}
+
+// Peer identifies the client of a call
+type Peer struct {
+	// Unix holds the credentials of a client connected to a unix socket,
+	// nil when they are not supported on the platform
+	Unix *UnixCredentials
+	// Certificate is the verified certificate of a client connected over
+	// TLS, nil when the client presented none
+	Certificate *x509.Certificate
+}
+
+// UnixCredentials are the credentials of the process connected to a unix
+// socket when it connected
+type UnixCredentials struct {
+	Pid uint32
+	Uid uint32
+	Gid uint32
+	// Groups are the supplementary groups of the process
+	Groups []uint32
+}
+
+// AuthType implements credentials.AuthInfo so that the credentials are
+// available to the calls of the connection
+func (c *UnixCredentials) AuthType() string {
+	return "unix"
+}
+
+// InGroup returns true if the gid is the group or a supplementary group of
+// the process
+func (c *UnixCredentials) InGroup(gid uint32) bool {
+	if c.Gid == gid {
+		return true
+	}
+	for _, g := range c.Groups {
+		if g == gid {
+			return true
+		}
+	}
+	return false
+}
+
+// PeerFromContext returns the client of the call of the context
+func PeerFromContext(ctx context.Context) Peer {
+	var out Peer
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return out
+	}
+	switch info := p.AuthInfo.(type) {
+	case *UnixCredentials:
+		out.Unix = info
+	case credentials.TLSInfo:
+		if chains := info.State.VerifiedChains; len(chains) > 0 && len(chains[0]) > 0 {
+			out.Certificate = chains[0][0]
+		}
+	}
+	return out
+}
+
+// AuthorizeFunc returns an error when the call of the method with the
+// request is denied
+type AuthorizeFunc func(ctx context.Context, method string, req interface{}) error
+
+type authorizeKey struct{}
+
+// WithAuthorize returns a context authorizing the calls made on behalf of
+// the call of the context, such as the items of a batch, with fn
+func WithAuthorize(ctx context.Context, fn AuthorizeFunc) context.Context {
+	return context.WithValue(ctx, authorizeKey{}, fn)
+}
+
+// Authorize returns an error when the call of the method with the request,
+// made on behalf of the call of the context, is denied. Calls are allowed
+// when the context has no authorization.
+func Authorize(ctx context.Context, method string, req interface{}) error {
+	fn, ok := ctx.Value(authorizeKey{}).(AuthorizeFunc)
+	if !ok {
+		return nil
+	}
+	return fn(ctx, method, req)
+}
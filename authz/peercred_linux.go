@@ -0,0 +1,80 @@
+package authz
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// UnixPeer returns the credentials of the process connected to the socket
+func UnixPeer(conn *net.UnixConn) (*UnixCredentials, error) {
+	// the credentials are read from a duplicate of the descriptor, as the
+	// raw connection is only reachable from Go 1.9
+	f, err := conn.File()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	ucred, err := unix.GetsockoptUcred(int(f.Fd()), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	if err != nil {
+		return nil, err
+	}
+	creds := &UnixCredentials{
+		Pid: uint32(ucred.Pid),
+		Uid: ucred.Uid,
+		Gid: ucred.Gid,
+	}
+	// the supplementary groups are not part of the credentials of the
+	// socket, they are read from the process while it is connected
+	creds.Groups, _ = processGroups(ucred.Pid, ucred.Uid)
+	return creds, nil
+}
+
+// processGroups returns the supplementary groups of the process, none when
+// the pid is no longer used by a process of the uid
+func processGroups(pid int32, uid uint32) ([]uint32, error) {
+	f, err := os.Open(fmt.Sprintf("/proc/%d/status", pid))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var (
+		s      = bufio.NewScanner(f)
+		groups []uint32
+		ruid   = -1
+	)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		switch fields[0] {
+		case "Uid:":
+			v, err := strconv.ParseUint(fields[1], 10, 32)
+			if err != nil {
+				return nil, err
+			}
+			ruid = int(v)
+		case "Groups:":
+			for _, field := range fields[1:] {
+				gid, err := strconv.ParseUint(field, 10, 32)
+				if err != nil {
+					return nil, err
+				}
+				groups = append(groups, uint32(gid))
+			}
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if ruid != int(uid) {
+		return nil, nil
+	}
+	return groups, nil
+}
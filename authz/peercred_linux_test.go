@@ -0,0 +1,49 @@
+package authz
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUnixPeer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "containerd-authz-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	l, err := net.Listen("unix", filepath.Join(dir, "test.sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	client, err := net.Dial("unix", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	conn, err := l.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	creds, err := UnixPeer(conn.(*net.UnixConn))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if int(creds.Pid) != os.Getpid() || int(creds.Uid) != os.Getuid() || int(creds.Gid) != os.Getgid() {
+		t.Fatalf("expected the credentials of the test process, got %+v", creds)
+	}
+	groups, err := os.Getgroups()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, g := range groups {
+		if !creds.InGroup(uint32(g)) {
+			t.Errorf("expected the process to be in group %d", g)
+		}
+	}
+}
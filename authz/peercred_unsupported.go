@@ -0,0 +1,15 @@
+// +build !linux
+
+package authz
+
+import (
+	"net"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+)
+
+// UnixPeer returns the credentials of the process connected to the socket
+func UnixPeer(conn *net.UnixConn) (*UnixCredentials, error) {
+	return nil, errors.Wrap(errdefs.ErrNotImplemented, "unix peer credentials")
+}
@@ -0,0 +1,251 @@
+// Package policy implements an authorization plugin allowing or denying the
+// calls to the GRPC API with the rules of its configuration.
+package policy
+
+import (
+	"path"
+
+	containersapi "github.com/containerd/containerd/api/services/containers/v1"
+	"github.com/containerd/containerd/authz"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/typeurl"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+const (
+	allow = "allow"
+	deny  = "deny"
+)
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.AuthzPlugin,
+		ID:   "policy",
+		Config: &Config{
+			Default: deny,
+		},
+		Init: New,
+	})
+}
+
+// Config of the policy
+type Config struct {
+	// Default is the action of calls matching no rule, "allow" or "deny"
+	Default string `toml:"default"`
+	// Rules are matched in order, the action of the first rule matching a
+	// call applies
+	Rules []Rule `toml:"rules"`
+}
+
+// Rule matches calls by client, method and namespace. Empty fields match
+// any call.
+type Rule struct {
+	// Users are the uids of clients connected to the unix socket
+	Users []uint32 `toml:"users"`
+	// Groups are the gids of clients connected to the unix socket, matching
+	// their group and supplementary groups
+	Groups []uint32 `toml:"groups"`
+	// Names are the common names of the certificates of clients connected
+	// over TLS
+	Names []string `toml:"names"`
+	// Methods are patterns of the full names of GRPC methods, such as
+	// "/containerd.services.events.v1.Events/*"
+	Methods []string `toml:"methods"`
+	// Namespaces are the namespaces of the calls
+	Namespaces []string `toml:"namespaces"`
+	// Action is "allow" or "deny"
+	Action string `toml:"action"`
+	// AllowHostNamespaces allows the containers created or updated by the
+	// calls allowed by the rule to share the network, pid or ipc namespace
+	// of the host
+	AllowHostNamespaces bool `toml:"allow_host_namespaces"`
+	// AllowNoCgroupLimits allows the containers created or updated by the
+	// calls allowed by the rule to set no memory, cpu quota or pids limit
+	AllowNoCgroupLimits bool `toml:"allow_no_cgroup_limits"`
+}
+
+// New returns the policy of the configuration. The plugin is skipped when
+// there are no rules so that all calls are allowed.
+func New(ic *plugin.InitContext) (interface{}, error) {
+	config := ic.Config.(*Config)
+	if len(config.Rules) == 0 {
+		return nil, plugin.SkipPlugin
+	}
+	if err := validAction(config.Default); err != nil {
+		return nil, errors.Wrap(err, "default")
+	}
+	for i, r := range config.Rules {
+		if err := validAction(r.Action); err != nil {
+			return nil, errors.Wrapf(err, "rule %d", i)
+		}
+		for _, m := range r.Methods {
+			if _, err := path.Match(m, ""); err != nil {
+				return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "rule %d: invalid method pattern %q", i, m)
+			}
+		}
+	}
+	return &Policy{config: config}, nil
+}
+
+func validAction(action string) error {
+	if action != allow && action != deny {
+		return errors.Wrapf(errdefs.ErrInvalidArgument, "action %q must be %q or %q", action, allow, deny)
+	}
+	return nil
+}
+
+// Policy authorizes calls with the rules of its configuration. Root clients
+// connected to the unix socket are always allowed so that the daemon cannot
+// be locked out of.
+type Policy struct {
+	config *Config
+}
+
+// Authorize allows or denies the call with the first rule matching it. The
+// containers created or updated by a call allowed by a rule must not share
+// the namespaces of the host or go without cgroup limits unless the rule
+// allows it.
+func (p *Policy) Authorize(ctx context.Context, r *authz.Request) error {
+	if r.Peer.Unix != nil && r.Peer.Unix.Uid == 0 {
+		return nil
+	}
+	for _, rule := range p.config.Rules {
+		if rule.match(r) {
+			if rule.Action != allow {
+				return errors.Wrapf(errdefs.ErrPermissionDenied, "%s", r.Method)
+			}
+			return rule.checkContainer(r)
+		}
+	}
+	if p.config.Default != allow {
+		return errors.Wrapf(errdefs.ErrPermissionDenied, "%s", r.Method)
+	}
+	return nil
+}
+
+// checkContainer denies the creation or update of a container sharing the
+// namespaces of the host or without cgroup limits when the rule does not
+// allow it
+func (r *Rule) checkContainer(req *authz.Request) error {
+	if r.AllowHostNamespaces && r.AllowNoCgroupLimits {
+		return nil
+	}
+	var c *containersapi.Container
+	switch m := req.Message.(type) {
+	case *containersapi.CreateContainerRequest:
+		c = &m.Container
+	case *containersapi.UpdateContainerRequest:
+		c = &m.Container
+	}
+	if c == nil || c.Spec == nil {
+		return nil
+	}
+	v, err := typeurl.UnmarshalAny(c.Spec)
+	if err != nil {
+		return errors.Wrapf(errdefs.ErrPermissionDenied, "%s: spec of container %s cannot be checked: %v", req.Method, c.ID, err)
+	}
+	spec, ok := v.(*specs.Spec)
+	if !ok || spec.Linux == nil {
+		return nil
+	}
+	if !r.AllowHostNamespaces {
+		if shared := hostNamespaces(spec.Linux); len(shared) > 0 {
+			return errors.Wrapf(errdefs.ErrPermissionDenied, "%s: container %s shares the %s namespace of the host", req.Method, c.ID, shared[0])
+		}
+	}
+	if !r.AllowNoCgroupLimits && !limited(spec.Linux.Resources) {
+		return errors.Wrapf(errdefs.ErrPermissionDenied, "%s: container %s sets no memory, cpu quota or pids limit", req.Method, c.ID)
+	}
+	return nil
+}
+
+// hostNamespaces returns the network, pid and ipc namespaces that the
+// container shares with the host, those it does not create or join
+func hostNamespaces(l *specs.Linux) []specs.LinuxNamespaceType {
+	var shared []specs.LinuxNamespaceType
+	for _, t := range []specs.LinuxNamespaceType{specs.NetworkNamespace, specs.PIDNamespace, specs.IPCNamespace} {
+		found := false
+		for _, ns := range l.Namespaces {
+			if ns.Type == t {
+				found = true
+				break
+			}
+		}
+		if !found {
+			shared = append(shared, t)
+		}
+	}
+	return shared
+}
+
+// limited returns true if the resources set a memory, cpu quota or pids
+// limit
+func limited(res *specs.LinuxResources) bool {
+	if res == nil {
+		return false
+	}
+	if res.Memory != nil && res.Memory.Limit != nil && *res.Memory.Limit > 0 {
+		return true
+	}
+	if res.CPU != nil && res.CPU.Quota != nil && *res.CPU.Quota > 0 {
+		return true
+	}
+	return res.Pids != nil && res.Pids.Limit > 0
+}
+
+func (r *Rule) match(req *authz.Request) bool {
+	return r.matchPeer(req.Peer) && r.matchMethod(req.Method) && r.matchNamespace(req.Namespace)
+}
+
+func (r *Rule) matchPeer(p authz.Peer) bool {
+	if len(r.Users) == 0 && len(r.Groups) == 0 && len(r.Names) == 0 {
+		return true
+	}
+	if p.Unix != nil {
+		for _, uid := range r.Users {
+			if p.Unix.Uid == uid {
+				return true
+			}
+		}
+		for _, gid := range r.Groups {
+			if p.Unix.InGroup(gid) {
+				return true
+			}
+		}
+	}
+	if p.Certificate != nil {
+		for _, name := range r.Names {
+			if p.Certificate.Subject.CommonName == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *Rule) matchMethod(method string) bool {
+	if len(r.Methods) == 0 {
+		return true
+	}
+	for _, m := range r.Methods {
+		if ok, _ := path.Match(m, method); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) matchNamespace(namespace string) bool {
+	if len(r.Namespaces) == 0 {
+		return true
+	}
+	for _, ns := range r.Namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+package policy
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	containersapi "github.com/containerd/containerd/api/services/containers/v1"
+	"github.com/containerd/containerd/authz"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/typeurl"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/net/context"
+)
+
+func TestPolicy(t *testing.T) {
+	p := &Policy{config: &Config{
+		Default: deny,
+		Rules: []Rule{
+			{
+				Groups: []uint32{1001},
+				Methods: []string{
+					"/containerd.services.containers.v1.Containers/List",
+					"/containerd.services.events.v1.Events/*",
+				},
+				Action: allow,
+			},
+			{
+				Names:      []string{"ci"},
+				Namespaces: []string{"ci"},
+				Action:     allow,
+			},
+		},
+	}}
+	var (
+		member = authz.Peer{Unix: &authz.UnixCredentials{Uid: 1000, Gid: 1000, Groups: []uint32{1001}}}
+		other  = authz.Peer{Unix: &authz.UnixCredentials{Uid: 1002, Gid: 1002}}
+		root   = authz.Peer{Unix: &authz.UnixCredentials{}}
+		ci     = authz.Peer{Certificate: &x509.Certificate{Subject: pkix.Name{CommonName: "ci"}}}
+	)
+	for _, tc := range []struct {
+		peer      authz.Peer
+		method    string
+		namespace string
+		allowed   bool
+	}{
+		{member, "/containerd.services.containers.v1.Containers/List", "default", true},
+		{member, "/containerd.services.events.v1.Events/Subscribe", "default", true},
+		{member, "/containerd.services.containers.v1.Containers/Create", "default", false},
+		{other, "/containerd.services.containers.v1.Containers/List", "default", false},
+		{root, "/containerd.services.containers.v1.Containers/Create", "default", true},
+		{ci, "/containerd.services.tasks.v1.Tasks/Create", "ci", true},
+		{ci, "/containerd.services.tasks.v1.Tasks/Create", "default", false},
+	} {
+		err := p.Authorize(context.Background(), &authz.Request{
+			Method:    tc.method,
+			Namespace: tc.namespace,
+			Peer:      tc.peer,
+		})
+		if tc.allowed && err != nil {
+			t.Errorf("expected %s in %s to be allowed for %+v: %v", tc.method, tc.namespace, tc.peer, err)
+		}
+		if !tc.allowed && !errdefs.IsPermissionDenied(err) {
+			t.Errorf("expected %s in %s to be denied for %+v, got %v", tc.method, tc.namespace, tc.peer, err)
+		}
+	}
+}
+
+func TestPolicyContainers(t *testing.T) {
+	typeurl.Register(&specs.Spec{}, "opencontainers/runtime-spec", "v1", "Spec")
+
+	var (
+		agents = authz.Peer{Unix: &authz.UnixCredentials{Uid: 1000, Gid: 1000}}
+		users  = authz.Peer{Unix: &authz.UnixCredentials{Uid: 1001, Gid: 1001}}
+	)
+	p := &Policy{config: &Config{
+		Default: deny,
+		Rules: []Rule{
+			{
+				Users:               []uint32{1000},
+				Action:              allow,
+				AllowHostNamespaces: true,
+				AllowNoCgroupLimits: true,
+			},
+			{
+				Users:  []uint32{1001},
+				Action: allow,
+			},
+		},
+	}}
+	memory := int64(1 << 30)
+	isolated := []specs.LinuxNamespace{
+		{Type: specs.PIDNamespace},
+		{Type: specs.NetworkNamespace},
+		{Type: specs.IPCNamespace},
+	}
+	limits := &specs.LinuxResources{Memory: &specs.LinuxMemory{Limit: &memory}}
+	for _, tc := range []struct {
+		peer    authz.Peer
+		linux   *specs.Linux
+		allowed bool
+	}{
+		{users, &specs.Linux{Namespaces: isolated, Resources: limits}, true},
+		{users, &specs.Linux{Namespaces: isolated[:2], Resources: limits}, false},
+		{users, &specs.Linux{Namespaces: isolated}, false},
+		{agents, &specs.Linux{}, true},
+	} {
+		any, err := typeurl.MarshalAny(&specs.Spec{Linux: tc.linux})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range []*authz.Request{
+			{
+				Method:  "/containerd.services.containers.v1.Containers/Create",
+				Message: &containersapi.CreateContainerRequest{Container: containersapi.Container{ID: "test", Spec: any}},
+				Peer:    tc.peer,
+			},
+			{
+				Method:  "/containerd.services.containers.v1.Containers/Update",
+				Message: &containersapi.UpdateContainerRequest{Container: containersapi.Container{ID: "test", Spec: any}},
+				Peer:    tc.peer,
+			},
+		} {
+			err := p.Authorize(context.Background(), r)
+			if tc.allowed && err != nil {
+				t.Errorf("expected %s of %+v to be allowed for %+v: %v", r.Method, tc.linux, tc.peer, err)
+			}
+			if !tc.allowed && !errdefs.IsPermissionDenied(err) {
+				t.Errorf("expected %s of %+v to be denied for %+v, got %v", r.Method, tc.linux, tc.peer, err)
+			}
+		}
+	}
+}
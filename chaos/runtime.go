@@ -0,0 +1,272 @@
+// Package chaos provides a runtime that wraps another runtime and injects
+// failures and latencies into the operations on its tasks.
+package chaos
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	chaosapi "github.com/containerd/containerd/api/services/chaos/v1"
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/containerd/typeurl"
+	"github.com/pkg/errors"
+)
+
+var pluginID = fmt.Sprintf("%s.%s", plugin.RuntimePlugin, "chaos")
+
+// exitOperation is the publishing of the exit events of the processes of the
+// tasks, which are dropped at the error rate of its fault
+const exitOperation = "exit"
+
+// operations are the task operations faults can be injected into
+var operations = map[string]struct{}{
+	"create":      {},
+	"start":       {},
+	"kill":        {},
+	"exec":        {},
+	"pause":       {},
+	"resume":      {},
+	"delete":      {},
+	exitOperation: {},
+}
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.RuntimePlugin,
+		ID:   "chaos",
+		Requires: []plugin.PluginType{
+			plugin.RuntimePlugin,
+		},
+		Config: &Config{
+			Runtime: "linux",
+		},
+		Init: New,
+	})
+}
+
+// Config for the chaos runtime
+type Config struct {
+	// Runtime is the id of the runtime plugin whose tasks are wrapped
+	Runtime string `toml:"runtime"`
+	// Seed for the random source deciding which operations fail. Zero
+	// seeds it from the current time.
+	Seed int64 `toml:"seed,omitempty"`
+}
+
+// New returns a chaos runtime wrapping the configured runtime. No faults are
+// injected until they are set through the chaos service.
+func New(ic *plugin.InitContext) (interface{}, error) {
+	cfg := ic.Config.(*Config)
+	runtimes, err := ic.GetAll(plugin.RuntimePlugin)
+	if err != nil {
+		return nil, err
+	}
+	rt, ok := runtimes[cfg.Runtime].(runtime.Runtime)
+	if !ok {
+		return nil, errors.Errorf("runtime %q wrapped by the chaos runtime is not loaded", cfg.Runtime)
+	}
+	seed := cfg.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	r := &Runtime{
+		runtime: rt,
+		tasks:   runtime.NewTaskList(),
+		faults:  make(map[string]chaosapi.Fault),
+		rand:    rand.New(rand.NewSource(seed)),
+	}
+	ic.Events.Transform(runtime.TaskExitEventTopic, r.dropExit)
+	return r, nil
+}
+
+// Runtime creates its tasks with the wrapped runtime and injects faults into
+// their operations
+type Runtime struct {
+	runtime runtime.Runtime
+	tasks   *runtime.TaskList
+
+	mu     sync.Mutex
+	faults map[string]chaosapi.Fault
+	rand   *rand.Rand
+}
+
+func (r *Runtime) ID() string {
+	return pluginID
+}
+
+func (r *Runtime) Create(ctx context.Context, id string, opts runtime.CreateOpts) (runtime.Task, error) {
+	if err := r.inject(ctx, "create"); err != nil {
+		return nil, err
+	}
+	t, err := r.runtime.Create(ctx, id, opts)
+	if err != nil {
+		return nil, err
+	}
+	ct := &task{Task: t, r: r}
+	if err := r.tasks.Add(ctx, ct); err != nil {
+		return nil, err
+	}
+	r.crash(ctx, "create", t)
+	return ct, nil
+}
+
+// Get returns the task. Tasks created before the daemon restarted are only
+// known to the wrapped runtime and are wrapped again when they are first
+// requested.
+func (r *Runtime) Get(ctx context.Context, id string) (runtime.Task, error) {
+	if t, err := r.tasks.Get(ctx, id); err == nil {
+		return t, nil
+	}
+	t, err := r.runtime.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	ct := &task{Task: t, r: r}
+	if err := r.tasks.Add(ctx, ct); err != nil {
+		return r.tasks.Get(ctx, id)
+	}
+	return ct, nil
+}
+
+func (r *Runtime) Tasks(ctx context.Context) ([]runtime.Task, error) {
+	return r.tasks.GetAll(ctx)
+}
+
+func (r *Runtime) Delete(ctx context.Context, t runtime.Task) (*runtime.Exit, error) {
+	if err := r.inject(ctx, "delete"); err != nil {
+		return nil, err
+	}
+	ct, ok := t.(*task)
+	if !ok {
+		return nil, fmt.Errorf("task cannot be cast as *chaos.task")
+	}
+	exit, err := r.runtime.Delete(ctx, ct.Task)
+	if err != nil {
+		return nil, err
+	}
+	r.tasks.Delete(ctx, t)
+	return exit, nil
+}
+
+// SetFaults replaces the faults injected into task operations
+func (r *Runtime) SetFaults(faults []chaosapi.Fault) error {
+	m := make(map[string]chaosapi.Fault)
+	for _, f := range faults {
+		if _, ok := operations[f.Operation]; !ok {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "unknown operation %q", f.Operation)
+		}
+		if f.ErrorRate < 0 || f.ErrorRate > 1 || f.ShimCrashRate < 0 || f.ShimCrashRate > 1 {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "rates of operation %q must be between 0 and 1", f.Operation)
+		}
+		if f.Delay < 0 {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "delay of operation %q must not be negative", f.Operation)
+		}
+		if f.Operation == exitOperation && (f.Delay != 0 || f.ShimCrashRate != 0) {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "exit events can only be dropped")
+		}
+		if _, ok := m[f.Operation]; ok {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "duplicate fault for operation %q", f.Operation)
+		}
+		m[f.Operation] = f
+	}
+	r.mu.Lock()
+	r.faults = m
+	r.mu.Unlock()
+	return nil
+}
+
+// Faults returns the faults injected into task operations
+func (r *Runtime) Faults() []chaosapi.Fault {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var faults []chaosapi.Fault
+	for _, f := range r.faults {
+		faults = append(faults, f)
+	}
+	sort.Slice(faults, func(i, j int) bool {
+		return faults[i].Operation < faults[j].Operation
+	})
+	return faults
+}
+
+// inject delays the operation and decides whether it fails
+func (r *Runtime) inject(ctx context.Context, op string) error {
+	r.mu.Lock()
+	f, ok := r.faults[op]
+	fail := ok && r.rand.Float64() < f.ErrorRate
+	r.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	if f.Delay > 0 {
+		select {
+		case <-time.After(f.Delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if fail {
+		log.G(ctx).WithField("operation", op).Debug("chaos: injecting failure")
+		return errors.Wrapf(errdefs.ErrUnavailable, "chaos: injected %s failure", op)
+	}
+	return nil
+}
+
+// crash kills the shim of the task after the operation if it is decided to
+// crash and the wrapped runtime supports killing shims
+func (r *Runtime) crash(ctx context.Context, op string, t runtime.Task) {
+	r.mu.Lock()
+	f, ok := r.faults[op]
+	crash := ok && r.rand.Float64() < f.ShimCrashRate
+	r.mu.Unlock()
+	if !crash {
+		return
+	}
+	k, ok := t.(interface {
+		KillShim(context.Context) error
+	})
+	if !ok {
+		log.G(ctx).WithField("operation", op).Warn("chaos: runtime does not support shim crashes")
+		return
+	}
+	log.G(ctx).WithField("operation", op).Debug("chaos: crashing shim")
+	if err := k.KillShim(ctx); err != nil {
+		log.G(ctx).WithError(err).Warn("chaos: failed to kill shim")
+	}
+}
+
+// dropExit drops the exit events of the processes of the tasks of the
+// runtime at the error rate of the exit fault. The tasks created before the
+// daemon restarted are only known once they are requested again.
+func (r *Runtime) dropExit(ctx context.Context, envelope *eventsapi.Envelope) error {
+	r.mu.Lock()
+	f, ok := r.faults[exitOperation]
+	drop := ok && r.rand.Float64() < f.ErrorRate
+	r.mu.Unlock()
+	if !drop {
+		return nil
+	}
+	v, err := typeurl.UnmarshalAny(envelope.Event)
+	if err != nil {
+		return err
+	}
+	e, ok := v.(*eventsapi.TaskExit)
+	if !ok {
+		return nil
+	}
+	if _, err := r.tasks.Get(namespaces.WithNamespace(ctx, envelope.Namespace), e.ContainerID); err != nil {
+		return nil
+	}
+	log.G(ctx).WithField("id", e.ContainerID).Debug("chaos: dropping exit event")
+	return events.ErrDropped
+}
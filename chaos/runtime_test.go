@@ -0,0 +1,103 @@
+package chaos
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	chaosapi "github.com/containerd/containerd/api/services/chaos/v1"
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/containerd/typeurl"
+)
+
+func newTestRuntime() *Runtime {
+	return &Runtime{
+		faults: make(map[string]chaosapi.Fault),
+		rand:   rand.New(rand.NewSource(1)),
+	}
+}
+
+func TestSetFaultsInvalid(t *testing.T) {
+	r := newTestRuntime()
+	for _, faults := range [][]chaosapi.Fault{
+		{{Operation: "unknown"}},
+		{{Operation: "start", ErrorRate: 2}},
+		{{Operation: "start", ShimCrashRate: -1}},
+		{{Operation: "start", Delay: -time.Second}},
+		{{Operation: "start"}, {Operation: "start"}},
+		{{Operation: "exit", Delay: time.Second}},
+	} {
+		if err := r.SetFaults(faults); !errdefs.IsInvalidArgument(err) {
+			t.Fatalf("expected invalid argument for %v, got %v", faults, err)
+		}
+	}
+}
+
+func TestInject(t *testing.T) {
+	r := newTestRuntime()
+	ctx := context.Background()
+	if err := r.inject(ctx, "start"); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.SetFaults([]chaosapi.Fault{
+		{Operation: "start", ErrorRate: 1},
+		{Operation: "kill", Delay: 10 * time.Millisecond},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.inject(ctx, "start"); !errdefs.IsUnavailable(err) {
+		t.Fatalf("expected unavailable error, got %v", err)
+	}
+	start := time.Now()
+	if err := r.inject(ctx, "kill"); err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(start) < 10*time.Millisecond {
+		t.Fatal("expected kill to be delayed")
+	}
+	if faults := r.Faults(); len(faults) != 2 || faults[0].Operation != "kill" {
+		t.Fatalf("unexpected faults %v", faults)
+	}
+}
+
+// idTask is a task that only has an id
+type idTask struct {
+	runtime.Task
+	id string
+}
+
+func (t idTask) ID() string {
+	return t.id
+}
+
+func TestDropExit(t *testing.T) {
+	r := newTestRuntime()
+	r.tasks = runtime.NewTaskList()
+	if err := r.tasks.AddWithNamespace("default", &task{Task: idTask{id: "web"}, r: r}); err != nil {
+		t.Fatal(err)
+	}
+	envelope := func(id string) *eventsapi.Envelope {
+		any, err := typeurl.MarshalAny(&eventsapi.TaskExit{ContainerID: id, ID: id})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &eventsapi.Envelope{Namespace: "default", Topic: runtime.TaskExitEventTopic, Event: any}
+	}
+	ctx := context.Background()
+	if err := r.dropExit(ctx, envelope("web")); err != nil {
+		t.Fatalf("expected the exit to be kept without fault, got %v", err)
+	}
+	if err := r.SetFaults([]chaosapi.Fault{{Operation: "exit", ErrorRate: 1}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.dropExit(ctx, envelope("web")); err != events.ErrDropped {
+		t.Fatalf("expected the exit to be dropped, got %v", err)
+	}
+	if err := r.dropExit(ctx, envelope("other")); err != nil {
+		t.Fatalf("expected the exit of a task of another runtime to be kept, got %v", err)
+	}
+}
@@ -0,0 +1,55 @@
+package chaos
+
+import (
+	chaosapi "github.com/containerd/containerd/api/services/chaos/v1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/plugin"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+var _ chaosapi.ChaosServer = &service{}
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.GRPCPlugin,
+		ID:   "chaos",
+		Requires: []plugin.PluginType{
+			plugin.RuntimePlugin,
+		},
+		Init: func(ic *plugin.InitContext) (interface{}, error) {
+			runtimes, err := ic.GetAll(plugin.RuntimePlugin)
+			if err != nil {
+				return nil, err
+			}
+			r, ok := runtimes["chaos"].(*Runtime)
+			if !ok {
+				return nil, plugin.SkipPlugin
+			}
+			return &service{runtime: r}, nil
+		},
+	})
+}
+
+type service struct {
+	runtime *Runtime
+}
+
+func (s *service) Register(server *grpc.Server) error {
+	chaosapi.RegisterChaosServer(server, s)
+	return nil
+}
+
+func (s *service) Set(ctx context.Context, r *chaosapi.SetFaultsRequest) (*empty.Empty, error) {
+	if err := s.runtime.SetFaults(r.Faults); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *service) Get(ctx context.Context, _ *empty.Empty) (*chaosapi.GetFaultsResponse, error) {
+	return &chaosapi.GetFaultsResponse{
+		Faults: s.runtime.Faults(),
+	}, nil
+}
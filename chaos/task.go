@@ -0,0 +1,60 @@
+package chaos
+
+import (
+	"context"
+
+	"github.com/containerd/containerd/runtime"
+)
+
+// task injects the faults of its runtime into the operations of the wrapped
+// task
+type task struct {
+	runtime.Task
+
+	r *Runtime
+}
+
+func (t *task) Start(ctx context.Context) error {
+	if err := t.r.inject(ctx, "start"); err != nil {
+		return err
+	}
+	err := t.Task.Start(ctx)
+	t.r.crash(ctx, "start", t.Task)
+	return err
+}
+
+func (t *task) Kill(ctx context.Context, signal uint32, all bool) error {
+	if err := t.r.inject(ctx, "kill"); err != nil {
+		return err
+	}
+	err := t.Task.Kill(ctx, signal, all)
+	t.r.crash(ctx, "kill", t.Task)
+	return err
+}
+
+func (t *task) Exec(ctx context.Context, id string, opts runtime.ExecOpts) (runtime.Process, error) {
+	if err := t.r.inject(ctx, "exec"); err != nil {
+		return nil, err
+	}
+	p, err := t.Task.Exec(ctx, id, opts)
+	t.r.crash(ctx, "exec", t.Task)
+	return p, err
+}
+
+func (t *task) Pause(ctx context.Context) error {
+	if err := t.r.inject(ctx, "pause"); err != nil {
+		return err
+	}
+	err := t.Task.Pause(ctx)
+	t.r.crash(ctx, "pause", t.Task)
+	return err
+}
+
+func (t *task) Resume(ctx context.Context) error {
+	if err := t.r.inject(ctx, "resume"); err != nil {
+		return err
+	}
+	err := t.Task.Resume(ctx)
+	t.r.crash(ctx, "resume", t.Task)
+	return err
+}
@@ -0,0 +1,56 @@
+package checkpoints
+
+import (
+	"context"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// Checkpoint is a record of the checkpoint of a container, its target is the
+// index in the content store of the blobs the container is restored from.
+type Checkpoint struct {
+	// Name uniquely identifies the checkpoint in a namespace.
+	//
+	// This property is required and cannot be changed after creation.
+	Name string
+
+	// Labels provide metadata extension for a checkpoint.
+	//
+	// These are optional and set on creation.
+	Labels map[string]string
+
+	// Target is the descriptor of the checkpoint index in the content store.
+	//
+	// This property is required and immutable.
+	Target ocispec.Descriptor
+
+	// ContainerID is the id of the container that was checkpointed.
+	//
+	// This property is optional but immutable.
+	ContainerID string
+
+	// Parent is the digest of the checkpoint the checkpoint was taken
+	// incrementally from, if any.
+	//
+	// This property is optional but immutable.
+	Parent string
+
+	// CreatedAt is the time at which the checkpoint was created.
+	CreatedAt time.Time
+}
+
+// Store interacts with the underlying storage backend for checkpoints.
+type Store interface {
+	Get(ctx context.Context, name string) (Checkpoint, error)
+
+	// List returns checkpoints that match one or more of the provided
+	// filters.
+	List(ctx context.Context, filters ...string) ([]Checkpoint, error)
+
+	Create(ctx context.Context, checkpoint Checkpoint) (Checkpoint, error)
+
+	// Delete the checkpoint record with the name, the blobs of the
+	// checkpoint are left in the content store.
+	Delete(ctx context.Context, name string) error
+}
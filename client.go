@@ -7,27 +7,40 @@ import (
 	"net/http"
 	"runtime"
 	"strconv"
-	"sync"
+	"strings"
 	"time"
 
+	checkpointsapi "github.com/containerd/containerd/api/services/checkpoints/v1"
 	containersapi "github.com/containerd/containerd/api/services/containers/v1"
 	contentapi "github.com/containerd/containerd/api/services/content/v1"
+	daemonapi "github.com/containerd/containerd/api/services/daemon/v1"
+	diagapi "github.com/containerd/containerd/api/services/diag/v1"
 	diffapi "github.com/containerd/containerd/api/services/diff/v1"
+	dnsapi "github.com/containerd/containerd/api/services/dns/v1"
 	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	fifosapi "github.com/containerd/containerd/api/services/fifos/v1"
 	imagesapi "github.com/containerd/containerd/api/services/images/v1"
+	introspectionapi "github.com/containerd/containerd/api/services/introspection/v1"
+	metadataapi "github.com/containerd/containerd/api/services/metadata/v1"
+	migrationapi "github.com/containerd/containerd/api/services/migration/v1"
 	namespacesapi "github.com/containerd/containerd/api/services/namespaces/v1"
+	profilesapi "github.com/containerd/containerd/api/services/profiles/v1"
+	sandboxesapi "github.com/containerd/containerd/api/services/sandboxes/v1"
 	snapshotapi "github.com/containerd/containerd/api/services/snapshot/v1"
+	specapi "github.com/containerd/containerd/api/services/spec/v1"
+	statsapi "github.com/containerd/containerd/api/services/stats/v1"
+	stdioapi "github.com/containerd/containerd/api/services/stdio/v1"
 	"github.com/containerd/containerd/api/services/tasks/v1"
 	versionservice "github.com/containerd/containerd/api/services/version/v1"
 	"github.com/containerd/containerd/containers"
 	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/distribution"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/images"
 	"github.com/containerd/containerd/plugin"
 	"github.com/containerd/containerd/reference"
 	"github.com/containerd/containerd/remotes"
 	"github.com/containerd/containerd/remotes/docker"
-	"github.com/containerd/containerd/remotes/docker/schema1"
 	contentservice "github.com/containerd/containerd/services/content"
 	"github.com/containerd/containerd/services/diff"
 	diffservice "github.com/containerd/containerd/services/diff"
@@ -40,6 +53,7 @@ import (
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
@@ -69,17 +83,41 @@ func New(address string, opts ...ClientOpt) (*Client, error) {
 		grpc.WithBackoffMaxDelay(3 * time.Second),
 		grpc.WithDialer(Dialer),
 	}
+	if copts.tls != nil {
+		gopts = []grpc.DialOption{
+			grpc.WithBlock(),
+			grpc.WithTransportCredentials(credentials.NewTLS(copts.tls)),
+			grpc.WithTimeout(60 * time.Second),
+			grpc.FailOnNonTempDialError(true),
+			grpc.WithBackoffMaxDelay(3 * time.Second),
+		}
+		address = strings.TrimPrefix(address, "tcp://")
+	}
 	if len(copts.dialOptions) > 0 {
 		gopts = copts.dialOptions
 	}
+	var (
+		unaries []grpc.UnaryClientInterceptor
+		streams []grpc.StreamClientInterceptor
+	)
 	if copts.defaultns != "" {
 		unary, stream := newNSInterceptors(copts.defaultns)
+		unaries, streams = append(unaries, unary), append(streams, stream)
+	}
+	if copts.retries > 0 {
+		unary, stream := newRetryInterceptors(copts.retries, copts.retryDelay)
+		unaries, streams = append(unaries, unary), append(streams, stream)
+	}
+	if len(unaries) > 0 {
 		gopts = append(gopts,
-			grpc.WithUnaryInterceptor(unary),
-			grpc.WithStreamInterceptor(stream),
+			grpc.WithUnaryInterceptor(chainUnaryInterceptors(unaries)),
+			grpc.WithStreamInterceptor(chainStreamInterceptors(streams)),
 		)
 	}
-	conn, err := grpc.Dial(DialAddress(address), gopts...)
+	if copts.tls == nil {
+		address = DialAddress(address)
+	}
+	conn, err := grpc.Dial(address, gopts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to dial %q", address)
 	}
@@ -185,6 +223,14 @@ type RemoteContext struct {
 	// manifests. If this option is false then any image which resolves
 	// to schema 1 will return an error since schema 1 is not supported.
 	ConvertSchema1 bool
+
+	// Platform selects the manifest of manifest lists, such as
+	// "linux/arm64". The platform of the host is used if it is empty.
+	Platform string
+
+	// Limiter bounds the concurrent downloads of the pull and their rate.
+	// Downloads are not limited if it is nil.
+	Limiter *remotes.Limiter
 }
 
 func defaultRemoteContext() *RemoteContext {
@@ -204,48 +250,17 @@ func (c *Client) Pull(ctx context.Context, ref string, opts ...RemoteOpts) (Imag
 			return nil, err
 		}
 	}
-	store := c.ContentStore()
-
-	name, desc, err := pullCtx.Resolver.Resolve(ctx, ref)
-	if err != nil {
-		return nil, err
+	resolver := pullCtx.Resolver
+	if pullCtx.Limiter != nil {
+		resolver = pullCtx.Limiter.Resolver(resolver)
 	}
-	fetcher, err := pullCtx.Resolver.Fetcher(ctx, name)
+	imgrec, err := distribution.Fetch(ctx, c.ContentStore(), resolver, ref, pullCtx.Platform, pullCtx.ConvertSchema1, pullCtx.BaseHandlers...)
 	if err != nil {
 		return nil, err
 	}
 
-	var (
-		schema1Converter *schema1.Converter
-		handler          images.Handler
-	)
-	if desc.MediaType == images.MediaTypeDockerSchema1Manifest && pullCtx.ConvertSchema1 {
-		schema1Converter = schema1.NewConverter(store, fetcher)
-		handler = images.Handlers(append(pullCtx.BaseHandlers, schema1Converter)...)
-	} else {
-		handler = images.Handlers(append(pullCtx.BaseHandlers,
-			remotes.FetchHandler(store, fetcher),
-			images.ChildrenHandler(store))...,
-		)
-	}
-
-	if err := images.Dispatch(ctx, handler, desc); err != nil {
-		return nil, err
-	}
-	if schema1Converter != nil {
-		desc, err = schema1Converter.Convert(ctx)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	imgrec := images.Image{
-		Name:   name,
-		Target: desc,
-	}
-
 	is := c.ImageService()
-	if updated, err := is.Update(ctx, imgrec, "target"); err != nil {
+	if updated, err := is.Update(ctx, imgrec, "target", "labels."+images.PlatformLabel); err != nil {
 		if !errdefs.IsNotFound(err) {
 			return nil, err
 		}
@@ -281,48 +296,7 @@ func (c *Client) Push(ctx context.Context, ref string, desc ocispec.Descriptor,
 		}
 	}
 
-	pusher, err := pushCtx.Resolver.Pusher(ctx, ref)
-	if err != nil {
-		return err
-	}
-
-	var m sync.Mutex
-	manifestStack := []ocispec.Descriptor{}
-
-	filterHandler := images.HandlerFunc(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
-		switch desc.MediaType {
-		case images.MediaTypeDockerSchema2Manifest, ocispec.MediaTypeImageManifest,
-			images.MediaTypeDockerSchema2ManifestList, ocispec.MediaTypeImageIndex:
-			m.Lock()
-			manifestStack = append(manifestStack, desc)
-			m.Unlock()
-			return nil, images.StopHandler
-		default:
-			return nil, nil
-		}
-	})
-
-	cs := c.ContentStore()
-	pushHandler := remotes.PushHandler(cs, pusher)
-
-	handlers := append(pushCtx.BaseHandlers,
-		images.ChildrenHandler(cs),
-		filterHandler,
-		pushHandler,
-	)
-
-	if err := images.Dispatch(ctx, images.Handlers(handlers...), desc); err != nil {
-		return err
-	}
-
-	// Iterate in reverse order as seen, parent always uploaded after child
-	for i := len(manifestStack) - 1; i >= 0; i-- {
-		_, err := pushHandler(ctx, manifestStack[i])
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+	return distribution.Push(ctx, c.ContentStore(), pushCtx.Resolver, ref, desc, pushCtx.BaseHandlers...)
 }
 
 // GetImage returns an existing image
@@ -398,12 +372,90 @@ func (c *Client) VersionService() versionservice.VersionClient {
 	return versionservice.NewVersionClient(c.conn)
 }
 
+func (c *Client) MetadataService() metadataapi.MetadataClient {
+	return metadataapi.NewMetadataClient(c.conn)
+}
+
+// DaemonService returns the service reloading the configuration of the
+// daemon
+func (c *Client) DaemonService() daemonapi.DaemonClient {
+	return daemonapi.NewDaemonClient(c.conn)
+}
+
+// DiagService returns the service collecting the support bundles of the
+// daemon
+func (c *Client) DiagService() diagapi.DiagClient {
+	return diagapi.NewDiagClient(c.conn)
+}
+
+func (c *Client) IntrospectionService() introspectionapi.IntrospectionClient {
+	return introspectionapi.NewIntrospectionClient(c.conn)
+}
+
+// StatsService returns the service aggregating the resource usage of groups
+// of containers
+func (c *Client) StatsService() statsapi.StatsClient {
+	return statsapi.NewStatsClient(c.conn)
+}
+
+// DNSService returns the service managing the hosts and resolv.conf files
+// of containers
+func (c *Client) DNSService() dnsapi.DNSClient {
+	return dnsapi.NewDNSClient(c.conn)
+}
+
+// SpecService returns the service generating and validating the specs of
+// containers
+func (c *Client) SpecService() specapi.SpecClient {
+	return specapi.NewSpecClient(c.conn)
+}
+
+// CheckpointService returns the service recording the checkpoints of
+// containers stored in the content store
+func (c *Client) CheckpointService() checkpointsapi.CheckpointsClient {
+	return checkpointsapi.NewCheckpointsClient(c.conn)
+}
+
+// SandboxService returns the service managing the sandboxes whose
+// namespaces are shared by their containers
+func (c *Client) SandboxService() sandboxesapi.SandboxesClient {
+	return sandboxesapi.NewSandboxesClient(c.conn)
+}
+
+// ProfileService returns the service registering the seccomp and AppArmor
+// profiles referenced by containers
+func (c *Client) ProfileService() profilesapi.ProfilesClient {
+	return profilesapi.NewProfilesClient(c.conn)
+}
+
+// FifosService returns the service allocating the stdio fifos of the
+// processes of containers
+func (c *Client) FifosService() fifosapi.FifosClient {
+	return fifosapi.NewFifosClient(c.conn)
+}
+
+// StdioService returns the service proxying the stdio of processes over
+// the connection
+func (c *Client) StdioService() stdioapi.StdioClient {
+	return stdioapi.NewStdioClient(c.conn)
+}
+
+// MigrationService returns the service streaming content to other
+// containerd daemons
+func (c *Client) MigrationService() migrationapi.MigrationClient {
+	return migrationapi.NewMigrationClient(c.conn)
+}
+
 // Version of containerd
 type Version struct {
 	// Version number
 	Version string
 	// Revision from git that was built
 	Revision string
+	// Runtimes are the ids of the runtimes of the daemon
+	Runtimes []string
+	// APIVersion is the version of the GRPC API, as major.minor
+	APIVersion string
 }
 
 // Version returns the version of containerd that the client is connected to
@@ -413,8 +465,10 @@ func (c *Client) Version(ctx context.Context) (Version, error) {
 		return Version{}, err
 	}
 	return Version{
-		Version:  response.Version,
-		Revision: response.Revision,
+		Version:    response.Version,
+		Revision:   response.Revision,
+		Runtimes:   response.Runtimes,
+		APIVersion: response.APIVersion,
 	}, nil
 }
 
@@ -1,6 +1,9 @@
 package containerd
 
 import (
+	"crypto/tls"
+	"time"
+
 	"github.com/containerd/containerd/images"
 	"github.com/containerd/containerd/remotes"
 	"google.golang.org/grpc"
@@ -9,6 +12,9 @@ import (
 type clientOpts struct {
 	defaultns   string
 	dialOptions []grpc.DialOption
+	tls         *tls.Config
+	retries     int
+	retryDelay  time.Duration
 }
 
 // ClientOpt allows callers to set options on the containerd client
@@ -33,6 +39,28 @@ func WithDialOpts(opts []grpc.DialOption) ClientOpt {
 	}
 }
 
+// WithTLS connects to containerd over TCP secured by the TLS configuration,
+// the address being the host and port of its TCP listener
+func WithTLS(config *tls.Config) ClientOpt {
+	return func(c *clientOpts) error {
+		c.tls = config
+		return nil
+	}
+}
+
+// WithRetry retries the calls failing because the daemon is unavailable,
+// such as while it restarts, up to attempts times, backing off exponentially
+// between the attempts up to maxDelay. Calls changing state are retried as
+// well, so a retried call may fail with AlreadyExists or NotFound when the
+// connection was lost after the daemon handled the first attempt.
+func WithRetry(attempts int, maxDelay time.Duration) ClientOpt {
+	return func(c *clientOpts) error {
+		c.retries = attempts
+		c.retryDelay = maxDelay
+		return nil
+	}
+}
+
 // RemoteOpts allows the caller to set distribution options for a remote
 type RemoteOpts func(*Client, *RemoteContext) error
 
@@ -60,6 +88,24 @@ func WithSchema1Conversion(client *Client, c *RemoteContext) error {
 	return nil
 }
 
+// WithPlatform selects the manifest of manifest lists built for the
+// platform, such as "linux/arm64", rather than the platform of the host.
+func WithPlatform(platform string) RemoteOpts {
+	return func(client *Client, c *RemoteContext) error {
+		c.Platform = platform
+		return nil
+	}
+}
+
+// WithLimiter bounds the number of concurrent downloads of the pull and
+// their rate. A limiter may be shared by pulls to apply across them.
+func WithLimiter(limiter *remotes.Limiter) RemoteOpts {
+	return func(client *Client, c *RemoteContext) error {
+		c.Limiter = limiter
+		return nil
+	}
+}
+
 // WithResolver specifies the resolver to use.
 func WithResolver(resolver remotes.Resolver) RemoteOpts {
 	return func(client *Client, c *RemoteContext) error {
@@ -49,20 +49,35 @@ func main() {
 		},
 		cli.StringFlag{
 			Name:  "namespace,n",
-			Usage: "namespace that owns the task",
+			Usage: "namespace that owns the task, empty to bind the shim to the namespace of the task it creates",
 		},
 		cli.StringFlag{
 			Name:  "socket,s",
-			Usage: "abstract socket path to serve on",
+			Usage: "abstract socket path to serve on, or vsock://port in a VM",
 		},
 		cli.StringFlag{
 			Name:  "address,a",
-			Usage: "grpc address back to containerd",
+			Usage: "grpc address back to containerd, or vsock://[cid:]port in a VM",
 		},
 		cli.StringFlag{
 			Name:  "workdir,w",
 			Usage: "path used to store large temporary data",
 		},
+		cli.IntFlag{
+			Name:  "io-buffer-size",
+			Usage: "size in bytes of the buffers copying the stdio of processes",
+			Value: shim.DefaultIOBufferSize,
+		},
+		cli.IntFlag{
+			Name:  "exec-max-exited",
+			Usage: "number of exited exec processes whose exit status is retained until they are deleted, -1 for no limit",
+			Value: shim.DefaultMaxExitedExecs,
+		},
+		cli.DurationFlag{
+			Name:  "exec-ttl",
+			Usage: "time the exit status of an exec process is retained until it is deleted, -1s to retain it forever",
+			Value: shim.DefaultExitedExecTTL,
+		},
 	}
 	app.Before = func(context *cli.Context) error {
 		if context.GlobalBool("debug") {
@@ -81,7 +96,8 @@ func main() {
 		if err != nil {
 			return err
 		}
-		server := newServer()
+		socket := context.GlobalString("socket")
+		server := newServer(socket)
 		e, err := connectEvents(context.GlobalString("address"))
 		if err != nil {
 			return err
@@ -90,6 +106,11 @@ func main() {
 			path,
 			context.GlobalString("namespace"),
 			context.GlobalString("workdir"),
+			context.GlobalInt("io-buffer-size"),
+			shim.ExecRetention{
+				MaxExited: context.GlobalInt("exec-max-exited"),
+				TTL:       context.GlobalDuration("exec-ttl"),
+			},
 			&remoteEventsPublisher{client: e},
 		)
 		if err != nil {
@@ -97,7 +118,6 @@ func main() {
 		}
 		logrus.Debug("registering grpc server")
 		shimapi.RegisterShimServer(server, sv)
-		socket := context.GlobalString("socket")
 		if err := serve(server, socket); err != nil {
 			return err
 		}
@@ -116,10 +136,13 @@ func serve(server *grpc.Server, path string) error {
 		l   net.Listener
 		err error
 	)
-	if path == "" {
+	switch {
+	case path == "":
 		l, err = net.FileListener(os.NewFile(3, "socket"))
 		path = "[inherited from parent]"
-	} else {
+	case isVsock(path):
+		l, err = listenVsock(path)
+	default:
 		l, err = net.Listen("unix", "\x00"+path)
 	}
 	if err != nil {
@@ -172,7 +195,11 @@ func dumpStacks() {
 }
 
 func connectEvents(address string) (eventsapi.EventsClient, error) {
-	conn, err := connect(address, containerd.Dialer)
+	dialer := containerd.Dialer
+	if isVsock(address) {
+		dialer = vsockDialer(address)
+	}
+	conn, err := connect(address, dialer)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to dial %q", address)
 	}
@@ -4,6 +4,8 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -32,10 +34,40 @@ func setupSignals() (chan os.Signal, error) {
 	return signals, nil
 }
 
-func newServer() *grpc.Server {
+// newServer returns the server of the shim API on the socket. The peer
+// credentials of vsock connections, from the host of the VM running the
+// shim, are not checked.
+func newServer(socket string) *grpc.Server {
+	if isVsock(socket) {
+		return grpc.NewServer()
+	}
 	return grpc.NewServer(grpc.Creds(NewUnixSocketCredentials(0, 0)))
 }
 
+func isVsock(address string) bool {
+	return strings.HasPrefix(address, sys.VsockScheme)
+}
+
+// listenVsock listens on the port of the vsock address
+func listenVsock(address string) (net.Listener, error) {
+	_, port, err := sys.ParseVsockAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	return sys.ListenVsock(port)
+}
+
+// vsockDialer connects to the vsock address, ignoring the address of grpc
+func vsockDialer(address string) func(string, time.Duration) (net.Conn, error) {
+	return func(string, time.Duration) (net.Conn, error) {
+		cid, port, err := sys.ParseVsockAddress(address)
+		if err != nil {
+			return nil, err
+		}
+		return sys.DialVsock(cid, port)
+	}
+}
+
 type unixSocketCredentials struct {
 	uid        int
 	gid        int
@@ -3,13 +3,17 @@
 package main
 
 import (
+	"net"
 	"os"
 	"os/signal"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc"
 
 	"github.com/containerd/containerd/reaper"
 	runc "github.com/containerd/go-runc"
+	"github.com/pkg/errors"
 )
 
 // setupSignals creates a new signal handler for all signals and sets the shim as a
@@ -23,6 +27,20 @@ func setupSignals() (chan os.Signal, error) {
 	return signals, nil
 }
 
-func newServer() *grpc.Server {
+func newServer(socket string) *grpc.Server {
 	return grpc.NewServer()
 }
+
+func isVsock(address string) bool {
+	return strings.HasPrefix(address, "vsock://")
+}
+
+func listenVsock(address string) (net.Listener, error) {
+	return nil, errors.New("vsock is only supported on linux")
+}
+
+func vsockDialer(address string) func(string, time.Duration) (net.Conn, error) {
+	return func(string, time.Duration) (net.Conn, error) {
+		return nil, errors.New("vsock is only supported on linux")
+	}
+}
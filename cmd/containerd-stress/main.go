@@ -47,6 +47,10 @@ func main() {
 			Value: 1 * time.Minute,
 			Usage: "set the duration of the stress test",
 		},
+		cli.IntFlag{
+			Name:  "scale",
+			Usage: "start this many pause containers at once instead of running the stress test",
+		},
 	}
 	app.Before = func(context *cli.Context) error {
 		if context.GlobalBool("debug") {
@@ -59,6 +63,10 @@ func main() {
 			Address:     context.GlobalString("address"),
 			Duration:    context.GlobalDuration("duration"),
 			Concurrency: context.GlobalInt("concurrent"),
+			Scale:       context.GlobalInt("scale"),
+		}
+		if config.Scale > 0 {
+			return scale(config)
 		}
 		return test(config)
 	}
@@ -72,6 +80,7 @@ type config struct {
 	Concurrency int
 	Duration    time.Duration
 	Address     string
+	Scale       int
 }
 
 func (c config) newClient() (*containerd.Client, error) {
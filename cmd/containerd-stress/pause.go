@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"runtime"
+)
+
+const (
+	elfHeaderSize  = 64
+	progHeaderSize = 56
+	loadAddress    = 0x400000
+)
+
+// pauseCode loops on the pause(2) syscall so the process sleeps until it is
+// signaled:
+//
+//	mov $34, %eax
+//	syscall
+//	jmp -9
+var pauseCode = []byte{0xb8, 0x22, 0x00, 0x00, 0x00, 0x0f, 0x05, 0xeb, 0xf7}
+
+// pauseBinary returns a minimal static ELF executable that does nothing but
+// sleep. It is written into the stub rootfs of the scale test containers so
+// that no image has to be pulled or unpacked for them.
+func pauseBinary() ([]byte, error) {
+	if runtime.GOARCH != "amd64" {
+		return nil, fmt.Errorf("no pause binary for %s", runtime.GOARCH)
+	}
+	var (
+		buf   bytes.Buffer
+		entry = uint64(loadAddress + elfHeaderSize + progHeaderSize)
+		size  = uint64(elfHeaderSize + progHeaderSize + len(pauseCode))
+	)
+	// ELF64 header
+	buf.Write([]byte{0x7f, 'E', 'L', 'F', 2, 1, 1, 0})
+	buf.Write(make([]byte, 8))
+	binary.Write(&buf, binary.LittleEndian, struct {
+		Type, Machine                              uint16
+		Version                                    uint32
+		Entry, Phoff, Shoff                        uint64
+		Flags                                      uint32
+		Ehsize, Phentsize, Phnum, Shentsize, Shnum uint16
+		Shstrndx                                   uint16
+	}{
+		Type:      2,  // ET_EXEC
+		Machine:   62, // EM_X86_64
+		Version:   1,
+		Entry:     entry,
+		Phoff:     elfHeaderSize,
+		Ehsize:    elfHeaderSize,
+		Phentsize: progHeaderSize,
+		Phnum:     1,
+	})
+	// a single PT_LOAD segment mapping the whole file
+	binary.Write(&buf, binary.LittleEndian, struct {
+		Type, Flags          uint32
+		Offset, Vaddr, Paddr uint64
+		Filesz, Memsz, Align uint64
+	}{
+		Type:   1,     // PT_LOAD
+		Flags:  4 | 1, // PF_R|PF_X
+		Vaddr:  loadAddress,
+		Paddr:  loadAddress,
+		Filesz: size,
+		Memsz:  size,
+		Align:  0x1000,
+	})
+	buf.Write(pauseCode)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,200 @@
+// +build !windows
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/containerd/containerd"
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/namespaces"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
+)
+
+// stubDirs are created in the stub rootfs as mount points for the default spec
+var stubDirs = []string{
+	"proc",
+	"dev",
+	"dev/pts",
+	"dev/shm",
+	"dev/mqueue",
+	"sys",
+	"sys/fs/cgroup",
+}
+
+// stubRootfs writes a rootfs holding only the pause binary to a temporary
+// directory
+func stubRootfs() (string, error) {
+	pause, err := pauseBinary()
+	if err != nil {
+		return "", err
+	}
+	dir, err := ioutil.TempDir("", "containerd-stress-")
+	if err != nil {
+		return "", err
+	}
+	for _, d := range stubDirs {
+		if err := os.MkdirAll(filepath.Join(dir, d), 0755); err != nil {
+			os.RemoveAll(dir)
+			return "", err
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "pause"), pause, 0755); err != nil {
+		os.RemoveAll(dir)
+		return "", err
+	}
+	return dir, nil
+}
+
+// scale creates and starts c.Scale containers running the pause binary from a
+// shared read-only stub rootfs, keeps them all running at once and then tears
+// them down, reporting the time taken by each phase
+func scale(c config) error {
+	ctx := namespaces.WithNamespace(context.Background(), "stress")
+	client, err := c.newClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if err := cleanup(ctx, client); err != nil {
+		return err
+	}
+	rootfs, err := stubRootfs()
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(rootfs)
+	spec, err := containerd.GenerateSpec(
+		containerd.WithProcessArgs("/pause"),
+		containerd.WithRootFSPath(rootfs, true),
+	)
+	if err != nil {
+		return err
+	}
+
+	// count the task events delivered while the containers are running to
+	// check that the fan-out keeps up
+	var events uint64
+	ectx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := client.EventService().Subscribe(ectx, &eventsapi.SubscribeRequest{
+		Filters: []string{`topic~="/tasks/"`},
+	})
+	if err != nil {
+		return err
+	}
+	go func() {
+		for {
+			if _, err := stream.Recv(); err != nil {
+				return
+			}
+			atomic.AddUint64(&events, 1)
+		}
+	}()
+
+	var (
+		mu    sync.Mutex
+		tasks []containerd.Task
+		ids   []string
+	)
+	logrus.Infof("starting %d containers", c.Scale)
+	failures := c.parallel(c.Scale, func(i int) error {
+		id := fmt.Sprintf("scale-%d", i)
+		task, err := startPause(ctx, client, id, spec)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		tasks = append(tasks, task)
+		ids = append(ids, id)
+		mu.Unlock()
+		return nil
+	}, "create/start")
+
+	start := time.Now()
+	list, err := client.TaskService().List(ctx, nil)
+	if err != nil {
+		return err
+	}
+	logrus.Infof("listed %d tasks in %0.3f seconds", len(list.Tasks), time.Since(start).Seconds())
+
+	failures += c.parallel(len(tasks), func(i int) error {
+		if _, err := tasks[i].Delete(ctx, containerd.WithProcessKill); err != nil {
+			return err
+		}
+		container, err := client.LoadContainer(ctx, ids[i])
+		if err != nil {
+			return err
+		}
+		return container.Delete(ctx)
+	}, "kill/delete")
+	logrus.WithField("failures", failures).Infof("received %d task events", atomic.LoadUint64(&events))
+	if failures > 0 {
+		return fmt.Errorf("%d operations failed", failures)
+	}
+	return nil
+}
+
+// startPause creates and starts a pause container
+func startPause(ctx context.Context, client *containerd.Client, id string, spec *specs.Spec) (containerd.Task, error) {
+	container, err := client.NewContainer(ctx, id, containerd.WithSpec(spec))
+	if err != nil {
+		return nil, err
+	}
+	task, err := container.NewTask(ctx, containerd.NullIO)
+	if err != nil {
+		container.Delete(ctx)
+		return nil, err
+	}
+	if err := task.Start(ctx); err != nil {
+		task.Delete(ctx, containerd.WithProcessKill)
+		container.Delete(ctx)
+		return nil, err
+	}
+	return task, nil
+}
+
+// parallel runs fn for 0 to n-1 on c.Concurrency workers, logging the rate
+// of the named operation, and returns the number of failed calls
+func (c config) parallel(n int, fn func(int) error, name string) int {
+	var (
+		wg       sync.WaitGroup
+		next     int64 = -1
+		failures int64
+		start    = time.Now()
+	)
+	for w := 0; w < c.Concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= n {
+					return
+				}
+				if err := fn(i); err != nil {
+					atomic.AddInt64(&failures, 1)
+					logrus.WithError(err).Errorf("%s %d", name, i)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	end := time.Since(start).Seconds()
+	logrus.WithField("failures", failures).Infof(
+		"%s %d containers in %0.3f seconds (%0.3f c/sec)",
+		name,
+		n,
+		end,
+		float64(n)/end,
+	)
+	return int(failures)
+}
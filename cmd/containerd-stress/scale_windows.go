@@ -0,0 +1,7 @@
+package main
+
+import "errors"
+
+func scale(c config) error {
+	return errors.New("scale mode is not supported on windows")
+}
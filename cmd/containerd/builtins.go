@@ -2,13 +2,23 @@ package main
 
 // register containerd builtins here
 import (
+	_ "github.com/containerd/containerd/admission/quota"
+	_ "github.com/containerd/containerd/authz/policy"
 	_ "github.com/containerd/containerd/differ"
+	_ "github.com/containerd/containerd/events/sinks"
+	_ "github.com/containerd/containerd/hooks/external"
+	_ "github.com/containerd/containerd/metrics/daemon"
+	_ "github.com/containerd/containerd/restart/monitor"
+	_ "github.com/containerd/containerd/services/checkpoints"
 	_ "github.com/containerd/containerd/services/containers"
 	_ "github.com/containerd/containerd/services/content"
 	_ "github.com/containerd/containerd/services/diff"
 	_ "github.com/containerd/containerd/services/events"
 	_ "github.com/containerd/containerd/services/healthcheck"
 	_ "github.com/containerd/containerd/services/images"
+	_ "github.com/containerd/containerd/services/introspection"
+	_ "github.com/containerd/containerd/services/metadata"
+	_ "github.com/containerd/containerd/services/migration"
 	_ "github.com/containerd/containerd/services/namespaces"
 	_ "github.com/containerd/containerd/services/snapshot"
 	_ "github.com/containerd/containerd/services/tasks"
@@ -0,0 +1,5 @@
+// +build chaos
+
+package main
+
+import _ "github.com/containerd/containerd/chaos"
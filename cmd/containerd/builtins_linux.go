@@ -1,7 +1,19 @@
 package main
 
 import (
+	_ "github.com/containerd/containerd/devices"
+	_ "github.com/containerd/containerd/firecracker"
 	_ "github.com/containerd/containerd/linux"
 	_ "github.com/containerd/containerd/metrics/cgroups"
+	_ "github.com/containerd/containerd/network"
+	_ "github.com/containerd/containerd/profiles"
+	_ "github.com/containerd/containerd/services/cri"
+	_ "github.com/containerd/containerd/services/dns"
+	_ "github.com/containerd/containerd/services/fifos"
+	_ "github.com/containerd/containerd/services/profiles"
+	_ "github.com/containerd/containerd/services/sandboxes"
+	_ "github.com/containerd/containerd/services/spec"
+	_ "github.com/containerd/containerd/services/stdio"
 	_ "github.com/containerd/containerd/snapshot/overlay"
+	_ "github.com/containerd/containerd/wasm"
 )
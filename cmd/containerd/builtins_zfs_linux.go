@@ -0,0 +1,5 @@
+// +build !no_zfs
+
+package main
+
+import _ "github.com/containerd/containerd/snapshot/zfs"
@@ -1,11 +1,15 @@
 package main
 
 import (
+	"os"
+	"path/filepath"
+
 	"github.com/containerd/containerd/server"
+	"github.com/containerd/containerd/sys"
 )
 
 func defaultConfig() *server.Config {
-	return &server.Config{
+	config := &server.Config{
 		Root:  server.DefaultRootDir,
 		State: server.DefaultStateDir,
 		GRPC: server.GRPCConfig{
@@ -17,4 +21,43 @@ func defaultConfig() *server.Config {
 			Address: server.DefaultDebugAddress,
 		},
 	}
+	if sys.IsRootless() {
+		applyRootlessDefaults(config)
+	}
+	return config
+}
+
+// applyRootlessDefaults keeps the data and the sockets of a rootless
+// containerd under the XDG directories of its user, as it cannot write to
+// the directories of the system
+func applyRootlessDefaults(config *server.Config) {
+	runtimeDir := os.Getenv("XDG_RUNTIME_DIR")
+	if runtimeDir == "" {
+		return
+	}
+	state := filepath.Join(runtimeDir, "containerd")
+	config.Root = filepath.Join(xdgDir("XDG_DATA_HOME", ".local/share"), "containerd")
+	config.State = state
+	config.GRPC.Address = filepath.Join(state, "containerd.sock")
+	config.GRPC.Uid = os.Getuid()
+	config.GRPC.Gid = os.Getgid()
+	config.Debug.Address = filepath.Join(state, "debug.sock")
+	config.Debug.Uid = os.Getuid()
+	config.Debug.Gid = os.Getgid()
+}
+
+func configPath() string {
+	if sys.IsRootless() && os.Getenv("XDG_RUNTIME_DIR") != "" {
+		return filepath.Join(xdgDir("XDG_CONFIG_HOME", ".config"), "containerd", "config.toml")
+	}
+	return "/etc/containerd/config.toml"
+}
+
+// xdgDir returns the XDG directory of the variable, or its default under the
+// home directory of the user
+func xdgDir(variable, home string) string {
+	if dir := os.Getenv(variable); dir != "" {
+		return dir
+	}
+	return filepath.Join(os.Getenv("HOME"), home)
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	golog "log"
@@ -66,6 +67,10 @@ func main() {
 			Name:  "root",
 			Usage: "containerd root directory",
 		},
+		cli.BoolFlag{
+			Name:  "check",
+			Usage: "check the configuration and the consistency of the state and print a JSON report instead of serving",
+		},
 	}
 	app.Commands = []cli.Command{
 		configCommand,
@@ -81,28 +86,36 @@ func main() {
 		// we don't miss any signals during boot
 		signal.Notify(signals, handledSignals...)
 
-		if err := server.LoadConfig(context.GlobalString("config"), config); err != nil && !os.IsNotExist(err) {
+		if err := loadConfig(context, config); err != nil {
 			return err
 		}
-		// apply flags to the config
-		if err := applyFlags(context, config); err != nil {
+		if err := setLevel(config); err != nil {
 			return err
 		}
 		address := config.GRPC.Address
 		if address == "" {
 			return errors.New("grpc address cannot be empty")
 		}
+		mode, err := config.GRPC.SocketMode()
+		if err != nil {
+			return err
+		}
 		log.G(ctx).WithFields(logrus.Fields{
 			"version":  version.Version,
 			"revision": version.Revision,
 		}).Info("starting containerd")
 
+		if context.GlobalBool("check") {
+			return check(ctx, config)
+		}
 		server, err := server.New(ctx, config)
 		if err != nil {
 			return err
 		}
+		// the configuration is reloaded on SIGHUP and by the daemon service
+		server.SetConfigLoader(configLoader(context))
 		if config.Debug.Address != "" {
-			l, err := sys.GetLocalListener(config.Debug.Address, config.Debug.Uid, config.Debug.Gid)
+			l, err := sys.GetLocalListener(config.Debug.Address, config.Debug.Uid, config.Debug.Gid, 0660)
 			if err != nil {
 				return errors.Wrapf(err, "failed to get listener for debug endpoint")
 			}
@@ -116,13 +129,27 @@ func main() {
 			serve(log.WithModule(ctx, "metrics"), l, server.ServeMetrics)
 		}
 
-		l, err := sys.GetLocalListener(address, config.GRPC.Uid, config.GRPC.Gid)
+		l, err := systemdListener(address)
 		if err != nil {
-			return errors.Wrapf(err, "failed to get listener for main endpoint")
+			return errors.Wrapf(err, "failed to get listener from systemd")
+		}
+		if l == nil {
+			if l, err = sys.GetLocalListener(address, config.GRPC.Uid, config.GRPC.Gid, mode); err != nil {
+				return errors.Wrapf(err, "failed to get listener for main endpoint")
+			}
 		}
 		serve(log.WithModule(ctx, "grpc"), l, server.ServeGRPC)
+		if config.GRPC.TCP.Enabled {
+			l, err := net.Listen("tcp", config.GRPC.TCP.Address)
+			if err != nil {
+				return errors.Wrapf(err, "failed to get listener for TCP endpoint")
+			}
+			serve(log.WithModule(ctx, "grpc"), l, server.ServeGRPC)
+		}
 
 		log.G(ctx).Infof("containerd successfully booted in %fs", time.Since(start).Seconds())
+		// the plugins are initialized and the tasks restored
+		notify(ctx, "READY=1")
 		return handleSignals(ctx, signals, server)
 	}
 	if err := app.Run(os.Args); err != nil {
@@ -131,6 +158,24 @@ func main() {
 	}
 }
 
+// check prints the consistency report of the configuration and state and
+// fails when they are not consistent
+func check(ctx context.Context, config *server.Config) error {
+	report, err := server.Check(ctx, config)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return err
+	}
+	if !report.OK {
+		return errors.New("consistency check failed")
+	}
+	return nil
+}
+
 func serve(ctx context.Context, l net.Listener, serveFunc func(net.Listener) error) {
 	path := l.Addr().String()
 	log.G(ctx).WithField("address", path).Info("serving...")
@@ -142,12 +187,31 @@ func serve(ctx context.Context, l net.Listener, serveFunc func(net.Listener) err
 	}()
 }
 
-func applyFlags(context *cli.Context, config *server.Config) error {
-	// the order for config vs flag values is that flags will always override
-	// the config values if they are set
-	if err := setLevel(context, config); err != nil {
+// loadConfig loads the configuration file over the defaults of the config
+// and applies the flags to it
+func loadConfig(context *cli.Context, config *server.Config) error {
+	if err := server.LoadConfig(context.GlobalString("config"), config); err != nil && !os.IsNotExist(err) {
 		return err
 	}
+	applyFlags(context, config)
+	return nil
+}
+
+// configLoader returns the function loading the configuration again when it
+// is reloaded
+func configLoader(context *cli.Context) func() (*server.Config, error) {
+	return func() (*server.Config, error) {
+		config := defaultConfig()
+		if err := loadConfig(context, config); err != nil {
+			return nil, err
+		}
+		return config, nil
+	}
+}
+
+func applyFlags(context *cli.Context, config *server.Config) {
+	// the order for config vs flag values is that flags will always override
+	// the config values if they are set
 	for _, v := range []struct {
 		name string
 		d    *string
@@ -160,21 +224,20 @@ func applyFlags(context *cli.Context, config *server.Config) error {
 			name: "address",
 			d:    &config.GRPC.Address,
 		},
+		{
+			name: "log-level",
+			d:    &config.Debug.Level,
+		},
 	} {
 		if s := context.GlobalString(v.name); s != "" {
 			*v.d = s
 		}
 	}
-	return nil
 }
 
-func setLevel(context *cli.Context, config *server.Config) error {
-	l := context.GlobalString("log-level")
-	if l == "" {
-		l = config.Debug.Level
-	}
-	if l != "" {
-		lvl, err := logrus.ParseLevel(l)
+func setLevel(config *server.Config) error {
+	if config.Debug.Level != "" {
+		lvl, err := logrus.ParseLevel(config.Debug.Level)
 		if err != nil {
 			return err
 		}
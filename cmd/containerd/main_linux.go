@@ -11,12 +11,15 @@ import (
 	"github.com/containerd/containerd/server"
 )
 
-const defaultConfigPath = "/etc/containerd/config.toml"
+// defaultConfigPath is under the configuration directory of the user in
+// rootless mode
+var defaultConfigPath = configPath()
 
 var handledSignals = []os.Signal{
 	unix.SIGTERM,
 	unix.SIGINT,
 	unix.SIGUSR1,
+	unix.SIGHUP,
 	unix.SIGCHLD,
 }
 
@@ -30,8 +33,15 @@ func handleSignals(ctx context.Context, signals chan os.Signal, server *server.S
 			}
 		case unix.SIGUSR1:
 			dumpStacks()
+		case unix.SIGHUP:
+			notify(ctx, "RELOADING=1")
+			if _, err := server.Reload(ctx); err != nil {
+				log.G(ctx).WithError(err).Error("reload configuration")
+			}
+			notify(ctx, "READY=1")
 		default:
-			server.Stop()
+			notify(ctx, "STOPPING=1")
+			server.Shutdown(ctx)
 			return nil
 		}
 	}
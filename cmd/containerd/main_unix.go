@@ -19,6 +19,7 @@ var handledSignals = []os.Signal{
 	unix.SIGTERM,
 	unix.SIGINT,
 	unix.SIGUSR1,
+	unix.SIGHUP,
 	unix.SIGCHLD,
 	unix.SIGPIPE,
 }
@@ -33,10 +34,14 @@ func handleSignals(ctx context.Context, signals chan os.Signal, server *server.S
 			}
 		case unix.SIGUSR1:
 			dumpStacks()
+		case unix.SIGHUP:
+			if _, err := server.Reload(ctx); err != nil {
+				log.G(ctx).WithError(err).Error("reload configuration")
+			}
 		case unix.SIGPIPE:
 			continue
 		default:
-			server.Stop()
+			server.Shutdown(ctx)
 			return nil
 		}
 	}
@@ -22,7 +22,7 @@ var (
 func handleSignals(ctx context.Context, signals chan os.Signal, server *server.Server) error {
 	for s := range signals {
 		log.G(ctx).WithField("signal", s).Debug("received signal")
-		server.Stop()
+		server.Shutdown(ctx)
 	}
 	return nil
 }
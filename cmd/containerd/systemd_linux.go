@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"net"
+	"os"
+	"strconv"
+	"syscall"
+
+	"github.com/containerd/containerd/log"
+	"github.com/pkg/errors"
+)
+
+// listenFdsStart is the first file descriptor passed by systemd
+const listenFdsStart = 3
+
+// systemdListener returns the listener passed by systemd socket activation
+// for the address, or nil when containerd was not socket activated
+func systemdListener(address string) (net.Listener, error) {
+	defer func() {
+		// the sockets are not passed on to the shims
+		os.Unsetenv("LISTEN_PID")
+		os.Unsetenv("LISTEN_FDS")
+		os.Unsetenv("LISTEN_FDNAMES")
+	}()
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || n <= 0 {
+		return nil, nil
+	}
+	var listeners []net.Listener
+	for fd := listenFdsStart; fd < listenFdsStart+n; fd++ {
+		syscall.CloseOnExec(fd)
+		f := os.NewFile(uintptr(fd), "LISTEN_FD_"+strconv.Itoa(fd))
+		l, err := net.FileListener(f)
+		f.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "systemd socket %d is not a listener", fd)
+		}
+		if l.Addr().String() == address {
+			return l, nil
+		}
+		listeners = append(listeners, l)
+	}
+	// a single socket is served whatever its address
+	if len(listeners) == 1 {
+		return listeners[0], nil
+	}
+	for _, l := range listeners {
+		l.Close()
+	}
+	return nil, errors.Errorf("none of the systemd sockets listens on %s", address)
+}
+
+// notify sends the state to systemd when containerd runs as a notify service
+func notify(ctx context.Context, state string) {
+	socket := os.Getenv("NOTIFY_SOCKET")
+	if socket == "" {
+		return
+	}
+	if socket[0] == '@' {
+		// abstract socket
+		socket = "\x00" + socket[1:]
+	}
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: socket, Net: "unixgram"})
+	if err != nil {
+		log.G(ctx).WithError(err).Warn("failed to notify systemd")
+		return
+	}
+	defer conn.Close()
+	if _, err := conn.Write([]byte(state)); err != nil {
+		log.G(ctx).WithError(err).Warn("failed to notify systemd")
+	}
+}
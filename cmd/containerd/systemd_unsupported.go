@@ -0,0 +1,15 @@
+// +build !linux
+
+package main
+
+import (
+	"context"
+	"net"
+)
+
+func systemdListener(address string) (net.Listener, error) {
+	return nil, nil
+}
+
+func notify(ctx context.Context, state string) {
+}
@@ -13,10 +13,26 @@ var taskCheckpointCommand = cli.Command{
 	Usage:     "checkpoint a container",
 	ArgsUsage: "CONTAINER",
 	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "name",
+			Usage: "record the checkpoint with the name rather than its digest",
+		},
 		cli.BoolFlag{
 			Name:  "exit",
 			Usage: "stop the container after the checkpoint",
 		},
+		cli.BoolFlag{
+			Name:  "tcp",
+			Usage: "allow checkpointing of established tcp connections",
+		},
+		cli.BoolFlag{
+			Name:  "external-unix-sockets",
+			Usage: "allow checkpointing of external unix sockets",
+		},
+		cli.BoolFlag{
+			Name:  "file-locks",
+			Usage: "allow checkpointing of file locks",
+		},
 	},
 	Action: func(context *cli.Context) error {
 		var (
@@ -41,13 +57,29 @@ var taskCheckpointCommand = cli.Command{
 			return err
 		}
 		var opts []containerd.CheckpointTaskOpts
+		if name := context.String("name"); name != "" {
+			opts = append(opts, containerd.WithCheckpointName(name))
+		}
 		if context.Bool("exit") {
 			opts = append(opts, containerd.WithExit)
 		}
+		if context.Bool("tcp") {
+			opts = append(opts, containerd.WithCheckpointOpenTCP)
+		}
+		if context.Bool("external-unix-sockets") {
+			opts = append(opts, containerd.WithCheckpointExternalUnixSockets)
+		}
+		if context.Bool("file-locks") {
+			opts = append(opts, containerd.WithCheckpointFileLocks)
+		}
 		checkpoint, err := task.Checkpoint(ctx, opts...)
 		if err != nil {
 			return err
 		}
+		if name := context.String("name"); name != "" {
+			fmt.Println(name)
+			return nil
+		}
 		fmt.Println(checkpoint.Digest.String())
 		return nil
 	},
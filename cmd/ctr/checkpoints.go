@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	checkpointsapi "github.com/containerd/containerd/api/services/checkpoints/v1"
+	units "github.com/docker/go-units"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var checkpointsCommand = cli.Command{
+	Name:  "checkpoints",
+	Usage: "manage the checkpoints of containers",
+	Subcommands: cli.Commands{
+		checkpointsListCommand,
+		checkpointsDeleteCommand,
+	},
+}
+
+var checkpointsListCommand = cli.Command{
+	Name:      "list",
+	Aliases:   []string{"ls"},
+	Usage:     "list checkpoints",
+	ArgsUsage: "[filter, ...]",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "quiet, q",
+			Usage: "print only the checkpoint name",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		resp, err := client.CheckpointService().List(ctx, &checkpointsapi.ListCheckpointsRequest{
+			Filters: context.Args(),
+		})
+		if err != nil {
+			return err
+		}
+		if context.Bool("quiet") {
+			for _, c := range resp.Checkpoints {
+				fmt.Println(c.Name)
+			}
+			return nil
+		}
+		w := tabwriter.NewWriter(os.Stdout, 10, 1, 3, ' ', 0)
+		fmt.Fprintln(w, "NAME\tCONTAINER\tDIGEST\tPARENT\tAGE\t")
+		for _, c := range resp.Checkpoints {
+			parent := c.Parent
+			if parent == "" {
+				parent = "-"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n",
+				c.Name,
+				c.ContainerID,
+				c.Target.Digest,
+				parent,
+				units.HumanDuration(time.Since(c.CreatedAt)))
+		}
+		return w.Flush()
+	},
+}
+
+var checkpointsDeleteCommand = cli.Command{
+	Name:      "delete",
+	Aliases:   []string{"rm"},
+	Usage:     "delete one or more checkpoints along with the content only they use",
+	ArgsUsage: "CHECKPOINT [CHECKPOINT, ...]",
+	Action: func(context *cli.Context) error {
+		if context.NArg() == 0 {
+			return errors.New("checkpoint name must be provided")
+		}
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		for _, name := range context.Args() {
+			if _, err := client.CheckpointService().Delete(ctx, &checkpointsapi.DeleteCheckpointRequest{
+				Name: name,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to delete checkpoint %s", name)
+			}
+		}
+		return nil
+	},
+}
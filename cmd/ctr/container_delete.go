@@ -20,6 +20,10 @@ var containersDeleteCommand = cli.Command{
 			Name:  "keep-snapshot",
 			Usage: "do not clean up snapshot with container",
 		},
+		cli.BoolFlag{
+			Name:  "force, f",
+			Usage: "kill and delete the task of the container, purging its state if it cannot be deleted cleanly",
+		},
 	},
 	Action: func(context *cli.Context) error {
 		var exitErr error
@@ -38,7 +42,7 @@ var containersDeleteCommand = cli.Command{
 			return errors.New("must specify at least one container to delete")
 		}
 		for _, arg := range context.Args() {
-			if err := deleteContainer(ctx, client, arg, deleteOpts...); err != nil {
+			if err := deleteContainer(ctx, client, arg, context.Bool("force"), deleteOpts...); err != nil {
 				if exitErr == nil {
 					exitErr = err
 				}
@@ -50,7 +54,7 @@ var containersDeleteCommand = cli.Command{
 	},
 }
 
-func deleteContainer(ctx context.Context, client *containerd.Client, id string, opts ...containerd.DeleteOpts) error {
+func deleteContainer(ctx context.Context, client *containerd.Client, id string, force bool, opts ...containerd.DeleteOpts) error {
 	container, err := client.LoadContainer(ctx, id)
 	if err != nil {
 		return err
@@ -59,6 +63,12 @@ func deleteContainer(ctx context.Context, client *containerd.Client, id string,
 	if err != nil {
 		return container.Delete(ctx, opts...)
 	}
+	if force {
+		if _, err := task.Delete(ctx, containerd.WithForceDelete); err != nil {
+			return err
+		}
+		return container.Delete(ctx, opts...)
+	}
 	status, err := task.Status(ctx)
 	if err != nil {
 		return err
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var containersSnapshotCommand = cli.Command{
+	Name:      "snapshot",
+	Usage:     "commit the writable layer of a container to a snapshot, freezing its running task meanwhile",
+	ArgsUsage: "CONTAINER NAME",
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "label",
+			Usage: "labels to attach to the snapshot",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		var (
+			id   = context.Args().First()
+			name = context.Args().Get(1)
+		)
+		if id == "" {
+			return errors.New("container id must be provided")
+		}
+		if name == "" {
+			return errors.New("snapshot name must be provided")
+		}
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		container, err := client.LoadContainer(ctx, id)
+		if err != nil {
+			return err
+		}
+		layer, err := container.Snapshot(ctx, name, labelArgs(context.StringSlice("label")))
+		if err != nil {
+			return err
+		}
+		fmt.Println(layer.Digest)
+		return nil
+	},
+}
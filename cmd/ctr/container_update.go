@@ -0,0 +1,66 @@
+package main
+
+import (
+	"github.com/containerd/containerd"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var containersUpdateProcessCommand = cli.Command{
+	Name:      "update-process",
+	Usage:     "amend the process and mounts of a container before its task is created",
+	ArgsUsage: "CONTAINER [COMMAND] [ARG...]",
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "env",
+			Usage: "add or replace environment variables of the process (i.e. FOO=bar)",
+		},
+		cli.StringFlag{
+			Name:  "cwd",
+			Usage: "replace the working directory of the process",
+		},
+		cli.StringSliceFlag{
+			Name:  "mount",
+			Usage: "add or replace a mount of the container (ex: type=bind,src=/tmp,dest=/host,options=rbind:ro)",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		id := context.Args().First()
+		if id == "" {
+			return errors.New("container id must be provided")
+		}
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		container, err := client.LoadContainer(ctx, id)
+		if err != nil {
+			return err
+		}
+		var opts []containerd.UpdateProcessOpts
+		if env := context.StringSlice("env"); len(env) > 0 {
+			opts = append(opts, containerd.WithPendingEnv(env))
+		}
+		if cwd := context.String("cwd"); cwd != "" {
+			opts = append(opts, containerd.WithPendingCwd(cwd))
+		}
+		if args := context.Args().Tail(); len(args) > 0 {
+			opts = append(opts, containerd.WithPendingArgs(args...))
+		}
+		var mounts []specs.Mount
+		for _, s := range context.StringSlice("mount") {
+			m, err := parseMountFlag(s)
+			if err != nil {
+				return err
+			}
+			mounts = append(mounts, m)
+		}
+		if len(mounts) > 0 {
+			opts = append(opts, containerd.WithPendingMounts(mounts))
+		}
+		return container.UpdateProcess(ctx, opts...)
+	},
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	snapshotapi "github.com/containerd/containerd/api/services/snapshot/v1"
+	"github.com/containerd/containerd/progress"
+	"github.com/urfave/cli"
+)
+
+var containersUsageCommand = cli.Command{
+	Name:      "usage",
+	Usage:     "show the disk usage of the writable layers of containers",
+	ArgsUsage: "[filter, ...]",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "b",
+			Usage: "display size in bytes",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		ctx, cancel := appContext(context)
+		defer cancel()
+		conn, err := getGRPCConnection(context)
+		if err != nil {
+			return err
+		}
+		resp, err := snapshotapi.NewSnapshotsClient(conn).ContainerUsage(ctx, &snapshotapi.ContainerUsageRequest{
+			Filters: context.Args(),
+		})
+		if err != nil {
+			return err
+		}
+		displaySize := func(s int64) string {
+			if context.Bool("b") {
+				return fmt.Sprintf("%d", s)
+			}
+			return progress.Bytes(s).String()
+		}
+		w := tabwriter.NewWriter(os.Stdout, 1, 8, 1, ' ', 0)
+		fmt.Fprintln(w, "CONTAINER\tSNAPSHOTTER\tSIZE\tINODES\tLIMIT\t")
+		for _, u := range resp.Usages {
+			limit := "-"
+			if u.SizeLimit > 0 {
+				limit = displaySize(u.SizeLimit)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t\n", u.ContainerID, u.Snapshotter, displaySize(u.Size_), u.Inodes, limit)
+		}
+		return w.Flush()
+	},
+}
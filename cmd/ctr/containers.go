@@ -22,7 +22,10 @@ var containersCommand = cli.Command{
 	Subcommands: []cli.Command{
 		containersDeleteCommand,
 		containersSetLabelsCommand,
+		containersUpdateProcessCommand,
+		containersSnapshotCommand,
 		containerInfoCommand,
+		containersUsageCommand,
 	},
 	ArgsUsage: "[filter, ...]",
 	Action: func(context *cli.Context) error {
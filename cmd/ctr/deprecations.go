@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	introspectionapi "github.com/containerd/containerd/api/services/introspection/v1"
+	"github.com/urfave/cli"
+)
+
+var deprecationsCommand = cli.Command{
+	Name:  "deprecations",
+	Usage: "list the deprecated API surfaces and how often they were used",
+	Action: func(context *cli.Context) error {
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		response, err := client.IntrospectionService().Deprecations(ctx, &introspectionapi.DeprecationsRequest{})
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 10, 1, 3, ' ', 0)
+		fmt.Fprintln(w, "ID\tMETHOD\tFIELD\tCOUNT\tLAST USED\tMESSAGE\t")
+		for _, d := range response.Deprecations {
+			lastUsed := "never"
+			if d.Count > 0 {
+				lastUsed = d.LastUsed.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\t\n", d.ID, d.Method, d.Field, d.Count, lastUsed, d.Message)
+		}
+		return w.Flush()
+	},
+}
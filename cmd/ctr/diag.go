@@ -0,0 +1,57 @@
+package main
+
+import (
+	"io"
+	"os"
+
+	diagapi "github.com/containerd/containerd/api/services/diag/v1"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var diagCommand = cli.Command{
+	Name:      "diag",
+	Usage:     "write a support bundle of the daemon to a file",
+	ArgsUsage: "<out>",
+	Description: `Write a gzipped tarball of the state of the running daemon for bug reports.
+
+The bundle holds the recent events and logs of the daemon, a dump of its
+goroutines, its configuration without the plugin sections, the status of its
+plugins and a summary of the containers and tasks of every namespace.
+`,
+	Action: func(context *cli.Context) error {
+		out := context.Args().First()
+		if out == "" {
+			return errors.New("output file must be provided")
+		}
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		stream, err := client.DiagService().Bundle(ctx, &diagapi.BundleRequest{})
+		if err != nil {
+			return err
+		}
+		f, err := os.OpenFile(out, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				f.Close()
+				return err
+			}
+			if _, err := f.Write(resp.Data); err != nil {
+				f.Close()
+				return err
+			}
+		}
+		return f.Close()
+	},
+}
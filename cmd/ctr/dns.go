@@ -0,0 +1,141 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	dnsapi "github.com/containerd/containerd/api/services/dns/v1"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var dnsCommand = cli.Command{
+	Name:  "dns",
+	Usage: "manage the hosts and resolv.conf files of containers",
+	Subcommands: cli.Commands{
+		dnsUpdateCommand,
+		dnsGetCommand,
+		dnsDeleteCommand,
+	},
+}
+
+var dnsUpdateCommand = cli.Command{
+	Name:      "update",
+	Usage:     "replace the dns configuration of a container",
+	ArgsUsage: "CONTAINER",
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "host",
+			Usage: "add a hosts entry, as ip=hostname[,hostname...]",
+		},
+		cli.StringSliceFlag{
+			Name:  "nameserver",
+			Usage: "add a nameserver",
+		},
+		cli.StringSliceFlag{
+			Name:  "search",
+			Usage: "add a search domain",
+		},
+		cli.StringSliceFlag{
+			Name:  "option",
+			Usage: "add a resolver option",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		id := context.Args().First()
+		if id == "" {
+			return errors.New("container id must be provided")
+		}
+		config := dnsapi.DNSConfig{
+			Nameservers: context.StringSlice("nameserver"),
+			Search:      context.StringSlice("search"),
+			Options:     context.StringSlice("option"),
+		}
+		for _, h := range context.StringSlice("host") {
+			parts := strings.SplitN(h, "=", 2)
+			if len(parts) != 2 {
+				return errors.Errorf("invalid host %q, expected ip=hostname[,hostname...]", h)
+			}
+			config.Hosts = append(config.Hosts, dnsapi.HostEntry{
+				IP:        parts[0],
+				Hostnames: strings.Split(parts[1], ","),
+			})
+		}
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		_, err = client.DNSService().Update(ctx, &dnsapi.UpdateDNSRequest{
+			ContainerID: id,
+			Config:      config,
+		})
+		return err
+	},
+}
+
+var dnsGetCommand = cli.Command{
+	Name:      "get",
+	Usage:     "show the dns configuration of a container",
+	ArgsUsage: "CONTAINER",
+	Action: func(context *cli.Context) error {
+		id := context.Args().First()
+		if id == "" {
+			return errors.New("container id must be provided")
+		}
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		resp, err := client.DNSService().Get(ctx, &dnsapi.GetDNSRequest{
+			ContainerID: id,
+		})
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 10, 1, 3, ' ', 0)
+		fmt.Fprintf(w, "HOSTS FILE\t%s\t\n", resp.HostsPath)
+		fmt.Fprintf(w, "RESOLV.CONF\t%s\t\n", resp.ResolvConfPath)
+		for _, h := range resp.Config.Hosts {
+			fmt.Fprintf(w, "HOST\t%s\t%s\t\n", h.IP, strings.Join(h.Hostnames, ","))
+		}
+		for _, n := range resp.Config.Nameservers {
+			fmt.Fprintf(w, "NAMESERVER\t%s\t\n", n)
+		}
+		for _, s := range resp.Config.Search {
+			fmt.Fprintf(w, "SEARCH\t%s\t\n", s)
+		}
+		for _, o := range resp.Config.Options {
+			fmt.Fprintf(w, "OPTION\t%s\t\n", o)
+		}
+		return w.Flush()
+	},
+}
+
+var dnsDeleteCommand = cli.Command{
+	Name:      "delete",
+	Aliases:   []string{"rm"},
+	Usage:     "remove the dns files of a container",
+	ArgsUsage: "CONTAINER",
+	Action: func(context *cli.Context) error {
+		id := context.Args().First()
+		if id == "" {
+			return errors.New("container id must be provided")
+		}
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		_, err = client.DNSService().Delete(ctx, &dnsapi.DeleteDNSRequest{
+			ContainerID: id,
+		})
+		return err
+	},
+}
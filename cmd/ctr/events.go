@@ -10,8 +10,15 @@ import (
 )
 
 var eventsCommand = cli.Command{
-	Name:  "events",
-	Usage: "display containerd events",
+	Name:      "events",
+	Usage:     "display containerd events",
+	ArgsUsage: "[filter, ...]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "durable",
+			Usage: "name of a durable subscription that redelivers events until they are displayed",
+		},
+	},
 	Action: func(context *cli.Context) error {
 		eventsClient, err := getEventsService(context)
 		if err != nil {
@@ -20,8 +27,10 @@ var eventsCommand = cli.Command{
 		ctx, cancel := appContext(context)
 		defer cancel()
 
+		durable := context.String("durable")
 		events, err := eventsClient.Subscribe(ctx, &eventsapi.SubscribeRequest{
 			Filters: context.Args(),
+			Durable: durable,
 		})
 		if err != nil {
 			return err
@@ -52,6 +61,14 @@ var eventsCommand = cli.Command{
 			); err != nil {
 				return err
 			}
+			if durable != "" {
+				if _, err := eventsClient.Ack(ctx, &eventsapi.AckRequest{
+					Durable:  durable,
+					Sequence: e.Sequence,
+				}); err != nil {
+					return err
+				}
+			}
 		}
 	},
 }
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"syscall"
+
+	introspectionapi "github.com/containerd/containerd/api/services/introspection/v1"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/plugin"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+const (
+	// externalPrefix is the prefix of the binaries on the PATH that are
+	// added as ctr subcommands, ctr-foo is run for "ctr foo"
+	externalPrefix = "ctr-"
+	// addressEnvVar holds the address of containerd for external commands
+	addressEnvVar = "CONTAINERD_ADDRESS"
+)
+
+// externalCommands returns a command for each ctr-<name> binary on the PATH
+// that does not conflict with an existing command. The first binary found
+// for a name is used.
+func externalCommands(existing []cli.Command) []cli.Command {
+	seen := make(map[string]struct{})
+	for _, c := range existing {
+		for _, name := range c.Names() {
+			seen[name] = struct{}{}
+		}
+	}
+	var commands []cli.Command
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		if dir == "" {
+			dir = "."
+		}
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, f := range files {
+			name := f.Name()
+			if !strings.HasPrefix(name, externalPrefix) || f.IsDir() {
+				continue
+			}
+			if runtime.GOOS == "windows" {
+				if !strings.EqualFold(filepath.Ext(name), ".exe") {
+					continue
+				}
+				name = strings.TrimSuffix(name, filepath.Ext(name))
+			} else if f.Mode()&0111 == 0 {
+				continue
+			}
+			name = strings.TrimPrefix(name, externalPrefix)
+			if _, ok := seen[name]; ok || name == "" {
+				continue
+			}
+			seen[name] = struct{}{}
+			commands = append(commands, externalCommand(name, filepath.Join(dir, f.Name())))
+		}
+	}
+	return commands
+}
+
+// externalCommand runs the binary with the arguments following the command
+// name
+func externalCommand(name, path string) cli.Command {
+	return cli.Command{
+		Name:            name,
+		Usage:           "external command provided by " + path,
+		SkipFlagParsing: true,
+		Action: func(context *cli.Context) error {
+			return runExternal(context, path, context.Args())
+		},
+	}
+}
+
+// pluginCommand runs the command advertised by a plugin of the daemon for
+// the first argument, which is not a command of ctr, with the arguments
+// following it. The plugins are only looked up for unknown commands so that
+// ctr does not connect to the daemon to build its commands.
+func pluginCommand(context *cli.Context) error {
+	if !context.Args().Present() {
+		return cli.ShowAppHelp(context)
+	}
+	name := context.Args().First()
+	ctx, cancel := appContext(context)
+	defer cancel()
+	client, err := newClient(context)
+	if err != nil {
+		return errors.Wrapf(err, "unknown command %q, failed to look up the commands of plugins", name)
+	}
+	defer client.Close()
+	response, err := client.IntrospectionService().Plugins(ctx, &introspectionapi.PluginsRequest{})
+	if err != nil {
+		return errors.Wrapf(err, "unknown command %q, failed to look up the commands of plugins", name)
+	}
+	for _, p := range response.Plugins {
+		path, ok := p.Exports[plugin.CommandExport+name]
+		if !ok || p.InitError != "" {
+			continue
+		}
+		if _, err := os.Stat(path); err != nil {
+			return errors.Wrapf(err, "command %q of plugin %s.%s is not available on this host", name, p.Type, p.ID)
+		}
+		return runExternal(context, path, context.Args().Tail())
+	}
+	return errors.Errorf("unknown command %q", name)
+}
+
+// runExternal runs the binary with the arguments. The global address and
+// namespace are passed in the environment.
+func runExternal(context *cli.Context, path string, args []string) error {
+	cmd := exec.Command(path, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(),
+		addressEnvVar+"="+context.GlobalString("address"),
+		namespaces.NamespaceEnvVar+"="+context.GlobalString("namespace"),
+	)
+	if err := cmd.Run(); err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			// the command has reported its own error
+			return cli.NewExitError("", exitErr.Sys().(syscall.WaitStatus).ExitStatus())
+		}
+		return err
+	}
+	return nil
+}
@@ -16,8 +16,10 @@ import (
 	"github.com/containerd/containerd/log"
 	"github.com/containerd/containerd/progress"
 	"github.com/containerd/containerd/remotes"
+	units "github.com/docker/go-units"
 	digest "github.com/opencontainers/go-digest"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 )
 
@@ -39,7 +41,7 @@ not use this implementation as a guide. The end goal should be having metadata,
 content and snapshots ready for a direct use via the 'ctr run'.
 
 Most of this is experimental and there are few leaps to make this work.`,
-	Flags: registryFlags,
+	Flags: append(append(registryFlags, platformFlags...), downloadFlags...),
 	Action: func(clicontext *cli.Context) error {
 		var (
 			ref = clicontext.Args().First()
@@ -63,6 +65,14 @@ func fetch(ctx context.Context, ref string, clicontext *cli.Context) (containerd
 		return nil, err
 	}
 
+	var rate int64
+	if r := clicontext.String("max-download-rate"); r != "" {
+		if rate, err = units.RAMInBytes(r); err != nil {
+			return nil, errors.Wrap(err, "invalid download rate")
+		}
+	}
+	limiter := remotes.NewLimiter(clicontext.Int("max-concurrent-downloads"), rate)
+
 	ongoing := newJobs(ref)
 
 	pctx, stopProgress := context.WithCancel(ctx)
@@ -85,7 +95,7 @@ func fetch(ctx context.Context, ref string, clicontext *cli.Context) (containerd
 
 	log.G(pctx).WithField("image", ref).Debug("fetching")
 
-	img, err := client.Pull(pctx, ref, containerd.WithResolver(resolver), containerd.WithImageHandler(h), containerd.WithSchema1Conversion)
+	img, err := client.Pull(pctx, ref, containerd.WithResolver(resolver), containerd.WithImageHandler(h), containerd.WithSchema1Conversion, containerd.WithPlatform(clicontext.String("platform")), containerd.WithLimiter(limiter))
 	stopProgress()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	imagesapi "github.com/containerd/containerd/api/services/images/v1"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var imagesSearchCommand = cli.Command{
+	Name:        "search",
+	Usage:       "find the images referencing a digest or matching a prefix",
+	ArgsUsage:   "[flags] <digest>|<prefix> [<filter>, ...]",
+	Description: "List the images whose target, manifests, config or layers include the digest, or whose name or target digest starts with the prefix.",
+	Action: func(clicontext *cli.Context) error {
+		query := clicontext.Args().First()
+		if query == "" {
+			return errors.New("digest or prefix must be provided")
+		}
+		req := &imagesapi.SearchImagesRequest{
+			Filters: clicontext.Args().Tail(),
+		}
+		if dgst, err := digest.Parse(query); err == nil {
+			req.Digest = dgst
+		} else {
+			req.Prefix = query
+		}
+		ctx, cancel := appContext(clicontext)
+		defer cancel()
+
+		conn, err := getGRPCConnection(clicontext)
+		if err != nil {
+			return err
+		}
+		resp, err := imagesapi.NewImagesClient(conn).Search(ctx, req)
+		if err != nil {
+			return errors.Wrap(err, "failed to search images")
+		}
+		tw := tabwriter.NewWriter(os.Stdout, 1, 8, 1, ' ', 0)
+		fmt.Fprintln(tw, "REF\tTYPE\tDIGEST\t")
+		for _, image := range resp.Images {
+			fmt.Fprintf(tw, "%v\t%v\t%v\t\n", image.Name, image.Target.MediaType, image.Target.Digest)
+		}
+		return tw.Flush()
+	},
+}
+
+var imagesContainerCommand = cli.Command{
+	Name:        "container",
+	Usage:       "show the image and snapshots of a container",
+	ArgsUsage:   "[flags] <container>",
+	Description: "Show the image a container was created from and the chain of snapshots of its root filesystem.",
+	Action: func(clicontext *cli.Context) error {
+		id := clicontext.Args().First()
+		if id == "" {
+			return errors.New("container id must be provided")
+		}
+		ctx, cancel := appContext(clicontext)
+		defer cancel()
+
+		conn, err := getGRPCConnection(clicontext)
+		if err != nil {
+			return err
+		}
+		resp, err := imagesapi.NewImagesClient(conn).ContainerImage(ctx, &imagesapi.ContainerImageRequest{
+			ContainerID: id,
+		})
+		if err != nil {
+			return err
+		}
+		tw := tabwriter.NewWriter(os.Stdout, 1, 8, 1, ' ', 0)
+		fmt.Fprintf(tw, "IMAGE:\t%s\n", resp.Name)
+		if resp.Image != nil {
+			fmt.Fprintf(tw, "DIGEST:\t%s\n", resp.Image.Target.Digest)
+		} else if resp.Name != "" {
+			fmt.Fprintln(tw, "DIGEST:\t-")
+		}
+		fmt.Fprintf(tw, "SNAPSHOTTER:\t%s\n", resp.Snapshotter)
+		for _, key := range resp.Snapshots {
+			fmt.Fprintf(tw, "SNAPSHOT:\t%s\n", key)
+		}
+		return tw.Flush()
+	},
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"text/tabwriter"
 
+	imagesapi "github.com/containerd/containerd/api/services/images/v1"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/images"
 	"github.com/containerd/containerd/log"
@@ -21,9 +22,13 @@ var imageCommand = cli.Command{
 	Subcommands: cli.Commands{
 		imagesListCommand,
 		imageRemoveCommand,
+		imagesTagCommand,
+		imagesUntagCommand,
 		imagesSetLabelsCommand,
 		imagesImportCommand,
 		imagesExportCommand,
+		imagesSearchCommand,
+		imagesContainerCommand,
 	},
 }
 
@@ -32,7 +37,7 @@ var imagesListCommand = cli.Command{
 	Aliases:     []string{"ls"},
 	Usage:       "list images known to containerd",
 	ArgsUsage:   "[flags] <ref>",
-	Description: `List images registered with containerd.`,
+	Description: `List images registered with containerd, with the size of their content and the platforms they are built for.`,
 	Flags:       []cli.Flag{},
 	Action: func(clicontext *cli.Context) error {
 		var (
@@ -41,27 +46,21 @@ var imagesListCommand = cli.Command{
 		)
 		defer cancel()
 
-		client, err := newClient(clicontext)
+		conn, err := getGRPCConnection(clicontext)
 		if err != nil {
 			return err
 		}
 
-		imageStore := client.ImageService()
-		cs := client.ContentStore()
-
-		images, err := imageStore.List(ctx, filters...)
+		resp, err := imagesapi.NewImagesClient(conn).List(ctx, &imagesapi.ListImagesRequest{
+			Filters: filters,
+		})
 		if err != nil {
 			return errors.Wrap(err, "failed to list images")
 		}
 
 		tw := tabwriter.NewWriter(os.Stdout, 1, 8, 1, ' ', 0)
-		fmt.Fprintln(tw, "REF\tTYPE\tDIGEST\tSIZE\tLABELS\t")
-		for _, image := range images {
-			size, err := image.Size(ctx, cs)
-			if err != nil {
-				log.G(ctx).WithError(err).Errorf("failed calculating size for image %s", image.Name)
-			}
-
+		fmt.Fprintln(tw, "REF\tTYPE\tDIGEST\tSIZE\tPLATFORMS\tLABELS\t")
+		for _, image := range resp.Images {
 			labels := "-"
 			if len(image.Labels) > 0 {
 				var pairs []string
@@ -71,12 +70,17 @@ var imagesListCommand = cli.Command{
 				sort.Strings(pairs)
 				labels = strings.Join(pairs, ",")
 			}
+			platforms := "-"
+			if len(image.Platforms) > 0 {
+				platforms = strings.Join(image.Platforms, ",")
+			}
 
-			fmt.Fprintf(tw, "%v\t%v\t%v\t%v\t%s\t\n",
+			fmt.Fprintf(tw, "%v\t%v\t%v\t%v\t%s\t%s\t\n",
 				image.Name,
 				image.Target.MediaType,
 				image.Target.Digest,
-				progress.Bytes(size),
+				progress.Bytes(image.Size_),
+				platforms,
 				labels)
 		}
 
@@ -88,7 +92,7 @@ var imagesSetLabelsCommand = cli.Command{
 	Name:        "label",
 	Usage:       "Set and clear labels for an image.",
 	ArgsUsage:   "[flags] <name> [<key>=<value>, ...]",
-	Description: "Set and clear labels for an image.",
+	Description: "Set and clear labels for an image. A label given as <key>= with an empty value is cleared.",
 	Flags:       []cli.Flag{},
 	Action: func(clicontext *cli.Context) error {
 		var (
@@ -137,16 +141,52 @@ var imagesSetLabelsCommand = cli.Command{
 }
 
 var imageRemoveCommand = cli.Command{
-	Name:        "remove",
-	Aliases:     []string{"rm"},
-	Usage:       "Remove one or more images by reference.",
+	Name:      "remove",
+	Aliases:   []string{"rm"},
+	Usage:     "Remove one or more images by reference.",
+	ArgsUsage: "[flags] <ref> [<ref>, ...]",
+	Description: `Remove one or more images by reference, along with the content and
+unpacked snapshots no other image, checkpoint or container uses.`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "keep-content",
+			Usage: "only remove the references, leaving the image content in place",
+		},
+	},
+	Action: func(clicontext *cli.Context) error {
+		return removeImages(clicontext, !clicontext.Bool("keep-content"))
+	},
+}
+
+var imagesUntagCommand = cli.Command{
+	Name:        "untag",
+	Usage:       "Remove one or more image references, keeping the image content.",
 	ArgsUsage:   "[flags] <ref> [<ref>, ...]",
-	Description: `Remove one or more images by reference.`,
-	Flags:       []cli.Flag{},
+	Description: `Remove one or more image references, keeping the image content.`,
+	Action: func(clicontext *cli.Context) error {
+		return removeImages(clicontext, false)
+	},
+}
+
+var imagesTagCommand = cli.Command{
+	Name:        "tag",
+	Usage:       "Add a reference to an image.",
+	ArgsUsage:   "[flags] <source_ref> <target_ref>",
+	Description: `Add a reference to the target of an existing image.`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "force",
+			Usage: "replace the target of an existing reference",
+		},
+	},
 	Action: func(clicontext *cli.Context) error {
 		var (
-			exitErr error
+			source = clicontext.Args().First()
+			target = clicontext.Args().Get(1)
 		)
+		if source == "" || target == "" {
+			return errors.New("source and target references must be provided")
+		}
 		ctx, cancel := appContext(clicontext)
 		defer cancel()
 
@@ -156,21 +196,59 @@ var imageRemoveCommand = cli.Command{
 		}
 
 		imageStore := client.ImageService()
+		image, err := imageStore.Get(ctx, source)
+		if err != nil {
+			return errors.Wrapf(err, "unable to get %v", source)
+		}
+		tagged := images.Image{
+			Name:   target,
+			Labels: image.Labels,
+			Target: image.Target,
+		}
+		if _, err := imageStore.Create(ctx, tagged); err != nil {
+			if !errdefs.IsAlreadyExists(err) || !clicontext.Bool("force") {
+				return errors.Wrapf(err, "unable to tag %v", target)
+			}
+			if _, err := imageStore.Update(ctx, tagged, "target"); err != nil {
+				return errors.Wrapf(err, "unable to tag %v", target)
+			}
+		}
 
-		for _, target := range clicontext.Args() {
-			if err := imageStore.Delete(ctx, target); err != nil {
-				if !errdefs.IsNotFound(err) {
-					if exitErr == nil {
-						exitErr = errors.Wrapf(err, "unable to delete %v", target)
-					}
-					log.G(ctx).WithError(err).Errorf("unable to delete %v", target)
-					continue
+		fmt.Println(target)
+		return nil
+	},
+}
+
+func removeImages(clicontext *cli.Context, gc bool) error {
+	var (
+		exitErr error
+	)
+	ctx, cancel := appContext(clicontext)
+	defer cancel()
+
+	conn, err := getGRPCConnection(clicontext)
+	if err != nil {
+		return err
+	}
+
+	imagesClient := imagesapi.NewImagesClient(conn)
+
+	for _, target := range clicontext.Args() {
+		if _, err := imagesClient.Delete(ctx, &imagesapi.DeleteImageRequest{
+			Name: target,
+			GC:   gc,
+		}); err != nil {
+			if !errdefs.IsNotFound(errdefs.FromGRPC(err)) {
+				if exitErr == nil {
+					exitErr = errors.Wrapf(err, "unable to delete %v", target)
 				}
+				log.G(ctx).WithError(err).Errorf("unable to delete %v", target)
+				continue
 			}
-
-			fmt.Println(target)
 		}
 
-		return exitErr
-	},
+		fmt.Println(target)
+	}
+
+	return exitErr
 }
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"io"
+	"os"
+	"time"
+
+	stdioapi "github.com/containerd/containerd/api/services/stdio/v1"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var logsCommand = cli.Command{
+	Name:      "logs",
+	Usage:     "print the output of a container or exec process logged by a log driver",
+	ArgsUsage: "ID",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "follow, f",
+			Usage: "keep printing the output until the process exits",
+		},
+		cli.Int64Flag{
+			Name:  "tail",
+			Usage: "print only the last lines of the output",
+		},
+		cli.StringFlag{
+			Name:  "since",
+			Usage: "print only the output since a time, such as 2017-09-01T15:04:05Z, or a duration ago, such as 10m",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		var (
+			ctx, cancel = appContext(context)
+			id          = context.Args().First()
+		)
+		defer cancel()
+		if id == "" {
+			return errors.New("id must be provided")
+		}
+		request := &stdioapi.LogsRequest{
+			ID:     id,
+			Follow: context.Bool("follow"),
+			Tail:   context.Int64("tail"),
+		}
+		if v := context.String("since"); v != "" {
+			since, err := parseSince(v, time.Now())
+			if err != nil {
+				return err
+			}
+			request.Since = &since
+		}
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		stream, err := client.StdioService().Logs(ctx, request)
+		if err != nil {
+			return err
+		}
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+			w := os.Stdout
+			if resp.Stream == stdioapi.StreamStderr {
+				w = os.Stderr
+			}
+			w.Write(resp.Data)
+		}
+	},
+}
+
+// parseSince parses a time in RFC 3339 format or a duration before now
+func parseSince(v string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(v); err == nil {
+		return now.Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		return time.Time{}, errors.Errorf("invalid since %q, expected a time or a duration", v)
+	}
+	return t, nil
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 
+	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/containerd/server"
 	"github.com/containerd/containerd/version"
@@ -48,6 +49,18 @@ containerd CLI
 			Usage: "address for containerd's GRPC server",
 			Value: server.DefaultAddress,
 		},
+		cli.StringFlag{
+			Name:  "tls-ca",
+			Usage: "CA verifying the certificate of a tcp:// address",
+		},
+		cli.StringFlag{
+			Name:  "tls-cert",
+			Usage: "client certificate for a tcp:// address",
+		},
+		cli.StringFlag{
+			Name:  "tls-key",
+			Usage: "client key for a tcp:// address",
+		},
 		cli.DurationFlag{
 			Name:  "timeout",
 			Usage: "total timeout for ctr commands",
@@ -65,23 +78,38 @@ containerd CLI
 	}
 	app.Commands = append([]cli.Command{
 		applyCommand,
+		checkpointsCommand,
 		containersCommand,
 		contentCommand,
+		deprecationsCommand,
+		diagCommand,
+		dnsCommand,
 		eventsCommand,
 		fetchCommand,
 		fetchObjectCommand,
 		imageCommand,
+		logsCommand,
+		metadataCommand,
 		namespacesCommand,
+		pluginsCommand,
 		pprofCommand,
+		profilesCommand,
 		pullCommand,
 		pushCommand,
 		pushObjectCommand,
+		reloadCommand,
+		replayCommand,
 		rootfsCommand,
 		runCommand,
+		sandboxesCommand,
 		snapshotCommand,
+		specCommand,
+		statsCommand,
 		tasksCommand,
 		versionCommand,
 	}, extraCmds...)
+	app.Commands = append(app.Commands, externalCommands(app.Commands)...)
+	app.Action = pluginCommand
 	app.Before = func(context *cli.Context) error {
 		if context.GlobalBool("debug") {
 			logrus.SetLevel(logrus.DebugLevel)
@@ -90,6 +118,9 @@ containerd CLI
 	}
 	if err := app.Run(os.Args); err != nil {
 		fmt.Fprintf(os.Stderr, "ctr: %s\n", err)
+		if hint, ok := errdefs.GetHint(err); ok {
+			fmt.Fprintf(os.Stderr, "ctr: hint: %s\n", hint.Remediation)
+		}
 		os.Exit(1)
 	}
 }
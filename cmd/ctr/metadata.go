@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	metadataapi "github.com/containerd/containerd/api/services/metadata/v1"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var metadataCommand = cli.Command{
+	Name:  "metadata",
+	Usage: "access the metadata store",
+	Subcommands: []cli.Command{
+		metadataExportCommand,
+	},
+}
+
+var metadataExportCommand = cli.Command{
+	Name:      "export",
+	Usage:     "write a consistent copy of the metadata store to a file",
+	ArgsUsage: "<out>",
+	Description: `Export a copy of the metadata store of the running daemon.
+
+The copy is a boltdb file that backup and inventory tools can read while
+the daemon keeps running. The schema of its bucket layout is printed.
+`,
+	Action: func(context *cli.Context) error {
+		out := context.Args().First()
+		if out == "" {
+			return errors.New("output file must be provided")
+		}
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		stream, err := client.MetadataService().Export(ctx, &metadataapi.ExportMetadataRequest{})
+		if err != nil {
+			return err
+		}
+		f, err := os.OpenFile(out, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		var (
+			schema    string
+			length, n int64
+		)
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				f.Close()
+				return err
+			}
+			if resp.Schema != "" {
+				schema, length = resp.Schema, resp.Length
+			}
+			if _, err := f.Write(resp.Data); err != nil {
+				f.Close()
+				return err
+			}
+			n += int64(len(resp.Data))
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+		if n != length {
+			return errors.Errorf("incomplete export, received %d of %d bytes", n, length)
+		}
+		fmt.Println(schema)
+		return nil
+	},
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	introspectionapi "github.com/containerd/containerd/api/services/introspection/v1"
+	"github.com/containerd/containerd/plugin"
+	"github.com/urfave/cli"
+)
+
+var pluginsCommand = cli.Command{
+	Name:      "plugins",
+	Usage:     "list the plugins of the daemon",
+	ArgsUsage: "[filter]",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "detailed,d",
+			Usage: "print the exports, capabilities and init error of each plugin",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		response, err := client.IntrospectionService().Plugins(ctx, &introspectionapi.PluginsRequest{
+			Filter: context.Args().First(),
+		})
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 10, 1, 3, ' ', 0)
+		if context.Bool("detailed") {
+			for _, p := range response.Plugins {
+				fmt.Fprintf(w, "Type:\t%s\n", p.Type)
+				fmt.Fprintf(w, "ID:\t%s\n", p.ID)
+				if len(p.Requires) > 0 {
+					fmt.Fprintf(w, "Requires:\t%s\n", strings.Join(p.Requires, ","))
+				}
+				for k, v := range p.Exports {
+					fmt.Fprintf(w, "Export:\t%s=%s\n", k, v)
+				}
+				if len(p.Capabilities) > 0 {
+					fmt.Fprintf(w, "Capabilities:\t%s\n", strings.Join(p.Capabilities, ","))
+				}
+				if p.InitError != "" {
+					fmt.Fprintf(w, "Error:\t%s\n", p.InitError)
+				}
+				fmt.Fprintln(w)
+			}
+			return w.Flush()
+		}
+		fmt.Fprintln(w, "TYPE\tID\tSTATUS\t")
+		for _, p := range response.Plugins {
+			status := "ok"
+			switch {
+			case strings.HasSuffix(p.InitError, plugin.SkipPlugin.Error()):
+				status = "skip"
+			case p.InitError != "":
+				status = "error"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t\n", p.Type, p.ID, status)
+		}
+		return w.Flush()
+	},
+}
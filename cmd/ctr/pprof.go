@@ -34,6 +34,8 @@ var pprofCommand = cli.Command{
 		pprofTraceCommand,
 		pprofBlockCommand,
 		pprofThreadcreateCommand,
+		pprofMutexCommand,
+		pprofVarsCommand,
 	},
 }
 
@@ -142,6 +144,38 @@ var pprofThreadcreateCommand = cli.Command{
 	},
 }
 
+var pprofMutexCommand = cli.Command{
+	Name:  "mutex",
+	Usage: "mutex contention profile",
+	Action: func(context *cli.Context) error {
+		client := getPProfClient(context)
+
+		output, err := httpGetRequest(client, "/debug/pprof/mutex")
+		if err != nil {
+			return err
+		}
+		defer output.Close()
+		_, err = io.Copy(os.Stdout, output)
+		return err
+	},
+}
+
+var pprofVarsCommand = cli.Command{
+	Name:  "vars",
+	Usage: "memory and runtime stats",
+	Action: func(context *cli.Context) error {
+		client := getPProfClient(context)
+
+		output, err := httpGetRequest(client, "/debug/vars")
+		if err != nil {
+			return err
+		}
+		defer output.Close()
+		_, err = io.Copy(os.Stdout, output)
+		return err
+	},
+}
+
 func getPProfClient(context *cli.Context) *http.Client {
 	dialer := getPProfDialer(context.GlobalString("debug-socket"))
 
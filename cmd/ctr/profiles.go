@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	profilesapi "github.com/containerd/containerd/api/services/profiles/v1"
+	units "github.com/docker/go-units"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var profilesCommand = cli.Command{
+	Name:  "profiles",
+	Usage: "manage the seccomp and apparmor profiles referenced by containers",
+	Subcommands: cli.Commands{
+		profilesListCommand,
+		profilesGetCommand,
+		profilesPutCommand,
+		profilesDeleteCommand,
+	},
+}
+
+var profilesListCommand = cli.Command{
+	Name:      "list",
+	Aliases:   []string{"ls"},
+	Usage:     "list profiles",
+	ArgsUsage: "[seccomp|apparmor]",
+	Action: func(context *cli.Context) error {
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		resp, err := client.ProfileService().List(ctx, &profilesapi.ListProfilesRequest{
+			Kind: context.Args().First(),
+		})
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 10, 1, 3, ' ', 0)
+		fmt.Fprintln(w, "KIND\tNAME\tSOURCE\tAGE\t")
+		for _, p := range resp.Profiles {
+			source := "api"
+			if p.Config {
+				source = "config"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n",
+				p.Kind,
+				p.Name,
+				source,
+				units.HumanDuration(time.Since(p.CreatedAt)))
+		}
+		return w.Flush()
+	},
+}
+
+var profilesGetCommand = cli.Command{
+	Name:      "get",
+	Usage:     "print a profile",
+	ArgsUsage: "seccomp|apparmor NAME",
+	Action: func(context *cli.Context) error {
+		if context.NArg() != 2 {
+			return errors.New("profile kind and name must be provided")
+		}
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		resp, err := client.ProfileService().Get(ctx, &profilesapi.GetProfileRequest{
+			Kind: context.Args().Get(0),
+			Name: context.Args().Get(1),
+		})
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(resp.Profile.Data)
+		return err
+	},
+}
+
+var profilesPutCommand = cli.Command{
+	Name:      "put",
+	Usage:     "register a profile from a file, or stdin with -",
+	ArgsUsage: "seccomp|apparmor NAME FILE",
+	Action: func(context *cli.Context) error {
+		if context.NArg() != 3 {
+			return errors.New("profile kind, name and file must be provided")
+		}
+		var (
+			data []byte
+			err  error
+			path = context.Args().Get(2)
+		)
+		if path == "-" {
+			data, err = ioutil.ReadAll(os.Stdin)
+		} else {
+			data, err = ioutil.ReadFile(path)
+		}
+		if err != nil {
+			return err
+		}
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		_, err = client.ProfileService().Put(ctx, &profilesapi.PutProfileRequest{
+			Profile: profilesapi.Profile{
+				Kind: context.Args().Get(0),
+				Name: context.Args().Get(1),
+				Data: data,
+			},
+		})
+		return err
+	},
+}
+
+var profilesDeleteCommand = cli.Command{
+	Name:      "delete",
+	Aliases:   []string{"rm"},
+	Usage:     "delete one or more profiles registered with the api",
+	ArgsUsage: "seccomp|apparmor NAME [NAME, ...]",
+	Action: func(context *cli.Context) error {
+		if context.NArg() < 2 {
+			return errors.New("profile kind and name must be provided")
+		}
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		kind := context.Args().First()
+		for _, name := range context.Args().Tail() {
+			if _, err := client.ProfileService().Delete(ctx, &profilesapi.DeleteProfileRequest{
+				Kind: kind,
+				Name: name,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to delete %s profile %s", kind, name)
+			}
+		}
+		return nil
+	},
+}
@@ -36,14 +36,14 @@ var taskPsCommand = cli.Command{
 		if err != nil {
 			return err
 		}
-		processes, err := task.Pids(ctx)
+		processes, err := task.Processes(ctx)
 		if err != nil {
 			return err
 		}
 		w := tabwriter.NewWriter(os.Stdout, 10, 1, 3, ' ', 0)
-		fmt.Fprintln(w, "PID")
+		fmt.Fprintln(w, "PID\tEXEC")
 		for _, ps := range processes {
-			if _, err := fmt.Fprintf(w, "%d\n", ps); err != nil {
+			if _, err := fmt.Fprintf(w, "%d\t%s\n", ps.Pid, ps.ExecID); err != nil {
 				return err
 			}
 		}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	daemonapi "github.com/containerd/containerd/api/services/daemon/v1"
+	"github.com/urfave/cli"
+)
+
+var reloadCommand = cli.Command{
+	Name:  "reload",
+	Usage: "reload the configuration of the daemon",
+	Description: `Reload the configuration file of the running daemon, as sending it SIGHUP
+does, and list the settings that changed.
+
+Settings are either applied to the running daemon, only applied once it is
+restarted, or refused, in which case the daemon keeps their previous value.
+Running containers are not affected.
+`,
+	Action: func(context *cli.Context) error {
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		resp, err := client.DaemonService().Reload(ctx, &daemonapi.ReloadRequest{})
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 10, 1, 3, ' ', 0)
+		fmt.Fprintln(w, "SETTING\tSTATUS\tERROR\t")
+		for _, name := range resp.Applied {
+			fmt.Fprintf(w, "%s\tapplied\t\t\n", name)
+		}
+		for _, name := range resp.RestartRequired {
+			fmt.Fprintf(w, "%s\trestart required\t\t\n", name)
+		}
+		var failed []string
+		for name := range resp.Failed {
+			failed = append(failed, name)
+		}
+		sort.Strings(failed)
+		for _, name := range failed {
+			fmt.Fprintf(w, "%s\tfailed\t%s\t\n", name, resp.Failed[name])
+		}
+		return w.Flush()
+	},
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/containerd/containerd/record"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var replayCommand = cli.Command{
+	Name:      "replay",
+	Usage:     "replay a trace of recorded calls against the daemon",
+	ArgsUsage: "<trace>",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "all, a",
+			Usage: "print the calls that ended with their recorded code too",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		path := context.Args().First()
+		if path == "" {
+			return errors.New("trace must be provided")
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		ctx, cancel := appContext(context)
+		defer cancel()
+		conn, err := getGRPCConnection(context)
+		if err != nil {
+			return err
+		}
+		results, err := record.Replay(ctx, conn, f)
+		w := tabwriter.NewWriter(os.Stdout, 10, 1, 3, ' ', 0)
+		fmt.Fprintln(w, "METHOD\tRECORDED\tREPLAYED\tERROR\t")
+		var mismatches int
+		for _, r := range results {
+			if !r.Matches() {
+				mismatches++
+			} else if !context.Bool("all") {
+				continue
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n", r.Record.Method, r.Record.Code, r.Code, r.Error)
+		}
+		if ferr := w.Flush(); ferr != nil {
+			return ferr
+		}
+		if err != nil {
+			return err
+		}
+		if mismatches > 0 {
+			return errors.Errorf("%d of %d calls did not end with their recorded code", mismatches, len(results))
+		}
+		return nil
+	},
+}
@@ -3,12 +3,14 @@ package main
 import (
 	gocontext "context"
 	"fmt"
+	"os"
 	"runtime"
+	"strings"
 	"syscall"
 
 	"github.com/containerd/console"
 	"github.com/containerd/containerd"
-	digest "github.com/opencontainers/go-digest"
+	"github.com/containerd/containerd/logfile"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -57,7 +59,7 @@ var runCommand = cli.Command{
 		},
 		cli.StringFlag{
 			Name:  "runtime",
-			Usage: "runtime name (io.containerd.runtime.v1.linux, io.containerd.runtime.v1.windows, io.containerd.runtime.v1.com.vmware.linux)",
+			Usage: "runtime name (io.containerd.runtime.v1.linux, io.containerd.runtime.v1.windows, io.containerd.runtime.v1.solaris, io.containerd.runtime.v1.com.vmware.linux)",
 			Value: fmt.Sprintf("io.containerd.runtime.v1.%s", runtime.GOOS),
 		},
 		cli.BoolFlag{
@@ -86,13 +88,54 @@ var runCommand = cli.Command{
 		},
 		cli.StringFlag{
 			Name:  "checkpoint",
-			Usage: "provide the checkpoint digest to restore the container",
+			Usage: "restore the container from the checkpoint with the name or index digest",
+		},
+		cli.IntFlag{
+			Name:  "priority",
+			Usage: "priority used to order the task's create and start when the daemon is under load",
+		},
+		cli.StringFlag{
+			Name:  "timezone",
+			Usage: "timezone of the container, host or a name such as Europe/Paris, bound from the timezone data of the host",
+		},
+		cli.StringFlag{
+			Name:  "locale",
+			Usage: "locale of the container set as LANG (ex: en_US.UTF-8)",
+		},
+		cli.BoolFlag{
+			Name:  "attach",
+			Usage: "proxy the stdio of the container over the GRPC connection instead of fifos on the host",
+		},
+		cli.StringFlag{
+			Name:  "log-driver",
+			Usage: "log the output of the container with a log driver of the daemon (json-file, journald, syslog, binary)",
+		},
+		cli.StringSliceFlag{
+			Name:  "log-opt",
+			Usage: "option of the log driver (ex: max-size=10m)",
+		},
+		cli.StringFlag{
+			Name:  "log-uri",
+			Usage: "send the output of the container to a URI written by the shim (file:///path, binary:///path?arg=x, null://)",
+		},
+		cli.StringFlag{
+			Name:  "log-file",
+			Usage: "write the output of the container to a log file in the CRI format instead of the terminal",
+		},
+		cli.IntFlag{
+			Name:  "max-log-line",
+			Usage: "maximum length of the lines of the log file, longer lines are split into partial entries",
+			Value: logfile.DefaultMaxLineSize,
+		},
+		cli.StringFlag{
+			Name:  "restart",
+			Usage: "restart policy for the task when it exits (no, always, on-failure[:max])",
 		},
 	}, snapshotterFlags...),
 	Action: func(context *cli.Context) error {
 		var (
-			err             error
-			checkpointIndex digest.Digest
+			err        error
+			checkpoint = context.String("checkpoint")
 
 			ctx, cancel = appContext(context)
 			id          = context.Args().Get(1)
@@ -107,11 +150,6 @@ var runCommand = cli.Command{
 		if id == "" {
 			return errors.New("container id must be provided")
 		}
-		if raw := context.String("checkpoint"); raw != "" {
-			if checkpointIndex, err = digest.Parse(raw); err != nil {
-				return err
-			}
-		}
 		client, err := newClient(context)
 		if err != nil {
 			return err
@@ -123,7 +161,62 @@ var runCommand = cli.Command{
 		if context.Bool("rm") {
 			defer container.Delete(ctx, containerd.WithSnapshotCleanup)
 		}
-		task, err := newTask(ctx, container, checkpointIndex, tty)
+		var (
+			task     containerd.Task
+			taskOpts = []containerd.NewTaskOpts{
+				containerd.WithTaskPriority(int32(context.Int("priority"))),
+			}
+		)
+		if timezone := context.String("timezone"); timezone != "" {
+			taskOpts = append(taskOpts, containerd.WithTimezone(timezone))
+		}
+		if locale := context.String("locale"); locale != "" {
+			taskOpts = append(taskOpts, containerd.WithLocale(locale))
+		}
+		if parent := context.String("cgroup-parent"); parent != "" {
+			taskOpts = append(taskOpts, containerd.WithCgroupParent(parent))
+		}
+		if context.Bool("systemd-cgroup") {
+			taskOpts = append(taskOpts, containerd.WithSystemdCgroup)
+		}
+		for _, join := range context.StringSlice("join-ns") {
+			parts := strings.SplitN(join, "=", 2)
+			if len(parts) != 2 {
+				return errors.Errorf("invalid namespace to join %q", join)
+			}
+			taskOpts = append(taskOpts, containerd.WithNamespaceOf(specs.LinuxNamespaceType(parts[0]), parts[1]))
+		}
+		if logFile := context.String("log-file"); logFile != "" {
+			if tty || checkpoint != "" {
+				return errors.New("log-file cannot be used with a tty or a checkpoint")
+			}
+			task, err = container.NewTask(ctx, containerd.LogFile(logFile, context.Int("max-log-line")), taskOpts...)
+		} else if uri := context.String("log-uri"); uri != "" {
+			if tty || checkpoint != "" {
+				return errors.New("log-uri cannot be used with a tty or a checkpoint")
+			}
+			task, err = container.NewTask(ctx, containerd.URIIO("", uri, uri), taskOpts...)
+		} else if driver := context.String("log-driver"); driver != "" {
+			if tty || checkpoint != "" {
+				return errors.New("log-driver cannot be used with a tty or a checkpoint")
+			}
+			options := make(map[string]string)
+			for _, opt := range context.StringSlice("log-opt") {
+				parts := strings.SplitN(opt, "=", 2)
+				if len(parts) != 2 {
+					return errors.Errorf("invalid log option %q", opt)
+				}
+				options[parts[0]] = parts[1]
+			}
+			task, err = container.NewTask(ctx, client.LogDriverIO(ctx, driver, options), taskOpts...)
+		} else if context.Bool("attach") {
+			if checkpoint != "" {
+				return errors.New("attach cannot be used with a checkpoint")
+			}
+			task, err = container.NewTask(ctx, client.RemoteIO(ctx, os.Stdin, os.Stdout, os.Stderr, tty), taskOpts...)
+		} else {
+			task, err = newTask(ctx, container, checkpoint, tty, taskOpts...)
+		}
 		if err != nil {
 			return err
 		}
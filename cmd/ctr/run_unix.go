@@ -6,14 +6,18 @@ import (
 	gocontext "context"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 
 	"golang.org/x/sys/unix"
 
 	"github.com/containerd/console"
 	"github.com/containerd/containerd"
-	digest "github.com/opencontainers/go-digest"
-	"github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/containerd/containerd/profiles"
+	"github.com/containerd/containerd/snapshot"
+	units "github.com/docker/go-units"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 )
@@ -22,9 +26,99 @@ func init() {
 	runCommand.Flags = append(runCommand.Flags, cli.BoolFlag{
 		Name:  "rootfs",
 		Usage: "Use custom rootfs that is not managed by containerd snapshotter.",
+	}, cli.StringFlag{
+		Name:  "rootfs-size",
+		Usage: "limit the disk space used by the writable layer of the container, such as 10g",
+	}, cli.StringFlag{
+		Name:  "volume-root",
+		Usage: "mount volumes under this directory at the volume paths of the image, initialized from the image content",
+	}, cli.BoolFlag{
+		Name:  "pid-host",
+		Usage: "enable host pid namespace for the container",
+	}, cli.BoolFlag{
+		Name:  "ipc-host",
+		Usage: "enable host ipc namespace for the container",
+	}, cli.BoolFlag{
+		Name:  "no-cgroup-limits",
+		Usage: "remove the resource limits of the container",
+	}, cli.StringFlag{
+		Name:  "uidmap",
+		Usage: "run the container in a user namespace with the uid mapping container-uid:host-uid:length",
+	}, cli.StringFlag{
+		Name:  "gidmap",
+		Usage: "run the container in a user namespace with the gid mapping container-gid:host-gid:length",
+	}, cli.StringFlag{
+		Name:  "network",
+		Usage: "set up the network of the container in the CNI network with the name, or default",
+	}, cli.StringFlag{
+		Name:  "sandbox",
+		Usage: "create the container in the sandbox with the id",
+	}, cli.StringFlag{
+		Name:  "cgroup-parent",
+		Usage: "create the cgroup of the container under the parent, a slice with --systemd-cgroup",
+	}, cli.BoolFlag{
+		Name:  "systemd-cgroup",
+		Usage: "manage the cgroup of the container as a transient systemd scope",
+	}, cli.StringSliceFlag{
+		Name:  "device",
+		Usage: "request devices from the device plugin of their kind, kind=id[,id] or kind=all, such as nvidia.com/gpu=0",
+	}, cli.StringFlag{
+		Name:  "seccomp-profile",
+		Usage: "run the container with the seccomp profile registered with the name in the daemon",
+	}, cli.StringFlag{
+		Name:  "apparmor-profile",
+		Usage: "run the container with the apparmor profile registered with the name in the daemon",
+	}, cli.StringSliceFlag{
+		Name:  "join-ns",
+		Usage: "join a namespace of the task of another container, type=container-id with a type of network, ipc, pid or uts",
 	})
 }
 
+// parseIDMapping parses a container-id:host-id:length mapping of ids
+func parseIDMapping(s string) (specs.LinuxIDMapping, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return specs.LinuxIDMapping{}, errors.Errorf("invalid id mapping %q, expected container-id:host-id:length", s)
+	}
+	var ids [3]uint32
+	for i, p := range parts {
+		v, err := strconv.ParseUint(p, 10, 32)
+		if err != nil {
+			return specs.LinuxIDMapping{}, errors.Wrapf(err, "invalid id mapping %q", s)
+		}
+		ids[i] = uint32(v)
+	}
+	return specs.LinuxIDMapping{
+		ContainerID: ids[0],
+		HostID:      ids[1],
+		Size:        ids[2],
+	}, nil
+}
+
+// userNamespace returns the uid and gid mappings of the flags, nil when the
+// container has no user namespace
+func userNamespace(context *cli.Context) (*specs.LinuxIDMapping, *specs.LinuxIDMapping, error) {
+	uidmap, gidmap := context.String("uidmap"), context.String("gidmap")
+	if uidmap == "" && gidmap == "" {
+		return nil, nil, nil
+	}
+	if uidmap == "" || gidmap == "" {
+		return nil, nil, errors.New("--uidmap and --gidmap must be set together")
+	}
+	uid, err := parseIDMapping(uidmap)
+	if err != nil {
+		return nil, nil, err
+	}
+	gid, err := parseIDMapping(gidmap)
+	if err != nil {
+		return nil, nil, err
+	}
+	if uid.ContainerID != 0 || gid.ContainerID != 0 {
+		return nil, nil, errors.New("the user namespace mappings must map the root of the container")
+	}
+	return &uid, &gid, nil
+}
+
 func handleConsoleResize(ctx gocontext.Context, task resizer, con console.Console) error {
 	// do an initial resize of the console
 	size, err := con.Size()
@@ -56,7 +150,7 @@ func withTTY() containerd.SpecOpts {
 }
 
 func setHostNetworking() containerd.SpecOpts {
-	return containerd.WithHostNamespace(specs.NetworkNamespace)
+	return containerd.WithHostNetwork
 }
 
 func newContainer(ctx gocontext.Context, client *containerd.Client, context *cli.Context) (containerd.Container, error) {
@@ -66,25 +160,50 @@ func newContainer(ctx gocontext.Context, client *containerd.Client, context *cli
 		args = context.Args()[2:]
 	)
 
-	if raw := context.String("checkpoint"); raw != "" {
-		checkpointIndex, err := digest.Parse(raw)
-		if err != nil {
-			return nil, err
-		}
-		if checkpointIndex != "" {
-			return client.NewContainer(ctx, id, containerd.WithCheckpoint(v1.Descriptor{
-				Digest: checkpointIndex,
-			}, id))
-		}
+	if checkpoint := context.String("checkpoint"); checkpoint != "" {
+		return client.NewContainer(ctx, id, containerd.WithCheckpointRef(checkpoint, id))
 	}
 
+	uid, gid, err := userNamespace(context)
+	if err != nil {
+		return nil, err
+	}
+	if context.String("rootfs-size") != "" && (context.Bool("rootfs") || context.Bool("readonly") || uid != nil) {
+		return nil, errors.New("rootfs-size can only be used with a writable snapshot of the image outside of a user namespace")
+	}
 	var (
 		opts  []containerd.SpecOpts
 		cOpts []containerd.NewContainerOpts
 	)
 	cOpts = append(cOpts, containerd.WithContainerLabels(labelArgs(context.StringSlice("label"))))
+	if policy := context.String("restart"); policy != "" {
+		cOpts = append(cOpts, containerd.WithRestartPolicy(policy))
+	}
+	if name := context.String("network"); name != "" {
+		cOpts = append(cOpts, containerd.WithNetwork(name))
+	}
+	if id := context.String("sandbox"); id != "" {
+		cOpts = append(cOpts, containerd.WithSandbox(id))
+	}
+	if name := context.String("seccomp-profile"); name != "" {
+		cOpts = append(cOpts, containerd.WithProfile(profiles.Seccomp, name))
+	}
+	if name := context.String("apparmor-profile"); name != "" {
+		cOpts = append(cOpts, containerd.WithProfile(profiles.AppArmor, name))
+	}
+	for _, d := range context.StringSlice("device") {
+		parts := strings.SplitN(d, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid device request %q, expected kind=id[,id]", d)
+		}
+		cOpts = append(cOpts, containerd.WithDeviceRequest(parts[0], strings.Split(parts[1], ",")...))
+	}
 	if context.Bool("rootfs") {
 		opts = append(opts, containerd.WithRootFSPath(ref, context.Bool("readonly")))
+		if uid != nil {
+			opts = append(opts, containerd.WithUserNamespaceMappings(
+				[]specs.LinuxIDMapping{*uid}, []specs.LinuxIDMapping{*gid}))
+		}
 	} else {
 		image, err := client.GetImage(ctx, ref)
 		if err != nil {
@@ -93,10 +212,32 @@ func newContainer(ctx gocontext.Context, client *containerd.Client, context *cli
 		opts = append(opts, containerd.WithImageConfig(ctx, image))
 		cOpts = append(cOpts, containerd.WithImage(image))
 		cOpts = append(cOpts, containerd.WithSnapshotter(context.String("snapshotter")))
-		if context.Bool("readonly") {
+		if uid != nil {
+			// the files of the image are owned by the root of the user
+			// namespace in a remapped snapshot
+			opts = append(opts, containerd.WithUserNamespaceMappings(
+				[]specs.LinuxIDMapping{*uid}, []specs.LinuxIDMapping{*gid}))
+			if context.Bool("readonly") {
+				cOpts = append(cOpts, containerd.WithRemappedSnapshotView(id, image, uid.HostID, gid.HostID))
+			} else {
+				cOpts = append(cOpts, containerd.WithRemappedSnapshot(id, image, uid.HostID, gid.HostID))
+			}
+		} else if context.Bool("readonly") {
 			cOpts = append(cOpts, containerd.WithNewSnapshotView(id, image))
 		} else {
-			cOpts = append(cOpts, containerd.WithNewSnapshot(id, image))
+			var sOpts []snapshot.Opt
+			if raw := context.String("rootfs-size"); raw != "" {
+				size, err := units.RAMInBytes(raw)
+				if err != nil {
+					return nil, errors.Wrapf(err, "invalid rootfs-size %q", raw)
+				}
+				sOpts = append(sOpts, snapshot.WithSizeLimit(size))
+			}
+			cOpts = append(cOpts, containerd.WithNewSnapshot(id, image, sOpts...))
+		}
+		// the volumes are owned by the root of the user namespace
+		if root := context.String("volume-root"); root != "" {
+			opts = append(opts, containerd.WithImageVolumes(ctx, id, image, context.String("snapshotter"), root, nil))
 		}
 	}
 	cOpts = append(cOpts, containerd.WithRuntime(context.String("runtime")))
@@ -111,6 +252,15 @@ func newContainer(ctx gocontext.Context, client *containerd.Client, context *cli
 	if context.Bool("net-host") {
 		opts = append(opts, setHostNetworking())
 	}
+	if context.Bool("pid-host") {
+		opts = append(opts, containerd.WithHostPID)
+	}
+	if context.Bool("ipc-host") {
+		opts = append(opts, containerd.WithHostIPC)
+	}
+	if context.Bool("no-cgroup-limits") {
+		opts = append(opts, containerd.WithNoCgroupLimits)
+	}
 	spec, err := containerd.GenerateSpec(opts...)
 	if err != nil {
 		return nil, err
@@ -119,15 +269,14 @@ func newContainer(ctx gocontext.Context, client *containerd.Client, context *cli
 	return client.NewContainer(ctx, id, cOpts...)
 }
 
-func newTask(ctx gocontext.Context, container containerd.Container, checkpoint digest.Digest, tty bool) (containerd.Task, error) {
+func newTask(ctx gocontext.Context, container containerd.Container, checkpoint string, tty bool, opts ...containerd.NewTaskOpts) (containerd.Task, error) {
 	if checkpoint == "" {
 		io := containerd.Stdio
 		if tty {
 			io = containerd.StdioTerminal
 		}
-		return container.NewTask(ctx, io)
+		return container.NewTask(ctx, io, opts...)
 	}
-	return container.NewTask(ctx, containerd.Stdio, containerd.WithTaskCheckpoint(v1.Descriptor{
-		Digest: checkpoint,
-	}))
+	opts = append(opts, containerd.WithTaskCheckpointRef(checkpoint))
+	return container.NewTask(ctx, containerd.Stdio, opts...)
 }
@@ -8,7 +8,6 @@ import (
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/log"
-	digest "github.com/opencontainers/go-digest"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -114,18 +113,22 @@ func newContainer(ctx gocontext.Context, client *containerd.Client, context *cli
 		return nil, err
 	}
 
-	return client.NewContainer(ctx, id,
+	cOpts := []containerd.NewContainerOpts{
 		containerd.WithSpec(spec),
 		containerd.WithContainerLabels(labels),
 		containerd.WithRuntime(context.String("runtime")),
 		// TODO(mlaventure): containerd.WithImage(image),
-	)
+	}
+	if policy := context.String("restart"); policy != "" {
+		cOpts = append(cOpts, containerd.WithRestartPolicy(policy))
+	}
+	return client.NewContainer(ctx, id, cOpts...)
 }
 
-func newTask(ctx gocontext.Context, container containerd.Container, _ digest.Digest, tty bool) (containerd.Task, error) {
+func newTask(ctx gocontext.Context, container containerd.Container, _ string, tty bool, opts ...containerd.NewTaskOpts) (containerd.Task, error) {
 	io := containerd.Stdio
 	if tty {
 		io = containerd.StdioTerminal
 	}
-	return container.NewTask(ctx, io)
+	return container.NewTask(ctx, io, opts...)
 }
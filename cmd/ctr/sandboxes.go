@@ -0,0 +1,155 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	sandboxesapi "github.com/containerd/containerd/api/services/sandboxes/v1"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var sandboxesCommand = cli.Command{
+	Name:    "sandboxes",
+	Aliases: []string{"sandbox"},
+	Usage:   "manage sandboxes of containers sharing namespaces",
+	Subcommands: cli.Commands{
+		sandboxesCreateCommand,
+		sandboxesListCommand,
+		sandboxesDeleteCommand,
+	},
+}
+
+var sandboxesCreateCommand = cli.Command{
+	Name:      "create",
+	Usage:     "create a sandbox",
+	ArgsUsage: "SANDBOX [<key>=<value>, ...]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "network",
+			Usage: "add the sandbox to the CNI network with the name, or default",
+		},
+		cli.StringFlag{
+			Name:  "cgroup-parent",
+			Usage: "create the cgroups of the containers of the sandbox under the parent",
+		},
+		cli.BoolFlag{
+			Name:  "share-pid",
+			Usage: "share the pid namespace of the first task between the containers of the sandbox",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		id, labels := objectWithLabelArgs(context)
+		if id == "" {
+			return errors.New("sandbox id must be provided")
+		}
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		resp, err := client.SandboxService().Create(ctx, &sandboxesapi.CreateSandboxRequest{
+			Sandbox: sandboxesapi.Sandbox{
+				ID:           id,
+				Labels:       labels,
+				Network:      context.String("network"),
+				CgroupParent: context.String("cgroup-parent"),
+				SharePid:     context.Bool("share-pid"),
+			},
+		})
+		if err != nil {
+			return err
+		}
+		var types []string
+		for t := range resp.Sandbox.Namespaces {
+			types = append(types, t)
+		}
+		sort.Strings(types)
+		for _, t := range types {
+			fmt.Printf("%s\t%s\n", t, resp.Sandbox.Namespaces[t])
+		}
+		return nil
+	},
+}
+
+var sandboxesListCommand = cli.Command{
+	Name:      "list",
+	Aliases:   []string{"ls"},
+	Usage:     "list sandboxes",
+	ArgsUsage: "[filter, ...]",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "quiet, q",
+			Usage: "print only the sandbox id",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		resp, err := client.SandboxService().List(ctx, &sandboxesapi.ListSandboxesRequest{
+			Filters: context.Args(),
+		})
+		if err != nil {
+			return err
+		}
+		if context.Bool("quiet") {
+			for _, s := range resp.Sandboxes {
+				fmt.Println(s.ID)
+			}
+			return nil
+		}
+		w := tabwriter.NewWriter(os.Stdout, 10, 1, 3, ' ', 0)
+		fmt.Fprintln(w, "ID\tNETWORK\tCGROUP PARENT\tSHARE PID\tLABELS\t")
+		for _, s := range resp.Sandboxes {
+			var labels []string
+			for k, v := range s.Labels {
+				labels = append(labels, k+"="+v)
+			}
+			sort.Strings(labels)
+			network := s.Network
+			if network == "" {
+				network = "-"
+			}
+			cgroupParent := s.CgroupParent
+			if cgroupParent == "" {
+				cgroupParent = "-"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%s\t\n", s.ID, network, cgroupParent, s.SharePid, strings.Join(labels, ","))
+		}
+		return w.Flush()
+	},
+}
+
+var sandboxesDeleteCommand = cli.Command{
+	Name:      "delete",
+	Aliases:   []string{"rm"},
+	Usage:     "delete one or more sandboxes without containers",
+	ArgsUsage: "SANDBOX [SANDBOX, ...]",
+	Action: func(context *cli.Context) error {
+		if context.NArg() == 0 {
+			return errors.New("sandbox id must be provided")
+		}
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		for _, id := range context.Args() {
+			if _, err := client.SandboxService().Delete(ctx, &sandboxesapi.DeleteSandboxRequest{
+				ID: id,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to delete sandbox %s", id)
+			}
+		}
+		return nil
+	},
+}
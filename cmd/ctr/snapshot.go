@@ -27,6 +27,8 @@ var snapshotCommand = cli.Command{
 		treeSnapshotCommand,
 		mountSnapshotCommand,
 		commitSnapshotCommand,
+		exportSnapshotCommand,
+		importSnapshotCommand,
 	},
 }
 
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	snapshotapi "github.com/containerd/containerd/api/services/snapshot/v1"
+	"github.com/urfave/cli"
+)
+
+// snapshotChunkSize is the size of the archive data sent in each message of
+// an import
+const snapshotChunkSize = 1 << 20
+
+var exportSnapshotCommand = cli.Command{
+	Name:      "export",
+	Usage:     "write the changes of a snapshot against its parent to a tar archive",
+	ArgsUsage: "<key> <out>",
+	Description: `Export the changes of a committed or active snapshot, such as the
+writable layer of a container, so that they can be imported into the
+same snapshotter on another host. The parent of the snapshot, which must be
+present on that host, is printed.
+`,
+	Action: func(clicontext *cli.Context) error {
+		if clicontext.NArg() != 2 {
+			return cli.ShowSubcommandHelp(clicontext)
+		}
+		ctx, cancel := appContext(clicontext)
+		defer cancel()
+		conn, err := getGRPCConnection(clicontext)
+		if err != nil {
+			return err
+		}
+		stream, err := snapshotapi.NewSnapshotsClient(conn).Export(ctx, &snapshotapi.ExportSnapshotRequest{
+			Snapshotter: clicontext.GlobalString("snapshotter"),
+			Key:         clicontext.Args().Get(0),
+		})
+		if err != nil {
+			return err
+		}
+		f, err := os.OpenFile(clicontext.Args().Get(1), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		var parent string
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				f.Close()
+				return err
+			}
+			if resp.Info != nil {
+				parent = resp.Info.Parent
+			}
+			if _, err := f.Write(resp.Data); err != nil {
+				f.Close()
+				return err
+			}
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+		fmt.Println(parent)
+		return nil
+	},
+}
+
+var importSnapshotCommand = cli.Command{
+	Name:      "import",
+	Usage:     "commit the changes of a tar archive on top of a parent snapshot",
+	ArgsUsage: "[flags] <name> <in>",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "parent",
+			Usage: "snapshot the changes are applied to, such as the chain ID of the layers of an image",
+		},
+		cli.StringSliceFlag{
+			Name:  "label",
+			Usage: "labels to attach to the snapshot (foo=bar)",
+		},
+	},
+	Action: func(clicontext *cli.Context) error {
+		if clicontext.NArg() != 2 {
+			return cli.ShowSubcommandHelp(clicontext)
+		}
+		ctx, cancel := appContext(clicontext)
+		defer cancel()
+		conn, err := getGRPCConnection(clicontext)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(clicontext.Args().Get(1))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		stream, err := snapshotapi.NewSnapshotsClient(conn).Import(ctx)
+		if err != nil {
+			return err
+		}
+		req := &snapshotapi.ImportSnapshotRequest{
+			Snapshotter: clicontext.GlobalString("snapshotter"),
+			Name:        clicontext.Args().Get(0),
+			Parent:      clicontext.String("parent"),
+			Labels:      labelArgs(clicontext.StringSlice("label")),
+		}
+		buf := make([]byte, snapshotChunkSize)
+		for {
+			n, err := io.ReadFull(f, buf)
+			if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+				return err
+			}
+			req.Data = buf[:n]
+			if serr := stream.Send(req); serr != nil {
+				return serr
+			}
+			if err != nil {
+				break
+			}
+			req = &snapshotapi.ImportSnapshotRequest{}
+		}
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			return err
+		}
+		fmt.Println(resp.Info.Name)
+		return nil
+	},
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+
+	"github.com/containerd/containerd/api/services/spec/v1"
+	"github.com/containerd/containerd/typeurl"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var specCommand = cli.Command{
+	Name:  "spec",
+	Usage: "generate and validate the specs of containers with the daemon",
+	Subcommands: cli.Commands{
+		specGenerateCommand,
+		specValidateCommand,
+	},
+}
+
+var specGenerateCommand = cli.Command{
+	Name:      "generate",
+	Usage:     "print the spec generated by the daemon from an image",
+	ArgsUsage: "[IMAGE] [COMMAND] [ARG...]",
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "env",
+			Usage: "add an environment variable",
+		},
+		cli.StringSliceFlag{
+			Name:  "mount",
+			Usage: "add a mount (ex: type=bind,src=/tmp,dest=/host,options=rbind:ro)",
+		},
+		cli.StringFlag{
+			Name:  "user,u",
+			Usage: "uid or uid:gid of the process",
+		},
+		cli.StringSliceFlag{
+			Name:  "cap",
+			Usage: "set the capabilities of the process, replacing the defaults",
+		},
+		cli.StringFlag{
+			Name:  "seccomp",
+			Usage: "path of a seccomp profile in the format of the runtime spec",
+		},
+		cli.BoolFlag{
+			Name:  "tty,t",
+			Usage: "allocate a terminal for the process",
+		},
+		cli.StringFlag{
+			Name:  "cwd",
+			Usage: "working directory of the process",
+		},
+		cli.StringFlag{
+			Name:  "hostname",
+			Usage: "hostname of the container",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		req := &spec.GenerateSpecRequest{
+			Image:        context.Args().First(),
+			Env:          context.StringSlice("env"),
+			User:         context.String("user"),
+			Capabilities: context.StringSlice("cap"),
+			Terminal:     context.Bool("tty"),
+			Cwd:          context.String("cwd"),
+			Hostname:     context.String("hostname"),
+		}
+		if context.NArg() > 1 {
+			req.Args = context.Args()[1:]
+		}
+		for _, s := range context.StringSlice("mount") {
+			m, err := parseMountFlag(s)
+			if err != nil {
+				return err
+			}
+			req.Mounts = append(req.Mounts, &spec.Mount{
+				Destination: m.Destination,
+				Type:        m.Type,
+				Source:      m.Source,
+				Options:     m.Options,
+			})
+		}
+		if path := context.String("seccomp"); path != "" {
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			req.Seccomp = data
+		}
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		resp, err := client.SpecService().Generate(ctx, req)
+		if err != nil {
+			return err
+		}
+		v, err := typeurl.UnmarshalAny(resp.Spec)
+		if err != nil {
+			return err
+		}
+		data, err := json.MarshalIndent(v, "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(append(data, '\n'))
+		return err
+	},
+}
+
+var specValidateCommand = cli.Command{
+	Name:      "validate",
+	Usage:     "validate a spec with the daemon",
+	ArgsUsage: "FILE",
+	Action: func(context *cli.Context) error {
+		path := context.Args().First()
+		if path == "" {
+			return errors.New("spec file must be provided")
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var s specs.Spec
+		if err := json.Unmarshal(data, &s); err != nil {
+			return errors.Wrapf(err, "invalid spec %s", path)
+		}
+		any, err := typeurl.MarshalAny(&s)
+		if err != nil {
+			return err
+		}
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		_, err = client.SpecService().Validate(ctx, &spec.ValidateSpecRequest{
+			Spec: any,
+		})
+		return err
+	},
+}
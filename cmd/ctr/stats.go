@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	statsapi "github.com/containerd/containerd/api/services/stats/v1"
+	"github.com/urfave/cli"
+)
+
+var statsCommand = cli.Command{
+	Name:      "stats",
+	Usage:     "show the combined resource usage of the containers matching the filters",
+	ArgsUsage: "[flags] [<filter>, ...]",
+	Description: `Show the resource usage of the running tasks of the containers matching
+any of the filters, combined from their latest cgroup samples. For example, the
+usage of the containers of a pod:
+
+	ctr stats 'labels."io.kubernetes.pod.uid"==abc'`,
+	Action: func(context *cli.Context) error {
+		ctx, cancel := appContext(context)
+		defer cancel()
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		resp, err := client.StatsService().Aggregate(ctx, &statsapi.AggregateRequest{
+			Filters: context.Args(),
+		})
+		if err != nil {
+			return err
+		}
+		u := resp.Usage
+		w := tabwriter.NewWriter(os.Stdout, 10, 1, 3, ' ', 0)
+		fmt.Fprintf(w, "CONTAINERS\t%s\t\n", strings.Join(resp.Containers, ","))
+		if len(resp.Containers) > 0 {
+			fmt.Fprintf(w, "OLDEST SAMPLE\t%s\t\n", resp.OldestSample.Format(time.RFC3339))
+		}
+		fmt.Fprintf(w, "CPU TOTAL\t%s\t\n", time.Duration(u.CPUUsageTotal))
+		fmt.Fprintf(w, "CPU KERNEL\t%s\t\n", time.Duration(u.CPUUsageKernel))
+		fmt.Fprintf(w, "CPU USER\t%s\t\n", time.Duration(u.CPUUsageUser))
+		fmt.Fprintf(w, "CPU THROTTLED\t%s\t\n", time.Duration(u.CPUThrottledTime))
+		fmt.Fprintf(w, "MEMORY\t%d\t\n", u.MemoryUsage)
+		fmt.Fprintf(w, "MEMORY RSS\t%d\t\n", u.MemoryRSS)
+		fmt.Fprintf(w, "MEMORY CACHE\t%d\t\n", u.MemoryCache)
+		fmt.Fprintf(w, "SWAP\t%d\t\n", u.MemorySwap)
+		fmt.Fprintf(w, "PIDS\t%d\t\n", u.Pids)
+		fmt.Fprintf(w, "BLKIO READ\t%d\t\n", u.BlkioReadBytes)
+		fmt.Fprintf(w, "BLKIO WRITE\t%d\t\n", u.BlkioWriteBytes)
+		return w.Flush()
+	},
+}
@@ -10,9 +10,10 @@ import (
 )
 
 var tasksCommand = cli.Command{
-	Name:    "tasks",
-	Usage:   "manage tasks",
-	Aliases: []string{"t"},
+	Name:      "tasks",
+	Usage:     "manage tasks",
+	Aliases:   []string{"t"},
+	ArgsUsage: "[filter]",
 	Flags: []cli.Flag{
 		cli.BoolFlag{
 			Name:  "quiet, q",
@@ -23,16 +24,19 @@ var tasksCommand = cli.Command{
 		taskAttachCommand,
 		taskCheckpointCommand,
 		taskExecCommand,
+		taskInspectCommand,
 		taskKillCommand,
 		taskPauseCommand,
 		taskPsCommand,
 		taskResumeCommand,
+		taskShimLogCommand,
 		taskStartCommand,
 		taskDeleteCommand,
 	},
 	Action: func(context *cli.Context) error {
 		var (
 			quiet       = context.Bool("quiet")
+			filter      = context.Args().First()
 			ctx, cancel = appContext(context)
 		)
 		defer cancel()
@@ -42,7 +46,9 @@ var tasksCommand = cli.Command{
 			return err
 		}
 		s := client.TaskService()
-		response, err := s.List(ctx, &tasks.ListTasksRequest{})
+		response, err := s.List(ctx, &tasks.ListTasksRequest{
+			Filter: filter,
+		})
 		if err != nil {
 			return err
 		}
@@ -1,11 +1,20 @@
 package main
 
-import "github.com/urfave/cli"
+import (
+	"github.com/containerd/containerd"
+	"github.com/urfave/cli"
+)
 
 var taskDeleteCommand = cli.Command{
 	Name:      "delete",
 	Usage:     "delete a task",
 	ArgsUsage: "CONTAINER",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "force, f",
+			Usage: "kill the task and purge its state if it is running or cannot be deleted cleanly",
+		},
+	},
 	Action: func(context *cli.Context) error {
 		ctx, cancel := appContext(context)
 		defer cancel()
@@ -21,7 +30,11 @@ var taskDeleteCommand = cli.Command{
 		if err != nil {
 			return err
 		}
-		status, err := task.Delete(ctx)
+		var opts []containerd.ProcessDeleteOpts
+		if context.Bool("force") {
+			opts = append(opts, containerd.WithForceDelete)
+		}
+		status, err := task.Delete(ctx, opts...)
 		if err != nil {
 			return err
 		}
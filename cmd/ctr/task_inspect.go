@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	tasks "github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/containerd/containerd/typeurl"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var taskInspectCommand = cli.Command{
+	Name:      "inspect",
+	Usage:     "print the stored record of a task",
+	ArgsUsage: "CONTAINER",
+	Action: func(context *cli.Context) error {
+		var (
+			ctx, cancel = appContext(context)
+			id          = context.Args().First()
+		)
+		defer cancel()
+		if id == "" {
+			return errors.New("container id must be provided")
+		}
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		response, err := client.TaskService().Inspect(ctx, &tasks.InspectTaskRequest{
+			ContainerID: id,
+		})
+		if err != nil {
+			return err
+		}
+		// decode the spec and options so they are printed as json rather
+		// than as raw bytes
+		out := struct {
+			*tasks.InspectTaskResponse
+			Spec    interface{} `json:"spec,omitempty"`
+			Options interface{} `json:"options,omitempty"`
+		}{
+			InspectTaskResponse: response,
+		}
+		if response.Spec != nil {
+			if out.Spec, err = typeurl.UnmarshalAny(response.Spec); err != nil {
+				return err
+			}
+		}
+		if response.Options != nil {
+			if out.Options, err = typeurl.UnmarshalAny(response.Options); err != nil {
+				out.Options = response.Options
+			}
+		}
+		data, err := json.MarshalIndent(out, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	},
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"os"
+
+	tasks "github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var taskShimLogCommand = cli.Command{
+	Name:      "shim-log",
+	Usage:     "print the log of the shim of a task run in debug mode",
+	ArgsUsage: "CONTAINER",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name:  "max-bytes",
+			Usage: "print at most the last bytes of the log, 1MiB when zero",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		var (
+			ctx, cancel = appContext(context)
+			id          = context.Args().First()
+		)
+		defer cancel()
+		if id == "" {
+			return errors.New("container id must be provided")
+		}
+		client, err := newClient(context)
+		if err != nil {
+			return err
+		}
+		response, err := client.TaskService().ShimLog(ctx, &tasks.ShimLogRequest{
+			ContainerID: id,
+			MaxBytes:    context.Int64("max-bytes"),
+		})
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(response.Data)
+		return err
+	},
+}
@@ -4,9 +4,11 @@ import (
 	"bufio"
 	gocontext "context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"os"
@@ -45,6 +47,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 var (
@@ -56,6 +59,25 @@ var (
 		},
 	}
 
+	platformFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  "platform",
+			Usage: "platform to select from manifest lists, such as linux/arm64; defaults to the platform of the host",
+		},
+	}
+
+	downloadFlags = []cli.Flag{
+		cli.IntFlag{
+			Name:  "max-concurrent-downloads",
+			Usage: "number of blobs to download at a time, unlimited when 0",
+			Value: 3,
+		},
+		cli.StringFlag{
+			Name:  "max-download-rate",
+			Usage: "cap on the download rate per second, such as 10MB, unlimited when empty",
+		},
+	}
+
 	registryFlags = []cli.Flag{
 		cli.BoolFlag{
 			Name:  "skip-verify,k",
@@ -78,6 +100,51 @@ var (
 
 var grpcConn *grpc.ClientConn
 
+// tcpScheme prefixes the address of a TCP listener of containerd, which is
+// connected to over TLS
+const tcpScheme = "tcp://"
+
+// clientTLSConfig returns the TLS configuration connecting to the TCP
+// listener of containerd from the global flags
+func clientTLSConfig(context *cli.Context) (*tls.Config, error) {
+	config := &tls.Config{}
+	if ca := context.GlobalString("tls-ca"); ca != "" {
+		data, err := ioutil.ReadFile(ca)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read the TLS CA")
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(data) {
+			return nil, errors.Errorf("no certificate found in %s", ca)
+		}
+	}
+	cert, key := context.GlobalString("tls-cert"), context.GlobalString("tls-key")
+	if cert != "" || key != "" {
+		pair, err := tls.LoadX509KeyPair(cert, key)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load the TLS certificate")
+		}
+		config.Certificates = []tls.Certificate{pair}
+	}
+	return config, nil
+}
+
+// dialTCP connects to the TCP listener of containerd at the address
+func dialTCP(context *cli.Context, address string) (*grpc.ClientConn, error) {
+	config, err := clientTLSConfig(context)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(strings.TrimPrefix(address, tcpScheme),
+		grpc.WithTransportCredentials(credentials.NewTLS(config)),
+		grpc.WithTimeout(100*time.Second),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to dial %q", address)
+	}
+	return conn, nil
+}
+
 // appContext returns the context for a command. Should only be called once per
 // command, near the start.
 //
@@ -111,7 +178,15 @@ func getNamespacesService(clicontext *cli.Context) (namespaces.Store, error) {
 }
 
 func newClient(context *cli.Context) (*containerd.Client, error) {
-	return containerd.New(context.GlobalString("address"))
+	address := context.GlobalString("address")
+	if strings.HasPrefix(address, tcpScheme) {
+		config, err := clientTLSConfig(context)
+		if err != nil {
+			return nil, err
+		}
+		return containerd.New(address, containerd.WithTLS(config))
+	}
+	return containerd.New(address)
 }
 
 func getContainersService(context *cli.Context) (containersapi.ContainersClient, error) {
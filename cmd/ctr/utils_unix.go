@@ -8,6 +8,7 @@ import (
 	"io"
 	"net"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -79,6 +80,14 @@ func getGRPCConnection(context *cli.Context) (*grpc.ClientConn, error) {
 	}
 
 	bindSocket := context.GlobalString("address")
+	if strings.HasPrefix(bindSocket, tcpScheme) {
+		conn, err := dialTCP(context, bindSocket)
+		if err != nil {
+			return nil, err
+		}
+		grpcConn = conn
+		return grpcConn, nil
+	}
 	dialOpts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithTimeout(100 * time.Second)}
 	dialOpts = append(dialOpts,
 		grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
@@ -4,6 +4,7 @@ import (
 	"io"
 	"net"
 	"os"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -22,6 +23,14 @@ func getGRPCConnection(context *cli.Context) (*grpc.ClientConn, error) {
 	}
 
 	bindAddress := context.GlobalString("address")
+	if strings.HasPrefix(bindAddress, tcpScheme) {
+		conn, err := dialTCP(context, bindAddress)
+		if err != nil {
+			return nil, err
+		}
+		grpcConn = conn
+		return grpcConn, nil
+	}
 	dialOpts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithTimeout(100 * time.Second)}
 	dialOpts = append(dialOpts,
 		grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
@@ -4,6 +4,7 @@ import (
 	gocontext "context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/containerd/containerd/version"
 	"github.com/urfave/cli"
@@ -19,6 +20,7 @@ var versionCommand = cli.Command{
 		fmt.Println("Client:")
 		fmt.Printf("  Version: %s\n", version.Version)
 		fmt.Printf("  Revision: %s\n", version.Revision)
+		fmt.Printf("  API Version: %s\n", version.APIVersion)
 		fmt.Println("")
 		client, err := newClient(context)
 		if err != nil {
@@ -32,6 +34,8 @@ var versionCommand = cli.Command{
 		fmt.Println("Server:")
 		fmt.Printf("  Version: %s\n", v.Version)
 		fmt.Printf("  Revision: %s\n", v.Revision)
+		fmt.Printf("  API Version: %s\n", v.APIVersion)
+		fmt.Printf("  Runtimes: %s\n", strings.Join(v.Runtimes, ", "))
 		if v.Version != version.Version {
 			fmt.Fprintf(os.Stderr, "WARNING: version mismatch\n")
 		}
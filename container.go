@@ -12,6 +12,8 @@ import (
 	"github.com/containerd/containerd/containers"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/typeurl"
+	protobuf "github.com/gogo/protobuf/types"
+	"github.com/opencontainers/image-spec/specs-go/v1"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 )
@@ -42,6 +44,19 @@ type Container interface {
 	Labels(context.Context) (map[string]string, error)
 	// SetLabels sets the provided labels for the container and returns the final label set
 	SetLabels(context.Context, map[string]string) (map[string]string, error)
+	// Extensions returns the extensions set on the container
+	Extensions(context.Context) (map[string]protobuf.Any, error)
+	// SetExtension sets the provided extension on the container, replacing
+	// any existing extension with the same name
+	SetExtension(context.Context, string, interface{}) error
+	// UpdateProcess amends the process and mounts of the spec of the
+	// container before its task is created, such as with details only
+	// known once the container is created
+	UpdateProcess(context.Context, ...UpdateProcessOpts) error
+	// Snapshot commits the writable layer of the rootfs of the container
+	// to a new snapshot with the name and labels, freezing its task while
+	// the layer is read if it is running, and returns the layer
+	Snapshot(ctx context.Context, name string, labels map[string]string) (v1.Descriptor, error)
 }
 
 func containerFromRecord(client *Client, c containers.Container) *container {
@@ -112,6 +127,72 @@ func (c *container) SetLabels(ctx context.Context, labels map[string]string) (ma
 	return m, nil
 }
 
+func (c *container) Extensions(ctx context.Context) (map[string]protobuf.Any, error) {
+	r, err := c.client.ContainerService().Get(ctx, c.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	c.c = r
+
+	m := make(map[string]protobuf.Any, len(r.Extensions))
+	for k, v := range c.c.Extensions {
+		m[k] = v
+	}
+
+	return m, nil
+}
+
+func (c *container) SetExtension(ctx context.Context, name string, extension interface{}) error {
+	any, err := typeurl.MarshalAny(extension)
+	if err != nil {
+		return err
+	}
+	container := containers.Container{
+		ID: c.ID(),
+		Extensions: map[string]protobuf.Any{
+			name: *any,
+		},
+	}
+
+	// mask off the path so other extensions are left alone
+	r, err := c.client.ContainerService().Update(ctx, container, strings.Join([]string{"extensions", name}, "."))
+	if err != nil {
+		return err
+	}
+
+	c.c = r // update our local container
+	return nil
+}
+
+func (c *container) UpdateProcess(ctx context.Context, opts ...UpdateProcessOpts) error {
+	if err := updateProcess(ctx, c.client, c.ID(), "", opts); err != nil {
+		return err
+	}
+	r, err := c.client.ContainerService().Get(ctx, c.ID())
+	if err != nil {
+		return err
+	}
+	c.c = r // update our local container
+	return nil
+}
+
+func (c *container) Snapshot(ctx context.Context, name string, labels map[string]string) (v1.Descriptor, error) {
+	r, err := c.client.TaskService().Snapshot(ctx, &tasks.SnapshotTaskRequest{
+		ContainerID: c.ID(),
+		Name:        name,
+		Labels:      labels,
+	})
+	if err != nil {
+		return v1.Descriptor{}, errdefs.FromGRPC(err)
+	}
+	return v1.Descriptor{
+		MediaType: r.Layer.MediaType,
+		Digest:    r.Layer.Digest,
+		Size:      r.Layer.Size_,
+	}, nil
+}
+
 // Spec returns the current OCI specification for the container
 func (c *container) Spec() (*specs.Spec, error) {
 	var s specs.Spec
@@ -213,10 +294,13 @@ func (c *container) NewTask(ctx context.Context, ioCreate IOCreation, opts ...Ne
 		}
 		request.Options = any
 	}
+	request.Priority = info.Priority
+	request.JoinNamespaces = info.JoinNamespaces
 	t := &task{
-		client: c.client,
-		io:     i,
-		id:     c.ID(),
+		client:   c.client,
+		io:       i,
+		id:       c.ID(),
+		priority: info.Priority,
 	}
 	if info.Checkpoint != nil {
 		request.Checkpoint = info.Checkpoint
@@ -2,9 +2,17 @@ package containerd
 
 import (
 	"context"
+	"strings"
 
 	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/devices"
 	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/network"
+	"github.com/containerd/containerd/profiles"
+	"github.com/containerd/containerd/restart"
+	"github.com/containerd/containerd/snapshot"
+	"github.com/containerd/containerd/typeurl"
+	protobuf "github.com/gogo/protobuf/types"
 	"github.com/opencontainers/image-spec/identity"
 	"github.com/pkg/errors"
 )
@@ -31,10 +39,117 @@ func WithImage(i Image) NewContainerOpts {
 	}
 }
 
-// WithContainerLabels adds the provided labels to the container
+// WithContainerLabels adds the provided labels to the container, keeping
+// the labels set by other options
 func WithContainerLabels(labels map[string]string) NewContainerOpts {
 	return func(_ context.Context, _ *Client, c *containers.Container) error {
-		c.Labels = labels
+		if c.Labels == nil {
+			c.Labels = make(map[string]string)
+		}
+		for k, v := range labels {
+			c.Labels[k] = v
+		}
+		return nil
+	}
+}
+
+// WithContainerExtension appends extension data to the container object.
+// Use this to decorate the container object with additional data for the client
+// integration.
+//
+// Make sure to register the type of `extension` in the typeurl package via
+// `typeurl.Register` otherwise the type data will be inferred, including how
+// to encode and decode the object.
+func WithContainerExtension(name string, extension interface{}) NewContainerOpts {
+	return func(ctx context.Context, client *Client, c *containers.Container) error {
+		any, err := typeurl.MarshalAny(extension)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal extension %q", name)
+		}
+
+		if name == "" {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "extension key must not be zero-length")
+		}
+		if c.Extensions == nil {
+			c.Extensions = make(map[string]protobuf.Any)
+		}
+		c.Extensions[name] = *any
+		return nil
+	}
+}
+
+// WithRestartPolicy records the policy, in the form accepted by restart.Parse,
+// used by the daemon to restart the container's task when it exits
+func WithRestartPolicy(policy string) NewContainerOpts {
+	return func(_ context.Context, _ *Client, c *containers.Container) error {
+		if _, err := restart.Parse(policy); err != nil {
+			return err
+		}
+		if c.Labels == nil {
+			c.Labels = make(map[string]string)
+		}
+		c.Labels[restart.PolicyLabel] = policy
+		return nil
+	}
+}
+
+// WithDeviceRequest requests the devices with the ids, or devices.All, of
+// the kind, such as "nvidia.com/gpu", from the device plugin of the daemon
+// allocating them. The devices injected into the task are listed on the
+// devices.AppliedLabel of the container.
+func WithDeviceRequest(kind string, ids ...string) NewContainerOpts {
+	return func(_ context.Context, _ *Client, c *containers.Container) error {
+		if kind == "" || len(ids) == 0 {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "device request requires a kind and ids")
+		}
+		if c.Labels == nil {
+			c.Labels = make(map[string]string)
+		}
+		c.Labels[devices.RequestLabelPrefix+kind] = strings.Join(ids, ",")
+		return nil
+	}
+}
+
+// WithNetwork has the cni hook of the daemon set up the network of the
+// container's task in the CNI network with the name, or
+// network.DefaultNetwork. The addresses of the task are listed on the
+// network.IPsLabel of the container.
+func WithNetwork(name string) NewContainerOpts {
+	return func(_ context.Context, _ *Client, c *containers.Container) error {
+		if c.Labels == nil {
+			c.Labels = make(map[string]string)
+		}
+		c.Labels[network.NetworkLabel] = name
+		return nil
+	}
+}
+
+// WithProfile sets the seccomp or AppArmor profile registered with the
+// name in the daemon, with the profiles service or its configuration, in
+// the spec of the tasks of the container
+func WithProfile(kind profiles.Kind, name string) NewContainerOpts {
+	return func(_ context.Context, _ *Client, c *containers.Container) error {
+		label := profiles.SeccompLabel
+		switch kind {
+		case profiles.Seccomp:
+		case profiles.AppArmor:
+			label = profiles.AppArmorLabel
+		default:
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "unknown profile kind %q", kind)
+		}
+		if c.Labels == nil {
+			c.Labels = make(map[string]string)
+		}
+		c.Labels[label] = name
+		return nil
+	}
+}
+
+// WithSandbox creates the container in the sandbox with the id, whose
+// namespaces and cgroup parent are used by the task of the container
+func WithSandbox(id string) NewContainerOpts {
+	return func(_ context.Context, _ *Client, c *containers.Container) error {
+		c.SandboxID = id
 		return nil
 	}
 }
@@ -63,15 +178,16 @@ func WithSnapshot(id string) NewContainerOpts {
 }
 
 // WithNewSnapshot allocates a new snapshot to be used by the container as the
-// root filesystem in read-write mode
-func WithNewSnapshot(id string, i Image) NewContainerOpts {
+// root filesystem in read-write mode, prepared with the options, such as
+// snapshot.WithSizeLimit
+func WithNewSnapshot(id string, i Image, opts ...snapshot.Opt) NewContainerOpts {
 	return func(ctx context.Context, client *Client, c *containers.Container) error {
 		diffIDs, err := i.(*image).i.RootFS(ctx, client.ContentStore())
 		if err != nil {
 			return err
 		}
 		setSnapshotterIfEmpty(c)
-		if _, err := client.SnapshotService(c.Snapshotter).Prepare(ctx, id, identity.ChainID(diffIDs).String()); err != nil {
+		if _, err := client.SnapshotService(c.Snapshotter).Prepare(ctx, id, identity.ChainID(diffIDs).String(), opts...); err != nil {
 			return err
 		}
 		c.RootFS = id
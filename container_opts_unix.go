@@ -7,16 +7,21 @@ import (
 	"encoding/json"
 	"fmt"
 
+	checkpointsapi "github.com/containerd/containerd/api/services/checkpoints/v1"
+	dnsapi "github.com/containerd/containerd/api/services/dns/v1"
 	"github.com/containerd/containerd/api/types"
 	"github.com/containerd/containerd/containers"
 	"github.com/containerd/containerd/content"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/typeurl"
 	"github.com/gogo/protobuf/proto"
 	protobuf "github.com/gogo/protobuf/types"
 	digest "github.com/opencontainers/go-digest"
 	"github.com/opencontainers/image-spec/identity"
 	"github.com/opencontainers/image-spec/specs-go/v1"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
 )
 
 // WithCheckpoint allows a container to be created from the checkpointed information
@@ -104,6 +109,58 @@ func WithTaskCheckpoint(desc v1.Descriptor) NewTaskOpts {
 	}
 }
 
+// WithCheckpointRef creates the container from the checkpoint recorded with
+// the name ref by the checkpoints service, see WithCheckpoint. The digest of a
+// checkpoint index in the content store is also accepted as ref.
+func WithCheckpointRef(ref, rootfsID string) NewContainerOpts {
+	return func(ctx context.Context, client *Client, c *containers.Container) error {
+		desc, err := resolveCheckpoint(ctx, client, ref)
+		if err != nil {
+			return err
+		}
+		return WithCheckpoint(desc, rootfsID)(ctx, client, c)
+	}
+}
+
+// WithTaskCheckpointRef restores the task from the checkpoint recorded with
+// the name ref by the checkpoints service, see WithTaskCheckpoint
+func WithTaskCheckpointRef(ref string) NewTaskOpts {
+	return func(ctx context.Context, c *Client, info *TaskInfo) error {
+		desc, err := resolveCheckpoint(ctx, c, ref)
+		if err != nil {
+			return err
+		}
+		return WithTaskCheckpoint(desc)(ctx, c, info)
+	}
+}
+
+// resolveCheckpoint returns the index of the checkpoint with the name ref,
+// falling back to ref as the digest of an unrecorded index
+func resolveCheckpoint(ctx context.Context, client *Client, ref string) (v1.Descriptor, error) {
+	resp, err := client.CheckpointService().Get(ctx, &checkpointsapi.GetCheckpointRequest{
+		Name: ref,
+	})
+	if err == nil {
+		return v1.Descriptor{
+			MediaType: resp.Checkpoint.Target.MediaType,
+			Digest:    resp.Checkpoint.Target.Digest,
+			Size:      resp.Checkpoint.Target.Size_,
+		}, nil
+	}
+	err = errdefs.FromGRPC(err)
+	if !errdefs.IsNotFound(err) && !errdefs.IsNotImplemented(err) {
+		return v1.Descriptor{}, err
+	}
+	dgst, perr := digest.Parse(ref)
+	if perr != nil {
+		return v1.Descriptor{}, err
+	}
+	return v1.Descriptor{
+		MediaType: v1.MediaTypeImageIndex,
+		Digest:    dgst,
+	}, nil
+}
+
 func decodeIndex(ctx context.Context, store content.Store, id digest.Digest) (*v1.Index, error) {
 	var index v1.Index
 	p, err := content.ReadBlob(ctx, store, id)
@@ -116,3 +173,51 @@ func decodeIndex(ctx context.Context, store content.Store, id digest.Digest) (*v
 
 	return &index, nil
 }
+
+// WithDNS writes the hosts and resolv.conf files of the container with the
+// configuration and bind mounts them into the container in place of the
+// files of the spec. The files are updated through the DNS service while the
+// container runs. The spec of the container must be set before the option.
+func WithDNS(config dnsapi.DNSConfig) NewContainerOpts {
+	return func(ctx context.Context, client *Client, c *containers.Container) error {
+		if c.Spec == nil {
+			return errors.Wrap(errdefs.ErrFailedPrecondition, "the spec of the container must be set before its dns")
+		}
+		v, err := typeurl.UnmarshalAny(c.Spec)
+		if err != nil {
+			return err
+		}
+		spec, ok := v.(*specs.Spec)
+		if !ok {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "unexpected spec type %T", v)
+		}
+		resp, err := client.DNSService().Update(ctx, &dnsapi.UpdateDNSRequest{
+			ContainerID: c.ID,
+			Config:      config,
+		})
+		if err != nil {
+			return errdefs.FromGRPC(err)
+		}
+		files := map[string]string{
+			"/etc/hosts":       resp.HostsPath,
+			"/etc/resolv.conf": resp.ResolvConfPath,
+		}
+		mounts := spec.Mounts[:0]
+		for _, m := range spec.Mounts {
+			if _, ok := files[m.Destination]; !ok {
+				mounts = append(mounts, m)
+			}
+		}
+		for _, dst := range []string{"/etc/hosts", "/etc/resolv.conf"} {
+			mounts = append(mounts, specs.Mount{
+				Destination: dst,
+				Type:        "bind",
+				Source:      files[dst],
+				Options:     []string{"rbind", "ro"},
+			})
+		}
+		spec.Mounts = mounts
+		c.Spec, err = typeurl.MarshalAny(spec)
+		return err
+	}
+}
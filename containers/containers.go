@@ -52,11 +52,31 @@ type Container struct {
 	// This field is not required but immutable.
 	Snapshotter string
 
+	// SandboxID is the id of the sandbox whose namespaces and cgroup parent
+	// the container shares.
+	//
+	// This field is not required but immutable.
+	SandboxID string
+
 	// CreatedAt is the time at which the container was created.
 	CreatedAt time.Time
 
 	// UpdatedAt is the time at which the container was updated.
+	//
+	// When set on an update, the update only succeeds if the container has
+	// not been updated since.
 	UpdatedAt time.Time
+
+	// Revision is incremented each time the container is updated.
+	//
+	// When set on an update, the update only succeeds if the container is
+	// still at this revision.
+	Revision uint64
+
+	// Extensions stores client-specified metadata
+	//
+	// These are optional and fully mutable.
+	Extensions map[string]types.Any
 }
 
 type RuntimeInfo struct {
@@ -96,7 +96,12 @@ func containerToProto(container *containers.Container) containersapi.Container {
 		},
 		Spec:        container.Spec,
 		Snapshotter: container.Snapshotter,
+		SandboxID:   container.SandboxID,
 		RootFS:      container.RootFS,
+		CreatedAt:   container.CreatedAt,
+		UpdatedAt:   container.UpdatedAt,
+		Revision:    container.Revision,
+		Extensions:  container.Extensions,
 	}
 }
 
@@ -115,7 +120,12 @@ func containerFromProto(containerpb *containersapi.Container) containers.Contain
 		Runtime:     runtime,
 		Spec:        containerpb.Spec,
 		Snapshotter: containerpb.Snapshotter,
+		SandboxID:   containerpb.SandboxID,
 		RootFS:      containerpb.RootFS,
+		CreatedAt:   containerpb.CreatedAt,
+		UpdatedAt:   containerpb.UpdatedAt,
+		Revision:    containerpb.Revision,
+		Extensions:  containerpb.Extensions,
 	}
 }
 
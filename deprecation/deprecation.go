@@ -0,0 +1,144 @@
+// Package deprecation tracks the use of the deprecated surfaces of the GRPC
+// API, so that it can be known when they are no longer used and can be
+// removed.
+package deprecation
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	metrics "github.com/docker/go-metrics"
+)
+
+// Surface is a deprecated method of the GRPC API or a deprecated field of
+// the requests of a method
+type Surface struct {
+	// ID identifies the deprecation, such as "tasks.create.checkpoint"
+	ID string
+	// Method is the full name of the GRPC method, such as
+	// "/containerd.services.tasks.v1.Tasks/Create"
+	Method string
+	// Field is the dotted path of the proto field names of the request,
+	// which is deprecated when it is set. The whole method is deprecated
+	// when empty.
+	Field string
+	// Message tells clients what to use instead
+	Message string
+}
+
+// Usage is the use of a deprecated surface since the daemon started
+type Usage struct {
+	Surface
+	Count    uint64
+	LastUsed time.Time
+}
+
+var (
+	mu sync.Mutex
+	// usages holds the registered surfaces by method
+	usages = make(map[string][]*Usage)
+	ids    = make(map[string]struct{})
+
+	usageCounter metrics.LabeledCounter
+)
+
+func init() {
+	ns := metrics.NewNamespace("containerd", "deprecation", nil)
+	usageCounter = ns.NewLabeledCounter("usage", "The number of calls using deprecated API surfaces by deprecation", "id")
+	metrics.Register(ns)
+}
+
+// Register marks the surface as deprecated. It is called in the init of the
+// package implementing the method.
+func Register(s Surface) {
+	mu.Lock()
+	defer mu.Unlock()
+	if s.ID == "" || s.Method == "" {
+		panic("deprecation: surface without an id or method")
+	}
+	if _, ok := ids[s.ID]; ok {
+		panic("deprecation: surface " + s.ID + " registered twice")
+	}
+	ids[s.ID] = struct{}{}
+	usages[s.Method] = append(usages[s.Method], &Usage{Surface: s})
+}
+
+// Observe records the deprecated surfaces used by the call of the method
+// with the request, which is nil for streaming methods, and returns their
+// usage.
+func Observe(method string, req interface{}) []Usage {
+	mu.Lock()
+	defer mu.Unlock()
+	var used []Usage
+	for _, u := range usages[method] {
+		if u.Field != "" && (req == nil || !isSet(reflect.ValueOf(req), strings.Split(u.Field, "."))) {
+			continue
+		}
+		u.Count++
+		u.LastUsed = time.Now()
+		usageCounter.WithValues(u.ID).Inc()
+		used = append(used, *u)
+	}
+	return used
+}
+
+// Usages returns the usage of the registered surfaces, ordered by id
+func Usages() []Usage {
+	mu.Lock()
+	defer mu.Unlock()
+	var out []Usage
+	for _, us := range usages {
+		for _, u := range us {
+			out = append(out, *u)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
+
+// isSet returns whether the field at the path of proto field names is set
+// in the message
+func isSet(v reflect.Value, path []string) bool {
+	for _, name := range path {
+		for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return false
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return false
+		}
+		f, ok := fieldByProtoName(v, name)
+		if !ok {
+			return false
+		}
+		v = f
+	}
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.String:
+		return v.Len() > 0
+	case reflect.Ptr, reflect.Interface:
+		return !v.IsNil()
+	}
+	return !reflect.DeepEqual(v.Interface(), reflect.Zero(v.Type()).Interface())
+}
+
+// fieldByProtoName returns the field of the generated struct with the proto
+// field name
+func fieldByProtoName(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		for _, opt := range strings.Split(t.Field(i).Tag.Get("protobuf"), ",") {
+			if opt == "name="+name {
+				return v.Field(i), true
+			}
+		}
+	}
+	return reflect.Value{}, false
+}
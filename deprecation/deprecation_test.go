@@ -0,0 +1,54 @@
+package deprecation
+
+import (
+	"testing"
+
+	tasks "github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/containerd/containerd/api/types"
+)
+
+func TestObserve(t *testing.T) {
+	const method = "/containerd.services.tasks.v1.Tasks/Create"
+	Register(Surface{
+		ID:      "test.create.checkpoint",
+		Method:  method,
+		Field:   "checkpoint.media_type",
+		Message: "test deprecation of a nested field",
+	})
+	Register(Surface{
+		ID:     "test.create.terminal",
+		Method: method,
+		Field:  "terminal",
+	})
+	Register(Surface{
+		ID:     "test.listpids",
+		Method: "/containerd.services.tasks.v1.Tasks/ListPids",
+	})
+
+	if used := Observe(method, &tasks.CreateTaskRequest{ContainerID: "test"}); len(used) != 0 {
+		t.Fatalf("expected no deprecated surface to be used, got %v", used)
+	}
+	used := Observe(method, &tasks.CreateTaskRequest{
+		Terminal:   true,
+		Checkpoint: &types.Descriptor{MediaType: "test"},
+	})
+	if len(used) != 2 {
+		t.Fatalf("expected the checkpoint and terminal surfaces to be used, got %v", used)
+	}
+	Observe("/containerd.services.tasks.v1.Tasks/ListPids", nil)
+	Observe("/containerd.services.tasks.v1.Tasks/ListPids", &tasks.ListPidsRequest{})
+
+	counts := make(map[string]uint64)
+	for _, u := range Usages() {
+		counts[u.ID] = u.Count
+	}
+	for id, expected := range map[string]uint64{
+		"test.create.checkpoint": 1,
+		"test.create.terminal":   1,
+		"test.listpids":          2,
+	} {
+		if counts[id] != expected {
+			t.Errorf("expected %s to be used %d times, got %d", id, expected, counts[id])
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Package devices allocates the devices of vendor plugins, such as GPUs and
+// other accelerators, to containers.
+//
+// Plugins of the DevicePlugin type returning a Provider allocate the devices
+// of their kind. Containers request devices with labels prefixed with
+// RequestLabelPrefix and the devices hook injects the allocated devices into
+// their spec and device cgroup before their task is created.
+package devices
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/containerd/containerd/hooks"
+	"golang.org/x/net/context"
+)
+
+const (
+	// RequestLabelPrefix prefixes the labels of the containers requesting
+	// devices of a kind, such as "containerd.io/devices/nvidia.com/gpu",
+	// whose value are the comma separated ids of the devices or "all"
+	RequestLabelPrefix = "containerd.io/devices/"
+	// AppliedLabel is the label of the containers holding the comma separated
+	// paths of the devices injected into their last task
+	AppliedLabel = "containerd.io/devices"
+	// All requests all the devices of a kind
+	All = "all"
+)
+
+// Provider allocates the devices of a kind to containers
+type Provider interface {
+	// Kind is the kind of the devices, such as "nvidia.com/gpu"
+	Kind() string
+	// Allocate returns the adjustment of the container injecting the
+	// devices with the ids, such as the devices and their cgroup rules, the
+	// mounts of the libraries of the driver and the environment of the
+	// process. The ids are All to request all the devices.
+	Allocate(ctx context.Context, containerID string, ids []string) (*hooks.Adjustment, error)
+	// Release releases the devices allocated to the container
+	Release(ctx context.Context, containerID string) error
+}
+
+// Requests returns the ids of the devices requested by the labels of a
+// container by kind
+func Requests(labels map[string]string) map[string][]string {
+	requests := make(map[string][]string)
+	for k, v := range labels {
+		if !strings.HasPrefix(k, RequestLabelPrefix) {
+			continue
+		}
+		kind := strings.TrimPrefix(k, RequestLabelPrefix)
+		for _, id := range strings.Split(v, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				requests[kind] = append(requests[kind], id)
+			}
+		}
+	}
+	return requests
+}
+
+// kinds returns the kinds of the requests in order
+func kinds(requests map[string][]string) []string {
+	out := make([]string, 0, len(requests))
+	for kind := range requests {
+		out = append(out, kind)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,83 @@
+package devices
+
+import (
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/hooks"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+func TestRequests(t *testing.T) {
+	requests := Requests(map[string]string{
+		RequestLabelPrefix + "nvidia.com/gpu": "0, 1",
+		RequestLabelPrefix + "fpga":           All,
+		AppliedLabel:                          "/dev/nvidia0",
+		"other":                               "value",
+	})
+	if len(requests) != 2 {
+		t.Fatalf("unexpected requests %v", requests)
+	}
+	if ids := requests["nvidia.com/gpu"]; len(ids) != 2 || ids[0] != "0" || ids[1] != "1" {
+		t.Fatalf("unexpected gpu ids %v", ids)
+	}
+	if ids := requests["fpga"]; len(ids) != 1 || ids[0] != All {
+		t.Fatalf("unexpected fpga ids %v", ids)
+	}
+}
+
+type testProvider struct {
+	kind     string
+	err      error
+	released bool
+}
+
+func (p *testProvider) Kind() string {
+	return p.kind
+}
+
+func (p *testProvider) Allocate(ctx context.Context, id string, ids []string) (*hooks.Adjustment, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &hooks.Adjustment{
+		Devices: []specs.LinuxDevice{{Path: "/dev/" + p.kind + ids[0], Type: "c"}},
+	}, nil
+}
+
+func (p *testProvider) Release(ctx context.Context, id string) error {
+	p.released = true
+	return nil
+}
+
+func TestAllocateReleasesOnFailure(t *testing.T) {
+	var (
+		a = &testProvider{kind: "a"}
+		b = &testProvider{kind: "b", err: errors.New("no device")}
+		h = &Hook{providers: map[string]Provider{"a": a, "b": b}}
+	)
+	_, err := h.Invoke(context.Background(), &hooks.Request{
+		Point:       hooks.PreCreate,
+		ContainerID: "test",
+		Labels: map[string]string{
+			RequestLabelPrefix + "a": "0",
+			RequestLabelPrefix + "b": "0",
+		},
+	})
+	if err == nil {
+		t.Fatal("expected the allocation to fail")
+	}
+	if !a.released {
+		t.Fatal("devices allocated before the failure were not released")
+	}
+
+	_, err = h.Invoke(context.Background(), &hooks.Request{
+		Point:  hooks.PreCreate,
+		Labels: map[string]string{RequestLabelPrefix + "c": "0"},
+	})
+	if !errdefs.IsNotFound(err) {
+		t.Fatalf("expected not found for a kind without provider, got %v", err)
+	}
+}
@@ -0,0 +1,138 @@
+package devices
+
+import (
+	"strings"
+
+	"github.com/boltdb/bolt"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/hooks"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/plugin"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.HookPlugin,
+		ID:   "devices",
+		Requires: []plugin.PluginType{
+			plugin.DevicePlugin,
+			plugin.MetadataPlugin,
+		},
+		Init: New,
+	})
+}
+
+// New returns the devices hook of the loaded device plugins. The plugin is
+// skipped when no device plugin is loaded.
+func New(ic *plugin.InitContext) (interface{}, error) {
+	plugins, err := ic.GetAll(plugin.DevicePlugin)
+	if err != nil || len(plugins) == 0 {
+		return nil, plugin.SkipPlugin
+	}
+	m, err := ic.Get(plugin.MetadataPlugin)
+	if err != nil {
+		return nil, err
+	}
+	h := &Hook{
+		db:        m.(*bolt.DB),
+		providers: make(map[string]Provider),
+	}
+	for id, p := range plugins {
+		provider, ok := p.(Provider)
+		if !ok {
+			return nil, errors.Errorf("device plugin %q is not a device provider", id)
+		}
+		h.providers[provider.Kind()] = provider
+	}
+	return h, nil
+}
+
+// Hook allocates the devices requested by containers with the providers of
+// their kinds before their task is created, and releases them after it
+// stopped
+type Hook struct {
+	db        *bolt.DB
+	providers map[string]Provider
+}
+
+// Invoke allocates the requested devices at the pre-create point and
+// releases them at the post-stop point
+func (h *Hook) Invoke(ctx context.Context, r *hooks.Request) (*hooks.Adjustment, error) {
+	requests := Requests(r.Labels)
+	if len(requests) == 0 {
+		return nil, nil
+	}
+	switch r.Point {
+	case hooks.PreCreate:
+		return h.allocate(ctx, r.ContainerID, requests)
+	case hooks.PostStop:
+		for _, kind := range kinds(requests) {
+			if p, ok := h.providers[kind]; ok {
+				if err := p.Release(ctx, r.ContainerID); err != nil {
+					log.G(ctx).WithError(err).WithField("id", r.ContainerID).Warnf("failed to release %s devices", kind)
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// allocate allocates the requested devices of each kind, releasing those
+// allocated when a provider fails, and records the injected devices on the
+// container
+func (h *Hook) allocate(ctx context.Context, id string, requests map[string][]string) (_ *hooks.Adjustment, err error) {
+	var (
+		out       hooks.Adjustment
+		allocated []Provider
+	)
+	defer func() {
+		if err != nil {
+			for _, p := range allocated {
+				if rerr := p.Release(ctx, id); rerr != nil {
+					log.G(ctx).WithError(rerr).WithField("id", id).Warnf("failed to release %s devices", p.Kind())
+				}
+			}
+		}
+	}()
+	for _, kind := range kinds(requests) {
+		p, ok := h.providers[kind]
+		if !ok {
+			return nil, errors.Wrapf(errdefs.ErrNotFound, "no device plugin for %s devices", kind)
+		}
+		a, err := p.Allocate(ctx, id, requests[kind])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to allocate %s devices", kind)
+		}
+		allocated = append(allocated, p)
+		if a == nil {
+			continue
+		}
+		out.Merge(a)
+	}
+	paths := make([]string, 0, len(out.Devices))
+	for _, d := range out.Devices {
+		paths = append(paths, d.Path)
+	}
+	if err := h.record(ctx, id, strings.Join(paths, ",")); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// record sets the devices injected into the task of the container on its
+// AppliedLabel so that they are visible in its info
+func (h *Hook) record(ctx context.Context, id, paths string) error {
+	return h.db.Update(func(tx *bolt.Tx) error {
+		_, err := metadata.NewContainerStore(tx).Update(ctx, containers.Container{
+			ID: id,
+			Labels: map[string]string{
+				AppliedLabel: paths,
+			},
+		}, "labels."+AppliedLabel)
+		return err
+	})
+}
@@ -0,0 +1,161 @@
+// Package distribution fetches images from registries into a content store
+// and unpacks them into snapshots.
+package distribution
+
+import (
+	"context"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/platforms"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker/schema1"
+	"github.com/containerd/containerd/rootfs"
+	"github.com/containerd/containerd/snapshot"
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// Fetch resolves the reference and fetches the image's manifest, config and
+// layers into the content store. The returned image has not been recorded
+// in an image store.
+//
+// Only the manifests of manifest lists built for the platform are fetched,
+// the default platform of the host is used when it is empty. The platform
+// the image resolved to is recorded in its images.PlatformLabel.
+//
+// Docker schema 1 manifests are converted when convertSchema1 is set. The
+// base handlers are called for each descriptor before it is fetched.
+func Fetch(ctx context.Context, store content.Store, resolver remotes.Resolver, ref, platform string, convertSchema1 bool, baseHandlers ...images.Handler) (images.Image, error) {
+	if platform == "" {
+		platform = platforms.Default()
+	}
+	p, err := platforms.Parse(platform)
+	if err != nil {
+		return images.Image{}, err
+	}
+	platform = platforms.Format(p)
+
+	name, desc, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		return images.Image{}, err
+	}
+	fetcher, err := resolver.Fetcher(ctx, name)
+	if err != nil {
+		return images.Image{}, err
+	}
+
+	var (
+		schema1Converter *schema1.Converter
+		handler          images.Handler
+	)
+	if desc.MediaType == images.MediaTypeDockerSchema1Manifest && convertSchema1 {
+		schema1Converter = schema1.NewConverter(store, fetcher)
+		handler = images.Handlers(append(baseHandlers, schema1Converter)...)
+	} else {
+		handler = images.Handlers(append(baseHandlers,
+			remotes.FetchHandler(store, fetcher),
+			images.FilterPlatform(platform, images.ChildrenHandler(store)))...,
+		)
+	}
+
+	if err := images.Dispatch(ctx, handler, desc); err != nil {
+		return images.Image{}, err
+	}
+	if schema1Converter != nil {
+		desc, err = schema1Converter.Convert(ctx)
+		if err != nil {
+			return images.Image{}, err
+		}
+	}
+
+	// single manifests record the platform of their config, which may not
+	// be the one asked for
+	switch desc.MediaType {
+	case images.MediaTypeDockerSchema2ManifestList, ocispec.MediaTypeImageIndex:
+	default:
+		resolved, err := images.Platforms(ctx, store, desc)
+		if err != nil {
+			return images.Image{}, err
+		}
+		if len(resolved) == 1 {
+			platform = resolved[0]
+		}
+	}
+
+	return images.Image{
+		Name: name,
+		Labels: map[string]string{
+			images.PlatformLabel: platform,
+		},
+		Target: desc,
+	}, nil
+}
+
+// Unpack applies the layers of the image from the content store to
+// snapshots, labeling each unpacked layer blob with the digest of its
+// uncompressed content.
+func Unpack(ctx context.Context, store content.Store, image images.Image, sn snapshot.Snapshotter, a rootfs.Applier) error {
+	layers, err := Layers(ctx, store, image)
+	if err != nil {
+		return err
+	}
+
+	var chain []digest.Digest
+	for _, layer := range layers {
+		unpacked, err := rootfs.ApplyLayer(ctx, layer, chain, sn, a)
+		if err != nil {
+			// TODO: possibly wait and retry if extraction of same chain id was in progress
+			return err
+		}
+		if unpacked {
+			info, err := store.Info(ctx, layer.Blob.Digest)
+			if err != nil {
+				return err
+			}
+			if info.Labels["containerd.io/uncompressed"] != layer.Diff.Digest.String() {
+				if info.Labels == nil {
+					info.Labels = map[string]string{}
+				}
+				info.Labels["containerd.io/uncompressed"] = layer.Diff.Digest.String()
+				if _, err := store.Update(ctx, info, "labels.containerd.io/uncompressed"); err != nil {
+					return err
+				}
+			}
+		}
+
+		chain = append(chain, layer.Diff.Digest)
+	}
+
+	return nil
+}
+
+// Layers returns the layers of the image, pairing the blob of each layer
+// in the manifest with the digest of its uncompressed content
+//
+// The manifest of the platform recorded for the image is selected from
+// manifest lists.
+func Layers(ctx context.Context, provider content.Provider, image images.Image) ([]rootfs.Layer, error) {
+	manifest, err := images.Manifest(ctx, provider, image.Target, image.Platform())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve manifest")
+	}
+	diffIDs, err := images.RootFS(ctx, provider, manifest.Config)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve rootfs")
+	}
+	if len(diffIDs) != len(manifest.Layers) {
+		return nil, errors.Errorf("mismatched image rootfs and manifest layers")
+	}
+	layers := make([]rootfs.Layer, len(diffIDs))
+	for i := range diffIDs {
+		layers[i].Diff = ocispec.Descriptor{
+			// TODO: derive media type from compressed type
+			MediaType: ocispec.MediaTypeImageLayer,
+			Digest:    diffIDs[i],
+		}
+		layers[i].Blob = manifest.Layers[i]
+	}
+	return layers, nil
+}
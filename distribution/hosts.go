@@ -0,0 +1,176 @@
+package distribution
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd/reference"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/pkg/errors"
+)
+
+// HostConfig configures how a registry host is accessed
+type HostConfig struct {
+	// Mirrors are hosts that are tried in order before the registry when
+	// fetching images. Each mirror may be configured as a host itself.
+	Mirrors []string `toml:"mirrors"`
+	// Username and Secret authenticate with the registry. If only a secret
+	// is given, it is used as a long lived token.
+	Username string `toml:"username"`
+	Secret   string `toml:"secret"`
+	// PlainHTTP connects to the registry over http rather than https
+	PlainHTTP bool `toml:"plain_http"`
+	// InsecureSkipVerify does not verify the certificate of the registry
+	InsecureSkipVerify bool `toml:"insecure_skip_verify"`
+	// CAFile is a PEM file of certificate authorities trusted for the
+	// registry in addition to the system pool
+	CAFile string `toml:"ca_file"`
+	// CertFile and KeyFile are a PEM client certificate and key presented
+	// to the registry
+	CertFile string `toml:"cert_file"`
+	KeyFile  string `toml:"key_file"`
+}
+
+// CredentialHelper returns the credentials for a registry host
+type CredentialHelper func(ctx context.Context, host string) (string, string, error)
+
+// Hosts creates resolvers for registry references according to the
+// configuration of their host
+type Hosts struct {
+	config  map[string]HostConfig
+	clients map[string]*http.Client
+	helper  CredentialHelper
+}
+
+// NewHosts returns the resolvers for the host configuration, keyed by the
+// host name of references such as "docker.io" or "localhost:5000". Hosts
+// without static credentials get them from the helper, if not nil.
+func NewHosts(config map[string]HostConfig, helper CredentialHelper) (*Hosts, error) {
+	h := &Hosts{
+		config:  config,
+		clients: make(map[string]*http.Client),
+		helper:  helper,
+	}
+	for host, c := range config {
+		tc, err := c.tlsConfig()
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid tls configuration for %s", host)
+		}
+		if tc == nil {
+			continue
+		}
+		h.clients[host] = &http.Client{
+			Transport: &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
+				Dial: (&net.Dialer{
+					Timeout:   30 * time.Second,
+					KeepAlive: 30 * time.Second,
+				}).Dial,
+				TLSClientConfig:     tc,
+				TLSHandshakeTimeout: 10 * time.Second,
+			},
+		}
+	}
+	return h, nil
+}
+
+func (c HostConfig) tlsConfig() (*tls.Config, error) {
+	if !c.InsecureSkipVerify && c.CAFile == "" && c.CertFile == "" {
+		return nil, nil
+	}
+	tc := &tls.Config{
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if c.CAFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		pem, err := ioutil.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("no certificates in %s", c.CAFile)
+		}
+		tc.RootCAs = pool
+	}
+	if c.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tc.Certificates = []tls.Certificate{cert}
+	}
+	return tc, nil
+}
+
+// Remote is a reference and the resolver to access it with
+type Remote struct {
+	Ref      string
+	Resolver remotes.Resolver
+}
+
+// Remotes returns the references to fetch the image from, those on the
+// mirrors of its host first and the reference itself last.
+//
+// The resolvers use the plain http setting of the host configuration or
+// plainHTTP if set. They authenticate with the provided username and
+// secret if set, otherwise with the credentials of the host.
+func (h *Hosts) Remotes(ctx context.Context, ref string, plainHTTP bool, username, secret string) ([]Remote, error) {
+	refspec, err := reference.Parse(ref)
+	if err != nil {
+		return nil, err
+	}
+	host := refspec.Hostname()
+	var out []Remote
+	for _, mirror := range h.config[host].Mirrors {
+		mirror = strings.TrimSuffix(mirror, "/")
+		out = append(out, Remote{
+			Ref: reference.Spec{
+				Locator: mirror + strings.TrimPrefix(refspec.Locator, host),
+				Object:  refspec.Object,
+			}.String(),
+			Resolver: h.resolver(ctx, mirror, plainHTTP, "", ""),
+		})
+	}
+	return append(out, Remote{
+		Ref:      ref,
+		Resolver: h.resolver(ctx, host, plainHTTP, username, secret),
+	}), nil
+}
+
+// Resolver returns the resolver for the host of the reference
+func (h *Hosts) Resolver(ctx context.Context, ref string, plainHTTP bool, username, secret string) (remotes.Resolver, error) {
+	refspec, err := reference.Parse(ref)
+	if err != nil {
+		return nil, err
+	}
+	return h.resolver(ctx, refspec.Hostname(), plainHTTP, username, secret), nil
+}
+
+func (h *Hosts) resolver(ctx context.Context, host string, plainHTTP bool, username, secret string) remotes.Resolver {
+	c := h.config[host]
+	return docker.NewResolver(docker.ResolverOptions{
+		PlainHTTP: plainHTTP || c.PlainHTTP,
+		Client:    h.clients[host],
+		Credentials: func(hostname string) (string, string, error) {
+			switch {
+			case username != "" || secret != "":
+				return username, secret, nil
+			case c.Username != "" || c.Secret != "":
+				return c.Username, c.Secret, nil
+			case h.helper != nil:
+				return h.helper(ctx, hostname)
+			}
+			return "", "", nil
+		},
+	})
+}
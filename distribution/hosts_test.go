@@ -0,0 +1,63 @@
+package distribution
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRemotesMirrors(t *testing.T) {
+	hosts, err := NewHosts(map[string]HostConfig{
+		"docker.io": {
+			Mirrors: []string{"mirror.local:5000/", "other.local"},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		ref      string
+		expected []string
+	}{
+		{
+			ref: "docker.io/library/redis:latest",
+			expected: []string{
+				"mirror.local:5000/library/redis:latest",
+				"other.local/library/redis:latest",
+				"docker.io/library/redis:latest",
+			},
+		},
+		{
+			ref: "docker.io/library/redis@sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			expected: []string{
+				"mirror.local:5000/library/redis@sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+				"other.local/library/redis@sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+				"docker.io/library/redis@sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			},
+		},
+		{
+			ref:      "localhost:5000/redis:latest",
+			expected: []string{"localhost:5000/redis:latest"},
+		},
+	} {
+		remotes, err := hosts.Remotes(context.Background(), tc.ref, false, "", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(remotes) != len(tc.expected) {
+			t.Fatalf("%s: expected %d remotes, got %d", tc.ref, len(tc.expected), len(remotes))
+		}
+		for i, r := range remotes {
+			if r.Ref != tc.expected[i] {
+				t.Errorf("%s: expected remote %d to be %s, got %s", tc.ref, i, tc.expected[i], r.Ref)
+			}
+		}
+	}
+}
+
+func TestNewHostsInvalidCA(t *testing.T) {
+	if _, err := NewHosts(map[string]HostConfig{
+		"docker.io": {CAFile: "/nonexistent/ca.pem"},
+	}, nil); err == nil {
+		t.Fatal("expected missing ca file to be an error")
+	}
+}
@@ -0,0 +1,78 @@
+package distribution
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/content/local"
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+func newStore(t *testing.T) (content.Store, func()) {
+	root, err := ioutil.TempDir("", "distribution-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs, err := local.NewStore(root)
+	if err != nil {
+		os.RemoveAll(root)
+		t.Fatal(err)
+	}
+	return cs, func() { os.RemoveAll(root) }
+}
+
+func TestExportImportOCI(t *testing.T) {
+	ctx := context.Background()
+	from, cleanup := newStore(t)
+	defer cleanup()
+	to, cleanup := newStore(t)
+	defer cleanup()
+
+	blob := []byte("layer")
+	desc := ocispec.Descriptor{
+		MediaType: ocispec.MediaTypeImageLayer,
+		Digest:    digest.FromBytes(blob),
+		Size:      int64(len(blob)),
+		Annotations: map[string]string{
+			ocispec.AnnotationRefName: "latest",
+		},
+	}
+	if err := content.WriteBlob(ctx, from, "test", bytes.NewReader(blob), desc.Size, desc.Digest); err != nil {
+		t.Fatal(err)
+	}
+
+	var archive bytes.Buffer
+	if err := ExportOCI(ctx, from, desc, &archive); err != nil {
+		t.Fatal(err)
+	}
+	imported, err := ImportOCI(ctx, to, &archive, "latest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported.Digest != desc.Digest {
+		t.Fatalf("expected %s to be imported, got %s", desc.Digest, imported.Digest)
+	}
+	p, err := content.ReadBlob(ctx, to, desc.Digest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(p, blob) {
+		t.Fatalf("unexpected blob content %q", p)
+	}
+}
+
+func TestExportOCIMissingContent(t *testing.T) {
+	ctx := context.Background()
+	from, cleanup := newStore(t)
+	defer cleanup()
+
+	var archive bytes.Buffer
+	if err := ExportOCI(ctx, from, ocispec.Descriptor{MediaType: "unknown", Digest: digest.FromString("")}, &archive); err == nil {
+		t.Fatal("expected export of missing content to fail")
+	}
+}
@@ -0,0 +1,106 @@
+package errdefs
+
+import (
+	"github.com/containerd/containerd/api/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// detailTypeURL is the type url of the detail in the details of a grpc status
+var detailTypeURL = "types.containerd.io/" + proto.MessageName(&types.ErrorDetail{})
+
+// WithDetail returns the error carrying the detail, which ToGRPC attaches to
+// the status details of the grpc error so that clients can tell what the
+// error is about without parsing its message. Fields of the detail that are
+// empty are filled in from the detail the error already carries.
+func WithDetail(err error, detail types.ErrorDetail) error {
+	if err == nil {
+		return nil
+	}
+	if d, ok := GetDetail(err); ok {
+		if detail.ContainerID == "" {
+			detail.ContainerID = d.ContainerID
+		}
+		if detail.ExecID == "" {
+			detail.ExecID = d.ExecID
+		}
+		if detail.Runtime == "" {
+			detail.Runtime = d.Runtime
+		}
+		if detail.RuntimeError == "" {
+			detail.RuntimeError = d.RuntimeError
+		}
+	}
+	return &detailError{error: err, detail: &detail}
+}
+
+// GetDetail returns the detail carried by an error, attached to a grpc error
+// returned by the daemon or kept by FromGRPC
+func GetDetail(err error) (*types.ErrorDetail, bool) {
+	for err != nil {
+		if d, ok := err.(*detailError); ok {
+			return d.detail, true
+		}
+		if st, ok := status.FromError(err); ok {
+			return detailFromStatus(st)
+		}
+		c, ok := err.(interface {
+			Cause() error
+		})
+		if !ok {
+			break
+		}
+		err = c.Cause()
+	}
+	return nil, false
+}
+
+func detailFromStatus(st *status.Status) (*types.ErrorDetail, bool) {
+	for _, d := range st.Proto().Details {
+		if d.TypeUrl != detailTypeURL {
+			continue
+		}
+		var detail types.ErrorDetail
+		if err := proto.Unmarshal(d.Value, &detail); err != nil {
+			return nil, false
+		}
+		return &detail, true
+	}
+	return nil, false
+}
+
+// attachDetail replaces the detail in the status details of the grpc error
+func attachDetail(gerr error, detail *types.ErrorDetail) error {
+	st, ok := status.FromError(gerr)
+	if !ok {
+		st = status.New(codes.Unknown, gerr.Error())
+	}
+	data, err := proto.Marshal(detail)
+	if err != nil {
+		return st.Err()
+	}
+	p := st.Proto()
+	details := p.Details[:0]
+	for _, d := range p.Details {
+		if d.TypeUrl != detailTypeURL {
+			details = append(details, d)
+		}
+	}
+	p.Details = append(details, &any.Any{
+		TypeUrl: detailTypeURL,
+		Value:   data,
+	})
+	return status.ErrorProto(p)
+}
+
+// detailError keeps the detail of an error until it is mapped to grpc
+type detailError struct {
+	error
+	detail *types.ErrorDetail
+}
+
+func (e *detailError) Cause() error {
+	return e.error
+}
@@ -0,0 +1,57 @@
+package errdefs
+
+import (
+	"testing"
+
+	"github.com/containerd/containerd/api/types"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestDetailRoundTrip(t *testing.T) {
+	// the runtime error is detailed by the shim, the container by the
+	// service calling it
+	shimErr := ToGRPC(WithDetail(errors.Errorf("OCI runtime start failed: exec format error"), types.ErrorDetail{
+		RuntimeError: "exec format error",
+	}))
+	input := errors.Wrap(FromGRPC(shimErr), "failed to start task")
+	gerr := ToGRPC(WithDetail(input, types.ErrorDetail{
+		ContainerID: "c1",
+		Runtime:     "io.containerd.runtime.v1.linux",
+	}))
+	detail, ok := GetDetail(gerr)
+	if !ok {
+		t.Fatal("expected detail on grpc error")
+	}
+	expected := types.ErrorDetail{
+		ContainerID:  "c1",
+		Runtime:      "io.containerd.runtime.v1.linux",
+		RuntimeError: "exec format error",
+	}
+	if *detail != expected {
+		t.Fatalf("expected detail %v, got %v", expected, *detail)
+	}
+	detail, ok = GetDetail(errors.Wrap(FromGRPC(gerr), "start"))
+	if !ok {
+		t.Fatal("expected detail to be kept by FromGRPC")
+	}
+	if *detail != expected {
+		t.Fatalf("expected detail %v, got %v", expected, *detail)
+	}
+}
+
+func TestDetailKeepsCode(t *testing.T) {
+	for _, input := range []error{
+		errors.Wrap(ErrNotFound, "container c1"),
+		errors.Wrap(grpc.Errorf(codes.NotFound, "container c1"), "get"),
+	} {
+		gerr := ToGRPC(WithDetail(input, types.ErrorDetail{ContainerID: "c1"}))
+		if code := grpc.Code(gerr); code != codes.NotFound {
+			t.Errorf("%v: expected code %v, got %v", input, codes.NotFound, code)
+		}
+		if !IsNotFound(FromGRPC(gerr)) {
+			t.Errorf("%v: expected not found error, got %v", input, FromGRPC(gerr))
+		}
+	}
+}
@@ -26,6 +26,10 @@ var (
 	ErrAlreadyExists      = errors.New("already exists")
 	ErrFailedPrecondition = errors.New("failed precondition")
 	ErrUnavailable        = errors.New("unavailable")
+	ErrConflict           = errors.New("conflict")
+	ErrNotImplemented     = errors.New("not implemented")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrResourceExhausted  = errors.New("resource exhausted")
 )
 
 func IsInvalidArgument(err error) bool {
@@ -52,3 +56,27 @@ func IsFailedPrecondition(err error) bool {
 func IsUnavailable(err error) bool {
 	return errors.Cause(err) == ErrUnavailable
 }
+
+// IsConflict returns true if an update was rejected because the object was
+// modified since the revision the update was based on
+func IsConflict(err error) bool {
+	return errors.Cause(err) == ErrConflict
+}
+
+// IsNotImplemented returns true if the operation is not supported by the
+// implementation handling it
+func IsNotImplemented(err error) bool {
+	return errors.Cause(err) == ErrNotImplemented
+}
+
+// IsPermissionDenied returns true if the caller is not allowed to perform
+// the operation
+func IsPermissionDenied(err error) bool {
+	return errors.Cause(err) == ErrPermissionDenied
+}
+
+// IsResourceExhausted returns true if the operation was rejected because it
+// would exceed a limit, such as a quota of its namespace
+func IsResourceExhausted(err error) bool {
+	return errors.Cause(err) == ErrResourceExhausted
+}
@@ -16,7 +16,8 @@ import (
 // type.
 //
 // If the error is unmapped, the original error will be returned to be handled
-// by the regular grpc error handling stack.
+// by the regular grpc error handling stack. The detail carried by the error,
+// added with WithDetail, is attached to the status details.
 func ToGRPC(err error) error {
 	if err == nil {
 		return nil
@@ -27,6 +28,14 @@ func ToGRPC(err error) error {
 		return err
 	}
 
+	gerr := toGRPC(err)
+	if detail, ok := GetDetail(err); ok {
+		return attachDetail(gerr, detail)
+	}
+	return gerr
+}
+
+func toGRPC(err error) error {
 	switch {
 	case IsInvalidArgument(err):
 		return grpc.Errorf(codes.InvalidArgument, err.Error())
@@ -38,8 +47,20 @@ func ToGRPC(err error) error {
 		return grpc.Errorf(codes.FailedPrecondition, err.Error())
 	case IsUnavailable(err):
 		return grpc.Errorf(codes.Unavailable, err.Error())
+	case IsConflict(err):
+		return grpc.Errorf(codes.Aborted, "%s", err.Error())
+	case IsNotImplemented(err):
+		return grpc.Errorf(codes.Unimplemented, "%s", err.Error())
+	case IsPermissionDenied(err):
+		return grpc.Errorf(codes.PermissionDenied, "%s", err.Error())
+	case IsResourceExhausted(err):
+		return grpc.Errorf(codes.ResourceExhausted, "%s", err.Error())
 	}
 
+	// keep the code of a grpc error given more context
+	if st, ok := status.FromError(errors.Cause(err)); ok {
+		return grpc.Errorf(st.Code(), "%s", err.Error())
+	}
 	return err
 }
 
@@ -69,16 +90,32 @@ func FromGRPC(err error) error {
 		cls = ErrUnavailable
 	case codes.FailedPrecondition:
 		cls = ErrFailedPrecondition
+	case codes.Aborted:
+		cls = ErrConflict
+	case codes.Unimplemented:
+		cls = ErrNotImplemented
+	case codes.PermissionDenied:
+		cls = ErrPermissionDenied
+	case codes.ResourceExhausted:
+		cls = ErrResourceExhausted
 	default:
 		cls = ErrUnknown
 	}
 
+	hint, hasHint := GetHint(err)
+	detail, hasDetail := GetDetail(err)
 	msg := rebaseMessage(cls, err)
 	if msg != "" {
 		err = errors.Wrapf(cls, msg)
 	} else {
 		err = errors.WithStack(cls)
 	}
+	if hasHint {
+		err = &hintError{error: err, hint: hint}
+	}
+	if hasDetail {
+		err = &detailError{error: err, detail: detail}
+	}
 
 	return err
 }
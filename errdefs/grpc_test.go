@@ -35,6 +35,21 @@ func TestGRPCRoundTrip(t *testing.T) {
 			cause: ErrUnavailable,
 			str:   "should be not available: unavailable",
 		},
+		{
+			input: errors.Wrap(ErrNotImplemented, "cannot pause"),
+			cause: ErrNotImplemented,
+			str:   "cannot pause: not implemented",
+		},
+		{
+			input: errors.Wrap(ErrPermissionDenied, "create"),
+			cause: ErrPermissionDenied,
+			str:   "create: permission denied",
+		},
+		{
+			input: errors.Wrap(ErrResourceExhausted, "create"),
+			cause: ErrResourceExhausted,
+			str:   "create: resource exhausted",
+		},
 		{
 			input: errShouldLeaveAlone,
 			cause: ErrUnknown,
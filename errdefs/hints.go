@@ -0,0 +1,168 @@
+package errdefs
+
+import (
+	"strings"
+
+	"github.com/containerd/containerd/api/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// hintTypeURL is the type url of the hint in the details of a grpc status
+var hintTypeURL = "types.containerd.io/" + proto.MessageName(&types.Hint{})
+
+// knownFailure matches an error message that contains all of the patterns
+type knownFailure struct {
+	patterns []string
+	hint     types.Hint
+}
+
+// knownFailures are common failures of the runtime and the kernel whose
+// messages do not explain how to resolve them. The first match is used.
+var knownFailures = []knownFailure{
+	{
+		patterns: []string{"containerd is running rootless"},
+		hint: types.Hint{
+			Reason:      "Rootless",
+			Remediation: "containerd runs without root; drop the resource limits of the container or run containerd under a systemd user unit with Delegate=yes on a cgroup2 host, and checkpoint containers with a containerd running as root",
+		},
+	},
+	{
+		patterns: []string{"cgroup", "permission denied"},
+		hint: types.Hint{
+			Reason:      "CgroupPermissionDenied",
+			Remediation: "the runtime could not write to the cgroup filesystem; run containerd as root with the cgroup hierarchies mounted read-write, or use a cgroup parent that is delegated to it",
+		},
+	},
+	{
+		patterns: []string{"unknown filesystem type 'overlay'"},
+		hint: types.Hint{
+			Reason:      "OverlayUnsupported",
+			Remediation: "the overlay filesystem is not available; load the overlay kernel module with `modprobe overlay` or use another snapshotter",
+		},
+	},
+	{
+		patterns: []string{"apparmor", "no such file or directory"},
+		hint: types.Hint{
+			Reason:      "AppArmorProfileNotLoaded",
+			Remediation: "the apparmor profile of the container is not loaded; load it with `apparmor_parser` or change the profile in the container spec",
+		},
+	},
+	{
+		patterns: []string{"apparmor", "denied"},
+		hint: types.Hint{
+			Reason:      "AppArmorDenied",
+			Remediation: "an apparmor profile denied the operation; check the kernel log for the denial and adjust the profile of the container or of containerd",
+		},
+	},
+	{
+		patterns: []string{"executable file not found"},
+		hint: types.Hint{
+			Reason:      "ExecutableNotFound",
+			Remediation: "the process args must refer to a binary in the container's rootfs and PATH must be set in the process environment",
+		},
+	},
+	{
+		patterns: []string{"no space left on device"},
+		hint: types.Hint{
+			Reason:      "NoSpaceLeft",
+			Remediation: "the filesystem holding the containerd root or state directory is full; free space or remove unused images and snapshots",
+		},
+	},
+}
+
+// WithHint maps the error to a grpc error like ToGRPC and, when the error
+// matches a known failure of the runtime or the kernel, attaches a hint
+// describing how to resolve it to the status details.
+func WithHint(err error) error {
+	if err == nil {
+		return nil
+	}
+	hint, ok := findHint(err.Error())
+	if !ok {
+		return ToGRPC(err)
+	}
+	st, ok := status.FromError(ToGRPC(err))
+	if !ok {
+		st = status.New(codes.Unknown, err.Error())
+	}
+	for _, d := range st.Proto().Details {
+		if d.TypeUrl == hintTypeURL {
+			return st.Err()
+		}
+	}
+	data, err := proto.Marshal(&hint)
+	if err != nil {
+		return st.Err()
+	}
+	p := st.Proto()
+	p.Details = append(p.Details, &any.Any{
+		TypeUrl: hintTypeURL,
+		Value:   data,
+	})
+	return status.ErrorProto(p)
+}
+
+// GetHint returns the hint attached to a grpc error returned by the daemon
+// or to an error converted from one by FromGRPC.
+func GetHint(err error) (*types.Hint, bool) {
+	for err != nil {
+		if h, ok := err.(*hintError); ok {
+			return h.hint, true
+		}
+		if st, ok := status.FromError(err); ok {
+			return hintFromStatus(st)
+		}
+		c, ok := err.(interface {
+			Cause() error
+		})
+		if !ok {
+			break
+		}
+		err = c.Cause()
+	}
+	return nil, false
+}
+
+func hintFromStatus(st *status.Status) (*types.Hint, bool) {
+	for _, d := range st.Proto().Details {
+		if d.TypeUrl != hintTypeURL {
+			continue
+		}
+		var hint types.Hint
+		if err := proto.Unmarshal(d.Value, &hint); err != nil {
+			return nil, false
+		}
+		return &hint, true
+	}
+	return nil, false
+}
+
+func findHint(msg string) (types.Hint, bool) {
+	msg = strings.ToLower(msg)
+	for _, f := range knownFailures {
+		matched := true
+		for _, p := range f.patterns {
+			if !strings.Contains(msg, p) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return f.hint, true
+		}
+	}
+	return types.Hint{}, false
+}
+
+// hintError keeps the hint of a grpc error converted by FromGRPC
+type hintError struct {
+	error
+	hint *types.Hint
+}
+
+func (e *hintError) Cause() error {
+	return e.error
+}
@@ -0,0 +1,44 @@
+package errdefs
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestHintRoundTrip(t *testing.T) {
+	input := errors.Wrap(ErrFailedPrecondition, "mkdir /sys/fs/cgroup/cpu/default: permission denied")
+	gerr := WithHint(input)
+	hint, ok := GetHint(gerr)
+	if !ok {
+		t.Fatal("expected hint on grpc error")
+	}
+	if hint.Reason != "CgroupPermissionDenied" {
+		t.Fatalf("unexpected hint reason %q", hint.Reason)
+	}
+
+	ferr := FromGRPC(gerr)
+	if !IsFailedPrecondition(ferr) {
+		t.Fatalf("expected failed precondition, got %v", ferr)
+	}
+	if ferr.Error() != input.Error() {
+		t.Fatalf("unexpected error message %q", ferr.Error())
+	}
+	hint, ok = GetHint(errors.Wrap(ferr, "failed to start task"))
+	if !ok {
+		t.Fatal("expected hint to be kept by FromGRPC")
+	}
+	if hint.Reason != "CgroupPermissionDenied" {
+		t.Fatalf("unexpected hint reason %q", hint.Reason)
+	}
+}
+
+func TestHintUnknownFailure(t *testing.T) {
+	gerr := WithHint(errors.Wrap(ErrNotFound, "container"))
+	if _, ok := GetHint(gerr); ok {
+		t.Fatal("unexpected hint for unknown failure")
+	}
+	if _, ok := GetHint(FromGRPC(gerr)); ok {
+		t.Fatal("unexpected hint for unknown failure")
+	}
+}
@@ -0,0 +1,47 @@
+package containerd
+
+import (
+	"context"
+
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/errdefs"
+)
+
+// Subscribe to the events published by the daemon matching the filters,
+// from all namespaces unless filtered on with 'namespace==<namespace>'.
+//
+// The events are sent on the returned channel until the context is done or
+// the subscription fails, at which point the error is sent on the error
+// channel and both channels are closed.
+func (c *Client) Subscribe(ctx context.Context, filters ...string) (<-chan *eventsapi.Envelope, <-chan error) {
+	var (
+		ch   = make(chan *eventsapi.Envelope)
+		errs = make(chan error, 1)
+	)
+	go func() {
+		defer close(ch)
+		defer close(errs)
+		session, err := c.EventService().Subscribe(ctx, &eventsapi.SubscribeRequest{
+			Filters: filters,
+		})
+		if err != nil {
+			errs <- errdefs.FromGRPC(err)
+			return
+		}
+		for {
+			e, err := session.Recv()
+			if err != nil {
+				if ctx.Err() == nil {
+					errs <- errdefs.FromGRPC(err)
+				}
+				return
+			}
+			select {
+			case ch <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, errs
+}
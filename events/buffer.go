@@ -0,0 +1,105 @@
+package events
+
+import (
+	"sync"
+
+	events "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/errdefs"
+	goevents "github.com/docker/go-events"
+	metrics "github.com/docker/go-metrics"
+	"github.com/pkg/errors"
+)
+
+// OverflowPolicy is what is done with the events published while the buffer
+// of a subscriber is full
+type OverflowPolicy string
+
+const (
+	// DropPolicy drops the events and reports how many were dropped in the
+	// next envelope delivered to the subscriber
+	DropPolicy OverflowPolicy = "drop"
+	// DisconnectPolicy ends the subscription with ErrSlowSubscriber
+	DisconnectPolicy OverflowPolicy = "disconnect"
+)
+
+// Validate returns an error if the policy is unknown
+func (p OverflowPolicy) Validate() error {
+	switch p {
+	case DropPolicy, DisconnectPolicy:
+		return nil
+	}
+	return errors.Wrapf(errdefs.ErrInvalidArgument, "unknown overflow policy %q", p)
+}
+
+// ErrSlowSubscriber ends the subscriptions whose buffer overflowed with the
+// disconnect policy. It is unavailable so that clients subscribe again.
+var ErrSlowSubscriber = errors.Wrap(errdefs.ErrUnavailable, "subscriber too slow to receive events")
+
+var (
+	droppedEvents   metrics.Counter
+	slowSubscribers metrics.Counter
+)
+
+func init() {
+	ns := metrics.NewNamespace("containerd", "events", nil)
+	droppedEvents = ns.NewCounter("dropped", "The number of events dropped for subscribers whose buffer was full")
+	slowSubscribers = ns.NewCounter("disconnected", "The number of subscriptions ended because their buffer was full")
+	metrics.Register(ns)
+}
+
+// buffer is a sink holding a bounded number of events for a subscriber,
+// which never blocks the broadcaster of the exchange
+type buffer struct {
+	C chan goevents.Event
+	// overflow is closed when the buffer overflows with the disconnect policy
+	overflow chan struct{}
+	policy   OverflowPolicy
+
+	mu      sync.Mutex
+	dropped uint64
+	closed  bool
+}
+
+func newBuffer(size int, policy OverflowPolicy) *buffer {
+	return &buffer{
+		C:        make(chan goevents.Event, size),
+		overflow: make(chan struct{}),
+		policy:   policy,
+	}
+}
+
+func (b *buffer) Write(ev goevents.Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return goevents.ErrSinkClosed
+	}
+	if envelope, ok := ev.(*events.Envelope); ok && b.dropped > 0 {
+		// the envelope is shared with the other subscribers
+		flagged := *envelope
+		flagged.Dropped = b.dropped
+		ev = &flagged
+	}
+	select {
+	case b.C <- ev:
+		b.dropped = 0
+		return nil
+	default:
+	}
+	if b.policy == DisconnectPolicy {
+		b.closed = true
+		close(b.overflow)
+		slowSubscribers.Inc()
+		return nil
+	}
+	b.dropped++
+	droppedEvents.Inc()
+	return nil
+}
+
+func (b *buffer) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	return nil
+}
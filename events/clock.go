@@ -0,0 +1,111 @@
+package events
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	_ "unsafe" // for go:linkname
+
+	events "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/pkg/errors"
+)
+
+const (
+	// WallClock stamps events with the time of the system clock
+	WallClock = "wall"
+	// MonotonicClock stamps events with the time the daemon started plus the
+	// time elapsed since on the monotonic clock, so that the timestamps of
+	// events do not go back when the system clock is stepped
+	MonotonicClock = "monotonic"
+)
+
+// Clock stamps the envelopes of events with their timestamp, epoch and
+// counter
+type Clock struct {
+	monotonic bool
+	start     time.Time
+	// startMono is the reading of the monotonic clock at start
+	startMono int64
+	epoch     uint64
+
+	mu      sync.Mutex
+	counter uint64
+}
+
+// NewClock returns a clock of the source, WallClock or MonotonicClock, whose
+// epoch is one more than the epoch persisted at path. The new epoch is
+// persisted before the clock is returned. An empty path starts from epoch 0
+// without persisting it.
+func NewClock(source, path string) (*Clock, error) {
+	c := &Clock{
+		start:     time.Now(),
+		startMono: nanotime(),
+	}
+	switch source {
+	case "", WallClock:
+	case MonotonicClock:
+		c.monotonic = true
+	default:
+		return nil, errors.Errorf("unknown event clock %q", source)
+	}
+	if path == "" {
+		return c, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if len(data) > 0 {
+		epoch, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid event epoch in %s", path)
+		}
+		c.epoch = epoch + 1
+	}
+	tmp := filepath.Join(filepath.Dir(path), "."+filepath.Base(path))
+	if err := ioutil.WriteFile(tmp, []byte(strconv.FormatUint(c.epoch, 10)), 0644); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Epoch returns the epoch of the clock
+func (c *Clock) Epoch() uint64 {
+	return c.epoch
+}
+
+// Now returns the time according to the source of the clock
+func (c *Clock) Now() time.Time {
+	if c.monotonic {
+		return c.start.Add(time.Duration(nanotime() - c.startMono)).UTC()
+	}
+	return time.Now().UTC()
+}
+
+// nanotime reads the monotonic clock of the runtime. containerd is built with
+// Go 1.8, whose time.Time carries no reading of the monotonic clock.
+//go:linkname nanotime runtime.nanotime
+func nanotime() int64
+
+// stamp sets the epoch and the next counter on the envelope, and the
+// timestamp and wall time if they are not set yet
+func (c *Clock) stamp(envelope *events.Envelope) {
+	c.mu.Lock()
+	c.counter++
+	envelope.Counter = c.counter
+	c.mu.Unlock()
+	envelope.Epoch = c.epoch
+	if envelope.Timestamp.IsZero() {
+		envelope.Timestamp = c.Now()
+	}
+	if envelope.WallTime.IsZero() {
+		envelope.WallTime = time.Now().UTC()
+	}
+}
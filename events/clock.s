@@ -0,0 +1,2 @@
+// This file allows nanotime in clock.go to be declared without a body,
+// its implementation being linked from the runtime.
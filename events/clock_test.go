@@ -0,0 +1,57 @@
+package events
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	events "github.com/containerd/containerd/api/services/events/v1"
+)
+
+func TestClockEpochPersisted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "events-clock-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "epoch")
+
+	for expected := uint64(0); expected < 3; expected++ {
+		c, err := NewClock(WallClock, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.Epoch() != expected {
+			t.Fatalf("expected epoch %d, got %d", expected, c.Epoch())
+		}
+	}
+}
+
+func TestClockStamp(t *testing.T) {
+	c, err := NewClock(MonotonicClock, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var last events.Envelope
+	for i := 0; i < 3; i++ {
+		var envelope events.Envelope
+		c.stamp(&envelope)
+		if envelope.Counter <= last.Counter {
+			t.Fatalf("expected counter after %d, got %d", last.Counter, envelope.Counter)
+		}
+		if envelope.Timestamp.Before(last.Timestamp) {
+			t.Fatalf("expected timestamp after %s, got %s", last.Timestamp, envelope.Timestamp)
+		}
+		if envelope.WallTime.IsZero() {
+			t.Fatal("expected wall time to be set")
+		}
+		last = envelope
+	}
+}
+
+func TestClockUnknownSource(t *testing.T) {
+	if _, err := NewClock("sundial", ""); err == nil {
+		t.Fatal("expected unknown clock source to be an error")
+	}
+}
@@ -3,7 +3,7 @@ package events
 import (
 	"context"
 	"strings"
-	"time"
+	"sync"
 
 	events "github.com/containerd/containerd/api/services/events/v1"
 	"github.com/containerd/containerd/errdefs"
@@ -20,22 +20,59 @@ import (
 
 type Exchange struct {
 	broadcaster *goevents.Broadcaster
+	clock       *Clock
+
+	mu         sync.RWMutex
+	transforms map[string][]TransformFunc
+}
+
+// TransformFunc rewrites the event of an envelope before it is broadcast,
+// such as to complete it with information only the daemon has
+type TransformFunc func(ctx context.Context, envelope *events.Envelope) error
+
+// ErrDropped is returned by a transform to have the envelope dropped rather
+// than broadcast
+var ErrDropped = errors.New("event dropped")
+
+// ExchangeOpt configures an exchange
+type ExchangeOpt func(*Exchange)
+
+// WithClock stamps the events on the exchange with the clock
+func WithClock(c *Clock) ExchangeOpt {
+	return func(e *Exchange) {
+		e.clock = c
+	}
 }
 
-func NewExchange() *Exchange {
-	return &Exchange{
+// NewExchange returns an exchange that stamps events with the wall clock in
+// epoch 0 unless a clock is provided
+func NewExchange(opts ...ExchangeOpt) *Exchange {
+	e := &Exchange{
 		broadcaster: goevents.NewBroadcaster(),
 	}
+	for _, o := range opts {
+		o(e)
+	}
+	if e.clock == nil {
+		e.clock, _ = NewClock(WallClock, "")
+	}
+	return e
 }
 
 // Forward accepts an envelope to be direcly distributed on the exchange.
 //
 // This is useful when an event is forwaded on behalf of another namespace or
-// when the event is propagated on behalf of another publisher.
+// when the event is propagated on behalf of another publisher. The timestamp
+// of the envelope is kept but it is given the epoch and counter of the
+// exchange's clock.
 func (e *Exchange) Forward(ctx context.Context, envelope *events.Envelope) (err error) {
 	if err := validateEnvelope(envelope); err != nil {
 		return err
 	}
+	e.clock.stamp(envelope)
+	if e.transform(ctx, envelope) {
+		return nil
+	}
 
 	defer func() {
 		logger := log.G(ctx).WithFields(logrus.Fields{
@@ -77,10 +114,13 @@ func (e *Exchange) Publish(ctx context.Context, topic string, event Event) (err
 		return err
 	}
 
-	envelope.Timestamp = time.Now().UTC()
+	e.clock.stamp(&envelope)
 	envelope.Namespace = namespace
 	envelope.Topic = topic
 	envelope.Event = encoded
+	if e.transform(ctx, &envelope) {
+		return nil
+	}
 
 	defer func() {
 		logger := log.G(ctx).WithFields(logrus.Fields{
@@ -99,6 +139,37 @@ func (e *Exchange) Publish(ctx context.Context, topic string, event Event) (err
 	return e.broadcaster.Write(&envelope)
 }
 
+// Transform registers a transform for the envelopes of the topic published
+// or forwarded on the exchange. Transforms are called in the order they are
+// registered and an envelope whose transform fails is broadcast as is, unless
+// it fails with ErrDropped.
+func (e *Exchange) Transform(topic string, fn TransformFunc) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.transforms == nil {
+		e.transforms = make(map[string][]TransformFunc)
+	}
+	e.transforms[topic] = append(e.transforms[topic], fn)
+}
+
+// transform applies the transforms of the topic to the envelope, returning
+// true when the envelope is dropped
+func (e *Exchange) transform(ctx context.Context, envelope *events.Envelope) bool {
+	e.mu.RLock()
+	transforms := e.transforms[envelope.Topic]
+	e.mu.RUnlock()
+	for _, fn := range transforms {
+		if err := fn(ctx, envelope); err != nil {
+			if err == ErrDropped {
+				log.G(ctx).WithField("topic", envelope.Topic).Debug("event dropped")
+				return true
+			}
+			log.G(ctx).WithError(err).WithField("topic", envelope.Topic).Warn("failed to transform event")
+		}
+	}
+	return false
+}
+
 // Subscribe to events on the exchange. Events are sent through the returned
 // channel ch. If an error is encountered, it will be sent on channel errs and
 // errs will be closed. To end the subscription, cancel the provided context.
@@ -108,18 +179,39 @@ func (e *Exchange) Publish(ctx context.Context, topic string, event Event) (err
 // the standard containerd filters package syntax.
 func (e *Exchange) Subscribe(ctx context.Context, fs ...string) (ch <-chan *events.Envelope, errs <-chan error) {
 	var (
-		evch                  = make(chan *events.Envelope)
-		errq                  = make(chan error, 1)
-		channel               = goevents.NewChannel(0)
-		queue                 = goevents.NewQueue(channel)
-		dst     goevents.Sink = queue
+		channel = goevents.NewChannel(0)
+		queue   = goevents.NewQueue(channel)
+	)
+	return e.subscribe(ctx, queue, channel.C, nil, func() {
+		channel.Close()
+		queue.Close()
+	}, fs...)
+}
+
+// SubscribeBuffered subscribes to events on the exchange like Subscribe, but
+// holds at most size events for the subscriber. The events published while
+// they are held are handled according to the policy, so that a subscriber
+// that does not keep up, such as a remote client, neither delays the other
+// subscribers nor holds an unbounded backlog of events in the daemon.
+func (e *Exchange) SubscribeBuffered(ctx context.Context, size int, policy OverflowPolicy, fs ...string) (ch <-chan *events.Envelope, errs <-chan error) {
+	b := newBuffer(size, policy)
+	return e.subscribe(ctx, b, b.C, b.overflow, func() { b.Close() }, fs...)
+}
+
+// subscribe adds the sink to the broadcaster and relays the events it queues
+// to the returned channel until the context is done or the overflow channel
+// is closed
+func (e *Exchange) subscribe(ctx context.Context, sink goevents.Sink, queued <-chan goevents.Event, overflow <-chan struct{}, closeSink func(), fs ...string) (ch <-chan *events.Envelope, errs <-chan error) {
+	var (
+		evch               = make(chan *events.Envelope)
+		errq               = make(chan error, 1)
+		dst  goevents.Sink = sink
 	)
 
 	closeAll := func() {
 		defer close(errq)
 		defer e.broadcaster.Remove(dst)
-		defer queue.Close()
-		defer channel.Close()
+		closeSink()
 	}
 
 	ch = evch
@@ -133,7 +225,7 @@ func (e *Exchange) Subscribe(ctx context.Context, fs ...string) (ch <-chan *even
 			return
 		}
 
-		dst = goevents.NewFilter(queue, goevents.MatcherFunc(func(gev goevents.Event) bool {
+		dst = goevents.NewFilter(sink, goevents.MatcherFunc(func(gev goevents.Event) bool {
 			return filter.Match(adapt(gev))
 		}))
 	}
@@ -147,7 +239,7 @@ func (e *Exchange) Subscribe(ctx context.Context, fs ...string) (ch <-chan *even
 	loop:
 		for {
 			select {
-			case ev := <-channel.C:
+			case ev := <-queued:
 				env, ok := ev.(*events.Envelope)
 				if !ok {
 					// TODO(stevvooe): For the most part, we are well protected
@@ -159,9 +251,15 @@ func (e *Exchange) Subscribe(ctx context.Context, fs ...string) (ch <-chan *even
 
 				select {
 				case evch <- env:
+				case <-overflow:
+					err = ErrSlowSubscriber
+					break loop
 				case <-ctx.Done():
 					break loop
 				}
+			case <-overflow:
+				err = ErrSlowSubscriber
+				break loop
 			case <-ctx.Done():
 				break loop
 			}
@@ -150,3 +150,141 @@ func TestExchangeValidateTopic(t *testing.T) {
 		})
 	}
 }
+
+func TestExchangeTransform(t *testing.T) {
+	ctx := namespaces.WithNamespace(context.Background(), t.Name())
+	exchange := NewExchange()
+	exchange.Transform("/test", func(ctx context.Context, envelope *events.Envelope) error {
+		v, err := typeurl.UnmarshalAny(envelope.Event)
+		if err != nil {
+			return err
+		}
+		e := v.(*events.ContainerCreate)
+		e.Image = "transformed"
+		envelope.Event, err = typeurl.MarshalAny(e)
+		return err
+	})
+	exchange.Transform("/test", func(ctx context.Context, envelope *events.Envelope) error {
+		return errors.New("failed")
+	})
+
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	eventq, errq := exchange.Subscribe(cctx)
+	for _, topic := range []string{"/test", "/other"} {
+		if err := exchange.Publish(ctx, topic, &events.ContainerCreate{ID: "test"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, expected := range []string{"transformed", ""} {
+		select {
+		case ev := <-eventq:
+			v, err := typeurl.UnmarshalAny(ev.Event)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if image := v.(*events.ContainerCreate).Image; image != expected {
+				t.Fatalf("expected image %q, got %q", expected, image)
+			}
+		case err := <-errq:
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestExchangeTransformDrop(t *testing.T) {
+	ctx := namespaces.WithNamespace(context.Background(), t.Name())
+	exchange := NewExchange()
+	exchange.Transform("/test", func(ctx context.Context, envelope *events.Envelope) error {
+		return ErrDropped
+	})
+
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	eventq, errq := exchange.Subscribe(cctx)
+	for _, topic := range []string{"/test", "/other"} {
+		if err := exchange.Publish(ctx, topic, &events.ContainerCreate{ID: "test"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	select {
+	case ev := <-eventq:
+		if ev.Topic != "/other" {
+			t.Fatalf("expected the event of /test to be dropped, got %s", ev.Topic)
+		}
+	case err := <-errq:
+		t.Fatal(err)
+	}
+}
+
+func TestExchangeSubscribeBufferedDrop(t *testing.T) {
+	ctx := namespaces.WithNamespace(context.Background(), t.Name())
+	exchange := NewExchange()
+
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	eventq, errq := exchange.SubscribeBuffered(cctx, 2, DropPolicy)
+	const published = 10
+	for i := 0; i < published; i++ {
+		if err := exchange.Publish(ctx, "/test", &events.ContainerCreate{ID: fmt.Sprint(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var received, dropped uint64
+drain:
+	for {
+		select {
+		case ev := <-eventq:
+			received++
+			dropped += ev.Dropped
+		case err := <-errq:
+			t.Fatal(err)
+		case <-time.After(100 * time.Millisecond):
+			break drain
+		}
+	}
+	if received >= published {
+		t.Fatalf("expected events to be dropped, received %d of %d", received, published)
+	}
+	// the next event delivered reports the events dropped since the last one
+	if err := exchange.Publish(ctx, "/test", &events.ContainerCreate{ID: "last"}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case ev := <-eventq:
+		received++
+		dropped += ev.Dropped
+	case err := <-errq:
+		t.Fatal(err)
+	case <-time.After(time.Second):
+		t.Fatal("expected the last event to be delivered")
+	}
+	if received+dropped != published+1 {
+		t.Fatalf("expected %d events received or dropped, got %d received and %d dropped", published+1, received, dropped)
+	}
+}
+
+func TestExchangeSubscribeBufferedDisconnect(t *testing.T) {
+	ctx := namespaces.WithNamespace(context.Background(), t.Name())
+	exchange := NewExchange()
+
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	_, errq := exchange.SubscribeBuffered(cctx, 1, DisconnectPolicy)
+	for i := 0; i < 5; i++ {
+		if err := exchange.Publish(ctx, "/test", &events.ContainerCreate{ID: fmt.Sprint(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	select {
+	case err := <-errq:
+		if err != ErrSlowSubscriber {
+			t.Fatalf("expected slow subscriber error, got %v", err)
+		}
+		if !errdefs.IsUnavailable(err) {
+			t.Fatalf("expected slow subscriber error to be unavailable, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the subscription to end")
+	}
+}
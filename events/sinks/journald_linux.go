@@ -0,0 +1,79 @@
+package sinks
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+const journalSocket = "/run/systemd/journal/socket"
+
+// journald writes each message as an entry of the systemd journal, with
+// the fields of the event prefixed with CONTAINERD_
+type journald struct {
+	conn *net.UnixConn
+}
+
+func newJournald() (sink, error) {
+	if _, err := os.Stat(journalSocket); err != nil {
+		return nil, errors.Wrap(err, "journald is not running")
+	}
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{
+		Name: journalSocket,
+		Net:  "unixgram",
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &journald{conn: conn}, nil
+}
+
+func (j *journald) send(ctx context.Context, m *Message) error {
+	var b bytes.Buffer
+	for _, f := range [][2]string{
+		{"MESSAGE", m.summary()},
+		{"PRIORITY", "6"},
+		{"SYSLOG_IDENTIFIER", "containerd"},
+		{"CONTAINERD_TOPIC", m.Topic},
+		{"CONTAINERD_NAMESPACE", m.Namespace},
+		{"CONTAINERD_EVENT_TYPE", m.Type},
+		{"CONTAINERD_EVENT", string(m.Event)},
+	} {
+		if f[1] != "" {
+			writeJournalField(&b, f[0], f[1])
+		}
+	}
+	if _, err := j.conn.Write(b.Bytes()); err != nil {
+		// entries too large for a datagram would have to be passed in a
+		// file descriptor
+		if opErr, ok := err.(*net.OpError); ok {
+			if serr, ok := opErr.Err.(*os.SyscallError); ok && serr.Err == syscall.EMSGSIZE {
+				return permanentError{err}
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// writeJournalField writes the field in the native protocol of journald,
+// with the length of the value prefixed when it spans lines
+func writeJournalField(b *bytes.Buffer, key, value string) {
+	if !strings.Contains(value, "\n") {
+		b.WriteString(key + "=" + value + "\n")
+		return
+	}
+	b.WriteString(key + "\n")
+	binary.Write(b, binary.LittleEndian, uint64(len(value)))
+	b.WriteString(value + "\n")
+}
+
+func (j *journald) close() error {
+	return j.conn.Close()
+}
@@ -0,0 +1,12 @@
+// +build !linux
+
+package sinks
+
+import (
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+)
+
+func newJournald() (sink, error) {
+	return nil, errors.Wrap(errdefs.ErrNotImplemented, "journald is only supported on linux")
+}
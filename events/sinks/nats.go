@@ -0,0 +1,218 @@
+package sinks
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/log"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+const defaultSubject = "containerd.events"
+
+// natsSink publishes each message as JSON on a NATS subject, speaking the
+// text protocol of NATS servers. The connection is made on the first
+// delivery and again after it fails.
+type natsSink struct {
+	addr    string
+	subject string
+	connect []byte
+
+	mu   sync.Mutex
+	conn *natsConn
+}
+
+// natsConn is a connection to a NATS server, answering the pings of the
+// server until it is closed
+type natsConn struct {
+	net.Conn
+	// mu serializes the writes of publishes and pongs
+	mu sync.Mutex
+	w  *bufio.Writer
+	// done is closed once the server closes the connection or reports an
+	// error
+	done chan struct{}
+}
+
+func newNATS(u, subject string) (sink, error) {
+	parsed, err := url.Parse(u)
+	if err != nil || parsed.Scheme != "nats" || parsed.Host == "" {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid nats url %q", u)
+	}
+	addr := parsed.Host
+	if parsed.Port() == "" {
+		addr = net.JoinHostPort(parsed.Hostname(), "4222")
+	}
+	if subject == "" {
+		subject = defaultSubject
+	}
+	options := map[string]interface{}{
+		"verbose":  false,
+		"pedantic": false,
+		"name":     "containerd",
+		"lang":     "go",
+	}
+	if user := parsed.User; user != nil {
+		if password, ok := user.Password(); ok {
+			options["user"] = user.Username()
+			options["pass"] = password
+		} else {
+			options["auth_token"] = user.Username()
+		}
+	}
+	connect, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+	return &natsSink{
+		addr:    addr,
+		subject: subject,
+		connect: connect,
+	}, nil
+}
+
+// natsSubject returns the subject of the message, its namespace and the
+// elements of its topic appended to the prefix, such as
+// "containerd.events.default.tasks.exit", so that subscribers can select
+// events with wildcards. The dots of the namespace are escaped so that it
+// is a single token of the subject.
+func natsSubject(prefix string, m *Message) string {
+	topic := strings.Replace(strings.Trim(m.Topic, "/"), "/", ".", -1)
+	return prefix + "." + strings.Replace(m.Namespace, ".", "%2E", -1) + "." + topic
+}
+
+func (n *natsSink) send(ctx context.Context, m *Message) error {
+	p, err := json.Marshal(m)
+	if err != nil {
+		return permanentError{err}
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.conn != nil {
+		select {
+		case <-n.conn.done:
+			n.conn.Close()
+			n.conn = nil
+		default:
+		}
+	}
+	if n.conn == nil {
+		if n.conn, err = n.dial(ctx); err != nil {
+			return err
+		}
+	}
+	if err := n.conn.publish(ctx, natsSubject(n.subject, m), p); err != nil {
+		n.conn.Close()
+		n.conn = nil
+		return err
+	}
+	return nil
+}
+
+// dial connects to the server, waiting for the pong of a ping sent after
+// the connect so that authentication errors are reported
+func (n *natsSink) dial(ctx context.Context) (*natsConn, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", n.addr)
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	r := bufio.NewReader(conn)
+	line, err := r.ReadString('\n')
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "failed to read nats server info")
+	}
+	if !strings.HasPrefix(line, "INFO") {
+		conn.Close()
+		return nil, errors.Errorf("unexpected nats server greeting %q", strings.TrimSpace(line))
+	}
+	if _, err := fmt.Fprintf(conn, "CONNECT %s\r\nPING\r\n", n.connect); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			conn.Close()
+			return nil, errors.Wrap(err, "failed to connect to nats server")
+		}
+		line = strings.TrimSpace(line)
+		if line == "PONG" {
+			break
+		}
+		if strings.HasPrefix(line, "-ERR") {
+			conn.Close()
+			return nil, errors.Errorf("nats server refused the connection: %s", line)
+		}
+	}
+	conn.SetDeadline(time.Time{})
+
+	c := &natsConn{
+		Conn: conn,
+		w:    bufio.NewWriter(conn),
+		done: make(chan struct{}),
+	}
+	go c.read(ctx, r)
+	return c, nil
+}
+
+func (c *natsConn) publish(ctx context.Context, subject string, p []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if deadline, ok := ctx.Deadline(); ok {
+		c.SetWriteDeadline(deadline)
+		defer c.SetWriteDeadline(time.Time{})
+	}
+	fmt.Fprintf(c.w, "PUB %s %d\r\n", subject, len(p))
+	c.w.Write(p)
+	c.w.WriteString("\r\n")
+	return c.w.Flush()
+}
+
+// read answers the pings of the server until the connection is closed
+func (c *natsConn) read(ctx context.Context, r *bufio.Reader) {
+	defer close(c.done)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "PING":
+			c.mu.Lock()
+			c.w.WriteString("PONG\r\n")
+			err := c.w.Flush()
+			c.mu.Unlock()
+			if err != nil {
+				return
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			log.G(ctx).Warnf("nats server error: %s", line)
+			return
+		}
+	}
+}
+
+func (n *natsSink) close() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.conn != nil {
+		n.conn.Close()
+		n.conn = nil
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package sinks
+
+import (
+	"context"
+
+	"github.com/containerd/containerd/plugin"
+)
+
+var _ plugin.Reloader = &Sinks{}
+
+// Reload replaces the sinks with those of the new configuration. The events
+// still buffered for the previous sinks are dropped.
+func (s *Sinks) Reload(ctx context.Context, config interface{}) ([]string, error) {
+	forwarders, err := newForwarders(config.(*Config))
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cancel()
+	s.start(forwarders)
+	return nil, nil
+}
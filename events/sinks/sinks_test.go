@@ -0,0 +1,231 @@
+package sinks
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/namespaces"
+	"golang.org/x/net/context"
+)
+
+func testMessage() *Message {
+	return &Message{
+		Timestamp: time.Now(),
+		Namespace: "default",
+		Topic:     "/tasks/exit",
+		Type:      "containerd.services.events.v1.TaskExit",
+		Event:     json.RawMessage(`{"container_id":"redis"}`),
+	}
+}
+
+// slowSink records the messages it is sent after a delay
+type slowSink struct {
+	mu       sync.Mutex
+	messages []*Message
+}
+
+func (s *slowSink) send(ctx context.Context, m *Message) error {
+	time.Sleep(10 * time.Millisecond)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = append(s.messages, m)
+	return nil
+}
+
+func (s *slowSink) close() error {
+	return nil
+}
+
+func TestFlush(t *testing.T) {
+	exchange := events.NewExchange()
+	sink := &slowSink{}
+	s := &Sinks{
+		ctx:      context.Background(),
+		exchange: exchange,
+	}
+	s.start([]*forwarder{{
+		name:    "slow",
+		sink:    sink,
+		buffer:  16,
+		timeout: time.Second,
+		flushes: make(chan chan struct{}),
+		done:    make(chan struct{}),
+	}})
+	defer s.Close()
+	// let the forwarder subscribe
+	time.Sleep(10 * time.Millisecond)
+
+	ctx := namespaces.WithNamespace(context.Background(), "default")
+	for i := 0; i < 5; i++ {
+		if err := exchange.Publish(ctx, "/tasks/exit", &eventsapi.TaskExit{ContainerID: "redis"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.Flush(fctx); err != nil {
+		t.Fatal(err)
+	}
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	if len(sink.messages) != 5 {
+		t.Fatalf("expected the 5 events to be delivered once flushed, got %d", len(sink.messages))
+	}
+}
+
+func TestWebhookRetries(t *testing.T) {
+	var (
+		requests int
+		statuses = []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}
+		received Message
+	)
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("expected the configured header, got %q", r.Header.Get("Authorization"))
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Error(err)
+		}
+		rw.WriteHeader(statuses[requests])
+		requests++
+	}))
+	defer s.Close()
+
+	sink, err := newWebhook(s.URL, map[string]string{"Authorization": "Bearer token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &forwarder{sink: sink, retries: 2, timeout: time.Second}
+	if !f.deliver(context.Background(), testMessage()) {
+		t.Fatal("expected the event to be delivered")
+	}
+	if requests != 3 {
+		t.Fatalf("expected 3 requests, got %d", requests)
+	}
+	if received.Topic != "/tasks/exit" || string(received.Event) != `{"container_id":"redis"}` {
+		t.Fatalf("unexpected message %+v", received)
+	}
+
+	// client errors are not retried
+	requests, statuses = 0, []int{http.StatusBadRequest, http.StatusOK}
+	if f.deliver(context.Background(), testMessage()) {
+		t.Fatal("expected the event to be dropped")
+	}
+	if requests != 1 {
+		t.Fatalf("expected 1 request, got %d", requests)
+	}
+}
+
+func TestNATS(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	published := make(chan string, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		fmt.Fprint(conn, "INFO {\"server_id\":\"test\"}\r\n")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch {
+			case strings.HasPrefix(line, "CONNECT "):
+				if !strings.Contains(line, `"user":"user"`) || !strings.Contains(line, `"pass":"secret"`) {
+					t.Errorf("expected credentials in %q", line)
+				}
+			case line == "PING\r\n":
+				fmt.Fprint(conn, "PONG\r\n")
+			case strings.HasPrefix(line, "PUB "):
+				var (
+					subject string
+					size    int
+				)
+				if _, err := fmt.Sscanf(line, "PUB %s %d\r\n", &subject, &size); err != nil {
+					t.Error(err)
+					return
+				}
+				p := make([]byte, size+2)
+				if _, err := io.ReadFull(r, p); err != nil {
+					t.Error(err)
+					return
+				}
+				published <- subject + " " + string(p[:size])
+			}
+		}
+	}()
+
+	sink, err := newNATS("nats://user:secret@"+l.Addr().String(), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sink.close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := sink.send(ctx, testMessage()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case p := <-published:
+		parts := strings.SplitN(p, " ", 2)
+		if parts[0] != "containerd.events.default.tasks.exit" {
+			t.Errorf("unexpected subject %s", parts[0])
+		}
+		var m Message
+		if err := json.Unmarshal([]byte(parts[1]), &m); err != nil {
+			t.Fatal(err)
+		}
+		if m.Namespace != "default" || m.Type != "containerd.services.events.v1.TaskExit" {
+			t.Errorf("unexpected message %+v", m)
+		}
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for the publish")
+	}
+}
+
+func TestNATSSubject(t *testing.T) {
+	for _, c := range []struct {
+		m       Message
+		subject string
+	}{
+		{Message{Namespace: "default", Topic: "/tasks/exit"}, "containerd.events.default.tasks.exit"},
+		{Message{Namespace: "k8s.io", Topic: "/containers/create"}, "containerd.events.k8s%2Eio.containers.create"},
+	} {
+		if subject := natsSubject("containerd.events", &c.m); subject != c.subject {
+			t.Errorf("expected subject %s but got %s", c.subject, subject)
+		}
+	}
+}
+
+func TestInvalidSinks(t *testing.T) {
+	for _, c := range []SinkConfig{
+		{Type: "unknown"},
+		{Type: "webhook", URL: "ftp://example.com"},
+		{Type: "nats", URL: "http://example.com"},
+		{Type: "webhook", URL: "http://example.com", Timeout: "soon"},
+		{Type: "webhook", URL: "http://example.com", Filters: []string{"topic=="}},
+	} {
+		if _, err := newForwarder(c); err == nil {
+			t.Errorf("expected an error for %+v", c)
+		}
+	}
+}
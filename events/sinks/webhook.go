@@ -0,0 +1,68 @@
+package sinks
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// webhook posts each message as JSON to a URL
+type webhook struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func newWebhook(u string, headers map[string]string) (sink, error) {
+	parsed, err := url.Parse(u)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid webhook url %q", u)
+	}
+	return &webhook{
+		url:     u,
+		headers: headers,
+		client:  &http.Client{},
+	}, nil
+}
+
+func (w *webhook) send(ctx context.Context, m *Message) error {
+	p, err := json.Marshal(m)
+	if err != nil {
+		return permanentError{err}
+	}
+	req, err := http.NewRequest(http.MethodPost, w.url, bytes.NewReader(p))
+	if err != nil {
+		return permanentError{err}
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range w.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	err = errors.Errorf("webhook responded %s", resp.Status)
+	// the request is only retried when the webhook may accept it later
+	if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
+		return err
+	}
+	return permanentError{err}
+}
+
+func (w *webhook) close() error {
+	return nil
+}
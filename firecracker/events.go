@@ -0,0 +1,101 @@
+// +build linux
+
+package firecracker
+
+import (
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/containerd/typeurl"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// agentPublisher is the part of the events service offered to the agent of
+// a VM
+type agentPublisher interface {
+	Publish(context.Context, *eventsapi.PublishRequest) (*empty.Empty, error)
+}
+
+// agentEventsDesc describes the events service with only its Publish call,
+// so that the guest can neither forward envelopes of other namespaces nor
+// subscribe to the events of the host
+var agentEventsDesc = grpc.ServiceDesc{
+	ServiceName: "containerd.services.events.v1.Events",
+	HandlerType: (*agentPublisher)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Publish",
+			Handler:    publishHandler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/containerd/containerd/api/services/events/v1/events.proto",
+}
+
+func publishHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(eventsapi.PublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	return srv.(agentPublisher).Publish(ctx, in)
+}
+
+// agentEvents publishes the events of the agent of a VM in the namespace of
+// its task, accepting only the task events of that task
+type agentEvents struct {
+	namespace string
+	id        string
+	publisher events.Publisher
+}
+
+func (a *agentEvents) Publish(ctx context.Context, r *eventsapi.PublishRequest) (*empty.Empty, error) {
+	if r.Event == nil {
+		return nil, errdefs.ToGRPCf(errdefs.ErrInvalidArgument, "event is required")
+	}
+	v, err := typeurl.UnmarshalAny(r.Event)
+	if err != nil {
+		return nil, errdefs.ToGRPC(errors.Wrap(errdefs.ErrInvalidArgument, err.Error()))
+	}
+	topic, id, ok := taskEvent(v)
+	if !ok || topic != r.Topic {
+		return nil, errdefs.ToGRPCf(errdefs.ErrPermissionDenied, "event %T on topic %q", v, r.Topic)
+	}
+	if id != a.id {
+		return nil, errdefs.ToGRPCf(errdefs.ErrPermissionDenied, "event of container %q", id)
+	}
+	if err := a.publisher.Publish(namespaces.WithNamespace(ctx, a.namespace), r.Topic, r.Event); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// taskEvent returns the topic and the container of the events a shim
+// publishes for its task
+func taskEvent(v interface{}) (string, string, bool) {
+	switch e := v.(type) {
+	case *eventsapi.TaskCreate:
+		return runtime.TaskCreateEventTopic, e.ContainerID, true
+	case *eventsapi.TaskStart:
+		return runtime.TaskStartEventTopic, e.ContainerID, true
+	case *eventsapi.TaskOOM:
+		return runtime.TaskOOMEventTopic, e.ContainerID, true
+	case *eventsapi.TaskExit:
+		return runtime.TaskExitEventTopic, e.ContainerID, true
+	case *eventsapi.TaskDelete:
+		return runtime.TaskDeleteEventTopic, e.ContainerID, true
+	case *eventsapi.TaskExecAdded:
+		return runtime.TaskExecAddedEventTopic, e.ContainerID, true
+	case *eventsapi.TaskPaused:
+		return runtime.TaskPausedEventTopic, e.ContainerID, true
+	case *eventsapi.TaskResumed:
+		return runtime.TaskResumedEventTopic, e.ContainerID, true
+	case *eventsapi.TaskCheckpointed:
+		return runtime.TaskCheckpointedEventTopic, e.ContainerID, true
+	}
+	return "", "", false
+}
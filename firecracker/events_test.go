@@ -0,0 +1,105 @@
+// +build linux
+
+package firecracker
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/containerd/typeurl"
+	"google.golang.org/grpc"
+)
+
+type recordedEvent struct {
+	namespace string
+	topic     string
+}
+
+type recorder struct {
+	events []recordedEvent
+}
+
+func (r *recorder) Publish(ctx context.Context, topic string, event events.Event) error {
+	ns, _ := namespaces.Namespace(ctx)
+	r.events = append(r.events, recordedEvent{namespace: ns, topic: topic})
+	return nil
+}
+
+func TestAgentEventsPublish(t *testing.T) {
+	var (
+		rec = &recorder{}
+		a   = &agentEvents{namespace: "tenant", id: "web", publisher: rec}
+		ctx = namespaces.WithNamespace(context.Background(), "other")
+	)
+	for _, tc := range []struct {
+		name  string
+		topic string
+		event interface{}
+		err   bool
+	}{
+		{"task exit", runtime.TaskExitEventTopic, &eventsapi.TaskExit{ContainerID: "web"}, false},
+		{"other container", runtime.TaskExitEventTopic, &eventsapi.TaskExit{ContainerID: "db"}, true},
+		{"mismatched topic", runtime.TaskDeleteEventTopic, &eventsapi.TaskExit{ContainerID: "web"}, true},
+		{"not a task event", "/containers/delete", &eventsapi.ContainerDelete{ID: "web"}, true},
+	} {
+		any, err := typeurl.MarshalAny(tc.event)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = a.Publish(ctx, &eventsapi.PublishRequest{Topic: tc.topic, Event: any})
+		if tc.err != (err != nil) {
+			t.Errorf("%s: unexpected error %v", tc.name, err)
+		}
+	}
+	if len(rec.events) != 1 || rec.events[0] != (recordedEvent{namespace: "tenant", topic: runtime.TaskExitEventTopic}) {
+		t.Fatalf("expected a single exit in the namespace of the task, got %v", rec.events)
+	}
+}
+
+func TestAgentEventsOnlyPublish(t *testing.T) {
+	dir, err := ioutil.TempDir("", "firecracker-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "events.sock")
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	server.RegisterService(&agentEventsDesc, &agentEvents{namespace: "tenant", id: "web", publisher: &recorder{}})
+	go server.Serve(l)
+	defer server.Stop()
+
+	conn, err := grpc.Dial(path, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(5*time.Second),
+		grpc.WithDialer(func(address string, timeout time.Duration) (net.Conn, error) {
+			return net.DialTimeout("unix", address, timeout)
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := eventsapi.NewEventsClient(conn)
+	ctx := context.Background()
+	if _, err := client.Forward(ctx, &eventsapi.ForwardRequest{Envelope: &eventsapi.Envelope{}}); !errdefs.IsNotImplemented(errdefs.FromGRPC(err)) {
+		t.Errorf("expected forward to be unimplemented, got %v", err)
+	}
+	stream, err := client.Subscribe(ctx, &eventsapi.SubscribeRequest{})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if !errdefs.IsNotImplemented(errdefs.FromGRPC(err)) {
+		t.Errorf("expected subscribe to be unimplemented, got %v", err)
+	}
+}
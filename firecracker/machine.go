@@ -0,0 +1,325 @@
+// +build linux
+
+package firecracker
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/reaper"
+	"github.com/pkg/errors"
+)
+
+const (
+	// guestCID is the vsock context id of the guest
+	guestCID = 3
+	// pidFile holds the pid of the VMM in the bundle, so that it can be
+	// killed when the daemon restarts
+	pidFile = "vmm.pid"
+	// apiSocket, vsockSocket and the drives are relative to the root of
+	// the VMM, which is the chroot of a jailed VMM
+	apiSocket   = "api.sock"
+	vsockSocket = "v.sock"
+	kernelFile  = "vmlinux"
+	imageFile   = "image.ext4"
+	bundleFile  = "bundle.ext4"
+	// blockFile is the block device or image of a block rootfs in the root
+	// of a jailed VMM
+	blockFile = "rootfs.block"
+)
+
+// drive is a block device or image attached to the VM after its root and
+// bundle drives
+type drive struct {
+	id       string
+	path     string
+	readOnly bool
+}
+
+// machine is a Firecracker microVM running a single task
+type machine struct {
+	id     string
+	bundle string
+	config *Config
+	// root is the directory holding the files of the VMM, the bundle or
+	// the chroot of a jailed VMM
+	root string
+	cmd  *exec.Cmd
+	// drives are attached to the VM in order, from /dev/vdc in the guest
+	drives []drive
+
+	mu       sync.Mutex
+	nextPort uint32
+	closers  []io.Closer
+}
+
+func newMachine(namespace, id, bundle string, config *Config) *machine {
+	m := &machine{
+		// VM ids are limited to 64 alphanumeric characters and hyphens
+		id:       fmt.Sprintf("%x", sha256.Sum256([]byte(namespace+"/"+id)))[:32],
+		bundle:   bundle,
+		config:   config,
+		root:     bundle,
+		nextPort: config.AgentPort + 2,
+	}
+	if config.Jailer != "" {
+		m.root = filepath.Join(config.ChrootBase, filepath.Base(config.Firecracker), m.id, "root")
+	}
+	return m
+}
+
+// path returns the host path of a file relative to the root of the VMM
+func (m *machine) path(name string) string {
+	return filepath.Join(m.root, name)
+}
+
+// start boots the VM with the image of the bundle as its second drive
+func (m *machine) start(ctx context.Context) error {
+	if err := os.MkdirAll(m.root, 0700); err != nil {
+		return err
+	}
+	files := map[string]string{
+		m.config.Kernel: kernelFile,
+		m.config.Image:  imageFile,
+	}
+	if m.root != m.bundle {
+		files[filepath.Join(m.bundle, bundleFile)] = bundleFile
+	}
+	for src, dst := range files {
+		if err := linkFile(src, m.path(dst)); err != nil {
+			return err
+		}
+	}
+	if m.root != m.bundle {
+		// block devices and images are linked into the chroot, never
+		// copied
+		for i, d := range m.drives {
+			name := fmt.Sprintf("%s.%d", blockFile, i)
+			if err := os.Link(d.path, m.path(name)); err != nil {
+				return errors.Wrapf(err, "block rootfs %s must be on the filesystem of the jailer chroot", d.path)
+			}
+			m.drives[i].path = "/" + name
+		}
+	}
+	if m.config.Jailer != "" {
+		m.cmd = exec.Command(m.config.Jailer,
+			"--id", m.id,
+			"--exec-file", m.config.Firecracker,
+			"--uid", strconv.Itoa(m.config.JailerUID),
+			"--gid", strconv.Itoa(m.config.JailerGID),
+			"--chroot-base-dir", m.config.ChrootBase,
+			"--", "--api-sock", "/"+apiSocket,
+		)
+		// the kernel and guest image are shared read-only, only the bundle
+		// image is written by the VMM
+		for _, p := range []string{m.root, m.path(bundleFile)} {
+			if err := os.Chown(p, m.config.JailerUID, m.config.JailerGID); err != nil {
+				return err
+			}
+		}
+	} else {
+		m.cmd = exec.Command(m.config.Firecracker, "--api-sock", m.path(apiSocket))
+	}
+	m.cmd.Dir = m.root
+	// the VMM is killed if the daemon dies, the jailer clears this when it
+	// drops its privileges so the pid is also recorded for the next daemon
+	m.cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setpgid:   true,
+		Pdeathsig: syscall.SIGKILL,
+	}
+	if err := reaper.Default.Start(m.cmd); err != nil {
+		return errors.Wrap(err, "failed to start firecracker")
+	}
+	go func() {
+		reaper.Default.Wait(m.cmd)
+		reaper.Default.Delete(m.cmd.Process.Pid)
+	}()
+	if err := ioutil.WriteFile(filepath.Join(m.bundle, pidFile), []byte(strconv.Itoa(m.cmd.Process.Pid)), 0600); err != nil {
+		return err
+	}
+	return m.configure(ctx)
+}
+
+// configure sets up the VM through the API of the VMM and boots it
+func (m *machine) configure(ctx context.Context) error {
+	api := m.path(apiSocket)
+	if err := waitFor(ctx, api, 5*time.Second); err != nil {
+		return errors.Wrap(err, "firecracker API socket not created")
+	}
+	client := &http.Client{
+		Transport: &http.Transport{
+			Dial: func(_, _ string) (net.Conn, error) {
+				return net.Dial("unix", api)
+			},
+		},
+	}
+	// the paths are seen by the VMM, relative to its chroot when jailed
+	root := m.root
+	if m.config.Jailer != "" {
+		root = "/"
+	}
+	type request struct {
+		path string
+		body interface{}
+	}
+	requests := []request{
+		{"/machine-config", map[string]interface{}{
+			"vcpu_count":   m.config.VCPUs,
+			"mem_size_mib": m.config.MemoryMB,
+		}},
+		{"/boot-source", map[string]interface{}{
+			"kernel_image_path": filepath.Join(root, kernelFile),
+			"boot_args":         m.config.KernelArgs,
+		}},
+		{"/drives/rootfs", map[string]interface{}{
+			"drive_id":       "rootfs",
+			"path_on_host":   filepath.Join(root, imageFile),
+			"is_root_device": true,
+			"is_read_only":   true,
+		}},
+		{"/drives/bundle", map[string]interface{}{
+			"drive_id":       "bundle",
+			"path_on_host":   filepath.Join(root, bundleFile),
+			"is_root_device": false,
+			"is_read_only":   false,
+		}},
+	}
+	for _, d := range m.drives {
+		requests = append(requests, request{"/drives/" + d.id, map[string]interface{}{
+			"drive_id":       d.id,
+			"path_on_host":   d.path,
+			"is_root_device": false,
+			"is_read_only":   d.readOnly,
+		}})
+	}
+	requests = append(requests,
+		request{"/vsock", map[string]interface{}{
+			"guest_cid": guestCID,
+			"uds_path":  filepath.Join(root, vsockSocket),
+		}},
+		request{"/actions", map[string]interface{}{
+			"action_type": "InstanceStart",
+		}},
+	)
+	for _, r := range requests {
+		if err := put(ctx, client, r.path, r.body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stop kills the VMM and removes its files
+func (m *machine) stop(ctx context.Context) {
+	m.mu.Lock()
+	for _, c := range m.closers {
+		c.Close()
+	}
+	m.closers = nil
+	m.mu.Unlock()
+	if m.cmd != nil && m.cmd.Process != nil {
+		// the jailer execs the VMM, which runs in the process group
+		syscall.Kill(-m.cmd.Process.Pid, syscall.SIGKILL)
+	}
+	if m.config.Jailer != "" {
+		if err := os.RemoveAll(filepath.Dir(m.root)); err != nil {
+			log.G(ctx).WithError(err).WithField("vm", m.id).Warn("failed to remove jailer chroot")
+		}
+	}
+}
+
+// allocPort returns an unused vsock port for a connection from the guest
+func (m *machine) allocPort() uint32 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	port := m.nextPort
+	m.nextPort++
+	return port
+}
+
+// track closes c when the VM is stopped
+func (m *machine) track(c io.Closer) {
+	m.mu.Lock()
+	m.closers = append(m.closers, c)
+	m.mu.Unlock()
+}
+
+func put(ctx context.Context, client *http.Client, path string, body interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPut, "http://localhost"+path, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrapf(err, "firecracker PUT %s", path)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := ioutil.ReadAll(resp.Body)
+		return errors.Errorf("firecracker PUT %s: %s: %s", path, resp.Status, bytes.TrimSpace(msg))
+	}
+	return nil
+}
+
+// waitFor waits for the file at path to be created
+func waitFor(ctx context.Context, path string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		_, err := os.Stat(path)
+		if err == nil || !os.IsNotExist(err) || time.Now().After(deadline) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// linkFile hard links src to dst, copying it when they are on different
+// filesystems
+func linkFile(src, dst string) error {
+	if err := os.Link(src, dst); err == nil || !isCrossDevice(err) {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func isCrossDevice(err error) bool {
+	le, ok := err.(*os.LinkError)
+	return ok && le.Err == syscall.EXDEV
+}
@@ -0,0 +1,391 @@
+// +build linux
+
+package firecracker
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/identifiers"
+	"github.com/containerd/containerd/linux"
+	client "github.com/containerd/containerd/linux/shim"
+	shim "github.com/containerd/containerd/linux/shim/v1"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/runtime"
+	"github.com/pkg/errors"
+)
+
+const (
+	runtimeName = "firecracker"
+	// guestBundle is the device of the bundle image in the guest, passed to
+	// the agent as the bundle of the task
+	guestBundle = "/dev/vdb"
+	// guestBlock is the device of a block rootfs in the guest, mounted by
+	// the agent as the rootfs of the task
+	guestBlock = "/dev/vdc"
+)
+
+var (
+	pluginID = fmt.Sprintf("%s.%s", plugin.RuntimePlugin, runtimeName)
+)
+
+var _ = (runtime.Runtime)(&Runtime{})
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.RuntimePlugin,
+		ID:   runtimeName,
+		Init: New,
+		Config: &Config{
+			Firecracker: "firecracker",
+			ChrootBase:  "/srv/jailer",
+			KernelArgs:  "console=ttyS0 reboot=k panic=1 pci=off",
+			AgentPort:   1024,
+			VCPUs:       1,
+			MemoryMB:    128,
+			BootTimeout: "10s",
+		},
+	})
+}
+
+// Config for the firecracker runtime
+type Config struct {
+	// Firecracker is the name or path of the firecracker binary
+	Firecracker string `toml:"firecracker"`
+	// Jailer is the name or path of the jailer binary sandboxing the VMM,
+	// the VMM is not jailed when empty
+	Jailer string `toml:"jailer"`
+	// JailerUID and JailerGID are the ids the jailed VMM runs as
+	JailerUID int `toml:"jailer_uid"`
+	JailerGID int `toml:"jailer_gid"`
+	// ChrootBase is the directory in which the jailer creates the chroot of
+	// each VMM
+	ChrootBase string `toml:"chroot_base"`
+	// Kernel is the path of the uncompressed guest kernel
+	Kernel string `toml:"kernel"`
+	// KernelArgs are the boot arguments of the guest kernel
+	KernelArgs string `toml:"kernel_args"`
+	// Image is the path of the root filesystem image of the guest, which
+	// runs the agent serving the shim API, such as containerd-shim serving
+	// on vsock. The tasks cannot be created without it.
+	Image string `toml:"image"`
+	// AgentPort is the vsock port the agent listens on. The agent publishes
+	// events to the next port.
+	AgentPort uint32 `toml:"agent_port"`
+	// VCPUs and MemoryMB size the VM of each task
+	VCPUs    int `toml:"vcpus"`
+	MemoryMB int `toml:"memory_mb"`
+	// BootTimeout is how long to wait for the agent once the VM is started
+	BootTimeout string `toml:"boot_timeout"`
+}
+
+// New returns a runtime running each task in a Firecracker microVM
+func New(ic *plugin.InitContext) (interface{}, error) {
+	cfg := ic.Config.(*Config)
+	// the runtime is only loaded on the hosts it is set up on, with a guest
+	// kernel and image configured and firecracker installed
+	if cfg.Kernel == "" || cfg.Image == "" {
+		log.G(ic.Context).Debug("no guest kernel and image configured for the firecracker runtime")
+		return nil, plugin.SkipPlugin
+	}
+	timeout, err := time.ParseDuration(cfg.BootTimeout)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid boot timeout %q", cfg.BootTimeout)
+	}
+	firecracker, err := exec.LookPath(cfg.Firecracker)
+	if err != nil {
+		log.G(ic.Context).WithError(err).Debug("firecracker not found")
+		return nil, plugin.SkipPlugin
+	}
+	cfg.Firecracker = firecracker
+	if _, err := exec.LookPath("mkfs.ext4"); err != nil {
+		log.G(ic.Context).WithError(err).Debug("mkfs.ext4 is required to build the bundle images")
+		return nil, plugin.SkipPlugin
+	}
+	if cfg.Jailer != "" {
+		if cfg.Jailer, err = exec.LookPath(cfg.Jailer); err != nil {
+			log.G(ic.Context).WithError(err).Debug("jailer not found")
+			return nil, plugin.SkipPlugin
+		}
+	}
+	if err := os.MkdirAll(ic.Root, 0700); err != nil {
+		return nil, errors.Wrapf(err, "could not create state directory at %s", ic.Root)
+	}
+	r := &Runtime{
+		root:    ic.Root,
+		config:  cfg,
+		timeout: timeout,
+		events:  ic.Events,
+		tasks:   runtime.NewTaskList(),
+	}
+	// VMs do not survive the daemon, only their bundles remain
+	r.cleanup(ic.Context)
+	return r, nil
+}
+
+// Runtime runs the tasks of containers in Firecracker microVMs. The bundle of
+// the task is built into an image attached to the VM, in which an agent
+// serving the shim API over vsock creates the task.
+type Runtime struct {
+	root    string
+	config  *Config
+	timeout time.Duration
+	events  *events.Exchange
+	tasks   *runtime.TaskList
+}
+
+// ID of the runtime
+func (r *Runtime) ID() string {
+	return pluginID
+}
+
+// Create boots a VM for the task and creates the task in it
+func (r *Runtime) Create(ctx context.Context, id string, opts runtime.CreateOpts) (_ runtime.Task, err error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := identifiers.Validate(id); err != nil {
+		return nil, errors.Wrapf(err, "invalid task id")
+	}
+	if opts.Checkpoint != "" {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, "firecracker tasks cannot be restored from a checkpoint")
+	}
+	block, err := blockRootfs(opts.Rootfs)
+	if err != nil {
+		return nil, err
+	}
+	bundle := filepath.Join(r.root, namespace, id)
+	if err := os.MkdirAll(filepath.Dir(bundle), 0700); err != nil {
+		return nil, err
+	}
+	if err := os.Mkdir(bundle, 0700); err != nil {
+		if os.IsExist(err) {
+			return nil, errors.Wrapf(errdefs.ErrAlreadyExists, "task %s", id)
+		}
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			removeBundle(ctx, bundle)
+		}
+	}()
+	if block != nil {
+		// the rootfs is attached as a drive rather than copied
+		opts.Rootfs = nil
+	}
+	if err := buildImage(bundle, opts); err != nil {
+		return nil, errors.Wrap(err, "failed to build bundle image")
+	}
+	m := newMachine(namespace, id, bundle, r.config)
+	var rootfs []*types.Mount
+	if block != nil {
+		m.drives = append(m.drives, drive{id: "container", path: block.Source, readOnly: hasOption(block.Options, "ro")})
+		fstype, _ := block.Option("fstype")
+		var options []string
+		for _, o := range block.Options {
+			if !strings.HasPrefix(o, "fstype=") {
+				options = append(options, o)
+			}
+		}
+		rootfs = append(rootfs, &types.Mount{Type: fstype, Source: guestBlock, Options: options})
+	}
+	defer func() {
+		if err != nil {
+			m.stop(ctx)
+		}
+	}()
+	if err := m.serveEvents(namespace, id, r.config.AgentPort+1, r.events); err != nil {
+		return nil, err
+	}
+	if err := m.start(ctx); err != nil {
+		return nil, err
+	}
+	s, err := client.New(ctx, client.Config{Address: m.path(vsockSocket)}, client.WithDialer(m.dialer(r.config.AgentPort, r.timeout)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to the agent")
+	}
+	m.track(s)
+	stdio, err := m.forwardIO(ctx, opts.IO)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.Create(ctx, &shim.CreateTaskRequest{
+		ID:       id,
+		Bundle:   guestBundle,
+		Stdin:    stdio.Stdin,
+		Stdout:   stdio.Stdout,
+		Stderr:   stdio.Stderr,
+		Terminal: stdio.Terminal,
+		Rootfs:   rootfs,
+		Options:  opts.Options,
+	}); err != nil {
+		return nil, errdefs.FromGRPC(err)
+	}
+	t := &Task{
+		Task: linux.NewTask(id, namespace, bundle, pluginID, s, opts),
+		vm:   m,
+	}
+	if err := r.tasks.Add(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Get returns the task of the container
+func (r *Runtime) Get(ctx context.Context, id string) (runtime.Task, error) {
+	return r.tasks.Get(ctx, id)
+}
+
+// Tasks returns the tasks of the namespace
+func (r *Runtime) Tasks(ctx context.Context) ([]runtime.Task, error) {
+	return r.tasks.GetAll(ctx)
+}
+
+// Delete deletes the task and stops its VM
+func (r *Runtime) Delete(ctx context.Context, rt runtime.Task) (*runtime.Exit, error) {
+	t, ok := rt.(*Task)
+	if !ok {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, "not a firecracker task")
+	}
+	exit, err := t.Task.Delete(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t.vm.stop(ctx)
+	r.tasks.Delete(ctx, t)
+	removeBundle(ctx, t.vm.bundle)
+	return exit, nil
+}
+
+// cleanup kills the VMMs left running by a previous daemon and removes their
+// bundles
+func (r *Runtime) cleanup(ctx context.Context) {
+	nss, err := ioutil.ReadDir(r.root)
+	if err != nil {
+		log.G(ctx).WithError(err).Warn("failed to read firecracker state directory")
+		return
+	}
+	for _, ns := range nss {
+		if !ns.IsDir() {
+			continue
+		}
+		bundles, err := ioutil.ReadDir(filepath.Join(r.root, ns.Name()))
+		if err != nil {
+			log.G(ctx).WithError(err).WithField("namespace", ns.Name()).Warn("failed to read firecracker bundles")
+			continue
+		}
+		for _, b := range bundles {
+			bundle := filepath.Join(r.root, ns.Name(), b.Name())
+			if data, err := ioutil.ReadFile(filepath.Join(bundle, pidFile)); err == nil {
+				if pid, err := strconv.Atoi(string(data)); err == nil && pid > 0 {
+					syscall.Kill(-pid, syscall.SIGKILL)
+				}
+			}
+			newMachine(ns.Name(), b.Name(), bundle, r.config).stop(ctx)
+			removeBundle(ctx, bundle)
+		}
+	}
+}
+
+// blockRootfs returns the block device or image of the rootfs, nil when the
+// rootfs is mounted on the host and built into the bundle image
+func blockRootfs(mounts []mount.Mount) (*mount.Mount, error) {
+	for i, m := range mounts {
+		switch m.Type {
+		case mount.TypeVirtiofs:
+			return nil, errors.Wrap(errdefs.ErrNotImplemented, "firecracker does not support virtiofs mounts")
+		case mount.TypeBlock:
+			if len(mounts) != 1 {
+				return nil, errors.Wrap(errdefs.ErrInvalidArgument, "a block rootfs must be the only mount of the rootfs")
+			}
+			if _, ok := m.Option("fstype"); !ok {
+				return nil, errors.Wrap(errdefs.ErrInvalidArgument, "a block rootfs requires a fstype= option")
+			}
+			return &mounts[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func hasOption(options []string, option string) bool {
+	for _, o := range options {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+// removeBundle removes the bundle unless the rootfs of its image is still
+// mounted
+func removeBundle(ctx context.Context, bundle string) {
+	if err := mount.UnmountAll(filepath.Join(bundle, "image", "rootfs"), 0); err != nil && !os.IsNotExist(err) {
+		// never remove the files of a rootfs that is still mounted
+		log.G(ctx).WithError(err).WithField("bundle", bundle).Warn("failed to unmount rootfs")
+		return
+	}
+	if err := os.RemoveAll(bundle); err != nil {
+		log.G(ctx).WithError(err).WithField("bundle", bundle).Warn("failed to remove bundle")
+	}
+}
+
+// buildImage builds the ext4 image attached to the VM from the spec and the
+// rootfs of the task, laid out as an OCI bundle. Writes of the task go to the
+// image and are not reflected in the snapshot of the rootfs.
+func buildImage(bundle string, opts runtime.CreateOpts) error {
+	dir := filepath.Join(bundle, "image")
+	rootfs := filepath.Join(dir, "rootfs")
+	if err := os.MkdirAll(rootfs, 0700); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "config.json"), opts.Spec.Value, 0600); err != nil {
+		return err
+	}
+	if err := mount.MountAll(opts.Rootfs, rootfs); err != nil {
+		return err
+	}
+	size, err := dirSize(dir)
+	if err == nil {
+		// leave room for the filesystem metadata and the writes of the task
+		sizeMB := size/(1<<20)*2 + 64
+		var out []byte
+		if out, err = exec.Command("mkfs.ext4", "-q", "-F", "-d", dir,
+			filepath.Join(bundle, bundleFile), fmt.Sprintf("%dM", sizeMB)).CombinedOutput(); err != nil {
+			err = errors.Wrapf(err, "mkfs.ext4: %s", out)
+		}
+	}
+	if uerr := mount.UnmountAll(rootfs, 0); uerr != nil {
+		return uerr
+	}
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(dir)
+}
+
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.Walk(dir, func(_ string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		size += fi.Size()
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,36 @@
+// +build linux
+
+package firecracker
+
+import (
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/mount"
+)
+
+func TestBlockRootfs(t *testing.T) {
+	block := mount.Mount{Type: mount.TypeBlock, Source: "/dev/sdb", Options: []string{"fstype=ext4", "ro"}}
+	m, err := blockRootfs([]mount.Mount{block})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m == nil || m.Source != "/dev/sdb" {
+		t.Fatalf("unexpected block rootfs %v", m)
+	}
+	if m, err := blockRootfs([]mount.Mount{{Type: "overlay", Source: "overlay"}}); err != nil || m != nil {
+		t.Fatalf("unexpected block rootfs %v for an overlay (%v)", m, err)
+	}
+	for name, tc := range map[string]struct {
+		mounts []mount.Mount
+		check  func(error) bool
+	}{
+		"virtiofs":       {[]mount.Mount{{Type: mount.TypeVirtiofs, Source: "/srv/share", Options: []string{"tag=rootfs"}}}, errdefs.IsNotImplemented},
+		"without fstype": {[]mount.Mount{{Type: mount.TypeBlock, Source: "/dev/sdb"}}, errdefs.IsInvalidArgument},
+		"with layers":    {[]mount.Mount{block, {Type: "bind", Source: "/tmp"}}, errdefs.IsInvalidArgument},
+	} {
+		if _, err := blockRootfs(tc.mounts); !tc.check(err) {
+			t.Errorf("%s: unexpected error %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+// +build linux
+
+package firecracker
+
+import (
+	"context"
+
+	"github.com/containerd/containerd/linux"
+	"github.com/containerd/containerd/runtime"
+)
+
+// Task is a task running in a microVM, driven through the shim API of the
+// agent in the VM
+type Task struct {
+	*linux.Task
+	vm *machine
+}
+
+// Exec adds a process to the task, with its stdio forwarded over vsock
+func (t *Task) Exec(ctx context.Context, id string, opts runtime.ExecOpts) (runtime.Process, error) {
+	stdio, err := t.vm.forwardIO(ctx, opts.IO)
+	if err != nil {
+		return nil, err
+	}
+	opts.IO = stdio
+	return t.Task.Exec(ctx, id, opts)
+}
+
+// KillShim stops the VM of the task without waiting for the task to exit
+func (t *Task) KillShim(ctx context.Context) error {
+	t.vm.stop(ctx)
+	return nil
+}
@@ -0,0 +1,166 @@
+// +build linux
+
+package firecracker
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/fifo"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// vsockScheme prefixes the stdio of processes in the guest, which connect to
+// the host on the vsock port following it
+const vsockScheme = "vsock://"
+
+// dialer returns a dialer connecting to the port of the guest through the
+// vsock socket of the VMM. Connections are retried until the guest listens on
+// the port or the timeout expires, while the VM boots.
+func (m *machine) dialer(port uint32, timeout time.Duration) func(string, time.Duration) (net.Conn, error) {
+	return func(string, time.Duration) (net.Conn, error) {
+		deadline := time.Now().Add(timeout)
+		for {
+			conn, err := dialVsock(m.path(vsockSocket), port)
+			if err == nil || time.Now().After(deadline) {
+				return conn, err
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+	}
+}
+
+// dialVsock connects to the port of the guest with the handshake of the
+// vsock socket of Firecracker
+func dialVsock(path string, port uint32) (net.Conn, error) {
+	conn, err := net.DialTimeout("unix", path, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetDeadline(time.Now().Add(time.Second))
+	if _, err := fmt.Fprintf(conn, "CONNECT %d\n", port); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	// read the response byte by byte so that nothing the guest sends after
+	// it is buffered
+	var line []byte
+	b := make([]byte, 1)
+	for {
+		if _, err := conn.Read(b); err != nil {
+			conn.Close()
+			return nil, errors.Wrapf(err, "vsock handshake on port %d", port)
+		}
+		if b[0] == '\n' {
+			break
+		}
+		line = append(line, b[0])
+	}
+	if !strings.HasPrefix(string(line), "OK ") {
+		conn.Close()
+		return nil, errors.Errorf("vsock handshake on port %d: %s", port, line)
+	}
+	conn.SetDeadline(time.Time{})
+	return conn, nil
+}
+
+// listen listens for the connections of the guest to the port of the host
+func (m *machine) listen(port uint32) (net.Listener, error) {
+	l, err := net.Listen("unix", fmt.Sprintf("%s_%d", m.path(vsockSocket), port))
+	if err != nil {
+		return nil, err
+	}
+	m.track(l)
+	return l, nil
+}
+
+// serveEvents publishes the task events the agent sends on the port to the
+// exchange, in the namespace of the task. Only the Publish call of the events
+// service is served, for the events of the task, as the guest is not trusted.
+func (m *machine) serveEvents(namespace, id string, port uint32, exchange *events.Exchange) error {
+	l, err := m.listen(port)
+	if err != nil {
+		return err
+	}
+	server := grpc.NewServer()
+	server.RegisterService(&agentEventsDesc, &agentEvents{
+		namespace: namespace,
+		id:        id,
+		publisher: exchange,
+	})
+	go server.Serve(l)
+	return nil
+}
+
+// forwardIO returns the stdio of a process in the guest, connected to the
+// fifos of the client through vsock ports
+func (m *machine) forwardIO(ctx context.Context, stdio runtime.IO) (runtime.IO, error) {
+	out := runtime.IO{Terminal: stdio.Terminal}
+	for _, s := range []struct {
+		fifo  string
+		guest *string
+		read  bool
+	}{
+		{stdio.Stdin, &out.Stdin, true},
+		{stdio.Stdout, &out.Stdout, false},
+		{stdio.Stderr, &out.Stderr, false},
+	} {
+		if s.fifo == "" {
+			continue
+		}
+		port := m.allocPort()
+		l, err := m.listen(port)
+		if err != nil {
+			return runtime.IO{}, err
+		}
+		flag := syscall.O_WRONLY
+		if s.read {
+			flag = syscall.O_RDONLY
+		}
+		// the fifo is opened in the background as the client may not have
+		// opened its end yet
+		f, err := fifo.OpenFifo(context.Background(), s.fifo, flag|syscall.O_NONBLOCK, 0)
+		if err != nil {
+			l.Close()
+			return runtime.IO{}, err
+		}
+		m.track(f)
+		go m.copyIO(ctx, l, f, s.read)
+		*s.guest = fmt.Sprintf("%s%d", vsockScheme, port)
+	}
+	return out, nil
+}
+
+// copyIO copies between the fifo and the first connection of the guest on
+// the listener
+func (m *machine) copyIO(ctx context.Context, l net.Listener, f io.ReadWriteCloser, stdin bool) {
+	conn, err := l.Accept()
+	l.Close()
+	if err != nil {
+		f.Close()
+		return
+	}
+	if stdin {
+		io.Copy(conn, bufio.NewReader(f))
+		// let the guest see the end of stdin
+		if c, ok := conn.(*net.UnixConn); ok {
+			c.CloseWrite()
+		}
+		return
+	}
+	if _, err := io.Copy(f, conn); err != nil {
+		log.G(ctx).WithError(err).Debug("vsock stdio copy")
+	}
+	conn.Close()
+	f.Close()
+}
@@ -0,0 +1,58 @@
+// +build linux
+
+package firecracker
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDialVsock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "firecracker-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, vsockSocket)
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	// emulate the vsock socket of the VMM, the guest listens on port 1024
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			line, _ := bufio.NewReader(conn).ReadString('\n')
+			if line != "CONNECT 1024\n" {
+				conn.Close()
+				continue
+			}
+			conn.Write([]byte("OK 1073741824\nhello"))
+			conn.Close()
+		}
+	}()
+
+	conn, err := dialVsock(path, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadAll(conn)
+	conn.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "hello" {
+		t.Fatalf("expected the data following the handshake, got %q", data)
+	}
+	if _, err := dialVsock(path, 1025); err == nil {
+		t.Fatal("expected a connection to a port the guest does not listen on to fail")
+	}
+}
@@ -0,0 +1,24 @@
+// Package quota limits the disk space used by directories with the project
+// quotas of the backing filesystem, such as xfs or ext4 mounted with the
+// prjquota option.
+//
+// Each directory given a quota is assigned its own project, inherited by the
+// files and directories created in it, so that the space they use is both
+// accounted and limited by the filesystem.
+package quota
+
+import "errors"
+
+// ErrNotSupported is returned when the backing filesystem does not support
+// project quotas, or they are not enabled
+var ErrNotSupported = errors.New("project quotas are not supported by the backing filesystem")
+
+// Usage of a directory, as accounted by its project quota
+type Usage struct {
+	// Size is the number of bytes used in the directory
+	Size int64
+	// Inodes is the number of inodes used in the directory
+	Inodes int64
+	// Limit is the number of bytes the directory may use, 0 when unlimited
+	Limit int64
+}
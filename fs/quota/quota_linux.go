@@ -0,0 +1,223 @@
+// +build linux
+
+package quota
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// ioctls getting and setting the extended attributes of an inode,
+	// _IOR('X', 31, struct fsxattr) and _IOW('X', 32, struct fsxattr)
+	fsIOCFSGetXAttr = 0x801c581f
+	fsIOCFSSetXAttr = 0x401c5820
+	// fsXFlagProjInherit has the files created in a directory inherit its
+	// project
+	fsXFlagProjInherit = 0x200
+
+	// quotactl commands on project quotas, QCMD(Q_GETQUOTA, PRJQUOTA) and
+	// QCMD(Q_SETQUOTA, PRJQUOTA)
+	qGetProjectQuota = 0x800007<<8 | 2
+	qSetProjectQuota = 0x800008<<8 | 2
+	// qifBLimits marks the block limits of a dqblk as valid
+	qifBLimits = 1
+	// blockSize is the unit of the block limits of a dqblk
+	blockSize = 1024
+
+	// deviceName is the name of the block device node made for quotactl
+	deviceName = "backingFsBlockDev"
+)
+
+// fsxattr is struct fsxattr of linux/fs.h
+type fsxattr struct {
+	xflags     uint32
+	extsize    uint32
+	nextents   uint32
+	projid     uint32
+	cowextsize uint32
+	pad        [8]byte
+}
+
+// dqblk is struct if_dqblk of linux/quota.h
+type dqblk struct {
+	bhardlimit uint64
+	bsoftlimit uint64
+	curspace   uint64
+	ihardlimit uint64
+	isoftlimit uint64
+	curinodes  uint64
+	btime      uint64
+	itime      uint64
+	valid      uint32
+	_          uint32
+}
+
+// Control sets the project quotas of the directories under a base directory
+type Control struct {
+	mu     sync.Mutex
+	device string
+	baseID uint32
+	nextID uint32
+}
+
+// NewControl returns a Control for the directories directly under base,
+// failing with ErrNotSupported when the filesystem of base does not have
+// project quotas enabled.
+//
+// Directories keep their project across restarts, new projects are
+// numbered after the highest project found under base.
+func NewControl(base string) (*Control, error) {
+	baseID, err := getProjectID(base)
+	if err != nil {
+		return nil, err
+	}
+	var st syscall.Stat_t
+	if err := syscall.Stat(base, &st); err != nil {
+		return nil, err
+	}
+	device := filepath.Join(base, deviceName)
+	if err := os.Remove(device); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err := unix.Mknod(device, unix.S_IFBLK|0600, int(st.Dev)); err != nil {
+		return nil, errors.Wrap(err, "failed to make the block device node for quotas")
+	}
+	c := &Control{
+		device: device,
+		baseID: baseID,
+		nextID: baseID + 1,
+	}
+	// quotactl fails unless project quotas are enabled on the filesystem
+	var dq dqblk
+	if err := c.quotactl(qGetProjectQuota, baseID, &dq); err != nil {
+		os.Remove(device)
+		return nil, errors.Wrapf(ErrNotSupported, "%v", err)
+	}
+	dirs, err := ioutil.ReadDir(base)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+		id, err := getProjectID(filepath.Join(base, d.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if id >= c.nextID {
+			c.nextID = id + 1
+		}
+	}
+	return c, nil
+}
+
+// SetQuota limits the space used by the files of dir, which must be directly
+// under the base directory, to size bytes, or only accounts for it when size
+// is 0. Directories without a project of their own are assigned a new one.
+func (c *Control) SetQuota(dir string, size int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id, err := getProjectID(dir)
+	if err != nil {
+		return err
+	}
+	if id == c.baseID {
+		id = c.nextID
+		if err := setProjectID(dir, id); err != nil {
+			return err
+		}
+		c.nextID++
+	}
+	dq := dqblk{
+		bhardlimit: uint64(size) / blockSize,
+		bsoftlimit: uint64(size) / blockSize,
+		valid:      qifBLimits,
+	}
+	if size > 0 && dq.bhardlimit == 0 {
+		dq.bhardlimit, dq.bsoftlimit = 1, 1
+	}
+	if err := c.quotactl(qSetProjectQuota, id, &dq); err != nil {
+		return errors.Wrapf(err, "failed to set the quota of project %d of %s", id, dir)
+	}
+	return nil
+}
+
+// GetQuota returns the usage and limit of the project of dir, failing with
+// os.ErrNotExist when dir was not given a quota
+func (c *Control) GetQuota(dir string) (Usage, error) {
+	id, err := getProjectID(dir)
+	if err != nil {
+		return Usage{}, err
+	}
+	if id == c.baseID {
+		return Usage{}, errors.Wrapf(os.ErrNotExist, "no quota set on %s", dir)
+	}
+	var dq dqblk
+	if err := c.quotactl(qGetProjectQuota, id, &dq); err != nil {
+		return Usage{}, errors.Wrapf(err, "failed to get the quota of project %d of %s", id, dir)
+	}
+	return Usage{
+		Size:   int64(dq.curspace),
+		Inodes: int64(dq.curinodes),
+		Limit:  int64(dq.bhardlimit) * blockSize,
+	}, nil
+}
+
+func (c *Control) quotactl(cmd int, id uint32, dq *dqblk) error {
+	device, err := syscall.BytePtrFromString(c.device)
+	if err != nil {
+		return err
+	}
+	if _, _, errno := unix.Syscall6(unix.SYS_QUOTACTL, uintptr(cmd), uintptr(unsafe.Pointer(device)), uintptr(id), uintptr(unsafe.Pointer(dq)), 0, 0); errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+func getProjectID(path string) (uint32, error) {
+	attr, err := getXAttr(path)
+	if err != nil {
+		return 0, err
+	}
+	return attr.projid, nil
+}
+
+func setProjectID(path string, id uint32) error {
+	attr, err := getXAttr(path)
+	if err != nil {
+		return err
+	}
+	attr.projid = id
+	attr.xflags |= fsXFlagProjInherit
+	return fsxattrIoctl(path, fsIOCFSSetXAttr, &attr)
+}
+
+func getXAttr(path string) (fsxattr, error) {
+	var attr fsxattr
+	return attr, fsxattrIoctl(path, fsIOCFSGetXAttr, &attr)
+}
+
+func fsxattrIoctl(path string, req uintptr, attr *fsxattr) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), req, uintptr(unsafe.Pointer(attr))); errno != 0 {
+		if errno == unix.ENOTTY || errno == unix.EOPNOTSUPP || errno == unix.EINVAL {
+			return errors.Wrapf(ErrNotSupported, "%s: %v", path, errno)
+		}
+		return errors.Wrapf(errno, "failed to access the project of %s", path)
+	}
+	return nil
+}
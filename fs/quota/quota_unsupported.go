@@ -0,0 +1,22 @@
+// +build !linux
+
+package quota
+
+// Control sets the project quotas of the directories under a base directory
+type Control struct{}
+
+// NewControl fails with ErrNotSupported, project quotas are only supported
+// on Linux
+func NewControl(base string) (*Control, error) {
+	return nil, ErrNotSupported
+}
+
+// SetQuota fails with ErrNotSupported
+func (c *Control) SetQuota(dir string, size int64) error {
+	return ErrNotSupported
+}
+
+// GetQuota fails with ErrNotSupported
+func (c *Control) GetQuota(dir string) (Usage, error) {
+	return Usage{}, ErrNotSupported
+}
@@ -1,9 +1,12 @@
 package containerd
 
 import (
+	"time"
+
 	"github.com/containerd/containerd/namespaces"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
 type namespaceInterceptor struct {
@@ -33,3 +36,100 @@ func newNSInterceptors(ns string) (grpc.UnaryClientInterceptor, grpc.StreamClien
 	}
 	return grpc.UnaryClientInterceptor(ni.unary), grpc.StreamClientInterceptor(ni.stream)
 }
+
+// retryInterceptor retries the calls failing because the daemon is
+// unavailable, such as while it restarts, backing off exponentially between
+// the attempts
+type retryInterceptor struct {
+	attempts int
+	maxDelay time.Duration
+}
+
+// retryBaseDelay is the delay before the first retry, doubled for each
+// following retry up to the maximum delay
+const retryBaseDelay = 100 * time.Millisecond
+
+func (ri retryInterceptor) unary(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	for attempt := 0; ; attempt++ {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if !ri.retry(ctx, attempt, err) {
+			return err
+		}
+	}
+}
+
+// stream retries the establishment of the streams, the errors of streams
+// once established being left to the caller
+func (ri retryInterceptor) stream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	for attempt := 0; ; attempt++ {
+		s, err := streamer(ctx, desc, cc, method, opts...)
+		if !ri.retry(ctx, attempt, err) {
+			return s, err
+		}
+	}
+}
+
+// retry returns whether the call failing with err is to be retried, waiting
+// for the backoff of the attempt before returning
+func (ri retryInterceptor) retry(ctx context.Context, attempt int, err error) bool {
+	if err == nil || attempt >= ri.attempts || grpc.Code(err) != codes.Unavailable {
+		return false
+	}
+	t := time.NewTimer(ri.backoff(attempt))
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (ri retryInterceptor) backoff(attempt int) time.Duration {
+	delay := retryBaseDelay
+	for i := 0; i < attempt && delay < ri.maxDelay; i++ {
+		delay *= 2
+	}
+	if ri.maxDelay > 0 && delay > ri.maxDelay {
+		delay = ri.maxDelay
+	}
+	return delay
+}
+
+func newRetryInterceptors(attempts int, maxDelay time.Duration) (grpc.UnaryClientInterceptor, grpc.StreamClientInterceptor) {
+	ri := retryInterceptor{
+		attempts: attempts,
+		maxDelay: maxDelay,
+	}
+	return grpc.UnaryClientInterceptor(ri.unary), grpc.StreamClientInterceptor(ri.stream)
+}
+
+// chainUnaryInterceptors returns an interceptor calling the interceptors in
+// order, as a connection only takes a single one
+func chainUnaryInterceptors(interceptors []grpc.UnaryClientInterceptor) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		next := invoker
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				return interceptor(ctx, method, req, reply, cc, inner, opts...)
+			}
+		}
+		return next(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// chainStreamInterceptors returns an interceptor calling the interceptors in
+// order, as a connection only takes a single one
+func chainStreamInterceptors(interceptors []grpc.StreamClientInterceptor) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		next := streamer
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+				return interceptor(ctx, desc, cc, method, inner, opts...)
+			}
+		}
+		return next(ctx, desc, cc, method, opts...)
+	}
+}
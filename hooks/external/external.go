@@ -0,0 +1,259 @@
+// Package external implements a hook plugin invoking the hooks services
+// outside of containerd configured for it.
+package external
+
+import (
+	"encoding/json"
+	"net"
+	"os"
+	"time"
+
+	hooksapi "github.com/containerd/containerd/api/services/hooks/v1"
+	"github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/hooks"
+	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/typeurl"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type:   plugin.HookPlugin,
+		ID:     "external",
+		Config: &Config{},
+		Init:   New,
+	})
+}
+
+// Config of the external hooks
+type Config struct {
+	// Hooks are invoked in order
+	Hooks []HookConfig `toml:"hooks"`
+}
+
+// HookConfig is a hooks service outside of containerd
+type HookConfig struct {
+	// Address is the unix socket of a service implementing
+	// containerd.services.hooks.v1.Hook
+	Address string `toml:"address"`
+	// Points are the points of the lifecycle the hook is invoked at, all of
+	// them when empty
+	Points []string `toml:"points"`
+	// Timeout bounds each call to the hook, such as "2s"
+	Timeout string `toml:"timeout"`
+}
+
+// New returns the external hooks of the configuration. The plugin is skipped
+// when no hooks are configured.
+func New(ic *plugin.InitContext) (interface{}, error) {
+	config := ic.Config.(*Config)
+	if len(config.Hooks) == 0 {
+		return nil, plugin.SkipPlugin
+	}
+	var e External
+	for i, c := range config.Hooks {
+		h, err := newHook(c)
+		if err != nil {
+			return nil, errors.Wrapf(err, "hook %d", i)
+		}
+		e.hooks = append(e.hooks, h)
+	}
+	return &e, nil
+}
+
+// External invokes the hooks services of its configuration in order. The
+// hooks invoked at the pre-create point get the spec adjusted by the hooks
+// invoked before them.
+type External struct {
+	hooks []*hook
+}
+
+// Invoke invokes the hooks configured for the point, returning the
+// adjustments of all of them
+func (e *External) Invoke(ctx context.Context, r *hooks.Request) (*hooks.Adjustment, error) {
+	var (
+		out  hooks.Adjustment
+		spec = r.Spec
+	)
+	for _, h := range e.hooks {
+		if !h.points[r.Point] {
+			continue
+		}
+		req := *r
+		req.Spec = spec
+		a, err := h.invoke(ctx, &req)
+		if err != nil {
+			return nil, errors.Wrapf(err, "hook %s", h.address)
+		}
+		if a == nil {
+			continue
+		}
+		if r.Point == hooks.PreCreate && spec != nil {
+			if spec, err = copySpec(spec); err != nil {
+				return nil, err
+			}
+			if err := a.Apply(spec); err != nil {
+				return nil, err
+			}
+		}
+		out.Merge(a)
+	}
+	return &out, nil
+}
+
+// copySpec copies the spec so that the spec of the request is not modified
+func copySpec(s *specs.Spec) (*specs.Spec, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	var out specs.Spec
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type hook struct {
+	address string
+	points  map[hooks.Point]bool
+	timeout time.Duration
+	client  hooksapi.HookClient
+}
+
+func newHook(c HookConfig) (*hook, error) {
+	if c.Address == "" {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, "address is required")
+	}
+	h := &hook{
+		address: c.Address,
+		points:  make(map[hooks.Point]bool),
+	}
+	for _, p := range c.Points {
+		if !validPoint(hooks.Point(p)) {
+			return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "unknown point %q", p)
+		}
+		h.points[hooks.Point(p)] = true
+	}
+	if len(c.Points) == 0 {
+		for _, p := range hooks.Points {
+			h.points[p] = true
+		}
+	}
+	if c.Timeout != "" {
+		d, err := time.ParseDuration(c.Timeout)
+		if err != nil {
+			return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "timeout: %v", err)
+		}
+		h.timeout = d
+	}
+	conn, err := grpc.Dial(c.Address,
+		grpc.WithInsecure(),
+		grpc.WithDialer(func(address string, timeout time.Duration) (net.Conn, error) {
+			return net.DialTimeout("unix", address, timeout)
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	h.client = hooksapi.NewHookClient(conn)
+	return h, nil
+}
+
+func validPoint(p hooks.Point) bool {
+	for _, v := range hooks.Points {
+		if p == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *hook) invoke(ctx context.Context, r *hooks.Request) (*hooks.Adjustment, error) {
+	if h.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.timeout)
+		defer cancel()
+	}
+	req := &hooksapi.InvokeRequest{
+		Point:       string(r.Point),
+		ContainerID: r.ContainerID,
+		Runtime:     r.Runtime,
+		Labels:      r.Labels,
+		SandboxID:   r.SandboxID,
+		Pid:         r.Pid,
+		ExitStatus:  r.ExitStatus,
+	}
+	if r.Spec != nil {
+		spec, err := typeurl.MarshalAny(r.Spec)
+		if err != nil {
+			return nil, err
+		}
+		req.Spec = spec
+	}
+	for _, m := range r.Rootfs {
+		req.Rootfs = append(req.Rootfs, &types.Mount{
+			Type:    m.Type,
+			Source:  m.Source,
+			Options: m.Options,
+		})
+	}
+	resp, err := h.client.Invoke(ctx, req)
+	if err != nil {
+		return nil, errdefs.FromGRPC(err)
+	}
+	return adjustment(resp)
+}
+
+// adjustment returns the adjustment of the response of a hook
+func adjustment(resp *hooksapi.InvokeResponse) (*hooks.Adjustment, error) {
+	a := &hooks.Adjustment{
+		Env:         resp.Env,
+		CgroupsPath: resp.CgroupsPath,
+	}
+	for _, m := range resp.Mounts {
+		a.Mounts = append(a.Mounts, specs.Mount{
+			Destination: m.Destination,
+			Type:        m.Type,
+			Source:      m.Source,
+			Options:     m.Options,
+		})
+	}
+	for _, d := range resp.Devices {
+		var (
+			mode     = os.FileMode(d.FileMode)
+			uid, gid = d.Uid, d.Gid
+		)
+		a.Devices = append(a.Devices, specs.LinuxDevice{
+			Path:     d.Path,
+			Type:     d.Type,
+			Major:    d.Major,
+			Minor:    d.Minor,
+			FileMode: &mode,
+			UID:      &uid,
+			GID:      &gid,
+		})
+	}
+	for _, ns := range resp.Namespaces {
+		a.Namespaces = append(a.Namespaces, specs.LinuxNamespace{
+			Type: specs.LinuxNamespaceType(ns.Type),
+			Path: ns.Path,
+		})
+	}
+	if resp.Resources != nil {
+		v, err := typeurl.UnmarshalAny(resp.Resources)
+		if err != nil {
+			return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "resources: %v", err)
+		}
+		resources, ok := v.(*specs.LinuxResources)
+		if !ok {
+			return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "resources of type %s are not LinuxResources", resp.Resources.TypeUrl)
+		}
+		a.Resources = resources
+	}
+	return a, nil
+}
@@ -0,0 +1,208 @@
+// Package hooks defines the hooks invoked at the points of the lifecycle of
+// containers.
+//
+// Plugins of the HookPlugin type returning a Hook are invoked in the order
+// of their ids at each point. At the pre-create point they may adjust the
+// environment, mounts, devices, resources, namespaces and security profiles
+// of the container and at the pre-start point its resources, so that
+// devices can be injected and policies enforced without changing the
+// daemon. An error returned at these points vetoes the creation or start of
+// the container.
+package hooks
+
+import (
+	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/oci"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/net/context"
+)
+
+// Point is a point of the lifecycle of a container
+type Point string
+
+const (
+	// PreCreate is before the task of the container is created
+	PreCreate Point = "pre-create"
+	// PostCreate is after the task of the container is created
+	PostCreate Point = "post-create"
+	// PreStart is before the task of the container is started
+	PreStart Point = "pre-start"
+	// PostStop is after the task of the container exited
+	PostStop Point = "post-stop"
+	// PostDelete is after the task of the container is deleted
+	PostDelete Point = "post-delete"
+)
+
+// Points are the points of the lifecycle in their order
+var Points = []Point{PreCreate, PostCreate, PreStart, PostStop, PostDelete}
+
+// Hook is invoked at the points of the lifecycle of containers
+type Hook interface {
+	// Invoke returns the adjustment of the container at the point, nil when
+	// the container is not adjusted
+	Invoke(ctx context.Context, r *Request) (*Adjustment, error)
+}
+
+// Request is the container at a point of its lifecycle. The namespace of
+// the container is the namespace of the context.
+type Request struct {
+	Point       Point
+	ContainerID string
+	// Runtime is the name of the runtime of the container
+	Runtime string
+	// Labels are the labels of the container
+	Labels map[string]string
+	// SandboxID is the id of the sandbox of the container, if any
+	SandboxID string
+	// Spec is the spec of the container, including the adjustments of the
+	// hooks invoked before at the pre-create point. Hooks must not modify it.
+	Spec *specs.Spec
+	// Rootfs are the mounts of the rootfs of the container
+	Rootfs []mount.Mount
+	// Pid is the pid of the task, set from the post-create point
+	Pid uint32
+	// ExitStatus is the exit status of the task at the post-stop and
+	// post-delete points
+	ExitStatus uint32
+}
+
+// Adjustment adjusts a container. Only the resources are applied at the
+// pre-start point and the adjustments are ignored at the other points after
+// the creation.
+type Adjustment struct {
+	// Env is appended to the environment of the process, replacing the
+	// variables with the same keys
+	Env []string
+	// Mounts are appended to the mounts of the container, replacing the
+	// mounts with the same destinations
+	Mounts []specs.Mount
+	// Devices are created in the container, replacing the devices with the
+	// same paths, and allowed by its device cgroup
+	Devices []specs.LinuxDevice
+	// Resources whose fields are set replace those of the container
+	Resources *specs.LinuxResources
+	// Namespaces replace the namespaces of the same types of the container,
+	// such as a network namespace set up by the hook
+	Namespaces []specs.LinuxNamespace
+	// CgroupsPath replaces the cgroups path of the container when it is set
+	CgroupsPath string
+	// Seccomp replaces the seccomp profile of the container when it is set
+	Seccomp *specs.LinuxSeccomp
+	// ApparmorProfile replaces the AppArmor profile of the process of the
+	// container when it is set
+	ApparmorProfile string
+}
+
+// Apply adjusts the spec
+func (a *Adjustment) Apply(s *specs.Spec) error {
+	if len(a.Env) > 0 {
+		if s.Process == nil {
+			s.Process = &specs.Process{}
+		}
+		if err := oci.WithEnv(a.Env)(s); err != nil {
+			return err
+		}
+	}
+	if err := oci.WithMounts(a.Mounts)(s); err != nil {
+		return err
+	}
+	if a.ApparmorProfile != "" {
+		if s.Process == nil {
+			s.Process = &specs.Process{}
+		}
+		s.Process.ApparmorProfile = a.ApparmorProfile
+	}
+	if len(a.Devices) == 0 && a.Resources == nil && len(a.Namespaces) == 0 && a.CgroupsPath == "" && a.Seccomp == nil {
+		return nil
+	}
+	if s.Linux == nil {
+		s.Linux = &specs.Linux{}
+	}
+	if a.CgroupsPath != "" {
+		s.Linux.CgroupsPath = a.CgroupsPath
+	}
+	if a.Seccomp != nil {
+		s.Linux.Seccomp = a.Seccomp
+	}
+	for _, ns := range a.Namespaces {
+		kept := s.Linux.Namespaces[:0:0]
+		for _, existing := range s.Linux.Namespaces {
+			if existing.Type != ns.Type {
+				kept = append(kept, existing)
+			}
+		}
+		s.Linux.Namespaces = append(kept, ns)
+	}
+	if s.Linux.Resources == nil {
+		s.Linux.Resources = &specs.LinuxResources{}
+	}
+	for _, d := range a.Devices {
+		kept := s.Linux.Devices[:0:0]
+		for _, existing := range s.Linux.Devices {
+			if existing.Path != d.Path {
+				kept = append(kept, existing)
+			}
+		}
+		s.Linux.Devices = append(kept, d)
+		major, minor := d.Major, d.Minor
+		s.Linux.Resources.Devices = append(s.Linux.Resources.Devices, specs.LinuxDeviceCgroup{
+			Allow:  true,
+			Type:   d.Type,
+			Major:  &major,
+			Minor:  &minor,
+			Access: "rwm",
+		})
+	}
+	MergeResources(s.Linux.Resources, a.Resources)
+	return nil
+}
+
+// Merge appends the adjustment to the adjustment
+func (a *Adjustment) Merge(other *Adjustment) {
+	a.Env = append(a.Env, other.Env...)
+	a.Mounts = append(a.Mounts, other.Mounts...)
+	a.Devices = append(a.Devices, other.Devices...)
+	a.Namespaces = append(a.Namespaces, other.Namespaces...)
+	if other.CgroupsPath != "" {
+		a.CgroupsPath = other.CgroupsPath
+	}
+	if other.Seccomp != nil {
+		a.Seccomp = other.Seccomp
+	}
+	if other.ApparmorProfile != "" {
+		a.ApparmorProfile = other.ApparmorProfile
+	}
+	if other.Resources != nil {
+		if a.Resources == nil {
+			a.Resources = &specs.LinuxResources{}
+		}
+		MergeResources(a.Resources, other.Resources)
+	}
+}
+
+// MergeResources replaces the resources with the fields of the adjustment
+// that are set, appending its device cgroup rules
+func MergeResources(r, adjustment *specs.LinuxResources) {
+	if adjustment == nil {
+		return
+	}
+	r.Devices = append(r.Devices, adjustment.Devices...)
+	if adjustment.Memory != nil {
+		r.Memory = adjustment.Memory
+	}
+	if adjustment.CPU != nil {
+		r.CPU = adjustment.CPU
+	}
+	if adjustment.Pids != nil {
+		r.Pids = adjustment.Pids
+	}
+	if adjustment.BlockIO != nil {
+		r.BlockIO = adjustment.BlockIO
+	}
+	if adjustment.HugepageLimits != nil {
+		r.HugepageLimits = adjustment.HugepageLimits
+	}
+	if adjustment.Network != nil {
+		r.Network = adjustment.Network
+	}
+}
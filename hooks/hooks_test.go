@@ -0,0 +1,70 @@
+package hooks
+
+import (
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestApply(t *testing.T) {
+	var (
+		limit int64 = 64 << 20
+		pids        = &specs.LinuxPids{Limit: 10}
+		s           = &specs.Spec{
+			Process: &specs.Process{Env: []string{"PATH=/bin", "A=1"}},
+			Mounts:  []specs.Mount{{Destination: "/data", Source: "/old"}},
+			Linux: &specs.Linux{
+				Resources: &specs.LinuxResources{Pids: pids},
+			},
+		}
+	)
+	a := &Adjustment{
+		Env:     []string{"A=2", "B=3"},
+		Mounts:  []specs.Mount{{Destination: "/data/", Source: "/new"}},
+		Devices: []specs.LinuxDevice{{Path: "/dev/gpu0", Type: "c", Major: 195, Minor: 0}},
+		Resources: &specs.LinuxResources{
+			Memory: &specs.LinuxMemory{Limit: &limit},
+		},
+	}
+	if err := a.Apply(s); err != nil {
+		t.Fatal(err)
+	}
+	if env := s.Process.Env; len(env) != 3 || env[0] != "PATH=/bin" || env[1] != "A=2" || env[2] != "B=3" {
+		t.Fatalf("unexpected env %v", env)
+	}
+	if len(s.Mounts) != 1 || s.Mounts[0].Source != "/new" {
+		t.Fatalf("unexpected mounts %v", s.Mounts)
+	}
+	if len(s.Linux.Devices) != 1 || s.Linux.Devices[0].Path != "/dev/gpu0" {
+		t.Fatalf("unexpected devices %v", s.Linux.Devices)
+	}
+	r := s.Linux.Resources
+	if len(r.Devices) != 1 || !r.Devices[0].Allow || *r.Devices[0].Major != 195 || *r.Devices[0].Minor != 0 {
+		t.Fatalf("unexpected device cgroup rules %v", r.Devices)
+	}
+	if r.Memory == nil || *r.Memory.Limit != limit {
+		t.Fatal("memory limit not adjusted")
+	}
+	if r.Pids != pids {
+		t.Fatal("pids limit not kept")
+	}
+}
+
+func TestApplyProfiles(t *testing.T) {
+	var (
+		s        = &specs.Spec{}
+		seccomp  = &specs.LinuxSeccomp{DefaultAction: specs.ActErrno}
+		a        = &Adjustment{}
+		profiles = &Adjustment{Seccomp: seccomp, ApparmorProfile: "restricted"}
+	)
+	a.Merge(profiles)
+	if err := a.Apply(s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Linux == nil || s.Linux.Seccomp != seccomp {
+		t.Fatal("seccomp profile not set")
+	}
+	if s.Process == nil || s.Process.ApparmorProfile != "restricted" {
+		t.Fatal("apparmor profile not set")
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"fmt"
 
 	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/platforms"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
 	"golang.org/x/sync/errgroup"
@@ -160,7 +162,8 @@ func ChildrenHandler(provider content.Provider) HandlerFunc {
 			descs = append(descs, index.Manifests...)
 		case MediaTypeDockerSchema2Layer, MediaTypeDockerSchema2LayerGzip,
 			MediaTypeDockerSchema2Config, ocispec.MediaTypeImageConfig,
-			ocispec.MediaTypeImageLayer, ocispec.MediaTypeImageLayerGzip:
+			ocispec.MediaTypeImageLayer, ocispec.MediaTypeImageLayerGzip,
+			MediaTypeContainerd1Checkpoint, MediaTypeContainerd1CheckpointConfig:
 			// childless data types.
 			return nil, nil
 		default:
@@ -170,3 +173,34 @@ func ChildrenHandler(provider content.Provider) HandlerFunc {
 		return descs, nil
 	}
 }
+
+// FilterPlatform wraps a handler, such as ChildrenHandler, to only return the
+// manifests of manifest lists built for the platform, or that do not
+// declare one. An error is returned when a manifest list has none.
+func FilterPlatform(platform string, f HandlerFunc) HandlerFunc {
+	return func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		children, err := f(ctx, desc)
+		if err != nil {
+			return children, err
+		}
+		switch desc.MediaType {
+		case MediaTypeDockerSchema2ManifestList, ocispec.MediaTypeImageIndex:
+		default:
+			return children, nil
+		}
+		want, err := platforms.Parse(platform)
+		if err != nil {
+			return nil, err
+		}
+		var descs []ocispec.Descriptor
+		for _, d := range children {
+			if d.Platform == nil || platforms.Match(want, *d.Platform) {
+				descs = append(descs, d)
+			}
+		}
+		if len(descs) == 0 {
+			return nil, errors.Wrapf(errdefs.ErrNotFound, "no manifest for platform %s in %s", platform, desc.Digest)
+		}
+		return descs, nil
+	}
+}
@@ -3,9 +3,12 @@ package images
 import (
 	"context"
 	"encoding/json"
+	"sort"
 	"time"
 
 	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/platforms"
 	digest "github.com/opencontainers/go-digest"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
@@ -17,6 +20,24 @@ type Image struct {
 	Labels               map[string]string
 	Target               ocispec.Descriptor
 	CreatedAt, UpdatedAt time.Time
+
+	// Revision is incremented each time the image is updated. When set on
+	// an update, the update only succeeds if the image is still at this
+	// revision.
+	Revision uint64
+}
+
+// PlatformLabel records the platform, such as "linux/arm64", the manifest
+// of an image is selected for when its target is a manifest list
+const PlatformLabel = "containerd.io/image.platform"
+
+// Platform returns the platform recorded for the image, or the default
+// platform of the host
+func (image *Image) Platform() string {
+	if p, ok := image.Labels[PlatformLabel]; ok && p != "" {
+		return p
+	}
+	return platforms.Default()
 }
 
 type Store interface {
@@ -40,7 +61,11 @@ type Store interface {
 // The caller can then use the descriptor to resolve and process the
 // configuration of the image.
 func (image *Image) Config(ctx context.Context, provider content.Provider) (ocispec.Descriptor, error) {
-	return Config(ctx, provider, image.Target)
+	manifest, err := Manifest(ctx, provider, image.Target, image.Platform())
+	if err != nil {
+		return ocispec.Descriptor{}, err
+	}
+	return manifest.Config, nil
 }
 
 // RootFS returns the unpacked diffids that make up and images rootfs.
@@ -67,34 +92,128 @@ func (image *Image) Size(ctx context.Context, provider content.Provider) (int64,
 	}), ChildrenHandler(provider)), image.Target)
 }
 
+// Contains returns true if the digest is the target of the image or the
+// digest of a manifest, config or layer it references. Manifests missing
+// from the provider are not descended into.
+func Contains(ctx context.Context, provider content.Provider, target ocispec.Descriptor, dgst digest.Digest) (bool, error) {
+	children := ChildrenHandler(provider)
+	err := Walk(ctx, HandlerFunc(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		if desc.Digest == dgst {
+			return nil, errFound
+		}
+		descs, err := children(ctx, desc)
+		if err != nil && errdefs.IsNotFound(err) {
+			return nil, nil
+		}
+		return descs, err
+	}), target)
+	if err == errFound {
+		return true, nil
+	}
+	return false, err
+}
+
+// errFound stops the walk of an image once a descriptor is found
+var errFound = errors.New("found")
+
+// Platforms returns the os/architecture pairs, such as "linux/amd64", of
+// the configs referenced by the target, sorted and without duplicates.
+// Manifests and configs missing from the provider are skipped.
+func Platforms(ctx context.Context, provider content.Provider, target ocispec.Descriptor) ([]string, error) {
+	var (
+		children  = ChildrenHandler(provider)
+		platforms = make(map[string]struct{})
+	)
+	if err := Walk(ctx, HandlerFunc(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		switch desc.MediaType {
+		case MediaTypeDockerSchema2Config, ocispec.MediaTypeImageConfig:
+			p, err := content.ReadBlob(ctx, provider, desc.Digest)
+			if err != nil {
+				if errdefs.IsNotFound(err) {
+					return nil, nil
+				}
+				return nil, err
+			}
+			var config ocispec.Image
+			if err := json.Unmarshal(p, &config); err != nil {
+				return nil, err
+			}
+			platforms[config.OS+"/"+config.Architecture] = struct{}{}
+			return nil, nil
+		}
+		descs, err := children(ctx, desc)
+		if err != nil && errdefs.IsNotFound(err) {
+			return nil, nil
+		}
+		return descs, err
+	}), target); err != nil {
+		return nil, err
+	}
+	var list []string
+	for platform := range platforms {
+		list = append(list, platform)
+	}
+	sort.Strings(list)
+	return list, nil
+}
+
 // Config resolves the image configuration descriptor using a content provided
-// to resolve child resources on the image.
+// to resolve child resources on the image. The manifest of the default
+// platform is selected from manifest lists.
 //
 // The caller can then use the descriptor to resolve and process the
 // configuration of the image.
 func Config(ctx context.Context, provider content.Provider, image ocispec.Descriptor) (ocispec.Descriptor, error) {
-	var configDesc ocispec.Descriptor
-	return configDesc, Walk(ctx, HandlerFunc(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+	manifest, err := Manifest(ctx, provider, image, platforms.Default())
+	if err != nil {
+		return ocispec.Descriptor{}, err
+	}
+	return manifest.Config, nil
+}
+
+// Manifest resolves the manifest of the image. When the image is a manifest
+// list, the first manifest built for the platform is selected; manifests
+// that do not declare a platform match any.
+func Manifest(ctx context.Context, provider content.Provider, image ocispec.Descriptor, platform string) (ocispec.Manifest, error) {
+	want, err := platforms.Parse(platform)
+	if err != nil {
+		return ocispec.Manifest{}, err
+	}
+	for depth := 0; ; depth++ {
+		p, err := content.ReadBlob(ctx, provider, image.Digest)
+		if err != nil {
+			return ocispec.Manifest{}, err
+		}
 		switch image.MediaType {
 		case MediaTypeDockerSchema2Manifest, ocispec.MediaTypeImageManifest:
-			p, err := content.ReadBlob(ctx, provider, image.Digest)
-			if err != nil {
-				return nil, err
-			}
-
 			var manifest ocispec.Manifest
 			if err := json.Unmarshal(p, &manifest); err != nil {
-				return nil, err
+				return ocispec.Manifest{}, err
+			}
+			return manifest, nil
+		case MediaTypeDockerSchema2ManifestList, ocispec.MediaTypeImageIndex:
+			// nested indexes are followed, up to a limit
+			if depth > 4 {
+				return ocispec.Manifest{}, errors.Errorf("manifest list %s nested too deep", image.Digest)
+			}
+			var index ocispec.Index
+			if err := json.Unmarshal(p, &index); err != nil {
+				return ocispec.Manifest{}, err
+			}
+			var found bool
+			for _, m := range index.Manifests {
+				if m.Platform == nil || platforms.Match(want, *m.Platform) {
+					image, found = m, true
+					break
+				}
+			}
+			if !found {
+				return ocispec.Manifest{}, errors.Wrapf(errdefs.ErrNotFound, "no manifest for platform %s in %s", platform, image.Digest)
 			}
-
-			configDesc = manifest.Config
-
-			return nil, nil
 		default:
-			return nil, errors.New("could not resolve config")
+			return ocispec.Manifest{}, errors.Errorf("could not resolve manifest of %s (%s)", image.Digest, image.MediaType)
 		}
-
-	}), image)
+	}
 }
 
 // RootFS returns the unpacked diffids that make up and images rootfs.
@@ -0,0 +1,219 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/content/local"
+	"github.com/containerd/containerd/errdefs"
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+func TestContains(t *testing.T) {
+	root, err := ioutil.TempDir("", "images-contains-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	cs, err := local.NewStore(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	config := ocispec.Descriptor{
+		MediaType: ocispec.MediaTypeImageConfig,
+		Digest:    digest.FromString("config"),
+		Size:      6,
+	}
+	layer := ocispec.Descriptor{
+		MediaType: ocispec.MediaTypeImageLayerGzip,
+		Digest:    digest.FromString("layer"),
+		Size:      5,
+	}
+	p, err := json.Marshal(ocispec.Manifest{
+		Config: config,
+		Layers: []ocispec.Descriptor{layer},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifest := ocispec.Descriptor{
+		MediaType: ocispec.MediaTypeImageManifest,
+		Digest:    digest.FromBytes(p),
+		Size:      int64(len(p)),
+	}
+	if err := content.WriteBlob(ctx, cs, "manifest", bytes.NewReader(p), manifest.Size, manifest.Digest); err != nil {
+		t.Fatal(err)
+	}
+
+	for dgst, expected := range map[digest.Digest]bool{
+		manifest.Digest:              true,
+		config.Digest:                true,
+		layer.Digest:                 true,
+		digest.FromString("unknown"): false,
+	} {
+		found, err := Contains(ctx, cs, manifest, dgst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if found != expected {
+			t.Errorf("%s: expected %v, got %v", dgst, expected, found)
+		}
+	}
+
+	// the children of a manifest missing from the store are not known
+	missing := ocispec.Descriptor{
+		MediaType: ocispec.MediaTypeImageManifest,
+		Digest:    digest.FromString("missing"),
+	}
+	found, err := Contains(ctx, cs, missing, config.Digest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found {
+		t.Error("expected digest not to be found in a missing manifest")
+	}
+}
+
+func TestPlatforms(t *testing.T) {
+	root, err := ioutil.TempDir("", "images-platforms-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	cs, err := local.NewStore(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	write := func(ref, mediaType string, v interface{}) ocispec.Descriptor {
+		p, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		desc := ocispec.Descriptor{
+			MediaType: mediaType,
+			Digest:    digest.FromBytes(p),
+			Size:      int64(len(p)),
+		}
+		if err := content.WriteBlob(ctx, cs, ref, bytes.NewReader(p), desc.Size, desc.Digest); err != nil {
+			t.Fatal(err)
+		}
+		return desc
+	}
+	var manifests []ocispec.Descriptor
+	for _, arch := range []string{"arm64", "amd64", "amd64"} {
+		config := write("config-"+arch, ocispec.MediaTypeImageConfig, ocispec.Image{
+			OS:           "linux",
+			Architecture: arch,
+		})
+		manifests = append(manifests, write("manifest-"+arch, ocispec.MediaTypeImageManifest, ocispec.Manifest{
+			Config: config,
+		}))
+	}
+	// the config of a missing manifest is skipped
+	manifests = append(manifests, ocispec.Descriptor{
+		MediaType: ocispec.MediaTypeImageManifest,
+		Digest:    digest.FromString("missing"),
+	})
+	index := write("index", ocispec.MediaTypeImageIndex, ocispec.Index{
+		Manifests: manifests,
+	})
+
+	platforms, err := Platforms(ctx, cs, index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(platforms) != 2 || platforms[0] != "linux/amd64" || platforms[1] != "linux/arm64" {
+		t.Fatalf("unexpected platforms %v", platforms)
+	}
+}
+
+func TestManifest(t *testing.T) {
+	root, err := ioutil.TempDir("", "images-manifest-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	cs, err := local.NewStore(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	write := func(ref, mediaType string, v interface{}) ocispec.Descriptor {
+		p, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		desc := ocispec.Descriptor{
+			MediaType: mediaType,
+			Digest:    digest.FromBytes(p),
+			Size:      int64(len(p)),
+		}
+		if err := content.WriteBlob(ctx, cs, ref, bytes.NewReader(p), desc.Size, desc.Digest); err != nil {
+			t.Fatal(err)
+		}
+		return desc
+	}
+	configs := make(map[string]ocispec.Descriptor)
+	var manifests []ocispec.Descriptor
+	for _, p := range []ocispec.Platform{
+		{OS: "linux", Architecture: "amd64"},
+		{OS: "linux", Architecture: "arm", Variant: "v6"},
+		{OS: "linux", Architecture: "arm", Variant: "v7"},
+	} {
+		name := p.Architecture + p.Variant
+		configs[name] = write("config-"+name, ocispec.MediaTypeImageConfig, ocispec.Image{
+			OS:           p.OS,
+			Architecture: p.Architecture,
+		})
+		m := write("manifest-"+name, ocispec.MediaTypeImageManifest, ocispec.Manifest{
+			Config: configs[name],
+		})
+		platform := p
+		m.Platform = &platform
+		manifests = append(manifests, m)
+	}
+	index := write("index", ocispec.MediaTypeImageIndex, ocispec.Index{
+		Manifests: manifests,
+	})
+
+	for platform, expected := range map[string]string{
+		"linux/amd64":  "amd64",
+		"linux/x86_64": "amd64",
+		"linux/armhf":  "armv7",
+		"linux/arm/v6": "armv6",
+	} {
+		manifest, err := Manifest(ctx, cs, index, platform)
+		if err != nil {
+			t.Fatalf("%s: %v", platform, err)
+		}
+		if manifest.Config.Digest != configs[expected].Digest {
+			t.Errorf("%s: expected the manifest of %s", platform, expected)
+		}
+	}
+	if _, err := Manifest(ctx, cs, index, "linux/s390x"); !errdefs.IsNotFound(err) {
+		t.Fatalf("expected not found for a missing platform, got %v", err)
+	}
+
+	children, err := FilterPlatform("linux/arm64", ChildrenHandler(cs))(ctx, index)
+	if err == nil || !errdefs.IsNotFound(err) {
+		t.Fatalf("expected not found filtering a missing platform, got %v %v", children, err)
+	}
+	children, err = FilterPlatform("linux/arm", ChildrenHandler(cs))(ctx, index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(children) != 2 {
+		t.Fatalf("expected both arm manifests, got %d", len(children))
+	}
+}
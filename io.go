@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/url"
 	"os"
+	"strings"
 	"sync"
+
+	"github.com/containerd/containerd/logfile"
 )
 
 // IOConfig holds the io configurations.
@@ -146,6 +150,77 @@ func StdioTerminal(id string) (IO, error) {
 	return NewIOWithTerminal(os.Stdin, os.Stdout, os.Stderr, true)(id)
 }
 
+// LogFile returns an IOCreation that appends the stdout and stderr of the
+// task to the log file at path in the CRI format, splitting lines longer
+// than maxLineSize into partial entries. The task has no stdin.
+func LogFile(path string, maxLineSize int) IOCreation {
+	return func(id string) (IO, error) {
+		f, err := logfile.Open(path, maxLineSize)
+		if err != nil {
+			return nil, err
+		}
+		i, err := NewIO(strings.NewReader(""), f.Stream("stdout"), f.Stream("stderr"))(id)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		return &logIO{IO: i, file: f}, nil
+	}
+}
+
+type logIO struct {
+	IO
+	file *logfile.File
+}
+
+// Wait waits for the output of the task to be copied and logs the last
+// incomplete lines
+func (l *logIO) Wait() {
+	l.IO.Wait()
+	l.file.Flush()
+}
+
+func (l *logIO) Close() error {
+	err := l.IO.Close()
+	if ferr := l.file.Close(); err == nil {
+		err = ferr
+	}
+	return err
+}
+
+// URIIO returns an IOCreation passing the stdio of the task to the runtime
+// as URIs, so that the shim writes the output to its destination without a
+// copy through fifos. The schemes are fifo:// for the path of a fifo,
+// file:// for a file read as stdin or appended with the output, binary:// for
+// a logger binary started by the shim with the values of the arg query
+// parameter as arguments and null:// to discard the stdio. An empty stdio is
+// null.
+func URIIO(stdin, stdout, stderr string) IOCreation {
+	return func(id string) (IO, error) {
+		return &cio{
+			config: IOConfig{
+				Stdin:  stdin,
+				Stdout: stdout,
+				Stderr: stderr,
+			},
+		}, nil
+	}
+}
+
+// BinaryIO returns an IOCreation sending the output of the task to a logger
+// binary started and supervised by the shim. The logger receives stdout on
+// the file descriptor 3 and stderr on 4, and the namespace and id of the
+// container in the CONTAINER_NAMESPACE and CONTAINER_ID environment
+// variables. The task has no stdin.
+func BinaryIO(binary string, args ...string) IOCreation {
+	u := url.URL{
+		Scheme:   "binary",
+		Path:     binary,
+		RawQuery: url.Values{"arg": args}.Encode(),
+	}
+	return URIIO("", u.String(), u.String())
+}
+
 // NullIO redirects the container's IO into /dev/null
 func NullIO(id string) (IO, error) {
 	return &cio{}, nil
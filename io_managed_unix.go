@@ -0,0 +1,80 @@
+// +build !windows
+
+package containerd
+
+import (
+	"context"
+	"io"
+
+	fifosapi "github.com/containerd/containerd/api/services/fifos/v1"
+	"github.com/containerd/containerd/errdefs"
+)
+
+// ManagedIO returns an IOCreation like NewIOWithTerminal whose fifos are
+// created by the daemon for the container, owned by the root of its user
+// namespace if it has one. The fifos are removed when the IO is closed or
+// the container deleted. The context holds the namespace of the container.
+func (c *Client) ManagedIO(ctx context.Context, containerID string, stdin io.Reader, stdout, stderr io.Writer, terminal bool) IOCreation {
+	return func(id string) (_ IO, err error) {
+		service := c.FifosService()
+		resp, err := service.Create(ctx, &fifosapi.CreateFifosRequest{
+			ContainerID: containerID,
+			ID:          id,
+			Stdin:       true,
+		})
+		if err != nil {
+			return nil, errdefs.FromGRPC(err)
+		}
+		i := &managedIO{
+			cio: cio{
+				config: IOConfig{
+					Terminal: terminal,
+					Stdin:    resp.Fifos.Stdin,
+					Stdout:   resp.Fifos.Stdout,
+					Stderr:   resp.Fifos.Stderr,
+				},
+			},
+			delete: func() error {
+				_, err := service.Delete(ctx, &fifosapi.DeleteFifosRequest{
+					ContainerID: containerID,
+					ID:          id,
+				})
+				if err = errdefs.FromGRPC(err); errdefs.IsNotFound(err) {
+					return nil
+				}
+				return err
+			},
+		}
+		defer func() {
+			if err != nil {
+				i.delete()
+			}
+		}()
+		i.closer, err = copyIO(&FIFOSet{
+			In:  resp.Fifos.Stdin,
+			Out: resp.Fifos.Stdout,
+			Err: resp.Fifos.Stderr,
+		}, &ioSet{
+			in:  stdin,
+			out: stdout,
+			err: stderr,
+		}, terminal)
+		if err != nil {
+			return nil, err
+		}
+		return i, nil
+	}
+}
+
+type managedIO struct {
+	cio
+	delete func() error
+}
+
+func (m *managedIO) Close() error {
+	err := m.cio.Close()
+	if derr := m.delete(); err == nil {
+		err = derr
+	}
+	return err
+}
@@ -0,0 +1,171 @@
+package containerd
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	stdioapi "github.com/containerd/containerd/api/services/stdio/v1"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/namespaces"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// RemoteIO returns an IOCreation that proxies the stdio of the process over
+// the connection of the client through fifos created by the daemon, so that
+// it does not need to run on the host of the daemon. The context holds the
+// namespace of the process and bounds the stream of its stdio.
+func (c *Client) RemoteIO(ctx context.Context, stdin io.Reader, stdout, stderr io.Writer, terminal bool) IOCreation {
+	return func(id string) (_ IO, err error) {
+		service := c.StdioService()
+		paths, err := service.Create(ctx, &stdioapi.CreateStdioRequest{
+			ID:       id,
+			Stdin:    stdin != nil,
+			Terminal: terminal,
+		})
+		if err != nil {
+			return nil, err
+		}
+		sctx, cancel := context.WithCancel(ctx)
+		i := &remoteIO{
+			config: IOConfig{
+				Terminal: terminal,
+				Stdin:    paths.Stdin,
+				Stdout:   paths.Stdout,
+				Stderr:   paths.Stderr,
+			},
+			id:      id,
+			service: service,
+			ctx:     ctx,
+			cancel:  cancel,
+		}
+		defer func() {
+			if err != nil {
+				i.Close()
+			}
+		}()
+		stream, err := service.Attach(sctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := stream.Send(&stdioapi.AttachRequest{ID: id}); err != nil {
+			return nil, err
+		}
+		// wait for the acknowledgement of the daemon so that no output of
+		// the process is missed
+		if _, err := stream.Recv(); err != nil {
+			return nil, err
+		}
+		if stdin != nil {
+			go sendStdin(stream, stdin)
+		}
+		i.wg.Add(1)
+		go func() {
+			defer i.wg.Done()
+			for {
+				resp, err := stream.Recv()
+				if err != nil {
+					if grpc.Code(err) == codes.ResourceExhausted {
+						log.G(ctx).WithError(err).Warn("output of the process no longer received")
+					}
+					return
+				}
+				w := stdout
+				if resp.Stream == stdioapi.StreamStderr {
+					w = stderr
+				}
+				if w != nil {
+					w.Write(resp.Data)
+				}
+			}
+		}()
+		return i, nil
+	}
+}
+
+// LogDriverIO returns an IOCreation that logs the output of the process to
+// a log driver of the daemon, such as json-file, journald, syslog or binary,
+// configured by the options. The process has no stdin and the client does
+// not need to read its output.
+func (c *Client) LogDriverIO(ctx context.Context, driver string, options map[string]string) IOCreation {
+	return func(id string) (IO, error) {
+		service := c.StdioService()
+		paths, err := service.Create(ctx, &stdioapi.CreateStdioRequest{
+			ID:         id,
+			LogDriver:  driver,
+			LogOptions: options,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &remoteIO{
+			config: IOConfig{
+				Stdout: paths.Stdout,
+				Stderr: paths.Stderr,
+			},
+			id:      id,
+			service: service,
+			ctx:     ctx,
+			cancel:  func() {},
+		}, nil
+	}
+}
+
+// sendStdin sends the stdin of the process to the daemon until its end
+func sendStdin(stream stdioapi.Stdio_AttachClient, stdin io.Reader) {
+	buf := make([]byte, 32<<10)
+	for {
+		n, err := stdin.Read(buf)
+		if n > 0 {
+			if serr := stream.Send(&stdioapi.AttachRequest{Stdin: buf[:n]}); serr != nil {
+				return
+			}
+		}
+		if err != nil {
+			stream.Send(&stdioapi.AttachRequest{CloseStdin: true})
+			return
+		}
+	}
+}
+
+// remoteIOGracePeriod is how long the output of a canceled remote IO is
+// still received
+const remoteIOGracePeriod = 2 * time.Second
+
+type remoteIO struct {
+	config  IOConfig
+	id      string
+	service stdioapi.StdioClient
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+func (r *remoteIO) Config() IOConfig {
+	return r.config
+}
+
+// Cancel ends the stream of the stdio after a grace period, as the last
+// output of a process that exited may still be in flight
+func (r *remoteIO) Cancel() {
+	time.AfterFunc(remoteIOGracePeriod, r.cancel)
+}
+
+// Wait waits for the output of the process to be received
+func (r *remoteIO) Wait() {
+	r.wg.Wait()
+}
+
+// Close ends the stream of the stdio and removes its fifos from the daemon
+func (r *remoteIO) Close() error {
+	r.cancel()
+	// the context of the process may be done by the time it is deleted
+	ctx := context.Background()
+	if namespace, ok := namespaces.Namespace(r.ctx); ok {
+		ctx = namespaces.WithNamespace(ctx, namespace)
+	}
+	_, err := r.service.Delete(ctx, &stdioapi.DeleteStdioRequest{ID: r.id})
+	return err
+}
@@ -0,0 +1,29 @@
+// Package labels provides common validation for labels set on objects
+// across containerd.
+package labels
+
+import (
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxSize = 4096
+)
+
+// Validate returns nil if the label key and value are valid.
+//
+// Keys must not be empty and the combined size of a key and its value is
+// limited to 4096 bytes so that labels remain cheap to store and filter on.
+func Validate(k, v string) error {
+	if len(k) == 0 {
+		return errors.Wrapf(errdefs.ErrInvalidArgument, "label key must not be empty")
+	}
+	if len(k)+len(v) > maxSize {
+		if len(k) > 10 {
+			k = k[:10]
+		}
+		return errors.Wrapf(errdefs.ErrInvalidArgument, "label key and value greater than maximum size (%d bytes), key: %s", maxSize, k)
+	}
+	return nil
+}
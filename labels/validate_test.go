@@ -0,0 +1,31 @@
+package labels
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+)
+
+func TestValidLabels(t *testing.T) {
+	for k, v := range map[string]string{
+		"containerd.io/pod": "default/test",
+		"empty-value":       "",
+		"max":               strings.Repeat("a", maxSize-len("max")),
+	} {
+		if err := Validate(k, v); err != nil {
+			t.Fatalf("unexpected error for label %q: %v", k, err)
+		}
+	}
+}
+
+func TestInvalidLabels(t *testing.T) {
+	for k, v := range map[string]string{
+		"":        "value",
+		"too-big": strings.Repeat("a", maxSize),
+	} {
+		if err := Validate(k, v); !errdefs.IsInvalidArgument(err) {
+			t.Fatalf("expected invalid argument for label %q, got %v", k, err)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// +build linux
+
+package linux
+
+import (
+	"context"
+
+	"github.com/containerd/containerd/errdefs"
+	client "github.com/containerd/containerd/linux/shim"
+	"github.com/containerd/containerd/runtime"
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+)
+
+// brokenTask is a task that could not be restored from its state, such as a
+// task whose shim is gone and whose runtime state cannot be cleaned up. It is
+// reported with the broken status until it is deleted.
+type brokenTask struct {
+	id        string
+	namespace string
+	bundle    string
+	err       error
+	// shim is the shim still running the task, if any, killed with the task
+	shim *client.Client
+}
+
+func newBrokenTask(id, namespace, bundle string, err error) *brokenTask {
+	return &brokenTask{
+		id:        id,
+		namespace: namespace,
+		bundle:    bundle,
+		err:       err,
+	}
+}
+
+func (t *brokenTask) ID() string {
+	return t.id
+}
+
+func (t *brokenTask) Info() runtime.TaskInfo {
+	return runtime.TaskInfo{
+		ID:        t.id,
+		Runtime:   pluginID,
+		Namespace: t.namespace,
+		Bundle:    t.bundle,
+	}
+}
+
+func (t *brokenTask) State(ctx context.Context) (runtime.State, error) {
+	return runtime.State{
+		Status: runtime.BrokenStatus,
+	}, nil
+}
+
+func (t *brokenTask) broken() error {
+	return errors.Wrapf(errdefs.ErrFailedPrecondition, "task %s is broken and can only be deleted: %v", t.id, t.err)
+}
+
+func (t *brokenTask) Kill(ctx context.Context, signal uint32, all bool) error {
+	return t.broken()
+}
+
+func (t *brokenTask) ResizePty(ctx context.Context, size runtime.ConsoleSize) error {
+	return t.broken()
+}
+
+func (t *brokenTask) CloseIO(ctx context.Context) error {
+	return t.broken()
+}
+
+func (t *brokenTask) Start(ctx context.Context) error {
+	return t.broken()
+}
+
+func (t *brokenTask) Pause(ctx context.Context) error {
+	return t.broken()
+}
+
+func (t *brokenTask) Resume(ctx context.Context) error {
+	return t.broken()
+}
+
+func (t *brokenTask) Exec(ctx context.Context, id string, opts runtime.ExecOpts) (runtime.Process, error) {
+	return nil, t.broken()
+}
+
+func (t *brokenTask) Pids(ctx context.Context) ([]runtime.ProcessInfo, error) {
+	return nil, t.broken()
+}
+
+func (t *brokenTask) Checkpoint(ctx context.Context, path string, options *types.Any) error {
+	return t.broken()
+}
+
+func (t *brokenTask) DeleteProcess(ctx context.Context, id string) (*runtime.Exit, error) {
+	return nil, t.broken()
+}
+
+func (t *brokenTask) Update(ctx context.Context, resources *types.Any) error {
+	return t.broken()
+}
+
+func (t *brokenTask) Process(ctx context.Context, id string) (runtime.Process, error) {
+	return nil, t.broken()
+}
@@ -0,0 +1,30 @@
+// +build linux
+
+package linux
+
+import (
+	"context"
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/runtime"
+	"github.com/pkg/errors"
+)
+
+func TestBrokenTask(t *testing.T) {
+	bt := newBrokenTask("test", "default", "/run/containerd/test", errors.New("shim is gone"))
+	var _ runtime.Task = bt
+	state, err := bt.State(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.Status != runtime.BrokenStatus {
+		t.Fatalf("expected the broken status, got %v", state.Status)
+	}
+	if err := bt.Start(context.Background()); !errdefs.IsFailedPrecondition(err) {
+		t.Fatalf("expected a failed precondition starting a broken task, got %v", err)
+	}
+	if info := bt.Info(); info.Namespace != "default" || info.Runtime != pluginID {
+		t.Fatalf("unexpected info %+v", info)
+	}
+}
@@ -5,15 +5,23 @@ package linux
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 
+	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/events"
 	"github.com/containerd/containerd/linux/runcopts"
 	client "github.com/containerd/containerd/linux/shim"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/mount"
 	"github.com/containerd/containerd/runtime"
 	"github.com/containerd/containerd/typeurl"
+	"github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 )
 
@@ -78,26 +86,64 @@ type bundle struct {
 }
 
 // NewShim connects to the shim managing the bundle and tasks
-func (b *bundle) NewShim(ctx context.Context, binary, grpcAddress string, remote, debug bool, createOpts runtime.CreateOpts) (*client.Client, error) {
+func (b *bundle) NewShim(ctx context.Context, binary, grpcAddress string, remote, debug bool, ioBufferSize int, retention client.ExecRetention, createOpts runtime.CreateOpts) (*client.Client, error) {
 	opt := client.WithStart(binary, grpcAddress, debug)
 	if !remote {
 		opt = client.WithLocal(b.events)
 	}
+	options, err := runcCreateOptions(createOpts)
+	if err != nil {
+		return nil, err
+	}
+	return client.New(ctx, client.Config{
+		Address:       b.shimAddress(),
+		Path:          b.path,
+		Namespace:     b.namespace,
+		CgroupPath:    options.ShimCgroup,
+		WorkDir:       b.workDir,
+		IOBufferSize:  ioBufferSize,
+		ExecRetention: retention,
+	}, opt)
+}
+
+// ClaimShim claims an idle shim of the pool for the task, nil when no shim
+// is idle. The address of the shim is recorded in the bundle so that the
+// shim is reconnected to when containerd restarts.
+func (b *bundle) ClaimShim(ctx context.Context, pool *client.Pool, createOpts runtime.CreateOpts) (*client.Client, error) {
+	options, err := runcCreateOptions(createOpts)
+	if err != nil {
+		return nil, err
+	}
+	s, address, err := pool.Claim(ctx, client.Config{
+		Path:       b.path,
+		CgroupPath: options.ShimCgroup,
+	})
+	if err != nil || s == nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(b.path, shimAddressFilename), []byte(address), 0600); err != nil {
+		if kerr := s.KillShim(ctx); kerr != nil {
+			log.G(ctx).WithError(kerr).WithField("address", address).Error("failed to kill pooled shim")
+		}
+		s.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// runcCreateOptions returns the runc options of the task, empty when the
+// options are for another shim. The options of external shims are opaque
+// to containerd and passed on to the shim as they are.
+func runcCreateOptions(createOpts runtime.CreateOpts) (runcopts.CreateOptions, error) {
 	var options runcopts.CreateOptions
-	if createOpts.Options != nil {
+	if createOpts.Options != nil && typeurl.Is(createOpts.Options, &options) {
 		v, err := typeurl.UnmarshalAny(createOpts.Options)
 		if err != nil {
-			return nil, err
+			return options, err
 		}
 		options = *v.(*runcopts.CreateOptions)
 	}
-	return client.New(ctx, client.Config{
-		Address:    b.shimAddress(),
-		Path:       b.path,
-		Namespace:  b.namespace,
-		CgroupPath: options.ShimCgroup,
-		WorkDir:    b.workDir,
-	}, opt)
+	return options, nil
 }
 
 // Connect reconnects to an existing shim
@@ -113,6 +159,117 @@ func (b *bundle) Connect(ctx context.Context, remote bool) (*client.Client, erro
 	}, opt)
 }
 
+// createOptsVersion is the version of the createOpts layout written to the
+// bundle. It must be incremented, and a migration added to LoadCreateOpts,
+// when the meaning of an existing field changes.
+const createOptsVersion = 1
+
+// createOpts holds the task creation options persisted in the bundle so
+// that they are available after the daemon restarts
+type createOpts struct {
+	// Version of the layout, files written before the layout was versioned
+	// have version 0 and share the layout of version 1
+	Version int           `json:"version"`
+	Rootfs  []mount.Mount `json:"rootfs,omitempty"`
+	Options *types.Any    `json:"options,omitempty"`
+}
+
+// SaveCreateOpts persists the rootfs mounts and options used to create the task
+func (b *bundle) SaveCreateOpts(opts runtime.CreateOpts) error {
+	data, err := json.Marshal(createOpts{
+		Version: createOptsVersion,
+		Rootfs:  opts.Rootfs,
+		Options: opts.Options,
+	})
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(b.path, createOptsFilename), data, 0600)
+}
+
+// LoadCreateOpts returns the rootfs mounts and options persisted for the task.
+// Bundles created before the options were persisted return empty options.
+//
+// Options written by a newer version of containerd are rejected rather than
+// misinterpreted. When strict is set, options with fields unknown to this
+// version are rejected as well.
+func (b *bundle) LoadCreateOpts(strict bool) (runtime.CreateOpts, error) {
+	data, err := ioutil.ReadFile(filepath.Join(b.path, createOptsFilename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return runtime.CreateOpts{}, nil
+		}
+		return runtime.CreateOpts{}, err
+	}
+	o, err := decodeCreateOpts(data, strict)
+	if err != nil {
+		return runtime.CreateOpts{}, errors.Wrapf(err, "failed to decode %s", createOptsFilename)
+	}
+	return runtime.CreateOpts{
+		Rootfs:  o.Rootfs,
+		Options: o.Options,
+	}, nil
+}
+
+func decodeCreateOpts(data []byte, strict bool) (createOpts, error) {
+	var v struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return createOpts{}, err
+	}
+	if v.Version > createOptsVersion {
+		return createOpts{}, errors.Wrapf(errdefs.ErrFailedPrecondition, "version %d is newer than supported version %d", v.Version, createOptsVersion)
+	}
+	var o createOpts
+	if strict {
+		if err := checkFields(data, o); err != nil {
+			return createOpts{}, err
+		}
+	}
+	if err := json.Unmarshal(data, &o); err != nil {
+		return createOpts{}, err
+	}
+	// version 0 shares the layout of version 1
+	o.Version = createOptsVersion
+	return o, nil
+}
+
+// checkFields returns an error for the first field of the JSON object that
+// is not a field of the struct v, matched like encoding/json matches them
+func checkFields(data []byte, v interface{}) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	t := reflect.TypeOf(v)
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		names = append(names, name)
+	}
+	for key := range fields {
+		known := false
+		for _, name := range names {
+			if strings.EqualFold(key, name) {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return errors.Errorf("json: unknown field %q", key)
+		}
+	}
+	return nil
+}
+
 // Delete deletes the bundle from disk
 func (b *bundle) Delete() error {
 	err := os.RemoveAll(b.path)
@@ -127,7 +284,11 @@ func (b *bundle) Delete() error {
 	return errors.Wrapf(err, "Failed to remove both bundle and workdir locations: %v", err2)
 }
 
+// shimAddress returns the address of the shim of the task, the address of
+// the pooled shim claimed by the task when it is recorded in the bundle
 func (b *bundle) shimAddress() string {
+	if data, err := ioutil.ReadFile(filepath.Join(b.path, shimAddressFilename)); err == nil {
+		return string(data)
+	}
 	return filepath.Join(string(filepath.Separator), "containerd-shim", b.namespace, b.id, "shim.sock")
-
 }
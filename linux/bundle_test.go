@@ -0,0 +1,116 @@
+// +build linux
+
+package linux
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+)
+
+func TestDecodeCreateOpts(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		data   string
+		strict bool
+		rootfs int
+		err    bool
+	}{
+		{
+			name:   "Unversioned",
+			data:   `{"rootfs":[{"type":"bind","source":"/tmp"}]}`,
+			rootfs: 1,
+		},
+		{
+			name:   "Current",
+			data:   `{"version":1,"rootfs":[{"type":"bind","source":"/tmp"}]}`,
+			rootfs: 1,
+		},
+		{
+			name: "UnknownField",
+			data: `{"version":1,"unknown":true}`,
+		},
+		{
+			name:   "UnknownFieldStrict",
+			data:   `{"version":1,"unknown":true}`,
+			strict: true,
+			err:    true,
+		},
+		{
+			name: "Newer",
+			data: `{"version":2}`,
+			err:  true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o, err := decodeCreateOpts([]byte(tc.data), tc.strict)
+			if tc.err {
+				if err == nil {
+					t.Fatal("expected error decoding create options")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if o.Version != createOptsVersion {
+				t.Fatalf("expected version %d, got %d", createOptsVersion, o.Version)
+			}
+			if len(o.Rootfs) != tc.rootfs {
+				t.Fatalf("expected %d rootfs mounts, got %d", tc.rootfs, len(o.Rootfs))
+			}
+		})
+	}
+
+	_, err := decodeCreateOpts([]byte(`{"version":2}`), false)
+	if !errdefs.IsFailedPrecondition(err) {
+		t.Fatalf("expected failed precondition for newer version, got %v", err)
+	}
+}
+
+func TestShimBinary(t *testing.T) {
+	r := &Runtime{
+		shims: map[string]string{
+			"io.containerd.kata.v1": "/opt/kata/bin/containerd-shim-kata-v1",
+			"custom":                "containerd-shim-custom",
+		},
+	}
+	for _, tc := range []struct {
+		name   string
+		binary string
+	}{
+		{name: "io.containerd.runc.v1", binary: "containerd-shim-runc-v1"},
+		{name: "io.containerd.kata.v1", binary: "/opt/kata/bin/containerd-shim-kata-v1"},
+		{name: "custom", binary: "containerd-shim-custom"},
+		{name: pluginID},
+		{name: "io.containerd..v1"},
+		{name: "org.example.runc.v1"},
+	} {
+		binary, ok := r.shimBinary(tc.name)
+		if ok != (tc.binary != "") || binary != tc.binary {
+			t.Errorf("%s: expected %q, got %q", tc.name, tc.binary, binary)
+		}
+	}
+}
+
+func TestShimAddress(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bundle-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	b := loadBundle(dir, dir, "default", "test", nil)
+	if address := b.shimAddress(); address != "/containerd-shim/default/test/shim.sock" {
+		t.Fatalf("unexpected address %q", address)
+	}
+	pooled := "/containerd-shim/.pool/abc-1/shim.sock"
+	if err := ioutil.WriteFile(filepath.Join(dir, shimAddressFilename), []byte(pooled), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if address := b.shimAddress(); address != pooled {
+		t.Fatalf("expected the address of the claimed shim, got %q", address)
+	}
+}
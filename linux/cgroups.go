@@ -0,0 +1,114 @@
+// +build linux
+
+package linux
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/linux/runcopts"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultSlice is the slice of the containers with the systemd cgroup
+	// driver when no parent is set
+	defaultSlice = "system.slice"
+	// defaultScopePrefix prefixes the scopes of the containers whose spec
+	// sets no cgroups path with the systemd cgroup driver
+	defaultScopePrefix = "containerd"
+)
+
+// withCgroupParent returns the spec with the cgroup of the container under
+// the parent of the options, or of the runtime when the options set none.
+// With the systemd cgroup driver, the "slice:prefix:name" cgroups path of
+// the spec is resolved to the path of the scope of the container in the
+// cgroup hierarchy, which the shim registers as a transient scope.
+func withCgroupParent(data []byte, id string, options runcopts.CreateOptions, parent string) ([]byte, error) {
+	if options.CgroupParent != "" {
+		parent = options.CgroupParent
+	}
+	if parent == "" && !options.SystemdCgroup {
+		return data, nil
+	}
+	var spec specs.Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, err
+	}
+	if spec.Linux == nil {
+		spec.Linux = &specs.Linux{}
+	}
+	path, err := cgroupsPath(spec.Linux.CgroupsPath, id, parent, options.SystemdCgroup)
+	if err != nil {
+		return nil, err
+	}
+	if path == spec.Linux.CgroupsPath {
+		return data, nil
+	}
+	spec.Linux.CgroupsPath = path
+	return json.Marshal(spec)
+}
+
+// cgroupsPath returns the cgroups path of the container under the parent
+func cgroupsPath(path, id, parent string, systemd bool) (string, error) {
+	if !systemd {
+		if strings.HasPrefix(path, "/") {
+			return path, nil
+		}
+		if path == "" {
+			path = id
+		}
+		return filepath.Join("/", parent, path), nil
+	}
+	if parent == "" {
+		parent = defaultSlice
+	}
+	if !strings.HasSuffix(parent, ".slice") || strings.Contains(parent, "/") {
+		return "", errors.Wrapf(errdefs.ErrInvalidArgument, "cgroup parent %q must be a slice with the systemd cgroup driver", parent)
+	}
+	if path == "" {
+		path = parent + ":" + defaultScopePrefix + ":" + id
+	}
+	parts := strings.Split(path, ":")
+	if len(parts) != 3 || parts[2] == "" {
+		return "", errors.Wrapf(errdefs.ErrInvalidArgument, "cgroups path %q must be slice:prefix:name with the systemd cgroup driver", path)
+	}
+	slice, prefix, name := parts[0], parts[1], parts[2]
+	if slice == "" {
+		slice = parent
+	}
+	dir, err := expandSlice(slice)
+	if err != nil {
+		return "", err
+	}
+	unit := name + ".scope"
+	if prefix != "" {
+		unit = prefix + "-" + unit
+	}
+	return filepath.Join(dir, unit), nil
+}
+
+// expandSlice returns the path of the slice in the cgroup hierarchy, each
+// dash of its name nesting it in a parent slice as done by systemd
+func expandSlice(slice string) (string, error) {
+	name := strings.TrimSuffix(slice, ".slice")
+	if name == slice || strings.Contains(name, "/") {
+		return "", errors.Wrapf(errdefs.ErrInvalidArgument, "invalid slice %q", slice)
+	}
+	if name == "-" {
+		return "/", nil
+	}
+	path, prefix := "/", ""
+	for _, component := range strings.Split(name, "-") {
+		if component == "" {
+			return "", errors.Wrapf(errdefs.ErrInvalidArgument, "invalid slice %q", slice)
+		}
+		prefix += component
+		path = filepath.Join(path, prefix+".slice")
+		prefix += "-"
+	}
+	return path, nil
+}
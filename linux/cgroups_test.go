@@ -0,0 +1,46 @@
+// +build linux
+
+package linux
+
+import (
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+)
+
+func TestCgroupsPath(t *testing.T) {
+	for _, tc := range []struct {
+		path, parent string
+		systemd      bool
+		expected     string
+	}{
+		{path: "", parent: "/containerd", expected: "/containerd/test"},
+		{path: "pods/web", parent: "/kubepods", expected: "/kubepods/pods/web"},
+		{path: "/explicit", parent: "/containerd", expected: "/explicit"},
+		{path: "", systemd: true, expected: "/system.slice/containerd-test.scope"},
+		{path: "", parent: "machine.slice", systemd: true, expected: "/machine.slice/containerd-test.scope"},
+		{path: "kubepods-burstable.slice:cri:web", systemd: true, expected: "/kubepods.slice/kubepods-burstable.slice/cri-web.scope"},
+		{path: ":docker:web", parent: "user-1000.slice", systemd: true, expected: "/user.slice/user-1000.slice/docker-web.scope"},
+		{path: "-.slice::web", systemd: true, expected: "/web.scope"},
+	} {
+		path, err := cgroupsPath(tc.path, "test", tc.parent, tc.systemd)
+		if err != nil {
+			t.Fatalf("%q under %q: %v", tc.path, tc.parent, err)
+		}
+		if path != tc.expected {
+			t.Errorf("%q under %q: expected %s but received %s", tc.path, tc.parent, tc.expected, path)
+		}
+	}
+	for _, tc := range []struct {
+		path, parent string
+	}{
+		{path: "/system.slice/test"},
+		{path: "", parent: "/containerd"},
+		{path: "system.slice:containerd:"},
+		{path: "a--b.slice:containerd:test"},
+	} {
+		if _, err := cgroupsPath(tc.path, "test", tc.parent, true); !errdefs.IsInvalidArgument(err) {
+			t.Errorf("%q under %q: expected an invalid argument but received %v", tc.path, tc.parent, err)
+		}
+	}
+}
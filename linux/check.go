@@ -0,0 +1,101 @@
+// +build linux
+
+package linux
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/plugin"
+	"github.com/pkg/errors"
+)
+
+// checkTimeout bounds the request for the info of each shim during a check
+const checkTimeout = 5 * time.Second
+
+// Check verifies that the shim of each task in the state of the runtime
+// responds and that the rootfs of the task is mounted in the mount
+// namespace of its shim. The tasks are only connected to, tasks whose shim
+// is gone are reported rather than cleaned up.
+func Check(ic *plugin.InitContext) []plugin.CheckResult {
+	config := ic.Config.(*Config)
+	// tasks without a shim do not outlive the daemon
+	if config.NoShim {
+		return nil
+	}
+	nss, err := ioutil.ReadDir(ic.State)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return []plugin.CheckResult{{
+			Name: "tasks",
+			Err:  errors.Wrap(err, "failed to read the state of the runtime"),
+		}}
+	}
+	var results []plugin.CheckResult
+	for _, namespace := range nss {
+		ns := namespace.Name()
+		if !namespace.IsDir() || ns == mountsDir || ns == poolDir {
+			continue
+		}
+		dirs, err := ioutil.ReadDir(filepath.Join(ic.State, ns))
+		if err != nil {
+			results = append(results, plugin.CheckResult{
+				Name: fmt.Sprintf("namespace %s", ns),
+				Err:  errors.Wrap(err, "failed to read the tasks of the namespace"),
+			})
+			continue
+		}
+		for _, dir := range dirs {
+			if !dir.IsDir() {
+				continue
+			}
+			id := dir.Name()
+			bundle := loadBundle(filepath.Join(ic.State, ns, id), filepath.Join(ic.Root, ns, id), ns, id, nil)
+			results = append(results, plugin.CheckResult{
+				Name: fmt.Sprintf("task %s/%s", ns, id),
+				Err:  checkTask(ic.Context, bundle, config.StrictState),
+			})
+		}
+	}
+	return results
+}
+
+func checkTask(ctx context.Context, bundle *bundle, strict bool) error {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	s, err := bundle.Connect(ctx, true)
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to shim")
+	}
+	defer s.Close()
+	info, err := s.ShimInfo(ctx, empty)
+	if err != nil {
+		return errors.Wrap(errdefs.FromGRPC(err), "shim is not responding")
+	}
+	opts, err := bundle.LoadCreateOpts(strict)
+	if err != nil {
+		return errors.Wrap(err, "failed to load task create options")
+	}
+	if len(opts.Rootfs) == 0 {
+		return nil
+	}
+	mounts, err := mount.PID(int(info.ShimPid))
+	if err != nil {
+		return errors.Wrapf(err, "failed to read the mounts of shim %d", info.ShimPid)
+	}
+	rootfs := filepath.Join(bundle.path, "rootfs")
+	for _, m := range mounts {
+		if m.Mountpoint == rootfs {
+			return nil
+		}
+	}
+	return errors.Errorf("rootfs %s is not mounted", rootfs)
+}
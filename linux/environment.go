@@ -0,0 +1,101 @@
+// +build linux
+
+package linux
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/containerd/containerd/errdefs"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	// zoneinfoDir holds the timezone data of the host
+	zoneinfoDir = "/usr/share/zoneinfo"
+	// localtime is the timezone of the host, and where the timezone is
+	// bound in containers
+	localtime = "/etc/localtime"
+)
+
+var localeRegexp = regexp.MustCompile(`^[A-Za-z0-9_.@-]+$`)
+
+// withEnvironment returns the spec with the timezone of the host, or the
+// named timezone from the zoneinfo of the host, bound to /etc/localtime and
+// TZ and LANG set for the process, so that images without timezone and
+// locale data do not need to bring their own
+func withEnvironment(data []byte, timezone, locale, zoneinfo string) ([]byte, error) {
+	if timezone == "" && locale == "" {
+		return data, nil
+	}
+	var spec specs.Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, err
+	}
+	if spec.Process == nil {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, "spec has no process")
+	}
+	if timezone != "" {
+		source, err := zoneinfoPath(zoneinfo, timezone)
+		if err != nil {
+			return nil, err
+		}
+		var mounts []specs.Mount
+		for _, m := range spec.Mounts {
+			if filepath.Clean(m.Destination) != localtime {
+				mounts = append(mounts, m)
+			}
+		}
+		spec.Mounts = append(mounts, specs.Mount{
+			Destination: localtime,
+			Type:        "bind",
+			Source:      source,
+			Options:     []string{"rbind", "ro"},
+		})
+		// a TZ naming the file is understood by libc and Go without any
+		// zoneinfo in the image
+		spec.Process.Env = setEnv(spec.Process.Env, "TZ", ":"+localtime)
+	}
+	if locale != "" {
+		if !localeRegexp.MatchString(locale) {
+			return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid locale %q", locale)
+		}
+		spec.Process.Env = setEnv(spec.Process.Env, "LANG", locale)
+	}
+	return json.Marshal(spec)
+}
+
+// zoneinfoPath returns the file of the timezone, the timezone of the host
+// for "host"
+func zoneinfoPath(zoneinfo, timezone string) (string, error) {
+	if timezone == "host" {
+		return filepath.EvalSymlinks(localtime)
+	}
+	name := filepath.Clean(timezone)
+	if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+		return "", errors.Wrapf(errdefs.ErrInvalidArgument, "invalid timezone %q", timezone)
+	}
+	path := filepath.Join(zoneinfo, name)
+	fi, err := os.Stat(path)
+	if err != nil || !fi.Mode().IsRegular() {
+		return "", errors.Wrapf(errdefs.ErrInvalidArgument, "unknown timezone %q", timezone)
+	}
+	return path, nil
+}
+
+// setEnv sets the variable in the environment, replacing its value if it
+// is already set
+func setEnv(env []string, key, value string) []string {
+	prefix := key + "="
+	var out []string
+	for _, e := range env {
+		if !strings.HasPrefix(e, prefix) {
+			out = append(out, e)
+		}
+	}
+	return append(out, prefix+value)
+}
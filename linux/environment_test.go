@@ -0,0 +1,76 @@
+// +build linux
+
+package linux
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestWithEnvironment(t *testing.T) {
+	zoneinfo, err := ioutil.TempDir("", "zoneinfo-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(zoneinfo)
+	if err := os.MkdirAll(filepath.Join(zoneinfo, "Europe"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	paris := filepath.Join(zoneinfo, "Europe", "Paris")
+	if err := ioutil.WriteFile(paris, []byte("TZif"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(specs.Spec{
+		Process: &specs.Process{Env: []string{"PATH=/bin", "TZ=UTC"}},
+		Mounts:  []specs.Mount{{Destination: "/etc/localtime", Source: "/etc/localtime"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unchanged, err := withEnvironment(data, "", "", zoneinfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(unchanged) != string(data) {
+		t.Fatal("expected the spec to be unchanged without timezone and locale")
+	}
+
+	data, err = withEnvironment(data, "Europe/Paris", "fr_FR.UTF-8", zoneinfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var spec specs.Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		t.Fatal(err)
+	}
+	if len(spec.Mounts) != 1 || spec.Mounts[0].Source != paris || spec.Mounts[0].Destination != "/etc/localtime" {
+		t.Fatalf("unexpected mounts %+v", spec.Mounts)
+	}
+	expected := []string{"PATH=/bin", "TZ=:/etc/localtime", "LANG=fr_FR.UTF-8"}
+	if len(spec.Process.Env) != len(expected) {
+		t.Fatalf("expected env %v, got %v", expected, spec.Process.Env)
+	}
+	for i := range expected {
+		if spec.Process.Env[i] != expected[i] {
+			t.Fatalf("expected env %v, got %v", expected, spec.Process.Env)
+		}
+	}
+
+	for _, c := range []struct{ timezone, locale string }{
+		{"../../etc/shadow", ""},
+		{"/etc/localtime", ""},
+		{"Mars/Olympus", ""},
+		{"Europe", ""},
+		{"", "en_US; rm -rf /"},
+	} {
+		if _, err := withEnvironment(data, c.timezone, c.locale, zoneinfo); err == nil {
+			t.Errorf("expected an error for timezone %q and locale %q", c.timezone, c.locale)
+		}
+	}
+}
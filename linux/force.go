@@ -0,0 +1,141 @@
+// +build linux
+
+package linux
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/containerd/cgroups"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/containerd/sys"
+	runc "github.com/containerd/go-runc"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+var _ runtime.ForceDeleter = &Runtime{}
+
+// ForceDelete kills the processes of the task and deletes it. When the shim
+// or runc fail to delete the task, the process group of the container is
+// killed with runc, its leftover rootfs mounts are unmounted and its cgroup
+// and bundle are removed so that the task does not stay in the runtime.
+func (r *Runtime) ForceDelete(ctx context.Context, c runtime.Task) (*runtime.Exit, error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if bt, ok := c.(*brokenTask); ok {
+		return r.deleteBroken(ctx, bt)
+	}
+	lc, ok := c.(*Task)
+	if !ok {
+		return nil, errors.New("task cannot be cast as *linux.Task")
+	}
+	if err := r.monitor.Stop(lc); err != nil {
+		log.G(ctx).WithError(err).WithField("id", lc.id).Warn("failed to stop monitoring task")
+	}
+	dctx, cancel := withTimeout(ctx, r.timeouts.delete)
+	if err := lc.Kill(dctx, uint32(unix.SIGKILL), true); err != nil {
+		log.G(ctx).WithError(err).WithField("id", lc.id).Debug("failed to kill task")
+	}
+	exit, err := lc.Delete(dctx)
+	cancel()
+	if err != nil {
+		log.G(ctx).WithError(err).WithField("id", lc.id).Warn("failed to delete task, purging its state")
+		exit = &runtime.Exit{
+			Status:    255,
+			Timestamp: time.Now(),
+		}
+	}
+	if err := lc.shim.KillShim(ctx); err != nil {
+		log.G(ctx).WithError(err).Error("failed to kill shim")
+	}
+	r.tasks.Delete(ctx, lc)
+
+	bundle := loadBundle(
+		filepath.Join(r.state, namespace, lc.id),
+		filepath.Join(r.root, namespace, lc.id),
+		namespace,
+		lc.id,
+		r.events,
+	)
+	if err != nil {
+		r.purge(ctx, bundle, namespace, lc.id)
+	}
+	if err := r.mounts.remove(ctx, namespace, lc.id); err != nil {
+		log.G(ctx).WithError(err).WithField("id", lc.id).Warn("failed to unmount rootfs")
+	}
+	if err := bundle.Delete(); err != nil {
+		return nil, errors.Wrap(err, "failed to delete bundle")
+	}
+	return exit, nil
+}
+
+// purge kills the processes of the container and removes its runtime state,
+// its rootfs mounts and its cgroup, logging what cannot be removed
+func (r *Runtime) purge(ctx context.Context, bundle *bundle, ns, id string) {
+	ctx = namespaces.WithNamespace(ctx, ns)
+	logger := log.G(ctx).WithField("id", id)
+	rt, err := r.getRuntime(ctx, ns, id)
+	if err != nil {
+		logger.WithError(err).Warn("failed to get runtime of task")
+	} else {
+		if err := rt.Kill(ctx, id, int(unix.SIGKILL), &runc.KillOpts{All: true}); err != nil {
+			logger.WithError(err).Debug("failed to kill processes of task")
+		}
+		if err := rt.Delete(ctx, id, &runc.DeleteOpts{Force: true}); err != nil {
+			logger.WithError(err).Warn("failed to delete runtime state")
+		}
+	}
+	if err := mount.UnmountAll(filepath.Join(bundle.path, "rootfs"), unix.MNT_DETACH); err != nil {
+		logger.WithError(err).Warn("failed to unmount task rootfs")
+	}
+	if err := removeCgroup(bundle.path); err != nil {
+		logger.WithError(err).Warn("failed to remove cgroup of task")
+	}
+}
+
+// removeCgroup removes the cgroup of the spec of the bundle, from the cgroup2
+// hierarchy on unified hosts. Cgroups managed by systemd are removed by
+// systemd once their processes exit.
+func removeCgroup(path string) error {
+	f, err := os.Open(filepath.Join(path, configFilename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+	var spec specs.Spec
+	if err := json.NewDecoder(f).Decode(&spec); err != nil {
+		return err
+	}
+	if spec.Linux == nil || !strings.HasPrefix(spec.Linux.CgroupsPath, "/") || filepath.Ext(spec.Linux.CgroupsPath) == ".scope" {
+		return nil
+	}
+	if sys.IsCgroupUnified() {
+		err := os.Remove(filepath.Join(sys.CgroupUnifiedMountpoint, spec.Linux.CgroupsPath))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	cg, err := cgroups.Load(cgroups.V1, cgroups.StaticPath(spec.Linux.CgroupsPath))
+	if err != nil {
+		if err == cgroups.ErrCgroupDeleted {
+			return nil
+		}
+		return err
+	}
+	return cg.Delete()
+}
@@ -0,0 +1,39 @@
+// +build linux
+
+package linux
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRemoveCgroupSkipsUnmanaged(t *testing.T) {
+	dir, err := ioutil.TempDir("", "force-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// a bundle whose config was never written has no cgroup to remove
+	if err := removeCgroup(dir); err != nil {
+		t.Fatalf("expected no error without a config, got %v", err)
+	}
+	// cgroups of systemd are removed by systemd
+	for _, path := range []string{"system.slice:containerd:test", "/system.slice/containerd-test.scope"} {
+		config := `{"linux":{"cgroupsPath":"` + path + `"}}`
+		if err := ioutil.WriteFile(filepath.Join(dir, configFilename), []byte(config), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := removeCgroup(dir); err != nil {
+			t.Fatalf("expected no error for the systemd cgroup %s, got %v", path, err)
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, configFilename), []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := removeCgroup(dir); err == nil {
+		t.Fatal("expected an error for a corrupt config")
+	}
+}
@@ -0,0 +1,56 @@
+// +build linux
+
+package linux
+
+import (
+	"context"
+	"time"
+
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/runtime"
+	metrics "github.com/docker/go-metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// shimInfoTimeout bounds the time waiting for a shim while collecting metrics
+const shimInfoTimeout = time.Second
+
+// ioCollector exports the bytes of stdio copied by the shim of each task
+type ioCollector struct {
+	tasks *runtime.TaskList
+	bytes *prometheus.Desc
+}
+
+func newIOCollector(ns *metrics.Namespace, tasks *runtime.TaskList) *ioCollector {
+	return &ioCollector{
+		tasks: tasks,
+		bytes: ns.NewDesc("io_bytes", "The bytes of stdio copied by the shim of a task by stream", metrics.Total, "container_id", "namespace", "stream"),
+	}
+}
+
+func (c *ioCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.bytes
+}
+
+func (c *ioCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, rt := range c.tasks.All() {
+		t, ok := rt.(*Task)
+		if !ok {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), shimInfoTimeout)
+		info, err := t.shim.ShimInfo(ctx, empty)
+		cancel()
+		if err != nil {
+			log.G(ctx).WithError(err).WithField("id", t.id).Debug("get shim info for metrics")
+			continue
+		}
+		for stream, n := range map[string]uint64{
+			"stdin":  info.StdinBytes,
+			"stdout": info.StdoutBytes,
+			"stderr": info.StderrBytes,
+		} {
+			ch <- prometheus.MustNewConstMetric(c.bytes, prometheus.CounterValue, float64(n), t.id, t.namespace, stream)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+// +build linux
+
+package linux
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/mount"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// mountsDir is the directory of the state of the runtime holding the records
+// of the rootfs mounts, which is not a valid namespace
+const mountsDir = ".mounts"
+
+// mountRecord is the rootfs of a task, recorded before it is mounted
+type mountRecord struct {
+	Target string        `json:"target"`
+	Mounts []mount.Mount `json:"mounts"`
+}
+
+// mountManager records the rootfs mounts of tasks in the state of the
+// runtime, so that they are unmounted when the task is deleted even if the
+// shim failed to, and that the mounts of tasks lost in a crash of the daemon
+// are found when it starts again. It counts the references of the tasks to
+// the lower directories of their overlay mounts, which are shared between
+// the tasks of containers of the same image.
+type mountManager struct {
+	root string
+
+	mu      sync.Mutex
+	records map[string]mountRecord
+	lowers  map[string]int
+}
+
+func newMountManager(root string) (*mountManager, error) {
+	m := &mountManager{
+		root:    root,
+		records: make(map[string]mountRecord),
+		lowers:  make(map[string]int),
+	}
+	namespaces, err := ioutil.ReadDir(root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return m, nil
+		}
+		return nil, err
+	}
+	for _, ns := range namespaces {
+		files, err := ioutil.ReadDir(filepath.Join(root, ns.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			id := strings.TrimSuffix(f.Name(), ".json")
+			if id == f.Name() {
+				continue
+			}
+			data, err := ioutil.ReadFile(filepath.Join(root, ns.Name(), f.Name()))
+			if err != nil {
+				return nil, err
+			}
+			var r mountRecord
+			if err := json.Unmarshal(data, &r); err != nil {
+				return nil, errors.Wrapf(err, "invalid mount record %s/%s", ns.Name(), id)
+			}
+			m.records[mountKey(ns.Name(), id)] = r
+			m.reference(r.Mounts, 1)
+		}
+	}
+	return m, nil
+}
+
+func mountKey(ns, id string) string {
+	return filepath.Join(ns, id)
+}
+
+// lowerDirs returns the lower directories of the overlay mounts
+func lowerDirs(mounts []mount.Mount) []string {
+	var dirs []string
+	for _, m := range mounts {
+		if m.Type != "overlay" {
+			continue
+		}
+		if v, ok := m.Option("lowerdir"); ok {
+			dirs = append(dirs, strings.Split(v, ":")...)
+		}
+	}
+	return dirs
+}
+
+func (m *mountManager) reference(mounts []mount.Mount, delta int) {
+	for _, dir := range lowerDirs(mounts) {
+		if m.lowers[dir] += delta; m.lowers[dir] <= 0 {
+			delete(m.lowers, dir)
+		}
+	}
+}
+
+// add records the rootfs mounts of the task at the target before they are
+// mounted
+func (m *mountManager) add(ns, id, target string, mounts []mount.Mount) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := mountKey(ns, id)
+	if _, ok := m.records[key]; ok {
+		return errors.Errorf("mounts of task %s already recorded", key)
+	}
+	r := mountRecord{
+		Target: target,
+		Mounts: mounts,
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Join(m.root, ns), 0711); err != nil {
+		return err
+	}
+	path := filepath.Join(m.root, ns, id+".json")
+	if err := ioutil.WriteFile(path+".tmp", data, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		os.Remove(path + ".tmp")
+		return err
+	}
+	m.records[key] = r
+	m.reference(mounts, 1)
+	return nil
+}
+
+// remove unmounts the rootfs of the task, if it is still mounted, and
+// removes its record
+func (m *mountManager) remove(ctx context.Context, ns, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := mountKey(ns, id)
+	r, ok := m.records[key]
+	if !ok {
+		return nil
+	}
+	if err := unmountRootfs(r.Target); err != nil {
+		return err
+	}
+	if err := os.Remove(filepath.Join(m.root, ns, id+".json")); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	delete(m.records, key)
+	m.reference(r.Mounts, -1)
+	return nil
+}
+
+// references returns the number of tasks mounting the lower directory
+func (m *mountManager) references(dir string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lowers[dir]
+}
+
+// sweep unmounts the rootfs of the tasks that are not live, those recorded
+// and those mounted under the state of the runtime without a record because
+// the daemon crashed before recording them
+func (m *mountManager) sweep(ctx context.Context, state string, live func(ns, id string) bool) {
+	m.mu.Lock()
+	var orphans [][2]string
+	for key := range m.records {
+		ns, id := filepath.Split(key)
+		if ns = filepath.Clean(ns); !live(ns, id) {
+			orphans = append(orphans, [2]string{ns, id})
+		}
+	}
+	m.mu.Unlock()
+	for _, o := range orphans {
+		log.G(ctx).WithField("id", o[1]).WithField("namespace", o[0]).Info("unmounting rootfs of orphaned task")
+		if err := m.remove(ctx, o[0], o[1]); err != nil {
+			log.G(ctx).WithError(err).WithField("id", o[1]).Warn("failed to unmount rootfs of orphaned task")
+		}
+	}
+	infos, err := mount.Self()
+	if err != nil {
+		log.G(ctx).WithError(err).Warn("failed to read mounts to sweep")
+		return
+	}
+	for _, info := range infos {
+		rel, err := filepath.Rel(state, info.Mountpoint)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			continue
+		}
+		parts := strings.Split(rel, string(filepath.Separator))
+		if len(parts) != 3 || parts[2] != "rootfs" || live(parts[0], parts[1]) {
+			continue
+		}
+		log.G(ctx).WithField("mountpoint", info.Mountpoint).Info("unmounting orphaned rootfs")
+		if err := unmountRootfs(info.Mountpoint); err != nil {
+			log.G(ctx).WithError(err).WithField("mountpoint", info.Mountpoint).Warn("failed to unmount orphaned rootfs")
+		}
+	}
+}
+
+// unmountRootfs unmounts all the mounts of the rootfs, detaching them when
+// they are still busy
+func unmountRootfs(target string) error {
+	if _, err := os.Stat(target); os.IsNotExist(err) {
+		return nil
+	}
+	if err := mount.UnmountAll(target, 0); err != nil {
+		switch err {
+		case unix.ENOENT:
+			return nil
+		case unix.EBUSY:
+			return mount.UnmountAll(target, unix.MNT_DETACH)
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+// +build linux
+
+package linux
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containerd/containerd/mount"
+)
+
+func TestMountManagerReferences(t *testing.T) {
+	root, err := ioutil.TempDir("", "mounts-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	overlay := func(lowers string) []mount.Mount {
+		return []mount.Mount{
+			{
+				Type:    "overlay",
+				Source:  "overlay",
+				Options: []string{"workdir=/w", "upperdir=/u", "lowerdir=" + lowers},
+			},
+		}
+	}
+	m, err := newMountManager(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the targets do not exist, so there is nothing to unmount
+	if err := m.add("default", "a", filepath.Join(root, "a"), overlay("/l2:/l1")); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.add("default", "b", filepath.Join(root, "b"), overlay("/l3:/l1")); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.add("default", "a", filepath.Join(root, "a"), nil); err == nil {
+		t.Fatal("expected an error recording the mounts of a task twice")
+	}
+	if n := m.references("/l1"); n != 2 {
+		t.Fatalf("expected 2 references to the shared lower dir, got %d", n)
+	}
+
+	// the records are reloaded after a restart
+	if m, err = newMountManager(root); err != nil {
+		t.Fatal(err)
+	}
+	if n := m.references("/l1"); n != 2 {
+		t.Fatalf("expected 2 references after reloading, got %d", n)
+	}
+	if err := m.remove(context.Background(), "default", "a"); err != nil {
+		t.Fatal(err)
+	}
+	if n := m.references("/l1"); n != 1 {
+		t.Fatalf("expected 1 reference after removing a task, got %d", n)
+	}
+	if n := m.references("/l2"); n != 0 {
+		t.Fatalf("expected no reference to the lower dir of the removed task, got %d", n)
+	}
+
+	var swept []string
+	m.sweep(context.Background(), filepath.Join(root, "state"), func(ns, id string) bool {
+		swept = append(swept, ns+"/"+id)
+		return false
+	})
+	if len(swept) != 1 || swept[0] != "default/b" {
+		t.Fatalf("expected the record of b to be swept, got %v", swept)
+	}
+	if n := m.references("/l1"); n != 0 {
+		t.Fatalf("expected no reference after sweeping, got %d", n)
+	}
+}
@@ -60,6 +60,8 @@ func (p *Process) State(ctx context.Context) (runtime.State, error) {
 		Stderr:     response.Stderr,
 		Terminal:   response.Terminal,
 		ExitStatus: response.ExitStatus,
+		ExitedAt:   response.ExitedAt,
+		StartedAt:  response.StartedAt,
 	}, nil
 }
 
@@ -87,6 +89,8 @@ func (p *Process) CloseIO(ctx context.Context) error {
 }
 
 func (p *Process) Start(ctx context.Context) error {
+	ctx, cancel := withTimeout(ctx, p.t.startTimeout)
+	defer cancel()
 	_, err := p.t.shim.Start(ctx, &shim.StartRequest{
 		ID: p.id,
 	})
@@ -95,3 +99,18 @@ func (p *Process) Start(ctx context.Context) error {
 	}
 	return nil
 }
+
+// UpdateProcess amends the spec of the exec process, which the shim holds
+// until the process is started
+func (p *Process) UpdateProcess(ctx context.Context, u runtime.ProcessUpdate) error {
+	_, err := p.t.shim.UpdateProcess(ctx, &shim.UpdateProcessRequest{
+		ID:   p.id,
+		Env:  u.Env,
+		Args: u.Args,
+		Cwd:  u.Cwd,
+	})
+	if err != nil {
+		return errdefs.FromGRPC(err)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+// +build linux
+
+package linux
+
+import (
+	"time"
+
+	client "github.com/containerd/containerd/linux/shim"
+	"github.com/pkg/errors"
+)
+
+// ExecConfig bounds the exec processes that exited and were not deleted by
+// their client, whose exit status the shims retain so that it is not missed
+type ExecConfig struct {
+	// MaxExited is the number of exited exec processes retained by each
+	// shim, the oldest being deleted first. The shim default applies when
+	// it is zero and there is no limit when it is negative.
+	MaxExited int `toml:"max_exited,omitempty"`
+	// TTL is how long an exited exec process is retained, such as "1h". The
+	// shim default applies when it is empty and "-1s" retains them forever.
+	TTL string `toml:"ttl,omitempty"`
+}
+
+func newExecRetention(config ExecConfig) (client.ExecRetention, error) {
+	r := client.ExecRetention{
+		MaxExited: config.MaxExited,
+	}
+	if config.TTL != "" {
+		d, err := time.ParseDuration(config.TTL)
+		if err != nil {
+			return r, errors.Wrapf(err, "invalid exec ttl %q", config.TTL)
+		}
+		if d == 0 {
+			return r, errors.Errorf("invalid exec ttl %q", config.TTL)
+		}
+		r.TTL = d
+	}
+	return r, nil
+}
@@ -0,0 +1,44 @@
+// +build linux
+
+package linux
+
+import (
+	"encoding/json"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/linux/runcopts"
+	"github.com/containerd/containerd/runtime"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// errRootless is the cause of the errors of operations that require real
+// root, which a rootless containerd refuses rather than letting them fail in
+// the runtime with an obscure error
+var errRootless = errors.Wrap(errdefs.ErrFailedPrecondition, "containerd is running rootless")
+
+// checkRootless returns an error if the task requires real root, or cgroups
+// that are not delegated to the user of a rootless containerd
+func checkRootless(spec []byte, opts runtime.CreateOpts, options runcopts.CreateOptions, delegated bool) error {
+	if opts.Checkpoint != "" {
+		return errors.Wrap(errRootless, "restoring a checkpoint requires root")
+	}
+	if delegated {
+		return nil
+	}
+	if options.ShimCgroup != "" {
+		return errors.Wrap(errRootless, "placing the shim in a cgroup requires a delegated cgroup")
+	}
+	var s specs.Spec
+	if err := json.Unmarshal(spec, &s); err != nil {
+		return err
+	}
+	if s.Linux == nil || s.Linux.Resources == nil {
+		return nil
+	}
+	r := s.Linux.Resources
+	if r.Memory != nil || r.CPU != nil || r.Pids != nil || r.BlockIO != nil || len(r.HugepageLimits) > 0 {
+		return errors.Wrap(errRootless, "resource limits require a delegated cgroup")
+	}
+	return nil
+}
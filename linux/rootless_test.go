@@ -0,0 +1,56 @@
+// +build linux
+
+package linux
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/linux/runcopts"
+	"github.com/containerd/containerd/runtime"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestCheckRootless(t *testing.T) {
+	limit := int64(1 << 20)
+	limited, err := json.Marshal(specs.Spec{
+		Linux: &specs.Linux{
+			Resources: &specs.LinuxResources{
+				Memory: &specs.LinuxMemory{Limit: &limit},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	unlimited, err := json.Marshal(specs.Spec{Linux: &specs.Linux{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name      string
+		spec      []byte
+		opts      runtime.CreateOpts
+		options   runcopts.CreateOptions
+		delegated bool
+		refused   bool
+	}{
+		{name: "unlimited", spec: unlimited},
+		{name: "limited", spec: limited, refused: true},
+		{name: "limited delegated", spec: limited, delegated: true},
+		{name: "shim cgroup", spec: unlimited, options: runcopts.CreateOptions{ShimCgroup: "/shim"}, refused: true},
+		{name: "checkpoint", spec: unlimited, opts: runtime.CreateOpts{Checkpoint: "/checkpoint"}, delegated: true, refused: true},
+	} {
+		err := checkRootless(tc.spec, tc.opts, tc.options, tc.delegated)
+		if !tc.refused {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", tc.name, err)
+			}
+			continue
+		}
+		if !errdefs.IsFailedPrecondition(err) {
+			t.Errorf("%s: expected failed precondition, got %v", tc.name, err)
+		}
+	}
+}
@@ -55,6 +55,26 @@ type CreateOptions struct {
 	CgroupsMode         string   `protobuf:"bytes,7,opt,name=cgroups_mode,json=cgroupsMode,proto3" json:"cgroups_mode,omitempty"`
 	NoNewKeyring        bool     `protobuf:"varint,8,opt,name=no_new_keyring,json=noNewKeyring,proto3" json:"no_new_keyring,omitempty"`
 	ShimCgroup          string   `protobuf:"bytes,9,opt,name=shim_cgroup,json=shimCgroup,proto3" json:"shim_cgroup,omitempty"`
+	// timezone of the process, "host" for the timezone of the host or a
+	// name such as Europe/Paris. The zoneinfo of the host is bound to
+	// /etc/localtime so that images without timezone data can use it.
+	Timezone string `protobuf:"bytes,10,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// locale of the process, such as en_US.UTF-8, set as LANG
+	Locale string `protobuf:"bytes,11,opt,name=locale,proto3" json:"locale,omitempty"`
+	// owner of the stdio pipes of the processes, so that the processes
+	// running as the root of a user namespace can reopen their stdio. The
+	// runtime sets them to the host ids of the root of the user namespace of
+	// the container when they are both zero.
+	IoUid uint32 `protobuf:"varint,12,opt,name=io_uid,json=ioUid,proto3" json:"io_uid,omitempty"`
+	IoGid uint32 `protobuf:"varint,13,opt,name=io_gid,json=ioGid,proto3" json:"io_gid,omitempty"`
+	// cgroup_parent is the cgroup under which the cgroup of the container is
+	// created when its spec sets no absolute cgroup, a slice such as
+	// machine.slice with the systemd cgroup driver
+	CgroupParent string `protobuf:"bytes,14,opt,name=cgroup_parent,json=cgroupParent,proto3" json:"cgroup_parent,omitempty"`
+	// systemd_cgroup manages the cgroup of the container as a transient
+	// systemd scope delegated to the container, the cgroups path of the spec
+	// being in the "slice:prefix:name" form
+	SystemdCgroup bool `protobuf:"varint,15,opt,name=systemd_cgroup,json=systemdCgroup,proto3" json:"systemd_cgroup,omitempty"`
 }
 
 func (m *CreateOptions) Reset()                    { *m = CreateOptions{} }
@@ -69,6 +89,10 @@ type CheckpointOptions struct {
 	FileLocks           bool     `protobuf:"varint,5,opt,name=file_locks,json=fileLocks,proto3" json:"file_locks,omitempty"`
 	EmptyNamespaces     []string `protobuf:"bytes,6,rep,name=empty_namespaces,json=emptyNamespaces" json:"empty_namespaces,omitempty"`
 	CgroupsMode         string   `protobuf:"bytes,7,opt,name=cgroups_mode,json=cgroupsMode,proto3" json:"cgroups_mode,omitempty"`
+	// pre_dump dumps the memory of the task while it keeps running, so that
+	// a checkpoint with the pre-dump as parent only dumps the memory changed
+	// since
+	PreDump bool `protobuf:"varint,8,opt,name=pre_dump,json=preDump,proto3" json:"pre_dump,omitempty"`
 }
 
 func (m *CheckpointOptions) Reset()                    { *m = CheckpointOptions{} }
@@ -212,6 +236,44 @@ func (m *CreateOptions) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRunc(dAtA, i, uint64(len(m.ShimCgroup)))
 		i += copy(dAtA[i:], m.ShimCgroup)
 	}
+	if len(m.Timezone) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintRunc(dAtA, i, uint64(len(m.Timezone)))
+		i += copy(dAtA[i:], m.Timezone)
+	}
+	if len(m.Locale) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRunc(dAtA, i, uint64(len(m.Locale)))
+		i += copy(dAtA[i:], m.Locale)
+	}
+	if m.IoUid != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintRunc(dAtA, i, uint64(m.IoUid))
+	}
+	if m.IoGid != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintRunc(dAtA, i, uint64(m.IoGid))
+	}
+	if len(m.CgroupParent) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintRunc(dAtA, i, uint64(len(m.CgroupParent)))
+		i += copy(dAtA[i:], m.CgroupParent)
+	}
+	if m.SystemdCgroup {
+		dAtA[i] = 0x78
+		i++
+		if m.SystemdCgroup {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -301,6 +363,16 @@ func (m *CheckpointOptions) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRunc(dAtA, i, uint64(len(m.CgroupsMode)))
 		i += copy(dAtA[i:], m.CgroupsMode)
 	}
+	if m.PreDump {
+		dAtA[i] = 0x40
+		i++
+		if m.PreDump {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -380,6 +452,27 @@ func (m *CreateOptions) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRunc(uint64(l))
 	}
+	l = len(m.Timezone)
+	if l > 0 {
+		n += 1 + l + sovRunc(uint64(l))
+	}
+	l = len(m.Locale)
+	if l > 0 {
+		n += 1 + l + sovRunc(uint64(l))
+	}
+	if m.IoUid != 0 {
+		n += 1 + sovRunc(uint64(m.IoUid))
+	}
+	if m.IoGid != 0 {
+		n += 1 + sovRunc(uint64(m.IoGid))
+	}
+	l = len(m.CgroupParent)
+	if l > 0 {
+		n += 1 + l + sovRunc(uint64(l))
+	}
+	if m.SystemdCgroup {
+		n += 2
+	}
 	return n
 }
 
@@ -411,6 +504,9 @@ func (m *CheckpointOptions) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRunc(uint64(l))
 	}
+	if m.PreDump {
+		n += 2
+	}
 	return n
 }
 
@@ -452,6 +548,12 @@ func (this *CreateOptions) String() string {
 		`CgroupsMode:` + fmt.Sprintf("%v", this.CgroupsMode) + `,`,
 		`NoNewKeyring:` + fmt.Sprintf("%v", this.NoNewKeyring) + `,`,
 		`ShimCgroup:` + fmt.Sprintf("%v", this.ShimCgroup) + `,`,
+		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
+		`Locale:` + fmt.Sprintf("%v", this.Locale) + `,`,
+		`IoUid:` + fmt.Sprintf("%v", this.IoUid) + `,`,
+		`IoGid:` + fmt.Sprintf("%v", this.IoGid) + `,`,
+		`CgroupParent:` + fmt.Sprintf("%v", this.CgroupParent) + `,`,
+		`SystemdCgroup:` + fmt.Sprintf("%v", this.SystemdCgroup) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -468,6 +570,7 @@ func (this *CheckpointOptions) String() string {
 		`FileLocks:` + fmt.Sprintf("%v", this.FileLocks) + `,`,
 		`EmptyNamespaces:` + fmt.Sprintf("%v", this.EmptyNamespaces) + `,`,
 		`CgroupsMode:` + fmt.Sprintf("%v", this.CgroupsMode) + `,`,
+		`PreDump:` + fmt.Sprintf("%v", this.PreDump) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -824,6 +927,151 @@ func (m *CreateOptions) Unmarshal(dAtA []byte) error {
 			}
 			m.ShimCgroup = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRunc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRunc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locale", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRunc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRunc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locale = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IoUid", wireType)
+			}
+			m.IoUid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRunc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IoUid |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IoGid", wireType)
+			}
+			m.IoGid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRunc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IoGid |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CgroupParent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRunc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRunc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CgroupParent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemdCgroup", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRunc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SystemdCgroup = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRunc(dAtA[iNdEx:])
@@ -1032,6 +1280,26 @@ func (m *CheckpointOptions) Unmarshal(dAtA []byte) error {
 			}
 			m.CgroupsMode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreDump", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRunc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PreDump = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRunc(dAtA[iNdEx:])
@@ -1163,33 +1431,39 @@ func init() {
 }
 
 var fileDescriptorRunc = []byte{
-	// 438 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x93, 0xb1, 0x6f, 0xd4, 0x30,
-	0x18, 0xc5, 0x9b, 0xb6, 0xb4, 0x89, 0xaf, 0x57, 0xc0, 0x50, 0x29, 0x14, 0x11, 0xca, 0x09, 0xa4,
-	0xb2, 0xdc, 0x49, 0xb0, 0x20, 0xd8, 0xb8, 0x11, 0x28, 0x25, 0xc0, 0xc2, 0x62, 0xa5, 0xbe, 0x8f,
-	0xc4, 0xba, 0xe4, 0xfb, 0x2c, 0xdb, 0xa1, 0xb9, 0x8d, 0x3f, 0xaf, 0x23, 0x62, 0x62, 0xa4, 0xf9,
-	0x47, 0x40, 0x71, 0x2e, 0x85, 0x95, 0x95, 0xed, 0xf9, 0xf7, 0x9e, 0x9e, 0xa5, 0x27, 0x7d, 0xec,
-	0x79, 0xae, 0x5c, 0x51, 0x9f, 0x4d, 0x25, 0x55, 0x33, 0x49, 0xe8, 0x32, 0x85, 0x60, 0x16, 0x7f,
-	0xcb, 0x52, 0x61, 0xdd, 0xcc, 0x4c, 0x8d, 0x92, 0xb4, 0xb3, 0x5e, 0x4c, 0xb5, 0x21, 0x47, 0xfc,
-	0xe0, 0x4f, 0x6a, 0xea, 0x53, 0xd3, 0xce, 0x3c, 0xbc, 0x9d, 0x53, 0x4e, 0x3e, 0x31, 0xeb, 0x54,
-	0x1f, 0x9e, 0xbc, 0x63, 0xa3, 0xb4, 0x46, 0xf9, 0x56, 0x3b, 0x45, 0x68, 0xf9, 0x5d, 0x16, 0x49,
-	0xa3, 0x6a, 0xa1, 0x33, 0x57, 0xc4, 0xc1, 0x51, 0x70, 0x1c, 0xa5, 0x61, 0x07, 0x4e, 0x33, 0x57,
-	0xf0, 0x47, 0x6c, 0xdf, 0xae, 0xac, 0x83, 0x6a, 0x21, 0x64, 0x6e, 0xa8, 0xd6, 0xf1, 0xa6, 0x4f,
-	0x8c, 0xd7, 0x74, 0xee, 0xe1, 0xe4, 0xfb, 0x26, 0x1b, 0xcf, 0x0d, 0x64, 0x0e, 0x86, 0xd6, 0x09,
-	0x1b, 0x23, 0x09, 0xad, 0xbe, 0x90, 0x13, 0x86, 0xc8, 0xf9, 0xe6, 0x30, 0x1d, 0x21, 0x9d, 0x76,
-	0x2c, 0x25, 0x72, 0xfc, 0x0e, 0x0b, 0x49, 0x03, 0x0a, 0x27, 0xfb, 0xda, 0x30, 0xdd, 0xed, 0xde,
-	0x1f, 0xa4, 0xe6, 0x4f, 0xd8, 0x01, 0x34, 0x0e, 0x0c, 0x66, 0xa5, 0xa8, 0x51, 0x35, 0xc2, 0x92,
-	0x5c, 0x82, 0xb3, 0xf1, 0x96, 0xcf, 0xdd, 0x1a, 0xcc, 0x8f, 0xa8, 0x9a, 0xf7, 0xbd, 0xc5, 0x0f,
-	0x59, 0xe8, 0xc0, 0x54, 0x0a, 0xb3, 0x32, 0xde, 0xf6, 0xb1, 0xab, 0x37, 0xbf, 0xc7, 0xd8, 0x67,
-	0x55, 0x82, 0x28, 0x49, 0x2e, 0x6d, 0x7c, 0xcd, 0xbb, 0x51, 0x47, 0x5e, 0x77, 0x80, 0x3f, 0x66,
-	0x37, 0xa0, 0xd2, 0x6e, 0x25, 0x30, 0xab, 0xc0, 0xea, 0x4c, 0x82, 0x8d, 0x77, 0x8e, 0xb6, 0x8e,
-	0xa3, 0xf4, 0xba, 0xe7, 0x27, 0x57, 0x98, 0x3f, 0x60, 0x7b, 0xfd, 0x12, 0x56, 0x54, 0xb4, 0x80,
-	0x78, 0xd7, 0xef, 0x31, 0x5a, 0xb3, 0x37, 0xb4, 0x00, 0xfe, 0x90, 0xed, 0x23, 0x09, 0x84, 0x73,
-	0xb1, 0x84, 0x95, 0x51, 0x98, 0xc7, 0xa1, 0xff, 0x70, 0x0f, 0xe9, 0x04, 0xce, 0x5f, 0xf5, 0x8c,
-	0xdf, 0x67, 0x23, 0x5b, 0xa8, 0x6a, 0xd8, 0x35, 0xf2, 0x3d, 0xac, 0x43, 0xeb, 0x51, 0x7f, 0x05,
-	0xec, 0xe6, 0xbc, 0x00, 0xb9, 0xd4, 0xa4, 0xd0, 0x0d, 0xc3, 0x72, 0xb6, 0x0d, 0x8d, 0x1a, 0xf6,
-	0xf4, 0xfa, 0x7f, 0x1d, 0xf2, 0x65, 0x7a, 0x71, 0x99, 0x6c, 0xfc, 0xb8, 0x4c, 0x36, 0xbe, 0xb6,
-	0x49, 0x70, 0xd1, 0x26, 0xc1, 0xb7, 0x36, 0x09, 0x7e, 0xb6, 0x49, 0xf0, 0xe9, 0xd9, 0x3f, 0x1e,
-	0xcb, 0x8b, 0x41, 0x9c, 0xed, 0xf8, 0x23, 0x78, 0xfa, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x00, 0x19,
-	0xba, 0x8f, 0x6f, 0x03, 0x00, 0x00,
+	// 535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x93, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0xc7, 0xeb, 0xbe, 0xa4, 0xce, 0xa6, 0x6e, 0x9f, 0x67, 0x21, 0x68, 0x5b, 0x84, 0x09, 0x01,
+	0xa4, 0x70, 0x49, 0x24, 0xb8, 0x20, 0xb8, 0x11, 0x24, 0x0e, 0x40, 0x09, 0x86, 0x5e, 0xb8, 0xac,
+	0xdc, 0xf5, 0xe0, 0xac, 0x62, 0xef, 0xac, 0xd6, 0x6b, 0x9a, 0x70, 0xea, 0xe7, 0xe0, 0x13, 0xf5,
+	0xc8, 0x91, 0x23, 0xcd, 0x27, 0x41, 0x5e, 0xdb, 0x05, 0x21, 0x2e, 0x5c, 0xb9, 0xcd, 0xfc, 0xe6,
+	0xaf, 0xff, 0x6a, 0x5e, 0x96, 0x3c, 0x49, 0xa5, 0x9d, 0x97, 0xa7, 0x63, 0x81, 0xf9, 0x44, 0xa0,
+	0xb2, 0xb1, 0x54, 0x60, 0x92, 0x5f, 0xc3, 0x4c, 0xaa, 0x72, 0x39, 0x31, 0xa5, 0x12, 0xa8, 0x6d,
+	0xe1, 0x82, 0xb1, 0x36, 0x68, 0x91, 0xf6, 0x7f, 0xaa, 0xc6, 0x4e, 0x35, 0xae, 0x8a, 0x47, 0xd7,
+	0x53, 0x4c, 0xd1, 0x29, 0x26, 0x55, 0x54, 0x8b, 0x87, 0x6f, 0x49, 0x2f, 0x2a, 0x95, 0x78, 0xa3,
+	0xad, 0x44, 0x55, 0xd0, 0x9b, 0xa4, 0x2b, 0x8c, 0x2c, 0xb9, 0x8e, 0xed, 0x9c, 0x79, 0x03, 0x6f,
+	0xd4, 0x8d, 0xfc, 0x0a, 0xcc, 0x62, 0x3b, 0xa7, 0xf7, 0xc9, 0x7e, 0xb1, 0x2a, 0x2c, 0xe4, 0x09,
+	0x17, 0xa9, 0xc1, 0x52, 0xb3, 0x4d, 0xa7, 0x08, 0x1a, 0x3a, 0x75, 0x70, 0x78, 0xbe, 0x4d, 0x82,
+	0xa9, 0x81, 0xd8, 0x42, 0xeb, 0x3a, 0x24, 0x81, 0x42, 0xae, 0xe5, 0x27, 0xb4, 0xdc, 0x20, 0x5a,
+	0xe7, 0xec, 0x47, 0x3d, 0x85, 0xb3, 0x8a, 0x45, 0x88, 0x96, 0x1e, 0x12, 0x1f, 0x35, 0x28, 0x6e,
+	0x45, 0x6d, 0xeb, 0x47, 0xbb, 0x55, 0xfe, 0x5e, 0x68, 0xfa, 0x90, 0xf4, 0x61, 0x69, 0xc1, 0xa8,
+	0x38, 0xe3, 0xa5, 0x92, 0x4b, 0x5e, 0xa0, 0x58, 0x80, 0x2d, 0xd8, 0x96, 0xd3, 0x5d, 0x6b, 0x8b,
+	0x27, 0x4a, 0x2e, 0xdf, 0xd5, 0x25, 0x7a, 0x44, 0x7c, 0x0b, 0x26, 0x97, 0x2a, 0xce, 0xd8, 0xb6,
+	0x93, 0x5d, 0xe5, 0xf4, 0x16, 0x21, 0x1f, 0x65, 0x06, 0x3c, 0x43, 0xb1, 0x28, 0xd8, 0x8e, 0xab,
+	0x76, 0x2b, 0xf2, 0xaa, 0x02, 0xf4, 0x01, 0xf9, 0x0f, 0x72, 0x6d, 0x57, 0x5c, 0xc5, 0x39, 0x14,
+	0x3a, 0x16, 0x50, 0xb0, 0xce, 0x60, 0x6b, 0xd4, 0x8d, 0x0e, 0x1c, 0x3f, 0xbe, 0xc2, 0xf4, 0x0e,
+	0xd9, 0xab, 0x27, 0x51, 0xf0, 0x1c, 0x13, 0x60, 0xbb, 0x6e, 0x1e, 0xbd, 0x86, 0xbd, 0xc6, 0x04,
+	0xe8, 0x3d, 0xb2, 0xaf, 0x90, 0x2b, 0x38, 0xe3, 0x0b, 0x58, 0x19, 0xa9, 0x52, 0xe6, 0xbb, 0x07,
+	0xf7, 0x14, 0x1e, 0xc3, 0xd9, 0xcb, 0x9a, 0xd1, 0xdb, 0xa4, 0x57, 0xcc, 0x65, 0xde, 0xce, 0xb5,
+	0xeb, 0x7c, 0x48, 0x85, 0xea, 0xa1, 0xba, 0x7e, 0x64, 0x0e, 0x9f, 0x51, 0x01, 0x23, 0xf5, 0x5e,
+	0xda, 0x9c, 0xde, 0x20, 0x9d, 0x0c, 0x45, 0x9c, 0x01, 0xeb, 0xb9, 0x4a, 0x93, 0xd1, 0x3e, 0xe9,
+	0x48, 0xe4, 0xa5, 0x4c, 0xd8, 0xde, 0xc0, 0x1b, 0x05, 0xd1, 0x8e, 0xc4, 0x13, 0x99, 0x34, 0x38,
+	0x95, 0x09, 0x0b, 0x5a, 0xfc, 0x42, 0x26, 0xf4, 0x2e, 0x09, 0xea, 0xd7, 0xb9, 0x8e, 0x0d, 0x28,
+	0xcb, 0xf6, 0x9d, 0x59, 0xd3, 0xe0, 0xcc, 0xb1, 0x3f, 0x9c, 0xc0, 0x81, 0xeb, 0xe6, 0xb7, 0x13,
+	0xf8, 0xb2, 0x49, 0xfe, 0x9f, 0xce, 0x41, 0x2c, 0x34, 0x4a, 0x65, 0xdb, 0x33, 0xa0, 0x64, 0x1b,
+	0x96, 0xb2, 0xdd, 0xbe, 0x8b, 0xff, 0xd9, 0xb5, 0x1f, 0x12, 0x5f, 0x1b, 0xe0, 0x49, 0x99, 0xeb,
+	0x66, 0xe1, 0xbb, 0xda, 0xc0, 0xf3, 0x32, 0xd7, 0xcf, 0xa2, 0x8b, 0xcb, 0x70, 0xe3, 0xdb, 0x65,
+	0xb8, 0x71, 0xbe, 0x0e, 0xbd, 0x8b, 0x75, 0xe8, 0x7d, 0x5d, 0x87, 0xde, 0xf7, 0x75, 0xe8, 0x7d,
+	0x78, 0xfc, 0x97, 0xbf, 0xfe, 0x69, 0x1b, 0x9c, 0x76, 0xdc, 0x6f, 0x7e, 0xf4, 0x63, 0x00, 0xac,
+	0x74, 0x20, 0x48, 0x38, 0x04, 0x00, 0x00,
 }
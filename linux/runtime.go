@@ -8,9 +8,15 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/boltdb/bolt"
 	"github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/containers"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/events"
 	"github.com/containerd/containerd/identifiers"
@@ -21,7 +27,11 @@ import (
 	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/containerd/plugin"
 	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/containerd/sys"
+	"github.com/containerd/containerd/tracing"
+	"github.com/containerd/containerd/typeurl"
 	runc "github.com/containerd/go-runc"
+	metrics "github.com/docker/go-metrics"
 	google_protobuf "github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
 
@@ -34,16 +44,29 @@ var (
 )
 
 const (
-	configFilename = "config.json"
-	defaultRuntime = "runc"
-	defaultShim    = "containerd-shim"
+	// restoreWorkers is the number of tasks of a namespace restored
+	// concurrently on startup
+	restoreWorkers = 32
+
+	configFilename     = "config.json"
+	createOptsFilename = "create.json"
+	defaultRuntime     = "runc"
+	defaultShim        = "containerd-shim"
+
+	// shimAddressFilename records in the bundle the address of the pooled
+	// shim claimed by the task
+	shimAddressFilename = "shim.address"
+	// poolDir is the directory of the state of the runtime holding the
+	// directories of the idle pooled shims
+	poolDir = ".pool"
 )
 
 func init() {
 	plugin.Register(&plugin.Registration{
-		Type: plugin.RuntimePlugin,
-		ID:   "linux",
-		Init: New,
+		Type:  plugin.RuntimePlugin,
+		ID:    "linux",
+		Init:  New,
+		Check: Check,
 		Requires: []plugin.PluginType{
 			plugin.TaskMonitorPlugin,
 			plugin.MetadataPlugin,
@@ -51,21 +74,63 @@ func init() {
 		Config: &Config{
 			Shim:    defaultShim,
 			Runtime: defaultRuntime,
+			Timeouts: TimeoutConfig{
+				Create: "5m",
+				Start:  "1m",
+				Delete: "1m",
+			},
 		},
 	})
 }
 
-var _ = (runtime.Runtime)(&Runtime{})
+var (
+	_ = (runtime.ShimRuntime)(&Runtime{})
+	_ = (runtime.ExitTranslator)(&Runtime{})
+)
 
 type Config struct {
 	// Shim is a path or name of binary implementing the Shim GRPC API
 	Shim string `toml:"shim,omitempty"`
+	// Shims maps runtime names of containers to the path or name of the
+	// shim binary running their tasks. Runtimes named
+	// "io.containerd.<name>.<version>" that are not listed run in the
+	// binary "containerd-shim-<name>-<version>".
+	Shims map[string]string `toml:"shims,omitempty"`
 	// Runtime is a path or name of an OCI runtime used by the shim
 	Runtime string `toml:"runtime,omitempty"`
 	// NoShim calls runc directly from within the pkg
 	NoShim bool `toml:"no_shim,omitempty"`
 	// Debug enable debug on the shim
 	ShimDebug bool `toml:"shim_debug,omitempty"`
+	// ShimPool is the number of idle shims kept started for the tasks run by
+	// the shim above, so that creating a task does not wait for its shim
+	ShimPool int `toml:"shim_pool,omitempty"`
+	// StrictState rejects persisted task state with unknown fields, such as
+	// state written by a newer version of containerd
+	StrictState bool `toml:"strict_state,omitempty"`
+	// IOBufferSize is the size in bytes of the buffers copying the stdio of
+	// processes in the shims, which use the default of the shim when unset
+	IOBufferSize int `toml:"io_buffer_size,omitempty"`
+	// Exec bounds the exited exec processes whose exit status the shims
+	// retain until they are deleted
+	Exec ExecConfig `toml:"exec"`
+	// CgroupParent is the default cgroup under which the cgroups of the
+	// containers are created when their spec sets no absolute cgroup, a
+	// slice with the systemd cgroup driver
+	CgroupParent string `toml:"cgroup_parent,omitempty"`
+	// SystemdCgroup manages the cgroups of the containers as transient
+	// systemd scopes by default
+	SystemdCgroup bool `toml:"systemd_cgroup,omitempty"`
+	// Timeouts bounds the create, start and delete of tasks so that a hung
+	// runtime does not block their callers forever
+	Timeouts TimeoutConfig `toml:"timeouts"`
+	// ExitCodes maps runtime names of containers to the translations of the
+	// exit statuses reported by their tasks, for wrapper runtimes encoding
+	// the exit of the workload in special exit statuses
+	ExitCodes map[string]runtime.ExitCodes `toml:"exit_codes,omitempty"`
+	// Runtimes are named runtimes with their own settings, run by the shim
+	// for the containers whose runtime has their name
+	Runtimes map[string]RuntimeEntry `toml:"runtimes,omitempty"`
 }
 
 func New(ic *plugin.InitContext) (interface{}, error) {
@@ -84,25 +149,88 @@ func New(ic *plugin.InitContext) (interface{}, error) {
 		return nil, err
 	}
 	cfg := ic.Config.(*Config)
+	timeouts, err := newTimeouts(cfg.Timeouts)
+	if err != nil {
+		return nil, err
+	}
+	retention, err := newExecRetention(cfg.Exec)
+	if err != nil {
+		return nil, err
+	}
+	for name, codes := range cfg.ExitCodes {
+		if err := codes.Validate(); err != nil {
+			return nil, errors.Wrapf(err, "exit codes of runtime %s", name)
+		}
+	}
+	if err := validateRuntimes(cfg.Runtimes); err != nil {
+		return nil, err
+	}
+	if cfg.ShimPool < 0 {
+		return nil, errors.Errorf("invalid shim pool size %d", cfg.ShimPool)
+	}
+	mounts, err := newMountManager(filepath.Join(ic.State, mountsDir))
+	if err != nil {
+		return nil, err
+	}
+	ns := metrics.NewNamespace("containerd", "shim", nil)
 	r := &Runtime{
-		root:      ic.Root,
-		state:     ic.State,
-		remote:    !cfg.NoShim,
-		shim:      cfg.Shim,
-		shimDebug: cfg.ShimDebug,
-		runtime:   cfg.Runtime,
-		monitor:   monitor.(runtime.TaskMonitor),
-		tasks:     runtime.NewTaskList(),
-		db:        m.(*bolt.DB),
-		address:   ic.Address,
-		events:    ic.Events,
+		root:         ic.Root,
+		state:        ic.State,
+		remote:       !cfg.NoShim,
+		shim:         cfg.Shim,
+		shims:        cfg.Shims,
+		shimDebug:    cfg.ShimDebug,
+		ioBufferSize: cfg.IOBufferSize,
+		cgroupParent: cfg.CgroupParent,
+		systemd:      cfg.SystemdCgroup,
+		retention:    retention,
+		runtime:      cfg.Runtime,
+		runtimes:     cfg.Runtimes,
+		strict:       cfg.StrictState,
+		timeouts:     timeouts,
+		exitCodes:    cfg.ExitCodes,
+		rootless:     sys.IsRootless(),
+		delegated:    sys.IsCgroupDelegated(),
+		monitor:      monitor.(runtime.TaskMonitor),
+		tasks:        runtime.NewTaskList(),
+		mounts:       mounts,
+		db:           m.(*bolt.DB),
+		address:      ic.Address,
+		events:       ic.Events,
+		incompatible: ns.NewLabeledCounter("incompatible", "The number of shims refused for speaking an unsupported protocol version", "version"),
+		claims:       ns.NewLabeledCounter("pool_claims", "The number of tasks created with an idle pooled shim or a started shim", "shim"),
 	}
+	ns.Add(newIOCollector(ns, r.tasks))
+	metrics.Register(ns)
+	ic.Meta.Exports["runtime"] = r.runtime
+	ic.Meta.Exports["rootless"] = strconv.FormatBool(r.rootless)
+	if len(r.runtimes) > 0 {
+		names := make([]string, 0, len(r.runtimes))
+		for name := range r.runtimes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		ic.Meta.Exports["runtimes"] = strings.Join(names, ",")
+	}
+	ic.Meta.Exports["shim_protocol_version"] = strconv.Itoa(client.ProtocolVersion)
 	tasks, err := r.restoreTasks(ic.Context)
 	if err != nil {
 		return nil, err
 	}
 	for _, t := range tasks {
-		if err := r.tasks.AddWithNamespace(t.namespace, t); err != nil {
+		if err := r.tasks.AddWithNamespace(t.Info().Namespace, t); err != nil {
+			log.G(ic.Context).WithError(err).WithField("id", t.ID()).Error("failed to add restored task")
+		}
+	}
+	r.mounts.sweep(ic.Context, r.state, func(ns, id string) bool {
+		_, err := r.tasks.Get(namespaces.WithNamespace(ic.Context, ns), id)
+		return err == nil
+	})
+	if r.remote && cfg.ShimPool > 0 {
+		if r.pool, err = client.NewPool(ic.Context, filepath.Join(ic.State, poolDir), r.shim, r.address, r.shimDebug, cfg.ShimPool, client.Config{
+			IOBufferSize:  r.ioBufferSize,
+			ExecRetention: r.retention,
+		}); err != nil {
 			return nil, err
 		}
 	}
@@ -113,21 +241,90 @@ type Runtime struct {
 	root      string
 	state     string
 	shim      string
+	shims     map[string]string
 	shimDebug bool
 	runtime   string
+	runtimes  map[string]RuntimeEntry
 	remote    bool
 	address   string
+	// rootless is set when containerd runs without real root, delegated
+	// when it can manage the cgroups of the containers nonetheless
+	rootless  bool
+	delegated bool
+	strict    bool
+	// ioBufferSize is passed to the shims, zero for their default
+	ioBufferSize int
+	retention    client.ExecRetention
+	// cgroupParent and systemd are the defaults of the cgroups of the
+	// containers run by the shim
+	cgroupParent string
+	systemd      bool
+	timeouts     timeouts
+	exitCodes    map[string]runtime.ExitCodes
 
 	monitor runtime.TaskMonitor
 	tasks   *runtime.TaskList
+	mounts  *mountManager
 	db      *bolt.DB
 	events  *events.Exchange
+	// pool keeps idle shims of the default shim binary, nil when disabled
+	pool *client.Pool
+
+	incompatible metrics.LabeledCounter
+	claims       metrics.LabeledCounter
 }
 
 func (r *Runtime) ID() string {
 	return pluginID
 }
 
+// LowerDirReferences returns the number of tasks whose rootfs mounts the
+// lower directory in an overlay, so that the directories still mounted by
+// tasks are not removed
+func (r *Runtime) LowerDirReferences(dir string) int {
+	return r.mounts.references(dir)
+}
+
+// TranslateExit translates the exit status with the exit codes configured
+// for the runtime of the container
+func (r *Runtime) TranslateExit(ctx context.Context, id string, status uint32) (uint32, string) {
+	if len(r.exitCodes) == 0 {
+		return status, ""
+	}
+	var container containers.Container
+	if err := r.db.View(func(tx *bolt.Tx) error {
+		var err error
+		container, err = metadata.NewContainerStore(tx).Get(ctx, id)
+		return err
+	}); err != nil {
+		log.G(ctx).WithError(err).WithField("id", id).Warn("failed to get container to translate exit status")
+		return status, ""
+	}
+	return r.exitCodes[container.Runtime.Name].Translate(status)
+}
+
+// Resolves returns true if the named runtime is configured or runs in an
+// external shim binary
+func (r *Runtime) Resolves(name string) bool {
+	if _, ok := r.runtimes[name]; ok {
+		return true
+	}
+	_, ok := r.shimBinary(name)
+	return ok
+}
+
+// shimBinary returns the shim binary running tasks of the named runtime
+func (r *Runtime) shimBinary(name string) (string, bool) {
+	if binary, ok := r.shims[name]; ok {
+		return binary, true
+	}
+	parts := strings.Split(name, ".")
+	if len(parts) != 4 || parts[0] != "io" || parts[1] != "containerd" || parts[2] == "" || parts[3] == "" {
+		return "", false
+	}
+	return fmt.Sprintf("containerd-shim-%s-%s", parts[2], parts[3]), true
+}
+
 func (r *Runtime) Create(ctx context.Context, id string, opts runtime.CreateOpts) (_ runtime.Task, err error) {
 	namespace, err := namespaces.NamespaceRequired(ctx)
 	if err != nil {
@@ -137,9 +334,82 @@ func (r *Runtime) Create(ctx context.Context, id string, opts runtime.CreateOpts
 	if err := identifiers.Validate(id); err != nil {
 		return nil, errors.Wrapf(err, "invalid task id")
 	}
+	binary, runtimeBinary := r.shim, r.runtime
+	remote, debug := r.remote, r.shimDebug
+	entry, named := r.runtimes[opts.Runtime]
+	if named {
+		if entry.Shim != "" {
+			binary = entry.Shim
+		}
+		if entry.Runtime != "" {
+			runtimeBinary = entry.Runtime
+		}
+		remote = remote && !entry.NoShim
+		debug = debug || entry.ShimDebug
+	} else if opts.Runtime != "" && opts.Runtime != pluginID {
+		var ok bool
+		if binary, ok = r.shimBinary(opts.Runtime); !ok {
+			return nil, errors.Wrapf(errdefs.ErrNotFound, "no shim for runtime %q", opts.Runtime)
+		}
+		if !remote {
+			return nil, errors.Wrapf(errdefs.ErrFailedPrecondition, "runtime %q requires a shim", opts.Runtime)
+		}
+	}
+	if binary == r.shim {
+		// only the shims of VM runtimes mount the rootfs in a guest
+		for _, m := range opts.Rootfs {
+			if m.IsVMOnly() {
+				return nil, errors.Wrapf(errdefs.ErrNotImplemented, "%s mounts require a VM runtime", m.Type)
+			}
+		}
+	}
+	ctx, cancel := withTimeout(ctx, r.timeouts.create)
+	defer cancel()
+
+	options, err := runcCreateOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := withEnvironment(opts.Spec.Value, options.Timezone, options.Locale, zoneinfoDir)
+	if err != nil {
+		return nil, err
+	}
+	if named {
+		if spec, err = withRuntimeDefaults(spec, entry); err != nil {
+			return nil, err
+		}
+	}
+	if binary == r.shim || named {
+		if r.systemd && !options.SystemdCgroup {
+			// the shim registers the scope of the container
+			options.SystemdCgroup = true
+			if opts.Options, err = typeurl.MarshalAny(&options); err != nil {
+				return nil, err
+			}
+		}
+		if spec, err = withCgroupParent(spec, id, options, r.cgroupParent); err != nil {
+			return nil, err
+		}
+	}
+	if r.rootless {
+		if err := checkRootless(spec, opts, options, r.delegated); err != nil {
+			return nil, err
+		}
+	}
+	if owned, ok, err := withIOOwner(spec, options); err != nil {
+		return nil, err
+	} else if ok {
+		// the options are saved with the bundle so that the owner is kept
+		// when the task is restored
+		if opts.Options, err = typeurl.MarshalAny(&owned); err != nil {
+			return nil, err
+		}
+	}
 
-	bundle, err := newBundle(filepath.Join(r.state, namespace), namespace, filepath.Join(r.root, namespace), id, opts.Spec.Value, r.events)
+	span, _ := tracing.StartSpan(ctx, "bundle")
+	bundle, err := newBundle(filepath.Join(r.state, namespace), namespace, filepath.Join(r.root, namespace), id, spec, r.events)
 	if err != nil {
+		span.Finish(err)
 		return nil, err
 	}
 	defer func() {
@@ -147,27 +417,71 @@ func (r *Runtime) Create(ctx context.Context, id string, opts runtime.CreateOpts
 			bundle.Delete()
 		}
 	}()
-	s, err := bundle.NewShim(ctx, r.shim, r.address, r.remote, r.shimDebug, opts)
+	err = bundle.SaveCreateOpts(opts)
+	span.Finish(err)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.mounts.add(namespace, id, filepath.Join(bundle.path, "rootfs"), opts.Rootfs); err != nil {
+		return nil, errors.Wrap(err, "failed to record rootfs mounts")
+	}
+	defer func() {
+		if err != nil {
+			if merr := r.mounts.remove(ctx, namespace, id); merr != nil {
+				log.G(ctx).WithError(merr).WithField("id", id).Error("failed to unmount rootfs")
+			}
+		}
+	}()
+	span, sctx := tracing.StartSpan(ctx, "shim.start")
+	var s *client.Client
+	if r.pool != nil && remote && binary == r.shim && debug == r.shimDebug {
+		s, err = bundle.ClaimShim(sctx, r.pool, opts)
+		if err == nil && s != nil {
+			r.claims.WithValues("idle").Inc()
+		}
+	}
+	if s == nil && err == nil {
+		s, err = bundle.NewShim(sctx, binary, r.address, remote, debug, r.ioBufferSize, r.retention, opts)
+		if err == nil && r.pool != nil {
+			r.claims.WithValues("started").Inc()
+		}
+	}
+	span.Finish(err)
 	if err != nil {
+		if client.IsIncompatible(err) {
+			r.countIncompatible(err)
+			return nil, errors.Wrapf(errdefs.ErrFailedPrecondition, "%s: %v", binary, err)
+		}
 		return nil, err
 	}
 	defer func() {
 		if err != nil {
-			if kerr := s.KillShim(ctx); kerr != nil {
-				log.G(ctx).WithError(err).Error("failed to kill shim")
+			// the context of the create may have expired, leaving runc
+			// killed with the container half created
+			cctx, cancel := context.WithTimeout(namespaces.WithNamespace(context.Background(), namespace), cleanupTimeout)
+			defer cancel()
+			if kerr := s.KillShim(cctx); kerr != nil {
+				log.G(ctx).WithError(kerr).Error("failed to kill shim")
+			}
+			if terr := r.terminate(cctx, bundle, namespace, id); terr != nil {
+				log.G(ctx).WithError(terr).WithField("id", id).Error("failed to terminate task")
 			}
 		}
 	}()
 	sopts := &shim.CreateTaskRequest{
-		ID:         id,
-		Bundle:     bundle.path,
-		Runtime:    r.runtime,
-		Stdin:      opts.IO.Stdin,
-		Stdout:     opts.IO.Stdout,
-		Stderr:     opts.IO.Stderr,
-		Terminal:   opts.IO.Terminal,
-		Checkpoint: opts.Checkpoint,
-		Options:    opts.Options,
+		ID:           id,
+		Bundle:       bundle.path,
+		Runtime:      runtimeBinary,
+		RuntimeRoot:  entry.RuntimeRoot,
+		RuntimeDebug: entry.Debug,
+		Namespace:    namespace,
+		WorkDir:      bundle.workDir,
+		Stdin:        opts.IO.Stdin,
+		Stdout:       opts.IO.Stdout,
+		Stderr:       opts.IO.Stderr,
+		Terminal:     opts.IO.Terminal,
+		Checkpoint:   opts.Checkpoint,
+		Options:      opts.Options,
 	}
 	for _, m := range opts.Rootfs {
 		sopts.Rootfs = append(sopts.Rootfs, &types.Mount{
@@ -176,10 +490,19 @@ func (r *Runtime) Create(ctx context.Context, id string, opts runtime.CreateOpts
 			Options: m.Options,
 		})
 	}
-	if _, err = s.Create(ctx, sopts); err != nil {
+	span, sctx = tracing.StartSpan(ctx, "shim.create")
+	_, err = s.Create(sctx, sopts)
+	span.Finish(err)
+	if err != nil {
+		if debug {
+			// the log is removed with the bundle of the failed task
+			if data, _, lerr := tailFile(filepath.Join(bundle.path, client.LogFile), createLogTail); lerr == nil && len(data) > 0 {
+				log.G(ctx).WithField("id", id).Errorf("shim log of failed create:\n%s", data)
+			}
+		}
 		return nil, errdefs.FromGRPC(err)
 	}
-	t := newTask(id, namespace, s)
+	t := newTask(id, namespace, bundle.path, s, opts, r.timeouts)
 	if err := r.tasks.Add(ctx, t); err != nil {
 		return nil, err
 	}
@@ -195,6 +518,9 @@ func (r *Runtime) Delete(ctx context.Context, c runtime.Task) (*runtime.Exit, er
 	if err != nil {
 		return nil, err
 	}
+	if bt, ok := c.(*brokenTask); ok {
+		return r.deleteBroken(ctx, bt)
+	}
 	lc, ok := c.(*Task)
 	if !ok {
 		return nil, fmt.Errorf("task cannot be cast as *linux.Task")
@@ -202,14 +528,19 @@ func (r *Runtime) Delete(ctx context.Context, c runtime.Task) (*runtime.Exit, er
 	if err := r.monitor.Stop(lc); err != nil {
 		return nil, err
 	}
-	rsp, err := lc.shim.Delete(ctx, empty)
+	dctx, cancel := withTimeout(ctx, r.timeouts.delete)
+	exit, err := lc.Delete(dctx)
+	cancel()
 	if err != nil {
-		return nil, errdefs.FromGRPC(err)
+		return nil, err
 	}
 	if err := lc.shim.KillShim(ctx); err != nil {
 		log.G(ctx).WithError(err).Error("failed to kill shim")
 	}
 	r.tasks.Delete(ctx, lc)
+	if err := r.mounts.remove(ctx, namespace, lc.id); err != nil {
+		log.G(ctx).WithError(err).WithField("id", lc.id).Error("failed to unmount rootfs")
+	}
 
 	bundle := loadBundle(
 		filepath.Join(r.state, namespace, lc.id),
@@ -221,32 +552,62 @@ func (r *Runtime) Delete(ctx context.Context, c runtime.Task) (*runtime.Exit, er
 	if err := bundle.Delete(); err != nil {
 		log.G(ctx).WithError(err).Error("failed to delete bundle")
 	}
-	return &runtime.Exit{
-		Status:    rsp.ExitStatus,
-		Timestamp: rsp.ExitedAt,
-		Pid:       rsp.Pid,
-	}, nil
+	return exit, nil
 }
 
 func (r *Runtime) Tasks(ctx context.Context) ([]runtime.Task, error) {
 	return r.tasks.GetAll(ctx)
 }
 
-func (r *Runtime) restoreTasks(ctx context.Context) ([]*Task, error) {
+// deleteBroken removes what is left of the runtime state and the bundle of
+// a task that could not be restored
+func (r *Runtime) deleteBroken(ctx context.Context, t *brokenTask) (*runtime.Exit, error) {
+	bundle := loadBundle(
+		filepath.Join(r.state, t.namespace, t.id),
+		filepath.Join(r.root, t.namespace, t.id),
+		t.namespace,
+		t.id,
+		r.events,
+	)
+	r.purge(ctx, bundle, t.namespace, t.id)
+	if t.shim != nil {
+		if err := t.shim.KillShim(ctx); err != nil {
+			log.G(ctx).WithError(err).WithField("id", t.id).Warn("failed to kill shim of broken task")
+		}
+		t.shim.Close()
+	}
+	if err := r.mounts.remove(ctx, t.namespace, t.id); err != nil {
+		log.G(ctx).WithError(err).WithField("id", t.id).Warn("failed to unmount rootfs of broken task")
+	}
+	if err := bundle.Delete(); err != nil {
+		return nil, errors.Wrap(err, "failed to delete bundle of broken task")
+	}
+	r.tasks.Delete(ctx, t)
+	return &runtime.Exit{
+		Status:    255,
+		Timestamp: time.Now(),
+	}, nil
+}
+
+func (r *Runtime) restoreTasks(ctx context.Context) ([]runtime.Task, error) {
 	dir, err := ioutil.ReadDir(r.state)
 	if err != nil {
 		return nil, err
 	}
-	var o []*Task
+	var o []runtime.Task
 	for _, namespace := range dir {
 		if !namespace.IsDir() {
 			continue
 		}
 		name := namespace.Name()
+		if name == mountsDir || name == poolDir {
+			continue
+		}
 		log.G(ctx).WithField("namespace", name).Debug("loading tasks in namespace")
 		tasks, err := r.loadTasks(ctx, name)
 		if err != nil {
-			return nil, err
+			log.G(ctx).WithError(err).WithField("namespace", name).Error("failed to load tasks in namespace")
+			continue
 		}
 		o = append(o, tasks...)
 	}
@@ -257,41 +618,97 @@ func (r *Runtime) Get(ctx context.Context, id string) (runtime.Task, error) {
 	return r.tasks.Get(ctx, id)
 }
 
-func (r *Runtime) loadTasks(ctx context.Context, ns string) ([]*Task, error) {
+// loadTasks restores the tasks of the namespace, connecting to their shims
+// concurrently so that the startup time does not grow with their number
+func (r *Runtime) loadTasks(ctx context.Context, ns string) ([]runtime.Task, error) {
 	dir, err := ioutil.ReadDir(filepath.Join(r.state, ns))
 	if err != nil {
 		return nil, err
 	}
-	var o []*Task
+	var ids []string
 	for _, path := range dir {
-		if !path.IsDir() {
-			continue
+		if path.IsDir() {
+			ids = append(ids, path.Name())
 		}
-		id := path.Name()
-		bundle := loadBundle(filepath.Join(r.state, ns, id),
-			filepath.Join(r.root, ns, id), ns, id, r.events)
-
-		s, err := bundle.Connect(ctx, r.remote)
-		if err != nil {
-			log.G(ctx).WithError(err).Error("connecting to shim")
-			if err := r.terminate(ctx, bundle, ns, id); err != nil {
-				log.G(ctx).WithError(err).WithField("bundle", bundle.path).Error("failed to terminate task, leaving bundle for debugging")
-				continue
-			}
-			if err := bundle.Delete(); err != nil {
-				log.G(ctx).WithError(err).Error("delete bundle")
+	}
+	var (
+		loaded = make([]runtime.Task, len(ids))
+		work   = make(chan int)
+		wg     sync.WaitGroup
+	)
+	for i := 0; i < restoreWorkers && i < len(ids); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range work {
+				loaded[j] = r.loadTask(ctx, ns, ids[j])
 			}
-			continue
+		}()
+	}
+	for i := range ids {
+		work <- i
+	}
+	close(work)
+	wg.Wait()
+	var o []runtime.Task
+	for _, t := range loaded {
+		if t != nil {
+			o = append(o, t)
 		}
-		o = append(o, &Task{
-			id:        id,
-			shim:      s,
-			namespace: ns,
-		})
 	}
 	return o, nil
 }
 
+// loadTask restores the task, nil when its shim was gone and its state was
+// cleaned up, and a broken task for anything left that cannot be managed, so
+// that it is reported and can be deleted
+func (r *Runtime) loadTask(ctx context.Context, ns, id string) runtime.Task {
+	bundle := loadBundle(filepath.Join(r.state, ns, id),
+		filepath.Join(r.root, ns, id), ns, id, r.events)
+
+	s, err := bundle.Connect(ctx, r.remote)
+	if err != nil {
+		// the task is left running for a version of containerd that
+		// supports its shim to manage, and reported as broken meanwhile
+		if client.IsIncompatible(err) {
+			r.countIncompatible(err)
+			log.G(ctx).WithError(err).WithField("id", id).Error("refusing to manage task of incompatible shim")
+			return newBrokenTask(id, ns, bundle.path, err)
+		}
+		log.G(ctx).WithError(err).Error("connecting to shim")
+		if terr := r.terminate(ctx, bundle, ns, id); terr != nil {
+			log.G(ctx).WithError(terr).WithField("bundle", bundle.path).Error("failed to terminate task, leaving bundle for debugging")
+			return newBrokenTask(id, ns, bundle.path, err)
+		}
+		if merr := r.mounts.remove(ctx, ns, id); merr != nil {
+			log.G(ctx).WithError(merr).WithField("id", id).Error("failed to unmount rootfs")
+			return newBrokenTask(id, ns, bundle.path, merr)
+		}
+		if derr := bundle.Delete(); derr != nil {
+			log.G(ctx).WithError(derr).Error("delete bundle")
+			return newBrokenTask(id, ns, bundle.path, derr)
+		}
+		return nil
+	}
+	opts, err := bundle.LoadCreateOpts(r.strict)
+	if err != nil {
+		// the task cannot be managed without the options it was created
+		// with, it is left running until it is deleted
+		log.G(ctx).WithError(err).WithField("id", id).Error("failed to load task create options")
+		t := newBrokenTask(id, ns, bundle.path, err)
+		t.shim = s
+		return t
+	}
+	return newTask(id, ns, bundle.path, s, opts, r.timeouts)
+}
+
+// countIncompatible counts the refused shim by its protocol version
+func (r *Runtime) countIncompatible(err error) {
+	if e, ok := errors.Cause(err).(*client.IncompatibleError); ok {
+		r.incompatible.WithValues(strconv.FormatUint(uint64(e.Version), 10)).Inc()
+	}
+}
+
 func (r *Runtime) terminate(ctx context.Context, bundle *bundle, ns, id string) error {
 	ctx = namespaces.WithNamespace(ctx, ns)
 	rt, err := r.getRuntime(ctx, ns, id)
@@ -310,20 +727,31 @@ func (r *Runtime) terminate(ctx context.Context, bundle *bundle, ns, id string)
 }
 
 func (r *Runtime) getRuntime(ctx context.Context, ns, id string) (*runc.Runc, error) {
+	var container containers.Container
 	if err := r.db.View(func(tx *bolt.Tx) error {
 		store := metadata.NewContainerStore(tx)
 		var err error
-		_, err = store.Get(ctx, id)
+		container, err = store.Get(ctx, id)
 		return err
 	}); err != nil {
 		return nil, err
 	}
-	return &runc.Runc{
+	rt := &runc.Runc{
 		// TODO: until we have a way to store/retrieve the original command
 		// we can only rely on runc from the default $PATH
 		Command:      runc.DefaultCommand,
 		LogFormat:    runc.JSON,
 		PdeathSignal: unix.SIGKILL,
 		Root:         filepath.Join(client.RuncRoot, ns),
-	}, nil
+	}
+	// the runtime of a named runtime is known from its configuration
+	if entry, ok := r.runtimes[container.Runtime.Name]; ok {
+		if entry.Runtime != "" {
+			rt.Command = entry.Runtime
+		}
+		if entry.RuntimeRoot != "" {
+			rt.Root = filepath.Join(entry.RuntimeRoot, ns)
+		}
+	}
+	return rt, nil
 }
@@ -0,0 +1,86 @@
+// +build linux
+
+package linux
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/containerd/containerd/errdefs"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// RuntimeEntry configures a named runtime, which containers select with the
+// name of their runtime so that runtimes with different settings, such as
+// "runc-debug" and "kata", run side by side
+type RuntimeEntry struct {
+	// Runtime is the path or name of the OCI runtime binary, the runtime of
+	// the plugin when it is empty
+	Runtime string `toml:"runtime,omitempty"`
+	// RuntimeRoot is the root directory of the state of the runtime, the
+	// root of the shim when it is empty
+	RuntimeRoot string `toml:"runtime_root,omitempty"`
+	// Shim is the path or name of the shim binary, the shim of the plugin
+	// when it is empty
+	Shim string `toml:"shim,omitempty"`
+	// Debug enables the debug output of the runtime
+	Debug bool `toml:"debug,omitempty"`
+	// NoShim runs the tasks of the runtime within containerd rather than
+	// in a shim, saving a process per task at the cost of the tasks not
+	// surviving a restart of containerd
+	NoShim bool `toml:"no_shim,omitempty"`
+	// ShimDebug writes the output of the shims of the runtime to the log
+	// file in the bundles of their tasks
+	ShimDebug bool `toml:"shim_debug,omitempty"`
+	// Annotations are added to the specs of the containers that do not set
+	// them
+	Annotations map[string]string `toml:"annotations,omitempty"`
+	// SelinuxLabel and ApparmorProfile are set for the processes of the
+	// containers that set none
+	SelinuxLabel    string `toml:"selinux_label,omitempty"`
+	ApparmorProfile string `toml:"apparmor_profile,omitempty"`
+}
+
+// validateRuntimes returns an error if a runtime entry cannot be used
+func validateRuntimes(entries map[string]RuntimeEntry) error {
+	for name, e := range entries {
+		if name == "" || name == pluginID {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "invalid runtime name %q", name)
+		}
+		if e.RuntimeRoot != "" && !filepath.IsAbs(e.RuntimeRoot) {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "runtime_root %q of runtime %s is not absolute", e.RuntimeRoot, name)
+		}
+	}
+	return nil
+}
+
+// withRuntimeDefaults returns the spec with the default annotations and
+// security labels of the runtime entry set where the spec has none
+func withRuntimeDefaults(data []byte, entry RuntimeEntry) ([]byte, error) {
+	if len(entry.Annotations) == 0 && entry.SelinuxLabel == "" && entry.ApparmorProfile == "" {
+		return data, nil
+	}
+	var spec specs.Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, err
+	}
+	for k, v := range entry.Annotations {
+		if _, ok := spec.Annotations[k]; ok {
+			continue
+		}
+		if spec.Annotations == nil {
+			spec.Annotations = make(map[string]string)
+		}
+		spec.Annotations[k] = v
+	}
+	if spec.Process != nil {
+		if spec.Process.SelinuxLabel == "" {
+			spec.Process.SelinuxLabel = entry.SelinuxLabel
+		}
+		if spec.Process.ApparmorProfile == "" {
+			spec.Process.ApparmorProfile = entry.ApparmorProfile
+		}
+	}
+	return json.Marshal(spec)
+}
@@ -0,0 +1,64 @@
+// +build linux
+
+package linux
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestWithRuntimeDefaults(t *testing.T) {
+	data, err := json.Marshal(specs.Spec{
+		Process:     &specs.Process{ApparmorProfile: "custom"},
+		Annotations: map[string]string{"io.kata.debug": "false"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = withRuntimeDefaults(data, RuntimeEntry{
+		Annotations: map[string]string{
+			"io.kata.debug":  "true",
+			"io.kata.kernel": "/opt/kata/vmlinuz",
+		},
+		SelinuxLabel:    "system_u:system_r:container_t:s0",
+		ApparmorProfile: "containerd-default",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var spec specs.Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		t.Fatal(err)
+	}
+	if v := spec.Annotations["io.kata.debug"]; v != "false" {
+		t.Errorf("annotation of the spec replaced with %q", v)
+	}
+	if v := spec.Annotations["io.kata.kernel"]; v != "/opt/kata/vmlinuz" {
+		t.Errorf("default annotation not added, got %q", v)
+	}
+	if spec.Process.ApparmorProfile != "custom" {
+		t.Errorf("apparmor profile of the spec replaced with %q", spec.Process.ApparmorProfile)
+	}
+	if spec.Process.SelinuxLabel != "system_u:system_r:container_t:s0" {
+		t.Errorf("default selinux label not set, got %q", spec.Process.SelinuxLabel)
+	}
+}
+
+func TestValidateRuntimes(t *testing.T) {
+	if err := validateRuntimes(map[string]RuntimeEntry{
+		"runc-debug": {Debug: true, RuntimeRoot: "/run/containerd/runc-debug"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	for name, entries := range map[string]map[string]RuntimeEntry{
+		"plugin id":     {pluginID: {}},
+		"relative root": {"kata": {RuntimeRoot: "kata"}},
+	} {
+		if err := validateRuntimes(entries); !errdefs.IsInvalidArgument(err) {
+			t.Errorf("%s: expected invalid argument, got %v", name, err)
+		}
+	}
+}
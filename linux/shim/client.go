@@ -9,6 +9,8 @@ import (
 	"net"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,7 +29,12 @@ import (
 
 type ClientOpt func(context.Context, Config) (shim.ShimClient, io.Closer, error)
 
-// WithStart executes a new shim process
+// LogFile is the file in the bundle of a task to which the stdout and stderr
+// of its shim are written in debug mode
+const LogFile = "shim.log"
+
+// WithStart executes a new shim process. In debug mode the output of the
+// shim is appended to the LogFile of its bundle.
 func WithStart(binary, address string, debug bool) ClientOpt {
 	return func(ctx context.Context, config Config) (_ shim.ShimClient, _ io.Closer, err error) {
 		socket, err := newSocket(config)
@@ -42,6 +49,15 @@ func WithStart(binary, address string, debug bool) ClientOpt {
 		defer f.Close()
 
 		cmd := newCommand(binary, address, debug, config, f)
+		if debug {
+			// the shim keeps writing to the file once containerd exits
+			lf, err := os.OpenFile(filepath.Join(config.Path, LogFile), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "failed to open shim log")
+			}
+			defer lf.Close()
+			cmd.Stdout, cmd.Stderr = lf, lf
+		}
 		if err := reaper.Default.Start(cmd); err != nil {
 			return nil, nil, errors.Wrapf(err, "failed to start shim")
 		}
@@ -57,7 +73,7 @@ func WithStart(binary, address string, debug bool) ClientOpt {
 		}).Infof("shim %s started", binary)
 		// set shim in cgroup if it is provided
 		if config.CgroupPath != "" {
-			if err := setCgroup(ctx, config, cmd); err != nil {
+			if err := setCgroup(ctx, config, cmd.Process.Pid); err != nil {
 				return nil, nil, err
 			}
 		}
@@ -83,6 +99,15 @@ func newCommand(binary, address string, debug bool, config Config, socket *os.Fi
 		"--address", address,
 		"--workdir", config.WorkDir,
 	}
+	if config.IOBufferSize > 0 {
+		args = append(args, "--io-buffer-size", strconv.Itoa(config.IOBufferSize))
+	}
+	if config.ExecRetention.MaxExited != 0 {
+		args = append(args, "--exec-max-exited", strconv.Itoa(config.ExecRetention.MaxExited))
+	}
+	if config.ExecRetention.TTL != 0 {
+		args = append(args, "--exec-ttl", config.ExecRetention.TTL.String())
+	}
 	if debug {
 		args = append(args, "--debug")
 	}
@@ -93,10 +118,6 @@ func newCommand(binary, address string, debug bool, config Config, socket *os.Fi
 	// will be mounted by the shim
 	cmd.SysProcAttr = &atter
 	cmd.ExtraFiles = append(cmd.ExtraFiles, socket)
-	if debug {
-		cmd.Stdout = os.Stdout
-		cmd.Stderr = os.Stderr
-	}
 	return cmd
 }
 
@@ -147,13 +168,69 @@ func WithConnect(ctx context.Context, config Config) (shim.ShimClient, io.Closer
 	if err != nil {
 		return nil, nil, err
 	}
-	return shim.NewShimClient(conn), conn, nil
+	client := shim.NewShimClient(conn)
+	if err := checkVersion(ctx, client); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return client, conn, nil
+}
+
+// WithDialer connects to a shim serving the shim API on the connections
+// returned by dial, such as a shim running in a VM
+func WithDialer(dial func(string, time.Duration) (net.Conn, error)) ClientOpt {
+	return func(ctx context.Context, config Config) (shim.ShimClient, io.Closer, error) {
+		conn, err := connect(config.Address, dial)
+		if err != nil {
+			return nil, nil, err
+		}
+		client := shim.NewShimClient(conn)
+		if err := checkVersion(ctx, client); err != nil {
+			conn.Close()
+			return nil, nil, err
+		}
+		return client, conn, nil
+	}
+}
+
+// IncompatibleError is returned when connecting to a shim that speaks a
+// version of the shim API that is not supported
+type IncompatibleError struct {
+	Version uint32
+}
+
+func (e *IncompatibleError) Error() string {
+	return fmt.Sprintf("shim protocol version %d is not supported, supported versions are %d to %d", e.Version, MinProtocolVersion, ProtocolVersion)
+}
+
+// IsIncompatible returns true if the error is caused by a shim speaking an
+// unsupported version of the shim API
+func IsIncompatible(err error) bool {
+	_, ok := errors.Cause(err).(*IncompatibleError)
+	return ok
+}
+
+// checkVersion returns an IncompatibleError if the shim speaks a version of
+// the shim API that is not supported
+func checkVersion(ctx context.Context, client shim.ShimClient) error {
+	info, err := client.ShimInfo(ctx, empty)
+	if err != nil {
+		return errors.Wrap(err, "failed to get shim info")
+	}
+	version := info.ProtocolVersion
+	if version == 0 {
+		version = 1
+	}
+	if version < MinProtocolVersion || version > ProtocolVersion {
+		return &IncompatibleError{Version: version}
+	}
+	return nil
 }
 
 // WithLocal uses an in process shim
 func WithLocal(publisher events.Publisher) func(context.Context, Config) (shim.ShimClient, io.Closer, error) {
 	return func(ctx context.Context, config Config) (shim.ShimClient, io.Closer, error) {
-		service, err := NewService(config.Path, config.Namespace, config.WorkDir, publisher)
+		service, err := NewService(config.Path, config.Namespace, config.WorkDir, config.IOBufferSize, config.ExecRetention, publisher)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -167,6 +244,11 @@ type Config struct {
 	Namespace  string
 	CgroupPath string
 	WorkDir    string
+	// IOBufferSize is the size of the buffers copying the stdio of the
+	// processes of the shim
+	IOBufferSize int
+	// ExecRetention bounds the exited exec processes retained by the shim
+	ExecRetention ExecRetention
 }
 
 // New returns a new shim client
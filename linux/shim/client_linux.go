@@ -4,11 +4,14 @@ package shim
 
 import (
 	"context"
-	"os/exec"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
 	"syscall"
 
 	"github.com/containerd/cgroups"
 	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/sys"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -18,19 +21,32 @@ var atter = syscall.SysProcAttr{
 	Setpgid:    true,
 }
 
-func setCgroup(ctx context.Context, config Config, cmd *exec.Cmd) error {
-	cg, err := cgroups.Load(cgroups.V1, cgroups.StaticPath(config.CgroupPath))
-	if err != nil {
-		return errors.Wrapf(err, "failed to load cgroup %s", config.CgroupPath)
-	}
-	if err := cg.Add(cgroups.Process{
-		Pid: cmd.Process.Pid,
-	}); err != nil {
-		return errors.Wrapf(err, "failed to join cgroup %s", config.CgroupPath)
+func setCgroup(ctx context.Context, config Config, pid int) error {
+	if sys.IsCgroupUnified() {
+		if err := joinUnifiedCgroup(config.CgroupPath, pid); err != nil {
+			return errors.Wrapf(err, "failed to join cgroup %s", config.CgroupPath)
+		}
+	} else {
+		cg, err := cgroups.Load(cgroups.V1, cgroups.StaticPath(config.CgroupPath))
+		if err != nil {
+			return errors.Wrapf(err, "failed to load cgroup %s", config.CgroupPath)
+		}
+		if err := cg.Add(cgroups.Process{
+			Pid: pid,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to join cgroup %s", config.CgroupPath)
+		}
 	}
 	log.G(ctx).WithFields(logrus.Fields{
-		"pid":     cmd.Process.Pid,
+		"pid":     pid,
 		"address": config.Address,
 	}).Infof("shim placed in cgroup %s", config.CgroupPath)
 	return nil
 }
+
+// joinUnifiedCgroup moves the pid to the cgroup of the cgroup2 hierarchy at
+// the path, which must exist like the cgroups of the v1 hierarchies
+func joinUnifiedCgroup(path string, pid int) error {
+	procs := filepath.Join(sys.CgroupUnifiedMountpoint, path, "cgroup.procs")
+	return ioutil.WriteFile(procs, []byte(strconv.Itoa(pid)), 0)
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
 	"path/filepath"
 	"sync"
 	"syscall"
@@ -15,8 +16,10 @@ import (
 	"golang.org/x/sys/unix"
 
 	"github.com/containerd/console"
+	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/identifiers"
 	shimapi "github.com/containerd/containerd/linux/shim/v1"
+	"github.com/containerd/containerd/oci"
 	"github.com/containerd/fifo"
 	runc "github.com/containerd/go-runc"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
@@ -33,6 +36,7 @@ type execProcess struct {
 	status  int
 	exited  time.Time
 	pid     int
+	started uint64
 	closers []io.Closer
 	stdin   io.Closer
 	stdio   stdio
@@ -63,8 +67,12 @@ func newExecProcess(context context.Context, path string, r *shimapi.ExecProcess
 			stdout:   r.Stdout,
 			stderr:   r.Stderr,
 			terminal: r.Terminal,
+			env:      append(parent.stdio.env[:len(parent.stdio.env):len(parent.stdio.env)], "EXEC_ID="+id),
 		},
 	}
+	if err := validateStdio(e.stdio); err != nil {
+		return nil, err
+	}
 	return e, nil
 }
 
@@ -86,10 +94,22 @@ func (e *execProcess) ExitedAt() time.Time {
 	return e.exited
 }
 
-func (e *execProcess) SetExited(status int) {
+func (e *execProcess) StartedAt() time.Time {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return startedAt(e.started)
+}
+
+func (e *execProcess) SetExited(status int, exitedAt time.Time) {
 	e.status = status
-	e.exited = time.Now()
+	e.exited = exitedAt
 	e.parent.platform.shutdownConsole(context.Background(), e.console)
+}
+
+// closeIO waits for the output of the exited process to be copied and closes
+// its io. A child left in the background holding the output of the process
+// open delays it until the child exits.
+func (e *execProcess) closeIO() {
 	e.Wait()
 	if e.io != nil {
 		for _, c := range e.closers {
@@ -112,9 +132,12 @@ func (e *execProcess) Resize(ws console.WinSize) error {
 
 func (e *execProcess) Kill(ctx context.Context, sig uint32, _ bool) error {
 	e.mu.Lock()
-	pid := e.pid
+	pid, started := e.pid, e.started
 	e.mu.Unlock()
 	if pid != 0 {
+		if err := checkPid(pid, started); err != nil {
+			return errors.Wrapf(err, "exec kill error")
+		}
 		if err := unix.Kill(pid, syscall.Signal(sig)); err != nil {
 			return errors.Wrapf(checkKillError(err), "exec kill error")
 		}
@@ -130,6 +153,30 @@ func (e *execProcess) Stdio() stdio {
 	return e.stdio
 }
 
+// update amends the spec of the process until it is started
+func (e *execProcess) update(r *shimapi.UpdateProcessRequest) error {
+	if e.Pid() != 0 {
+		return errors.Wrapf(errdefs.ErrFailedPrecondition, "process %s is already started", e.id)
+	}
+	var opts []oci.Opt
+	if len(r.Env) > 0 {
+		opts = append(opts, oci.WithEnv(r.Env))
+	}
+	if len(r.Args) > 0 {
+		opts = append(opts, oci.WithArgs(r.Args))
+	}
+	if r.Cwd != "" {
+		opts = append(opts, oci.WithCwd(r.Cwd))
+	}
+	spec := &specs.Spec{Process: &e.spec}
+	for _, o := range opts {
+		if err := o(spec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (e *execProcess) Start(ctx context.Context) (err error) {
 	var (
 		socket  *runc.Socket
@@ -145,7 +192,7 @@ func (e *execProcess) Start(ctx context.Context) (err error) {
 			return errors.Wrap(err, "creating new NULL IO")
 		}
 	} else {
-		if e.io, err = runc.NewPipeIO(); err != nil {
+		if e.io, err = newPipeIO(e.parent.ioUID, e.parent.ioGID); err != nil {
 			return errors.Wrap(err, "failed to create runc io pipes")
 		}
 	}
@@ -160,10 +207,10 @@ func (e *execProcess) Start(ctx context.Context) (err error) {
 	if err := e.parent.runtime.Exec(ctx, e.parent.id, e.spec, opts); err != nil {
 		return e.parent.runtimeError(err, "OCI runtime exec failed")
 	}
-	if e.stdio.stdin != "" {
-		sc, err := fifo.OpenFifo(ctx, e.stdio.stdin, syscall.O_WRONLY|syscall.O_NONBLOCK, 0)
+	if stdin, ok := fifoPath(e.stdio.stdin); ok {
+		sc, err := fifo.OpenFifo(ctx, stdin, syscall.O_WRONLY|syscall.O_NONBLOCK, 0)
 		if err != nil {
-			return errors.Wrapf(err, "failed to open stdin fifo %s", e.stdio.stdin)
+			return errors.Wrapf(err, "failed to open stdin fifo %s", stdin)
 		}
 		e.closers = append(e.closers, sc)
 		e.stdin = sc
@@ -174,11 +221,11 @@ func (e *execProcess) Start(ctx context.Context) (err error) {
 		if err != nil {
 			return errors.Wrap(err, "failed to retrieve console master")
 		}
-		if e.console, err = e.parent.platform.copyConsole(ctx, console, e.stdio.stdin, e.stdio.stdout, e.stdio.stderr, &e.WaitGroup, &copyWaitGroup); err != nil {
+		if e.console, err = e.parent.platform.copyConsole(ctx, console, e.stdio, &e.WaitGroup, &copyWaitGroup); err != nil {
 			return errors.Wrap(err, "failed to start console copy")
 		}
 	} else if !e.stdio.isNull() {
-		if err := copyPipes(ctx, e.io, e.stdio.stdin, e.stdio.stdout, e.stdio.stderr, &e.WaitGroup, &copyWaitGroup); err != nil {
+		if err := e.parent.platform.copyPipes(ctx, e.io, e.stdio, &e.WaitGroup, &copyWaitGroup); err != nil {
 			return errors.Wrap(err, "failed to start io pipe copy")
 		}
 	}
@@ -187,8 +234,14 @@ func (e *execProcess) Start(ctx context.Context) (err error) {
 	if err != nil {
 		return errors.Wrap(err, "failed to retrieve OCI runtime exec pid")
 	}
+	// the process may already have exited and been reaped
+	started, err := processStarted(pid)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to retrieve OCI runtime exec process start time")
+	}
 	e.mu.Lock()
 	e.pid = pid
+	e.started = started
 	e.mu.Unlock()
 	return nil
 }
@@ -210,7 +263,11 @@ func (e *execProcess) Status(ctx context.Context) (string, error) {
 	if e.pid == 0 {
 		return "created", nil
 	}
-	// if we have a pid and it can be signaled, the process is running
+	// if we have a pid that was not reused and it can be signaled, the
+	// process is running
+	if err := checkPid(e.pid, e.started); err != nil {
+		return "stopped", nil
+	}
 	if err := unix.Kill(e.pid, 0); err == nil {
 		return "running", nil
 	}
@@ -0,0 +1,69 @@
+// +build !windows
+
+package shim
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/containerd/console"
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/reaper"
+	runc "github.com/containerd/go-runc"
+	"golang.org/x/net/context"
+)
+
+type nullPlatform struct{}
+
+func (nullPlatform) copyConsole(ctx context.Context, console console.Console, s stdio, wg, cwg *sync.WaitGroup) (console.Console, error) {
+	return console, nil
+}
+
+func (nullPlatform) copyPipes(ctx context.Context, rio runc.IO, s stdio, wg, cwg *sync.WaitGroup) error {
+	return nil
+}
+
+func (nullPlatform) shutdownConsole(ctx context.Context, console console.Console) error {
+	return nil
+}
+
+func TestProcessExitsDoesNotWaitForExecOutput(t *testing.T) {
+	init := &initProcess{id: "init", pid: 11, platform: nullPlatform{}}
+	exec := &execProcess{id: "exec", pid: 10, parent: init}
+	// the output of the exec is still held open, as by a background child
+	exec.Add(1)
+	s := &Service{
+		id:          "container",
+		initProcess: init,
+		processes: map[string]process{
+			"init": init,
+			"exec": exec,
+		},
+		context:   context.Background(),
+		events:    make(chan interface{}, 2),
+		retention: ExecRetention{MaxExited: -1, TTL: -1},
+	}
+	exits := make(chan reaper.Exit, 2)
+	exits <- reaper.Exit{Pid: 10, Timestamp: time.Now()}
+	exits <- reaper.Exit{Pid: 11, Timestamp: time.Now()}
+	close(exits)
+	go s.processExits(exits)
+
+	next := func() *eventsapi.TaskExit {
+		select {
+		case e := <-s.events:
+			return e.(*eventsapi.TaskExit)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for an exit")
+		}
+		return nil
+	}
+	if e := next(); e.ID != "init" {
+		t.Fatalf("expected the exit of the init process first, got %s", e.ID)
+	}
+	exec.Done()
+	if e := next(); e.ID != "exec" {
+		t.Fatalf("expected the exit of the exec once its output is copied, got %s", e.ID)
+	}
+}
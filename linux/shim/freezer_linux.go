@@ -0,0 +1,70 @@
+package shim
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd/sys"
+	"github.com/pkg/errors"
+)
+
+// freezeTimeout is how long the shim waits for the processes of a cgroup2
+// cgroup to be frozen
+const freezeTimeout = 5 * time.Second
+
+// freezeUnified freezes or thaws the cgroup2 cgroup of the pid through its
+// cgroup.freeze file, for OCI runtimes that only freeze the cgroups of the
+// v1 hierarchies. It returns false on hosts running the v1 hierarchies.
+func freezeUnified(pid int, freeze bool) (bool, error) {
+	if !sys.IsCgroupUnified() {
+		return false, nil
+	}
+	path, err := sys.CgroupUnifiedPath(pid)
+	if err != nil {
+		return true, err
+	}
+	value := "0"
+	if freeze {
+		value = "1"
+	}
+	if err := ioutil.WriteFile(filepath.Join(path, "cgroup.freeze"), []byte(value), 0); err != nil {
+		return true, err
+	}
+	// the processes are frozen asynchronously
+	for deadline := time.Now().Add(freezeTimeout); ; {
+		if frozenUnified(path) == freeze {
+			return true, nil
+		}
+		if time.Now().After(deadline) {
+			return true, errors.Errorf("cgroup %s not frozen after %s", path, freezeTimeout)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// isFrozenUnified returns whether the cgroup2 cgroup of the pid is frozen
+func isFrozenUnified(pid int) bool {
+	if !sys.IsCgroupUnified() {
+		return false
+	}
+	path, err := sys.CgroupUnifiedPath(pid)
+	if err != nil {
+		return false
+	}
+	return frozenUnified(path)
+}
+
+func frozenUnified(path string) bool {
+	data, err := ioutil.ReadFile(filepath.Join(path, "cgroup.events"))
+	if err != nil {
+		return false
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.TrimSpace(line) == "frozen 1" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,13 @@
+// +build !windows,!linux
+
+package shim
+
+// freezeUnified is not supported on this platform, processes are only frozen
+// by the OCI runtime
+func freezeUnified(pid int, freeze bool) (bool, error) {
+	return false, nil
+}
+
+func isFrozenUnified(pid int) bool {
+	return false
+}
@@ -17,12 +17,14 @@ import (
 	"golang.org/x/sys/unix"
 
 	"github.com/containerd/console"
+	"github.com/containerd/containerd/api/types"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/identifiers"
 	"github.com/containerd/containerd/linux/runcopts"
 	shimapi "github.com/containerd/containerd/linux/shim/v1"
 	"github.com/containerd/containerd/log"
 	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/tracing"
 	"github.com/containerd/containerd/typeurl"
 	"github.com/containerd/fifo"
 	runc "github.com/containerd/go-runc"
@@ -50,10 +52,14 @@ type initProcess struct {
 	status   int
 	exited   time.Time
 	pid      int
+	started  uint64
 	closers  []io.Closer
 	stdin    io.Closer
 	stdio    stdio
 	rootfs   string
+	// ioUID and ioGID own the stdio pipes of the processes of the task
+	ioUID int
+	ioGID int
 }
 
 func newInitProcess(context context.Context, plat platform, path, namespace, workDir string, r *shimapi.CreateTaskRequest) (*initProcess, error) {
@@ -83,6 +89,7 @@ func newInitProcess(context context.Context, plat platform, path, namespace, wor
 			log.G(context).WithError(err2).Warn("Failed to cleanup rootfs mount")
 		}
 	}()
+	span, _ := tracing.StartSpan(context, "mount")
 	for _, rm := range r.Rootfs {
 		m := &mount.Mount{
 			Type:    rm.Type,
@@ -90,15 +97,23 @@ func newInitProcess(context context.Context, plat platform, path, namespace, wor
 			Options: rm.Options,
 		}
 		if err := m.Mount(rootfs); err != nil {
-			return nil, errors.Wrapf(err, "failed to mount rootfs component %v", m)
+			err = errors.Wrapf(err, "failed to mount rootfs component %v", m)
+			span.Finish(err)
+			return nil, err
 		}
 	}
+	span.Finish(nil)
+	root := RuncRoot
+	if r.RuntimeRoot != "" {
+		root = r.RuntimeRoot
+	}
 	runtime := &runc.Runc{
 		Command:      r.Runtime,
 		Log:          filepath.Join(path, "log.json"),
 		LogFormat:    runc.JSON,
 		PdeathSignal: syscall.SIGKILL,
-		Root:         filepath.Join(RuncRoot, namespace),
+		Root:         filepath.Join(root, namespace),
+		Debug:        r.RuntimeDebug,
 	}
 	p := &initProcess{
 		id:       r.ID,
@@ -110,9 +125,18 @@ func newInitProcess(context context.Context, plat platform, path, namespace, wor
 			stdout:   r.Stdout,
 			stderr:   r.Stderr,
 			terminal: r.Terminal,
+			env: []string{
+				"CONTAINER_NAMESPACE=" + namespace,
+				"CONTAINER_ID=" + r.ID,
+			},
 		},
 		rootfs:  rootfs,
 		workDir: workDir,
+		ioUID:   int(options.IoUid),
+		ioGID:   int(options.IoGid),
+	}
+	if err := validateStdio(p.stdio); err != nil {
+		return nil, err
 	}
 	var (
 		err    error
@@ -123,12 +147,12 @@ func newInitProcess(context context.Context, plat platform, path, namespace, wor
 			return nil, errors.Wrap(err, "failed to create OCI runtime console socket")
 		}
 		defer socket.Close()
-	} else if hasNoIO(r) {
+	} else if p.stdio.isNull() {
 		if p.io, err = runc.NewNullIO(); err != nil {
 			return nil, errors.Wrap(err, "creating new NULL IO")
 		}
 	} else {
-		if p.io, err = runc.NewPipeIO(); err != nil {
+		if p.io, err = newPipeIO(p.ioUID, p.ioGID); err != nil {
 			return nil, errors.Wrap(err, "failed to create OCI runtime io pipes")
 		}
 	}
@@ -146,7 +170,10 @@ func newInitProcess(context context.Context, plat platform, path, namespace, wor
 			Detach:      true,
 			NoSubreaper: true,
 		}
-		if _, err := p.runtime.Restore(context, r.ID, r.Bundle, opts); err != nil {
+		span, sctx := tracing.StartSpan(context, "runc.restore")
+		_, err := p.runtime.Restore(sctx, r.ID, r.Bundle, opts)
+		span.Finish(err)
+		if err != nil {
 			return nil, p.runtimeError(err, "OCI runtime restore failed")
 		}
 	} else {
@@ -159,14 +186,17 @@ func newInitProcess(context context.Context, plat platform, path, namespace, wor
 		if socket != nil {
 			opts.ConsoleSocket = socket
 		}
-		if err := p.runtime.Create(context, r.ID, r.Bundle, opts); err != nil {
+		span, sctx := tracing.StartSpan(context, "runc.create")
+		err := p.runtime.Create(sctx, r.ID, r.Bundle, opts)
+		span.Finish(err)
+		if err != nil {
 			return nil, p.runtimeError(err, "OCI runtime create failed")
 		}
 	}
-	if r.Stdin != "" {
-		sc, err := fifo.OpenFifo(context, r.Stdin, syscall.O_WRONLY|syscall.O_NONBLOCK, 0)
+	if stdin, ok := fifoPath(r.Stdin); ok {
+		sc, err := fifo.OpenFifo(context, stdin, syscall.O_WRONLY|syscall.O_NONBLOCK, 0)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to open stdin fifo %s", r.Stdin)
+			return nil, errors.Wrapf(err, "failed to open stdin fifo %s", stdin)
 		}
 		p.stdin = sc
 		p.closers = append(p.closers, sc)
@@ -177,13 +207,13 @@ func newInitProcess(context context.Context, plat platform, path, namespace, wor
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to retrieve console master")
 		}
-		console, err = plat.copyConsole(context, console, r.Stdin, r.Stdout, r.Stderr, &p.WaitGroup, &copyWaitGroup)
+		console, err = plat.copyConsole(context, console, p.stdio, &p.WaitGroup, &copyWaitGroup)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to start console copy")
 		}
 		p.console = console
-	} else if !hasNoIO(r) {
-		if err := copyPipes(context, p.io, r.Stdin, r.Stdout, r.Stderr, &p.WaitGroup, &copyWaitGroup); err != nil {
+	} else if !p.stdio.isNull() {
+		if err := plat.copyPipes(context, p.io, p.stdio, &p.WaitGroup, &copyWaitGroup); err != nil {
 			return nil, errors.Wrap(err, "failed to start io pipe copy")
 		}
 	}
@@ -194,6 +224,14 @@ func newInitProcess(context context.Context, plat platform, path, namespace, wor
 		return nil, errors.Wrap(err, "failed to retrieve OCI runtime container pid")
 	}
 	p.pid = pid
+	if p.started, err = processStarted(pid); err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "failed to retrieve container process start time")
+	}
+	if options.SystemdCgroup {
+		if err := startScope(r.Bundle, r.ID, pid); err != nil {
+			return nil, err
+		}
+	}
 	success = true
 	return p, nil
 }
@@ -214,12 +252,20 @@ func (p *initProcess) ExitedAt() time.Time {
 	return p.exited
 }
 
+func (p *initProcess) StartedAt() time.Time {
+	return startedAt(p.started)
+}
+
 // Status return the state of the container (created, running, paused, stopped)
 func (p *initProcess) Status(ctx context.Context) (string, error) {
 	c, err := p.runtime.State(ctx, p.id)
 	if err != nil {
 		return "", p.runtimeError(err, "OCI runtime state failed")
 	}
+	// a cgroup2 cgroup frozen by the shim is running for the OCI runtime
+	if c.Status == "running" && isFrozenUnified(p.pid) {
+		return "paused", nil
+	}
 	return c.Status, nil
 }
 
@@ -230,10 +276,10 @@ func (p *initProcess) Start(context context.Context) error {
 	return p.runtimeError(err, "OCI runtime start failed")
 }
 
-func (p *initProcess) SetExited(status int) {
+func (p *initProcess) SetExited(status int, exitedAt time.Time) {
 	p.mu.Lock()
 	p.status = status
-	p.exited = time.Now()
+	p.exited = exitedAt
 	p.platform.shutdownConsole(context.Background(), p.console)
 	p.mu.Unlock()
 }
@@ -278,11 +324,22 @@ func (p *initProcess) Resize(ws console.WinSize) error {
 
 func (p *initProcess) Pause(context context.Context) error {
 	err := p.runtime.Pause(context, p.id)
+	if err != nil {
+		// OCI runtimes predating cgroup2 cannot freeze its cgroups
+		if ok, ferr := freezeUnified(p.pid, true); ok {
+			return ferr
+		}
+	}
 	return p.runtimeError(err, "OCI runtime pause failed")
 }
 
 func (p *initProcess) Resume(context context.Context) error {
 	err := p.runtime.Resume(context, p.id)
+	if err != nil {
+		if ok, ferr := freezeUnified(p.pid, false); ok {
+			return ferr
+		}
+	}
 	return p.runtimeError(err, "OCI runtime resume failed")
 }
 
@@ -314,7 +371,11 @@ func (p *initProcess) Checkpoint(context context.Context, r *shimapi.CheckpointT
 		options = *v.(*runcopts.CheckpointOptions)
 	}
 	var actions []runc.CheckpointAction
-	if !options.Exit {
+	switch {
+	case options.PreDump:
+		// the task keeps running after a pre-dump
+		actions = append(actions, runc.PreDump)
+	case !options.Exit:
 		actions = append(actions, runc.LeaveRunning)
 	}
 	work := filepath.Join(p.workDir, "criu-work")
@@ -322,6 +383,7 @@ func (p *initProcess) Checkpoint(context context.Context, r *shimapi.CheckpointT
 	if err := p.runtime.Checkpoint(context, p.id, &runc.CheckpointOpts{
 		WorkDir:                  p.workDir,
 		ImagePath:                r.Path,
+		ParentPath:               r.ParentPath,
 		AllowOpenTCP:             options.OpenTcp,
 		AllowExternalUnixSockets: options.ExternalUnixSockets,
 		AllowTerminal:            options.Terminal,
@@ -394,7 +456,10 @@ func (p *initProcess) runtimeError(rErr error, msg string) error {
 	case rMsg == "":
 		return errors.Wrap(rErr, msg)
 	default:
-		return errors.Errorf("%s: %s", msg, rMsg)
+		return errdefs.WithDetail(errors.Errorf("%s: %s", msg, rMsg), types.ErrorDetail{
+			ContainerID:  p.id,
+			RuntimeError: rMsg,
+		})
 	}
 }
 
@@ -429,7 +494,3 @@ func checkKillError(err error) error {
 	}
 	return errors.Wrapf(err, "unknown error after kill")
 }
-
-func hasNoIO(r *shimapi.CreateTaskRequest) bool {
-	return r.Stdin == "" && r.Stdout == "" && r.Stderr == ""
-}
@@ -4,98 +4,105 @@ package shim
 
 import (
 	"context"
-	"fmt"
 	"io"
 	"sync"
-	"syscall"
+	"sync/atomic"
 
-	"github.com/containerd/console"
-	"github.com/containerd/fifo"
 	runc "github.com/containerd/go-runc"
+	"github.com/pkg/errors"
 )
 
-func copyConsole(ctx context.Context, console console.Console, stdin, stdout, stderr string, wg, cwg *sync.WaitGroup) error {
-	if stdin != "" {
-		in, err := fifo.OpenFifo(ctx, stdin, syscall.O_RDONLY, 0)
-		if err != nil {
-			return err
-		}
-		cwg.Add(1)
-		go func() {
-			cwg.Done()
-			io.Copy(console, in)
-		}()
+// DefaultIOBufferSize is the size of the buffers copying the stdio of
+// processes when none is configured
+const DefaultIOBufferSize = 32 << 10
+
+// ioConfig configures the copying of the stdio of the processes of the shim
+// and counts the bytes copied for each stream
+type ioConfig struct {
+	// the counters are accessed atomically and kept first for their
+	// alignment on 32 bit platforms
+	stdin  uint64
+	stdout uint64
+	stderr uint64
+
+	bufSize int
+	pool    sync.Pool
+}
+
+func newIOConfig(bufSize int) *ioConfig {
+	if bufSize <= 0 {
+		bufSize = DefaultIOBufferSize
 	}
-	outw, err := fifo.OpenFifo(ctx, stdout, syscall.O_WRONLY, 0)
-	if err != nil {
-		return err
+	c := &ioConfig{
+		bufSize: bufSize,
 	}
-	outr, err := fifo.OpenFifo(ctx, stdout, syscall.O_RDONLY, 0)
-	if err != nil {
-		return err
+	c.pool.New = func() interface{} {
+		return make([]byte, c.bufSize)
 	}
-	wg.Add(1)
-	cwg.Add(1)
-	go func() {
-		cwg.Done()
-		io.Copy(outw, console)
-		console.Close()
-		outr.Close()
-		outw.Close()
-		wg.Done()
-	}()
-	return nil
+	return c
+}
+
+// copy copies src to dst with a pooled buffer, adding the bytes copied to
+// the counter
+func (c *ioConfig) copy(dst io.Writer, src io.Reader, counter *uint64) (int64, error) {
+	buf := c.pool.Get().([]byte)
+	defer c.pool.Put(buf)
+	return io.CopyBuffer(&countingWriter{w: dst, n: counter}, src, buf)
 }
 
-func copyPipes(ctx context.Context, rio runc.IO, stdin, stdout, stderr string, wg, cwg *sync.WaitGroup) error {
-	for name, dest := range map[string]func(wc io.WriteCloser, rc io.Closer){
-		stdout: func(wc io.WriteCloser, rc io.Closer) {
-			wg.Add(1)
-			cwg.Add(1)
-			go func() {
-				cwg.Done()
-				io.Copy(wc, rio.Stdout())
-				wg.Done()
-				wc.Close()
-				rc.Close()
-			}()
-		},
-		stderr: func(wc io.WriteCloser, rc io.Closer) {
-			wg.Add(1)
-			cwg.Add(1)
-			go func() {
-				cwg.Done()
-				io.Copy(wc, rio.Stderr())
-				wg.Done()
-				wc.Close()
-				rc.Close()
-			}()
-		},
+// counters returns the bytes copied for stdin, stdout and stderr
+func (c *ioConfig) counters() (stdin, stdout, stderr uint64) {
+	return atomic.LoadUint64(&c.stdin), atomic.LoadUint64(&c.stdout), atomic.LoadUint64(&c.stderr)
+}
+
+type countingWriter struct {
+	w io.Writer
+	n *uint64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	atomic.AddUint64(w.n, uint64(n))
+	return n, err
+}
+
+// copyPipes copies the stdio of the process between its pipes and the
+// destinations of its stdio
+func copyPipes(ctx context.Context, c *ioConfig, rio runc.IO, s stdio, wg, cwg *sync.WaitGroup) error {
+	outputs, err := openOutputs(ctx, s.env, s.stdout, s.stderr)
+	if err != nil {
+		return errors.Wrap(err, "containerd-shim: opening outputs failed")
+	}
+	for i, o := range []struct {
+		src     io.Reader
+		counter *uint64
+	}{
+		{rio.Stdout(), &c.stdout},
+		{rio.Stderr(), &c.stderr},
 	} {
-		fw, err := fifo.OpenFifo(ctx, name, syscall.O_WRONLY, 0)
-		if err != nil {
-			return fmt.Errorf("containerd-shim: opening %s failed: %s", name, err)
-		}
-		fr, err := fifo.OpenFifo(ctx, name, syscall.O_RDONLY, 0)
-		if err != nil {
-			return fmt.Errorf("containerd-shim: opening %s failed: %s", name, err)
-		}
-		dest(fw, fr)
+		wg.Add(1)
+		cwg.Add(1)
+		go func(dst io.WriteCloser, src io.Reader, counter *uint64) {
+			cwg.Done()
+			c.copyOutput(dst, src, counter)
+			wg.Done()
+			dst.Close()
+		}(outputs[i], o.src, o.counter)
 	}
-	if stdin == "" {
+	in, err := openInput(ctx, s.stdin)
+	if err != nil {
+		return errors.Wrapf(err, "containerd-shim: opening %s failed", s.stdin)
+	}
+	if in == nil {
 		rio.Stdin().Close()
 		return nil
 	}
-	f, err := fifo.OpenFifo(ctx, stdin, syscall.O_RDONLY, 0)
-	if err != nil {
-		return fmt.Errorf("containerd-shim: opening %s failed: %s", stdin, err)
-	}
 	cwg.Add(1)
 	go func() {
 		cwg.Done()
-		io.Copy(rio.Stdin(), f)
+		c.copy(rio.Stdin(), in, &c.stdin)
 		rio.Stdin().Close()
-		f.Close()
+		in.Close()
 	}()
 	return nil
 }
@@ -0,0 +1,66 @@
+package shim
+
+import (
+	"io"
+	"os"
+	"sync/atomic"
+
+	"golang.org/x/sys/unix"
+)
+
+// copyOutput copies the output of a process to its destination. Pipes of
+// the process are spliced into fifos, files and the pipes of binary loggers
+// within the kernel, falling back to copying through a buffer when the
+// destination does not support it.
+func (c *ioConfig) copyOutput(dst io.Writer, src io.Reader, counter *uint64) {
+	if f, ok := src.(*os.File); ok {
+		if out, closeOut, err := outputFile(dst); err == nil {
+			spliced, err := c.splice(out, f, counter)
+			if closeOut {
+				out.Close()
+			}
+			if spliced || err != unix.EINVAL {
+				return
+			}
+		}
+	}
+	c.copy(dst, src, counter)
+}
+
+// outputFile returns the file of the destination of an output to splice
+// into and whether it must be closed after splicing. Fifos are opened again
+// as the writers of the fifo package are not files.
+func outputFile(dst io.Writer) (*os.File, bool, error) {
+	switch o := dst.(type) {
+	case *os.File:
+		return o, false, nil
+	case *loggerWriter:
+		return o.File, false, nil
+	case *fifoOutput:
+		f, err := os.OpenFile(o.path, os.O_WRONLY, 0)
+		return f, true, err
+	}
+	return nil, false, unix.EINVAL
+}
+
+// splice moves the data of src to dst until src is closed, returning whether
+// any data was moved. The descriptors are put in blocking mode so that the
+// calls wait for data and room in the pipes.
+func (c *ioConfig) splice(dst, src *os.File, counter *uint64) (bool, error) {
+	var (
+		rfd     = int(src.Fd())
+		wfd     = int(dst.Fd())
+		spliced bool
+	)
+	for {
+		n, err := unix.Splice(rfd, nil, wfd, nil, c.bufSize, unix.SPLICE_F_MOVE)
+		if err == unix.EINTR {
+			continue
+		}
+		if err != nil || n == 0 {
+			return spliced, err
+		}
+		spliced = true
+		atomic.AddUint64(counter, uint64(n))
+	}
+}
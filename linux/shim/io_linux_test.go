@@ -0,0 +1,76 @@
+package shim
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"syscall"
+	"testing"
+)
+
+func TestCopyOutputSplice(t *testing.T) {
+	dir, err := ioutil.TempDir("", "shim-io-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "stdout")
+	if err := syscall.Mkfifo(path, 0600); err != nil {
+		t.Fatal(err)
+	}
+	fr, err := os.OpenFile(path, os.O_RDONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fr.Close()
+	fw, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fw.Close()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	data := bytes.Repeat([]byte("containerd\n"), 1000)
+	go func() {
+		w.Write(data)
+		w.Close()
+	}()
+	read := make(chan []byte)
+	go func() {
+		syscall.SetNonblock(int(fr.Fd()), false)
+		b, _ := ioutil.ReadAll(fr)
+		read <- b
+	}()
+
+	c := newIOConfig(4096)
+	c.copyOutput(&fifoOutput{WriteCloser: fw, reader: fr, path: path}, r, &c.stdout)
+	fw.Close()
+	if b := <-read; !bytes.Equal(b, data) {
+		t.Fatalf("expected %d bytes to be copied, got %d", len(data), len(b))
+	}
+	if n := atomic.LoadUint64(&c.stdout); n != uint64(len(data)) {
+		t.Fatalf("expected %d bytes to be counted, got %d", len(data), n)
+	}
+}
+
+func TestCopyOutputBuffer(t *testing.T) {
+	var out bytes.Buffer
+	c := newIOConfig(0)
+	if c.bufSize != DefaultIOBufferSize {
+		t.Fatalf("expected the default buffer size, got %d", c.bufSize)
+	}
+	data := []byte("hello")
+	c.copyOutput(&out, bytes.NewReader(data), &c.stderr)
+	if out.String() != "hello" {
+		t.Fatalf("unexpected output %q", out.String())
+	}
+	if _, _, stderr := c.counters(); stderr != uint64(len(data)) {
+		t.Fatalf("expected %d bytes to be counted, got %d", len(data), stderr)
+	}
+}
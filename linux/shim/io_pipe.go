@@ -0,0 +1,106 @@
+// +build !windows
+
+package shim
+
+import (
+	"io"
+	"os"
+	"os/exec"
+
+	runc "github.com/containerd/go-runc"
+	"golang.org/x/sys/unix"
+)
+
+// newPipeIO returns the pipes of the stdio of a process, with the ends of
+// the process owned by the uid and gid so that a process running as the root
+// of a user namespace can reopen them, such as through /dev/stdout
+func newPipeIO(uid, gid int) (_ runc.IO, err error) {
+	if uid == 0 && gid == 0 {
+		return runc.NewPipeIO()
+	}
+	var pipes []*pipe
+	defer func() {
+		if err != nil {
+			for _, p := range pipes {
+				p.Close()
+			}
+		}
+	}()
+	for i := 0; i < 3; i++ {
+		p, err := newPipe()
+		if err != nil {
+			return nil, err
+		}
+		pipes = append(pipes, p)
+	}
+	// stdin is read by the process and its outputs are written by it
+	for _, f := range []*os.File{pipes[0].r, pipes[1].w, pipes[2].w} {
+		if err := unix.Fchown(int(f.Fd()), uid, gid); err != nil {
+			return nil, err
+		}
+	}
+	return &pipeIO{in: pipes[0], out: pipes[1], err: pipes[2]}, nil
+}
+
+type pipe struct {
+	r *os.File
+	w *os.File
+}
+
+func newPipe() (*pipe, error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	return &pipe{r: r, w: w}, nil
+}
+
+func (p *pipe) Close() error {
+	err := p.r.Close()
+	if werr := p.w.Close(); err == nil {
+		err = werr
+	}
+	return err
+}
+
+type pipeIO struct {
+	in  *pipe
+	out *pipe
+	err *pipe
+}
+
+func (i *pipeIO) Stdin() io.WriteCloser {
+	return i.in.w
+}
+
+func (i *pipeIO) Stdout() io.ReadCloser {
+	return i.out.r
+}
+
+func (i *pipeIO) Stderr() io.ReadCloser {
+	return i.err.r
+}
+
+func (i *pipeIO) Close() error {
+	var err error
+	for _, p := range []*pipe{i.in, i.out, i.err} {
+		if cerr := p.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// CloseAfterStart closes the ends of the outputs of the process once it is
+// started so that the outputs end when it exits
+func (i *pipeIO) CloseAfterStart() error {
+	i.out.w.Close()
+	i.err.w.Close()
+	return nil
+}
+
+func (i *pipeIO) Set(cmd *exec.Cmd) {
+	cmd.Stdin = i.in.r
+	cmd.Stdout = i.out.w
+	cmd.Stderr = i.err.w
+}
@@ -0,0 +1,10 @@
+// +build !windows,!linux
+
+package shim
+
+import "io"
+
+// copyOutput copies the output of a process to its destination
+func (c *ioConfig) copyOutput(dst io.Writer, src io.Reader, counter *uint64) {
+	c.copy(dst, src, counter)
+}
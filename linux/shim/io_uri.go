@@ -0,0 +1,217 @@
+// +build !windows
+
+package shim
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/fifo"
+	"github.com/pkg/errors"
+)
+
+// The schemes of the stdio of a process. A stdio without a scheme is the
+// path of a fifo and an empty stdio is null.
+const (
+	// stdioFifo copies the stdio through the fifo at the path
+	stdioFifo = "fifo"
+	// stdioFile copies stdin from the file at the path and appends the
+	// output to it
+	stdioFile = "file"
+	// stdioBinary copies the output to a logger binary started and
+	// supervised by the shim
+	stdioBinary = "binary"
+	// stdioNull discards the stdio
+	stdioNull = "null"
+	// stdioVsock copies the stdio through a connection to the vsock port
+	// of the host, or of the context id, such as vsock://1026 or
+	// vsock://2:1026, for shims running in VMs
+	stdioVsock = "vsock"
+	// stdioSerial copies the stdio through the serial port at the path,
+	// which is set to raw mode
+	stdioSerial = "serial"
+)
+
+// parseStdio parses the stdio of a process, returning an invalid argument
+// error for unsupported schemes
+func parseStdio(s string) (*url.URL, error) {
+	if s == "" {
+		return &url.URL{Scheme: stdioNull}, nil
+	}
+	if !strings.Contains(s, "://") {
+		return &url.URL{Scheme: stdioFifo, Path: s}, nil
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid stdio %q: %v", s, err)
+	}
+	switch u.Scheme {
+	case stdioNull:
+	case stdioVsock:
+		if _, _, err := vsockAddress(s); err != nil {
+			return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid stdio %q: %v", s, err)
+		}
+	case stdioFifo, stdioFile, stdioBinary, stdioSerial:
+		if u.Host != "" || !filepath.IsAbs(u.Path) {
+			return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "stdio %q requires an absolute path", s)
+		}
+	default:
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "unsupported stdio scheme %q", u.Scheme)
+	}
+	return u, nil
+}
+
+// validateStdio checks the stdio of a process before it is created so that
+// invalid stdio is reported to the client rather than by the runtime
+func validateStdio(s stdio) error {
+	for i, v := range []string{s.stdin, s.stdout, s.stderr} {
+		u, err := parseStdio(v)
+		if err != nil {
+			return err
+		}
+		if u.Scheme != stdioBinary {
+			continue
+		}
+		if i == 0 {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "stdin cannot be a binary logger")
+		}
+		info, err := os.Stat(u.Path)
+		if err != nil {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "binary logger %s: %v", u.Path, err)
+		}
+		if info.IsDir() {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "binary logger %s is a directory", u.Path)
+		}
+	}
+	return nil
+}
+
+// isNullStdio returns whether the stdio discards its data
+func isNullStdio(s string) bool {
+	return s == "" || strings.HasPrefix(s, stdioNull+"://")
+}
+
+// fifoPath returns the path of the stdio if it is a fifo
+func fifoPath(s string) (string, bool) {
+	u, err := parseStdio(s)
+	if err != nil || u.Scheme != stdioFifo {
+		return "", false
+	}
+	return u.Path, true
+}
+
+// openInput opens the stdin of a process for reading, returning nil when it
+// is null
+func openInput(ctx context.Context, s string) (io.ReadCloser, error) {
+	u, err := parseStdio(s)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case stdioFifo:
+		return fifo.OpenFifo(ctx, u.Path, syscall.O_RDONLY, 0)
+	case stdioFile:
+		return os.Open(u.Path)
+	case stdioVsock:
+		return dialVsock(s)
+	case stdioSerial:
+		return openSerial(u.Path)
+	}
+	return nil, nil
+}
+
+// fifoOutput is an output written to a fifo, which is kept open for reading
+// so that writes do not fail while no client reads the fifo
+type fifoOutput struct {
+	io.WriteCloser
+	reader io.Closer
+	path   string
+}
+
+func (f *fifoOutput) Close() error {
+	err := f.WriteCloser.Close()
+	f.reader.Close()
+	return err
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+// openOutputs opens the outputs of a process for writing. The outputs using
+// the same binary logger share its process, which receives stdout on the
+// file descriptor 3 and stderr on 4.
+func openOutputs(ctx context.Context, env []string, outputs ...string) (_ []io.WriteCloser, err error) {
+	var (
+		writers = make([]io.WriteCloser, 0, len(outputs))
+		loggers = make(map[string]*binaryLogger)
+	)
+	defer func() {
+		if err != nil {
+			for _, w := range writers {
+				w.Close()
+			}
+		}
+	}()
+	for i, s := range outputs {
+		u, err := parseStdio(s)
+		if err != nil {
+			return nil, err
+		}
+		var w io.WriteCloser
+		switch u.Scheme {
+		case stdioFifo:
+			fw, err := fifo.OpenFifo(ctx, u.Path, syscall.O_WRONLY, 0)
+			if err != nil {
+				return nil, errors.Wrapf(err, "containerd-shim: opening %s failed", u.Path)
+			}
+			fr, err := fifo.OpenFifo(ctx, u.Path, syscall.O_RDONLY, 0)
+			if err != nil {
+				fw.Close()
+				return nil, errors.Wrapf(err, "containerd-shim: opening %s failed", u.Path)
+			}
+			w = &fifoOutput{WriteCloser: fw, reader: fr, path: u.Path}
+		case stdioFile:
+			if w, err = os.OpenFile(u.Path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0640); err != nil {
+				return nil, err
+			}
+		case stdioVsock:
+			if w, err = dialVsock(s); err != nil {
+				return nil, errors.Wrapf(err, "containerd-shim: connecting %s failed", s)
+			}
+		case stdioSerial:
+			if w, err = openSerial(u.Path); err != nil {
+				return nil, errors.Wrapf(err, "containerd-shim: opening %s failed", u.Path)
+			}
+		case stdioBinary:
+			l, ok := loggers[s]
+			if !ok {
+				l = newBinaryLogger(u, env)
+				loggers[s] = l
+			}
+			if w, err = l.pipe(i); err != nil {
+				return nil, err
+			}
+		default:
+			w = nopWriteCloser{ioutil.Discard}
+		}
+		writers = append(writers, w)
+	}
+	for _, l := range loggers {
+		if err := l.start(); err != nil {
+			return nil, errors.Wrapf(err, "failed to start binary logger %s", l.path)
+		}
+	}
+	return writers, nil
+}
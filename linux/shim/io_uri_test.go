@@ -0,0 +1,149 @@
+// +build !windows
+
+package shim
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/reaper"
+)
+
+func TestParseStdio(t *testing.T) {
+	for _, tc := range []struct {
+		stdio  string
+		scheme string
+		path   string
+	}{
+		{"", stdioNull, ""},
+		{"null://", stdioNull, ""},
+		{"/run/fifo/stdout", stdioFifo, "/run/fifo/stdout"},
+		{"fifo:///run/fifo/stdout", stdioFifo, "/run/fifo/stdout"},
+		{"file:///var/log/app.log", stdioFile, "/var/log/app.log"},
+		{"binary:///usr/bin/logger?arg=x", stdioBinary, "/usr/bin/logger"},
+		{"serial:///dev/ttyS1", stdioSerial, "/dev/ttyS1"},
+		{"vsock://1026", stdioVsock, ""},
+		{"vsock://2:1026", stdioVsock, ""},
+	} {
+		u, err := parseStdio(tc.stdio)
+		if err != nil {
+			t.Fatalf("%q: %v", tc.stdio, err)
+		}
+		if u.Scheme != tc.scheme || u.Path != tc.path {
+			t.Fatalf("%q: expected %s %q, got %s %q", tc.stdio, tc.scheme, tc.path, u.Scheme, u.Path)
+		}
+	}
+	for _, s := range []string{
+		"http://example.com/stdout",
+		"file://relative/app.log",
+		"binary://",
+		"serial://ttyS1",
+		"vsock://port",
+	} {
+		if _, err := parseStdio(s); !errdefs.IsInvalidArgument(err) {
+			t.Fatalf("%q: expected an invalid argument error, got %v", s, err)
+		}
+	}
+}
+
+func TestValidateStdio(t *testing.T) {
+	if err := validateStdio(stdio{stdin: "binary:///bin/cat"}); !errdefs.IsInvalidArgument(err) {
+		t.Fatalf("expected a binary stdin to be rejected, got %v", err)
+	}
+	if err := validateStdio(stdio{stdout: "binary:///does/not/exist"}); !errdefs.IsInvalidArgument(err) {
+		t.Fatalf("expected a missing binary logger to be rejected, got %v", err)
+	}
+	if err := validateStdio(stdio{stdout: "binary:///bin/cat", stderr: "null://"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFileOutput(t *testing.T) {
+	dir, err := ioutil.TempDir("", "shim-io-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "app.log")
+	if err := ioutil.WriteFile(path, []byte("first\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	outputs, err := openOutputs(context.Background(), nil, "file://"+path, "null://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newIOConfig(0)
+	c.copyOutput(outputs[0], strings.NewReader("second\n"), &c.stdout)
+	c.copyOutput(outputs[1], strings.NewReader("discarded\n"), &c.stderr)
+	for _, o := range outputs {
+		o.Close()
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "first\nsecond\n" {
+		t.Fatalf("unexpected log %q", data)
+	}
+}
+
+func TestBinaryLoggerOutput(t *testing.T) {
+	// the shim reaps its children on SIGCHLD
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(10 * time.Millisecond):
+				reaper.Reap()
+			}
+		}
+	}()
+	dir, err := ioutil.TempDir("", "shim-logger-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "log")
+	script := "#!/bin/sh\n" +
+		"{ cat <&3; echo \"$CONTAINER_ID $1\"; } >" + path + "\n"
+	binary := filepath.Join(dir, "logger")
+	if err := ioutil.WriteFile(binary, []byte(script), 0700); err != nil {
+		t.Fatal(err)
+	}
+	uri := "binary://" + binary + "?arg=x"
+	outputs, err := openOutputs(context.Background(), []string{"CONTAINER_ID=test"}, uri, uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger := outputs[0].(*loggerWriter).logger
+	if outputs[1].(*loggerWriter).logger != logger {
+		t.Fatal("expected the outputs to share the logger")
+	}
+	c := newIOConfig(0)
+	c.copyOutput(outputs[0], bytes.NewReader([]byte("hello\n")), &c.stdout)
+	for _, o := range outputs {
+		o.Close()
+	}
+	select {
+	case <-logger.done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("binary logger did not exit")
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "hello\ntest x\n" {
+		t.Fatalf("unexpected log %q", data)
+	}
+}
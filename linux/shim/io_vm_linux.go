@@ -0,0 +1,42 @@
+package shim
+
+import (
+	"net"
+	"os"
+
+	"github.com/containerd/console"
+	"github.com/containerd/containerd/sys"
+	"golang.org/x/sys/unix"
+)
+
+func vsockAddress(s string) (uint32, uint32, error) {
+	return sys.ParseVsockAddress(s)
+}
+
+// dialVsock connects the stdio to the vsock address
+func dialVsock(s string) (net.Conn, error) {
+	cid, port, err := sys.ParseVsockAddress(s)
+	if err != nil {
+		return nil, err
+	}
+	return sys.DialVsock(cid, port)
+}
+
+// openSerial opens the serial port in raw mode, so that the stdio is not
+// altered by the line discipline
+func openSerial(path string) (*os.File, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		return nil, err
+	}
+	c, err := console.ConsoleFromFile(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := c.SetRaw(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
@@ -0,0 +1,23 @@
+// +build !windows,!linux
+
+package shim
+
+import (
+	"net"
+	"os"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+)
+
+func vsockAddress(s string) (uint32, uint32, error) {
+	return 0, 0, errors.Wrap(errdefs.ErrNotImplemented, "vsock is only supported on linux")
+}
+
+func dialVsock(s string) (net.Conn, error) {
+	return nil, errors.Wrap(errdefs.ErrNotImplemented, "vsock is only supported on linux")
+}
+
+func openSerial(path string) (*os.File, error) {
+	return nil, errors.Wrap(errdefs.ErrNotImplemented, "serial stdio is only supported on linux")
+}
@@ -86,3 +86,7 @@ func (c *local) ShimInfo(ctx context.Context, in *google_protobuf.Empty, opts ..
 func (c *local) Update(ctx context.Context, in *shimapi.UpdateTaskRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
 	return c.s.Update(ctx, in)
 }
+
+func (c *local) UpdateProcess(ctx context.Context, in *shimapi.UpdateProcessRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	return c.s.UpdateProcess(ctx, in)
+}
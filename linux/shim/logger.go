@@ -0,0 +1,149 @@
+// +build !windows
+
+package shim
+
+import (
+	"net/url"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/reaper"
+)
+
+const (
+	// loggerRestartDelay is how long the shim waits before restarting a
+	// binary logger that exited while the output of the process is open
+	loggerRestartDelay = time.Second
+	// loggerExitTimeout is how long a binary logger is given to log the end
+	// of the output before it is killed
+	loggerExitTimeout = 10 * time.Second
+)
+
+// binaryLogger supervises a logger binary receiving the output of a process
+// on pipes. The shim keeps the read ends of the pipes so that the output is
+// held in the pipes rather than lost while the logger is restarted.
+type binaryLogger struct {
+	path string
+	args []string
+	env  []string
+	// files are the read ends of the pipes of stdout and stderr, nil for
+	// an output that does not use the logger
+	files []*os.File
+
+	mu     sync.Mutex
+	cmd    *exec.Cmd
+	open   int
+	closed bool
+	done   chan struct{}
+}
+
+func newBinaryLogger(u *url.URL, env []string) *binaryLogger {
+	return &binaryLogger{
+		path:  u.Path,
+		args:  u.Query()["arg"],
+		env:   env,
+		files: make([]*os.File, 2),
+		done:  make(chan struct{}),
+	}
+}
+
+// pipe returns the writer of the output at index i, 0 for stdout and 1 for
+// stderr
+func (l *binaryLogger) pipe(i int) (*loggerWriter, error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	l.files[i] = r
+	l.open++
+	return &loggerWriter{File: w, logger: l}, nil
+}
+
+// start starts the logger and supervises it until its outputs are closed
+func (l *binaryLogger) start() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	cmd := exec.Command(l.path, l.args...)
+	cmd.Env = append(os.Environ(), l.env...)
+	cmd.ExtraFiles = l.files
+	if err := reaper.Default.Start(cmd); err != nil {
+		return err
+	}
+	l.cmd = cmd
+	go l.supervise(cmd)
+	return nil
+}
+
+// supervise waits for the logger to exit and restarts it while the output
+// of the process is open
+func (l *binaryLogger) supervise(cmd *exec.Cmd) {
+	status, _ := reaper.Default.Wait(cmd)
+	reaper.Default.Delete(cmd.Process.Pid)
+	for {
+		l.mu.Lock()
+		closed := l.closed
+		l.mu.Unlock()
+		if closed {
+			break
+		}
+		log.L.WithField("logger", l.path).Warnf("binary logger exited with status %d, restarting", status)
+		time.Sleep(loggerRestartDelay)
+		err := l.start()
+		if err == nil {
+			return
+		}
+		log.L.WithError(err).WithField("logger", l.path).Error("failed to restart binary logger")
+	}
+	for _, f := range l.files {
+		if f != nil {
+			f.Close()
+		}
+	}
+	close(l.done)
+}
+
+// closeWriter stops the logger once all its outputs are closed, killing it
+// if it does not exit on its own
+func (l *binaryLogger) closeWriter() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.open--; l.open > 0 {
+		return
+	}
+	l.closed = true
+	if l.cmd == nil {
+		// the logger was never started
+		for _, f := range l.files {
+			if f != nil {
+				f.Close()
+			}
+		}
+		return
+	}
+	go func() {
+		select {
+		case <-l.done:
+		case <-time.After(loggerExitTimeout):
+			l.mu.Lock()
+			l.cmd.Process.Kill()
+			l.mu.Unlock()
+		}
+	}()
+}
+
+// loggerWriter is the write end of a pipe to a binary logger
+type loggerWriter struct {
+	*os.File
+	logger *binaryLogger
+	once   sync.Once
+}
+
+// Close closes the pipe, marking the output closed first so that the logger
+// exiting at the end of the output is not restarted
+func (w *loggerWriter) Close() error {
+	w.once.Do(w.logger.closeWriter)
+	return w.File.Close()
+}
@@ -0,0 +1,191 @@
+// +build !windows
+
+package shim
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/containerd/containerd/log"
+)
+
+// poolRetryDelay is the delay before a pooled shim that failed to start is
+// started again
+const poolRetryDelay = time.Second
+
+// Pool keeps idle shims started ahead of the tasks that claim them, so that
+// creating a task does not wait for its shim to start. Pooled shims are
+// started without a namespace and bound to their task when they create it.
+type Pool struct {
+	dir     string
+	binary  string
+	address string
+	debug   bool
+	config  Config
+	// prefix makes the addresses of the shims unique across restarts of
+	// containerd, as claimed shims keep serving on their pool address
+	prefix string
+	seq    int
+
+	idle chan *pooled
+	// tokens holds a token for each shim to start
+	tokens chan struct{}
+}
+
+type pooled struct {
+	id     string
+	client *Client
+}
+
+// NewPool returns a pool keeping size idle shims of the binary, started with
+// the io buffer size and exec retention of the config. Each idle shim has a
+// directory in dir, and the idle shims left in dir by a previous containerd
+// are killed.
+func NewPool(ctx context.Context, dir, binary, address string, debug bool, size int, config Config) (*Pool, error) {
+	if err := os.MkdirAll(dir, 0711); err != nil {
+		return nil, err
+	}
+	killIdle(ctx, dir)
+	p := &Pool{
+		dir:     dir,
+		binary:  binary,
+		address: address,
+		debug:   debug,
+		config:  config,
+		prefix:  strconv.FormatInt(time.Now().UnixNano(), 36),
+		idle:    make(chan *pooled, size),
+		tokens:  make(chan struct{}, size),
+	}
+	for i := 0; i < size; i++ {
+		p.tokens <- struct{}{}
+	}
+	go p.fill(ctx)
+	return p, nil
+}
+
+// Claim returns an idle shim and the address it serves on, or a nil client
+// when no shim is idle. The shim is placed in the cgroup of the config and
+// its log is moved to the bundle at the path of the config.
+func (p *Pool) Claim(ctx context.Context, config Config) (*Client, string, error) {
+	for {
+		var s *pooled
+		select {
+		case s = <-p.idle:
+			p.tokens <- struct{}{}
+		default:
+			return nil, "", nil
+		}
+		address := poolAddress(s.id)
+		info, err := s.client.ShimInfo(ctx, empty)
+		if err != nil {
+			log.G(ctx).WithError(err).WithField("address", address).Warn("discarding pooled shim")
+			s.client.Close()
+			os.RemoveAll(filepath.Join(p.dir, s.id))
+			continue
+		}
+		if err := p.claim(ctx, s, int(info.ShimPid), config); err != nil {
+			if kerr := s.client.KillShim(ctx); kerr != nil {
+				log.G(ctx).WithError(kerr).WithField("address", address).Error("failed to kill pooled shim")
+			}
+			s.client.Close()
+			os.RemoveAll(filepath.Join(p.dir, s.id))
+			return nil, "", err
+		}
+		return s.client, address, nil
+	}
+}
+
+func (p *Pool) claim(ctx context.Context, s *pooled, pid int, config Config) error {
+	config.Address = poolAddress(s.id)
+	if config.CgroupPath != "" {
+		if err := setCgroup(ctx, config, pid); err != nil {
+			return err
+		}
+	}
+	dir := filepath.Join(p.dir, s.id)
+	if p.debug {
+		// the shim keeps writing to the moved file
+		if err := os.Rename(filepath.Join(dir, LogFile), filepath.Join(config.Path, LogFile)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return os.RemoveAll(dir)
+}
+
+// fill starts a shim for each token until the context is done
+func (p *Pool) fill(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.tokens:
+		}
+		for {
+			s, err := p.start(ctx)
+			if err == nil {
+				p.idle <- s
+				break
+			}
+			log.G(ctx).WithError(err).Error("failed to start pooled shim")
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(poolRetryDelay):
+			}
+		}
+	}
+}
+
+func (p *Pool) start(ctx context.Context) (*pooled, error) {
+	p.seq++
+	id := fmt.Sprintf("%s-%d", p.prefix, p.seq)
+	path := filepath.Join(p.dir, id)
+	if err := os.Mkdir(path, 0711); err != nil {
+		return nil, err
+	}
+	config := p.config
+	config.Address = poolAddress(id)
+	config.Path = path
+	s, err := New(ctx, config, WithStart(p.binary, p.address, p.debug))
+	if err != nil {
+		os.RemoveAll(path)
+		return nil, err
+	}
+	return &pooled{
+		id:     id,
+		client: s,
+	}, nil
+}
+
+// killIdle kills the idle shims left in the directory by a previous
+// containerd
+func killIdle(ctx context.Context, dir string) {
+	dirs, err := ioutil.ReadDir(dir)
+	if err != nil {
+		log.G(ctx).WithError(err).Warn("failed to read shim pool")
+		return
+	}
+	for _, d := range dirs {
+		id := d.Name()
+		if s, err := New(ctx, Config{Address: poolAddress(id)}, WithConnect); err == nil {
+			if err := s.KillShim(ctx); err != nil {
+				log.G(ctx).WithError(err).WithField("address", poolAddress(id)).Warn("failed to kill idle shim")
+			}
+			s.Close()
+		}
+		if err := os.RemoveAll(filepath.Join(dir, id)); err != nil {
+			log.G(ctx).WithError(err).Warn("failed to remove idle shim")
+		}
+	}
+}
+
+// poolAddress returns the address of the pooled shim, which cannot be the
+// address of a task as namespaces do not start with a dot
+func poolAddress(id string) string {
+	return filepath.Join(string(filepath.Separator), "containerd-shim", ".pool", id, "shim.sock")
+}
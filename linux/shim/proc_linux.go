@@ -0,0 +1,58 @@
+package shim
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd/sys"
+	"github.com/opencontainers/runc/libcontainer/system"
+	"github.com/pkg/errors"
+)
+
+// clockTicks is the USER_HZ value in which the kernel reports process times
+var clockTicks = time.Duration(system.GetClockTicks())
+
+// processStarted returns the time the process started in clock ticks since
+// boot, as reported by /proc/<pid>/stat
+func processStarted(pid int) (uint64, error) {
+	return sys.ProcessStartTime(pid)
+}
+
+// startedAt converts a process start time in clock ticks since boot to a
+// wall clock time
+func startedAt(ticks uint64) time.Time {
+	if ticks == 0 {
+		return time.Time{}
+	}
+	boot, err := bootTime()
+	if err != nil {
+		return time.Time{}
+	}
+	return boot.Add(time.Duration(ticks) * time.Second / clockTicks)
+}
+
+func bootTime() (time.Time, error) {
+	f, err := os.Open("/proc/stat")
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) == 2 && fields[0] == "btime" {
+			sec, err := strconv.ParseInt(fields[1], 10, 64)
+			if err != nil {
+				return time.Time{}, err
+			}
+			return time.Unix(sec, 0), nil
+		}
+	}
+	if err := s.Err(); err != nil {
+		return time.Time{}, err
+	}
+	return time.Time{}, errors.New("boot time not found in /proc/stat")
+}
@@ -0,0 +1,15 @@
+// +build !windows,!linux
+
+package shim
+
+import "time"
+
+// processStarted is not supported on this platform, pids are not checked for
+// reuse
+func processStarted(pid int) (uint64, error) {
+	return 0, nil
+}
+
+func startedAt(ticks uint64) time.Time {
+	return time.Time{}
+}
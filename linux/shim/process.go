@@ -5,9 +5,12 @@ package shim
 import (
 	"context"
 	"io"
+	"os"
 	"time"
 
 	"github.com/containerd/console"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
 )
 
 type stdio struct {
@@ -15,10 +18,12 @@ type stdio struct {
 	stdout   string
 	stderr   string
 	terminal bool
+	// env is the environment of the binary loggers of the process
+	env []string
 }
 
 func (s stdio) isNull() bool {
-	return s.stdin == "" && s.stdout == "" && s.stderr == ""
+	return isNullStdio(s.stdin) && isNullStdio(s.stdout) && isNullStdio(s.stderr)
 }
 
 type process interface {
@@ -28,12 +33,14 @@ type process interface {
 	Pid() int
 	// Resize resizes the process console
 	Resize(ws console.WinSize) error
-	// SetExited sets the exit status for the process
-	SetExited(status int)
+	// SetExited sets the exit status and time for the process
+	SetExited(status int, exitedAt time.Time)
 	// ExitStatus returns the exit status
 	ExitStatus() int
 	// ExitedAt is the time the process exited
 	ExitedAt() time.Time
+	// StartedAt is the time the process started
+	StartedAt() time.Time
 	// Delete deletes the process and its resourcess
 	Delete(context.Context) error
 	// Stdin returns the process STDIN
@@ -47,3 +54,23 @@ type process interface {
 	// Status returns the process status
 	Status(ctx context.Context) (string, error)
 }
+
+// checkPid returns ErrNotFound if the pid no longer refers to the process
+// that was started at the given time, guarding against signaling a process
+// that reused the pid of one that has exited
+func checkPid(pid int, started uint64) error {
+	if started == 0 {
+		return nil
+	}
+	s, err := processStarted(pid)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return errors.Wrapf(errdefs.ErrNotFound, "process already finished")
+		}
+		return err
+	}
+	if s != started {
+		return errors.Wrapf(errdefs.ErrNotFound, "process already finished")
+	}
+	return nil
+}
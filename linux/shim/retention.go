@@ -0,0 +1,91 @@
+// +build !windows
+
+package shim
+
+import (
+	"sort"
+	"time"
+
+	"github.com/containerd/containerd/log"
+)
+
+const (
+	// DefaultMaxExitedExecs is the default number of exited exec processes
+	// whose exit status is retained by a shim
+	DefaultMaxExitedExecs = 256
+	// DefaultExitedExecTTL is the default time the exit status of an exec
+	// process is retained after it exits
+	DefaultExitedExecTTL = time.Hour
+)
+
+// ExecRetention bounds the exec processes that exited and were not deleted
+// with DeleteProcess. Their exit status is retained so that clients that
+// were not waiting for them do not miss it, until they are deleted, they
+// expire or the oldest are evicted for newer ones.
+type ExecRetention struct {
+	// MaxExited is the number of exited exec processes retained, no limit
+	// when it is negative and DefaultMaxExitedExecs when zero
+	MaxExited int
+	// TTL is how long an exited exec process is retained, forever when it
+	// is negative and DefaultExitedExecTTL when zero
+	TTL time.Duration
+}
+
+func (r ExecRetention) withDefaults() ExecRetention {
+	if r.MaxExited == 0 {
+		r.MaxExited = DefaultMaxExitedExecs
+	}
+	if r.TTL == 0 {
+		r.TTL = DefaultExitedExecTTL
+	}
+	return r
+}
+
+// retainExec evicts the oldest exited exec processes over the limit once
+// the process exited, and schedules its expiry. The caller must hold the
+// lock of the service.
+func (s *Service) retainExec(p process) {
+	if s.initProcess == nil || p.ID() == s.initProcess.id {
+		return
+	}
+	if ttl := s.retention.TTL; ttl > 0 {
+		exitedAt := p.ExitedAt()
+		time.AfterFunc(ttl, func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			// the process may have been deleted and its id reused
+			if q, ok := s.processes[p.ID()]; ok && q == p && q.ExitedAt().Equal(exitedAt) {
+				s.evictExec(q, "expired")
+			}
+		})
+	}
+	if s.retention.MaxExited < 0 {
+		return
+	}
+	var exited []process
+	for _, q := range s.processes {
+		if q.ID() != s.initProcess.id && !q.ExitedAt().IsZero() {
+			exited = append(exited, q)
+		}
+	}
+	if len(exited) <= s.retention.MaxExited {
+		return
+	}
+	sort.Slice(exited, func(i, j int) bool {
+		return exited[i].ExitedAt().Before(exited[j].ExitedAt())
+	})
+	for _, q := range exited[:len(exited)-s.retention.MaxExited] {
+		s.evictExec(q, "evicted")
+	}
+}
+
+// evictExec deletes the exited exec process that was not deleted by a
+// client, freeing its resources. The caller must hold the lock of the
+// service.
+func (s *Service) evictExec(p process, reason string) {
+	if err := p.Delete(s.context); err != nil {
+		log.G(s.context).WithError(err).WithField("exec", p.ID()).Warn("failed to delete exited exec process")
+	}
+	delete(s.processes, p.ID())
+	log.G(s.context).WithField("exec", p.ID()).WithField("exit_status", p.ExitStatus()).Debugf("exit status of exec process %s", reason)
+}
@@ -0,0 +1,78 @@
+// +build !windows
+
+package shim
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type exitedProcess struct {
+	process
+	id       string
+	exitedAt time.Time
+	deleted  bool
+}
+
+func (p *exitedProcess) ID() string          { return p.id }
+func (p *exitedProcess) ExitedAt() time.Time { return p.exitedAt }
+func (p *exitedProcess) ExitStatus() int     { return 0 }
+
+func (p *exitedProcess) Delete(context.Context) error {
+	p.deleted = true
+	return nil
+}
+
+func TestRetainExecEvictsOldest(t *testing.T) {
+	s := &Service{
+		initProcess: &initProcess{id: "init"},
+		processes:   make(map[string]process),
+		context:     context.Background(),
+		retention:   ExecRetention{MaxExited: 2, TTL: -1},
+	}
+	now := time.Now()
+	var execs []*exitedProcess
+	for i, id := range []string{"a", "b", "c"} {
+		p := &exitedProcess{id: id, exitedAt: now.Add(time.Duration(i) * time.Second)}
+		execs = append(execs, p)
+		s.processes[id] = p
+	}
+	s.processes["running"] = &exitedProcess{id: "running"}
+	s.retainExec(execs[2])
+
+	if _, ok := s.processes["a"]; ok || !execs[0].deleted {
+		t.Fatal("expected the oldest exited exec to be evicted")
+	}
+	for _, id := range []string{"b", "c", "running"} {
+		if _, ok := s.processes[id]; !ok {
+			t.Fatalf("expected %s to be retained", id)
+		}
+	}
+}
+
+func TestRetainExecExpires(t *testing.T) {
+	s := &Service{
+		initProcess: &initProcess{id: "init"},
+		processes:   make(map[string]process),
+		context:     context.Background(),
+		retention:   ExecRetention{MaxExited: -1, TTL: 10 * time.Millisecond},
+	}
+	p := &exitedProcess{id: "a", exitedAt: time.Now()}
+	s.processes["a"] = p
+	s.mu.Lock()
+	s.retainExec(p)
+	s.mu.Unlock()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		s.mu.Lock()
+		_, ok := s.processes["a"]
+		s.mu.Unlock()
+		if !ok {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("expected the exited exec to expire")
+}
@@ -3,9 +3,10 @@
 package shim
 
 import (
-	"fmt"
 	"os"
+	"path/filepath"
 	"sync"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -20,6 +21,8 @@ import (
 	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/containerd/reaper"
 	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/containerd/sys"
+	runc "github.com/containerd/go-runc"
 	google_protobuf "github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
@@ -27,13 +30,33 @@ import (
 
 var empty = &google_protobuf.Empty{}
 
-const RuncRoot = "/run/containerd/runc"
+// RuncRoot is the root directory of the state of runc, under the runtime
+// directory of the user in rootless mode
+var RuncRoot = runcRoot()
 
-// NewService returns a new shim service that can be used via GRPC
-func NewService(path, namespace, workDir string, publisher events.Publisher) (*Service, error) {
-	if namespace == "" {
-		return nil, fmt.Errorf("shim namespace cannot be empty")
+func runcRoot() string {
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" && sys.IsRootless() {
+		return filepath.Join(dir, "containerd", "runc")
 	}
+	return "/run/containerd/runc"
+}
+
+const (
+	// publishRetryDelay is the delay before an event is published again
+	// while containerd is unavailable, doubled for each attempt
+	publishRetryDelay = 100 * time.Millisecond
+	// maxPublishRetryDelay is the maximum delay between attempts
+	maxPublishRetryDelay = 5 * time.Second
+)
+
+// NewService returns a new shim service that can be used via GRPC. The stdio
+// of its processes is copied with buffers of ioBufferSize bytes, or
+// DefaultIOBufferSize when it is not set, and its exited exec processes are
+// retained within the bounds of the retention.
+//
+// A service without a namespace, such as the service of a pooled shim, is
+// bound to the bundle, namespace and work directory of the task it creates.
+func NewService(path, namespace, workDir string, ioBufferSize int, retention ExecRetention, publisher events.Publisher) (*Service, error) {
 	context := namespaces.WithNamespace(context.Background(), namespace)
 	s := &Service{
 		path:      path,
@@ -42,18 +65,22 @@ func NewService(path, namespace, workDir string, publisher events.Publisher) (*S
 		namespace: namespace,
 		context:   context,
 		workDir:   workDir,
+		io:        newIOConfig(ioBufferSize),
+		retention: retention.withDefaults(),
 	}
 	if err := s.initPlatform(); err != nil {
 		return nil, errors.Wrap(err, "failed to initialized platform behavior")
 	}
 	go s.forward(publisher)
+	go s.processExits(reaper.Default.Subscribe())
 	return s, nil
 }
 
 // platform handles platform-specific behavior that may differs across
 // platform implementations
 type platform interface {
-	copyConsole(ctx context.Context, console console.Console, stdin, stdout, stderr string, wg, cwg *sync.WaitGroup) (console.Console, error)
+	copyConsole(ctx context.Context, console console.Console, s stdio, wg, cwg *sync.WaitGroup) (console.Console, error)
+	copyPipes(ctx context.Context, rio runc.IO, s stdio, wg, cwg *sync.WaitGroup) error
 	shutdownConsole(ctx context.Context, console console.Console) error
 }
 
@@ -70,27 +97,30 @@ type Service struct {
 	namespace     string
 	context       context.Context
 
-	workDir  string
-	platform platform
+	workDir   string
+	platform  platform
+	io        *ioConfig
+	retention ExecRetention
 }
 
 func (s *Service) Create(ctx context.Context, r *shimapi.CreateTaskRequest) (*shimapi.CreateTaskResponse, error) {
+	// hold the lock while the process is created so that its exit, such as
+	// the exit of a restored process, is handled once its pid is known
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.bind(r); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
 	process, err := newInitProcess(ctx, s.platform, s.path, s.namespace, s.workDir, r)
 	if err != nil {
 		return nil, errdefs.ToGRPC(err)
 	}
-	s.mu.Lock()
 	// save the main task id and bundle to the shim for additional requests
 	s.id = r.ID
 	s.bundle = r.Bundle
 	s.initProcess = process
 	pid := process.Pid()
 	s.processes[r.ID] = process
-	s.mu.Unlock()
-	cmd := &reaper.Cmd{
-		ExitCh: make(chan int, 1),
-	}
-	reaper.Default.Register(pid, cmd)
 	s.events <- &eventsapi.TaskCreate{
 		ContainerID: r.ID,
 		Bundle:      r.Bundle,
@@ -104,19 +134,45 @@ func (s *Service) Create(ctx context.Context, r *shimapi.CreateTaskRequest) (*sh
 		Checkpoint: r.Checkpoint,
 		Pid:        uint32(pid),
 	}
-	go s.waitExit(process, pid, cmd)
 	return &shimapi.CreateTaskResponse{
 		Pid: uint32(pid),
 	}, nil
 }
 
+// bind binds a service without a namespace to the bundle, namespace and
+// work directory of the task
+func (s *Service) bind(r *shimapi.CreateTaskRequest) error {
+	if s.namespace != "" {
+		if r.Namespace != "" && r.Namespace != s.namespace {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "shim is bound to namespace %s", s.namespace)
+		}
+		return nil
+	}
+	if r.Namespace == "" {
+		return errors.Wrap(errdefs.ErrInvalidArgument, "namespace required to bind shim")
+	}
+	// the bundle is the working directory of shims started for the task
+	if err := os.Chdir(r.Bundle); err != nil {
+		return errors.Wrap(err, "failed to bind shim to bundle")
+	}
+	s.path = r.Bundle
+	s.namespace = r.Namespace
+	s.workDir = r.WorkDir
+	s.context = namespaces.WithNamespace(context.Background(), r.Namespace)
+	return nil
+}
+
 func (s *Service) Start(ctx context.Context, r *shimapi.StartRequest) (*shimapi.StartResponse, error) {
+	// hold the lock while the process is started so that its exit is
+	// handled once its pid is known
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	p, ok := s.processes[r.ID]
 	if !ok {
 		return nil, errdefs.ToGRPCf(errdefs.ErrNotFound, "process %s not found", r.ID)
 	}
 	if err := p.Start(ctx); err != nil {
-		return nil, err
+		return nil, errdefs.ToGRPC(err)
 	}
 	if r.ID == s.id {
 		s.events <- &eventsapi.TaskStart{
@@ -125,11 +181,6 @@ func (s *Service) Start(ctx context.Context, r *shimapi.StartRequest) (*shimapi.
 		}
 	} else {
 		pid := p.Pid()
-		cmd := &reaper.Cmd{
-			ExitCh: make(chan int, 1),
-		}
-		reaper.Default.Register(pid, cmd)
-		go s.waitExit(p, pid, cmd)
 		s.events <- &eventsapi.TaskExecStarted{
 			ContainerID: s.id,
 			ExecID:      r.ID,
@@ -148,7 +199,7 @@ func (s *Service) Delete(ctx context.Context, r *google_protobuf.Empty) (*shimap
 	}
 	p := s.initProcess
 	if err := p.Delete(ctx); err != nil {
-		return nil, err
+		return nil, errdefs.ToGRPC(err)
 	}
 	s.mu.Lock()
 	delete(s.processes, p.ID())
@@ -180,7 +231,7 @@ func (s *Service) DeleteProcess(ctx context.Context, r *shimapi.DeleteProcessReq
 		return nil, errors.Wrapf(errdefs.ErrNotFound, "process %s not found", r.ID)
 	}
 	if err := p.Delete(ctx); err != nil {
-		return nil, err
+		return nil, errdefs.ToGRPC(err)
 	}
 	s.mu.Lock()
 	delete(s.processes, p.ID())
@@ -239,7 +290,7 @@ func (s *Service) State(ctx context.Context, r *shimapi.StateRequest) (*shimapi.
 	}
 	st, err := p.Status(ctx)
 	if err != nil {
-		return nil, err
+		return nil, errdefs.ToGRPC(err)
 	}
 	status := task.StatusUnknown
 	switch st {
@@ -266,6 +317,7 @@ func (s *Service) State(ctx context.Context, r *shimapi.StateRequest) (*shimapi.
 		Terminal:   sio.terminal,
 		ExitStatus: uint32(p.ExitStatus()),
 		ExitedAt:   p.ExitedAt(),
+		StartedAt:  p.StartedAt(),
 	}, nil
 }
 
@@ -274,7 +326,7 @@ func (s *Service) Pause(ctx context.Context, r *google_protobuf.Empty) (*google_
 		return nil, errdefs.ToGRPCf(errdefs.ErrFailedPrecondition, "container must be created")
 	}
 	if err := s.initProcess.Pause(ctx); err != nil {
-		return nil, err
+		return nil, errdefs.ToGRPC(err)
 	}
 	s.events <- &eventsapi.TaskPaused{
 		ContainerID: s.id,
@@ -287,7 +339,7 @@ func (s *Service) Resume(ctx context.Context, r *google_protobuf.Empty) (*google
 		return nil, errdefs.ToGRPCf(errdefs.ErrFailedPrecondition, "container must be created")
 	}
 	if err := s.initProcess.Resume(ctx); err != nil {
-		return nil, err
+		return nil, errdefs.ToGRPC(err)
 	}
 	s.events <- &eventsapi.TaskResumed{
 		ContainerID: s.id,
@@ -320,8 +372,25 @@ func (s *Service) ListPids(ctx context.Context, r *shimapi.ListPidsRequest) (*sh
 	if err != nil {
 		return nil, errdefs.ToGRPC(err)
 	}
+	execs := make(map[uint32]string)
+	s.mu.Lock()
+	for id, p := range s.processes {
+		if p == s.initProcess {
+			continue
+		}
+		execs[uint32(p.Pid())] = id
+	}
+	s.mu.Unlock()
+	var processes []*task.ProcessInfo
+	for _, pid := range pids {
+		processes = append(processes, &task.ProcessInfo{
+			Pid:    pid,
+			ExecID: execs[pid],
+		})
+	}
 	return &shimapi.ListPidsResponse{
-		Pids: pids,
+		Pids:      pids,
+		Processes: processes,
 	}, nil
 }
 
@@ -330,8 +399,11 @@ func (s *Service) CloseIO(ctx context.Context, r *shimapi.CloseIORequest) (*goog
 	if !ok {
 		return nil, errdefs.ToGRPCf(errdefs.ErrNotFound, "process does not exist %s", r.ID)
 	}
-	if err := p.Stdin().Close(); err != nil {
-		return nil, err
+	// the stdin of a process without a stdin fifo cannot be closed
+	if stdin := p.Stdin(); stdin != nil {
+		if err := stdin.Close(); err != nil {
+			return nil, err
+		}
 	}
 	return empty, nil
 }
@@ -350,8 +422,13 @@ func (s *Service) Checkpoint(ctx context.Context, r *shimapi.CheckpointTaskReque
 }
 
 func (s *Service) ShimInfo(ctx context.Context, r *google_protobuf.Empty) (*shimapi.ShimInfoResponse, error) {
+	stdin, stdout, stderr := s.io.counters()
 	return &shimapi.ShimInfoResponse{
-		ShimPid: uint32(os.Getpid()),
+		ShimPid:         uint32(os.Getpid()),
+		ProtocolVersion: ProtocolVersion,
+		StdinBytes:      stdin,
+		StdoutBytes:     stdout,
+		StderrBytes:     stderr,
 	}, nil
 }
 
@@ -365,18 +442,74 @@ func (s *Service) Update(ctx context.Context, r *shimapi.UpdateTaskRequest) (*go
 	return empty, nil
 }
 
-func (s *Service) waitExit(p process, pid int, cmd *reaper.Cmd) {
-	status := <-cmd.ExitCh
-	p.SetExited(status)
+func (s *Service) UpdateProcess(ctx context.Context, r *shimapi.UpdateProcessRequest) (*google_protobuf.Empty, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.processes[r.ID]
+	if !ok {
+		return nil, errdefs.ToGRPCf(errdefs.ErrNotFound, "process %s not found", r.ID)
+	}
+	e, ok := p.(*execProcess)
+	if !ok {
+		return nil, errdefs.ToGRPCf(errdefs.ErrFailedPrecondition, "the init process cannot be updated once created")
+	}
+	if err := e.update(r); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return empty, nil
+}
+
+// processExits handles the exits collected by the reaper for all the
+// processes of the shim in a single goroutine
+func (s *Service) processExits(exits chan reaper.Exit) {
+	for e := range exits {
+		s.mu.Lock()
+		p := s.exitedProcess(e.Pid)
+		id := s.id
+		s.mu.Unlock()
+		if p == nil {
+			// the exit of a runtime command or of an orphan reparented to
+			// the shim
+			continue
+		}
+		p.SetExited(e.Status, e.Timestamp)
+		exit := &eventsapi.TaskExit{
+			ContainerID: id,
+			ID:          p.ID(),
+			Pid:         uint32(e.Pid),
+			ExitStatus:  uint32(e.Status),
+			ExitedAt:    e.Timestamp,
+		}
+		if ep, ok := p.(*execProcess); ok {
+			// the exit of an exec is published once its output is copied,
+			// without holding up the exits of the other processes
+			go func() {
+				ep.closeIO()
+				s.publishExit(p, exit)
+			}()
+			continue
+		}
+		s.publishExit(p, exit)
+	}
+}
+
+// publishExit publishes the exit of the process and retains it as exited
+func (s *Service) publishExit(p process, exit *eventsapi.TaskExit) {
+	s.events <- exit
+	s.mu.Lock()
+	s.retainExec(p)
+	s.mu.Unlock()
+}
 
-	reaper.Default.Delete(pid)
-	s.events <- &eventsapi.TaskExit{
-		ContainerID: s.id,
-		ID:          p.ID(),
-		Pid:         uint32(pid),
-		ExitStatus:  uint32(status),
-		ExitedAt:    p.ExitedAt(),
+// exitedProcess returns the running process with the pid, nil if there is
+// none. The caller must hold the lock of the service.
+func (s *Service) exitedProcess(pid int) process {
+	for _, p := range s.processes {
+		if p.Pid() == pid && p.ExitedAt().IsZero() {
+			return p
+		}
 	}
+	return nil
 }
 
 func (s *Service) getContainerPids(ctx context.Context, id string) ([]uint32, error) {
@@ -391,10 +524,29 @@ func (s *Service) getContainerPids(ctx context.Context, id string) ([]uint32, er
 	return pids, nil
 }
 
+// forward publishes the events of the shim in order. Events that cannot be
+// published because containerd is unavailable, as while it restarts, are
+// retried until it is back so that exits are not lost; the shim queues the
+// events that occur in the meantime.
 func (s *Service) forward(publisher events.Publisher) {
 	for e := range s.events {
-		if err := publisher.Publish(s.context, getTopic(e), e); err != nil {
-			log.G(s.context).WithError(err).Error("post event")
+		topic := getTopic(e)
+		for delay := publishRetryDelay; ; delay *= 2 {
+			err := publisher.Publish(s.context, topic, e)
+			if err == nil {
+				break
+			}
+			if !errdefs.IsUnavailable(err) {
+				log.G(s.context).WithError(err).Error("post event")
+				break
+			}
+			if delay == publishRetryDelay {
+				log.G(s.context).WithError(err).WithField("topic", topic).Warn("containerd unavailable, retrying event")
+			}
+			if delay > maxPublishRetryDelay {
+				delay = maxPublishRetryDelay
+			}
+			time.Sleep(delay)
 		}
 	}
 }
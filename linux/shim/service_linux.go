@@ -1,21 +1,20 @@
 package shim
 
 import (
-	"io"
 	"sync"
-	"syscall"
 
 	"github.com/containerd/console"
-	"github.com/containerd/fifo"
+	runc "github.com/containerd/go-runc"
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 )
 
 type linuxPlatform struct {
 	epoller *console.Epoller
+	io      *ioConfig
 }
 
-func (p *linuxPlatform) copyConsole(ctx context.Context, console console.Console, stdin, stdout, stderr string, wg, cwg *sync.WaitGroup) (console.Console, error) {
+func (p *linuxPlatform) copyConsole(ctx context.Context, console console.Console, s stdio, wg, cwg *sync.WaitGroup) (console.Console, error) {
 	if p.epoller == nil {
 		return nil, errors.New("uninitialized epoller")
 	}
@@ -25,23 +24,21 @@ func (p *linuxPlatform) copyConsole(ctx context.Context, console console.Console
 		return nil, err
 	}
 
-	if stdin != "" {
-		in, err := fifo.OpenFifo(ctx, stdin, syscall.O_RDONLY, 0)
-		if err != nil {
-			return nil, err
-		}
+	in, err := openInput(ctx, s.stdin)
+	if err != nil {
+		return nil, err
+	}
+	if in != nil {
 		cwg.Add(1)
 		go func() {
 			cwg.Done()
-			io.Copy(epollConsole, in)
+			p.io.copy(epollConsole, in, &p.io.stdin)
+			in.Close()
 		}()
 	}
 
-	outw, err := fifo.OpenFifo(ctx, stdout, syscall.O_WRONLY, 0)
-	if err != nil {
-		return nil, err
-	}
-	outr, err := fifo.OpenFifo(ctx, stdout, syscall.O_RDONLY, 0)
+	// the output of a terminal is all written to stdout
+	outputs, err := openOutputs(ctx, s.env, s.stdout)
 	if err != nil {
 		return nil, err
 	}
@@ -49,15 +46,18 @@ func (p *linuxPlatform) copyConsole(ctx context.Context, console console.Console
 	cwg.Add(1)
 	go func() {
 		cwg.Done()
-		io.Copy(outw, epollConsole)
+		p.io.copy(outputs[0], epollConsole, &p.io.stdout)
 		epollConsole.Close()
-		outr.Close()
-		outw.Close()
+		outputs[0].Close()
 		wg.Done()
 	}()
 	return epollConsole, nil
 }
 
+func (p *linuxPlatform) copyPipes(ctx context.Context, rio runc.IO, s stdio, wg, cwg *sync.WaitGroup) error {
+	return copyPipes(ctx, p.io, rio, s, wg, cwg)
+}
+
 func (p *linuxPlatform) shutdownConsole(ctx context.Context, cons console.Console) error {
 	if p.epoller == nil {
 		return errors.New("uninitialized epoller")
@@ -81,6 +81,7 @@ func (s *Service) initPlatform() error {
 	}
 	s.platform = &linuxPlatform{
 		epoller: epoller,
+		io:      s.io,
 	}
 	go epoller.Wait()
 	return nil
@@ -0,0 +1,54 @@
+// +build !windows
+
+package shim
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+	shimapi "github.com/containerd/containerd/linux/shim/v1"
+	"github.com/containerd/containerd/namespaces"
+)
+
+func TestBind(t *testing.T) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(cwd)
+	bundle, err := ioutil.TempDir("", "shim-bind-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(bundle)
+
+	s := &Service{context: context.Background()}
+	if err := s.bind(&shimapi.CreateTaskRequest{Bundle: bundle}); !errdefs.IsInvalidArgument(err) {
+		t.Fatalf("expected invalid argument without namespace, got %v", err)
+	}
+	if err := s.bind(&shimapi.CreateTaskRequest{
+		Bundle:    bundle,
+		Namespace: "test",
+		WorkDir:   "/var/lib/containerd/test/id",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if s.path != bundle || s.namespace != "test" || s.workDir != "/var/lib/containerd/test/id" {
+		t.Fatalf("shim not bound to the task: %q %q %q", s.path, s.namespace, s.workDir)
+	}
+	if ns, _ := namespaces.Namespace(s.context); ns != "test" {
+		t.Fatalf("expected the context in namespace test, got %q", ns)
+	}
+	if dir, err := os.Getwd(); err != nil || dir != bundle {
+		t.Fatalf("expected working directory %s, got %s: %v", bundle, dir, err)
+	}
+	if err := s.bind(&shimapi.CreateTaskRequest{Bundle: bundle, Namespace: "other"}); !errdefs.IsInvalidArgument(err) {
+		t.Fatalf("expected invalid argument for another namespace, got %v", err)
+	}
+	if err := s.bind(&shimapi.CreateTaskRequest{Bundle: bundle}); err != nil {
+		t.Fatalf("expected a bound shim to accept a request without namespace: %v", err)
+	}
+}
@@ -3,35 +3,32 @@
 package shim
 
 import (
-	"io"
 	"sync"
-	"syscall"
 
 	"github.com/containerd/console"
-	"github.com/containerd/fifo"
+	runc "github.com/containerd/go-runc"
 	"golang.org/x/net/context"
 )
 
 type unixPlatform struct {
+	io *ioConfig
 }
 
-func (p *unixPlatform) copyConsole(ctx context.Context, console console.Console, stdin, stdout, stderr string, wg, cwg *sync.WaitGroup) (console.Console, error) {
-	if stdin != "" {
-		in, err := fifo.OpenFifo(ctx, stdin, syscall.O_RDONLY, 0)
-		if err != nil {
-			return nil, err
-		}
+func (p *unixPlatform) copyConsole(ctx context.Context, console console.Console, s stdio, wg, cwg *sync.WaitGroup) (console.Console, error) {
+	in, err := openInput(ctx, s.stdin)
+	if err != nil {
+		return nil, err
+	}
+	if in != nil {
 		cwg.Add(1)
 		go func() {
 			cwg.Done()
-			io.Copy(console, in)
+			p.io.copy(console, in, &p.io.stdin)
+			in.Close()
 		}()
 	}
-	outw, err := fifo.OpenFifo(ctx, stdout, syscall.O_WRONLY, 0)
-	if err != nil {
-		return nil, err
-	}
-	outr, err := fifo.OpenFifo(ctx, stdout, syscall.O_RDONLY, 0)
+	// the output of a terminal is all written to stdout
+	outputs, err := openOutputs(ctx, s.env, s.stdout)
 	if err != nil {
 		return nil, err
 	}
@@ -39,20 +36,25 @@ func (p *unixPlatform) copyConsole(ctx context.Context, console console.Console,
 	cwg.Add(1)
 	go func() {
 		cwg.Done()
-		io.Copy(outw, console)
+		p.io.copy(outputs[0], console, &p.io.stdout)
 		console.Close()
-		outr.Close()
-		outw.Close()
+		outputs[0].Close()
 		wg.Done()
 	}()
 	return console, nil
 }
 
+func (p *unixPlatform) copyPipes(ctx context.Context, rio runc.IO, s stdio, wg, cwg *sync.WaitGroup) error {
+	return copyPipes(ctx, p.io, rio, s, wg, cwg)
+}
+
 func (p *unixPlatform) shutdownConsole(ctx context.Context, cons console.Console) error {
 	return nil
 }
 
 func (s *Service) initPlatform() error {
-	s.platform = &unixPlatform{}
+	s.platform = &unixPlatform{
+		io: s.io,
+	}
 	return nil
 }
@@ -0,0 +1,88 @@
+// +build linux
+
+package shim
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	systemdDbus "github.com/coreos/go-systemd/dbus"
+	"github.com/godbus/dbus"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// scopeStartTimeout bounds the start of the transient scope of a container
+const scopeStartTimeout = 30 * time.Second
+
+// startScope registers the cgroup of the container at the cgroups path of
+// the spec of the bundle as a transient systemd scope holding its init
+// process. The scope is delegated to the container so that systemd leaves
+// the cgroups managed by runc alone, and carries the limits of the container
+// so that systemd does not reset them.
+func startScope(bundle, id string, pid int) error {
+	f, err := os.Open(filepath.Join(bundle, "config.json"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var spec specs.Spec
+	if err := json.NewDecoder(f).Decode(&spec); err != nil {
+		return err
+	}
+	if spec.Linux == nil || filepath.Ext(spec.Linux.CgroupsPath) != ".scope" {
+		return errors.Errorf("cgroups path of container %s is not a systemd scope", id)
+	}
+	var (
+		unit  = filepath.Base(spec.Linux.CgroupsPath)
+		slice = filepath.Base(filepath.Dir(spec.Linux.CgroupsPath))
+	)
+	if slice == "/" {
+		slice = "-.slice"
+	}
+	properties := []systemdDbus.Property{
+		systemdDbus.PropDescription("containerd container " + id),
+		systemdDbus.PropSlice(slice),
+		systemdDbus.PropPids(uint32(pid)),
+		newProperty("Delegate", true),
+		newProperty("DefaultDependencies", false),
+		newProperty("MemoryAccounting", true),
+		newProperty("CPUAccounting", true),
+		newProperty("BlockIOAccounting", true),
+	}
+	if r := spec.Linux.Resources; r != nil {
+		if r.Memory != nil && r.Memory.Limit != nil && *r.Memory.Limit > 0 {
+			properties = append(properties, newProperty("MemoryLimit", uint64(*r.Memory.Limit)))
+		}
+		if r.CPU != nil && r.CPU.Shares != nil && *r.CPU.Shares > 0 {
+			properties = append(properties, newProperty("CPUShares", *r.CPU.Shares))
+		}
+	}
+	conn, err := systemdDbus.New()
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to systemd")
+	}
+	defer conn.Close()
+	ch := make(chan string, 1)
+	if _, err := conn.StartTransientUnit(unit, "replace", properties, ch); err != nil {
+		return errors.Wrapf(err, "failed to start scope %s", unit)
+	}
+	select {
+	case result := <-ch:
+		if result != "done" {
+			return errors.Errorf("failed to start scope %s: %s", unit, result)
+		}
+	case <-time.After(scopeStartTimeout):
+		return errors.Errorf("timeout starting scope %s", unit)
+	}
+	return nil
+}
+
+func newProperty(name string, value interface{}) systemdDbus.Property {
+	return systemdDbus.Property{
+		Name:  name,
+		Value: dbus.MakeVariant(value),
+	}
+}
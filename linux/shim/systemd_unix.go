@@ -0,0 +1,12 @@
+// +build !linux,!windows
+
+package shim
+
+import (
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+)
+
+func startScope(bundle, id string, pid int) error {
+	return errors.Wrap(errdefs.ErrNotImplemented, "systemd cgroups are only supported on linux")
+}
@@ -25,6 +25,7 @@
 		CheckpointTaskRequest
 		ShimInfoResponse
 		UpdateTaskRequest
+		UpdateProcessRequest
 		StartRequest
 		StartResponse
 */
@@ -78,6 +79,17 @@ type CreateTaskRequest struct {
 	Checkpoint       string                    `protobuf:"bytes,9,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
 	ParentCheckpoint string                    `protobuf:"bytes,10,opt,name=parent_checkpoint,json=parentCheckpoint,proto3" json:"parent_checkpoint,omitempty"`
 	Options          *google_protobuf.Any      `protobuf:"bytes,11,opt,name=options" json:"options,omitempty"`
+	// runtime_root is the root directory of the state of the runtime, under
+	// which the state of each namespace is kept. The shim's default is used
+	// when it is empty.
+	RuntimeRoot string `protobuf:"bytes,12,opt,name=runtime_root,json=runtimeRoot,proto3" json:"runtime_root,omitempty"`
+	// runtime_debug enables the debug output of the runtime
+	RuntimeDebug bool `protobuf:"varint,13,opt,name=runtime_debug,json=runtimeDebug,proto3" json:"runtime_debug,omitempty"`
+	// namespace and work_dir bind a shim started without a task, such as a
+	// pooled shim, to the namespace and work directory of the task. A shim
+	// started for the task rejects another namespace.
+	Namespace string `protobuf:"bytes,14,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	WorkDir   string `protobuf:"bytes,15,opt,name=work_dir,json=workDir,proto3" json:"work_dir,omitempty"`
 }
 
 func (m *CreateTaskRequest) Reset()                    { *m = CreateTaskRequest{} }
@@ -159,6 +171,7 @@ type StateResponse struct {
 	Terminal   bool                       `protobuf:"varint,8,opt,name=terminal,proto3" json:"terminal,omitempty"`
 	ExitStatus uint32                     `protobuf:"varint,9,opt,name=exit_status,json=exitStatus,proto3" json:"exit_status,omitempty"`
 	ExitedAt   time.Time                  `protobuf:"bytes,10,opt,name=exited_at,json=exitedAt,stdtime" json:"exited_at"`
+	StartedAt  time.Time                  `protobuf:"bytes,11,opt,name=started_at,json=startedAt,stdtime" json:"started_at"`
 }
 
 func (m *StateResponse) Reset()                    { *m = StateResponse{} }
@@ -193,7 +206,8 @@ func (*ListPidsRequest) ProtoMessage()               {}
 func (*ListPidsRequest) Descriptor() ([]byte, []int) { return fileDescriptorShim, []int{11} }
 
 type ListPidsResponse struct {
-	Pids []uint32 `protobuf:"varint,1,rep,packed,name=pids" json:"pids,omitempty"`
+	Pids      []uint32                           `protobuf:"varint,1,rep,packed,name=pids" json:"pids,omitempty"`
+	Processes []*containerd_v1_types.ProcessInfo `protobuf:"bytes,2,rep,name=processes" json:"processes,omitempty"`
 }
 
 func (m *ListPidsResponse) Reset()                    { *m = ListPidsResponse{} }
@@ -203,6 +217,10 @@ func (*ListPidsResponse) Descriptor() ([]byte, []int) { return fileDescriptorShi
 type CheckpointTaskRequest struct {
 	Path    string               `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	Options *google_protobuf.Any `protobuf:"bytes,2,opt,name=options" json:"options,omitempty"`
+	// parent_path is the path of the images of the parent checkpoint,
+	// relative to the path, so that only the memory changed since the
+	// parent is dumped
+	ParentPath string `protobuf:"bytes,3,opt,name=parent_path,json=parentPath,proto3" json:"parent_path,omitempty"`
 }
 
 func (m *CheckpointTaskRequest) Reset()                    { *m = CheckpointTaskRequest{} }
@@ -211,6 +229,14 @@ func (*CheckpointTaskRequest) Descriptor() ([]byte, []int) { return fileDescript
 
 type ShimInfoResponse struct {
 	ShimPid uint32 `protobuf:"varint,1,opt,name=shim_pid,json=shimPid,proto3" json:"shim_pid,omitempty"`
+	// ProtocolVersion is the version of the shim API spoken by the shim.
+	// Shims that predate it do not set it and speak version 1.
+	ProtocolVersion uint32 `protobuf:"varint,2,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// StdinBytes, StdoutBytes and StderrBytes are the bytes of stdio copied
+	// by the shim for its processes since it started.
+	StdinBytes  uint64 `protobuf:"varint,3,opt,name=stdin_bytes,json=stdinBytes,proto3" json:"stdin_bytes,omitempty"`
+	StdoutBytes uint64 `protobuf:"varint,4,opt,name=stdout_bytes,json=stdoutBytes,proto3" json:"stdout_bytes,omitempty"`
+	StderrBytes uint64 `protobuf:"varint,5,opt,name=stderr_bytes,json=stderrBytes,proto3" json:"stderr_bytes,omitempty"`
 }
 
 func (m *ShimInfoResponse) Reset()                    { *m = ShimInfoResponse{} }
@@ -225,13 +251,24 @@ func (m *UpdateTaskRequest) Reset()                    { *m = UpdateTaskRequest{
 func (*UpdateTaskRequest) ProtoMessage()               {}
 func (*UpdateTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptorShim, []int{15} }
 
+type UpdateProcessRequest struct {
+	ID   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Env  []string `protobuf:"bytes,2,rep,name=env" json:"env,omitempty"`
+	Args []string `protobuf:"bytes,3,rep,name=args" json:"args,omitempty"`
+	Cwd  string   `protobuf:"bytes,4,opt,name=cwd,proto3" json:"cwd,omitempty"`
+}
+
+func (m *UpdateProcessRequest) Reset()                    { *m = UpdateProcessRequest{} }
+func (*UpdateProcessRequest) ProtoMessage()               {}
+func (*UpdateProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorShim, []int{16} }
+
 type StartRequest struct {
 	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (m *StartRequest) Reset()                    { *m = StartRequest{} }
 func (*StartRequest) ProtoMessage()               {}
-func (*StartRequest) Descriptor() ([]byte, []int) { return fileDescriptorShim, []int{16} }
+func (*StartRequest) Descriptor() ([]byte, []int) { return fileDescriptorShim, []int{17} }
 
 type StartResponse struct {
 	ID  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -240,7 +277,7 @@ type StartResponse struct {
 
 func (m *StartResponse) Reset()                    { *m = StartResponse{} }
 func (*StartResponse) ProtoMessage()               {}
-func (*StartResponse) Descriptor() ([]byte, []int) { return fileDescriptorShim, []int{17} }
+func (*StartResponse) Descriptor() ([]byte, []int) { return fileDescriptorShim, []int{18} }
 
 func init() {
 	proto.RegisterType((*CreateTaskRequest)(nil), "containerd.runtime.linux.shim.v1.CreateTaskRequest")
@@ -259,6 +296,7 @@ func init() {
 	proto.RegisterType((*CheckpointTaskRequest)(nil), "containerd.runtime.linux.shim.v1.CheckpointTaskRequest")
 	proto.RegisterType((*ShimInfoResponse)(nil), "containerd.runtime.linux.shim.v1.ShimInfoResponse")
 	proto.RegisterType((*UpdateTaskRequest)(nil), "containerd.runtime.linux.shim.v1.UpdateTaskRequest")
+	proto.RegisterType((*UpdateProcessRequest)(nil), "containerd.runtime.linux.shim.v1.UpdateProcessRequest")
 	proto.RegisterType((*StartRequest)(nil), "containerd.runtime.linux.shim.v1.StartRequest")
 	proto.RegisterType((*StartResponse)(nil), "containerd.runtime.linux.shim.v1.StartResponse")
 }
@@ -291,6 +329,8 @@ type ShimClient interface {
 	// ShimInfo returns information about the shim.
 	ShimInfo(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*ShimInfoResponse, error)
 	Update(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// UpdateProcess amends the spec of an exec process that is not started
+	UpdateProcess(ctx context.Context, in *UpdateProcessRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 }
 
 type shimClient struct {
@@ -436,6 +476,15 @@ func (c *shimClient) Update(ctx context.Context, in *UpdateTaskRequest, opts ...
 	return out, nil
 }
 
+func (c *shimClient) UpdateProcess(ctx context.Context, in *UpdateProcessRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/containerd.runtime.linux.shim.v1.Shim/UpdateProcess", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Shim service
 
 type ShimServer interface {
@@ -456,6 +505,8 @@ type ShimServer interface {
 	// ShimInfo returns information about the shim.
 	ShimInfo(context.Context, *google_protobuf1.Empty) (*ShimInfoResponse, error)
 	Update(context.Context, *UpdateTaskRequest) (*google_protobuf1.Empty, error)
+	// UpdateProcess amends the spec of an exec process that is not started
+	UpdateProcess(context.Context, *UpdateProcessRequest) (*google_protobuf1.Empty, error)
 }
 
 func RegisterShimServer(s *grpc.Server, srv ShimServer) {
@@ -732,6 +783,24 @@ func _Shim_Update_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Shim_UpdateProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProcessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShimServer).UpdateProcess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.runtime.linux.shim.v1.Shim/UpdateProcess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShimServer).UpdateProcess(ctx, req.(*UpdateProcessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Shim_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "containerd.runtime.linux.shim.v1.Shim",
 	HandlerType: (*ShimServer)(nil),
@@ -796,6 +865,10 @@ var _Shim_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _Shim_Update_Handler,
 		},
+		{
+			MethodName: "UpdateProcess",
+			Handler:    _Shim_UpdateProcess_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/containerd/containerd/linux/shim/v1/shim.proto",
@@ -896,6 +969,34 @@ func (m *CreateTaskRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n1
 	}
+	if len(m.RuntimeRoot) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintShim(dAtA, i, uint64(len(m.RuntimeRoot)))
+		i += copy(dAtA[i:], m.RuntimeRoot)
+	}
+	if m.RuntimeDebug {
+		dAtA[i] = 0x68
+		i++
+		if m.RuntimeDebug {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintShim(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.WorkDir) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintShim(dAtA, i, uint64(len(m.WorkDir)))
+		i += copy(dAtA[i:], m.WorkDir)
+	}
 	return i, nil
 }
 
@@ -1198,6 +1299,14 @@ func (m *StateResponse) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n4
+	dAtA[i] = 0x5a
+	i++
+	i = encodeVarintShim(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)))
+	n5, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartedAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
 	return i, nil
 }
 
@@ -1314,21 +1423,33 @@ func (m *ListPidsResponse) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.Pids) > 0 {
-		dAtA6 := make([]byte, len(m.Pids)*10)
-		var j5 int
+		dAtA7 := make([]byte, len(m.Pids)*10)
+		var j6 int
 		for _, num := range m.Pids {
 			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j5++
+				j6++
 			}
-			dAtA6[j5] = uint8(num)
-			j5++
+			dAtA7[j6] = uint8(num)
+			j6++
 		}
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintShim(dAtA, i, uint64(j5))
-		i += copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintShim(dAtA, i, uint64(j6))
+		i += copy(dAtA[i:], dAtA7[:j6])
+	}
+	if len(m.Processes) > 0 {
+		for _, msg := range m.Processes {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintShim(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
@@ -1358,11 +1479,17 @@ func (m *CheckpointTaskRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintShim(dAtA, i, uint64(m.Options.Size()))
-		n7, err := m.Options.MarshalTo(dAtA[i:])
+		n8, err := m.Options.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
+	}
+	if len(m.ParentPath) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintShim(dAtA, i, uint64(len(m.ParentPath)))
+		i += copy(dAtA[i:], m.ParentPath)
 	}
 	return i, nil
 }
@@ -1387,6 +1514,26 @@ func (m *ShimInfoResponse) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintShim(dAtA, i, uint64(m.ShimPid))
 	}
+	if m.ProtocolVersion != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintShim(dAtA, i, uint64(m.ProtocolVersion))
+	}
+	if m.StdinBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintShim(dAtA, i, uint64(m.StdinBytes))
+	}
+	if m.StdoutBytes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintShim(dAtA, i, uint64(m.StdoutBytes))
+	}
+	if m.StderrBytes != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintShim(dAtA, i, uint64(m.StderrBytes))
+	}
 	return i, nil
 }
 
@@ -1409,11 +1556,71 @@ func (m *UpdateTaskRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintShim(dAtA, i, uint64(m.Resources.Size()))
-		n8, err := m.Resources.MarshalTo(dAtA[i:])
+		n9, err := m.Resources.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n9
+	}
+	return i, nil
+}
+
+func (m *UpdateProcessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateProcessRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintShim(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Env) > 0 {
+		for _, s := range m.Env {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Cwd) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintShim(dAtA, i, uint64(len(m.Cwd)))
+		i += copy(dAtA[i:], m.Cwd)
 	}
 	return i, nil
 }
@@ -1546,6 +1753,21 @@ func (m *CreateTaskRequest) Size() (n int) {
 		l = m.Options.Size()
 		n += 1 + l + sovShim(uint64(l))
 	}
+	l = len(m.RuntimeRoot)
+	if l > 0 {
+		n += 1 + l + sovShim(uint64(l))
+	}
+	if m.RuntimeDebug {
+		n += 2
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovShim(uint64(l))
+	}
+	l = len(m.WorkDir)
+	if l > 0 {
+		n += 1 + l + sovShim(uint64(l))
+	}
 	return n
 }
 
@@ -1680,6 +1902,8 @@ func (m *StateResponse) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExitedAt)
 	n += 1 + l + sovShim(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)
+	n += 1 + l + sovShim(uint64(l))
 	return n
 }
 
@@ -1732,6 +1956,12 @@ func (m *ListPidsResponse) Size() (n int) {
 		}
 		n += 1 + sovShim(uint64(l)) + l
 	}
+	if len(m.Processes) > 0 {
+		for _, e := range m.Processes {
+			l = e.Size()
+			n += 1 + l + sovShim(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1746,6 +1976,10 @@ func (m *CheckpointTaskRequest) Size() (n int) {
 		l = m.Options.Size()
 		n += 1 + l + sovShim(uint64(l))
 	}
+	l = len(m.ParentPath)
+	if l > 0 {
+		n += 1 + l + sovShim(uint64(l))
+	}
 	return n
 }
 
@@ -1755,6 +1989,18 @@ func (m *ShimInfoResponse) Size() (n int) {
 	if m.ShimPid != 0 {
 		n += 1 + sovShim(uint64(m.ShimPid))
 	}
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovShim(uint64(m.ProtocolVersion))
+	}
+	if m.StdinBytes != 0 {
+		n += 1 + sovShim(uint64(m.StdinBytes))
+	}
+	if m.StdoutBytes != 0 {
+		n += 1 + sovShim(uint64(m.StdoutBytes))
+	}
+	if m.StderrBytes != 0 {
+		n += 1 + sovShim(uint64(m.StderrBytes))
+	}
 	return n
 }
 
@@ -1768,6 +2014,32 @@ func (m *UpdateTaskRequest) Size() (n int) {
 	return n
 }
 
+func (m *UpdateProcessRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovShim(uint64(l))
+	}
+	if len(m.Env) > 0 {
+		for _, s := range m.Env {
+			l = len(s)
+			n += 1 + l + sovShim(uint64(l))
+		}
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovShim(uint64(l))
+		}
+	}
+	l = len(m.Cwd)
+	if l > 0 {
+		n += 1 + l + sovShim(uint64(l))
+	}
+	return n
+}
+
 func (m *StartRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -1820,6 +2092,10 @@ func (this *CreateTaskRequest) String() string {
 		`Checkpoint:` + fmt.Sprintf("%v", this.Checkpoint) + `,`,
 		`ParentCheckpoint:` + fmt.Sprintf("%v", this.ParentCheckpoint) + `,`,
 		`Options:` + strings.Replace(fmt.Sprintf("%v", this.Options), "Any", "google_protobuf.Any", 1) + `,`,
+		`RuntimeRoot:` + fmt.Sprintf("%v", this.RuntimeRoot) + `,`,
+		`RuntimeDebug:` + fmt.Sprintf("%v", this.RuntimeDebug) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`WorkDir:` + fmt.Sprintf("%v", this.WorkDir) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1917,6 +2193,7 @@ func (this *StateResponse) String() string {
 		`Terminal:` + fmt.Sprintf("%v", this.Terminal) + `,`,
 		`ExitStatus:` + fmt.Sprintf("%v", this.ExitStatus) + `,`,
 		`ExitedAt:` + strings.Replace(strings.Replace(this.ExitedAt.String(), "Timestamp", "google_protobuf3.Timestamp", 1), `&`, ``, 1) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(this.StartedAt.String(), "Timestamp", "google_protobuf3.Timestamp", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1960,6 +2237,7 @@ func (this *ListPidsResponse) String() string {
 	}
 	s := strings.Join([]string{`&ListPidsResponse{`,
 		`Pids:` + fmt.Sprintf("%v", this.Pids) + `,`,
+		`Processes:` + strings.Replace(fmt.Sprintf("%v", this.Processes), "ProcessInfo", "containerd_v1_types.ProcessInfo", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1971,6 +2249,7 @@ func (this *CheckpointTaskRequest) String() string {
 	s := strings.Join([]string{`&CheckpointTaskRequest{`,
 		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
 		`Options:` + strings.Replace(fmt.Sprintf("%v", this.Options), "Any", "google_protobuf.Any", 1) + `,`,
+		`ParentPath:` + fmt.Sprintf("%v", this.ParentPath) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1981,6 +2260,10 @@ func (this *ShimInfoResponse) String() string {
 	}
 	s := strings.Join([]string{`&ShimInfoResponse{`,
 		`ShimPid:` + fmt.Sprintf("%v", this.ShimPid) + `,`,
+		`ProtocolVersion:` + fmt.Sprintf("%v", this.ProtocolVersion) + `,`,
+		`StdinBytes:` + fmt.Sprintf("%v", this.StdinBytes) + `,`,
+		`StdoutBytes:` + fmt.Sprintf("%v", this.StdoutBytes) + `,`,
+		`StderrBytes:` + fmt.Sprintf("%v", this.StderrBytes) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1995,6 +2278,19 @@ func (this *UpdateTaskRequest) String() string {
 	}, "")
 	return s
 }
+func (this *UpdateProcessRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateProcessRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Env:` + fmt.Sprintf("%v", this.Env) + `,`,
+		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
+		`Cwd:` + fmt.Sprintf("%v", this.Cwd) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *StartRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -2369,6 +2665,113 @@ func (m *CreateTaskRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeDebug", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RuntimeDebug = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipShim(dAtA[iNdEx:])
@@ -3402,14 +3805,44 @@ func (m *StateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipShim(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthShim
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShim(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShim
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -3810,6 +4243,37 @@ func (m *ListPidsResponse) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pids", wireType)
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Processes = append(m.Processes, &containerd_v1_types.ProcessInfo{})
+			if err := m.Processes[len(m.Processes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipShim(dAtA[iNdEx:])
@@ -3922,6 +4386,35 @@ func (m *CheckpointTaskRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipShim(dAtA[iNdEx:])
@@ -3991,6 +4484,82 @@ func (m *ShimInfoResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdinBytes", wireType)
+			}
+			m.StdinBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StdinBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdoutBytes", wireType)
+			}
+			m.StdoutBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StdoutBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StderrBytes", wireType)
+			}
+			m.StderrBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StderrBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipShim(dAtA[iNdEx:])
@@ -4095,6 +4664,172 @@ func (m *UpdateTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpdateProcessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShim
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateProcessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cwd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cwd = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShim(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShim
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *StartRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4382,73 +5117,88 @@ func init() {
 }
 
 var fileDescriptorShim = []byte{
-	// 1083 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xef, 0xfa, 0xbf, 0x9f, 0x71, 0x48, 0x86, 0x34, 0x6c, 0x5d, 0xc9, 0xb1, 0xf6, 0x10, 0x19,
-	0xa1, 0xae, 0x89, 0x83, 0x5a, 0x0a, 0x12, 0x52, 0x92, 0x56, 0x28, 0x82, 0xa8, 0xd1, 0x26, 0x05,
-	0x04, 0x42, 0xd1, 0xc6, 0x3b, 0xb1, 0x47, 0xb1, 0x77, 0xb6, 0x3b, 0xb3, 0x21, 0xe1, 0xc4, 0x89,
-	0x33, 0x1f, 0x87, 0x8f, 0x90, 0x03, 0x07, 0x8e, 0x9c, 0x0a, 0x8d, 0xc4, 0x91, 0xef, 0x80, 0xe6,
-	0x8f, 0xed, 0xb5, 0x9d, 0x65, 0xd7, 0xbd, 0xc4, 0xf3, 0x66, 0x7e, 0xef, 0xcd, 0x9b, 0xf7, 0x7b,
-	0x7f, 0x36, 0xf0, 0xb4, 0x4f, 0xf8, 0x20, 0x3a, 0xb3, 0x7b, 0x74, 0xd4, 0xe9, 0x51, 0x9f, 0xbb,
-	0xc4, 0xc7, 0xa1, 0x17, 0x5f, 0x0e, 0x89, 0x1f, 0x5d, 0x75, 0xd8, 0x80, 0x8c, 0x3a, 0x97, 0xdb,
-	0xf2, 0xd7, 0x0e, 0x42, 0xca, 0x29, 0x6a, 0x4d, 0x41, 0x76, 0x18, 0xf9, 0x9c, 0x8c, 0xb0, 0x2d,
-	0xc1, 0xb6, 0x04, 0x5d, 0x6e, 0x37, 0x1e, 0xf4, 0x29, 0xed, 0x0f, 0x71, 0x47, 0xe2, 0xcf, 0xa2,
-	0xf3, 0x8e, 0xeb, 0x5f, 0x2b, 0xe5, 0xc6, 0xc3, 0xf9, 0x23, 0x3c, 0x0a, 0xf8, 0xf8, 0x70, 0xbd,
-	0x4f, 0xfb, 0x54, 0x2e, 0x3b, 0x62, 0xa5, 0x77, 0x37, 0xe7, 0x55, 0xc4, 0x8d, 0x8c, 0xbb, 0xa3,
-	0x40, 0x03, 0x1e, 0xa7, 0xbe, 0xc5, 0x0d, 0x48, 0x87, 0x5f, 0x07, 0x98, 0x75, 0x46, 0x34, 0xf2,
-	0xb9, 0xd6, 0xfb, 0x74, 0x09, 0x3d, 0xee, 0xb2, 0x0b, 0xf9, 0x47, 0xe9, 0x5a, 0xff, 0xe6, 0x60,
-	0x6d, 0x3f, 0xc4, 0x2e, 0xc7, 0x27, 0x2e, 0xbb, 0x70, 0xf0, 0xab, 0x08, 0x33, 0x8e, 0x36, 0x20,
-	0x47, 0x3c, 0xd3, 0x68, 0x19, 0xed, 0xea, 0x5e, 0xe9, 0xf6, 0xf5, 0x66, 0xee, 0xe0, 0x99, 0x93,
-	0x23, 0x1e, 0xda, 0x80, 0xd2, 0x59, 0xe4, 0x7b, 0x43, 0x6c, 0xe6, 0xc4, 0x99, 0xa3, 0x25, 0x64,
-	0x42, 0x59, 0x47, 0xd0, 0xcc, 0xcb, 0x83, 0xb1, 0x88, 0x3a, 0x50, 0x0a, 0x29, 0xe5, 0xe7, 0xcc,
-	0x2c, 0xb4, 0xf2, 0xed, 0x5a, 0xf7, 0x7d, 0x3b, 0x16, 0x75, 0xe9, 0x92, 0x7d, 0x28, 0x9e, 0xe2,
-	0x68, 0x18, 0x6a, 0x40, 0x85, 0xe3, 0x70, 0x44, 0x7c, 0x77, 0x68, 0x16, 0x5b, 0x46, 0xbb, 0xe2,
-	0x4c, 0x64, 0xb4, 0x0e, 0x45, 0xc6, 0x3d, 0xe2, 0x9b, 0x25, 0x79, 0x89, 0x12, 0x84, 0x53, 0x8c,
-	0x7b, 0x34, 0xe2, 0x66, 0x59, 0x39, 0xa5, 0x24, 0xbd, 0x8f, 0xc3, 0xd0, 0xac, 0x4c, 0xf6, 0x71,
-	0x18, 0xa2, 0x26, 0x40, 0x6f, 0x80, 0x7b, 0x17, 0x01, 0x25, 0x3e, 0x37, 0xab, 0xf2, 0x2c, 0xb6,
-	0x83, 0x3e, 0x84, 0xb5, 0xc0, 0x0d, 0xb1, 0xcf, 0x4f, 0x63, 0x30, 0x90, 0xb0, 0x55, 0x75, 0xb0,
-	0x3f, 0x05, 0xdb, 0x50, 0xa6, 0x01, 0x27, 0xd4, 0x67, 0x66, 0xad, 0x65, 0xb4, 0x6b, 0xdd, 0x75,
-	0x5b, 0xd1, 0x6c, 0x8f, 0x69, 0xb6, 0x77, 0xfd, 0x6b, 0x67, 0x0c, 0xb2, 0xb6, 0x00, 0xc5, 0xc3,
-	0xcd, 0x02, 0xea, 0x33, 0x8c, 0x56, 0x21, 0x1f, 0xe8, 0x80, 0xd7, 0x1d, 0xb1, 0xb4, 0x7e, 0x31,
-	0x60, 0xe5, 0x19, 0x1e, 0x62, 0x8e, 0x93, 0x41, 0x68, 0x13, 0x6a, 0xf8, 0x8a, 0xf0, 0x53, 0xc6,
-	0x5d, 0x1e, 0x31, 0xc9, 0x49, 0xdd, 0x01, 0xb1, 0x75, 0x2c, 0x77, 0xd0, 0x2e, 0x54, 0x85, 0x84,
-	0xbd, 0x53, 0x97, 0x4b, 0x66, 0x6a, 0xdd, 0xc6, 0x82, 0x7f, 0x27, 0xe3, 0x34, 0xdc, 0xab, 0xdc,
-	0xbc, 0xde, 0xbc, 0xf7, 0xeb, 0x5f, 0x9b, 0x86, 0x53, 0x51, 0x6a, 0xbb, 0xdc, 0xb2, 0x61, 0x5d,
-	0xf9, 0x71, 0x14, 0xd2, 0x1e, 0x66, 0x2c, 0x25, 0x45, 0xac, 0xdf, 0x0c, 0x40, 0xcf, 0xaf, 0x70,
-	0x2f, 0x1b, 0x7c, 0x86, 0xee, 0x5c, 0x12, 0xdd, 0xf9, 0xbb, 0xe9, 0x2e, 0x24, 0xd0, 0x5d, 0x9c,
-	0xa1, 0xbb, 0x0d, 0x05, 0x16, 0xe0, 0x9e, 0xcc, 0x99, 0x24, 0x7a, 0x24, 0xc2, 0xba, 0x0f, 0xef,
-	0xcd, 0x78, 0xae, 0xe2, 0x6e, 0x7d, 0x0b, 0xab, 0x0e, 0x66, 0xe4, 0x27, 0x7c, 0xc4, 0xaf, 0xd3,
-	0x9e, 0xb3, 0x0e, 0xc5, 0x1f, 0x89, 0xc7, 0x07, 0x9a, 0x0b, 0x25, 0x08, 0xd7, 0x06, 0x98, 0xf4,
-	0x07, 0x8a, 0x83, 0xba, 0xa3, 0x25, 0x6b, 0x0b, 0xde, 0x11, 0x44, 0xe1, 0xb4, 0x98, 0xfe, 0x9e,
-	0x83, 0xba, 0x06, 0xea, 0x5c, 0x58, 0xb6, 0x40, 0x75, 0xee, 0xe4, 0xa7, 0xb9, 0xb3, 0x23, 0xc2,
-	0x25, 0xd3, 0x46, 0x84, 0x71, 0xa5, 0xfb, 0x30, 0x5e, 0x98, 0x97, 0xdb, 0xba, 0x36, 0x55, 0x1e,
-	0x39, 0x1a, 0x3a, 0x65, 0xa4, 0x78, 0x37, 0x23, 0xa5, 0x04, 0x46, 0xca, 0x33, 0x8c, 0xc4, 0x39,
-	0xaf, 0xcc, 0x71, 0x3e, 0x97, 0xd2, 0xd5, 0xff, 0x4f, 0x69, 0x78, 0xab, 0x94, 0x7e, 0x01, 0xb5,
-	0x2f, 0xc9, 0x70, 0x98, 0xa1, 0xd9, 0x31, 0xd2, 0x1f, 0x27, 0x66, 0xdd, 0xd1, 0x92, 0x88, 0xa5,
-	0x3b, 0x1c, 0xca, 0x58, 0x56, 0x1c, 0xb1, 0xb4, 0x3e, 0x87, 0x95, 0xfd, 0x21, 0x65, 0xf8, 0xe0,
-	0x45, 0x86, 0xfc, 0x50, 0x01, 0x54, 0xb9, 0xae, 0x04, 0xeb, 0x03, 0x78, 0xf7, 0x2b, 0xc2, 0xf8,
-	0x11, 0xf1, 0x52, 0xcb, 0x6b, 0x0b, 0x56, 0xa7, 0x50, 0x9d, 0x0c, 0x08, 0x0a, 0x01, 0xf1, 0x98,
-	0x69, 0xb4, 0xf2, 0xed, 0xba, 0x23, 0xd7, 0xd6, 0xf7, 0x70, 0x7f, 0xda, 0xa5, 0xe2, 0xad, 0x5d,
-	0x80, 0x5d, 0x3e, 0x50, 0xa6, 0x1d, 0xb9, 0x8e, 0x37, 0xb1, 0x5c, 0x96, 0x26, 0xf6, 0x08, 0x56,
-	0x8f, 0x07, 0x64, 0x74, 0xe0, 0x9f, 0xd3, 0x89, 0x13, 0x0f, 0xa0, 0x22, 0xc6, 0xe6, 0xe9, 0xb4,
-	0x45, 0x95, 0x85, 0x7c, 0x44, 0x3c, 0xeb, 0x0b, 0x58, 0x7b, 0x19, 0x78, 0x73, 0x23, 0xa6, 0x0b,
-	0xd5, 0x10, 0x33, 0x1a, 0x85, 0x3d, 0xcc, 0xa4, 0x42, 0xd2, 0xad, 0x53, 0x98, 0xae, 0x97, 0x90,
-	0xa7, 0x05, 0xe9, 0xa9, 0x2c, 0x17, 0x81, 0x4b, 0x29, 0x17, 0x5d, 0x16, 0xb9, 0x49, 0x59, 0x74,
-	0xff, 0x01, 0x28, 0x88, 0xb7, 0xa1, 0x01, 0x14, 0x65, 0xc9, 0x21, 0xdb, 0x4e, 0xfb, 0x4e, 0xb0,
-	0xe3, 0x45, 0xdc, 0xe8, 0x64, 0xc6, 0x6b, 0xe7, 0x18, 0x94, 0xd4, 0x48, 0x40, 0x3b, 0xe9, 0xaa,
-	0x0b, 0xb3, 0xba, 0xf1, 0xf1, 0x72, 0x4a, 0xfa, 0x52, 0xf5, 0xbc, 0x90, 0x67, 0x7c, 0xde, 0x24,
-	0xe6, 0x19, 0x9f, 0x17, 0x8b, 0xbd, 0x03, 0x25, 0x35, 0x40, 0xd0, 0xc6, 0x02, 0xbf, 0xcf, 0xc5,
-	0x47, 0x53, 0xe3, 0xa3, 0x74, 0x93, 0x73, 0xa3, 0xf0, 0x1a, 0xea, 0x33, 0x43, 0x09, 0x3d, 0xce,
-	0x6a, 0x62, 0x76, 0x2c, 0xbd, 0xc5, 0xd5, 0xaf, 0xa0, 0x32, 0x2e, 0x40, 0xb4, 0x9d, 0xae, 0x3d,
-	0x57, 0xd7, 0x8d, 0xee, 0x32, 0x2a, 0xfa, 0xca, 0x27, 0x50, 0x3c, 0x72, 0x23, 0x96, 0x1c, 0xc0,
-	0x84, 0x7d, 0xf4, 0x09, 0x94, 0x1c, 0xcc, 0xa2, 0xd1, 0xf2, 0x9a, 0x3f, 0x00, 0xc4, 0x3e, 0x72,
-	0x9e, 0x64, 0x48, 0xb1, 0xbb, 0x9a, 0x4d, 0xa2, 0xf9, 0x43, 0x28, 0x88, 0x0e, 0x8c, 0x1e, 0xa5,
-	0x1b, 0x8e, 0x75, 0xea, 0x44, 0x73, 0x27, 0x50, 0x10, 0x83, 0x1b, 0x65, 0x28, 0x85, 0xc5, 0x4f,
-	0x93, 0x44, 0xab, 0xdf, 0x40, 0x75, 0x32, 0xf7, 0x51, 0x06, 0xde, 0xe6, 0x3f, 0x12, 0x12, 0x0d,
-	0x1f, 0x43, 0x59, 0x8f, 0x0b, 0x94, 0x21, 0xff, 0x66, 0x27, 0x4b, 0xa2, 0xd1, 0xaf, 0xa1, 0x32,
-	0xee, 0xc9, 0x89, 0x6c, 0x67, 0x78, 0xc4, 0x42, 0x5f, 0x7f, 0x09, 0x25, 0xd5, 0xbc, 0xb3, 0x74,
-	0xa7, 0x85, 0x36, 0x9f, 0xe4, 0xee, 0xde, 0xe1, 0xcd, 0x9b, 0xe6, 0xbd, 0x3f, 0xdf, 0x34, 0xef,
-	0xfd, 0x7c, 0xdb, 0x34, 0x6e, 0x6e, 0x9b, 0xc6, 0x1f, 0xb7, 0x4d, 0xe3, 0xef, 0xdb, 0xa6, 0xf1,
-	0xdd, 0xce, 0x72, 0xff, 0xd1, 0x7d, 0x26, 0x7e, 0xcf, 0x4a, 0xd2, 0xfc, 0xce, 0x7f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xa0, 0x05, 0x00, 0x28, 0x0f, 0x0e, 0x00, 0x00,
+	// 1313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x6f, 0x1b, 0x45,
+	0x10, 0xef, 0xf9, 0x2b, 0xf6, 0xb8, 0x4e, 0xdd, 0x25, 0x2d, 0x57, 0x17, 0x39, 0xe6, 0x90, 0xaa,
+	0x54, 0x88, 0x33, 0x71, 0x50, 0x4b, 0x41, 0xaa, 0x94, 0x8f, 0x0a, 0x55, 0x50, 0x35, 0xba, 0x7e,
+	0x80, 0x90, 0x90, 0x75, 0xf1, 0x6d, 0xec, 0x55, 0xec, 0xdb, 0xeb, 0xee, 0x5e, 0xda, 0x20, 0x1e,
+	0x78, 0xe2, 0x19, 0xfe, 0x1b, 0xde, 0x78, 0x43, 0x7d, 0xe4, 0x91, 0xa7, 0x42, 0xf3, 0x07, 0xf0,
+	0x37, 0xa0, 0xfd, 0x70, 0x7c, 0xb6, 0x73, 0xf1, 0xb9, 0x2f, 0xf1, 0xce, 0xdc, 0x6f, 0x66, 0x77,
+	0x67, 0x7e, 0x3b, 0x33, 0x0a, 0xdc, 0xeb, 0x13, 0x31, 0x88, 0x0f, 0xdc, 0x1e, 0x1d, 0xb5, 0x7b,
+	0x34, 0x14, 0x3e, 0x09, 0x31, 0x0b, 0x92, 0xcb, 0x21, 0x09, 0xe3, 0x57, 0x6d, 0x3e, 0x20, 0xa3,
+	0xf6, 0xf1, 0xa6, 0xfa, 0x75, 0x23, 0x46, 0x05, 0x45, 0xad, 0x09, 0xc8, 0x65, 0x71, 0x28, 0xc8,
+	0x08, 0xbb, 0x0a, 0xec, 0x2a, 0xd0, 0xf1, 0x66, 0xe3, 0x46, 0x9f, 0xd2, 0xfe, 0x10, 0xb7, 0x15,
+	0xfe, 0x20, 0x3e, 0x6c, 0xfb, 0xe1, 0x89, 0x36, 0x6e, 0xdc, 0x9c, 0xfd, 0x84, 0x47, 0x91, 0x18,
+	0x7f, 0x5c, 0xeb, 0xd3, 0x3e, 0x55, 0xcb, 0xb6, 0x5c, 0x19, 0xed, 0xfa, 0xac, 0x89, 0xdc, 0x91,
+	0x0b, 0x7f, 0x14, 0x19, 0xc0, 0x9d, 0x85, 0x77, 0xf1, 0x23, 0xd2, 0x16, 0x27, 0x11, 0xe6, 0xed,
+	0x11, 0x8d, 0x43, 0x61, 0xec, 0xbe, 0x58, 0xc2, 0x4e, 0xf8, 0xfc, 0x48, 0xfd, 0xd1, 0xb6, 0xce,
+	0x7f, 0x79, 0xb8, 0xba, 0xcb, 0xb0, 0x2f, 0xf0, 0x53, 0x9f, 0x1f, 0x79, 0xf8, 0x45, 0x8c, 0xb9,
+	0x40, 0xd7, 0x21, 0x47, 0x02, 0xdb, 0x6a, 0x59, 0x1b, 0x95, 0x9d, 0xd2, 0xe9, 0x9b, 0xf5, 0xdc,
+	0xc3, 0x3d, 0x2f, 0x47, 0x02, 0x74, 0x1d, 0x4a, 0x07, 0x71, 0x18, 0x0c, 0xb1, 0x9d, 0x93, 0xdf,
+	0x3c, 0x23, 0x21, 0x1b, 0x56, 0x4c, 0x04, 0xed, 0xbc, 0xfa, 0x30, 0x16, 0x51, 0x1b, 0x4a, 0x8c,
+	0x52, 0x71, 0xc8, 0xed, 0x42, 0x2b, 0xbf, 0x51, 0xed, 0xbc, 0xef, 0x26, 0xa2, 0xae, 0x8e, 0xe4,
+	0x3e, 0x92, 0x57, 0xf1, 0x0c, 0x0c, 0x35, 0xa0, 0x2c, 0x30, 0x1b, 0x91, 0xd0, 0x1f, 0xda, 0xc5,
+	0x96, 0xb5, 0x51, 0xf6, 0xce, 0x64, 0xb4, 0x06, 0x45, 0x2e, 0x02, 0x12, 0xda, 0x25, 0xb5, 0x89,
+	0x16, 0xe4, 0xa1, 0xb8, 0x08, 0x68, 0x2c, 0xec, 0x15, 0x7d, 0x28, 0x2d, 0x19, 0x3d, 0x66, 0xcc,
+	0x2e, 0x9f, 0xe9, 0x31, 0x63, 0xa8, 0x09, 0xd0, 0x1b, 0xe0, 0xde, 0x51, 0x44, 0x49, 0x28, 0xec,
+	0x8a, 0xfa, 0x96, 0xd0, 0xa0, 0x8f, 0xe1, 0x6a, 0xe4, 0x33, 0x1c, 0x8a, 0x6e, 0x02, 0x06, 0x0a,
+	0x56, 0xd7, 0x1f, 0x76, 0x27, 0x60, 0x17, 0x56, 0x68, 0x24, 0x08, 0x0d, 0xb9, 0x5d, 0x6d, 0x59,
+	0x1b, 0xd5, 0xce, 0x9a, 0xab, 0xd3, 0xec, 0x8e, 0xd3, 0xec, 0x6e, 0x87, 0x27, 0xde, 0x18, 0x84,
+	0x3e, 0x84, 0xcb, 0x26, 0x34, 0x5d, 0x79, 0x61, 0xfb, 0xb2, 0xf2, 0x5b, 0x35, 0x3a, 0x8f, 0x52,
+	0x81, 0x3e, 0x82, 0xda, 0x18, 0x12, 0xe0, 0x83, 0xb8, 0x6f, 0xd7, 0x54, 0x18, 0xc6, 0x76, 0x7b,
+	0x52, 0x87, 0x3e, 0x80, 0x4a, 0xe8, 0x8f, 0x30, 0x8f, 0xfc, 0x1e, 0xb6, 0x57, 0x95, 0x93, 0x89,
+	0x02, 0xdd, 0x80, 0xf2, 0x4b, 0xca, 0x8e, 0xba, 0x01, 0x61, 0xf6, 0x15, 0x9d, 0x10, 0x29, 0xef,
+	0x11, 0xe6, 0xdc, 0x02, 0x94, 0xcc, 0x37, 0x8f, 0x68, 0xc8, 0x31, 0xaa, 0x43, 0x3e, 0x32, 0x19,
+	0xaf, 0x79, 0x72, 0xe9, 0xfc, 0x62, 0xc1, 0xea, 0x1e, 0x1e, 0x62, 0x81, 0xd3, 0x41, 0x68, 0x1d,
+	0xaa, 0xf8, 0x15, 0x11, 0x5d, 0x2e, 0x7c, 0x11, 0x73, 0x45, 0x8a, 0x9a, 0x07, 0x52, 0xf5, 0x44,
+	0x69, 0xd0, 0x36, 0x54, 0xa4, 0x84, 0x83, 0xae, 0x2f, 0x14, 0x35, 0xaa, 0x9d, 0xc6, 0x5c, 0x80,
+	0x9e, 0x8e, 0xdf, 0xc1, 0x4e, 0xf9, 0xf5, 0x9b, 0xf5, 0x4b, 0xbf, 0xfe, 0xb3, 0x6e, 0x79, 0x65,
+	0x6d, 0xb6, 0x2d, 0x1c, 0x17, 0xd6, 0xf4, 0x39, 0xf6, 0x19, 0xed, 0x61, 0xce, 0x17, 0x70, 0xd4,
+	0xf9, 0xdd, 0x02, 0xf4, 0xe0, 0x15, 0xee, 0x65, 0x83, 0x4f, 0xf1, 0x2d, 0x97, 0xc6, 0xb7, 0xfc,
+	0xf9, 0x7c, 0x2b, 0xa4, 0xf0, 0xad, 0x38, 0xc5, 0xb7, 0x0d, 0x28, 0xf0, 0x08, 0xf7, 0xec, 0xd2,
+	0x05, 0xfc, 0x50, 0x08, 0xe7, 0x1a, 0xbc, 0x37, 0x75, 0x72, 0x1d, 0x77, 0xe7, 0x3b, 0xa8, 0x7b,
+	0x98, 0x93, 0x1f, 0xf1, 0xbe, 0x38, 0x59, 0x74, 0x9d, 0x35, 0x28, 0xbe, 0x24, 0x81, 0x18, 0x98,
+	0x5c, 0x68, 0x41, 0x1e, 0x6d, 0x80, 0x49, 0x7f, 0xa0, 0x73, 0x50, 0xf3, 0x8c, 0xe4, 0xdc, 0x82,
+	0xcb, 0x32, 0x51, 0x78, 0x51, 0x4c, 0x7f, 0xcb, 0x43, 0xcd, 0x00, 0x0d, 0x17, 0x96, 0xad, 0x10,
+	0x86, 0x3b, 0xf9, 0x09, 0x77, 0xb6, 0x64, 0xb8, 0x14, 0x6d, 0x64, 0x18, 0x57, 0x3b, 0x37, 0x93,
+	0x95, 0xe1, 0x78, 0xd3, 0x14, 0x07, 0xcd, 0x23, 0xcf, 0x40, 0x27, 0x19, 0x29, 0x9e, 0x9f, 0x91,
+	0x52, 0x4a, 0x46, 0x56, 0xa6, 0x32, 0x92, 0xcc, 0x79, 0x79, 0x26, 0xe7, 0x33, 0x94, 0xae, 0x5c,
+	0x4c, 0x69, 0x78, 0x17, 0x4a, 0xa3, 0x5d, 0x00, 0x2e, 0x7c, 0x66, 0x7c, 0x54, 0x97, 0xf0, 0x51,
+	0x31, 0x76, 0xdb, 0xc2, 0x79, 0x0c, 0xd5, 0xaf, 0xc9, 0x70, 0x98, 0xa1, 0x64, 0x73, 0xd2, 0x1f,
+	0xb3, 0xbb, 0xe6, 0x19, 0x49, 0x26, 0xc4, 0x1f, 0x0e, 0x55, 0x42, 0xca, 0x9e, 0x5c, 0x3a, 0xf7,
+	0x61, 0x75, 0x77, 0x48, 0x39, 0x7e, 0xf8, 0x38, 0x03, 0xc9, 0x74, 0x16, 0xf4, 0x83, 0xd1, 0x82,
+	0x73, 0x1b, 0xae, 0x7c, 0x43, 0xb8, 0xd8, 0x27, 0xc1, 0xc2, 0x37, 0x7a, 0x08, 0xf5, 0x09, 0xd4,
+	0x30, 0x0a, 0x41, 0x21, 0x22, 0x01, 0xb7, 0xad, 0x56, 0x7e, 0xa3, 0xe6, 0xa9, 0x35, 0xba, 0x0f,
+	0x95, 0x48, 0x3f, 0x06, 0x2c, 0xab, 0x8b, 0x6c, 0x20, 0xad, 0x73, 0x69, 0x62, 0x9e, 0xcc, 0xc3,
+	0xf0, 0x90, 0x7a, 0x13, 0x13, 0xe7, 0x27, 0xb8, 0x36, 0xa9, 0xd5, 0xc9, 0x06, 0x27, 0x37, 0xf3,
+	0xc5, 0x40, 0x1f, 0xcd, 0x53, 0xeb, 0x64, 0x29, 0xcf, 0x65, 0x29, 0xe5, 0xeb, 0x50, 0x35, 0x7d,
+	0x42, 0xb9, 0xd2, 0x35, 0x02, 0xb4, 0x6a, 0xdf, 0x17, 0x03, 0xe7, 0x0f, 0x0b, 0xea, 0x4f, 0x06,
+	0x64, 0xa4, 0x4e, 0x35, 0xbe, 0xe6, 0x0d, 0x28, 0xcb, 0xf1, 0xa2, 0x3b, 0xa9, 0xa4, 0x2b, 0x52,
+	0xde, 0x27, 0x01, 0xba, 0x0d, 0x75, 0xb5, 0x53, 0x8f, 0x0e, 0xbb, 0xc7, 0x98, 0x71, 0x42, 0x43,
+	0x93, 0xb4, 0x2b, 0x63, 0xfd, 0x73, 0xad, 0x96, 0x7b, 0xab, 0xa0, 0x77, 0x0f, 0x4e, 0x04, 0xe6,
+	0x6a, 0xef, 0x82, 0x07, 0x4a, 0xb5, 0x23, 0x35, 0xb2, 0xcf, 0xe8, 0x47, 0x60, 0x10, 0x05, 0x85,
+	0xa8, 0x6a, 0x5d, 0x12, 0x82, 0x19, 0x33, 0x90, 0xe2, 0x19, 0x04, 0x33, 0xa6, 0x20, 0xce, 0x57,
+	0x70, 0xf5, 0x59, 0x14, 0xcc, 0x0c, 0x07, 0x1d, 0xa8, 0x30, 0xcc, 0x69, 0xcc, 0x7a, 0x98, 0xdb,
+	0xd6, 0x05, 0x91, 0x9a, 0xc0, 0x9c, 0x43, 0x58, 0xd3, 0x8e, 0x32, 0x56, 0xe5, 0x3a, 0xe4, 0x71,
+	0x78, 0xac, 0x52, 0x5e, 0xf1, 0xe4, 0x52, 0x66, 0xcc, 0x67, 0x7d, 0x79, 0x55, 0xa9, 0x52, 0x6b,
+	0x89, 0xea, 0xbd, 0x0c, 0x4c, 0x19, 0x96, 0x4b, 0x53, 0xd0, 0x98, 0x58, 0x44, 0xc0, 0x7b, 0x50,
+	0x33, 0xb8, 0x05, 0xf5, 0xcc, 0xd4, 0xad, 0xdc, 0x59, 0xdd, 0xea, 0xfc, 0x59, 0x85, 0x82, 0xcc,
+	0x2a, 0x1a, 0x40, 0x51, 0xd5, 0x44, 0xe4, 0xba, 0x8b, 0x26, 0x49, 0x37, 0x59, 0x65, 0x1b, 0xed,
+	0xcc, 0x78, 0x73, 0x38, 0x0e, 0x25, 0xdd, 0xb3, 0xd1, 0xd6, 0x62, 0xd3, 0xb9, 0x69, 0xae, 0xf1,
+	0xd9, 0x72, 0x46, 0x66, 0x53, 0x7d, 0x3d, 0x26, 0x32, 0x5e, 0x8f, 0x89, 0xe5, 0xae, 0x97, 0x88,
+	0xbd, 0x07, 0x25, 0xdd, 0xe1, 0xd1, 0xf5, 0x39, 0x1e, 0x3d, 0x90, 0x63, 0x75, 0xe3, 0xd3, 0xc5,
+	0x2e, 0x67, 0x66, 0x95, 0x13, 0xa8, 0x4d, 0x4d, 0x0d, 0xe8, 0x4e, 0x56, 0x17, 0xd3, 0x0c, 0x7d,
+	0x87, 0xad, 0x5f, 0x40, 0x79, 0x5c, 0xdc, 0xd0, 0xe6, 0x62, 0xeb, 0x99, 0x9a, 0xd9, 0xe8, 0x2c,
+	0x63, 0x62, 0xb6, 0xbc, 0x0b, 0xc5, 0x7d, 0x3f, 0xe6, 0xe9, 0x01, 0x4c, 0xd1, 0xa3, 0xcf, 0xa1,
+	0xe4, 0x61, 0x1e, 0x8f, 0x96, 0xb7, 0xfc, 0x01, 0x20, 0x31, 0x06, 0xdf, 0xcd, 0x40, 0xb1, 0xf3,
+	0x0a, 0x71, 0xaa, 0xfb, 0x47, 0x50, 0x90, 0xdd, 0x0d, 0x7d, 0xb2, 0xd8, 0x71, 0xa2, 0x0b, 0xa6,
+	0xba, 0x7b, 0x0a, 0x05, 0x39, 0x59, 0xa1, 0x0c, 0x4f, 0x61, 0x7e, 0x76, 0x4c, 0xf5, 0xfa, 0x2d,
+	0x54, 0xce, 0x06, 0x33, 0x94, 0x21, 0x6f, 0xb3, 0x53, 0x5c, 0xaa, 0xe3, 0x27, 0xb0, 0x62, 0x5a,
+	0x31, 0xca, 0xc0, 0xbf, 0xe9, 0xae, 0x9d, 0xea, 0xf4, 0x39, 0x94, 0xc7, 0xdd, 0x28, 0x35, 0xdb,
+	0x19, 0x2e, 0x31, 0xd7, 0xd1, 0x9e, 0x41, 0x49, 0xd7, 0xf6, 0x2c, 0xd5, 0x69, 0xae, 0x9d, 0xa4,
+	0x1e, 0xb7, 0x0b, 0xb5, 0xa9, 0x96, 0x91, 0xe5, 0x05, 0x9f, 0xd7, 0x63, 0xd2, 0x36, 0xd8, 0x79,
+	0xf4, 0xfa, 0x6d, 0xf3, 0xd2, 0xdf, 0x6f, 0x9b, 0x97, 0x7e, 0x3e, 0x6d, 0x5a, 0xaf, 0x4f, 0x9b,
+	0xd6, 0x5f, 0xa7, 0x4d, 0xeb, 0xdf, 0xd3, 0xa6, 0xf5, 0xfd, 0xd6, 0x72, 0xff, 0x54, 0xf8, 0x52,
+	0xfe, 0x1e, 0x94, 0x94, 0xfb, 0xad, 0xff, 0x07, 0x00, 0x81, 0xae, 0x14, 0x62, 0x92, 0x10, 0x00,
+	0x00,
 }
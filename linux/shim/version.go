@@ -0,0 +1,12 @@
+package shim
+
+const (
+	// ProtocolVersion is the version of the shim API spoken by this shim.
+	// It must be incremented when a change to the API cannot be handled by
+	// the previous version, and MinProtocolVersion raised when containerd
+	// stops handling shims of older versions.
+	ProtocolVersion = 2
+	// MinProtocolVersion is the oldest version of the shim API that
+	// containerd manages shims of
+	MinProtocolVersion = 1
+)
@@ -0,0 +1,66 @@
+// +build linux
+
+package linux
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/containerd/containerd/errdefs"
+	client "github.com/containerd/containerd/linux/shim"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/runtime"
+	"github.com/pkg/errors"
+)
+
+var _ = (runtime.ShimLogger)(&Runtime{})
+
+// createLogTail is the amount of the shim log logged when a task fails to
+// be created in debug mode
+const createLogTail = 4096
+
+// ShimLog returns the end of the log written by the shim of the task while
+// its runtime runs shims in debug mode
+func (r *Runtime) ShimLog(ctx context.Context, t runtime.Task, max int64) ([]byte, bool, error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	data, truncated, err := tailFile(filepath.Join(r.state, namespace, t.ID(), client.LogFile), max)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, false, errors.Wrapf(errdefs.ErrNotFound, "no shim log for task %s", t.ID())
+		}
+		return nil, false, err
+	}
+	return data, truncated, nil
+}
+
+// tailFile returns at most the last max bytes of the file and whether the
+// beginning of the file was left out
+func tailFile(path string, max int64) ([]byte, bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, false, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, false, err
+	}
+	var truncated bool
+	if size := fi.Size(); size > max {
+		if _, err := f.Seek(size-max, io.SeekStart); err != nil {
+			return nil, false, err
+		}
+		truncated = true
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(f, max))
+	if err != nil {
+		return nil, false, err
+	}
+	return data, truncated, nil
+}
@@ -0,0 +1,42 @@
+// +build linux
+
+package linux
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTailFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "shimlog-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "shim.log")
+	if err := ioutil.WriteFile(path, []byte("0123456789"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		max       int64
+		data      string
+		truncated bool
+	}{
+		{max: 4, data: "6789", truncated: true},
+		{max: 10, data: "0123456789"},
+		{max: 100, data: "0123456789"},
+	} {
+		data, truncated, err := tailFile(path, tc.max)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != tc.data || truncated != tc.truncated {
+			t.Errorf("max %d: expected %q (truncated %v), got %q (truncated %v)", tc.max, tc.data, tc.truncated, data, truncated)
+		}
+	}
+	if _, _, err := tailFile(filepath.Join(dir, "missing"), 4); !os.IsNotExist(err) {
+		t.Errorf("expected not exist error, got %v", err)
+	}
+}
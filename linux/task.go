@@ -4,26 +4,50 @@ package linux
 
 import (
 	"context"
+	"time"
 
 	"github.com/containerd/containerd/api/types/task"
 	"github.com/containerd/containerd/errdefs"
 	client "github.com/containerd/containerd/linux/shim"
 	shim "github.com/containerd/containerd/linux/shim/v1"
+	"github.com/containerd/containerd/mount"
 	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/containerd/sys"
 	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
 )
 
+var _ runtime.ParentCheckpointer = &Task{}
+
 type Task struct {
 	id        string
+	runtime   string
 	shim      *client.Client
 	namespace string
+	bundle    string
+	rootfs    []mount.Mount
+	options   *types.Any
+	// startTimeout bounds the start of the task and its processes
+	startTimeout time.Duration
+}
+
+func newTask(id, namespace, bundle string, shim *client.Client, opts runtime.CreateOpts, timeouts timeouts) *Task {
+	t := NewTask(id, namespace, bundle, pluginID, shim, opts)
+	t.startTimeout = timeouts.start
+	return t
 }
 
-func newTask(id, namespace string, shim *client.Client) *Task {
+// NewTask returns a task of the runtime with the given ID driven through the
+// shim API, for runtimes that run the shim elsewhere, such as in a VM
+func NewTask(id, namespace, bundle, runtimeID string, shim *client.Client, opts runtime.CreateOpts) *Task {
 	return &Task{
 		id:        id,
+		runtime:   runtimeID,
 		shim:      shim,
 		namespace: namespace,
+		bundle:    bundle,
+		rootfs:    opts.Rootfs,
+		options:   opts.Options,
 	}
 }
 
@@ -34,12 +58,17 @@ func (t *Task) ID() string {
 func (t *Task) Info() runtime.TaskInfo {
 	return runtime.TaskInfo{
 		ID:        t.id,
-		Runtime:   pluginID,
+		Runtime:   t.runtime,
 		Namespace: t.namespace,
+		Bundle:    t.bundle,
+		Rootfs:    t.rootfs,
+		Options:   t.options,
 	}
 }
 
 func (t *Task) Start(ctx context.Context) error {
+	ctx, cancel := withTimeout(ctx, t.startTimeout)
+	defer cancel()
 	_, err := t.shim.Start(ctx, &shim.StartRequest{
 		ID: t.id,
 	})
@@ -78,6 +107,7 @@ func (t *Task) State(ctx context.Context) (runtime.State, error) {
 		Terminal:   response.Terminal,
 		ExitStatus: response.ExitStatus,
 		ExitedAt:   response.ExitedAt,
+		StartedAt:  response.StartedAt,
 	}, nil
 }
 
@@ -125,14 +155,29 @@ func (t *Task) Exec(ctx context.Context, id string, opts runtime.ExecOpts) (runt
 	}, nil
 }
 
-func (t *Task) Pids(ctx context.Context) ([]uint32, error) {
+func (t *Task) Pids(ctx context.Context) ([]runtime.ProcessInfo, error) {
 	resp, err := t.shim.ListPids(ctx, &shim.ListPidsRequest{
 		ID: t.id,
 	})
 	if err != nil {
 		return nil, errdefs.FromGRPC(err)
 	}
-	return resp.Pids, nil
+	var processList []runtime.ProcessInfo
+	for _, p := range resp.Processes {
+		processList = append(processList, runtime.ProcessInfo{
+			Pid:    p.Pid,
+			ExecID: p.ExecID,
+		})
+	}
+	// shims older than the process info only return the pids
+	if len(resp.Processes) == 0 {
+		for _, pid := range resp.Pids {
+			processList = append(processList, runtime.ProcessInfo{
+				Pid: pid,
+			})
+		}
+	}
+	return processList, nil
 }
 
 func (t *Task) ResizePty(ctx context.Context, size runtime.ConsoleSize) error {
@@ -159,9 +204,19 @@ func (t *Task) CloseIO(ctx context.Context) error {
 }
 
 func (t *Task) Checkpoint(ctx context.Context, path string, options *types.Any) error {
+	return t.CheckpointWithParent(ctx, path, "", options)
+}
+
+// CheckpointWithParent checkpoints the task relative to the parent checkpoint,
+// a pre-dump of the task or a previous checkpoint
+func (t *Task) CheckpointWithParent(ctx context.Context, path, parent string, options *types.Any) error {
+	if sys.IsRootless() {
+		return errors.Wrap(errRootless, "checkpointing requires root")
+	}
 	r := &shim.CheckpointTaskRequest{
-		Path:    path,
-		Options: options,
+		Path:       path,
+		Options:    options,
+		ParentPath: parent,
 	}
 	if _, err := t.shim.Checkpoint(ctx, r); err != nil {
 		return errdefs.FromGRPC(err)
@@ -190,6 +245,24 @@ func (t *Task) Update(ctx context.Context, resources *types.Any) error {
 	return err
 }
 
+// Delete deletes the task from the shim and returns its exit
+func (t *Task) Delete(ctx context.Context) (*runtime.Exit, error) {
+	r, err := t.shim.Delete(ctx, empty)
+	if err != nil {
+		return nil, errdefs.FromGRPC(err)
+	}
+	return &runtime.Exit{
+		Status:    r.ExitStatus,
+		Timestamp: r.ExitedAt,
+		Pid:       r.Pid,
+	}, nil
+}
+
+// KillShim kills the shim of the task without waiting for the task to exit
+func (t *Task) KillShim(ctx context.Context) error {
+	return t.shim.KillShim(ctx)
+}
+
 func (t *Task) Process(ctx context.Context, id string) (runtime.Process, error) {
 	// TODO: verify process exists for container
 	return &Process{
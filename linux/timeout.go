@@ -0,0 +1,64 @@
+// +build linux
+
+package linux
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// cleanupTimeout bounds the cleanup of a task that failed to be created,
+// which runs after the context of the create may have expired
+const cleanupTimeout = 10 * time.Second
+
+// TimeoutConfig sets the default timeouts of the operations of the runtime
+// on tasks, which apply when the caller sets no earlier deadline. An
+// operation is not bounded when its timeout is empty.
+type TimeoutConfig struct {
+	Create string `toml:"create,omitempty"`
+	Start  string `toml:"start,omitempty"`
+	Delete string `toml:"delete,omitempty"`
+}
+
+type timeouts struct {
+	create time.Duration
+	start  time.Duration
+	delete time.Duration
+}
+
+func newTimeouts(config TimeoutConfig) (timeouts, error) {
+	var t timeouts
+	for _, o := range []struct {
+		name    string
+		timeout string
+		d       *time.Duration
+	}{
+		{"create", config.Create, &t.create},
+		{"start", config.Start, &t.start},
+		{"delete", config.Delete, &t.delete},
+	} {
+		if o.timeout == "" {
+			continue
+		}
+		d, err := time.ParseDuration(o.timeout)
+		if err != nil {
+			return timeouts{}, errors.Wrapf(err, "invalid %s timeout %q", o.name, o.timeout)
+		}
+		if d < 0 {
+			return timeouts{}, errors.Errorf("invalid %s timeout %q", o.name, o.timeout)
+		}
+		*o.d = d
+	}
+	return t, nil
+}
+
+// withTimeout returns a context expiring after the timeout, or only
+// canceled by the returned function when the timeout is zero
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout == 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,57 @@
+// +build linux
+
+package linux
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestNewTimeouts(t *testing.T) {
+	to, err := newTimeouts(TimeoutConfig{
+		Create: "2m",
+		Delete: "30s",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if to.create != 2*time.Minute || to.start != 0 || to.delete != 30*time.Second {
+		t.Fatalf("unexpected timeouts %+v", to)
+	}
+	for _, c := range []TimeoutConfig{
+		{Create: "soon"},
+		{Start: "-1s"},
+	} {
+		if _, err := newTimeouts(c); err == nil {
+			t.Errorf("expected error for %+v", c)
+		}
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	ctx, cancel := withTimeout(context.Background(), 0)
+	if _, ok := ctx.Deadline(); ok {
+		t.Fatal("expected no deadline without a timeout")
+	}
+	cancel()
+	if ctx.Err() != context.Canceled {
+		t.Fatalf("expected context to be canceled, got %v", ctx.Err())
+	}
+
+	parent, pcancel := context.WithTimeout(context.Background(), time.Second)
+	defer pcancel()
+	ctx, cancel = withTimeout(parent, time.Hour)
+	defer cancel()
+	pd, _ := parent.Deadline()
+	if d, _ := ctx.Deadline(); !d.Equal(pd) {
+		t.Fatalf("expected the earlier deadline of the caller %v, got %v", pd, d)
+	}
+
+	ctx, cancel = withTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	<-ctx.Done()
+	if ctx.Err() != context.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded, got %v", ctx.Err())
+	}
+}
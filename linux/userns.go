@@ -0,0 +1,30 @@
+// +build linux
+
+package linux
+
+import (
+	"encoding/json"
+
+	"github.com/containerd/containerd/linux/runcopts"
+	"github.com/containerd/containerd/oci"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// withIOOwner returns the options with the owner of the stdio pipes set to
+// the host ids of the root of the user namespace of the container, and
+// whether they were changed. Options setting an owner are kept.
+func withIOOwner(spec []byte, options runcopts.CreateOptions) (runcopts.CreateOptions, bool, error) {
+	if options.IoUid != 0 || options.IoGid != 0 {
+		return options, false, nil
+	}
+	var s specs.Spec
+	if err := json.Unmarshal(spec, &s); err != nil {
+		return options, false, err
+	}
+	uid, gid, ok, err := oci.RootIDs(&s)
+	if err != nil || !ok || (uid == 0 && gid == 0) {
+		return options, false, nil
+	}
+	options.IoUid, options.IoGid = uid, gid
+	return options, true, nil
+}
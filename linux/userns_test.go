@@ -0,0 +1,47 @@
+// +build linux
+
+package linux
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/containerd/containerd/linux/runcopts"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestWithIOOwner(t *testing.T) {
+	data, err := json.Marshal(specs.Spec{
+		Linux: &specs.Linux{
+			Namespaces:  []specs.LinuxNamespace{{Type: specs.UserNamespace}},
+			UIDMappings: []specs.LinuxIDMapping{{ContainerID: 0, HostID: 100000, Size: 65536}},
+			GIDMappings: []specs.LinuxIDMapping{{ContainerID: 0, HostID: 200000, Size: 65536}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	options, ok, err := withIOOwner(data, runcopts.CreateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || options.IoUid != 100000 || options.IoGid != 200000 {
+		t.Fatalf("unexpected io owner %d:%d (changed %v)", options.IoUid, options.IoGid, ok)
+	}
+	// an owner set by the client is kept
+	options, ok, err = withIOOwner(data, runcopts.CreateOptions{IoUid: 1000, IoGid: 1000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok || options.IoUid != 1000 || options.IoGid != 1000 {
+		t.Fatalf("unexpected io owner %d:%d (changed %v)", options.IoUid, options.IoGid, ok)
+	}
+	// without a user namespace the pipes are owned by the shim
+	data, err = json.Marshal(specs.Spec{Linux: &specs.Linux{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, err = withIOOwner(data, runcopts.CreateOptions{}); err != nil || ok {
+		t.Fatalf("unexpected io owner without user namespace (changed %v, %v)", ok, err)
+	}
+}
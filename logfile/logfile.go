@@ -0,0 +1,136 @@
+// Package logfile writes the output of containers to a log file in the CRI
+// format, one entry per line:
+//
+//	<RFC3339Nano timestamp> <stream> <P|F> <content>
+//
+// Lines longer than the maximum line size are split into entries tagged P,
+// for partial, followed by a last entry tagged F, so that readers of the
+// log never have to buffer more than the maximum line size.
+package logfile
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/logging"
+)
+
+const (
+	// DefaultMaxLineSize is the maximum size of the content of an entry
+	// when none is configured
+	DefaultMaxLineSize = logging.DefaultMaxLineSize
+
+	// Partial tags an entry holding part of a line
+	Partial = "P"
+	// Full tags an entry ending a line
+	Full = "F"
+)
+
+var _ logging.Driver = &File{}
+
+// File is a log file shared by the streams of a container
+type File struct {
+	mu          sync.Mutex
+	w           io.WriteCloser
+	maxLineSize int
+	streams     []*Stream
+	closed      bool
+}
+
+// Open opens the log file at path for appending, creating it if needed
+func Open(path string, maxLineSize int) (*File, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0640)
+	if err != nil {
+		return nil, err
+	}
+	return New(f, maxLineSize), nil
+}
+
+// New returns a log file writing its entries to w
+func New(w io.WriteCloser, maxLineSize int) *File {
+	if maxLineSize <= 0 {
+		maxLineSize = DefaultMaxLineSize
+	}
+	return &File{
+		w:           w,
+		maxLineSize: maxLineSize,
+	}
+}
+
+// Stream returns a writer logging the output written to it as entries of
+// the named stream, such as stdout or stderr
+func (f *File) Stream(name string) *Stream {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	s := &Stream{
+		Stream: logging.NewStream(f, name, f.maxLineSize),
+	}
+	f.streams = append(f.streams, s)
+	return s
+}
+
+// Flush writes the incomplete lines buffered by the streams
+func (f *File) Flush() error {
+	f.mu.Lock()
+	streams := f.streams
+	f.mu.Unlock()
+	for _, s := range streams {
+		if err := s.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close flushes the streams and closes the file, output written to the
+// streams afterwards is discarded
+func (f *File) Close() error {
+	err := f.Flush()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed {
+		return err
+	}
+	f.closed = true
+	if cerr := f.w.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// Log writes the message as an entry of the file
+func (f *File) Log(m *logging.Message) error {
+	tag := Full
+	if m.Partial {
+		tag = Partial
+	}
+	return f.write(m.Timestamp, m.Stream, tag, m.Line)
+}
+
+func (f *File) write(timestamp time.Time, stream, tag string, content []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed {
+		return nil
+	}
+	var b bytes.Buffer
+	b.Grow(len(content) + 64)
+	b.WriteString(timestamp.Format(time.RFC3339Nano))
+	b.WriteByte(' ')
+	b.WriteString(stream)
+	b.WriteByte(' ')
+	b.WriteString(tag)
+	b.WriteByte(' ')
+	b.Write(content)
+	b.WriteByte('\n')
+	_, err := f.w.Write(b.Bytes())
+	return err
+}
+
+// Stream splits the output of a container stream into log entries
+type Stream struct {
+	*logging.Stream
+}
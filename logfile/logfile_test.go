@@ -0,0 +1,82 @@
+package logfile
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type nopCloser struct {
+	bytes.Buffer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+// entries returns the stream, tag and content of the entries of the log
+func entries(t *testing.T, log string) [][3]string {
+	var out [][3]string
+	for _, line := range strings.Split(strings.TrimSuffix(log, "\n"), "\n") {
+		parts := strings.SplitN(line, " ", 4)
+		if len(parts) != 4 {
+			t.Fatalf("invalid entry %q", line)
+		}
+		out = append(out, [3]string{parts[1], parts[2], parts[3]})
+	}
+	return out
+}
+
+func TestStream(t *testing.T) {
+	var (
+		w      nopCloser
+		f      = New(&w, 4)
+		stdout = f.Stream("stdout")
+		stderr = f.Stream("stderr")
+	)
+	for _, s := range []struct {
+		stream *Stream
+		data   string
+	}{
+		{stdout, "ab"},
+		{stdout, "c\n"},
+		{stderr, "0123456789\n"},
+		{stdout, "wxyz\nlast"},
+	} {
+		n, err := s.stream.Write([]byte(s.data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != len(s.data) {
+			t.Fatalf("expected %d bytes written, got %d", len(s.data), n)
+		}
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	expected := [][3]string{
+		{"stdout", Full, "abc"},
+		{"stderr", Partial, "0123"},
+		{"stderr", Partial, "4567"},
+		{"stderr", Full, "89"},
+		{"stdout", Full, "wxyz"},
+		{"stdout", Full, "last"},
+	}
+	actual := entries(t, w.String())
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Errorf("entry %d: expected %v, got %v", i, expected[i], actual[i])
+		}
+	}
+
+	// output written after the file is closed is discarded
+	if _, err := stdout.Write([]byte("late\n")); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(entries(t, w.String())); got != len(expected) {
+		t.Fatalf("expected %d entries after close, got %d", len(expected), got)
+	}
+}
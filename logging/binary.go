@@ -0,0 +1,99 @@
+package logging
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+)
+
+func init() {
+	Register("binary", newBinary)
+}
+
+// binaryWaitTimeout is how long a logging binary has to exit once its
+// stdin is closed before it is killed
+const binaryWaitTimeout = 10 * time.Second
+
+// binaryMessage is a message sent to a logging binary
+type binaryMessage struct {
+	Log     string    `json:"log"`
+	Stream  string    `json:"stream"`
+	Partial bool      `json:"partial,omitempty"`
+	Time    time.Time `json:"time"`
+}
+
+// binaryLogger sends the messages of a container to a logging binary run for
+// the container
+type binaryLogger struct {
+	mu    sync.Mutex
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+	enc   *json.Encoder
+	done  chan error
+}
+
+// newBinary runs the binary at the path option with the namespace and the
+// id of the container as arguments. The messages are written to its stdin
+// as json objects, one per line, and its stdin is closed when the output of
+// the container ends.
+func newBinary(info Info) (Driver, error) {
+	path := info.Options["path"]
+	if path == "" {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, "binary log driver requires a path")
+	}
+	cmd := exec.Command(path, info.Namespace, info.ID)
+	cmd.Dir = info.Root
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := os.MkdirAll(info.Root, 0700); err != nil {
+		return nil, err
+	}
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, errors.Wrapf(err, "failed to start logging binary %s", path)
+	}
+	b := &binaryLogger{
+		cmd:   cmd,
+		stdin: stdin,
+		enc:   json.NewEncoder(stdin),
+		done:  make(chan error, 1),
+	}
+	go func() {
+		b.done <- cmd.Wait()
+		close(b.done)
+	}()
+	return b, nil
+}
+
+func (b *binaryLogger) Log(m *Message) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.enc.Encode(binaryMessage{
+		Log:     string(m.Line),
+		Stream:  m.Stream,
+		Partial: m.Partial,
+		Time:    m.Timestamp,
+	})
+}
+
+func (b *binaryLogger) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.stdin.Close()
+	select {
+	case err := <-b.done:
+		return err
+	case <-time.After(binaryWaitTimeout):
+		b.cmd.Process.Kill()
+		return <-b.done
+	}
+}
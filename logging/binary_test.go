@@ -0,0 +1,59 @@
+// +build !windows
+
+package logging
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestBinary(t *testing.T) {
+	root, err := ioutil.TempDir("", "logging-binary-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	// the logger records its arguments and its stdin in its directory
+	logger := filepath.Join(root, "logger")
+	if err := ioutil.WriteFile(logger, []byte("#!/bin/sh\necho \"$1 $2\" > args\ncat > messages\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(root, "container")
+	d, err := New("binary", Info{
+		Namespace: "default",
+		ID:        "test",
+		Root:      dir,
+		Options:   map[string]string{"path": logger},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Log(&Message{Stream: "stderr", Line: []byte("line"), Partial: true, Timestamp: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Close(); err != nil {
+		t.Fatal(err)
+	}
+	args, err := ioutil.ReadFile(filepath.Join(dir, "args"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(args) != "default test\n" {
+		t.Fatalf("unexpected arguments %q", args)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "messages"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m binaryMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Log != "line" || m.Stream != "stderr" || !m.Partial {
+		t.Fatalf("unexpected message %+v", m)
+	}
+}
@@ -0,0 +1,76 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"strconv"
+)
+
+func init() {
+	Register("journald", newJournald)
+}
+
+// journalSocket is the socket of the native protocol of journald
+const journalSocket = "/run/systemd/journal/socket"
+
+// journald sends messages to the journal with the fields of their
+// container, CONTAINER_NAMESPACE and CONTAINER_ID, stdout at the info and
+// stderr at the error priority
+type journald struct {
+	conn   *net.UnixConn
+	fields map[string]string
+}
+
+// newJournald creates a journald driver, the tag option sets the
+// SYSLOG_IDENTIFIER of the messages, <namespace>/<id> by default
+func newJournald(info Info) (Driver, error) {
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: journalSocket, Net: "unixgram"})
+	if err != nil {
+		return nil, err
+	}
+	tag := info.Options["tag"]
+	if tag == "" {
+		tag = info.Namespace + "/" + info.ID
+	}
+	return &journald{
+		conn: conn,
+		fields: map[string]string{
+			"CONTAINER_NAMESPACE": info.Namespace,
+			"CONTAINER_ID":        info.ID,
+			"SYSLOG_IDENTIFIER":   tag,
+		},
+	}, nil
+}
+
+func (j *journald) Log(m *Message) error {
+	var b bytes.Buffer
+	priority := 6
+	if m.Stream == "stderr" {
+		priority = 3
+	}
+	writeJournalField(&b, "PRIORITY", []byte(strconv.Itoa(priority)))
+	for k, v := range j.fields {
+		writeJournalField(&b, k, []byte(v))
+	}
+	if m.Partial {
+		writeJournalField(&b, "CONTAINER_PARTIAL_MESSAGE", []byte("true"))
+	}
+	writeJournalField(&b, "MESSAGE", m.Line)
+	_, err := j.conn.Write(b.Bytes())
+	return err
+}
+
+// writeJournalField writes the field in the binary form of the native
+// protocol, which allows any byte in the value
+func writeJournalField(b *bytes.Buffer, key string, value []byte) {
+	b.WriteString(key)
+	b.WriteByte('\n')
+	binary.Write(b, binary.LittleEndian, uint64(len(value)))
+	b.Write(value)
+	b.WriteByte('\n')
+}
+
+func (j *journald) Close() error {
+	return j.conn.Close()
+}
@@ -0,0 +1,190 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/errdefs"
+	units "github.com/docker/go-units"
+	"github.com/pkg/errors"
+)
+
+func init() {
+	Register("json-file", newJSONFile)
+	RegisterReader("json-file", newJSONFileReader)
+}
+
+// jsonEntry is a line of a json-file log, in the format of the docker
+// json-file driver
+type jsonEntry struct {
+	Log    string    `json:"log"`
+	Stream string    `json:"stream"`
+	Time   time.Time `json:"time"`
+}
+
+// jsonFile logs messages as json entries to a file, rotated when it
+// reaches its maximum size or age
+type jsonFile struct {
+	mu      sync.Mutex
+	path    string
+	maxSize int64
+	maxAge  time.Duration
+	maxFile int
+
+	f       *os.File
+	size    int64
+	created time.Time
+}
+
+// newJSONFile creates a json-file driver configured by the options:
+//
+//	path      the log file, json.log in the root of the driver by default
+//	max-size  the size at which the file is rotated, such as 10m, unlimited
+//	          by default
+//	max-age   the age at which the file is rotated, such as 24h, unlimited
+//	          by default
+//	max-file  the number of files kept including the current one, 1 by
+//	          default
+func newJSONFile(info Info) (Driver, error) {
+	path, maxFile, err := jsonFileOptions(info)
+	if err != nil {
+		return nil, err
+	}
+	j := &jsonFile{
+		path:    path,
+		maxFile: maxFile,
+	}
+	if v, ok := info.Options["max-size"]; ok {
+		if j.maxSize, err = units.RAMInBytes(v); err != nil || j.maxSize <= 0 {
+			return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid max-size %q", v)
+		}
+	}
+	if v, ok := info.Options["max-age"]; ok {
+		if j.maxAge, err = time.ParseDuration(v); err != nil || j.maxAge <= 0 {
+			return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid max-age %q", v)
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(j.path), 0700); err != nil {
+		return nil, err
+	}
+	if err := j.open(); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// jsonFileOptions returns the path of the log file and the number of files
+// kept configured by the options
+func jsonFileOptions(info Info) (string, int, error) {
+	path, maxFile := info.Options["path"], 1
+	if path == "" {
+		path = filepath.Join(info.Root, "json.log")
+	}
+	if v, ok := info.Options["max-file"]; ok {
+		var err error
+		if maxFile, err = strconv.Atoi(v); err != nil || maxFile < 1 {
+			return "", 0, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid max-file %q", v)
+		}
+	}
+	return path, maxFile, nil
+}
+
+func (j *jsonFile) open() error {
+	f, err := os.OpenFile(j.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0640)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	j.f, j.size, j.created = f, fi.Size(), time.Now()
+	if fi.Size() > 0 {
+		// the age of an existing file is counted from its last write
+		j.created = fi.ModTime()
+	}
+	return nil
+}
+
+func (j *jsonFile) Log(m *Message) error {
+	line := string(m.Line)
+	if !m.Partial {
+		line += "\n"
+	}
+	data, err := json.Marshal(jsonEntry{
+		Log:    line,
+		Stream: m.Stream,
+		Time:   m.Timestamp,
+	})
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.f == nil {
+		return errors.New("log file closed")
+	}
+	if j.expired(int64(len(data)), m.Timestamp) {
+		if err := j.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := j.f.Write(data)
+	j.size += int64(n)
+	return err
+}
+
+// expired returns true if the file must be rotated before writing size
+// more bytes at the time
+func (j *jsonFile) expired(size int64, now time.Time) bool {
+	if j.size == 0 {
+		return false
+	}
+	if j.maxSize > 0 && j.size+size > j.maxSize {
+		return true
+	}
+	return j.maxAge > 0 && now.Sub(j.created) >= j.maxAge
+}
+
+// rotate shifts the rotated files, dropping the oldest, and starts a new
+// file
+func (j *jsonFile) rotate() error {
+	if err := j.f.Close(); err != nil {
+		return err
+	}
+	j.f = nil
+	if j.maxFile == 1 {
+		if err := os.Remove(j.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return j.open()
+	}
+	for i := j.maxFile - 1; i > 0; i-- {
+		from := j.path
+		if i > 1 {
+			from = fmt.Sprintf("%s.%d", j.path, i-1)
+		}
+		if err := os.Rename(from, fmt.Sprintf("%s.%d", j.path, i)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return j.open()
+}
+
+func (j *jsonFile) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.f == nil {
+		return nil
+	}
+	err := j.f.Close()
+	j.f = nil
+	return err
+}
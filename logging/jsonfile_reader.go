@@ -0,0 +1,201 @@
+package logging
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// followInterval is how often a followed json-file log is checked for new
+// entries
+const followInterval = 250 * time.Millisecond
+
+// jsonFileReader reads back the entries of a json-file log, from the oldest
+// rotated file to the current one
+type jsonFileReader struct {
+	path    string
+	maxFile int
+}
+
+func newJSONFileReader(info Info) (Reader, error) {
+	path, maxFile, err := jsonFileOptions(info)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonFileReader{
+		path:    path,
+		maxFile: maxFile,
+	}, nil
+}
+
+func (r *jsonFileReader) ReadLogs(ctx context.Context, config ReadConfig, done <-chan struct{}, fn func(*Message) error) error {
+	// the selected messages are sent once the tail of the log is known
+	var selected []*Message
+	collect := func(m *Message) error {
+		if m.Timestamp.Before(config.Since) {
+			return nil
+		}
+		if config.Tail > 0 && len(selected) == config.Tail {
+			selected = append(selected[:0], selected[1:]...)
+		}
+		selected = append(selected, m)
+		return nil
+	}
+	for i := r.maxFile - 1; i > 0; i-- {
+		if err := r.readFile(fmt.Sprintf("%s.%d", r.path, i), collect); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	t, err := openJSONTail(r.path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	defer func() {
+		// the followed file changes as the log is rotated
+		if t != nil {
+			t.close()
+		}
+	}()
+	if t != nil {
+		if err := t.drain(collect); err != nil {
+			return err
+		}
+	}
+	for _, m := range selected {
+		if err := fn(m); err != nil {
+			return err
+		}
+	}
+	if !config.Follow {
+		return nil
+	}
+	send := func(m *Message) error {
+		if m.Timestamp.Before(config.Since) {
+			return nil
+		}
+		return fn(m)
+	}
+	ticker := time.NewTicker(followInterval)
+	defer ticker.Stop()
+	for {
+		finished := false
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-done:
+			finished = true
+		case <-ticker.C:
+		}
+		if t != nil {
+			if err := t.drain(send); err != nil {
+				return err
+			}
+		}
+		// continue with the new file once the followed one is rotated
+		if t == nil || t.rotated() {
+			next, err := openJSONTail(r.path)
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			if next != nil {
+				if t != nil {
+					t.close()
+				}
+				t = next
+				if err := t.drain(send); err != nil {
+					return err
+				}
+			}
+		}
+		if finished {
+			return nil
+		}
+	}
+}
+
+func (r *jsonFileReader) readFile(path string, fn func(*Message) error) error {
+	t, err := openJSONTail(path)
+	if err != nil {
+		return err
+	}
+	defer t.close()
+	return t.drain(fn)
+}
+
+// jsonTail reads the entries appended to a json-file log
+type jsonTail struct {
+	path string
+	f    *os.File
+	r    *bufio.Reader
+	// pending is the part of an entry whose end is not written yet
+	pending []byte
+}
+
+func openJSONTail(path string) (*jsonTail, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonTail{
+		path: path,
+		f:    f,
+		r:    bufio.NewReader(f),
+	}, nil
+}
+
+// drain calls fn with the entries written to the file since the last call
+func (t *jsonTail) drain(fn func(*Message) error) error {
+	for {
+		line, err := t.r.ReadBytes('\n')
+		if err != nil {
+			t.pending = append(t.pending, line...)
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if len(t.pending) > 0 {
+			line = append(t.pending, line...)
+			t.pending = nil
+		}
+		var e jsonEntry
+		if err := json.Unmarshal(line, &e); err != nil {
+			// skip the entries left corrupt by a crash
+			continue
+		}
+		m := &Message{
+			Stream:    e.Stream,
+			Line:      []byte(e.Log),
+			Timestamp: e.Time,
+			Partial:   true,
+		}
+		if bytes.HasSuffix(m.Line, []byte("\n")) {
+			m.Line, m.Partial = m.Line[:len(m.Line)-1], false
+		}
+		if err := fn(m); err != nil {
+			return err
+		}
+	}
+}
+
+// rotated returns true if the path of the file is now another file
+func (t *jsonTail) rotated() bool {
+	current, err := t.f.Stat()
+	if err != nil {
+		return true
+	}
+	fi, err := os.Stat(t.path)
+	if err != nil {
+		return true
+	}
+	return !os.SameFile(current, fi)
+}
+
+func (t *jsonTail) close() error {
+	return t.f.Close()
+}
@@ -0,0 +1,192 @@
+package logging
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/containerd/containerd/errdefs"
+)
+
+func readJSONLog(t *testing.T, path string) []jsonEntry {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var entries []jsonEntry
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var e jsonEntry
+		if err := json.Unmarshal(s.Bytes(), &e); err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+func TestJSONFileRotation(t *testing.T) {
+	root, err := ioutil.TempDir("", "logging-json-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	d, err := New("json-file", Info{
+		Namespace: "default",
+		ID:        "test",
+		Root:      root,
+		Options: map[string]string{
+			"max-size": "100",
+			"max-file": "2",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// each entry is about 70 bytes, so that every entry starts a new file
+	for _, line := range []string{"first", "second", "third"} {
+		if err := d.Log(&Message{Stream: "stdout", Line: []byte(line), Timestamp: time.Now()}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := d.Close(); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(root, "json.log")
+	for p, expected := range map[string]string{
+		path:        "third\n",
+		path + ".1": "second\n",
+	} {
+		entries := readJSONLog(t, p)
+		if len(entries) != 1 || entries[0].Log != expected || entries[0].Stream != "stdout" {
+			t.Errorf("%s: unexpected entries %+v", p, entries)
+		}
+	}
+	if _, err := os.Stat(path + ".2"); !os.IsNotExist(err) {
+		t.Errorf("expected only %d files to be kept", 2)
+	}
+}
+
+func TestJSONFileMaxAge(t *testing.T) {
+	root, err := ioutil.TempDir("", "logging-json-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	d, err := New("json-file", Info{
+		Root:    root,
+		Options: map[string]string{"max-age": "1h"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	now := time.Now()
+	for _, m := range []*Message{
+		{Stream: "stderr", Line: []byte("old"), Partial: true, Timestamp: now},
+		{Stream: "stderr", Line: []byte("new"), Timestamp: now.Add(2 * time.Hour)},
+	} {
+		if err := d.Log(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	entries := readJSONLog(t, filepath.Join(root, "json.log"))
+	if len(entries) != 1 || entries[0].Log != "new\n" {
+		t.Fatalf("expected the file to be rotated, got %+v", entries)
+	}
+}
+
+func TestJSONFileInvalidOptions(t *testing.T) {
+	for _, options := range []map[string]string{
+		{"max-size": "big"},
+		{"max-age": "-1h"},
+		{"max-file": "0"},
+	} {
+		if _, err := New("json-file", Info{Root: os.TempDir(), Options: options}); err == nil {
+			t.Errorf("expected an error for %v", options)
+		}
+	}
+}
+
+func TestJSONFileReadLogs(t *testing.T) {
+	root, err := ioutil.TempDir("", "logging-json-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	info := Info{
+		Root: root,
+		Options: map[string]string{
+			"max-size": "100",
+			"max-file": "3",
+		},
+	}
+	d, err := New("json-file", info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	start := time.Now()
+	for i, line := range []string{"first", "second", "third"} {
+		if err := d.Log(&Message{Stream: "stdout", Line: []byte(line), Timestamp: start.Add(time.Duration(i) * time.Minute)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r, err := NewReader("json-file", info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	read := func(config ReadConfig, done <-chan struct{}) []string {
+		var lines []string
+		if err := r.ReadLogs(context.Background(), config, done, func(m *Message) error {
+			lines = append(lines, string(m.Line))
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		return lines
+	}
+	for _, tc := range []struct {
+		config   ReadConfig
+		expected []string
+	}{
+		{ReadConfig{}, []string{"first", "second", "third"}},
+		{ReadConfig{Tail: 2}, []string{"second", "third"}},
+		{ReadConfig{Since: start.Add(time.Minute)}, []string{"second", "third"}},
+		{ReadConfig{Tail: 1, Since: start.Add(time.Minute)}, []string{"third"}},
+	} {
+		if lines := read(tc.config, nil); !reflect.DeepEqual(lines, tc.expected) {
+			t.Errorf("%+v: expected %v, got %v", tc.config, tc.expected, lines)
+		}
+	}
+
+	// entries logged while following are read until the log is done
+	done := make(chan struct{})
+	go func() {
+		time.Sleep(2 * followInterval)
+		if err := d.Log(&Message{Stream: "stderr", Line: []byte("fourth"), Timestamp: time.Now()}); err != nil {
+			t.Error(err)
+		}
+		close(done)
+	}()
+	lines := read(ReadConfig{Tail: 1, Follow: true}, done)
+	if expected := []string{"third", "fourth"}; !reflect.DeepEqual(lines, expected) {
+		t.Errorf("expected %v, got %v", expected, lines)
+	}
+}
+
+func TestReadLogsUnsupportedDriver(t *testing.T) {
+	if _, err := NewReader("syslog", Info{}); !errdefs.IsNotImplemented(err) {
+		t.Fatalf("expected not implemented error, got %v", err)
+	}
+}
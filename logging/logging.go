@@ -0,0 +1,84 @@
+// Package logging directs the output of containers to log drivers, such as
+// files rotated by size and age, journald, syslog or a logging binary run
+// for each container.
+package logging
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+)
+
+// Message is a line, or part of a line, of the output of a container
+type Message struct {
+	// Stream is the name of the stream of the output, stdout or stderr
+	Stream string
+	// Line is the content of the line without its newline, it is only
+	// valid during the call to Log
+	Line []byte
+	// Partial is set when the line continues in the next message, because
+	// it is longer than the maximum line size
+	Partial   bool
+	Timestamp time.Time
+}
+
+// Driver logs the output of a container
+type Driver interface {
+	Log(*Message) error
+	Close() error
+}
+
+// Info describes the container logged by a driver
+type Info struct {
+	Namespace string
+	ID        string
+	// Root is a directory the driver may keep its files in, it is removed
+	// with the container
+	Root string
+	// Options configure the driver
+	Options map[string]string
+}
+
+// Factory creates the driver of a container
+type Factory func(Info) (Driver, error)
+
+var (
+	mu        sync.Mutex
+	factories = make(map[string]Factory)
+)
+
+// Register registers the factory of the named driver
+func Register(name string, factory Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := factories[name]; ok {
+		panic("log driver " + name + " registered twice")
+	}
+	factories[name] = factory
+}
+
+// New creates a driver with the registered factory of the name
+func New(name string, info Info) (Driver, error) {
+	mu.Lock()
+	factory, ok := factories[name]
+	mu.Unlock()
+	if !ok {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "unknown log driver %q", name)
+	}
+	return factory(info)
+}
+
+// Drivers returns the names of the registered drivers
+func Drivers() []string {
+	mu.Lock()
+	defer mu.Unlock()
+	var names []string
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,57 @@
+package logging
+
+import (
+	"context"
+	"time"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+)
+
+// ReadConfig selects the messages read back from a log
+type ReadConfig struct {
+	// Tail limits the messages to the last ones, all messages are read when
+	// it is zero
+	Tail int
+	// Since limits the messages to the ones logged at or after the time
+	Since time.Time
+	// Follow keeps reading the messages logged after the call
+	Follow bool
+}
+
+// Reader reads back the messages logged by a driver
+type Reader interface {
+	// ReadLogs calls fn with the messages selected by the config in the
+	// order they were logged. With Follow, it keeps calling fn with the
+	// messages logged afterwards until done is closed, once the driver is
+	// closed, or the context is done.
+	ReadLogs(ctx context.Context, config ReadConfig, done <-chan struct{}, fn func(*Message) error) error
+}
+
+// ReaderFactory creates the reader of the logs of a container, with the info
+// its driver was created with
+type ReaderFactory func(Info) (Reader, error)
+
+var readers = make(map[string]ReaderFactory)
+
+// RegisterReader registers the factory of the reader of the named driver
+func RegisterReader(name string, factory ReaderFactory) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := readers[name]; ok {
+		panic("log reader " + name + " registered twice")
+	}
+	readers[name] = factory
+}
+
+// NewReader creates a reader with the registered factory of the name. The
+// logs of drivers without a reader, such as syslog, cannot be read back.
+func NewReader(name string, info Info) (Reader, error) {
+	mu.Lock()
+	factory, ok := readers[name]
+	mu.Unlock()
+	if !ok {
+		return nil, errors.Wrapf(errdefs.ErrNotImplemented, "logs of log driver %q cannot be read", name)
+	}
+	return factory(info)
+}
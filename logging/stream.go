@@ -0,0 +1,89 @@
+package logging
+
+import (
+	"bytes"
+	"sync"
+	"time"
+)
+
+// DefaultMaxLineSize is the maximum size of the content of a message when
+// none is configured
+const DefaultMaxLineSize = 16 << 10
+
+// Stream splits the output of a container stream into messages to a driver
+type Stream struct {
+	mu     sync.Mutex
+	driver Driver
+	name   string
+	buf    []byte
+}
+
+// NewStream returns a stream logging the output written to it to the driver
+// as messages of the named stream, splitting lines longer than maxLineSize
+// into partial messages
+func NewStream(driver Driver, name string, maxLineSize int) *Stream {
+	if maxLineSize <= 0 {
+		maxLineSize = DefaultMaxLineSize
+	}
+	return &Stream{
+		driver: driver,
+		name:   name,
+		buf:    make([]byte, 0, maxLineSize),
+	}
+}
+
+// Write logs the complete lines of p and buffers the rest, logging partial
+// messages whenever the buffered line reaches the maximum line size
+func (s *Stream) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := len(p)
+	for len(p) > 0 {
+		i := bytes.IndexByte(p, '\n')
+		if i < 0 {
+			i = len(p)
+		}
+		// fill the buffer up to the maximum line size
+		room := cap(s.buf) - len(s.buf)
+		if i > room {
+			s.buf = append(s.buf, p[:room]...)
+			p = p[room:]
+			if err := s.emit(true); err != nil {
+				return n - len(p), err
+			}
+			continue
+		}
+		s.buf = append(s.buf, p[:i]...)
+		p = p[i:]
+		if len(p) == 0 {
+			break
+		}
+		// drop the newline
+		p = p[1:]
+		if err := s.emit(false); err != nil {
+			return n - len(p), err
+		}
+	}
+	return n, nil
+}
+
+// Flush logs the buffered incomplete line as the end of the line
+func (s *Stream) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.buf) == 0 {
+		return nil
+	}
+	return s.emit(false)
+}
+
+func (s *Stream) emit(partial bool) error {
+	err := s.driver.Log(&Message{
+		Stream:    s.name,
+		Line:      s.buf,
+		Partial:   partial,
+		Timestamp: time.Now().UTC(),
+	})
+	s.buf = s.buf[:0]
+	return err
+}
@@ -0,0 +1,61 @@
+package logging
+
+import (
+	"testing"
+)
+
+type recorder struct {
+	messages []Message
+}
+
+func (r *recorder) Log(m *Message) error {
+	line := make([]byte, len(m.Line))
+	copy(line, m.Line)
+	r.messages = append(r.messages, Message{Stream: m.Stream, Line: line, Partial: m.Partial})
+	return nil
+}
+
+func (r *recorder) Close() error {
+	return nil
+}
+
+func TestStream(t *testing.T) {
+	var (
+		r = &recorder{}
+		s = NewStream(r, "stdout", 4)
+	)
+	for _, data := range []string{"ab", "c\n0123456789\n", "wxyz\nlast"} {
+		if _, err := s.Write([]byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	expected := []struct {
+		line    string
+		partial bool
+	}{
+		{"abc", false},
+		{"0123", true},
+		{"4567", true},
+		{"89", false},
+		{"wxyz", false},
+		{"last", false},
+	}
+	if len(r.messages) != len(expected) {
+		t.Fatalf("expected %d messages, got %d", len(expected), len(r.messages))
+	}
+	for i, e := range expected {
+		m := r.messages[i]
+		if string(m.Line) != e.line || m.Partial != e.partial || m.Stream != "stdout" {
+			t.Errorf("message %d: expected %q partial %v, got %q partial %v", i, e.line, e.partial, m.Line, m.Partial)
+		}
+	}
+}
+
+func TestUnknownDriver(t *testing.T) {
+	if _, err := New("unknown", Info{}); err == nil {
+		t.Fatal("expected an error for an unknown driver")
+	}
+}
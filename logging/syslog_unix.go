@@ -0,0 +1,93 @@
+// +build !windows
+
+package logging
+
+import (
+	"log/syslog"
+	"net/url"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+)
+
+func init() {
+	Register("syslog", newSyslog)
+}
+
+var facilities = map[string]syslog.Priority{
+	"kern":     syslog.LOG_KERN,
+	"user":     syslog.LOG_USER,
+	"mail":     syslog.LOG_MAIL,
+	"daemon":   syslog.LOG_DAEMON,
+	"auth":     syslog.LOG_AUTH,
+	"syslog":   syslog.LOG_SYSLOG,
+	"lpr":      syslog.LOG_LPR,
+	"news":     syslog.LOG_NEWS,
+	"uucp":     syslog.LOG_UUCP,
+	"cron":     syslog.LOG_CRON,
+	"authpriv": syslog.LOG_AUTHPRIV,
+	"ftp":      syslog.LOG_FTP,
+	"local0":   syslog.LOG_LOCAL0,
+	"local1":   syslog.LOG_LOCAL1,
+	"local2":   syslog.LOG_LOCAL2,
+	"local3":   syslog.LOG_LOCAL3,
+	"local4":   syslog.LOG_LOCAL4,
+	"local5":   syslog.LOG_LOCAL5,
+	"local6":   syslog.LOG_LOCAL6,
+	"local7":   syslog.LOG_LOCAL7,
+}
+
+// syslogDriver logs stdout at the info and stderr at the error priority
+type syslogDriver struct {
+	w *syslog.Writer
+}
+
+// newSyslog creates a syslog driver configured by the options:
+//
+//	address   the syslog server, such as udp://host:514 or unix:///dev/log,
+//	          the local server by default
+//	facility  the facility of the messages, daemon by default
+//	tag       the tag of the messages, <namespace>/<id> by default
+func newSyslog(info Info) (Driver, error) {
+	var network, raddr string
+	if address := info.Options["address"]; address != "" {
+		u, err := url.Parse(address)
+		if err != nil {
+			return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid syslog address %q", address)
+		}
+		switch u.Scheme {
+		case "unix", "unixgram":
+			network, raddr = u.Scheme, u.Path
+		case "tcp", "udp":
+			network, raddr = u.Scheme, u.Host
+		default:
+			return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "unsupported syslog address %q", address)
+		}
+	}
+	facility := syslog.LOG_DAEMON
+	if name, ok := info.Options["facility"]; ok {
+		if facility, ok = facilities[name]; !ok {
+			return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "unknown syslog facility %q", name)
+		}
+	}
+	tag := info.Options["tag"]
+	if tag == "" {
+		tag = info.Namespace + "/" + info.ID
+	}
+	w, err := syslog.Dial(network, raddr, facility|syslog.LOG_INFO, tag)
+	if err != nil {
+		return nil, err
+	}
+	return &syslogDriver{w: w}, nil
+}
+
+func (s *syslogDriver) Log(m *Message) error {
+	if m.Stream == "stderr" {
+		return s.w.Err(string(m.Line))
+	}
+	return s.w.Info(string(m.Line))
+}
+
+func (s *syslogDriver) Close() error {
+	return s.w.Close()
+}
@@ -3,10 +3,12 @@ package metadata
 import (
 	"strings"
 
+	"github.com/containerd/containerd/checkpoints"
 	"github.com/containerd/containerd/containers"
 	"github.com/containerd/containerd/content"
 	"github.com/containerd/containerd/filters"
 	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/sandboxes"
 )
 
 func adaptImage(o interface{}) filters.Adaptor {
@@ -62,6 +64,61 @@ func adaptContainer(o interface{}) filters.Adaptor {
 			}
 		case "image":
 			return obj.Image, len(obj.Image) > 0
+		case "sandbox_id":
+			return obj.SandboxID, len(obj.SandboxID) > 0
+		case "labels":
+			return checkMap(fieldpath[1:], obj.Labels)
+		}
+
+		return "", false
+	})
+}
+
+func adaptSandbox(o interface{}) filters.Adaptor {
+	obj := o.(sandboxes.Sandbox)
+	return filters.AdapterFunc(func(fieldpath []string) (string, bool) {
+		if len(fieldpath) == 0 {
+			return "", false
+		}
+
+		switch fieldpath[0] {
+		case "id":
+			return obj.ID, len(obj.ID) > 0
+		case "network":
+			return obj.Network, len(obj.Network) > 0
+		case "labels":
+			return checkMap(fieldpath[1:], obj.Labels)
+		}
+
+		return "", false
+	})
+}
+
+func adaptCheckpoint(o interface{}) filters.Adaptor {
+	obj := o.(checkpoints.Checkpoint)
+	return filters.AdapterFunc(func(fieldpath []string) (string, bool) {
+		if len(fieldpath) == 0 {
+			return "", false
+		}
+
+		switch fieldpath[0] {
+		case "name":
+			return obj.Name, len(obj.Name) > 0
+		case "container_id":
+			return obj.ContainerID, len(obj.ContainerID) > 0
+		case "parent":
+			return obj.Parent, len(obj.Parent) > 0
+		case "target":
+			if len(fieldpath) < 2 {
+				return "", false
+			}
+
+			switch fieldpath[1] {
+			case "digest":
+				return obj.Target.Digest.String(), len(obj.Target.Digest) > 0
+			case "mediatype":
+				return obj.Target.MediaType, len(obj.Target.MediaType) > 0
+			}
 		case "labels":
 			return checkMap(fieldpath[1:], obj.Labels)
 		}
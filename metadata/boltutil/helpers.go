@@ -1,6 +1,7 @@
 package boltutil
 
 import (
+	"encoding/binary"
 	"time"
 
 	"github.com/boltdb/bolt"
@@ -11,6 +12,7 @@ var (
 	bucketKeyLabels    = []byte("labels")
 	bucketKeyCreatedAt = []byte("createdat")
 	bucketKeyUpdatedAt = []byte("updatedat")
+	bucketKeyRevision  = []byte("revision")
 )
 
 // ReadLabels reads the labels key from the bucket
@@ -109,3 +111,22 @@ func WriteTimestamps(bkt *bolt.Bucket, created, updated time.Time) error {
 
 	return nil
 }
+
+// ReadRevision reads the revision from a bucket, returning zero for objects
+// written before revisions were recorded.
+// Uses the key "revision"
+func ReadRevision(bkt *bolt.Bucket) uint64 {
+	v := bkt.Get(bucketKeyRevision)
+	if v == nil {
+		return 0
+	}
+	revision, _ := binary.Uvarint(v)
+	return revision
+}
+
+// WriteRevision writes the revision to a bucket.
+// Uses the key "revision"
+func WriteRevision(bkt *bolt.Bucket, revision uint64) error {
+	buf := make([]byte, binary.MaxVarintLen64)
+	return bkt.Put(bucketKeyRevision, buf[:binary.PutUvarint(buf, revision)])
+}
@@ -12,7 +12,7 @@ import (
 //
 // Generically, we try to do the following:
 //
-// 	<version>/<namespace>/<object>/<key> -> <field>
+//	<version>/<namespace>/<object>/<key> -> <field>
 //
 // version: Currently, this is "v1". Additions can be made to v1 in a backwards
 // compatible way. If the layout changes, a new version must be made, along
@@ -27,16 +27,24 @@ import (
 //
 // key: object-specific key identifying the storage bucket for the objects
 // contents.
+const (
+	// SchemaVersion is the version of the bucket layout
+	SchemaVersion = "v1"
+)
+
 var (
-	bucketKeyVersion          = []byte("v1")
-	bucketKeyObjectLabels     = []byte("labels")     // stores the labels for a namespace.
-	bucketKeyObjectIndexes    = []byte("indexes")    // reserved
-	bucketKeyObjectImages     = []byte("images")     // stores image objects
-	bucketKeyObjectContainers = []byte("containers") // stores container objects
-	bucketKeyObjectSnapshots  = []byte("snapshots")  // stores snapshot references
-	bucketKeyObjectContent    = []byte("content")    // stores content references
-	bucketKeyObjectBlob       = []byte("blob")       // stores content links
-	bucketKeyObjectIngest     = []byte("ingest")     // stores ingest links
+	bucketKeyVersion           = []byte(SchemaVersion)
+	bucketKeyObjectLabels      = []byte("labels")      // stores the labels for a namespace.
+	bucketKeyObjectIndexes     = []byte("indexes")     // reserved
+	bucketKeyObjectImages      = []byte("images")      // stores image objects
+	bucketKeyObjectContainers  = []byte("containers")  // stores container objects
+	bucketKeyObjectSnapshots   = []byte("snapshots")   // stores snapshot references
+	bucketKeyObjectPrepared    = []byte("prepared")    // stores cached snapshots awaiting a claim
+	bucketKeyObjectContent     = []byte("content")     // stores content references
+	bucketKeyObjectBlob        = []byte("blob")        // stores content links
+	bucketKeyObjectIngest      = []byte("ingest")      // stores ingest links
+	bucketKeyObjectSandboxes   = []byte("sandboxes")   // stores sandbox objects
+	bucketKeyObjectCheckpoints = []byte("checkpoints") // stores checkpoint objects
 
 	bucketKeyDigest      = []byte("digest")
 	bucketKeyMediaType   = []byte("mediatype")
@@ -50,6 +58,13 @@ var (
 	bucketKeyRootFS      = []byte("rootfs")
 	bucketKeySnapshotter = []byte("snapshotter")
 	bucketKeyTarget      = []byte("target")
+	bucketKeyExtensions  = []byte("extensions")
+	bucketKeySandbox     = []byte("sandbox")
+	bucketKeyCgroup      = []byte("cgroup")
+	bucketKeyNetwork     = []byte("network")
+	bucketKeySharePID    = []byte("sharepid")
+	bucketKeyNamespaces  = []byte("namespaces")
+	bucketKeyContainer   = []byte("container")
 )
 
 func getBucket(tx *bolt.Tx, keys ...[]byte) *bolt.Bucket {
@@ -143,6 +158,18 @@ func getSnapshotterBucket(tx *bolt.Tx, namespace, snapshotter string) *bolt.Buck
 	return getBucket(tx, bucketKeyVersion, []byte(namespace), bucketKeyObjectSnapshots, []byte(snapshotter))
 }
 
+func createPreparedBucket(tx *bolt.Tx, namespace, snapshotter string) (*bolt.Bucket, error) {
+	bkt, err := createBucketIfNotExists(tx, bucketKeyVersion, []byte(namespace), bucketKeyObjectPrepared, []byte(snapshotter))
+	if err != nil {
+		return nil, err
+	}
+	return bkt, nil
+}
+
+func getPreparedBucket(tx *bolt.Tx, namespace, snapshotter string) *bolt.Bucket {
+	return getBucket(tx, bucketKeyVersion, []byte(namespace), bucketKeyObjectPrepared, []byte(snapshotter))
+}
+
 func createBlobBucket(tx *bolt.Tx, namespace string, dgst digest.Digest) (*bolt.Bucket, error) {
 	bkt, err := createBucketIfNotExists(tx, bucketKeyVersion, []byte(namespace), bucketKeyObjectContent, bucketKeyObjectBlob, []byte(dgst.String()))
 	if err != nil {
@@ -170,3 +197,23 @@ func createIngestBucket(tx *bolt.Tx, namespace string) (*bolt.Bucket, error) {
 func getIngestBucket(tx *bolt.Tx, namespace string) *bolt.Bucket {
 	return getBucket(tx, bucketKeyVersion, []byte(namespace), bucketKeyObjectContent, bucketKeyObjectIngest)
 }
+
+func createSandboxesBucket(tx *bolt.Tx, namespace string) (*bolt.Bucket, error) {
+	return createBucketIfNotExists(tx, bucketKeyVersion, []byte(namespace), bucketKeyObjectSandboxes)
+}
+
+func getSandboxesBucket(tx *bolt.Tx, namespace string) *bolt.Bucket {
+	return getBucket(tx, bucketKeyVersion, []byte(namespace), bucketKeyObjectSandboxes)
+}
+
+func getSandboxBucket(tx *bolt.Tx, namespace, id string) *bolt.Bucket {
+	return getBucket(tx, bucketKeyVersion, []byte(namespace), bucketKeyObjectSandboxes, []byte(id))
+}
+
+func createCheckpointsBucket(tx *bolt.Tx, namespace string) (*bolt.Bucket, error) {
+	return createBucketIfNotExists(tx, bucketKeyVersion, []byte(namespace), bucketKeyObjectCheckpoints)
+}
+
+func getCheckpointsBucket(tx *bolt.Tx, namespace string) *bolt.Bucket {
+	return getBucket(tx, bucketKeyVersion, []byte(namespace), bucketKeyObjectCheckpoints)
+}
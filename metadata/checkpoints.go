@@ -0,0 +1,190 @@
+package metadata
+
+import (
+	"context"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/containerd/containerd/checkpoints"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/filters"
+	"github.com/containerd/containerd/labels"
+	"github.com/containerd/containerd/metadata/boltutil"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/pkg/errors"
+)
+
+type checkpointStore struct {
+	tx *bolt.Tx
+}
+
+// NewCheckpointStore returns a store backed by a bolt DB
+func NewCheckpointStore(tx *bolt.Tx) checkpoints.Store {
+	return &checkpointStore{
+		tx: tx,
+	}
+}
+
+func (s *checkpointStore) Get(ctx context.Context, name string) (checkpoints.Checkpoint, error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return checkpoints.Checkpoint{}, err
+	}
+
+	bkt := getCheckpointsBucket(s.tx, namespace)
+	if bkt == nil {
+		return checkpoints.Checkpoint{}, errors.Wrapf(errdefs.ErrNotFound, "checkpoint %q", name)
+	}
+
+	cbkt := bkt.Bucket([]byte(name))
+	if cbkt == nil {
+		return checkpoints.Checkpoint{}, errors.Wrapf(errdefs.ErrNotFound, "checkpoint %q", name)
+	}
+
+	checkpoint := checkpoints.Checkpoint{Name: name}
+	if err := readCheckpoint(&checkpoint, cbkt); err != nil {
+		return checkpoints.Checkpoint{}, errors.Wrapf(err, "failed to read checkpoint %v", name)
+	}
+
+	return checkpoint, nil
+}
+
+func (s *checkpointStore) List(ctx context.Context, fs ...string) ([]checkpoints.Checkpoint, error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := filters.ParseAll(fs...)
+	if err != nil {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, err.Error())
+	}
+
+	bkt := getCheckpointsBucket(s.tx, namespace)
+	if bkt == nil {
+		return nil, nil
+	}
+
+	var m []checkpoints.Checkpoint
+	if err := bkt.ForEach(func(k, v []byte) error {
+		cbkt := bkt.Bucket(k)
+		if cbkt == nil {
+			return nil
+		}
+		checkpoint := checkpoints.Checkpoint{Name: string(k)}
+		if err := readCheckpoint(&checkpoint, cbkt); err != nil {
+			return errors.Wrap(err, "failed to read checkpoint")
+		}
+		if filter.Match(adaptCheckpoint(checkpoint)) {
+			m = append(m, checkpoint)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (s *checkpointStore) Create(ctx context.Context, checkpoint checkpoints.Checkpoint) (checkpoints.Checkpoint, error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return checkpoints.Checkpoint{}, err
+	}
+
+	if err := validateCheckpoint(&checkpoint); err != nil {
+		return checkpoints.Checkpoint{}, errors.Wrap(err, "create checkpoint failed validation")
+	}
+
+	bkt, err := createCheckpointsBucket(s.tx, namespace)
+	if err != nil {
+		return checkpoints.Checkpoint{}, err
+	}
+
+	cbkt, err := bkt.CreateBucket([]byte(checkpoint.Name))
+	if err != nil {
+		if err == bolt.ErrBucketExists {
+			err = errors.Wrapf(errdefs.ErrAlreadyExists, "checkpoint %q", checkpoint.Name)
+		}
+		return checkpoints.Checkpoint{}, err
+	}
+
+	checkpoint.CreatedAt = time.Now().UTC()
+	if err := writeCheckpoint(cbkt, &checkpoint); err != nil {
+		return checkpoints.Checkpoint{}, errors.Wrap(err, "failed to write checkpoint")
+	}
+
+	return checkpoint, nil
+}
+
+func (s *checkpointStore) Delete(ctx context.Context, name string) error {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return err
+	}
+
+	bkt := getCheckpointsBucket(s.tx, namespace)
+	if bkt == nil || bkt.Bucket([]byte(name)) == nil {
+		return errors.Wrapf(errdefs.ErrNotFound, "checkpoint %q", name)
+	}
+
+	return bkt.DeleteBucket([]byte(name))
+}
+
+func validateCheckpoint(checkpoint *checkpoints.Checkpoint) error {
+	if checkpoint.Name == "" {
+		return errors.Wrap(errdefs.ErrInvalidArgument, "checkpoint name is required")
+	}
+
+	if err := checkpoint.Target.Digest.Validate(); err != nil {
+		return errors.Wrapf(errdefs.ErrInvalidArgument, "checkpoint.Target.Digest: %v", err)
+	}
+
+	for k, v := range checkpoint.Labels {
+		if err := labels.Validate(k, v); err != nil {
+			return errors.Wrapf(err, "checkpoint.Labels")
+		}
+	}
+
+	return nil
+}
+
+func readCheckpoint(checkpoint *checkpoints.Checkpoint, bkt *bolt.Bucket) error {
+	labels, err := boltutil.ReadLabels(bkt)
+	if err != nil {
+		return err
+	}
+	checkpoint.Labels = labels
+
+	// checkpoints are not updated, the update time is the creation time
+	var updated time.Time
+	if err := boltutil.ReadTimestamps(bkt, &checkpoint.CreatedAt, &updated); err != nil {
+		return err
+	}
+
+	checkpoint.ContainerID = string(bkt.Get(bucketKeyContainer))
+	checkpoint.Parent = string(bkt.Get(bucketKeyParent))
+
+	return readTarget(&checkpoint.Target, bkt)
+}
+
+func writeCheckpoint(bkt *bolt.Bucket, checkpoint *checkpoints.Checkpoint) error {
+	if err := boltutil.WriteTimestamps(bkt, checkpoint.CreatedAt, checkpoint.CreatedAt); err != nil {
+		return err
+	}
+
+	for _, v := range [][2][]byte{
+		{bucketKeyContainer, []byte(checkpoint.ContainerID)},
+		{bucketKeyParent, []byte(checkpoint.Parent)},
+	} {
+		if err := bkt.Put(v[0], v[1]); err != nil {
+			return err
+		}
+	}
+
+	if err := boltutil.WriteLabels(bkt, checkpoint.Labels); err != nil {
+		return err
+	}
+
+	return writeTarget(bkt, checkpoint.Target)
+}
@@ -0,0 +1,84 @@
+package metadata
+
+import (
+	"testing"
+
+	"github.com/boltdb/bolt"
+	"github.com/containerd/containerd/checkpoints"
+	"github.com/containerd/containerd/errdefs"
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+func TestCheckpoints(t *testing.T) {
+	ctx, db, cancel := testEnv(t)
+	defer cancel()
+
+	target := ocispec.Descriptor{
+		MediaType: ocispec.MediaTypeImageIndex,
+		Digest:    digest.FromString("index"),
+		Size:      5,
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		store := NewCheckpointStore(tx)
+		if _, err := store.Create(ctx, checkpoints.Checkpoint{Name: "invalid"}); !errdefs.IsInvalidArgument(err) {
+			t.Fatalf("expected invalid argument for a checkpoint without target, got %v", err)
+		}
+		for _, c := range []checkpoints.Checkpoint{
+			{Name: "first", Target: target, ContainerID: "c1"},
+			{Name: "second", Target: target, ContainerID: "c2", Parent: target.Digest.String(), Labels: map[string]string{"keep": "true"}},
+		} {
+			if _, err := store.Create(ctx, c); err != nil {
+				return err
+			}
+		}
+		if _, err := store.Create(ctx, checkpoints.Checkpoint{Name: "first", Target: target}); !errdefs.IsAlreadyExists(err) {
+			t.Fatalf("expected already exists, got %v", err)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.View(func(tx *bolt.Tx) error {
+		store := NewCheckpointStore(tx)
+		checkpoint, err := store.Get(ctx, "second")
+		if err != nil {
+			return err
+		}
+		if checkpoint.Target.Digest != target.Digest || checkpoint.Target.Size != target.Size || checkpoint.ContainerID != "c2" || checkpoint.Parent != target.Digest.String() {
+			t.Fatalf("unexpected checkpoint %+v", checkpoint)
+		}
+		if checkpoint.CreatedAt.IsZero() || checkpoint.Labels["keep"] != "true" {
+			t.Fatalf("expected creation time and labels, got %+v", checkpoint)
+		}
+		list, err := store.List(ctx, "container_id==c1")
+		if err != nil {
+			return err
+		}
+		if len(list) != 1 || list[0].Name != "first" {
+			t.Fatalf("expected the first checkpoint, got %+v", list)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		store := NewCheckpointStore(tx)
+		if err := store.Delete(ctx, "first"); err != nil {
+			return err
+		}
+		if err := store.Delete(ctx, "first"); !errdefs.IsNotFound(err) {
+			t.Fatalf("expected not found deleting twice, got %v", err)
+		}
+		_, err := store.Get(ctx, "first")
+		if !errdefs.IsNotFound(err) {
+			t.Fatalf("expected not found, got %v", err)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/filters"
 	"github.com/containerd/containerd/identifiers"
+	"github.com/containerd/containerd/labels"
 	"github.com/containerd/containerd/metadata/boltutil"
 	"github.com/containerd/containerd/namespaces"
 	"github.com/gogo/protobuf/proto"
@@ -95,6 +96,10 @@ func (s *containerStore) Create(ctx context.Context, container containers.Contai
 		return containers.Container{}, errors.Wrap(err, "create container failed validation")
 	}
 
+	if container.SandboxID != "" && getSandboxBucket(s.tx, namespace, container.SandboxID) == nil {
+		return containers.Container{}, errors.Wrapf(errdefs.ErrNotFound, "sandbox %q", container.SandboxID)
+	}
+
 	bkt, err := createContainersBucket(s.tx, namespace)
 	if err != nil {
 		return containers.Container{}, err
@@ -110,6 +115,7 @@ func (s *containerStore) Create(ctx context.Context, container containers.Contai
 
 	container.CreatedAt = time.Now().UTC()
 	container.UpdatedAt = container.CreatedAt
+	container.Revision = 1
 	if err := writeContainer(cbkt, &container); err != nil {
 		return containers.Container{}, errors.Wrap(err, "failed to write container")
 	}
@@ -144,9 +150,18 @@ func (s *containerStore) Update(ctx context.Context, container containers.Contai
 	createdat := updated.CreatedAt
 	updated.ID = container.ID
 
+	// a non-zero revision or updated at acts as a precondition so that
+	// concurrent read-modify-write cycles do not silently overwrite each other
+	if container.Revision != 0 && container.Revision != updated.Revision {
+		return containers.Container{}, errors.Wrapf(errdefs.ErrConflict, "container %q is at revision %d, not %d", container.ID, updated.Revision, container.Revision)
+	}
+	if !container.UpdatedAt.IsZero() && !container.UpdatedAt.Equal(updated.UpdatedAt) {
+		return containers.Container{}, errors.Wrapf(errdefs.ErrConflict, "container %q has been updated since %v", container.ID, container.UpdatedAt)
+	}
+
 	if len(fieldpaths) == 0 {
 		// only allow updates to these field on full replace.
-		fieldpaths = []string{"labels", "spec"}
+		fieldpaths = []string{"labels", "spec", "extensions"}
 
 		// Fields that are immutable must cause an error when no field paths
 		// are provided. This allows these fields to become mutable in the
@@ -166,6 +181,10 @@ func (s *containerStore) Update(ctx context.Context, container containers.Contai
 		if updated.Runtime.Name != container.Runtime.Name {
 			return containers.Container{}, errors.Wrapf(errdefs.ErrInvalidArgument, "container.Runtime.Name field is immutable")
 		}
+
+		if updated.SandboxID != container.SandboxID {
+			return containers.Container{}, errors.Wrapf(errdefs.ErrInvalidArgument, "container.SandboxID field is immutable")
+		}
 	}
 
 	// apply the field mask. If you update this code, you better follow the
@@ -181,11 +200,29 @@ func (s *containerStore) Update(ctx context.Context, container containers.Contai
 			continue
 		}
 
+		if strings.HasPrefix(path, "extensions.") {
+			if updated.Extensions == nil {
+				updated.Extensions = map[string]types.Any{}
+			}
+			key := strings.TrimPrefix(path, "extensions.")
+			if ext, ok := container.Extensions[key]; ok {
+				updated.Extensions[key] = ext
+			} else {
+				delete(updated.Extensions, key)
+				if len(updated.Extensions) == 0 {
+					updated.Extensions = nil
+				}
+			}
+			continue
+		}
+
 		switch path {
 		case "labels":
 			updated.Labels = container.Labels
 		case "spec":
 			updated.Spec = container.Spec
+		case "extensions":
+			updated.Extensions = container.Extensions
 		default:
 			return containers.Container{}, errors.Wrapf(errdefs.ErrInvalidArgument, "cannot update %q field on %q", path, container.ID)
 		}
@@ -197,6 +234,7 @@ func (s *containerStore) Update(ctx context.Context, container containers.Contai
 
 	updated.CreatedAt = createdat
 	updated.UpdatedAt = time.Now().UTC()
+	updated.Revision++
 	if err := writeContainer(cbkt, &updated); err != nil {
 		return containers.Container{}, errors.Wrap(err, "failed to write container")
 	}
@@ -226,7 +264,13 @@ func validateContainer(container *containers.Container) error {
 		return errors.Wrapf(err, "container.ID validation error")
 	}
 
-	// labels and image have no validation
+	for k, v := range container.Labels {
+		if err := labels.Validate(k, v); err != nil {
+			return errors.Wrapf(err, "containers.Labels")
+		}
+	}
+
+	// image has no validation
 	if container.Runtime.Name == "" {
 		return errors.Wrapf(errdefs.ErrInvalidArgument, "container.Runtime.Name must be set")
 	}
@@ -252,6 +296,7 @@ func readContainer(container *containers.Container, bkt *bolt.Bucket) error {
 	if err := boltutil.ReadTimestamps(bkt, &container.CreatedAt, &container.UpdatedAt); err != nil {
 		return err
 	}
+	container.Revision = boltutil.ReadRevision(bkt)
 
 	return bkt.ForEach(func(k, v []byte) error {
 		switch string(k) {
@@ -288,6 +333,28 @@ func readContainer(container *containers.Container, bkt *bolt.Bucket) error {
 			container.RootFS = string(v)
 		case string(bucketKeySnapshotter):
 			container.Snapshotter = string(v)
+		case string(bucketKeySandbox):
+			container.SandboxID = string(v)
+		case string(bucketKeyExtensions):
+			ebkt := bkt.Bucket(bucketKeyExtensions)
+			if ebkt == nil {
+				return nil
+			}
+
+			extensions := make(map[string]types.Any)
+			if err := ebkt.ForEach(func(k, v []byte) error {
+				var any types.Any
+				if err := proto.Unmarshal(v, &any); err != nil {
+					return err
+				}
+
+				extensions[string(k)] = any
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			container.Extensions = extensions
 		}
 
 		return nil
@@ -299,6 +366,10 @@ func writeContainer(bkt *bolt.Bucket, container *containers.Container) error {
 		return err
 	}
 
+	if err := boltutil.WriteRevision(bkt, container.Revision); err != nil {
+		return err
+	}
+
 	if container.Spec != nil {
 		spec, err := container.Spec.Marshal()
 		if err != nil {
@@ -314,6 +385,7 @@ func writeContainer(bkt *bolt.Bucket, container *containers.Container) error {
 		{bucketKeyImage, []byte(container.Image)},
 		{bucketKeySnapshotter, []byte(container.Snapshotter)},
 		{bucketKeyRootFS, []byte(container.RootFS)},
+		{bucketKeySandbox, []byte(container.SandboxID)},
 	} {
 		if err := bkt.Put(v[0], v[1]); err != nil {
 			return err
@@ -351,5 +423,40 @@ func writeContainer(bkt *bolt.Bucket, container *containers.Container) error {
 		}
 	}
 
+	if err := writeExtensions(bkt, container.Extensions); err != nil {
+		return err
+	}
+
 	return boltutil.WriteLabels(bkt, container.Labels)
 }
+
+// writeExtensions replaces the extensions stored on the container bucket
+func writeExtensions(bkt *bolt.Bucket, extensions map[string]types.Any) error {
+	if ebkt := bkt.Bucket(bucketKeyExtensions); ebkt != nil {
+		if err := bkt.DeleteBucket(bucketKeyExtensions); err != nil {
+			return err
+		}
+	}
+
+	if len(extensions) == 0 {
+		return nil
+	}
+
+	ebkt, err := bkt.CreateBucket(bucketKeyExtensions)
+	if err != nil {
+		return err
+	}
+
+	for name, ext := range extensions {
+		data, err := proto.Marshal(&ext)
+		if err != nil {
+			return err
+		}
+
+		if err := ebkt.Put([]byte(name), data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/containerd/containerd/filters"
 	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/containerd/typeurl"
+	"github.com/gogo/protobuf/types"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 )
@@ -50,7 +52,8 @@ func TestContainersList(t *testing.T) {
 			Runtime: containers.RuntimeInfo{
 				Name: "testruntime",
 			},
-			Image: "test image",
+			Image:    "test image",
+			Revision: 1,
 		}
 
 		if err := db.Update(func(tx *bolt.Tx) error {
@@ -328,6 +331,73 @@ func TestContainersCreateUpdateDelete(t *testing.T) {
 				Image: "test image",
 			},
 		},
+		{
+			name: "UpdateExtension",
+			original: containers.Container{
+				Spec: encoded,
+				Runtime: containers.RuntimeInfo{
+					Name: "testruntime",
+				},
+				Extensions: map[string]types.Any{
+					"hello": {
+						TypeUrl: "test.update.extensions",
+						Value:   []byte("hello"),
+					},
+					"other": {
+						TypeUrl: "test.update.extensions",
+						Value:   []byte("other"),
+					},
+				},
+			},
+			input: containers.Container{
+				Extensions: map[string]types.Any{
+					"hello": {
+						TypeUrl: "test.update.extensions",
+						Value:   []byte("world"),
+					},
+				},
+			},
+			fieldpaths: []string{"extensions.hello"},
+			expected: containers.Container{
+				Spec: encoded,
+				Runtime: containers.RuntimeInfo{
+					Name: "testruntime",
+				},
+				Extensions: map[string]types.Any{
+					"hello": {
+						TypeUrl: "test.update.extensions",
+						Value:   []byte("world"),
+					},
+					"other": {
+						TypeUrl: "test.update.extensions",
+						Value:   []byte("other"),
+					},
+				},
+			},
+		},
+		{
+			name: "DeleteExtension",
+			original: containers.Container{
+				Spec: encoded,
+				Runtime: containers.RuntimeInfo{
+					Name: "testruntime",
+				},
+				Extensions: map[string]types.Any{
+					"hello": {
+						TypeUrl: "test.update.extensions",
+						Value:   []byte("hello"),
+					},
+				},
+			},
+			input:      containers.Container{},
+			fieldpaths: []string{"extensions.hello"},
+			expected: containers.Container{
+				Spec: encoded,
+				Runtime: containers.RuntimeInfo{
+					Name: "testruntime",
+				},
+			},
+		},
 		{
 			name: "DeleteAllLabels",
 			original: containers.Container{
@@ -420,6 +490,56 @@ func TestContainersCreateUpdateDelete(t *testing.T) {
 			},
 			createerr: errdefs.ErrInvalidArgument,
 		},
+		{
+			name: "UpdateStaleUpdatedAt",
+			original: containers.Container{
+				Spec: encoded,
+				Runtime: containers.RuntimeInfo{
+					Name: "testruntime",
+				},
+			},
+			input: containers.Container{
+				Labels: map[string]string{
+					"foo": "one",
+				},
+				UpdatedAt: time.Unix(1, 0),
+			},
+			fieldpaths: []string{"labels"},
+			cause:      errdefs.ErrConflict,
+		},
+		{
+			name: "UpdateStaleRevision",
+			original: containers.Container{
+				Spec: encoded,
+				Runtime: containers.RuntimeInfo{
+					Name: "testruntime",
+				},
+			},
+			input: containers.Container{
+				Labels: map[string]string{
+					"foo": "one",
+				},
+				Revision: 5,
+			},
+			fieldpaths: []string{"labels"},
+			cause:      errdefs.ErrConflict,
+		},
+		{
+			name: "UpdateLabelTooLarge",
+			original: containers.Container{
+				Spec: encoded,
+				Runtime: containers.RuntimeInfo{
+					Name: "testruntime",
+				},
+			},
+			input: containers.Container{
+				Labels: map[string]string{
+					"foo": strings.Repeat("a", 4096),
+				},
+			},
+			fieldpaths: []string{"labels"},
+			cause:      errdefs.ErrInvalidArgument,
+		},
 	} {
 		t.Run(testcase.name, func(t *testing.T) {
 			testcase.original.ID = testcase.name
@@ -454,6 +574,13 @@ func TestContainersCreateUpdateDelete(t *testing.T) {
 				testcase.original.UpdatedAt = result.UpdatedAt
 				testcase.expected.UpdatedAt = result.UpdatedAt
 
+				// each update increments the revision
+				if result.Revision != 1 {
+					t.Fatalf("unexpected revision on create: %d", result.Revision)
+				}
+				testcase.original.Revision = result.Revision
+				testcase.expected.Revision = result.Revision + 1
+
 				checkContainersEqual(t, &result, &testcase.original, "unexpected result on container update")
 				return nil
 			}); err != nil {
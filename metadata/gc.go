@@ -0,0 +1,11 @@
+package metadata
+
+import "sync"
+
+// GCLock serializes the removal of content that no record references with
+// the creation of records referencing content. Services removing content
+// after finding it unreferenced hold it for writing, from listing the
+// records through the removal, and services creating records hold it for
+// reading, from checking the content through the creation, so that content
+// is not removed once it is referenced again.
+var GCLock sync.RWMutex
@@ -14,6 +14,7 @@ import (
 	"github.com/containerd/containerd/metadata/boltutil"
 	"github.com/containerd/containerd/namespaces"
 	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
 )
 
@@ -113,6 +114,7 @@ func (s *imageStore) Create(ctx context.Context, image images.Image) (images.Ima
 
 		image.CreatedAt = time.Now().UTC()
 		image.UpdatedAt = image.CreatedAt
+		image.Revision = 1
 		return writeImage(ibkt, &image)
 	})
 }
@@ -138,8 +140,13 @@ func (s *imageStore) Update(ctx context.Context, image images.Image, fieldpaths
 			return errors.Wrapf(err, "image %q", image.Name)
 		}
 		createdat := updated.CreatedAt
+		revision := updated.Revision
 		updated.Name = image.Name
 
+		if image.Revision != 0 && image.Revision != revision {
+			return errors.Wrapf(errdefs.ErrConflict, "image %q is at revision %d, not %d", image.Name, revision, image.Revision)
+		}
+
 		if len(fieldpaths) > 0 {
 			for _, path := range fieldpaths {
 				if strings.HasPrefix(path, "labels.") {
@@ -172,6 +179,7 @@ func (s *imageStore) Update(ctx context.Context, image images.Image, fieldpaths
 
 		updated.CreatedAt = createdat
 		updated.UpdatedAt = time.Now().UTC()
+		updated.Revision = revision + 1
 		return writeImage(ibkt, &updated)
 	})
 }
@@ -195,6 +203,7 @@ func readImage(image *images.Image, bkt *bolt.Bucket) error {
 	if err := boltutil.ReadTimestamps(bkt, &image.CreatedAt, &image.UpdatedAt); err != nil {
 		return err
 	}
+	image.Revision = boltutil.ReadRevision(bkt)
 
 	labels, err := boltutil.ReadLabels(bkt)
 	if err != nil {
@@ -202,6 +211,27 @@ func readImage(image *images.Image, bkt *bolt.Bucket) error {
 	}
 	image.Labels = labels
 
+	return readTarget(&image.Target, bkt)
+}
+
+func writeImage(bkt *bolt.Bucket, image *images.Image) error {
+	if err := boltutil.WriteTimestamps(bkt, image.CreatedAt, image.UpdatedAt); err != nil {
+		return err
+	}
+
+	if err := boltutil.WriteRevision(bkt, image.Revision); err != nil {
+		return err
+	}
+
+	if err := boltutil.WriteLabels(bkt, image.Labels); err != nil {
+		return errors.Wrapf(err, "writing labels for image %v", image.Name)
+	}
+
+	return writeTarget(bkt, image.Target)
+}
+
+// readTarget reads the descriptor in the target bucket of bkt
+func readTarget(target *ocispec.Descriptor, bkt *bolt.Bucket) error {
 	tbkt := bkt.Bucket(bucketKeyTarget)
 	if tbkt == nil {
 		return errors.New("unable to read target bucket")
@@ -215,40 +245,32 @@ func readImage(image *images.Image, bkt *bolt.Bucket) error {
 		// keys, rather than full arrays.
 		switch string(k) {
 		case string(bucketKeyDigest):
-			image.Target.Digest = digest.Digest(v)
+			target.Digest = digest.Digest(v)
 		case string(bucketKeyMediaType):
-			image.Target.MediaType = string(v)
+			target.MediaType = string(v)
 		case string(bucketKeySize):
-			image.Target.Size, _ = binary.Varint(v)
+			target.Size, _ = binary.Varint(v)
 		}
 
 		return nil
 	})
 }
 
-func writeImage(bkt *bolt.Bucket, image *images.Image) error {
-	if err := boltutil.WriteTimestamps(bkt, image.CreatedAt, image.UpdatedAt); err != nil {
-		return err
-	}
-
-	if err := boltutil.WriteLabels(bkt, image.Labels); err != nil {
-		return errors.Wrapf(err, "writing labels for image %v", image.Name)
-	}
-
-	// write the target bucket
+// writeTarget writes the descriptor to the target bucket of bkt
+func writeTarget(bkt *bolt.Bucket, target ocispec.Descriptor) error {
 	tbkt, err := bkt.CreateBucketIfNotExists([]byte(bucketKeyTarget))
 	if err != nil {
 		return err
 	}
 
-	sizeEncoded, err := encodeSize(image.Target.Size)
+	sizeEncoded, err := encodeSize(target.Size)
 	if err != nil {
 		return err
 	}
 
 	for _, v := range [][2][]byte{
-		{bucketKeyDigest, []byte(image.Target.Digest)},
-		{bucketKeyMediaType, []byte(image.Target.MediaType)},
+		{bucketKeyDigest, []byte(target.Digest)},
+		{bucketKeyMediaType, []byte(target.MediaType)},
 		{bucketKeySize, sizeEncoded},
 	} {
 		if err := tbkt.Put(v[0], v[1]); err != nil {
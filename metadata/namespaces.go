@@ -144,6 +144,24 @@ func (s *namespaceStore) namespaceEmpty(ctx context.Context, namespace string) (
 		return false, nil
 	}
 
+	sandboxes, err := NewSandboxStore(s.tx).List(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if len(sandboxes) > 0 {
+		return false, nil
+	}
+
+	checkpoints, err := NewCheckpointStore(s.tx).List(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if len(checkpoints) > 0 {
+		return false, nil
+	}
+
 	// TODO(stevvooe): Need to add check for content store, as well. Still need
 	// to make content store namespace aware.
 
@@ -0,0 +1,292 @@
+package metadata
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/filters"
+	"github.com/containerd/containerd/identifiers"
+	"github.com/containerd/containerd/labels"
+	"github.com/containerd/containerd/metadata/boltutil"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/sandboxes"
+	"github.com/pkg/errors"
+)
+
+type sandboxStore struct {
+	tx *bolt.Tx
+}
+
+// NewSandboxStore returns a store backed by a bolt DB
+func NewSandboxStore(tx *bolt.Tx) sandboxes.Store {
+	return &sandboxStore{
+		tx: tx,
+	}
+}
+
+func (s *sandboxStore) Get(ctx context.Context, id string) (sandboxes.Sandbox, error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return sandboxes.Sandbox{}, err
+	}
+
+	bkt := getSandboxBucket(s.tx, namespace, id)
+	if bkt == nil {
+		return sandboxes.Sandbox{}, errors.Wrapf(errdefs.ErrNotFound, "sandbox %q", id)
+	}
+
+	sandbox := sandboxes.Sandbox{ID: id}
+	if err := readSandbox(&sandbox, bkt); err != nil {
+		return sandboxes.Sandbox{}, errors.Wrapf(err, "failed to read sandbox %v", id)
+	}
+
+	return sandbox, nil
+}
+
+func (s *sandboxStore) List(ctx context.Context, fs ...string) ([]sandboxes.Sandbox, error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := filters.ParseAll(fs...)
+	if err != nil {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, err.Error())
+	}
+
+	bkt := getSandboxesBucket(s.tx, namespace)
+	if bkt == nil {
+		return nil, nil
+	}
+
+	var m []sandboxes.Sandbox
+	if err := bkt.ForEach(func(k, v []byte) error {
+		sbkt := bkt.Bucket(k)
+		if sbkt == nil {
+			return nil
+		}
+		sandbox := sandboxes.Sandbox{ID: string(k)}
+		if err := readSandbox(&sandbox, sbkt); err != nil {
+			return errors.Wrap(err, "failed to read sandbox")
+		}
+		if filter.Match(adaptSandbox(sandbox)) {
+			m = append(m, sandbox)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (s *sandboxStore) Create(ctx context.Context, sandbox sandboxes.Sandbox) (sandboxes.Sandbox, error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return sandboxes.Sandbox{}, err
+	}
+
+	if err := validateSandbox(&sandbox); err != nil {
+		return sandboxes.Sandbox{}, errors.Wrap(err, "create sandbox failed validation")
+	}
+
+	bkt, err := createSandboxesBucket(s.tx, namespace)
+	if err != nil {
+		return sandboxes.Sandbox{}, err
+	}
+
+	sbkt, err := bkt.CreateBucket([]byte(sandbox.ID))
+	if err != nil {
+		if err == bolt.ErrBucketExists {
+			err = errors.Wrapf(errdefs.ErrAlreadyExists, "sandbox %q", sandbox.ID)
+		}
+		return sandboxes.Sandbox{}, err
+	}
+
+	sandbox.CreatedAt = time.Now().UTC()
+	sandbox.UpdatedAt = sandbox.CreatedAt
+	if err := writeSandbox(sbkt, &sandbox); err != nil {
+		return sandboxes.Sandbox{}, errors.Wrap(err, "failed to write sandbox")
+	}
+
+	return sandbox, nil
+}
+
+func (s *sandboxStore) Update(ctx context.Context, sandbox sandboxes.Sandbox, fieldpaths ...string) (sandboxes.Sandbox, error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return sandboxes.Sandbox{}, err
+	}
+
+	if sandbox.ID == "" {
+		return sandboxes.Sandbox{}, errors.Wrapf(errdefs.ErrInvalidArgument, "must specify a sandbox id")
+	}
+
+	sbkt := getSandboxBucket(s.tx, namespace, sandbox.ID)
+	if sbkt == nil {
+		return sandboxes.Sandbox{}, errors.Wrapf(errdefs.ErrNotFound, "sandbox %q", sandbox.ID)
+	}
+
+	var updated sandboxes.Sandbox
+	if err := readSandbox(&updated, sbkt); err != nil {
+		return updated, errors.Wrapf(err, "failed to read sandbox from bucket")
+	}
+	updated.ID = sandbox.ID
+
+	if len(fieldpaths) == 0 {
+		fieldpaths = []string{"labels", "namespaces"}
+	}
+
+	for _, path := range fieldpaths {
+		if strings.HasPrefix(path, "labels.") {
+			if updated.Labels == nil {
+				updated.Labels = map[string]string{}
+			}
+			key := strings.TrimPrefix(path, "labels.")
+			updated.Labels[key] = sandbox.Labels[key]
+			continue
+		}
+
+		if strings.HasPrefix(path, "namespaces.") {
+			if updated.Namespaces == nil {
+				updated.Namespaces = map[string]string{}
+			}
+			key := strings.TrimPrefix(path, "namespaces.")
+			if p, ok := sandbox.Namespaces[key]; ok && p != "" {
+				updated.Namespaces[key] = p
+			} else {
+				delete(updated.Namespaces, key)
+			}
+			continue
+		}
+
+		switch path {
+		case "labels":
+			updated.Labels = sandbox.Labels
+		case "namespaces":
+			updated.Namespaces = sandbox.Namespaces
+		default:
+			return sandboxes.Sandbox{}, errors.Wrapf(errdefs.ErrInvalidArgument, "cannot update %q field on %q", path, sandbox.ID)
+		}
+	}
+
+	if err := validateSandbox(&updated); err != nil {
+		return sandboxes.Sandbox{}, errors.Wrap(err, "update failed validation")
+	}
+
+	updated.UpdatedAt = time.Now().UTC()
+	if err := writeSandbox(sbkt, &updated); err != nil {
+		return sandboxes.Sandbox{}, errors.Wrap(err, "failed to write sandbox")
+	}
+
+	return updated, nil
+}
+
+func (s *sandboxStore) Delete(ctx context.Context, id string) error {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return err
+	}
+
+	bkt := getSandboxesBucket(s.tx, namespace)
+	if bkt == nil || bkt.Bucket([]byte(id)) == nil {
+		return errors.Wrapf(errdefs.ErrNotFound, "sandbox %q", id)
+	}
+
+	if cbkt := getContainersBucket(s.tx, namespace); cbkt != nil {
+		if err := cbkt.ForEach(func(k, v []byte) error {
+			if c := cbkt.Bucket(k); c != nil && string(c.Get(bucketKeySandbox)) == id {
+				return errors.Wrapf(errdefs.ErrFailedPrecondition, "sandbox %q has container %q", id, k)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return bkt.DeleteBucket([]byte(id))
+}
+
+func validateSandbox(sandbox *sandboxes.Sandbox) error {
+	if err := identifiers.Validate(sandbox.ID); err != nil {
+		return errors.Wrapf(err, "sandbox.ID validation error")
+	}
+
+	for k, v := range sandbox.Labels {
+		if err := labels.Validate(k, v); err != nil {
+			return errors.Wrapf(err, "sandbox.Labels")
+		}
+	}
+
+	return nil
+}
+
+func readSandbox(sandbox *sandboxes.Sandbox, bkt *bolt.Bucket) error {
+	labels, err := boltutil.ReadLabels(bkt)
+	if err != nil {
+		return err
+	}
+	sandbox.Labels = labels
+
+	if err := boltutil.ReadTimestamps(bkt, &sandbox.CreatedAt, &sandbox.UpdatedAt); err != nil {
+		return err
+	}
+
+	sandbox.CgroupParent = string(bkt.Get(bucketKeyCgroup))
+	sandbox.Network = string(bkt.Get(bucketKeyNetwork))
+	sandbox.SharePID = len(bkt.Get(bucketKeySharePID)) > 0
+
+	if nbkt := bkt.Bucket(bucketKeyNamespaces); nbkt != nil {
+		sandbox.Namespaces = make(map[string]string)
+		if err := nbkt.ForEach(func(k, v []byte) error {
+			sandbox.Namespaces[string(k)] = string(v)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeSandbox(bkt *bolt.Bucket, sandbox *sandboxes.Sandbox) error {
+	if err := boltutil.WriteTimestamps(bkt, sandbox.CreatedAt, sandbox.UpdatedAt); err != nil {
+		return err
+	}
+
+	var sharePID []byte
+	if sandbox.SharePID {
+		sharePID = []byte{1}
+	}
+	for _, v := range [][2][]byte{
+		{bucketKeyCgroup, []byte(sandbox.CgroupParent)},
+		{bucketKeyNetwork, []byte(sandbox.Network)},
+		{bucketKeySharePID, sharePID},
+	} {
+		if err := bkt.Put(v[0], v[1]); err != nil {
+			return err
+		}
+	}
+
+	if nbkt := bkt.Bucket(bucketKeyNamespaces); nbkt != nil {
+		if err := bkt.DeleteBucket(bucketKeyNamespaces); err != nil {
+			return err
+		}
+	}
+	if len(sandbox.Namespaces) > 0 {
+		nbkt, err := bkt.CreateBucket(bucketKeyNamespaces)
+		if err != nil {
+			return err
+		}
+		for k, v := range sandbox.Namespaces {
+			if err := nbkt.Put([]byte(k), []byte(v)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return boltutil.WriteLabels(bkt, sandbox.Labels)
+}
@@ -0,0 +1,83 @@
+package metadata
+
+import (
+	"testing"
+
+	"github.com/boltdb/bolt"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/sandboxes"
+	"github.com/containerd/containerd/typeurl"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestSandboxes(t *testing.T) {
+	ctx, db, cancel := testEnv(t)
+	defer cancel()
+
+	spec, err := typeurl.MarshalAny(&specs.Spec{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	container := containers.Container{
+		ID:        "member",
+		Spec:      spec,
+		Runtime:   containers.RuntimeInfo{Name: "testruntime"},
+		SandboxID: "pod",
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		if _, err := NewContainerStore(tx).Create(ctx, container); !errdefs.IsNotFound(err) {
+			t.Fatalf("expected not found for a container in a missing sandbox, got %v", err)
+		}
+		store := NewSandboxStore(tx)
+		if _, err := store.Create(ctx, sandboxes.Sandbox{
+			ID:           "pod",
+			CgroupParent: "/pods/pod",
+			SharePID:     true,
+			Namespaces:   map[string]string{"network": "/run/netns/pod"},
+		}); err != nil {
+			return err
+		}
+		_, err := NewContainerStore(tx).Create(ctx, container)
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		store := NewSandboxStore(tx)
+		if _, err := store.Update(ctx, sandboxes.Sandbox{
+			ID:         "pod",
+			Namespaces: map[string]string{"pid": "/proc/1/ns/pid"},
+		}, "namespaces.pid"); err != nil {
+			return err
+		}
+		sandbox, err := store.Get(ctx, "pod")
+		if err != nil {
+			return err
+		}
+		if sandbox.CgroupParent != "/pods/pod" || !sandbox.SharePID {
+			t.Fatalf("unexpected sandbox %+v", sandbox)
+		}
+		if len(sandbox.Namespaces) != 2 || sandbox.Namespaces["pid"] != "/proc/1/ns/pid" {
+			t.Fatalf("unexpected namespaces %v", sandbox.Namespaces)
+		}
+		members, err := NewContainerStore(tx).List(ctx, "sandbox_id==pod")
+		if err != nil {
+			return err
+		}
+		if len(members) != 1 || members[0].SandboxID != "pod" {
+			t.Fatalf("unexpected members %v", members)
+		}
+		if err := store.Delete(ctx, "pod"); !errdefs.IsFailedPrecondition(err) {
+			t.Fatalf("expected failed precondition deleting a sandbox with containers, got %v", err)
+		}
+		if err := NewContainerStore(tx).Delete(ctx, "member"); err != nil {
+			return err
+		}
+		return store.Delete(ctx, "pod")
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
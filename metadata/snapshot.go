@@ -19,16 +19,37 @@ type snapshotter struct {
 	snapshot.Snapshotter
 	name string
 	db   *bolt.DB
+
+	cacheSize int
+	cacheTTL  time.Duration
+}
+
+// SnapshotterOpt allows callers to set options on the metadata snapshotter
+type SnapshotterOpt func(*snapshotter)
+
+// WithPrepareCache keeps up to size active snapshots per namespace prepared
+// ahead of time on the parents passed to Prepare. A later Prepare on one of
+// those parents claims a cached snapshot instead of preparing a new one.
+// Cached snapshots that are not claimed within ttl are removed.
+func WithPrepareCache(size int, ttl time.Duration) SnapshotterOpt {
+	return func(s *snapshotter) {
+		s.cacheSize = size
+		s.cacheTTL = ttl
+	}
 }
 
 // NewSnapshotter returns a new Snapshotter which namespaces the given snapshot
 // using the provided name and metadata store.
-func NewSnapshotter(db *bolt.DB, name string, sn snapshot.Snapshotter) snapshot.Snapshotter {
-	return &snapshotter{
+func NewSnapshotter(db *bolt.DB, name string, sn snapshot.Snapshotter, opts ...SnapshotterOpt) snapshot.Snapshotter {
+	s := &snapshotter{
 		Snapshotter: sn,
 		name:        name,
 		db:          db,
 	}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
 }
 
 func createKey(id uint64, namespace, key string) string {
@@ -257,6 +278,18 @@ func (s *snapshotter) createSnapshot(ctx context.Context, key, parent string, re
 		}
 	}
 
+	limit, err := snapshot.SizeLimit(base)
+	if err != nil {
+		return nil, err
+	}
+	// only the size limit is passed on to the backend, prepared snapshots
+	// are cached without one
+	var bopts []snapshot.Opt
+	if limit > 0 {
+		bopts = append(bopts, snapshot.WithSizeLimit(limit))
+	}
+	cached := !readonly && parent != "" && s.cacheSize > 0 && limit == 0
+
 	var m []mount.Mount
 	if err := update(ctx, s.db, func(tx *bolt.Tx) error {
 		bkt, err := createSnapshotterBucket(tx, ns, s.name)
@@ -284,11 +317,23 @@ func (s *snapshotter) createSnapshot(ctx context.Context, key, parent string, re
 			}
 		}
 
-		sid, err := bkt.NextSequence()
-		if err != nil {
-			return err
+		var (
+			bkey    string
+			claimed bool
+		)
+		if cached {
+			if bkey, err = s.claimPrepared(ctx, tx, ns, parent); err != nil {
+				return err
+			}
+			claimed = bkey != ""
+		}
+		if !claimed {
+			sid, err := bkt.NextSequence()
+			if err != nil {
+				return err
+			}
+			bkey = createKey(sid, ns, key)
 		}
-		bkey := createKey(sid, ns, key)
 		if err := bbkt.Put(bucketKeyName, []byte(bkey)); err != nil {
 			return err
 		}
@@ -303,15 +348,21 @@ func (s *snapshotter) createSnapshot(ctx context.Context, key, parent string, re
 
 		// TODO: Consider doing this outside of transaction to lessen
 		// metadata lock time
-		if readonly {
+		switch {
+		case claimed:
+			m, err = s.Snapshotter.Mounts(ctx, bkey)
+		case readonly:
 			m, err = s.Snapshotter.View(ctx, bkey, bparent)
-		} else {
-			m, err = s.Snapshotter.Prepare(ctx, bkey, bparent)
+		default:
+			m, err = s.Snapshotter.Prepare(ctx, bkey, bparent, bopts...)
 		}
 		return err
 	}); err != nil {
 		return nil, err
 	}
+	if cached {
+		go s.fillPrepared(ns, parent)
+	}
 	return m, nil
 }
 
@@ -404,6 +455,14 @@ func (s *snapshotter) Remove(ctx context.Context, key string) error {
 			return err
 		}
 
+		// cached snapshots prepared on the removed snapshot would prevent
+		// its removal from the backing snapshotter
+		if err := s.evictPrepared(ctx, tx, ns, func(parent string, _ time.Time) bool {
+			return parent == key
+		}); err != nil {
+			return err
+		}
+
 		return s.Snapshotter.Remove(ctx, bkey)
 	})
 }
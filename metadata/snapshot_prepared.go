@@ -0,0 +1,184 @@
+package metadata
+
+import (
+	"context"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/metadata/boltutil"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/snapshot"
+)
+
+// preparedKey is the key name used for the backing snapshots that are
+// prepared ahead of time
+const preparedKey = "prepared"
+
+// claimPrepared removes a cached snapshot prepared on parent from the cache
+// and returns its backing key. An empty key is returned if the cache holds no
+// snapshot for parent.
+func (s *snapshotter) claimPrepared(ctx context.Context, tx *bolt.Tx, ns, parent string) (string, error) {
+	if err := s.evictPrepared(ctx, tx, ns, s.expired); err != nil {
+		return "", err
+	}
+	bkt := getPreparedBucket(tx, ns, s.name)
+	if bkt == nil {
+		return "", nil
+	}
+	c := bkt.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if v != nil {
+			continue
+		}
+		if string(bkt.Bucket(k).Get(bucketKeyParent)) == parent {
+			return string(k), bkt.DeleteBucket(k)
+		}
+	}
+	return "", nil
+}
+
+// fillPrepared prepares a snapshot on parent and adds it to the cache unless
+// the cache is full
+func (s *snapshotter) fillPrepared(ns, parent string) {
+	ctx := namespaces.WithNamespace(context.Background(), ns)
+	if err := update(ctx, s.db, func(tx *bolt.Tx) error {
+		bkt := getSnapshotterBucket(tx, ns, s.name)
+		if bkt == nil {
+			return nil
+		}
+		pbkt := bkt.Bucket([]byte(parent))
+		if pbkt == nil {
+			// the parent was removed before the cache could be filled
+			return nil
+		}
+		if err := s.evictPrepared(ctx, tx, ns, s.expired); err != nil {
+			return err
+		}
+		cbkt, err := createPreparedBucket(tx, ns, s.name)
+		if err != nil {
+			return err
+		}
+		var count int
+		if err := cbkt.ForEach(func(k, v []byte) error {
+			if v == nil {
+				count++
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if count >= s.cacheSize {
+			return nil
+		}
+
+		sid, err := bkt.NextSequence()
+		if err != nil {
+			return err
+		}
+		bkey := createKey(sid, ns, preparedKey)
+		ebkt, err := cbkt.CreateBucket([]byte(bkey))
+		if err != nil {
+			return err
+		}
+		if err := ebkt.Put(bucketKeyParent, []byte(parent)); err != nil {
+			return err
+		}
+		ts := time.Now().UTC()
+		if err := boltutil.WriteTimestamps(ebkt, ts, ts); err != nil {
+			return err
+		}
+		_, err = s.Snapshotter.Prepare(ctx, bkey, string(pbkt.Get(bucketKeyName)))
+		return err
+	}); err != nil {
+		log.G(ctx).WithError(err).WithField("parent", parent).Warn("failed to cache prepared snapshot")
+	}
+}
+
+// evictPrepared removes the cached snapshots selected by fn
+func (s *snapshotter) evictPrepared(ctx context.Context, tx *bolt.Tx, ns string, fn func(parent string, created time.Time) bool) error {
+	bkt := getPreparedBucket(tx, ns, s.name)
+	if bkt == nil {
+		return nil
+	}
+	var evict []string
+	if err := bkt.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return nil
+		}
+		var (
+			ebkt             = bkt.Bucket(k)
+			created, updated time.Time
+		)
+		if err := boltutil.ReadTimestamps(ebkt, &created, &updated); err != nil {
+			return err
+		}
+		if fn(string(ebkt.Get(bucketKeyParent)), created) {
+			evict = append(evict, string(k))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, bkey := range evict {
+		if err := bkt.DeleteBucket([]byte(bkey)); err != nil {
+			return err
+		}
+		if err := s.Snapshotter.Remove(ctx, bkey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *snapshotter) expired(_ string, created time.Time) bool {
+	return s.cacheTTL > 0 && time.Since(created) > s.cacheTTL
+}
+
+// ReapPrepared removes the prepared snapshots cached by the snapshotter that
+// were not claimed within their ttl, checking every ttl until the context is
+// done, so that they do not wait on disk for the next Prepare. It returns at
+// once for snapshotters without a prepare cache.
+func ReapPrepared(ctx context.Context, sn snapshot.Snapshotter) {
+	s, ok := sn.(*snapshotter)
+	if !ok || s.cacheSize <= 0 || s.cacheTTL <= 0 {
+		return
+	}
+	t := time.NewTicker(s.cacheTTL)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			if err := s.reapExpired(ctx); err != nil {
+				log.G(ctx).WithError(err).WithField("snapshotter", s.name).Warn("failed to remove expired prepared snapshots")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// reapExpired removes the expired prepared snapshots of every namespace
+func (s *snapshotter) reapExpired(ctx context.Context) error {
+	return update(ctx, s.db, func(tx *bolt.Tx) error {
+		v1 := tx.Bucket(bucketKeyVersion)
+		if v1 == nil {
+			return nil
+		}
+		var nss []string
+		if err := v1.ForEach(func(k, v []byte) error {
+			if v == nil {
+				nss = append(nss, string(k))
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, ns := range nss {
+			if err := s.evictPrepared(namespaces.WithNamespace(ctx, ns), tx, ns, s.expired); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
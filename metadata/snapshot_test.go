@@ -2,11 +2,15 @@ package metadata
 
 import (
 	"context"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/boltdb/bolt"
+	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/containerd/snapshot"
 	"github.com/containerd/containerd/snapshot/naive"
 	"github.com/containerd/containerd/snapshot/testsuite"
@@ -40,3 +44,137 @@ func TestMetadata(t *testing.T) {
 	testutil.RequiresRoot(t)
 	testsuite.SnapshotterSuite(t, "Metadata", newSnapshotter)
 }
+
+func TestPrepareCache(t *testing.T) {
+	root, err := ioutil.TempDir("", "metadata-prepare-cache-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	backing, err := naive.NewSnapshotter(filepath.Join(root, "naive"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := bolt.Open(filepath.Join(root, "metadata.db"), 0660, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	var (
+		ctx = namespaces.WithNamespace(context.Background(), "testing")
+		sn  = NewSnapshotter(db, "naive", backing, WithPrepareCache(1, time.Minute)).(*snapshotter)
+	)
+	if _, err := sn.Prepare(ctx, "base-active", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := sn.Commit(ctx, "base", "base-active"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sn.Prepare(ctx, "first", "base"); err != nil {
+		t.Fatal(err)
+	}
+	// wait for the cache to be filled in the background
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		var cached int
+		if err := db.View(func(tx *bolt.Tx) error {
+			if bkt := getPreparedBucket(tx, "testing", "naive"); bkt != nil {
+				cached = bkt.Stats().BucketN - 1
+			}
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if cached == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("timeout waiting for prepared snapshot to be cached")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if _, err := sn.Prepare(ctx, "second", "base"); err != nil {
+		t.Fatal(err)
+	}
+	bkey, err := sn.resolveKey(ctx, "second")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(bkey, "/"+preparedKey) {
+		t.Fatalf("expected second snapshot to be claimed from the cache, got backing key %q", bkey)
+	}
+	for _, key := range []string{"first", "second", "base"} {
+		if err := sn.Remove(ctx, key); err != nil {
+			t.Fatalf("failed to remove %q: %v", key, err)
+		}
+	}
+}
+
+func TestPrepareCacheReap(t *testing.T) {
+	root, err := ioutil.TempDir("", "metadata-prepare-reap-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	backing, err := naive.NewSnapshotter(filepath.Join(root, "naive"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := bolt.Open(filepath.Join(root, "metadata.db"), 0660, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	var (
+		ctx = namespaces.WithNamespace(context.Background(), "testing")
+		sn  = NewSnapshotter(db, "naive", backing, WithPrepareCache(1, 50*time.Millisecond)).(*snapshotter)
+	)
+	if _, err := sn.Prepare(ctx, "base-active", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := sn.Commit(ctx, "base", "base-active"); err != nil {
+		t.Fatal(err)
+	}
+	// fill the cache directly rather than waiting for the background fill
+	sn.fillPrepared("testing", "base")
+	cached := func() (n int) {
+		if err := db.View(func(tx *bolt.Tx) error {
+			if bkt := getPreparedBucket(tx, "testing", "naive"); bkt != nil {
+				n = bkt.Stats().BucketN - 1
+			}
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+	if n := cached(); n != 1 {
+		t.Fatalf("expected a prepared snapshot to be cached, got %d", n)
+	}
+	reapCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go ReapPrepared(reapCtx, sn)
+	deadline := time.Now().Add(10 * time.Second)
+	for cached() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("timeout waiting for the expired prepared snapshot to be removed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	var backingKeys int
+	if err := backing.Walk(ctx, func(ctx context.Context, info snapshot.Info) error {
+		if info.Kind == snapshot.KindActive {
+			backingKeys++
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if backingKeys != 0 {
+		t.Fatalf("expected the backing snapshot to be removed, got %d active snapshots", backingKeys)
+	}
+}
@@ -0,0 +1,46 @@
+// +build linux
+
+package cgroups
+
+import (
+	"github.com/containerd/cgroups"
+	"github.com/containerd/containerd/sys"
+)
+
+// Cgroup is the cgroup of a task, in the cgroup v1 hierarchies or in the
+// unified cgroup2 hierarchy depending on the host
+type Cgroup interface {
+	// Stat returns the stats of the cgroup, the stats of the cgroup2
+	// controllers being mapped to their cgroup v1 counterparts
+	Stat() (*cgroups.Stats, error)
+	// Deleted returns whether the cgroup was deleted
+	Deleted() bool
+}
+
+// LoadCgroup returns the cgroup of the pid in the hierarchy of the host
+func LoadCgroup(pid int, unified bool) (Cgroup, error) {
+	if unified {
+		path, err := sys.CgroupUnifiedPath(pid)
+		if err != nil {
+			return nil, err
+		}
+		return &unifiedCgroup{path: path}, nil
+	}
+	cg, err := cgroups.Load(cgroups.V1, cgroups.PidPath(pid))
+	if err != nil {
+		return nil, err
+	}
+	return &v1Cgroup{cg}, nil
+}
+
+type v1Cgroup struct {
+	cgroups.Cgroup
+}
+
+func (c *v1Cgroup) Stat() (*cgroups.Stats, error) {
+	return c.Cgroup.Stat(cgroups.IgnoreNotExist)
+}
+
+func (c *v1Cgroup) Deleted() bool {
+	return c.Cgroup.State() == cgroups.Deleted
+}
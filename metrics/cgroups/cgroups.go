@@ -3,75 +3,241 @@
 package cgroups
 
 import (
+	"strconv"
+	"time"
+
+	"github.com/boltdb/bolt"
 	"github.com/containerd/cgroups"
 	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/events"
 	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/containerd/plugin"
 	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/containerd/sys"
 	metrics "github.com/docker/go-metrics"
+	"github.com/pkg/errors"
 	"golang.org/x/net/context"
+	"golang.org/x/sys/unix"
 )
 
 func init() {
 	plugin.Register(&plugin.Registration{
 		Type: plugin.TaskMonitorPlugin,
 		ID:   "cgroups",
+		Requires: []plugin.PluginType{
+			plugin.MetadataPlugin,
+		},
+		Config: &Config{
+			SampleInterval: "10s",
+			OOMAction:      OOMReport,
+		},
 		Init: New,
 	})
 }
 
+// Config for the cgroups task monitor
+type Config struct {
+	// NoHostMetrics disables the export of the node-level cpu and memory
+	// totals alongside the container metrics
+	NoHostMetrics bool `toml:"no_host_metrics"`
+	// SampleInterval is the interval at which the cgroups of the tasks are
+	// sampled for the aggregated usage of the stats service
+	SampleInterval string `toml:"sample_interval"`
+	// OOMAction is what is done when a task runs out of memory, OOMReport or
+	// OOMKill
+	OOMAction string `toml:"oom_action"`
+	// RecordOOM records the number of OOMs of a task and the time of the
+	// last one in the labels of its container
+	RecordOOM bool `toml:"record_oom"`
+}
+
+const (
+	// OOMReport publishes a /tasks/oom event
+	OOMReport = "report"
+	// OOMKill publishes a /tasks/oom event and kills the task, so that a
+	// workload that lost one of its processes to the OOM killer does not
+	// keep running in a degraded state
+	OOMKill = "kill"
+)
+
+const (
+	// OOMCountLabel is the label of the containers holding the number of
+	// OOMs of their task when the OOMs are recorded
+	OOMCountLabel = "containerd.io/oom.count"
+	// OOMLastLabel is the label of the containers holding the time of the
+	// last OOM of their task, in RFC 3339 format
+	OOMLastLabel = "containerd.io/oom.last"
+)
+
 func New(ic *plugin.InitContext) (interface{}, error) {
+	config := ic.Config.(*Config)
+	interval, err := time.ParseDuration(config.SampleInterval)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid sample interval %q", config.SampleInterval)
+	}
+	if interval <= 0 {
+		return nil, errors.Errorf("sample interval must be positive, got %s", config.SampleInterval)
+	}
+	switch config.OOMAction {
+	case "", OOMReport, OOMKill:
+	default:
+		return nil, errors.Errorf("unknown oom action %q", config.OOMAction)
+	}
+	var db *bolt.DB
+	if config.RecordOOM {
+		m, err := ic.Get(plugin.MetadataPlugin)
+		if err != nil {
+			return nil, err
+		}
+		db = m.(*bolt.DB)
+	}
 	var (
 		ns        = metrics.NewNamespace("container", "", nil)
 		collector = NewCollector(ns)
+		network   = NewNetworkCollector(ns)
 	)
 	oom, err := NewOOMCollector(ns)
 	if err != nil {
 		return nil, err
 	}
+	var unified *UnifiedOOMWatcher
+	if sys.IsCgroupUnified() {
+		if unified, err = NewUnifiedOOMWatcher(); err != nil {
+			return nil, err
+		}
+	}
 	metrics.Register(ns)
+	go collector.Sample(ic.Context, interval)
+	if !config.NoHostMetrics {
+		host := metrics.NewNamespace("host", "", nil)
+		NewHostCollector(host)
+		metrics.Register(host)
+	}
 	return &cgroupsMonitor{
 		collector: collector,
+		network:   network,
 		oom:       oom,
+		unified:   unified,
 		context:   ic.Context,
 		publisher: ic.Events,
+		config:    config,
+		db:        db,
+
+		undelegated: !sys.IsCgroupDelegated(),
 	}, nil
 }
 
 type cgroupsMonitor struct {
 	collector *Collector
+	network   *NetworkCollector
 	oom       *OOMCollector
+	// unified watches the OOMs of the tasks on hosts running the cgroup2
+	// hierarchy only, nil on hosts running the cgroup v1 hierarchies
+	unified   *UnifiedOOMWatcher
 	context   context.Context
 	publisher events.Publisher
+	config    *Config
+	db        *bolt.DB
+	// undelegated is set when containerd runs rootless without a delegated
+	// cgroup, the tasks then running in the cgroup of containerd
+	undelegated bool
 }
 
 func (m *cgroupsMonitor) Monitor(c runtime.Task) error {
+	if m.undelegated {
+		return nil
+	}
 	info := c.Info()
 	state, err := c.State(m.context)
 	if err != nil {
 		return err
 	}
-	cg, err := cgroups.Load(cgroups.V1, cgroups.PidPath(int(state.Pid)))
+	cg, err := LoadCgroup(int(state.Pid), m.unified != nil)
 	if err != nil {
 		return err
 	}
 	if err := m.collector.Add(info.ID, info.Namespace, cg); err != nil {
 		return err
 	}
-	return m.oom.Add(info.ID, info.Namespace, cg, m.trigger)
+	if err := m.network.Add(info.ID, info.Namespace, state.Pid); err != nil {
+		log.G(m.context).WithError(err).WithField("id", info.ID).Warn("failed to collect network stats")
+	}
+	if u, ok := cg.(*unifiedCgroup); ok {
+		return m.unified.Add(info.ID, info.Namespace, u.path, func(id, path string, events MemoryEvents) {
+			m.trigger(c, &eventsapi.TaskOOM{
+				ContainerID:    id,
+				OccurredAt:     time.Now().UTC(),
+				MemoryUsage:    readMemoryValue(path, "memory.current"),
+				MaxMemoryUsage: readMemoryValue(path, "memory.peak"),
+				MemoryLimit:    readMemoryValue(path, "memory.max"),
+				MemoryFailcnt:  events.Max,
+				OOMCount:       events.OOMKill,
+			})
+		})
+	}
+	// triggers are called one at a time by the collector
+	var count uint64
+	return m.oom.Add(info.ID, info.Namespace, cg.(*v1Cgroup).Cgroup, func(id string, cg cgroups.Cgroup) {
+		count++
+		event := &eventsapi.TaskOOM{
+			ContainerID: id,
+			OccurredAt:  time.Now().UTC(),
+			OOMCount:    count,
+		}
+		stats, err := cg.Stat(cgroups.IgnoreNotExist)
+		if err != nil {
+			log.G(m.context).WithError(err).WithField("id", id).Warn("failed to read memory stats of OOM")
+		} else if stats.Memory != nil {
+			event.MemoryUsage = stats.Memory.Usage.Usage
+			event.MaxMemoryUsage = stats.Memory.Usage.Max
+			event.MemoryLimit = stats.Memory.Usage.Limit
+			event.MemoryFailcnt = stats.Memory.Usage.Failcnt
+		}
+		m.trigger(c, event)
+	})
 }
 
 func (m *cgroupsMonitor) Stop(c runtime.Task) error {
 	info := c.Info()
 	m.collector.Remove(info.ID, info.Namespace)
+	m.network.Remove(info.ID, info.Namespace)
 	return nil
 }
 
-func (m *cgroupsMonitor) trigger(id string, cg cgroups.Cgroup) {
-	if err := m.publisher.Publish(m.context, runtime.TaskOOMEventTopic, &eventsapi.TaskOOM{
-		ContainerID: id,
-	}); err != nil {
-		log.G(m.context).WithError(err).Error("post OOM event")
+// trigger reports the OOM of the task and applies the OOM action
+func (m *cgroupsMonitor) trigger(t runtime.Task, event *eventsapi.TaskOOM) {
+	info := t.Info()
+	ctx := namespaces.WithNamespace(m.context, info.Namespace)
+	if err := m.publisher.Publish(ctx, runtime.TaskOOMEventTopic, event); err != nil {
+		log.G(ctx).WithError(err).Error("post OOM event")
 	}
+	if m.config.RecordOOM {
+		if err := m.recordOOM(ctx, event); err != nil {
+			log.G(ctx).WithError(err).WithField("id", info.ID).Warn("failed to record OOM")
+		}
+	}
+	if m.config.OOMAction == OOMKill {
+		log.G(ctx).WithField("id", info.ID).Info("killing task after OOM")
+		if err := t.Kill(ctx, uint32(unix.SIGKILL), true); err != nil && !errdefs.IsNotFound(err) {
+			log.G(ctx).WithError(err).WithField("id", info.ID).Error("failed to kill task after OOM")
+		}
+	}
+}
+
+// recordOOM records the OOM in the labels of the container of the task
+func (m *cgroupsMonitor) recordOOM(ctx context.Context, event *eventsapi.TaskOOM) error {
+	return m.db.Update(func(tx *bolt.Tx) error {
+		_, err := metadata.NewContainerStore(tx).Update(ctx, containers.Container{
+			ID: event.ContainerID,
+			Labels: map[string]string{
+				OOMCountLabel: strconv.FormatUint(event.OOMCount, 10),
+				OOMLastLabel:  event.OccurredAt.Format(time.RFC3339Nano),
+			},
+		}, "labels."+OOMCountLabel, "labels."+OOMLastLabel)
+		return err
+	})
 }
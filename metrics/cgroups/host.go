@@ -0,0 +1,148 @@
+// +build linux
+
+package cgroups
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+
+	metrics "github.com/docker/go-metrics"
+	"github.com/opencontainers/runc/libcontainer/system"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+// clockTicks is the number of clock ticks per second used in /proc/stat
+var clockTicks = uint64(system.GetClockTicks())
+
+// NewHostCollector registers a collector of the node-level cpu and memory
+// totals with the provided namespace. They are collected on the same scrape
+// as the container metrics so that utilization percentages can be computed
+// without correlating samples of another agent.
+func NewHostCollector(ns *metrics.Namespace) *HostCollector {
+	c := &HostCollector{
+		cpus:            ns.NewDesc("cpus", "The number of cpus on the host", metrics.Total),
+		cpuUsage:        ns.NewDesc("cpu_usage", "The total non-idle cpu time of the host", metrics.Nanoseconds),
+		cpuIdle:         ns.NewDesc("cpu_idle", "The total idle cpu time of the host, including time waiting on io", metrics.Nanoseconds),
+		memoryTotal:     ns.NewDesc("memory_total", "The total usable memory of the host", metrics.Bytes),
+		memoryAvailable: ns.NewDesc("memory_available", "The memory available on the host for starting new applications", metrics.Bytes),
+	}
+	ns.Add(c)
+	return c
+}
+
+// HostCollector exports node-level metrics in the prometheus format
+type HostCollector struct {
+	cpus            *prometheus.Desc
+	cpuUsage        *prometheus.Desc
+	cpuIdle         *prometheus.Desc
+	memoryTotal     *prometheus.Desc
+	memoryAvailable *prometheus.Desc
+}
+
+func (c *HostCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.cpus
+	ch <- c.cpuUsage
+	ch <- c.cpuIdle
+	ch <- c.memoryTotal
+	ch <- c.memoryAvailable
+}
+
+func (c *HostCollector) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(c.cpus, prometheus.GaugeValue, float64(runtime.NumCPU()))
+	if usage, idle, err := hostCPU(); err != nil {
+		logrus.WithError(err).Error("stat host cpu")
+	} else {
+		ch <- prometheus.MustNewConstMetric(c.cpuUsage, prometheus.CounterValue, float64(usage))
+		ch <- prometheus.MustNewConstMetric(c.cpuIdle, prometheus.CounterValue, float64(idle))
+	}
+	if total, available, err := hostMemory(); err != nil {
+		logrus.WithError(err).Error("stat host memory")
+	} else {
+		ch <- prometheus.MustNewConstMetric(c.memoryTotal, prometheus.GaugeValue, float64(total))
+		ch <- prometheus.MustNewConstMetric(c.memoryAvailable, prometheus.GaugeValue, float64(available))
+	}
+}
+
+// hostCPU returns the non-idle and idle cpu time of the host in nanoseconds
+// from the aggregate cpu line of /proc/stat
+func hostCPU() (usage, idle uint64, err error) {
+	f, err := os.Open("/proc/stat")
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) < 5 || fields[0] != "cpu" {
+			continue
+		}
+		// user nice system idle iowait irq softirq steal; guest time is
+		// already accounted in user and nice
+		for i, v := range fields[1:] {
+			if i > 7 {
+				break
+			}
+			ticks, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				return 0, 0, err
+			}
+			if i == 3 || i == 4 {
+				idle += ticks
+			} else {
+				usage += ticks
+			}
+		}
+		ns := uint64(1e9) / clockTicks
+		return usage * ns, idle * ns, nil
+	}
+	if err := s.Err(); err != nil {
+		return 0, 0, err
+	}
+	return 0, 0, fmt.Errorf("no cpu line in /proc/stat")
+}
+
+// hostMemory returns the total and available memory of the host in bytes
+// from /proc/meminfo
+func hostMemory() (total, available uint64, err error) {
+	f, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+	var found int
+	s := bufio.NewScanner(f)
+	for s.Scan() && found < 2 {
+		fields := strings.Fields(s.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		var v *uint64
+		switch fields[0] {
+		case "MemTotal:":
+			v = &total
+		case "MemAvailable:":
+			v = &available
+		default:
+			continue
+		}
+		kb, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0, 0, err
+		}
+		*v = kb * 1024
+		found++
+	}
+	if err := s.Err(); err != nil {
+		return 0, 0, err
+	}
+	if found < 2 {
+		return 0, 0, fmt.Errorf("MemTotal or MemAvailable missing from /proc/meminfo")
+	}
+	return total, available, nil
+}
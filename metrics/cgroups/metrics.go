@@ -7,11 +7,13 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/containerd/cgroups"
 	metrics "github.com/docker/go-metrics"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
 )
 
 var (
@@ -43,7 +45,31 @@ func NewCollector(ns *metrics.Namespace) *Collector {
 type task struct {
 	id        string
 	namespace string
-	cgroup    cgroups.Cgroup
+	cgroup    Cgroup
+
+	mu     sync.Mutex
+	sample sample
+}
+
+// sample is the latest stats of the cgroup of a task
+type sample struct {
+	stats     *cgroups.Stats
+	timestamp time.Time
+}
+
+// stat reads the stats of the cgroup and keeps them as the latest sample
+func (t *task) stat() (*cgroups.Stats, error) {
+	stats, err := t.cgroup.Stat()
+	if err != nil {
+		return nil, err
+	}
+	t.mu.Lock()
+	t.sample = sample{
+		stats:     stats,
+		timestamp: time.Now(),
+	}
+	t.mu.Unlock()
+	return stats, nil
 }
 
 func taskID(id, namespace string) string {
@@ -71,26 +97,69 @@ func (c *Collector) Collect(ch chan<- prometheus.Metric) {
 	wg := &sync.WaitGroup{}
 	for _, t := range c.cgroups {
 		wg.Add(1)
-		go c.collect(t.id, t.namespace, t.cgroup, ch, wg)
+		go c.collect(t, ch, wg)
 	}
 	c.mu.RUnlock()
 	wg.Wait()
 }
 
-func (c *Collector) collect(id, namespace string, cg cgroups.Cgroup, ch chan<- prometheus.Metric, wg *sync.WaitGroup) {
+func (c *Collector) collect(t *task, ch chan<- prometheus.Metric, wg *sync.WaitGroup) {
 	defer wg.Done()
-	stats, err := cg.Stat(cgroups.IgnoreNotExist)
+	stats, err := t.stat()
 	if err != nil {
-		logrus.WithError(err).Errorf("stat cgroup %s", id)
+		logrus.WithError(err).Errorf("stat cgroup %s", t.id)
 		return
 	}
 	for _, m := range c.metrics {
-		m.collect(id, namespace, stats, c.ns, ch)
+		m.collect(t.id, t.namespace, stats, c.ns, ch)
+	}
+}
+
+// Sample reads the stats of every cgroup at each interval until the context
+// is canceled, keeping them as the latest samples of the tasks
+func (c *Collector) Sample(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		c.mu.RLock()
+		tasks := make([]*task, 0, len(c.cgroups))
+		for _, t := range c.cgroups {
+			tasks = append(tasks, t)
+		}
+		c.mu.RUnlock()
+		for _, t := range tasks {
+			if _, err := t.stat(); err != nil {
+				logrus.WithError(err).Debugf("sample cgroup %s", t.id)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Latest returns the latest sample of the stats of the cgroup collected
+// under the provided id, false if the cgroup is not collected or has not
+// been sampled yet
+func (c *Collector) Latest(id, namespace string) (*cgroups.Stats, time.Time, bool) {
+	c.mu.RLock()
+	t, ok := c.cgroups[taskID(id, namespace)]
+	c.mu.RUnlock()
+	if !ok {
+		return nil, time.Time{}, false
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.sample.stats == nil {
+		return nil, time.Time{}, false
 	}
+	return t.sample.stats, t.sample.timestamp, true
 }
 
 // Add adds the provided cgroup and id so that metrics are collected and exported
-func (c *Collector) Add(id, namespace string, cg cgroups.Cgroup) error {
+func (c *Collector) Add(id, namespace string, cg Cgroup) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if _, ok := c.cgroups[taskID(id, namespace)]; ok {
@@ -106,7 +175,7 @@ func (c *Collector) Add(id, namespace string, cg cgroups.Cgroup) error {
 
 // Get returns the cgroup that is being collected under the provided id
 // returns ErrCgroupNotExists if the id is not being collected
-func (c *Collector) Get(id, namespace string) (cgroups.Cgroup, error) {
+func (c *Collector) Get(id, namespace string) (Cgroup, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	t, ok := c.cgroups[taskID(id, namespace)]
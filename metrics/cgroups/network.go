@@ -0,0 +1,147 @@
+// +build linux
+
+package cgroups
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	metrics "github.com/docker/go-metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+// netDevFields are the columns of /proc/net/dev exported for each interface,
+// indexed by their position after the interface name
+var netDevFields = []struct {
+	index int
+	name  string
+	help  string
+	unit  metrics.Unit
+}{
+	{0, "network_rx", "The number of bytes received on the interface", metrics.Bytes},
+	{1, "network_rx_packets", "The number of packets received on the interface", metrics.Total},
+	{2, "network_rx_errors", "The number of receive errors on the interface", metrics.Total},
+	{3, "network_rx_dropped", "The number of received packets dropped on the interface", metrics.Total},
+	{8, "network_tx", "The number of bytes transmitted on the interface", metrics.Bytes},
+	{9, "network_tx_packets", "The number of packets transmitted on the interface", metrics.Total},
+	{10, "network_tx_errors", "The number of transmit errors on the interface", metrics.Total},
+	{11, "network_tx_dropped", "The number of transmitted packets dropped on the interface", metrics.Total},
+}
+
+// NewNetworkCollector registers a collector of the interface counters of
+// the network namespaces of tasks with the provided namespace
+func NewNetworkCollector(ns *metrics.Namespace) *NetworkCollector {
+	c := &NetworkCollector{
+		tasks: make(map[string]*netTask),
+	}
+	for _, f := range netDevFields {
+		c.descs = append(c.descs, ns.NewDesc(f.name, f.help, f.unit, "container_id", "namespace", "interface"))
+	}
+	ns.Add(c)
+	return c
+}
+
+type netTask struct {
+	id        string
+	namespace string
+	pid       uint32
+}
+
+// NetworkCollector exports the network interface counters of tasks in the
+// prometheus format
+type NetworkCollector struct {
+	mu    sync.RWMutex
+	tasks map[string]*netTask
+	descs []*prometheus.Desc
+}
+
+func (c *NetworkCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range c.descs {
+		ch <- d
+	}
+}
+
+func (c *NetworkCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, t := range c.tasks {
+		devs, err := netDev(t.pid)
+		if err != nil {
+			logrus.WithError(err).Errorf("stat network of %s", t.id)
+			continue
+		}
+		for iface, values := range devs {
+			for i, f := range netDevFields {
+				ch <- prometheus.MustNewConstMetric(c.descs[i], prometheus.CounterValue, float64(values[f.index]), t.id, t.namespace, iface)
+			}
+		}
+	}
+}
+
+// Add collects the interface counters of the network namespace of the pid
+// for the task. Tasks sharing the network namespace of the daemon are
+// ignored as their counters are those of the host.
+func (c *NetworkCollector) Add(id, namespace string, pid uint32) error {
+	netns, err := os.Readlink(fmt.Sprintf("/proc/%d/ns/net", pid))
+	if err != nil {
+		return err
+	}
+	host, err := os.Readlink("/proc/self/ns/net")
+	if err != nil {
+		return err
+	}
+	if netns == host {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tasks[taskID(id, namespace)] = &netTask{
+		id:        id,
+		namespace: namespace,
+		pid:       pid,
+	}
+	return nil
+}
+
+// Remove stops the collection of the interface counters of the task
+func (c *NetworkCollector) Remove(id, namespace string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.tasks, taskID(id, namespace))
+}
+
+// netDev returns the counters of the interfaces, other than loopback, in the
+// network namespace of the pid
+func netDev(pid uint32) (map[string][]uint64, error) {
+	f, err := os.Open(fmt.Sprintf("/proc/%d/net/dev", pid))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	devs := make(map[string][]uint64)
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		parts := strings.SplitN(s.Text(), ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		iface := strings.TrimSpace(parts[0])
+		fields := strings.Fields(parts[1])
+		if iface == "lo" || len(fields) < 16 {
+			continue
+		}
+		values := make([]uint64, len(fields))
+		for i, v := range fields {
+			if values[i], err = strconv.ParseUint(v, 10, 64); err != nil {
+				return nil, err
+			}
+		}
+		devs[iface] = values
+	}
+	return devs, s.Err()
+}
@@ -0,0 +1,172 @@
+// +build linux
+
+package cgroups
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+// MemoryEvents are the counters of memory.events of a cgroup2 cgroup
+type MemoryEvents struct {
+	// Max is the number of times the usage was about to go over the limit
+	Max uint64
+	// OOM is the number of times the usage reached the limit and the
+	// allocation failed
+	OOM uint64
+	// OOMKill is the number of processes killed by the OOM killer
+	OOMKill uint64
+}
+
+func readMemoryEvents(path string) (MemoryEvents, error) {
+	var events MemoryEvents
+	data, err := ioutil.ReadFile(filepath.Join(path, "memory.events"))
+	if err != nil {
+		return events, err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		switch fields[0] {
+		case "max":
+			events.Max = v
+		case "oom":
+			events.OOM = v
+		case "oom_kill":
+			events.OOMKill = v
+		}
+	}
+	return events, nil
+}
+
+// readMemoryValue reads a memory file of a cgroup2 cgroup, returning 0 for
+// "max" or a file that does not exist on the kernel
+func readMemoryValue(path, name string) uint64 {
+	data, err := ioutil.ReadFile(filepath.Join(path, name))
+	if err != nil {
+		return 0
+	}
+	v, _ := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	return v
+}
+
+// UnifiedTrigger is called with the path of the cgroup2 cgroup that had OOMs
+// and its memory events
+type UnifiedTrigger func(id, path string, events MemoryEvents)
+
+// UnifiedOOMWatcher watches the memory.events of cgroup2 cgroups with inotify
+// and calls their triggers when their OOM kill count increases
+type UnifiedOOMWatcher struct {
+	mu sync.Mutex
+
+	fd  int
+	set map[int]*unifiedOOM
+}
+
+type unifiedOOM struct {
+	id        string
+	namespace string
+	path      string
+	last      MemoryEvents
+	triggers  []UnifiedTrigger
+}
+
+func NewUnifiedOOMWatcher() (*UnifiedOOMWatcher, error) {
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC)
+	if err != nil {
+		return nil, err
+	}
+	w := &UnifiedOOMWatcher{
+		fd:  fd,
+		set: make(map[int]*unifiedOOM),
+	}
+	go w.start()
+	return w, nil
+}
+
+// Add watches the memory events of the cgroup at the path
+func (w *UnifiedOOMWatcher) Add(id, namespace, path string, triggers ...UnifiedTrigger) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	last, err := readMemoryEvents(path)
+	if err != nil {
+		return err
+	}
+	wd, err := unix.InotifyAddWatch(w.fd, filepath.Join(path, "memory.events"), unix.IN_MODIFY)
+	if err != nil {
+		return err
+	}
+	w.set[wd] = &unifiedOOM{
+		id:        id,
+		namespace: namespace,
+		path:      path,
+		last:      last,
+		triggers:  triggers,
+	}
+	return nil
+}
+
+// Close closes the inotify fd
+func (w *UnifiedOOMWatcher) Close() error {
+	return unix.Close(w.fd)
+}
+
+func (w *UnifiedOOMWatcher) start() {
+	var buf [unix.SizeofInotifyEvent * 128]byte
+	for {
+		n, err := unix.Read(w.fd, buf[:])
+		if err != nil {
+			if err == unix.EINTR {
+				continue
+			}
+			logrus.WithError(err).Error("cgroups: inotify read")
+			return
+		}
+		for offset := 0; offset+unix.SizeofInotifyEvent <= n; {
+			event := (*unix.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			w.process(int(event.Wd), event.Mask)
+			offset += unix.SizeofInotifyEvent + int(event.Len)
+		}
+	}
+}
+
+func (w *UnifiedOOMWatcher) process(wd int, mask uint32) {
+	w.mu.Lock()
+	info, ok := w.set[wd]
+	if !ok {
+		w.mu.Unlock()
+		return
+	}
+	// the watch is removed by the kernel when the cgroup is deleted
+	if mask&unix.IN_IGNORED != 0 {
+		delete(w.set, wd)
+		w.mu.Unlock()
+		return
+	}
+	events, err := readMemoryEvents(info.path)
+	if err != nil || events.OOMKill <= info.last.OOMKill {
+		if err == nil {
+			info.last = events
+		}
+		w.mu.Unlock()
+		return
+	}
+	info.last = events
+	w.mu.Unlock()
+	for _, t := range info.triggers {
+		t(info.id, info.path, events)
+	}
+}
@@ -0,0 +1,45 @@
+// +build linux
+
+package cgroups
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadMemoryEvents(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cgroups-memory-events-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	data := "low 0\nhigh 0\nmax 12\noom 3\noom_kill 2\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "memory.events"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "memory.max"), []byte("max\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "memory.current"), []byte("4096\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	events, err := readMemoryEvents(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := MemoryEvents{Max: 12, OOM: 3, OOMKill: 2}
+	if events != expected {
+		t.Fatalf("expected %+v, got %+v", expected, events)
+	}
+	if v := readMemoryValue(dir, "memory.current"); v != 4096 {
+		t.Fatalf("expected usage of 4096, got %d", v)
+	}
+	// an unlimited cgroup and a file missing on older kernels read as 0
+	for _, name := range []string{"memory.max", "memory.peak"} {
+		if v := readMemoryValue(dir, name); v != 0 {
+			t.Fatalf("expected %s to read as 0, got %d", name, v)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// +build linux
+
+package cgroups
+
+import (
+	"github.com/boltdb/bolt"
+	"github.com/containerd/cgroups"
+	api "github.com/containerd/containerd/api/services/stats/v1"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/plugin"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+var _ api.StatsServer = &service{}
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.GRPCPlugin,
+		ID:   "stats",
+		Requires: []plugin.PluginType{
+			plugin.TaskMonitorPlugin,
+			plugin.MetadataPlugin,
+		},
+		Init: func(ic *plugin.InitContext) (interface{}, error) {
+			monitors, err := ic.GetAll(plugin.TaskMonitorPlugin)
+			if err != nil {
+				return nil, err
+			}
+			m, ok := monitors["cgroups"].(*cgroupsMonitor)
+			if !ok {
+				return nil, plugin.SkipPlugin
+			}
+			db, err := ic.Get(plugin.MetadataPlugin)
+			if err != nil {
+				return nil, err
+			}
+			return &service{
+				collector: m.collector,
+				db:        db.(*bolt.DB),
+			}, nil
+		},
+	})
+}
+
+type service struct {
+	collector *Collector
+	db        *bolt.DB
+}
+
+func (s *service) Register(server *grpc.Server) error {
+	api.RegisterStatsServer(server, s)
+	return nil
+}
+
+func (s *service) Aggregate(ctx context.Context, r *api.AggregateRequest) (*api.AggregateResponse, error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	var selected []containers.Container
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		selected, err = metadata.NewContainerStore(tx).List(ctx, r.Filters...)
+		return err
+	}); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	resp := &api.AggregateResponse{}
+	for _, c := range selected {
+		stats, timestamp, ok := s.collector.Latest(c.ID, namespace)
+		if !ok {
+			continue
+		}
+		addUsage(&resp.Usage, stats)
+		resp.Containers = append(resp.Containers, c.ID)
+		if resp.OldestSample.IsZero() || timestamp.Before(resp.OldestSample) {
+			resp.OldestSample = timestamp
+		}
+	}
+	return resp, nil
+}
+
+// addUsage adds the usage of the stats of a cgroup to the combined usage
+func addUsage(u *api.Usage, stats *cgroups.Stats) {
+	if stats.Cpu != nil {
+		u.CPUUsageTotal += stats.Cpu.Usage.Total
+		u.CPUUsageKernel += stats.Cpu.Usage.Kernel
+		u.CPUUsageUser += stats.Cpu.Usage.User
+		u.CPUThrottledPeriods += stats.Cpu.Throttling.ThrottledPeriods
+		u.CPUThrottledTime += stats.Cpu.Throttling.ThrottledTime
+	}
+	if stats.Memory != nil {
+		u.MemoryUsage += stats.Memory.Usage.Usage
+		u.MemoryRSS += stats.Memory.RSS
+		u.MemoryCache += stats.Memory.Cache
+		u.MemorySwap += stats.Memory.Swap.Usage
+	}
+	if stats.Pids != nil {
+		u.Pids += stats.Pids.Current
+	}
+	if stats.Blkio != nil {
+		for _, e := range stats.Blkio.IoServiceBytesRecursive {
+			switch e.Op {
+			case "Read":
+				u.BlkioReadBytes += e.Value
+			case "Write":
+				u.BlkioWriteBytes += e.Value
+			}
+		}
+	}
+}
+
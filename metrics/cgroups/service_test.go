@@ -0,0 +1,48 @@
+// +build linux
+
+package cgroups
+
+import (
+	"testing"
+
+	"github.com/containerd/cgroups"
+	api "github.com/containerd/containerd/api/services/stats/v1"
+)
+
+func TestAddUsage(t *testing.T) {
+	var u api.Usage
+	for i := 0; i < 2; i++ {
+		addUsage(&u, &cgroups.Stats{
+			Cpu: &cgroups.CpuStat{
+				Usage: cgroups.CpuUsage{Total: 100, Kernel: 40, User: 60},
+			},
+			Memory: &cgroups.MemoryStat{
+				RSS:   10,
+				Usage: cgroups.MemoryEntry{Usage: 30},
+			},
+			Pids: &cgroups.PidsStat{Current: 3},
+			Blkio: &cgroups.BlkioStat{
+				IoServiceBytesRecursive: []cgroups.BlkioEntry{
+					{Op: "Read", Value: 5},
+					{Op: "Write", Value: 7},
+					{Op: "Total", Value: 12},
+				},
+			},
+		})
+	}
+	// a cgroup without stats for some controllers
+	addUsage(&u, &cgroups.Stats{Pids: &cgroups.PidsStat{Current: 1}})
+	expected := api.Usage{
+		CPUUsageTotal:   200,
+		CPUUsageKernel:  80,
+		CPUUsageUser:    120,
+		MemoryUsage:     60,
+		MemoryRSS:       20,
+		Pids:            7,
+		BlkioReadBytes:  10,
+		BlkioWriteBytes: 14,
+	}
+	if u != expected {
+		t.Fatalf("expected %+v, got %+v", expected, u)
+	}
+}
@@ -0,0 +1,164 @@
+// +build linux
+
+package cgroups
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/containerd/cgroups"
+)
+
+// unifiedCgroup is a cgroup of the cgroup2 hierarchy, read from the stat
+// files of its controllers
+type unifiedCgroup struct {
+	path string
+}
+
+func (c *unifiedCgroup) Deleted() bool {
+	_, err := os.Stat(c.path)
+	return os.IsNotExist(err)
+}
+
+// Stat reads the stats of the enabled controllers of the cgroup. The files
+// of the controllers that are not enabled are missing and ignored.
+func (c *unifiedCgroup) Stat() (*cgroups.Stats, error) {
+	if c.Deleted() {
+		return nil, cgroups.ErrCgroupDeleted
+	}
+	stats := &cgroups.Stats{}
+	if kv, err := readKeyValues(c.path, "cpu.stat"); err == nil {
+		// cgroup2 reports the cpu time in microseconds
+		stats.Cpu = &cgroups.CpuStat{
+			Usage: cgroups.CpuUsage{
+				Total:  kv["usage_usec"] * 1000,
+				Kernel: kv["system_usec"] * 1000,
+				User:   kv["user_usec"] * 1000,
+			},
+			Throttling: cgroups.Throttle{
+				Periods:          kv["nr_periods"],
+				ThrottledPeriods: kv["nr_throttled"],
+				ThrottledTime:    kv["throttled_usec"] * 1000,
+			},
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	if kv, err := readKeyValues(c.path, "memory.stat"); err == nil {
+		events, _ := readMemoryEvents(c.path)
+		stats.Memory = &cgroups.MemoryStat{
+			Cache:        kv["file"],
+			RSS:          kv["anon"],
+			RSSHuge:      kv["anon_thp"],
+			MappedFile:   kv["file_mapped"],
+			Dirty:        kv["file_dirty"],
+			Writeback:    kv["file_writeback"],
+			PgFault:      kv["pgfault"],
+			PgMajFault:   kv["pgmajfault"],
+			InactiveAnon: kv["inactive_anon"],
+			ActiveAnon:   kv["active_anon"],
+			InactiveFile: kv["inactive_file"],
+			ActiveFile:   kv["active_file"],
+			Unevictable:  kv["unevictable"],
+			Usage: cgroups.MemoryEntry{
+				Usage:   readMemoryValue(c.path, "memory.current"),
+				Max:     readMemoryValue(c.path, "memory.peak"),
+				Limit:   readMemoryValue(c.path, "memory.max"),
+				Failcnt: events.Max,
+			},
+			Swap: cgroups.MemoryEntry{
+				Usage: readMemoryValue(c.path, "memory.swap.current"),
+				Limit: readMemoryValue(c.path, "memory.swap.max"),
+			},
+			Kernel: cgroups.MemoryEntry{
+				Usage: kv["kernel_stack"] + kv["slab"] + kv["sock"],
+			},
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	if _, err := os.Stat(filepath.Join(c.path, "pids.current")); err == nil {
+		stats.Pids = &cgroups.PidsStat{
+			Current: readMemoryValue(c.path, "pids.current"),
+			Limit:   readMemoryValue(c.path, "pids.max"),
+		}
+	}
+	blkio, err := readIOStat(c.path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	stats.Blkio = blkio
+	return stats, nil
+}
+
+// readKeyValues reads a flat keyed file of a cgroup2 cgroup
+func readKeyValues(path, name string) (map[string]uint64, error) {
+	f, err := os.Open(filepath.Join(path, name))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	kv := make(map[string]uint64)
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		kv[fields[0]] = v
+	}
+	return kv, s.Err()
+}
+
+// readIOStat reads io.stat, whose lines hold the bytes and operations read
+// and written on a device, into the service bytes and serviced entries of
+// the cgroup v1 blkio controller
+func readIOStat(path string) (*cgroups.BlkioStat, error) {
+	f, err := os.Open(filepath.Join(path, "io.stat"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	stat := &cgroups.BlkioStat{}
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		var major, minor uint64
+		if devices := strings.SplitN(fields[0], ":", 2); len(devices) == 2 {
+			major, _ = strconv.ParseUint(devices[0], 10, 64)
+			minor, _ = strconv.ParseUint(devices[1], 10, 64)
+		}
+		kv := make(map[string]uint64)
+		for _, field := range fields[1:] {
+			parts := strings.SplitN(field, "=", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			kv[parts[0]], _ = strconv.ParseUint(parts[1], 10, 64)
+		}
+		entry := func(op string, v uint64) cgroups.BlkioEntry {
+			return cgroups.BlkioEntry{Op: op, Major: major, Minor: minor, Value: v}
+		}
+		stat.IoServiceBytesRecursive = append(stat.IoServiceBytesRecursive,
+			entry("Read", kv["rbytes"]),
+			entry("Write", kv["wbytes"]),
+			entry("Total", kv["rbytes"]+kv["wbytes"]),
+		)
+		stat.IoServicedRecursive = append(stat.IoServicedRecursive,
+			entry("Read", kv["rios"]),
+			entry("Write", kv["wios"]),
+			entry("Total", kv["rios"]+kv["wios"]),
+		)
+	}
+	return stat, s.Err()
+}
@@ -0,0 +1,63 @@
+// +build linux
+
+package cgroups
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUnifiedCgroupStat(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cgroups-unified-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for name, data := range map[string]string{
+		"cpu.stat":       "usage_usec 300\nuser_usec 200\nsystem_usec 100\nnr_periods 4\nnr_throttled 1\nthrottled_usec 50\n",
+		"memory.stat":    "anon 10\nfile 20\nfile_mapped 5\n",
+		"memory.current": "30\n",
+		"memory.max":     "max\n",
+		"memory.events":  "low 0\nhigh 0\nmax 2\noom 0\noom_kill 0\n",
+		"pids.current":   "3\n",
+		"pids.max":       "100\n",
+		"io.stat":        "8:0 rbytes=4096 wbytes=8192 rios=1 wios=2 dbytes=0 dios=0\n",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stats, err := (&unifiedCgroup{path: dir}).Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u := stats.Cpu.Usage; u.Total != 300000 || u.User != 200000 || u.Kernel != 100000 {
+		t.Fatalf("unexpected cpu usage %+v", u)
+	}
+	if th := stats.Cpu.Throttling; th.Periods != 4 || th.ThrottledPeriods != 1 || th.ThrottledTime != 50000 {
+		t.Fatalf("unexpected cpu throttling %+v", th)
+	}
+	if m := stats.Memory; m.RSS != 10 || m.Cache != 20 || m.MappedFile != 5 || m.Usage.Usage != 30 || m.Usage.Limit != 0 || m.Usage.Failcnt != 2 {
+		t.Fatalf("unexpected memory stats %+v", m)
+	}
+	if p := stats.Pids; p.Current != 3 || p.Limit != 100 {
+		t.Fatalf("unexpected pids stats %+v", p)
+	}
+	var read, written uint64
+	for _, e := range stats.Blkio.IoServiceBytesRecursive {
+		if e.Major != 8 || e.Minor != 0 {
+			t.Fatalf("unexpected device %d:%d", e.Major, e.Minor)
+		}
+		switch e.Op {
+		case "Read":
+			read = e.Value
+		case "Write":
+			written = e.Value
+		}
+	}
+	if read != 4096 || written != 8192 {
+		t.Fatalf("expected 4096 bytes read and 8192 written, got %d and %d", read, written)
+	}
+}
@@ -0,0 +1,126 @@
+package daemon
+
+import (
+	"github.com/boltdb/bolt"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/runtime"
+	metrics "github.com/docker/go-metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/context"
+)
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.MetricsPlugin,
+		ID:   "daemon",
+		Requires: []plugin.PluginType{
+			plugin.RuntimePlugin,
+			plugin.MetadataPlugin,
+		},
+		Init: New,
+	})
+}
+
+var statuses = map[runtime.Status]string{
+	runtime.CreatedStatus: "created",
+	runtime.RunningStatus: "running",
+	runtime.StoppedStatus: "stopped",
+	runtime.DeletedStatus: "deleted",
+	runtime.PausedStatus:  "paused",
+	runtime.PausingStatus: "pausing",
+	runtime.BrokenStatus:  "broken",
+	runtime.Status(0):     "unknown",
+}
+
+// New registers the daemon level metrics, task counts by status and event
+// throughput by topic, with the metrics endpoint
+func New(ic *plugin.InitContext) (interface{}, error) {
+	rt, err := ic.GetAll(plugin.RuntimePlugin)
+	if err != nil {
+		return nil, err
+	}
+	m, err := ic.Get(plugin.MetadataPlugin)
+	if err != nil {
+		return nil, err
+	}
+	ns := metrics.NewNamespace("containerd", "", nil)
+	c := &Collector{
+		context: ic.Context,
+		db:      m.(*bolt.DB),
+		tasks:   ns.NewDesc("tasks", "The number of tasks by runtime and status", metrics.Total, "runtime", "status"),
+		events:  ns.NewLabeledCounter("events", "The number of events published by topic", "topic"),
+	}
+	for _, r := range rt {
+		c.runtimes = append(c.runtimes, r.(runtime.Runtime))
+	}
+	ns.Add(c)
+	metrics.Register(ns)
+	go c.countEvents(ic.Events)
+	return c, nil
+}
+
+// Collector exports daemon level metrics in the prometheus format
+type Collector struct {
+	context  context.Context
+	db       *bolt.DB
+	runtimes []runtime.Runtime
+	tasks    *prometheus.Desc
+	events   metrics.LabeledCounter
+}
+
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.tasks
+}
+
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	var nss []string
+	if err := c.db.View(func(tx *bolt.Tx) error {
+		var err error
+		nss, err = metadata.NewNamespaceStore(tx).List(c.context)
+		return err
+	}); err != nil {
+		log.G(c.context).WithError(err).Error("list namespaces for metrics")
+		return
+	}
+	for _, r := range c.runtimes {
+		counts := make(map[runtime.Status]int)
+		for _, n := range nss {
+			ctx := namespaces.WithNamespace(c.context, n)
+			tasks, err := r.Tasks(ctx)
+			if err != nil {
+				log.G(ctx).WithError(err).Error("list tasks for metrics")
+				continue
+			}
+			for _, t := range tasks {
+				state, err := t.State(ctx)
+				if err != nil {
+					counts[0]++
+					continue
+				}
+				counts[state.Status]++
+			}
+		}
+		for status, name := range statuses {
+			ch <- prometheus.MustNewConstMetric(c.tasks, prometheus.GaugeValue, float64(counts[status]), r.ID(), name)
+		}
+	}
+}
+
+func (c *Collector) countEvents(exchange *events.Exchange) {
+	ch, errs := exchange.Subscribe(c.context)
+	for {
+		select {
+		case e := <-ch:
+			c.events.WithValues(e.Topic).Inc()
+		case err := <-errs:
+			if err != nil {
+				log.G(c.context).WithError(err).Error("event metrics subscription")
+			}
+			return
+		}
+	}
+}
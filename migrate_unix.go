@@ -0,0 +1,184 @@
+// +build !windows
+
+package containerd
+
+import (
+	"context"
+
+	migrationapi "github.com/containerd/containerd/api/services/migration/v1"
+	"github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// MigrateInfo holds the settings of a migration
+type MigrateInfo struct {
+	// PreCopies is the number of pre-dumps of the memory of the task sent to
+	// the remote daemon while the task keeps running, before the final
+	// checkpoint stops it
+	PreCopies int
+	// RemoteAddress is the address the local daemon reaches the remote
+	// daemon at. When set, the local daemon streams the checkpoints to the
+	// remote daemon rather than the client copying them.
+	RemoteAddress string
+	// CheckpointOpts are applied to each checkpoint of the task
+	CheckpointOpts []CheckpointTaskOpts
+}
+
+// MigrateOpts allows the caller to set migration options
+type MigrateOpts func(*MigrateInfo) error
+
+// WithPreCopies pre-dumps the memory of the task n times before the final
+// checkpoint. Each checkpoint only dumps the memory changed since the
+// previous one, so that the task is stopped for less time when its memory
+// changes slowly.
+func WithPreCopies(n int) MigrateOpts {
+	return func(i *MigrateInfo) error {
+		if n < 0 {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "negative number of pre-copies %d", n)
+		}
+		i.PreCopies = n
+		return nil
+	}
+}
+
+// WithRemoteAddress streams the checkpoints from the local daemon to the
+// remote daemon at the address, as reached from the local daemon
+func WithRemoteAddress(address string) MigrateOpts {
+	return func(i *MigrateInfo) error {
+		i.RemoteAddress = address
+		return nil
+	}
+}
+
+// WithMigrateCheckpointOpts applies the options to each checkpoint of the
+// task
+func WithMigrateCheckpointOpts(opts ...CheckpointTaskOpts) MigrateOpts {
+	return func(i *MigrateInfo) error {
+		i.CheckpointOpts = append(i.CheckpointOpts, opts...)
+		return nil
+	}
+}
+
+// Migrate moves the container's task to the daemon of the remote client.
+//
+// The memory of the task is pre-dumped the number of pre-copies while it
+// keeps running, then the task is checkpointed and stopped. Each checkpoint
+// is sent to the remote daemon as it is taken, along with the container's
+// image and its rootfs changes for the final checkpoint, and the container
+// and task are restored there. The returned task has been created on the
+// remote daemon but not started.
+//
+// The local task is deleted once the remote task has been created. If the
+// migration fails after the final checkpoint was taken, the checkpoints
+// remain in the local content store so that the task can be restored
+// locally.
+func (c *Client) Migrate(ctx context.Context, container Container, remote *Client, ioCreate IOCreation, opts ...MigrateOpts) (Task, error) {
+	var info MigrateInfo
+	for _, o := range opts {
+		if err := o(&info); err != nil {
+			return nil, err
+		}
+	}
+	task, err := container.Task(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	var parent digest.Digest
+	for i := 0; i < info.PreCopies; i++ {
+		index, err := task.Checkpoint(ctx, append([]CheckpointTaskOpts{WithPreDump, WithParentCheckpoint(parent)}, info.CheckpointOpts...)...)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to pre-dump task")
+		}
+		if err := c.sendContent(ctx, remote, info.RemoteAddress, index); err != nil {
+			return nil, errors.Wrap(err, "failed to copy pre-dump")
+		}
+		parent = index.Digest
+	}
+	index, err := task.Checkpoint(ctx, append([]CheckpointTaskOpts{WithExit, WithParentCheckpoint(parent)}, info.CheckpointOpts...)...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to checkpoint task")
+	}
+	if err := c.sendContent(ctx, remote, info.RemoteAddress, index); err != nil {
+		return nil, errors.Wrap(err, "failed to copy checkpoint")
+	}
+	if image := container.Info().Image; image != "" {
+		if err := remote.createImage(ctx, image, c); err != nil {
+			return nil, err
+		}
+	}
+	restored, err := remote.NewContainer(ctx, container.ID(), WithCheckpoint(index, container.ID()))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to restore container")
+	}
+	rtask, err := restored.NewTask(ctx, ioCreate, WithTaskCheckpoint(index))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to restore task")
+	}
+	if _, err := task.Delete(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to delete migrated task")
+	}
+	return rtask, nil
+}
+
+// sendContent copies the content referenced by desc to the remote daemon.
+// The local daemon streams the content when the address it reaches the
+// remote daemon at is known and it has the migration service, otherwise the
+// content is copied through the client.
+func (c *Client) sendContent(ctx context.Context, remote *Client, address string, desc v1.Descriptor) error {
+	if address != "" {
+		_, err := c.MigrationService().Send(ctx, &migrationapi.SendRequest{
+			Target: types.Descriptor{
+				MediaType: desc.MediaType,
+				Digest:    desc.Digest,
+				Size_:     desc.Size,
+			},
+			Address: address,
+		})
+		if err = errdefs.FromGRPC(err); !errdefs.IsNotImplemented(err) {
+			return err
+		}
+	}
+	return copyContent(ctx, c.ContentStore(), remote.ContentStore(), desc)
+}
+
+// createImage copies the named image record from the source client and
+// unpacks it into the default snapshotter so that a checkpoint of a
+// container using the image can be restored
+func (c *Client) createImage(ctx context.Context, name string, source *Client) error {
+	i, err := source.ImageService().Get(ctx, name)
+	if err != nil {
+		return err
+	}
+	if _, err := c.ImageService().Create(ctx, i); err != nil && !errdefs.IsAlreadyExists(err) {
+		return err
+	}
+	image, err := c.GetImage(ctx, name)
+	if err != nil {
+		return err
+	}
+	return image.Unpack(ctx, DefaultSnapshotter)
+}
+
+// copyContent copies the content referenced by desc, including its children,
+// from one content store to another, skipping blobs that are already present
+func copyContent(ctx context.Context, from content.Store, to content.Store, desc v1.Descriptor) error {
+	copyHandler := images.HandlerFunc(func(ctx context.Context, desc v1.Descriptor) ([]v1.Descriptor, error) {
+		if _, err := to.Info(ctx, desc.Digest); err == nil {
+			return nil, nil
+		} else if !errdefs.IsNotFound(err) {
+			return nil, err
+		}
+		ra, err := from.ReaderAt(ctx, desc.Digest)
+		if err != nil {
+			return nil, err
+		}
+		defer ra.Close()
+		return nil, content.WriteBlob(ctx, to, desc.Digest.String(), content.NewReader(ra), ra.Size(), desc.Digest)
+	})
+	return images.Walk(ctx, images.Handlers(copyHandler, images.ChildrenHandler(from)), desc)
+}
@@ -1,5 +1,7 @@
 package mount
 
+import "strings"
+
 // Mount is the lingua franca of containerd. A mount represents a
 // serialized mount syscall. Components either emit or consume mounts.
 type Mount struct {
@@ -13,6 +15,35 @@ type Mount struct {
 	Options []string
 }
 
+// The types of the mounts that are not mounted on the host but passed to the
+// guest of a VM runtime, which mounts them in the VM.
+const (
+	// TypeBlock is a block device, or an image file, holding the
+	// filesystem of the "fstype=" option, such as ext4, which is attached
+	// to the VM as a drive. A "ro" option attaches it read-only.
+	TypeBlock = "block"
+	// TypeVirtiofs is a directory of the host shared with the VM through
+	// virtio-fs, whose tag is the "tag=" option
+	TypeVirtiofs = "virtiofs"
+)
+
+// IsVMOnly returns whether the mount can only be mounted in the guest of a
+// VM runtime
+func (m *Mount) IsVMOnly() bool {
+	return m.Type == TypeBlock || m.Type == TypeVirtiofs
+}
+
+// Option returns the value of the key=value option of the mount, and
+// whether it is set
+func (m *Mount) Option(key string) (string, bool) {
+	for _, o := range m.Options {
+		if strings.HasPrefix(o, key+"=") {
+			return o[len(key)+1:], true
+		}
+	}
+	return "", false
+}
+
 // MountAll mounts all the provided mounts to the provided target
 func MountAll(mounts []Mount, target string) error {
 	for _, m := range mounts {
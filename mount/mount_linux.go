@@ -1,16 +1,47 @@
 package mount
 
 import (
+	"os/exec"
 	"strings"
 
+	"github.com/pkg/errors"
 	"golang.org/x/sys/unix"
 )
 
 func (m *Mount) Mount(target string) error {
+	if helper, ok := fuseHelper(m.Type); ok {
+		return m.mountHelper(helper, target)
+	}
 	flags, data := parseMountOptions(m.Options)
 	return unix.Mount(m.Source, target, m.Type, uintptr(flags), data)
 }
 
+// fuseHelper returns the name of the FUSE program mounting mounts of the
+// type, such as fuse-overlayfs for "fuse3.fuse-overlayfs"
+func fuseHelper(typ string) (string, bool) {
+	for _, prefix := range []string{"fuse3.", "fuse."} {
+		if strings.HasPrefix(typ, prefix) && len(typ) > len(prefix) {
+			return typ[len(prefix):], true
+		}
+	}
+	return "", false
+}
+
+// mountHelper mounts with the FUSE program, which mounts without the
+// privileges required by mount(2), such as in a user namespace
+func (m *Mount) mountHelper(helper, target string) error {
+	args := []string{}
+	if len(m.Options) > 0 {
+		args = append(args, "-o", strings.Join(m.Options, ","))
+	}
+	args = append(args, target)
+	out, err := exec.Command(helper, args...).CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "mount helper %s: %s", helper, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
 func Unmount(mount string, flags int) error {
 	return unix.Unmount(mount, flags)
 }
@@ -0,0 +1,238 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+)
+
+// Network is a CNI network configuration
+type Network struct {
+	Name       string
+	CNIVersion string
+	// Plugins are the configurations of the plugins of the network in the
+	// order they are added
+	Plugins []json.RawMessage
+}
+
+type networkList struct {
+	CNIVersion string            `json:"cniVersion"`
+	Name       string            `json:"name"`
+	Plugins    []json.RawMessage `json:"plugins"`
+}
+
+// LoadNetworks returns the networks of the .conf, .conflist and .json files
+// of the directories in order, skipping the directories that do not exist
+func LoadNetworks(dirs []string) ([]*Network, error) {
+	var networks []*Network
+	for _, dir := range dirs {
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		var names []string
+		for _, f := range files {
+			switch filepath.Ext(f.Name()) {
+			case ".conf", ".conflist", ".json":
+				if !f.IsDir() {
+					names = append(names, f.Name())
+				}
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			n, err := loadNetwork(filepath.Join(dir, name))
+			if err != nil {
+				return nil, errors.Wrapf(err, "cni configuration %s", filepath.Join(dir, name))
+			}
+			networks = append(networks, n)
+		}
+	}
+	return networks, nil
+}
+
+func loadNetwork(path string) (*Network, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var l networkList
+	if err := json.Unmarshal(data, &l); err != nil {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, err.Error())
+	}
+	if l.Name == "" {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, "network has no name")
+	}
+	n := &Network{
+		Name:       l.Name,
+		CNIVersion: l.CNIVersion,
+		Plugins:    l.Plugins,
+	}
+	if filepath.Ext(path) != ".conflist" {
+		// a single plugin configuration
+		n.Plugins = []json.RawMessage{data}
+	}
+	if len(n.Plugins) == 0 {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, "network has no plugins")
+	}
+	return n, nil
+}
+
+// FindNetwork returns the network with the name, or the first network for
+// DefaultNetwork
+func FindNetwork(dirs []string, name string) (*Network, error) {
+	networks, err := LoadNetworks(dirs)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range networks {
+		if name == DefaultNetwork || n.Name == name {
+			return n, nil
+		}
+	}
+	return nil, errors.Wrapf(errdefs.ErrNotFound, "cni network %q", name)
+}
+
+// Result is the result of adding a container to a network
+type Result struct {
+	CNIVersion string     `json:"cniVersion"`
+	IPs        []IPConfig `json:"ips"`
+	// IP4 and IP6 are the addresses of results of CNI versions before 0.3.0
+	IP4 *IPConfig `json:"ip4,omitempty"`
+	IP6 *IPConfig `json:"ip6,omitempty"`
+}
+
+// IPConfig is an address assigned to a container
+type IPConfig struct {
+	Address string `json:"address,omitempty"`
+	// IP is the address of results of CNI versions before 0.3.0
+	IP      string `json:"ip,omitempty"`
+	Gateway string `json:"gateway,omitempty"`
+}
+
+// Addresses returns the addresses of the result in CIDR notation
+func (r *Result) Addresses() []string {
+	var out []string
+	for _, ip := range r.IPs {
+		out = append(out, ip.Address)
+	}
+	for _, ip := range []*IPConfig{r.IP4, r.IP6} {
+		if ip != nil && ip.IP != "" {
+			out = append(out, ip.IP)
+		}
+	}
+	return out
+}
+
+// CNI invokes the CNI plugins of networks
+type CNI struct {
+	// BinDirs are searched for the binaries of the plugins
+	BinDirs []string
+	// IfName is the name of the interface of the container
+	IfName string
+}
+
+// Add adds the container with the network namespace at the path to the
+// network, returning the result of the last plugin
+func (c *CNI) Add(ctx context.Context, n *Network, id, netns string) (*Result, error) {
+	var prev json.RawMessage
+	for _, p := range n.Plugins {
+		out, err := c.exec(ctx, "ADD", n, p, prev, id, netns)
+		if err != nil {
+			return nil, err
+		}
+		prev = out
+	}
+	var r Result
+	if len(prev) > 0 {
+		if err := json.Unmarshal(prev, &r); err != nil {
+			return nil, errors.Wrap(err, "invalid cni result")
+		}
+	}
+	return &r, nil
+}
+
+// Del removes the container with the network namespace at the path from
+// the network, invoking its plugins in the reverse order
+func (c *CNI) Del(ctx context.Context, n *Network, id, netns string) error {
+	for i := len(n.Plugins) - 1; i >= 0; i-- {
+		if _, err := c.exec(ctx, "DEL", n, n.Plugins[i], nil, id, netns); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cniError is the error a plugin writes to its stdout when it fails
+type cniError struct {
+	Code    uint   `json:"code"`
+	Msg     string `json:"msg"`
+	Details string `json:"details,omitempty"`
+}
+
+func (c *CNI) exec(ctx context.Context, command string, n *Network, plugin, prev json.RawMessage, id, netns string) (json.RawMessage, error) {
+	var conf map[string]interface{}
+	if err := json.Unmarshal(plugin, &conf); err != nil {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "network %s: %v", n.Name, err)
+	}
+	typ, _ := conf["type"].(string)
+	if typ == "" {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "network %s: plugin has no type", n.Name)
+	}
+	conf["name"] = n.Name
+	conf["cniVersion"] = n.CNIVersion
+	if prev != nil {
+		conf["prevResult"] = prev
+	}
+	stdin, err := json.Marshal(conf)
+	if err != nil {
+		return nil, err
+	}
+	path, err := c.find(typ)
+	if err != nil {
+		return nil, err
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, path)
+	cmd.Env = append(os.Environ(),
+		"CNI_COMMAND="+command,
+		"CNI_CONTAINERID="+id,
+		"CNI_NETNS="+netns,
+		"CNI_IFNAME="+c.IfName,
+		"CNI_PATH="+strings.Join(c.BinDirs, string(os.PathListSeparator)),
+	)
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		var e cniError
+		if jerr := json.Unmarshal(stdout.Bytes(), &e); jerr == nil && e.Msg != "" {
+			return nil, errors.Errorf("cni plugin %s %s failed: %s: %s (code %d)", typ, command, e.Msg, e.Details, e.Code)
+		}
+		return nil, errors.Wrapf(err, "cni plugin %s %s failed: %s", typ, command, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// find returns the path of the binary of the plugin type
+func (c *CNI) find(typ string) (string, error) {
+	for _, dir := range c.BinDirs {
+		path := filepath.Join(dir, typ)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path, nil
+		}
+	}
+	return "", errors.Wrapf(errdefs.ErrNotFound, "cni plugin %s in %s", typ, strings.Join(c.BinDirs, ", "))
+}
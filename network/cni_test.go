@@ -0,0 +1,89 @@
+package network
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+)
+
+const testPlugin = `#!/bin/sh
+conf=$(cat)
+echo "$CNI_COMMAND $0 $CNI_CONTAINERID $CNI_NETNS $CNI_IFNAME" >> "$(dirname $0)/calls"
+case "$conf" in
+*fail*) echo '{"code": 11, "msg": "no addresses"}'; exit 1;;
+*prevResult*) echo '{"cniVersion": "0.3.1", "ips": [{"version": "4", "address": "10.4.0.2/24"}]}';;
+*) echo '{"cniVersion": "0.3.1", "ips": [{"version": "4", "address": "10.4.0.1/24"}]}';;
+esac
+`
+
+func writeFile(t *testing.T, path, data string, mode os.FileMode) {
+	if err := ioutil.WriteFile(path, []byte(data), mode); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCNI(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cni-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeFile(t, filepath.Join(dir, "bridge"), testPlugin, 0755)
+	writeFile(t, filepath.Join(dir, "portmap"), testPlugin, 0755)
+	writeFile(t, filepath.Join(dir, "10-test.conflist"), `{
+		"cniVersion": "0.3.1",
+		"name": "test",
+		"plugins": [{"type": "bridge"}, {"type": "portmap"}]
+	}`, 0644)
+	writeFile(t, filepath.Join(dir, "20-fail.conf"), `{"cniVersion": "0.3.1", "name": "fail", "type": "bridge"}`, 0644)
+
+	n, err := FindNetwork([]string{filepath.Join(dir, "missing"), dir}, DefaultNetwork)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Name != "test" || len(n.Plugins) != 2 {
+		t.Fatalf("unexpected default network %s with %d plugins", n.Name, len(n.Plugins))
+	}
+	c := &CNI{BinDirs: []string{dir}, IfName: "eth0"}
+	result, err := c.Add(context.Background(), n, "id", "/var/run/netns/test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addrs := result.Addresses(); len(addrs) != 1 || addrs[0] != "10.4.0.2/24" {
+		t.Fatalf("the result of the last plugin was not returned: %v", addrs)
+	}
+	if err := c.Del(context.Background(), n, "id", "/var/run/netns/test"); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "calls"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := strings.Split(strings.TrimSpace(string(data)), "\n")
+	expected := []string{"ADD bridge", "ADD portmap", "DEL portmap", "DEL bridge"}
+	if len(calls) != len(expected) {
+		t.Fatalf("unexpected calls %v", calls)
+	}
+	for i, call := range calls {
+		fields := strings.Fields(call)
+		if fields[0]+" "+filepath.Base(fields[1]) != expected[i] || fields[2] != "id" || fields[4] != "eth0" {
+			t.Fatalf("unexpected call %d: %s", i, call)
+		}
+	}
+
+	fail, err := FindNetwork([]string{dir}, "fail")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Add(context.Background(), fail, "id", "/var/run/netns/test"); err == nil || !strings.Contains(err.Error(), "no addresses") {
+		t.Fatalf("expected the error of the plugin, got %v", err)
+	}
+	if _, err := FindNetwork([]string{dir}, "other"); !errdefs.IsNotFound(err) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
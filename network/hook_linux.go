@@ -0,0 +1,173 @@
+package network
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/boltdb/bolt"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/hooks"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/plugin"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.HookPlugin,
+		ID:   "cni",
+		Requires: []plugin.PluginType{
+			plugin.MetadataPlugin,
+		},
+		Config: &Config{
+			ConfDirs: []string{"/etc/cni/net.d"},
+			BinDirs:  []string{"/opt/cni/bin"},
+			IfName:   "eth0",
+		},
+		Init: New,
+	})
+}
+
+// Config of the cni hook
+type Config struct {
+	// ConfDirs hold the network configurations, which are loaded as
+	// containers are created
+	ConfDirs []string `toml:"conf_dirs"`
+	// BinDirs hold the binaries of the CNI plugins
+	BinDirs []string `toml:"bin_dirs"`
+	// IfName is the name of the interface of the containers
+	IfName string `toml:"if_name"`
+}
+
+// New returns the cni hook of the configuration
+func New(ic *plugin.InitContext) (interface{}, error) {
+	config := ic.Config.(*Config)
+	if err := os.MkdirAll(ic.State, 0711); err != nil {
+		return nil, err
+	}
+	m, err := ic.Get(plugin.MetadataPlugin)
+	if err != nil {
+		return nil, err
+	}
+	return &Hook{
+		db:       m.(*bolt.DB),
+		state:    ic.State,
+		confDirs: config.ConfDirs,
+		cni: &CNI{
+			BinDirs: config.BinDirs,
+			IfName:  config.IfName,
+		},
+	}, nil
+}
+
+// Hook sets up the network of the tasks of the containers labeled with
+// NetworkLabel in network namespaces it owns
+type Hook struct {
+	db       *bolt.DB
+	state    string
+	confDirs []string
+	cni      *CNI
+}
+
+// Invoke sets up the network at the pre-create point and tears it down at
+// the post-delete point
+func (h *Hook) Invoke(ctx context.Context, r *hooks.Request) (*hooks.Adjustment, error) {
+	name := r.Labels[NetworkLabel]
+	if name == "" {
+		return nil, nil
+	}
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return nil, err
+	}
+	path := filepath.Join(h.state, namespace, r.ContainerID)
+	switch r.Point {
+	case hooks.PreCreate:
+		return h.setup(ctx, name, r.ContainerID, path)
+	case hooks.PostDelete:
+		if err := h.teardown(ctx, name, r.ContainerID, path); err != nil {
+			return nil, err
+		}
+		return nil, h.record(ctx, r.ContainerID, nil)
+	}
+	return nil, nil
+}
+
+func (h *Hook) setup(ctx context.Context, name, id, path string) (_ *hooks.Adjustment, err error) {
+	n, err := FindNetwork(h.confDirs, name)
+	if err != nil {
+		return nil, err
+	}
+	// the namespace of a task that was not deleted
+	if _, err := os.Stat(path); err == nil {
+		if err := h.teardown(ctx, name, id, path); err != nil {
+			return nil, err
+		}
+	}
+	if err := NewNamespace(path); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if derr := h.cni.Del(ctx, n, id, path); derr != nil {
+				log.G(ctx).WithError(derr).WithField("id", id).Warn("failed to remove container from network")
+			}
+			RemoveNamespace(path)
+		}
+	}()
+	result, err := h.cni.Add(ctx, n, id, path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to add container to network %s", n.Name)
+	}
+	if err := h.record(ctx, id, result.Addresses()); err != nil {
+		return nil, err
+	}
+	return &hooks.Adjustment{
+		Namespaces: []specs.LinuxNamespace{
+			{
+				Type: specs.NetworkNamespace,
+				Path: path,
+			},
+		},
+	}, nil
+}
+
+// teardown removes the container from the network and removes its network
+// namespace
+func (h *Hook) teardown(ctx context.Context, name, id, path string) error {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	}
+	n, err := FindNetwork(h.confDirs, name)
+	switch {
+	case err == nil:
+		if err := h.cni.Del(ctx, n, id, path); err != nil {
+			return errors.Wrapf(err, "failed to remove container from network %s", n.Name)
+		}
+	case errdefs.IsNotFound(err):
+		log.G(ctx).WithField("id", id).Warnf("cni network %s was removed, removing the network namespace only", name)
+	default:
+		return err
+	}
+	return RemoveNamespace(path)
+}
+
+// record sets the addresses of the container on its IPsLabel so that they
+// are visible in its info
+func (h *Hook) record(ctx context.Context, id string, addresses []string) error {
+	return h.db.Update(func(tx *bolt.Tx) error {
+		_, err := metadata.NewContainerStore(tx).Update(ctx, containers.Container{
+			ID: id,
+			Labels: map[string]string{
+				IPsLabel: strings.Join(addresses, ","),
+			},
+		}, "labels."+IPsLabel)
+		return err
+	})
+}
@@ -0,0 +1,17 @@
+package network
+
+import (
+	"github.com/containerd/containerd/sys"
+	"golang.org/x/sys/unix"
+)
+
+// NewNamespace creates a network namespace and bind mounts it at the path so
+// that it is kept without processes
+func NewNamespace(path string) error {
+	return sys.NewNamespace(path, unix.CLONE_NEWNET)
+}
+
+// RemoveNamespace unmounts and removes the network namespace at the path
+func RemoveNamespace(path string) error {
+	return sys.RemoveNamespace(path)
+}
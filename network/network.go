@@ -0,0 +1,20 @@
+// Package network sets up the networking of containers with CNI plugins.
+//
+// The cni hook creates a network namespace for the task of each container
+// labeled with NetworkLabel, adds it to the CNI network of the label and
+// records the addresses assigned to the container on IPsLabel. The network
+// is torn down and the namespace removed when the task is deleted.
+package network
+
+const (
+	// NetworkLabel is the label of the containers whose network is set up
+	// by the cni hook, naming their CNI network or "default" for the first
+	// network of the configuration directories
+	NetworkLabel = "containerd.io/network"
+	// IPsLabel is the label of the containers holding the comma separated
+	// addresses, in CIDR notation, assigned to their task
+	IPsLabel = "containerd.io/network.ips"
+	// DefaultNetwork selects the first network of the configuration
+	// directories
+	DefaultNetwork = "default"
+)
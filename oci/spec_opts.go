@@ -0,0 +1,138 @@
+package oci
+
+import (
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/opencontainers/image-spec/specs-go/v1"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// Opt modifies a spec
+type Opt func(*specs.Spec) error
+
+// WithImageConfig sets the environment, args, user and working directory of
+// the process from the runtime configuration of an image
+func WithImageConfig(config v1.ImageConfig) Opt {
+	return func(s *specs.Spec) error {
+		s.Process.Env = append(s.Process.Env, config.Env...)
+		s.Process.Args = append(config.Entrypoint, config.Cmd...)
+		if config.User != "" {
+			if err := WithUser(config.User)(s); err != nil {
+				return err
+			}
+		} else {
+			s.Process.User.UID, s.Process.User.GID = 0, 0
+		}
+		cwd := config.WorkingDir
+		if cwd == "" {
+			cwd = "/"
+		}
+		s.Process.Cwd = cwd
+		return nil
+	}
+}
+
+// WithEnv appends the KEY=VALUE variables to the environment of the process,
+// replacing the variables with the same keys
+func WithEnv(env []string) Opt {
+	return func(s *specs.Spec) error {
+		for _, e := range env {
+			key := strings.SplitN(e, "=", 2)[0]
+			kept := s.Process.Env[:0:0]
+			for _, v := range s.Process.Env {
+				if strings.SplitN(v, "=", 2)[0] != key {
+					kept = append(kept, v)
+				}
+			}
+			s.Process.Env = append(kept, e)
+		}
+		return nil
+	}
+}
+
+// WithArgs sets the args of the process
+func WithArgs(args []string) Opt {
+	return func(s *specs.Spec) error {
+		s.Process.Args = args
+		return nil
+	}
+}
+
+// WithCwd sets the working directory of the process
+func WithCwd(cwd string) Opt {
+	return func(s *specs.Spec) error {
+		s.Process.Cwd = cwd
+		return nil
+	}
+}
+
+// WithMounts appends the mounts, replacing the mounts with the same
+// destinations
+func WithMounts(mounts []specs.Mount) Opt {
+	return func(s *specs.Spec) error {
+		for _, m := range mounts {
+			kept := s.Mounts[:0:0]
+			for _, existing := range s.Mounts {
+				if path.Clean(existing.Destination) != path.Clean(m.Destination) {
+					kept = append(kept, existing)
+				}
+			}
+			s.Mounts = append(kept, m)
+		}
+		return nil
+	}
+}
+
+// WithUser sets the user of the process from a uid or uid:gid, the gid being
+// the uid when it is not set. Names are not resolved as the files of the
+// rootfs are not read.
+func WithUser(user string) Opt {
+	return func(s *specs.Spec) error {
+		parts := strings.Split(user, ":")
+		if len(parts) > 2 {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "invalid user %q", user)
+		}
+		ids := make([]uint32, len(parts))
+		for i, p := range parts {
+			v, err := strconv.ParseUint(p, 0, 32)
+			if err != nil {
+				return errors.Wrapf(errdefs.ErrInvalidArgument, "user %q is not a uid or uid:gid", user)
+			}
+			ids[i] = uint32(v)
+		}
+		s.Process.User.UID, s.Process.User.GID = ids[0], ids[0]
+		if len(ids) == 2 {
+			s.Process.User.GID = ids[1]
+		}
+		return nil
+	}
+}
+
+// WithCapabilities sets the capabilities of the process, replacing the
+// default capabilities
+func WithCapabilities(caps []string) Opt {
+	return func(s *specs.Spec) error {
+		s.Process.Capabilities = &specs.LinuxCapabilities{
+			Bounding:    caps,
+			Permitted:   caps,
+			Inheritable: caps,
+			Effective:   caps,
+		}
+		return nil
+	}
+}
+
+// WithSeccomp sets the seccomp profile of the container
+func WithSeccomp(profile *specs.LinuxSeccomp) Opt {
+	return func(s *specs.Spec) error {
+		if s.Linux == nil {
+			s.Linux = &specs.Linux{}
+		}
+		s.Linux.Seccomp = profile
+		return nil
+	}
+}
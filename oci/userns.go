@@ -0,0 +1,44 @@
+package oci
+
+import (
+	"github.com/containerd/containerd/errdefs"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// RootIDs returns the host uid and gid of the root of the user namespace of
+// the spec, and whether the spec has a user namespace. An error is returned
+// when the root of the user namespace is not mapped to the host.
+func RootIDs(s *specs.Spec) (uint32, uint32, bool, error) {
+	if s.Linux == nil || !hasNamespace(s.Linux.Namespaces, specs.UserNamespace) {
+		return 0, 0, false, nil
+	}
+	uid, ok := HostID(s.Linux.UIDMappings, 0)
+	if !ok {
+		return 0, 0, true, errors.Wrap(errdefs.ErrInvalidArgument, "root of the user namespace has no host uid")
+	}
+	gid, ok := HostID(s.Linux.GIDMappings, 0)
+	if !ok {
+		return 0, 0, true, errors.Wrap(errdefs.ErrInvalidArgument, "root of the user namespace has no host gid")
+	}
+	return uid, gid, true, nil
+}
+
+// HostID returns the host id of the id of the container in the mappings
+func HostID(mappings []specs.LinuxIDMapping, id uint32) (uint32, bool) {
+	for _, m := range mappings {
+		if id >= m.ContainerID && id-m.ContainerID < m.Size {
+			return m.HostID + id - m.ContainerID, true
+		}
+	}
+	return 0, false
+}
+
+func hasNamespace(namespaces []specs.LinuxNamespace, typ specs.LinuxNamespaceType) bool {
+	for _, ns := range namespaces {
+		if ns.Type == typ {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,30 @@
+package oci
+
+import (
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestRootIDs(t *testing.T) {
+	userns := []specs.LinuxNamespace{{Type: specs.UserNamespace}}
+	mappings := []specs.LinuxIDMapping{{ContainerID: 0, HostID: 100000, Size: 65536}}
+	uid, gid, ok, err := RootIDs(&specs.Spec{Linux: &specs.Linux{
+		Namespaces:  userns,
+		UIDMappings: mappings,
+		GIDMappings: []specs.LinuxIDMapping{{ContainerID: 0, HostID: 200000, Size: 65536}},
+	}})
+	if err != nil || !ok || uid != 100000 || gid != 200000 {
+		t.Fatalf("unexpected root ids %d:%d (user namespace %v, error %v)", uid, gid, ok, err)
+	}
+	if _, _, ok, err := RootIDs(&specs.Spec{Linux: &specs.Linux{UIDMappings: mappings}}); ok || err != nil {
+		t.Fatalf("expected no user namespace but got %v, %v", ok, err)
+	}
+	if _, _, _, err := RootIDs(&specs.Spec{Linux: &specs.Linux{Namespaces: userns, UIDMappings: mappings}}); !errdefs.IsInvalidArgument(err) {
+		t.Fatalf("expected an invalid argument error for an unmapped gid but got %v", err)
+	}
+	if id, ok := HostID(mappings, 1000); !ok || id != 101000 {
+		t.Fatalf("unexpected host id %d (%v)", id, ok)
+	}
+}
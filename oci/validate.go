@@ -0,0 +1,151 @@
+package oci
+
+import (
+	"path"
+	"strings"
+
+	"github.com/containerd/containerd/errdefs"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// capabilities are the names of the Linux capabilities
+var capabilities = map[string]struct{}{}
+
+func init() {
+	for _, c := range []string{
+		"CHOWN", "DAC_OVERRIDE", "DAC_READ_SEARCH", "FOWNER", "FSETID", "KILL",
+		"SETGID", "SETUID", "SETPCAP", "LINUX_IMMUTABLE", "NET_BIND_SERVICE",
+		"NET_BROADCAST", "NET_ADMIN", "NET_RAW", "IPC_LOCK", "IPC_OWNER",
+		"SYS_MODULE", "SYS_RAWIO", "SYS_CHROOT", "SYS_PTRACE", "SYS_PACCT",
+		"SYS_ADMIN", "SYS_BOOT", "SYS_NICE", "SYS_RESOURCE", "SYS_TIME",
+		"SYS_TTY_CONFIG", "MKNOD", "LEASE", "AUDIT_WRITE", "AUDIT_CONTROL",
+		"SETFCAP", "MAC_OVERRIDE", "MAC_ADMIN", "SYSLOG", "WAKE_ALARM",
+		"BLOCK_SUSPEND", "AUDIT_READ",
+	} {
+		capabilities["CAP_"+c] = struct{}{}
+	}
+}
+
+var namespaceTypes = map[specs.LinuxNamespaceType]struct{}{
+	specs.PIDNamespace:     {},
+	specs.NetworkNamespace: {},
+	specs.MountNamespace:   {},
+	specs.IPCNamespace:     {},
+	specs.UTSNamespace:     {},
+	specs.UserNamespace:    {},
+	specs.CgroupNamespace:  {},
+}
+
+var seccompActions = map[specs.LinuxSeccompAction]struct{}{
+	specs.ActKill:  {},
+	specs.ActTrap:  {},
+	specs.ActErrno: {},
+	specs.ActTrace: {},
+	specs.ActAllow: {},
+}
+
+// Validate returns an invalid argument error describing the first field of
+// the spec that does not follow the runtime spec, or that runc rejects
+func Validate(s *specs.Spec) error {
+	if !strings.HasPrefix(s.Version, "1.") {
+		return invalid("unsupported spec version %q", s.Version)
+	}
+	if s.Root == nil || s.Root.Path == "" {
+		return invalid("root.path is required")
+	}
+	if s.Process != nil {
+		if err := validateProcess(s.Process); err != nil {
+			return err
+		}
+	}
+	for _, m := range s.Mounts {
+		if !path.IsAbs(m.Destination) {
+			return invalid("mount destination %q is not absolute", m.Destination)
+		}
+	}
+	if s.Linux != nil {
+		if err := validateLinux(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateProcess(p *specs.Process) error {
+	if len(p.Args) == 0 {
+		return invalid("process.args is required")
+	}
+	if !path.IsAbs(p.Cwd) {
+		return invalid("process.cwd %q is not absolute", p.Cwd)
+	}
+	for _, e := range p.Env {
+		if !strings.Contains(e, "=") {
+			return invalid("process.env %q is not KEY=VALUE", e)
+		}
+	}
+	if c := p.Capabilities; c != nil {
+		for _, set := range [][]string{c.Bounding, c.Effective, c.Inheritable, c.Permitted, c.Ambient} {
+			for _, name := range set {
+				if _, ok := capabilities[name]; !ok {
+					return invalid("unknown capability %q", name)
+				}
+			}
+		}
+	}
+	for _, r := range p.Rlimits {
+		if !strings.HasPrefix(r.Type, "RLIMIT_") {
+			return invalid("unknown rlimit %q", r.Type)
+		}
+		if r.Soft > r.Hard {
+			return invalid("soft limit of %s is over its hard limit", r.Type)
+		}
+	}
+	return nil
+}
+
+func validateLinux(s *specs.Spec) error {
+	var (
+		l    = s.Linux
+		seen = make(map[specs.LinuxNamespaceType]struct{})
+	)
+	for _, ns := range l.Namespaces {
+		if _, ok := namespaceTypes[ns.Type]; !ok {
+			return invalid("unknown namespace %q", ns.Type)
+		}
+		if _, ok := seen[ns.Type]; ok {
+			return invalid("duplicate namespace %q", ns.Type)
+		}
+		seen[ns.Type] = struct{}{}
+	}
+	if _, ok := seen[specs.UserNamespace]; ok {
+		if len(l.UIDMappings) == 0 || len(l.GIDMappings) == 0 {
+			return invalid("user namespace requires uid and gid mappings")
+		}
+	} else if len(l.UIDMappings) > 0 || len(l.GIDMappings) > 0 {
+		return invalid("uid and gid mappings require a user namespace")
+	}
+	if s.Hostname != "" {
+		if _, ok := seen[specs.UTSNamespace]; !ok {
+			return invalid("hostname requires a uts namespace")
+		}
+	}
+	if sc := l.Seccomp; sc != nil {
+		if _, ok := seccompActions[sc.DefaultAction]; !ok {
+			return invalid("unknown seccomp default action %q", sc.DefaultAction)
+		}
+		for _, sys := range sc.Syscalls {
+			if len(sys.Names) == 0 {
+				return invalid("seccomp rule without syscall names")
+			}
+			if _, ok := seccompActions[sys.Action]; !ok {
+				return invalid("unknown seccomp action %q", sys.Action)
+			}
+		}
+	}
+	return nil
+}
+
+func invalid(format string, args ...interface{}) error {
+	return errors.Wrapf(errdefs.ErrInvalidArgument, format, args...)
+}
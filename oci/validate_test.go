@@ -0,0 +1,93 @@
+//go:build !windows
+// +build !windows
+
+package oci
+
+import (
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/opencontainers/image-spec/specs-go/v1"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestGenerateValid(t *testing.T) {
+	s, err := Generate(
+		WithImageConfig(v1.ImageConfig{
+			Env:        []string{"PATH=/bin", "HOME=/root"},
+			Entrypoint: []string{"redis-server"},
+			User:       "999:999",
+			WorkingDir: "/data",
+		}),
+		WithEnv([]string{"HOME=/data"}),
+		WithUser("1000"),
+		WithMounts([]specs.Mount{{Destination: "/run", Type: "bind", Source: "/tmp", Options: []string{"rbind"}}}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Validate(s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Process.User.UID != 1000 || s.Process.User.GID != 1000 {
+		t.Errorf("unexpected user %d:%d", s.Process.User.UID, s.Process.User.GID)
+	}
+	if s.Process.Cwd != "/data" {
+		t.Errorf("unexpected cwd %q", s.Process.Cwd)
+	}
+	var homes int
+	for _, e := range s.Process.Env {
+		if e == "HOME=/root" {
+			t.Error("HOME of the image was not replaced")
+		}
+		if e == "HOME=/data" {
+			homes++
+		}
+	}
+	if homes != 1 {
+		t.Errorf("expected HOME=/data once, got %d", homes)
+	}
+	var runs int
+	for _, m := range s.Mounts {
+		if m.Destination == "/run" {
+			runs++
+			if m.Type != "bind" {
+				t.Errorf("default /run mount was not replaced")
+			}
+		}
+	}
+	if runs != 1 {
+		t.Errorf("expected a single /run mount, got %d", runs)
+	}
+}
+
+func TestValidateInvalid(t *testing.T) {
+	for name, opt := range map[string]Opt{
+		"no args":    WithArgs(nil),
+		"capability": WithCapabilities([]string{"CAP_FLY"}),
+		"relative cwd": func(s *specs.Spec) error {
+			s.Process.Cwd = "data"
+			return nil
+		},
+		"relative mount": WithMounts([]specs.Mount{{Destination: "data", Type: "tmpfs"}}),
+		"mappings without userns": func(s *specs.Spec) error {
+			s.Linux.UIDMappings = []specs.LinuxIDMapping{{HostID: 1000, Size: 1}}
+			return nil
+		},
+		"seccomp action": WithSeccomp(&specs.LinuxSeccomp{DefaultAction: "SCMP_ACT_MAYBE"}),
+	} {
+		s, err := Generate(WithArgs([]string{"sh"}), opt)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if err := Validate(s); !errdefs.IsInvalidArgument(err) {
+			t.Errorf("%s: expected invalid argument, got %v", name, err)
+		}
+	}
+}
+
+func TestWithUserInvalid(t *testing.T) {
+	if _, err := Generate(WithUser("redis")); !errdefs.IsInvalidArgument(err) {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+}
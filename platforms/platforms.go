@@ -0,0 +1,88 @@
+// Package platforms parses and matches the platforms images are built for,
+// written as "os/architecture[/variant]", such as "linux/amd64" or
+// "linux/arm/v7".
+package platforms
+
+import (
+	"runtime"
+	"strings"
+
+	"github.com/containerd/containerd/errdefs"
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// Default returns the platform of the host, which images are pulled and
+// run for unless another is given
+func Default() string {
+	return Format(Normalize(specs.Platform{
+		OS:           runtime.GOOS,
+		Architecture: runtime.GOARCH,
+	}))
+}
+
+// Parse parses a platform specifier. The architecture may be given with
+// common aliases, such as "x86_64" or "aarch64", and is normalized.
+func Parse(specifier string) (specs.Platform, error) {
+	parts := strings.Split(specifier, "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return specs.Platform{}, errors.Wrapf(errdefs.ErrInvalidArgument, "platform %q must be os/architecture[/variant]", specifier)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return specs.Platform{}, errors.Wrapf(errdefs.ErrInvalidArgument, "platform %q has an empty component", specifier)
+		}
+	}
+	p := specs.Platform{
+		OS:           parts[0],
+		Architecture: parts[1],
+	}
+	if len(parts) == 3 {
+		p.Variant = parts[2]
+	}
+	return Normalize(p), nil
+}
+
+// Format returns the specifier of the platform
+func Format(p specs.Platform) string {
+	s := p.OS + "/" + p.Architecture
+	if p.Variant != "" {
+		s += "/" + p.Variant
+	}
+	return s
+}
+
+// Normalize lowercases the os and architecture of the platform and maps
+// architecture aliases to their Go names
+func Normalize(p specs.Platform) specs.Platform {
+	p.OS = strings.ToLower(p.OS)
+	p.Architecture = strings.ToLower(p.Architecture)
+	p.Variant = strings.ToLower(p.Variant)
+	switch p.Architecture {
+	case "x86_64", "x86-64":
+		p.Architecture = "amd64"
+	case "i386":
+		p.Architecture = "386"
+	case "aarch64":
+		p.Architecture = "arm64"
+	case "armhf":
+		p.Architecture, p.Variant = "arm", "v7"
+	case "armel":
+		p.Architecture, p.Variant = "arm", "v6"
+	}
+	// arm64 only has one variant
+	if p.Architecture == "arm64" && p.Variant == "v8" {
+		p.Variant = ""
+	}
+	return p
+}
+
+// Match returns true if an image built for the platform runs on want. The
+// variant is only compared when both have one.
+func Match(want, platform specs.Platform) bool {
+	want, platform = Normalize(want), Normalize(platform)
+	if want.OS != platform.OS || want.Architecture != platform.Architecture {
+		return false
+	}
+	return want.Variant == "" || platform.Variant == "" || want.Variant == platform.Variant
+}
@@ -0,0 +1,54 @@
+package platforms
+
+import (
+	"testing"
+
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		specifier string
+		expected  string
+	}{
+		{"linux/amd64", "linux/amd64"},
+		{"Linux/x86_64", "linux/amd64"},
+		{"linux/aarch64", "linux/arm64"},
+		{"linux/arm64/v8", "linux/arm64"},
+		{"linux/armhf", "linux/arm/v7"},
+		{"linux/arm/v6", "linux/arm/v6"},
+		{"windows/amd64", "windows/amd64"},
+	} {
+		p, err := Parse(tc.specifier)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.specifier, err)
+		}
+		if actual := Format(p); actual != tc.expected {
+			t.Errorf("%s: expected %s, got %s", tc.specifier, tc.expected, actual)
+		}
+	}
+	for _, specifier := range []string{"", "linux", "linux/", "/amd64", "linux/arm/v7/extra"} {
+		if _, err := Parse(specifier); err == nil {
+			t.Errorf("%q: expected an error", specifier)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	for _, tc := range []struct {
+		want, platform specs.Platform
+		expected       bool
+	}{
+		{specs.Platform{OS: "linux", Architecture: "amd64"}, specs.Platform{OS: "linux", Architecture: "amd64"}, true},
+		{specs.Platform{OS: "linux", Architecture: "amd64"}, specs.Platform{OS: "linux", Architecture: "x86_64"}, true},
+		{specs.Platform{OS: "linux", Architecture: "amd64"}, specs.Platform{OS: "linux", Architecture: "arm64"}, false},
+		{specs.Platform{OS: "linux", Architecture: "amd64"}, specs.Platform{OS: "windows", Architecture: "amd64"}, false},
+		{specs.Platform{OS: "linux", Architecture: "arm"}, specs.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}, true},
+		{specs.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}, specs.Platform{OS: "linux", Architecture: "arm"}, true},
+		{specs.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}, specs.Platform{OS: "linux", Architecture: "arm", Variant: "v6"}, false},
+	} {
+		if actual := Match(tc.want, tc.platform); actual != tc.expected {
+			t.Errorf("%s on %s: expected %v, got %v", Format(tc.platform), Format(tc.want), tc.expected, actual)
+		}
+	}
+}
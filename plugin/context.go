@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"sync"
 
 	"github.com/containerd/containerd/events"
 	"github.com/containerd/containerd/log"
@@ -12,6 +13,7 @@ import (
 func NewContext(ctx context.Context, plugins map[PluginType]map[string]interface{}, root, state, id string) *InitContext {
 	return &InitContext{
 		plugins: plugins,
+		Meta:    &Meta{Exports: make(map[string]string)},
 		Root:    filepath.Join(root, id),
 		State:   filepath.Join(state, id),
 		Context: log.WithModule(ctx, id),
@@ -25,6 +27,12 @@ type InitContext struct {
 	Context context.Context
 	Config  interface{}
 	Events  *events.Exchange
+	// Meta is filled in by the plugin during initialization to describe
+	// itself to clients of the introspection service
+	Meta *Meta
+	// Plugins records the initialization of all plugins, including those
+	// initialized after this one
+	Plugins *Set
 
 	plugins map[PluginType]map[string]interface{}
 }
@@ -43,3 +51,50 @@ func (i *InitContext) GetAll(t PluginType) (map[string]interface{}, error) {
 	}
 	return p, nil
 }
+
+// CommandExport is the prefix of the exports advertising ctr commands, a
+// plugin exporting "ctr.command.<name>" with the path of a binary has the
+// binary run for "ctr <name>"
+const CommandExport = "ctr.command."
+
+// Meta describes an initialized plugin
+type Meta struct {
+	// Exports are values the plugin makes available to clients, such as the
+	// version of the component it wraps
+	Exports map[string]string
+	// Capabilities are the optional features supported by the plugin
+	Capabilities []string
+}
+
+// Plugin records the result of initializing a registered plugin
+type Plugin struct {
+	Registration *Registration
+	Meta         *Meta
+	// Err is the error returned by the plugin's Init, if any
+	Err error
+}
+
+// Set records the plugins in the order of their initialization
+type Set struct {
+	mu      sync.Mutex
+	ordered []*Plugin
+}
+
+// NewSet returns an empty set of plugins
+func NewSet() *Set {
+	return &Set{}
+}
+
+// Add records the plugin in the set
+func (s *Set) Add(p *Plugin) {
+	s.mu.Lock()
+	s.ordered = append(s.ordered, p)
+	s.mu.Unlock()
+}
+
+// All returns the plugins in the order of their initialization
+func (s *Set) All() []*Plugin {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Plugin(nil), s.ordered...)
+}
@@ -1,6 +1,7 @@
 package plugin
 
 import (
+	"context"
 	"fmt"
 	"sync"
 
@@ -36,16 +37,35 @@ const (
 	DiffPlugin        PluginType = "io.containerd.differ.v1"
 	MetadataPlugin    PluginType = "io.containerd.metadata.v1"
 	ContentPlugin     PluginType = "io.containerd.content.v1"
+	MetricsPlugin     PluginType = "io.containerd.metrics.v1"
+	InternalPlugin    PluginType = "io.containerd.internal.v1"
+	AuthzPlugin       PluginType = "io.containerd.authz.v1"
+	HookPlugin        PluginType = "io.containerd.hook.v1"
+	DevicePlugin      PluginType = "io.containerd.device.v1"
+	AdmissionPlugin   PluginType = "io.containerd.admission.v1"
 )
 
+// Registration describes a plugin and how to initialize it.
+//
+// If the instance returned by Init implements io.Closer, it is closed when the
+// daemon shuts down. Plugins are closed in the reverse order of their
+// initialization so that the plugins an instance requires are still open
+// while it is being closed.
 type Registration struct {
 	Type     PluginType
 	ID       string
 	Config   interface{}
 	Requires []PluginType
 	Init     func(*InitContext) (interface{}, error)
-
-	added bool
+	// Check, if set, verifies the consistency of the state of the plugin
+	// for the check of the daemon. The plugin is not initialized, Check is
+	// given its configuration and directories, without other plugins or
+	// events, and only reads the state so that it can run next to a daemon
+	// serving with the same state.
+	Check func(*InitContext) []CheckResult
+
+	added    bool
+	visiting bool
 }
 
 func (r *Registration) URI() string {
@@ -56,6 +76,30 @@ type Service interface {
 	Register(*grpc.Server) error
 }
 
+// CheckResult is the outcome of verifying an item of the state of a plugin
+type CheckResult struct {
+	// Name identifies the item checked, such as a task
+	Name string
+	// Err is the inconsistency found, nil when the item is consistent
+	Err error
+}
+
+// Flusher is implemented by plugins holding events or data that must be
+// delivered before the daemon shuts down
+type Flusher interface {
+	// Flush delivers the data held by the plugin until the context is done
+	Flush(ctx context.Context) error
+}
+
+// Reloader is implemented by plugins whose configuration can be changed
+// while the daemon runs
+type Reloader interface {
+	// Reload applies the new configuration of the plugin, decoded like the
+	// configuration it was initialized with. It returns the settings of the
+	// plugin that changed but are only applied by a restart of the daemon.
+	Reload(ctx context.Context, config interface{}) (restart []string, err error)
+}
+
 var register = struct {
 	sync.Mutex
 	r []*Registration
@@ -87,9 +131,13 @@ func Register(r *Registration) {
 	register.r = append(register.r, r)
 }
 
+// Graph returns the registrations ordered so that each plugin follows the
+// plugins of the types it requires. A plugin may require other plugins of
+// its own type, it is then ordered after them. When plugins require each
+// other's types, the cycle is broken in registration order.
 func Graph() (ordered []*Registration) {
 	for _, r := range register.r {
-		children(r.Requires, &ordered)
+		children(r, &ordered)
 		if !r.added {
 			ordered = append(ordered, r)
 			r.added = true
@@ -98,11 +146,13 @@ func Graph() (ordered []*Registration) {
 	return ordered
 }
 
-func children(types []PluginType, ordered *[]*Registration) {
-	for _, t := range types {
+func children(reg *Registration, ordered *[]*Registration) {
+	reg.visiting = true
+	defer func() { reg.visiting = false }()
+	for _, t := range reg.Requires {
 		for _, r := range register.r {
-			if r.Type == t {
-				children(r.Requires, ordered)
+			if r.Type == t && r != reg && !r.visiting {
+				children(r, ordered)
 				if !r.added {
 					*ordered = append(*ordered, r)
 					r.added = true
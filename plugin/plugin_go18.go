@@ -28,7 +28,7 @@ func loadPlugins(path string) error {
 	}
 	for _, lib := range libs {
 		if _, err := plugin.Open(lib); err != nil {
-			return err
+			return fmt.Errorf("failed to load plugin %s: %v", lib, err)
 		}
 	}
 	return nil
@@ -8,6 +8,7 @@ import (
 
 	eventsapi "github.com/containerd/containerd/api/services/events/v1"
 	"github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/containerd/containerd/api/types"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/runtime"
 	"github.com/containerd/containerd/typeurl"
@@ -35,6 +36,59 @@ type Process interface {
 	IO() IO
 	// Status returns the executing status of the process
 	Status(context.Context) (Status, error)
+	// UpdateProcess amends the spec of the process before it is started.
+	// The process of a task is only updated while the creation of the task
+	// is deferred, Container.UpdateProcess updating it before the task is
+	// created.
+	UpdateProcess(context.Context, ...UpdateProcessOpts) error
+}
+
+// UpdateProcessInfo holds the changes to the spec of a process that is not
+// started
+type UpdateProcessInfo struct {
+	// Env is added to the environment of the process, replacing the
+	// variables with the same names
+	Env []string
+	// Args replace the args of the process when set
+	Args []string
+	// Cwd replaces the working directory of the process when set
+	Cwd string
+	// Mounts are added to the container, replacing the mounts with the
+	// same destinations. They can only be set for the process of a
+	// container.
+	Mounts []specs.Mount
+}
+
+// UpdateProcessOpts allows a caller to change the spec of a process before
+// it is started
+type UpdateProcessOpts func(context.Context, *Client, *UpdateProcessInfo) error
+
+// updateProcess updates the process of the container, or its exec process
+// when execID is set
+func updateProcess(ctx context.Context, client *Client, containerID, execID string, opts []UpdateProcessOpts) error {
+	var i UpdateProcessInfo
+	for _, o := range opts {
+		if err := o(ctx, client, &i); err != nil {
+			return err
+		}
+	}
+	request := &tasks.UpdateProcessRequest{
+		ContainerID: containerID,
+		ExecID:      execID,
+		Env:         i.Env,
+		Args:        i.Args,
+		Cwd:         i.Cwd,
+	}
+	for _, m := range i.Mounts {
+		request.Mounts = append(request.Mounts, &types.Mount{
+			Type:    m.Type,
+			Source:  m.Source,
+			Target:  m.Destination,
+			Options: m.Options,
+		})
+	}
+	_, err := client.TaskService().UpdateProcess(ctx, request)
+	return errdefs.FromGRPC(err)
 }
 
 // ExitStatus encapsulates a process' exit status.
@@ -42,6 +96,7 @@ type Process interface {
 type ExitStatus struct {
 	code     uint32
 	exitedAt time.Time
+	reason   string
 	err      error
 }
 
@@ -54,6 +109,13 @@ func (s ExitStatus) Result() (uint32, time.Time, error) {
 	return s.code, s.exitedAt, s.err
 }
 
+// Reason returns the reason of the exit set by runtimes translating the exit
+// statuses of their tasks, such as "GuestPanic" for a VM runtime, empty
+// otherwise
+func (s ExitStatus) Reason() string {
+	return s.reason
+}
+
 type process struct {
 	id   string
 	task *task
@@ -88,6 +150,10 @@ func (p *process) Start(ctx context.Context) error {
 	return nil
 }
 
+func (p *process) UpdateProcess(ctx context.Context, opts ...UpdateProcessOpts) error {
+	return updateProcess(ctx, p.task.client, p.task.id, p.id, opts)
+}
+
 func (p *process) Kill(ctx context.Context, s syscall.Signal) error {
 	_, err := p.task.client.TaskService().Kill(ctx, &tasks.KillRequest{
 		Signal:      uint32(s),
@@ -116,7 +182,7 @@ func (p *process) Wait(ctx context.Context) (<-chan ExitStatus, error) {
 	chStatus := make(chan ExitStatus, 1)
 	if status.Status == Stopped {
 		cancel()
-		chStatus <- ExitStatus{code: status.ExitStatus, exitedAt: status.ExitTime}
+		chStatus <- ExitStatus{code: status.ExitStatus, exitedAt: status.ExitTime, reason: status.ExitReason}
 		return chStatus, nil
 	}
 
@@ -137,7 +203,7 @@ func (p *process) Wait(ctx context.Context) (<-chan ExitStatus, error) {
 				}
 				e := v.(*eventsapi.TaskExit)
 				if e.ID == p.id && e.ContainerID == p.task.id {
-					chStatus <- ExitStatus{code: e.ExitStatus, exitedAt: e.ExitedAt}
+					chStatus <- ExitStatus{code: e.ExitStatus, exitedAt: e.ExitedAt, reason: e.ExitReason}
 					return
 				}
 			}
@@ -215,5 +281,6 @@ func (p *process) Status(ctx context.Context) (Status, error) {
 	return Status{
 		Status:     ProcessStatus(strings.ToLower(r.Process.Status.String())),
 		ExitStatus: r.Process.ExitStatus,
+		ExitReason: r.Process.ExitReason,
 	}, nil
 }
@@ -0,0 +1,42 @@
+package profiles
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os/exec"
+	"strings"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+)
+
+// appArmorEnabled returns whether AppArmor is enabled in the kernel
+func appArmorEnabled() bool {
+	p, err := ioutil.ReadFile("/sys/module/apparmor/parameters/enabled")
+	return err == nil && strings.HasPrefix(string(p), "Y")
+}
+
+// loadAppArmor loads or replaces the profile of the text in the kernel with
+// apparmor_parser
+func loadAppArmor(data []byte) error {
+	return appArmorParser(data, "--replace")
+}
+
+// unloadAppArmor removes the profile of the text from the kernel
+func unloadAppArmor(data []byte) error {
+	return appArmorParser(data, "--remove")
+}
+
+func appArmorParser(data []byte, action string) error {
+	if !appArmorEnabled() {
+		return errors.Wrap(errdefs.ErrFailedPrecondition, "apparmor is not enabled")
+	}
+	// the profile is not cached as it is loaded again when the daemon
+	// starts
+	cmd := exec.Command("apparmor_parser", action, "--skip-cache")
+	cmd.Stdin = bytes.NewReader(data)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return errors.Errorf("apparmor_parser %s: %v: %s", action, err, bytes.TrimSpace(out))
+	}
+	return nil
+}
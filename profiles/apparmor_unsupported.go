@@ -0,0 +1,16 @@
+// +build !linux
+
+package profiles
+
+import (
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+)
+
+func loadAppArmor(data []byte) error {
+	return errors.Wrap(errdefs.ErrNotImplemented, "apparmor is only supported on linux")
+}
+
+func unloadAppArmor(data []byte) error {
+	return errors.Wrap(errdefs.ErrNotImplemented, "apparmor is only supported on linux")
+}
@@ -0,0 +1,111 @@
+package profiles
+
+import (
+	"io/ioutil"
+	"sort"
+
+	"github.com/containerd/containerd/hooks"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/plugin"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type:   plugin.HookPlugin,
+		ID:     "security-profiles",
+		Config: &Config{},
+		Init:   New,
+	})
+}
+
+// Config registers profiles from files by name
+type Config struct {
+	// Seccomp are the paths of the JSON files of seccomp profiles by name
+	Seccomp map[string]string `toml:"seccomp"`
+	// AppArmor are the paths of AppArmor profiles by name, loaded into the
+	// kernel when the daemon starts
+	AppArmor map[string]string `toml:"apparmor"`
+}
+
+// New returns the profiles hook with the store of the profiles of the
+// plugin's root and configuration. AppArmor profiles of the configuration
+// that cannot be loaded, such as when AppArmor is disabled, are skipped.
+func New(ic *plugin.InitContext) (interface{}, error) {
+	store, err := NewStore(ic.Context, ic.Root)
+	if err != nil {
+		return nil, err
+	}
+	config := ic.Config.(*Config)
+	for _, kind := range Kinds {
+		paths := config.Seccomp
+		if kind == AppArmor {
+			paths = config.AppArmor
+		}
+		names := make([]string, 0, len(paths))
+		for name := range paths {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			data, err := ioutil.ReadFile(paths[name])
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read %s profile %s", kind, name)
+			}
+			if _, err := store.Register(Profile{
+				Kind:   kind,
+				Name:   name,
+				Data:   data,
+				Config: true,
+			}); err != nil {
+				if kind == AppArmor {
+					log.G(ic.Context).WithError(err).Warnf("skipping apparmor profile %s", name)
+					continue
+				}
+				return nil, errors.Wrapf(err, "invalid %s profile %s", kind, name)
+			}
+		}
+	}
+	return &Hook{
+		Store: store,
+	}, nil
+}
+
+// Hook sets the profiles referenced by the labels of containers in the spec
+// of their tasks
+type Hook struct {
+	Store *Store
+}
+
+// Invoke returns the adjustment setting the profiles of the container at
+// the pre-create point, vetoing the creation when they are not registered
+func (h *Hook) Invoke(ctx context.Context, r *hooks.Request) (*hooks.Adjustment, error) {
+	if r.Point != hooks.PreCreate {
+		return nil, nil
+	}
+	var (
+		seccomp  = r.Labels[SeccompLabel]
+		apparmor = r.Labels[AppArmorLabel]
+	)
+	if seccomp == "" && apparmor == "" {
+		return nil, nil
+	}
+	var a hooks.Adjustment
+	if seccomp != "" {
+		p, err := h.Store.Get(Seccomp, seccomp)
+		if err != nil {
+			return nil, err
+		}
+		if a.Seccomp, err = ParseSeccomp(p.Data); err != nil {
+			return nil, err
+		}
+	}
+	if apparmor != "" {
+		if _, err := h.Store.Get(AppArmor, apparmor); err != nil {
+			return nil, err
+		}
+		a.ApparmorProfile = apparmor
+	}
+	return &a, nil
+}
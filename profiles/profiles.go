@@ -0,0 +1,283 @@
+// Package profiles registers the seccomp and AppArmor profiles of the daemon
+// by name.
+//
+// Profiles are registered from the configuration of the profiles plugin or
+// with the profiles service, and containers reference them with the
+// SeccompLabel and AppArmorLabel labels. The profiles hook sets the profiles
+// referenced in the spec of the task of the container before it is created.
+package profiles
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/identifiers"
+	"github.com/containerd/containerd/log"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+const (
+	// SeccompLabel is the label of the containers holding the name of the
+	// seccomp profile of their tasks
+	SeccompLabel = "containerd.io/profiles/seccomp"
+	// AppArmorLabel is the label of the containers holding the name of the
+	// AppArmor profile of their tasks
+	AppArmorLabel = "containerd.io/profiles/apparmor"
+)
+
+// Kind is the kind of a profile
+type Kind string
+
+const (
+	// Seccomp profiles are the JSON of the seccomp section of the linux
+	// runtime spec
+	Seccomp Kind = "seccomp"
+	// AppArmor profiles are the text of an AppArmor profile loaded into the
+	// kernel
+	AppArmor Kind = "apparmor"
+)
+
+// Kinds are the kinds of profiles
+var Kinds = []Kind{Seccomp, AppArmor}
+
+// Profile is a profile registered by name
+type Profile struct {
+	Kind Kind
+	Name string
+	Data []byte
+	// Config is set for the profiles of the configuration, which cannot be
+	// replaced nor deleted
+	Config    bool
+	CreatedAt time.Time
+}
+
+// Store keeps the profiles of the configuration in memory and those
+// registered with the API under its root, at <root>/<kind>/<name>, so that
+// they are registered again when the daemon restarts
+type Store struct {
+	root string
+	// load and unload load AppArmor profiles into and unload them from the
+	// kernel
+	load, unload func([]byte) error
+
+	mu       sync.Mutex
+	profiles map[Kind]map[string]Profile
+}
+
+// NewStore returns the store of the profiles registered under root,
+// loading the AppArmor profiles among them. AppArmor profiles that fail to
+// load are kept registered so that they can be deleted.
+func NewStore(ctx context.Context, root string) (*Store, error) {
+	s := &Store{
+		root:     root,
+		load:     loadAppArmor,
+		unload:   unloadAppArmor,
+		profiles: make(map[Kind]map[string]Profile),
+	}
+	for _, kind := range Kinds {
+		s.profiles[kind] = make(map[string]Profile)
+		if err := os.MkdirAll(filepath.Join(root, string(kind)), 0700); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.restore(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Store) restore(ctx context.Context) error {
+	for _, kind := range Kinds {
+		dir := filepath.Join(s.root, string(kind))
+		entries, err := ioutil.ReadDir(dir)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if strings.HasPrefix(e.Name(), ".") {
+				continue
+			}
+			data, err := ioutil.ReadFile(filepath.Join(dir, e.Name()))
+			if err != nil {
+				return err
+			}
+			if kind == AppArmor {
+				if err := s.load(data); err != nil {
+					log.G(ctx).WithError(err).Warnf("failed to load apparmor profile %s", e.Name())
+				}
+			}
+			s.profiles[kind][e.Name()] = Profile{
+				Kind:      kind,
+				Name:      e.Name(),
+				Data:      data,
+				CreatedAt: e.ModTime(),
+			}
+		}
+	}
+	return nil
+}
+
+// Get returns the profile of the kind with the name
+func (s *Store) Get(kind Kind, name string) (Profile, error) {
+	if err := validateKind(kind); err != nil {
+		return Profile{}, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.profiles[kind][name]
+	if !ok {
+		return Profile{}, errors.Wrapf(errdefs.ErrNotFound, "%s profile %q", kind, name)
+	}
+	return p, nil
+}
+
+// List returns the profiles of the kind, of all kinds when it is empty, in
+// the order of their kind and name
+func (s *Store) List(kind Kind) ([]Profile, error) {
+	kinds := Kinds
+	if kind != "" {
+		if err := validateKind(kind); err != nil {
+			return nil, err
+		}
+		kinds = []Kind{kind}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []Profile
+	for _, k := range kinds {
+		start := len(out)
+		for _, p := range s.profiles[k] {
+			out = append(out, p)
+		}
+		ps := out[start:]
+		sort.Slice(ps, func(i, j int) bool {
+			return ps[i].Name < ps[j].Name
+		})
+	}
+	return out, nil
+}
+
+// Register validates the profile and registers it, loading AppArmor
+// profiles into the kernel. Profiles registered with the API replace those
+// registered before with the same kind and name.
+func (s *Store) Register(p Profile) (Profile, error) {
+	if err := Validate(p); err != nil {
+		return Profile{}, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.profiles[p.Kind][p.Name]; ok && existing.Config {
+		return Profile{}, errors.Wrapf(errdefs.ErrFailedPrecondition, "%s profile %q is set by the configuration", p.Kind, p.Name)
+	}
+	if p.Kind == AppArmor {
+		if err := s.load(p.Data); err != nil {
+			return Profile{}, err
+		}
+	}
+	p.CreatedAt = time.Now().UTC()
+	if !p.Config {
+		if err := writeFile(filepath.Join(s.root, string(p.Kind), p.Name), p.Data); err != nil {
+			return Profile{}, err
+		}
+	}
+	s.profiles[p.Kind][p.Name] = p
+	return p, nil
+}
+
+// Delete deletes a profile registered with the API, unloading AppArmor
+// profiles from the kernel
+func (s *Store) Delete(kind Kind, name string) error {
+	if err := validateKind(kind); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.profiles[kind][name]
+	if !ok {
+		return errors.Wrapf(errdefs.ErrNotFound, "%s profile %q", kind, name)
+	}
+	if p.Config {
+		return errors.Wrapf(errdefs.ErrFailedPrecondition, "%s profile %q is set by the configuration", kind, name)
+	}
+	if err := os.Remove(filepath.Join(s.root, string(kind), name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	delete(s.profiles[kind], name)
+	if kind == AppArmor {
+		// the profile stays loaded while processes are confined by it
+		if err := s.unload(p.Data); err != nil {
+			return errors.Wrapf(err, "failed to unload apparmor profile %s", name)
+		}
+	}
+	return nil
+}
+
+// Validate checks the name and data of the profile, seccomp profiles must
+// set their default action and AppArmor profiles declare the profile of
+// their name
+func Validate(p Profile) error {
+	if err := validateKind(p.Kind); err != nil {
+		return err
+	}
+	if err := identifiers.Validate(p.Name); err != nil {
+		return err
+	}
+	switch p.Kind {
+	case Seccomp:
+		if _, err := ParseSeccomp(p.Data); err != nil {
+			return err
+		}
+	case AppArmor:
+		declared := regexp.MustCompile(fmt.Sprintf(`(?m)^\s*profile\s+%s[\s{]`, regexp.QuoteMeta(p.Name)))
+		if !declared.Match(p.Data) {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "apparmor profile does not declare the profile %s", p.Name)
+		}
+	}
+	return nil
+}
+
+// ParseSeccomp returns the seccomp profile of the data
+func ParseSeccomp(data []byte) (*specs.LinuxSeccomp, error) {
+	var seccomp specs.LinuxSeccomp
+	if err := json.Unmarshal(data, &seccomp); err != nil {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid seccomp profile: %v", err)
+	}
+	if seccomp.DefaultAction == "" {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, "seccomp profile has no default action")
+	}
+	return &seccomp, nil
+}
+
+func validateKind(kind Kind) error {
+	for _, k := range Kinds {
+		if k == kind {
+			return nil
+		}
+	}
+	return errors.Wrapf(errdefs.ErrInvalidArgument, "unknown profile kind %q", kind)
+}
+
+// writeFile replaces the file atomically, writing it first to a hidden file
+// that is not restored
+func writeFile(path string, data []byte) error {
+	tmp := filepath.Join(filepath.Dir(path), "."+filepath.Base(path))
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
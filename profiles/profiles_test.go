@@ -0,0 +1,134 @@
+package profiles
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/hooks"
+	"golang.org/x/net/context"
+)
+
+const (
+	testSeccomp  = `{"defaultAction":"SCMP_ACT_ERRNO","syscalls":[{"names":["read"],"action":"SCMP_ACT_ALLOW"}]}`
+	testAppArmor = "#include <tunables/global>\nprofile restricted flags=(attach_disconnected) {\n  file,\n}\n"
+)
+
+// newTestStore returns a store recording the AppArmor profiles loaded
+func newTestStore(t *testing.T, root string, loaded map[string]bool) *Store {
+	s, err := NewStore(context.Background(), root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.load = func(data []byte) error {
+		loaded[string(data)] = true
+		return nil
+	}
+	s.unload = func(data []byte) error {
+		delete(loaded, string(data))
+		return nil
+	}
+	return s
+}
+
+func TestStore(t *testing.T) {
+	root, err := ioutil.TempDir("", "profiles-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	loaded := make(map[string]bool)
+	s := newTestStore(t, root, loaded)
+	if _, err := s.Register(Profile{Kind: Seccomp, Name: "strict", Data: []byte(testSeccomp)}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Register(Profile{Kind: AppArmor, Name: "restricted", Data: []byte(testAppArmor)}); err != nil {
+		t.Fatal(err)
+	}
+	if !loaded[testAppArmor] {
+		t.Fatal("expected the apparmor profile to be loaded")
+	}
+	if _, err := s.Register(Profile{Kind: Seccomp, Name: "default", Data: []byte(testSeccomp), Config: true}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Register(Profile{Kind: Seccomp, Name: "default", Data: []byte(testSeccomp)}); !errdefs.IsFailedPrecondition(err) {
+		t.Fatalf("expected the profile of the configuration not to be replaced, got %v", err)
+	}
+	if err := s.Delete(Seccomp, "default"); !errdefs.IsFailedPrecondition(err) {
+		t.Fatalf("expected the profile of the configuration not to be deleted, got %v", err)
+	}
+
+	// the profiles registered with the api are restored
+	restored := newTestStore(t, root, loaded)
+	list, err := restored.List("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 || list[0].Name != "strict" || list[1].Name != "restricted" {
+		t.Fatalf("unexpected profiles %+v", list)
+	}
+	if err := restored.Delete(AppArmor, "restricted"); err != nil {
+		t.Fatal(err)
+	}
+	if loaded[testAppArmor] {
+		t.Fatal("expected the apparmor profile to be unloaded")
+	}
+	if _, err := restored.Get(AppArmor, "restricted"); !errdefs.IsNotFound(err) {
+		t.Fatalf("expected the profile to be deleted, got %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, p := range []Profile{
+		{Kind: "selinux", Name: "strict", Data: []byte(testSeccomp)},
+		{Kind: Seccomp, Name: "../strict", Data: []byte(testSeccomp)},
+		{Kind: Seccomp, Name: "strict", Data: []byte(`{"syscalls":[]}`)},
+		{Kind: Seccomp, Name: "strict", Data: []byte(`not json`)},
+		{Kind: AppArmor, Name: "other", Data: []byte(testAppArmor)},
+	} {
+		if err := Validate(p); !errdefs.IsInvalidArgument(err) {
+			t.Errorf("expected %s profile %q to be invalid, got %v", p.Kind, p.Name, err)
+		}
+	}
+}
+
+func TestHook(t *testing.T) {
+	root, err := ioutil.TempDir("", "profiles-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	s := newTestStore(t, root, make(map[string]bool))
+	if _, err := s.Register(Profile{Kind: Seccomp, Name: "strict", Data: []byte(testSeccomp)}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Register(Profile{Kind: AppArmor, Name: "restricted", Data: []byte(testAppArmor)}); err != nil {
+		t.Fatal(err)
+	}
+	h := &Hook{Store: s}
+	a, err := h.Invoke(context.Background(), &hooks.Request{
+		Point: hooks.PreCreate,
+		Labels: map[string]string{
+			SeccompLabel:  "strict",
+			AppArmorLabel: "restricted",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Seccomp == nil || a.Seccomp.DefaultAction != "SCMP_ACT_ERRNO" || len(a.Seccomp.Syscalls) != 1 {
+		t.Fatalf("unexpected seccomp profile %+v", a.Seccomp)
+	}
+	if a.ApparmorProfile != "restricted" {
+		t.Fatalf("unexpected apparmor profile %q", a.ApparmorProfile)
+	}
+	if _, err := h.Invoke(context.Background(), &hooks.Request{
+		Point:  hooks.PreCreate,
+		Labels: map[string]string{SeccompLabel: "unknown"},
+	}); !errdefs.IsNotFound(err) {
+		t.Fatalf("expected an unknown profile to veto the creation, got %v", err)
+	}
+}
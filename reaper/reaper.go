@@ -7,44 +7,88 @@ import (
 	"fmt"
 	"os/exec"
 	"sync"
+	"time"
 
 	"github.com/containerd/containerd/sys"
 	"github.com/pkg/errors"
 )
 
+// subscriberBuffer is the number of exits buffered for each subscriber, as
+// the reaper blocks while a subscriber is full
+const subscriberBuffer = 2048
+
+// Exit is the exit of a process collected by the reaper
+type Exit struct {
+	Pid    int
+	Status int
+	// Timestamp is the time the exit was collected
+	Timestamp time.Time
+}
+
 // Reap should be called when the process receives an SIGCHLD.  Reap will reap
 // all exited processes and close their wait channels
 func Reap() error {
 	exits, err := sys.Reap(false)
+	now := time.Now()
 	for _, e := range exits {
 		Default.Lock()
 		c, ok := Default.cmds[e.Pid]
-		if !ok {
-			Default.unknown[e.Pid] = e.Status
-			Default.Unlock()
-			continue
-		}
 		Default.Unlock()
-		if c.c != nil {
-			// after we get an exit, call wait on the go process to make sure all
-			// pipes are closed and finalizers are run on the process
-			c.c.Wait()
+		if ok {
+			if c.c != nil {
+				// after we get an exit, call wait on the go process to make sure all
+				// pipes are closed and finalizers are run on the process
+				c.c.Wait()
+			}
+			c.ExitCh <- e.Status
 		}
-		c.ExitCh <- e.Status
+		Default.notify(Exit{
+			Pid:       e.Pid,
+			Status:    e.Status,
+			Timestamp: now,
+		})
 	}
 	return err
 }
 
 var Default = &Monitor{
-	cmds:    make(map[int]*Cmd),
-	unknown: make(map[int]int),
+	cmds:        make(map[int]*Cmd),
+	subscribers: make(map[chan Exit]struct{}),
 }
 
 type Monitor struct {
 	sync.Mutex
 
-	cmds    map[int]*Cmd
-	unknown map[int]int
+	cmds map[int]*Cmd
+
+	subscribers map[chan Exit]struct{}
+}
+
+// Subscribe returns a channel receiving every exit collected by the reaper,
+// so that the exits of many processes are handled by a single goroutine
+// instead of one waiting for each process
+func (m *Monitor) Subscribe() chan Exit {
+	c := make(chan Exit, subscriberBuffer)
+	m.Lock()
+	m.subscribers[c] = struct{}{}
+	m.Unlock()
+	return c
+}
+
+// Unsubscribe stops the delivery of exits to the channel and closes it
+func (m *Monitor) Unsubscribe(c chan Exit) {
+	m.Lock()
+	delete(m.subscribers, c)
+	m.Unlock()
+	close(c)
+}
+
+func (m *Monitor) notify(e Exit) {
+	m.Lock()
+	for c := range m.subscribers {
+		c <- e
+	}
+	m.Unlock()
 }
 
 func (m *Monitor) Output(c *exec.Cmd) ([]byte, error) {
@@ -93,6 +137,9 @@ func (m *Monitor) Wait(c *exec.Cmd) (int, error) {
 	return m.WaitPid(c.Process.Pid)
 }
 
+// Register registers the process for its exit to be sent on the channel of
+// the command. Exits of processes that are not registered are only sent to
+// the subscribers.
 func (m *Monitor) Register(pid int, c *Cmd) {
 	m.Lock()
 	m.RegisterNL(pid, c)
@@ -102,12 +149,6 @@ func (m *Monitor) Register(pid int, c *Cmd) {
 // RegisterNL does not grab the lock internally
 // the caller is responsible for locking the monitor
 func (m *Monitor) RegisterNL(pid int, c *Cmd) {
-	if status, ok := m.unknown[pid]; ok {
-		delete(m.unknown, pid)
-		m.cmds[pid] = c
-		c.ExitCh <- status
-		return
-	}
 	m.cmds[pid] = c
 }
 
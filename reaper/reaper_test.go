@@ -0,0 +1,42 @@
+// +build !windows
+
+package reaper
+
+import (
+	"os/exec"
+	"testing"
+	"time"
+)
+
+func TestSubscribe(t *testing.T) {
+	exits := Default.Subscribe()
+	defer Default.Unsubscribe(exits)
+
+	cmd := exec.Command("sh", "-c", "exit 3")
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	timeout := time.After(10 * time.Second)
+	for {
+		if err := Reap(); err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case e := <-exits:
+			if e.Pid != cmd.Process.Pid {
+				continue
+			}
+			if e.Status != 3 {
+				t.Fatalf("expected exit status 3, got %d", e.Status)
+			}
+			if e.Timestamp.Before(start) {
+				t.Fatalf("expected the exit to be stamped after the start, got %v", e.Timestamp)
+			}
+			return
+		case <-timeout:
+			t.Fatal("exit not delivered to the subscriber")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
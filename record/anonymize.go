@@ -0,0 +1,171 @@
+package record
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+var (
+	// hashedFields are the string fields holding identifiers and names,
+	// whose values are hashed
+	hashedFields = map[string]bool{
+		"ID":          true,
+		"ContainerID": true,
+		"ExecID":      true,
+		"Name":        true,
+		"Namespace":   true,
+		"Image":       true,
+		"Key":         true,
+		"Parent":      true,
+		"RootFS":      true,
+		"Ref":         true,
+	}
+	// stdioFields are the string fields holding paths of stdio on the host
+	// of the daemon, which are cleared
+	stdioFields = map[string]bool{
+		"Stdin":  true,
+		"Stdout": true,
+		"Stderr": true,
+	}
+	// keptStructs are the structs whose fields are not anonymized, as they
+	// select the behavior of the daemon rather than identify workloads
+	keptStructs = map[string]bool{
+		"Container_Runtime": true,
+	}
+)
+
+// keptLabelPrefix prefixes the labels of containerd whose values are kept
+const keptLabelPrefix = "containerd.io/"
+
+// Anonymizer replaces the identifiers, the label values, the stdio paths,
+// the data and the environment values of specs of messages with values
+// hashed with a key, so that a trace keeps the relations between its calls
+// without revealing the workloads of the daemon
+type Anonymizer struct {
+	key []byte
+}
+
+// NewAnonymizer returns an anonymizer hashing values with the key
+func NewAnonymizer(key []byte) *Anonymizer {
+	return &Anonymizer{key: key}
+}
+
+// Value returns the anonymized value, the same for equal values
+func (a *Anonymizer) Value(s string) string {
+	if a == nil || s == "" {
+		return s
+	}
+	mac := hmac.New(sha256.New, a.key)
+	mac.Write([]byte(s))
+	return "anon-" + hex.EncodeToString(mac.Sum(nil))[:20]
+}
+
+// Anonymize anonymizes the message in place
+func (a *Anonymizer) Anonymize(v interface{}) {
+	a.walk(reflect.ValueOf(v))
+}
+
+func (a *Anonymizer) walk(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		if any, ok := v.Interface().(*types.Any); ok {
+			a.any(any)
+			return
+		}
+		a.walk(v.Elem())
+	case reflect.Struct:
+		t := v.Type()
+		if keptStructs[t.Name()] {
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			f, name := v.Field(i), t.Field(i).Name
+			if !f.CanSet() {
+				continue
+			}
+			switch {
+			case f.Kind() == reflect.String && hashedFields[name]:
+				f.SetString(a.Value(f.String()))
+			case f.Kind() == reflect.String && stdioFields[name]:
+				f.SetString("")
+			case f.Kind() == reflect.Slice && f.Type().Elem().Kind() == reflect.Uint8:
+				if name == "Data" {
+					f.SetBytes(make([]byte, f.Len()))
+				}
+			case f.Kind() == reflect.Map && name == "Labels":
+				a.labels(f)
+			default:
+				a.walk(f)
+			}
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			a.walk(v.Index(i))
+		}
+	}
+}
+
+// labels hashes the values of the labels other than those of containerd
+func (a *Anonymizer) labels(v reflect.Value) {
+	labels, ok := v.Interface().(map[string]string)
+	if !ok {
+		return
+	}
+	for k, value := range labels {
+		if !strings.HasPrefix(k, keptLabelPrefix) {
+			labels[k] = a.Value(value)
+		}
+	}
+}
+
+// any anonymizes the environment of the specs and processes of containers,
+// other values being kept
+func (a *Anonymizer) any(any *types.Any) {
+	if !strings.Contains(any.TypeUrl, "opencontainers/runtime-spec") {
+		return
+	}
+	var (
+		process *specs.Process
+		v       interface{}
+	)
+	switch {
+	case strings.HasSuffix(any.TypeUrl, "/Spec"):
+		var spec specs.Spec
+		if err := json.Unmarshal(any.Value, &spec); err != nil {
+			return
+		}
+		v, process = &spec, spec.Process
+	case strings.HasSuffix(any.TypeUrl, "/Process"):
+		var p specs.Process
+		if err := json.Unmarshal(any.Value, &p); err != nil {
+			return
+		}
+		v, process = &p, &p
+	default:
+		return
+	}
+	if process == nil {
+		return
+	}
+	for i, env := range process.Env {
+		if parts := strings.SplitN(env, "=", 2); len(parts) == 2 {
+			process.Env[i] = parts[0] + "=" + a.Value(parts[1])
+		}
+	}
+	if data, err := json.Marshal(v); err == nil {
+		any.Value = data
+	}
+}
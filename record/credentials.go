@@ -0,0 +1,51 @@
+package record
+
+import "reflect"
+
+// credentialFields are the string fields holding credentials, such as those
+// of registries, which are cleared from every trace, anonymized or not
+var credentialFields = map[string]bool{
+	"Username":      true,
+	"Password":      true,
+	"Secret":        true,
+	"Auth":          true,
+	"IdentityToken": true,
+	"RegistryToken": true,
+}
+
+// clearCredentials clears the credential fields of the message in place
+func clearCredentials(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			clearCredentials(v.Elem())
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Field(i)
+			if !f.CanSet() {
+				continue
+			}
+			if f.Kind() == reflect.String && credentialFields[t.Field(i).Name] {
+				f.SetString("")
+				continue
+			}
+			clearCredentials(f)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			clearCredentials(v.Index(i))
+		}
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			// map values cannot be set in place, only messages they point to
+			if e := v.MapIndex(k); e.Kind() == reflect.Ptr {
+				clearCredentials(e)
+			}
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Package record records the unary calls of the GRPC API of the daemon to
+// traces and replays them, so that traffic of real deployments can be turned
+// into regression tests of the services.
+package record
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/namespaces"
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Record is a call of a trace, written as a line of JSON
+type Record struct {
+	Time      time.Time     `json:"time"`
+	Method    string        `json:"method"`
+	Namespace string        `json:"namespace,omitempty"`
+	Duration  time.Duration `json:"duration"`
+	// RequestType and ResponseType are the names of the protobuf messages
+	// of the call, Request and Response their encoding
+	RequestType  string `json:"request_type"`
+	Request      []byte `json:"request"`
+	ResponseType string `json:"response_type,omitempty"`
+	Response     []byte `json:"response,omitempty"`
+	// Code is the GRPC status code of the call
+	Code codes.Code `json:"code"`
+	// Error is the error message of the call, which is not recorded in
+	// anonymized traces
+	Error string `json:"error,omitempty"`
+}
+
+// Recorder writes the unary calls of the daemon to a trace
+type Recorder struct {
+	anonymizer *Anonymizer
+	methods    []string
+
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewRecorder returns a recorder writing the calls of the methods with the
+// prefixes, or of all methods when there are none, to w. The calls are
+// anonymized by the anonymizer, unless it is nil.
+func NewRecorder(w io.Writer, anonymizer *Anonymizer, methods []string) *Recorder {
+	return &Recorder{
+		anonymizer: anonymizer,
+		methods:    methods,
+		enc:        json.NewEncoder(w),
+	}
+}
+
+// Records returns whether the calls of the method are recorded
+func (r *Recorder) Records(method string) bool {
+	if len(r.methods) == 0 {
+		return true
+	}
+	for _, m := range r.methods {
+		if strings.HasPrefix(method, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// Record writes the call of the method to the trace
+func (r *Recorder) Record(ctx context.Context, method string, req, resp interface{}, d time.Duration, err error) error {
+	if !r.Records(method) {
+		return nil
+	}
+	rec := Record{
+		Time:     time.Now().UTC(),
+		Method:   method,
+		Duration: d,
+		Code:     grpc.Code(err),
+	}
+	if err != nil && r.anonymizer == nil {
+		rec.Error = grpc.ErrorDesc(err)
+	}
+	if namespace, ok := namespaces.Namespace(ctx); ok {
+		rec.Namespace = r.anonymizer.Value(namespace)
+	}
+	var eerr error
+	if rec.RequestType, rec.Request, eerr = r.encode(req); eerr != nil {
+		return eerr
+	}
+	if err == nil && resp != nil {
+		if rec.ResponseType, rec.Response, eerr = r.encode(resp); eerr != nil {
+			return eerr
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.enc.Encode(&rec)
+}
+
+// encode returns the name and the encoding of the message, anonymized
+func (r *Recorder) encode(v interface{}) (string, []byte, error) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return "", nil, nil
+	}
+	name := gogoproto.MessageName(m)
+	if name == "" {
+		name = proto.MessageName(m)
+	}
+	// the message is cloned as it is still used by the call
+	m = gogoproto.Clone(m)
+	clearCredentials(reflect.ValueOf(m))
+	if r.anonymizer != nil {
+		r.anonymizer.Anonymize(m)
+	}
+	data, err := gogoproto.Marshal(m)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, data, nil
+}
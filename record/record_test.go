@@ -0,0 +1,179 @@
+package record
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	containersapi "github.com/containerd/containerd/api/services/containers/v1"
+	imagesapi "github.com/containerd/containerd/api/services/images/v1"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/gogo/protobuf/types"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestAnonymize(t *testing.T) {
+	spec, err := json.Marshal(&specs.Spec{
+		Process: &specs.Process{
+			Env: []string{"PASSWORD=secret"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &containersapi.CreateContainerRequest{
+		Container: containersapi.Container{
+			ID: "redis",
+			Labels: map[string]string{
+				"team":                         "payments",
+				"containerd.io/restart.policy": "always",
+			},
+			Image: "registry.example.com/redis:latest",
+			Runtime: &containersapi.Container_Runtime{
+				Name: "io.containerd.runtime.v1.linux",
+			},
+			Spec: &types.Any{
+				TypeUrl: "types.containerd.io/opencontainers/runtime-spec/1/Spec",
+				Value:   spec,
+			},
+		},
+	}
+	a := NewAnonymizer([]byte("key"))
+	a.Anonymize(req)
+	c := req.Container
+	if c.ID != a.Value("redis") || c.ID == "redis" {
+		t.Fatalf("expected the id to be hashed, got %q", c.ID)
+	}
+	if c.Image == "registry.example.com/redis:latest" {
+		t.Fatal("expected the image to be hashed")
+	}
+	if c.Labels["team"] == "payments" || c.Labels["containerd.io/restart.policy"] != "always" {
+		t.Fatalf("unexpected labels %v", c.Labels)
+	}
+	if c.Runtime.Name != "io.containerd.runtime.v1.linux" {
+		t.Fatalf("expected the runtime to be kept, got %q", c.Runtime.Name)
+	}
+	if bytes.Contains(c.Spec.Value, []byte("secret")) || !bytes.Contains(c.Spec.Value, []byte("PASSWORD=anon-")) {
+		t.Fatalf("expected the environment values to be hashed, got %s", c.Spec.Value)
+	}
+}
+
+// serve serves the health service with the services set serving
+func serve(t *testing.T, dir string, recorder *Recorder, services ...string) (*grpc.ClientConn, func()) {
+	path := filepath.Join(dir, "grpc.sock")
+	os.Remove(path)
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var opts []grpc.ServerOption
+	if recorder != nil {
+		opts = append(opts, grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			start := time.Now()
+			resp, err := handler(ctx, req)
+			if rerr := recorder.Record(ctx, info.FullMethod, req, resp, time.Since(start), err); rerr != nil {
+				t.Error(rerr)
+			}
+			return resp, err
+		}))
+	}
+	server := grpc.NewServer(opts...)
+	hs := health.NewServer()
+	for _, s := range services {
+		hs.SetServingStatus(s, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(server, hs)
+	go server.Serve(l)
+	conn, err := grpc.Dial(path, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(5*time.Second),
+		grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
+			return net.DialTimeout("unix", addr, timeout)
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn, func() {
+		conn.Close()
+		server.Stop()
+	}
+}
+
+func TestRecordReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "record-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var trace bytes.Buffer
+	recorder := NewRecorder(&trace, NewAnonymizer([]byte("key")), []string{"/grpc.health.v1.Health/"})
+	conn, stop := serve(t, dir, recorder, "db")
+	ctx := namespaces.WithNamespace(context.Background(), "test")
+	client := healthpb.NewHealthClient(conn)
+	for _, s := range []string{"db", "cache"} {
+		client.Check(ctx, &healthpb.HealthCheckRequest{Service: s})
+	}
+	stop()
+	if n := strings.Count(trace.String(), "\n"); n != 2 {
+		t.Fatalf("expected 2 records, got %d", n)
+	}
+
+	// the cache is serving on the new build
+	conn, stop = serve(t, dir, nil, "db", "cache")
+	defer stop()
+	results, err := Replay(context.Background(), conn, &trace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if r := results[0]; !r.Matches() || r.Record.Namespace != NewAnonymizer([]byte("key")).Value("test") {
+		t.Fatalf("unexpected result %+v", r)
+	}
+	if r := results[1]; r.Matches() || r.Record.Code != codes.NotFound || r.Code != codes.OK {
+		t.Fatalf("expected the replayed call to differ, got %+v", r)
+	}
+}
+
+func TestRecordClearsCredentials(t *testing.T) {
+	req := &imagesapi.PushImageRequest{
+		Name:     "registry.example.com/redis:latest",
+		Username: "deploy",
+		Secret:   "hunter2",
+	}
+	for _, anonymizer := range []*Anonymizer{nil, NewAnonymizer([]byte("key"))} {
+		var trace bytes.Buffer
+		recorder := NewRecorder(&trace, anonymizer, nil)
+		if err := recorder.Record(context.Background(), "/containerd.services.images.v1.Images/Push", req, nil, time.Second, nil); err != nil {
+			t.Fatal(err)
+		}
+		var rec Record
+		if err := json.Unmarshal(trace.Bytes(), &rec); err != nil {
+			t.Fatal(err)
+		}
+		var recorded imagesapi.PushImageRequest
+		if err := recorded.Unmarshal(rec.Request); err != nil {
+			t.Fatal(err)
+		}
+		if recorded.Username != "" || recorded.Secret != "" {
+			t.Errorf("expected the credentials to be cleared, got %q %q", recorded.Username, recorded.Secret)
+		}
+		if strings.Contains(trace.String(), "hunter2") {
+			t.Error("expected the secret not to be in the trace")
+		}
+	}
+	if req.Username != "deploy" || req.Secret != "hunter2" {
+		t.Fatal("expected the request of the call to be kept")
+	}
+}
@@ -0,0 +1,74 @@
+package record
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/containerd/containerd/namespaces"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Result is the result of a replayed call
+type Result struct {
+	Record Record
+	// Code and Error are the status of the replayed call
+	Code  codes.Code
+	Error string
+}
+
+// Matches returns whether the replayed call ended with the recorded code.
+// Responses are not compared as they hold values, such as pids and
+// timestamps, that change from a run to another.
+func (r Result) Matches() bool {
+	return r.Code == r.Record.Code
+}
+
+// Replay calls the methods of the trace on the connection in order, in the
+// namespaces of the recorded calls, and returns the result of each call
+func Replay(ctx context.Context, conn *grpc.ClientConn, trace io.Reader) ([]Result, error) {
+	var (
+		results []Result
+		dec     = json.NewDecoder(trace)
+	)
+	for {
+		var rec Record
+		if err := dec.Decode(&rec); err != nil {
+			if err == io.EOF {
+				return results, nil
+			}
+			return results, errors.Wrapf(err, "invalid record %d", len(results)+1)
+		}
+		cctx := ctx
+		if rec.Namespace != "" {
+			cctx = namespaces.WithNamespace(ctx, rec.Namespace)
+		}
+		err := grpc.Invoke(cctx, rec.Method, &rawMessage{data: rec.Request}, &rawMessage{}, conn)
+		results = append(results, Result{
+			Record: rec,
+			Code:   grpc.Code(err),
+			Error:  grpc.ErrorDesc(err),
+		})
+	}
+}
+
+// rawMessage is an encoded message, so that calls are replayed without the
+// types of their messages
+type rawMessage struct {
+	data []byte
+}
+
+func (m *rawMessage) Reset()         { m.data = nil }
+func (m *rawMessage) String() string { return string(m.data) }
+func (*rawMessage) ProtoMessage()    {}
+
+func (m *rawMessage) Marshal() ([]byte, error) {
+	return m.data, nil
+}
+
+func (m *rawMessage) Unmarshal(data []byte) error {
+	m.data = append(m.data[:0], data...)
+	return nil
+}
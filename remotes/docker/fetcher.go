@@ -2,7 +2,9 @@ package docker
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"path"
 	"strings"
@@ -31,32 +33,48 @@ func (r dockerFetcher) Fetch(ctx context.Context, desc ocispec.Descriptor) (io.R
 		return nil, err
 	}
 
-	for _, path := range paths {
-		u := r.url(path)
+	// the request is only made on the first read, so that a resumed copy
+	// can seek to its offset first
+	return newHTTPReadSeeker(desc.Size, func(offset int64) (io.ReadCloser, error) {
+		for _, path := range paths {
+			u := r.url(path)
 
-		req, err := http.NewRequest(http.MethodGet, u, nil)
-		if err != nil {
-			return nil, err
-		}
+			req, err := http.NewRequest(http.MethodGet, u, nil)
+			if err != nil {
+				return nil, err
+			}
 
-		req.Header.Set("Accept", strings.Join([]string{desc.MediaType, `*`}, ", "))
-		resp, err := r.doRequestWithRetries(ctx, req, nil)
-		if err != nil {
-			return nil, err
-		}
+			req.Header.Set("Accept", strings.Join([]string{desc.MediaType, `*`}, ", "))
+			if offset > 0 {
+				req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+			}
+			resp, err := r.doRequestWithRetries(ctx, req, nil)
+			if err != nil {
+				return nil, err
+			}
 
-		if resp.StatusCode > 299 {
-			if resp.StatusCode == http.StatusNotFound {
-				continue // try one of the other urls.
+			if resp.StatusCode > 299 {
+				if resp.StatusCode == http.StatusNotFound {
+					continue // try one of the other urls.
+				}
+				resp.Body.Close()
+				return nil, errors.Errorf("unexpected status code %v: %v", u, resp.Status)
 			}
-			resp.Body.Close()
-			return nil, errors.Errorf("unexpected status code %v: %v", u, resp.Status)
-		}
 
-		return resp.Body, nil
-	}
+			// the registry may ignore the range and send the whole blob
+			if offset > 0 && resp.StatusCode != http.StatusPartialContent {
+				log.G(ctx).Debugf("range not supported, discarding %d bytes", offset)
+				if _, err := io.CopyN(ioutil.Discard, resp.Body, offset); err != nil {
+					resp.Body.Close()
+					return nil, errors.Wrap(err, "failed to skip to the resumed offset")
+				}
+			}
+
+			return resp.Body, nil
+		}
 
-	return nil, errors.New("not found")
+		return nil, errors.New("not found")
+	}), nil
 }
 
 // getV2URLPaths generates the candidate urls paths for the object based on the
@@ -0,0 +1,71 @@
+package docker
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+func TestFetcherResume(t *testing.T) {
+	var (
+		blob = []byte(strings.Repeat("containerd", 100))
+		desc = ocispec.Descriptor{
+			MediaType: ocispec.MediaTypeImageLayer,
+			Digest:    digest.FromBytes(blob),
+			Size:      int64(len(blob)),
+		}
+	)
+	for _, supportsRange := range []bool{true, false} {
+		var ranges []string
+		s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v2/testname/blobs/"+desc.Digest.String() {
+				rw.WriteHeader(http.StatusNotFound)
+				return
+			}
+			ranges = append(ranges, r.Header.Get("Range"))
+			if !supportsRange {
+				r.Header.Del("Range")
+			}
+			http.ServeContent(rw, r, "", time.Time{}, bytes.NewReader(blob))
+		}))
+
+		ctx := context.Background()
+		fetcher, err := NewResolver(ResolverOptions{PlainHTTP: true}).Fetcher(ctx, s.URL[7:]+"/testname:latest")
+		if err != nil {
+			t.Fatal(err)
+		}
+		rc, err := fetcher.Fetch(ctx, desc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		seeker, ok := rc.(io.Seeker)
+		if !ok {
+			t.Fatal("expected the fetched blob to be seekable")
+		}
+		if _, err := seeker.Seek(400, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+		p, err := ioutil.ReadAll(rc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rc.Close()
+		s.Close()
+
+		if !bytes.Equal(p, blob[400:]) {
+			t.Errorf("range %v: unexpected content after seeking", supportsRange)
+		}
+		if len(ranges) != 1 || ranges[0] != "bytes=400-" {
+			t.Errorf("range %v: unexpected requests %v", supportsRange, ranges)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package docker
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// httpReadSeeker reads a blob from a registry, opening it at the offset it
+// was seeked to on the first read after a seek
+type httpReadSeeker struct {
+	size   int64
+	offset int64
+	rc     io.ReadCloser
+	open   func(offset int64) (io.ReadCloser, error)
+	closed bool
+}
+
+func newHTTPReadSeeker(size int64, open func(offset int64) (io.ReadCloser, error)) *httpReadSeeker {
+	return &httpReadSeeker{
+		size: size,
+		open: open,
+	}
+}
+
+func (hrs *httpReadSeeker) Read(p []byte) (n int, err error) {
+	if hrs.closed {
+		return 0, io.EOF
+	}
+	if hrs.rc == nil {
+		if hrs.rc, err = hrs.open(hrs.offset); err != nil {
+			return 0, err
+		}
+	}
+	n, err = hrs.rc.Read(p)
+	hrs.offset += int64(n)
+	return n, err
+}
+
+func (hrs *httpReadSeeker) Close() error {
+	if hrs.closed {
+		return nil
+	}
+	hrs.closed = true
+	if hrs.rc != nil {
+		return hrs.rc.Close()
+	}
+	return nil
+}
+
+func (hrs *httpReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	if hrs.closed {
+		return 0, errors.New("seek on closed reader")
+	}
+	abs := hrs.offset
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs += offset
+	case io.SeekEnd:
+		if hrs.size < 0 {
+			return 0, errors.New("seek from end of unknown size")
+		}
+		abs = hrs.size + offset
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if abs < 0 {
+		return 0, errors.New("negative offset")
+	}
+	if abs != hrs.offset {
+		// the body is reopened at the new offset on the next read
+		if hrs.rc != nil {
+			if err := hrs.rc.Close(); err != nil {
+				return 0, err
+			}
+			hrs.rc = nil
+		}
+		hrs.offset = abs
+	}
+	return hrs.offset, nil
+}
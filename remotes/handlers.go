@@ -95,6 +95,26 @@ func fetch(ctx context.Context, ingester content.Ingester, fetcher Fetcher, desc
 		break
 	}
 
+	for attempt := 1; ; attempt++ {
+		err := copyFetched(ctx, cw, fetcher, desc)
+		if err == nil || attempt > fetchResumes || ctx.Err() != nil {
+			return err
+		}
+		// only interrupted downloads are resumed, from the offset the
+		// ingest got to
+		status, serr := cw.Status()
+		if serr != nil || status.Offset >= desc.Size {
+			return err
+		}
+		log.G(ctx).WithError(err).WithField("offset", status.Offset).Warn("fetch interrupted, resuming")
+	}
+}
+
+// fetchResumes is the number of times an interrupted download is resumed
+// before the fetch fails
+const fetchResumes = 3
+
+func copyFetched(ctx context.Context, cw content.Writer, fetcher Fetcher, desc ocispec.Descriptor) error {
 	rc, err := fetcher.Fetch(ctx, desc)
 	if err != nil {
 		return err
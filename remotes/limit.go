@@ -0,0 +1,178 @@
+package remotes
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// Limiter bounds the number of concurrent fetches and the rate their content
+// is read at. Resolvers wrapped with the same limiter share its limits, so
+// that a limiter held by a daemon applies across all of its pulls.
+type Limiter struct {
+	// slots has a buffered element per fetch in progress, nil when the
+	// fetches are not bounded
+	slots chan struct{}
+	// rate is the number of bytes read per second, 0 when unlimited
+	rate int64
+
+	mu sync.Mutex
+	// next is the time the bytes read so far are paid for
+	next time.Time
+}
+
+// NewLimiter returns a limiter allowing at most concurrency fetches at a
+// time and reading rate bytes per second across them. Either is unlimited
+// when 0.
+func NewLimiter(concurrency int, rate int64) *Limiter {
+	l := &Limiter{
+		rate: rate,
+	}
+	if concurrency > 0 {
+		l.slots = make(chan struct{}, concurrency)
+	}
+	return l
+}
+
+// Resolver wraps the resolver for the fetchers it returns to be limited
+func (l *Limiter) Resolver(resolver Resolver) Resolver {
+	return &limitedResolver{
+		Resolver: resolver,
+		limiter:  l,
+	}
+}
+
+// Fetcher wraps the fetcher to hold a slot of the limiter from each fetch
+// until its reader is closed, and to throttle its reads
+func (l *Limiter) Fetcher(fetcher Fetcher) Fetcher {
+	return FetcherFunc(func(ctx context.Context, desc ocispec.Descriptor) (io.ReadCloser, error) {
+		if err := l.acquire(ctx); err != nil {
+			return nil, err
+		}
+		rc, err := fetcher.Fetch(ctx, desc)
+		if err != nil {
+			l.release()
+			return nil, err
+		}
+		lr := &limitedReader{
+			ReadCloser: rc,
+			ctx:        ctx,
+			limiter:    l,
+		}
+		// seekable readers stay so for downloads to be resumed
+		if seeker, ok := rc.(io.Seeker); ok {
+			return &limitedReadSeeker{
+				limitedReader: lr,
+				seeker:        seeker,
+			}, nil
+		}
+		return lr, nil
+	})
+}
+
+func (l *Limiter) acquire(ctx context.Context) error {
+	if l.slots == nil {
+		return nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *Limiter) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+// wait accounts for n bytes read, waiting until the bytes read before them
+// are paid for at the rate of the limiter
+func (l *Limiter) wait(ctx context.Context, n int) error {
+	if l.rate == 0 || n == 0 {
+		return nil
+	}
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(time.Duration(int64(n) * int64(time.Second) / l.rate))
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// chunk is the largest read made at once, a tenth of a second of the rate,
+// so that reads into large buffers do not come in bursts
+func (l *Limiter) chunk(size int) int {
+	if l.rate == 0 {
+		return size
+	}
+	max := int(l.rate / 10)
+	if max < 4096 {
+		max = 4096
+	}
+	if size > max {
+		return max
+	}
+	return size
+}
+
+type limitedResolver struct {
+	Resolver
+	limiter *Limiter
+}
+
+func (r *limitedResolver) Fetcher(ctx context.Context, ref string) (Fetcher, error) {
+	fetcher, err := r.Resolver.Fetcher(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return r.limiter.Fetcher(fetcher), nil
+}
+
+type limitedReader struct {
+	io.ReadCloser
+	ctx     context.Context
+	limiter *Limiter
+	once    sync.Once
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p[:r.limiter.chunk(len(p))])
+	if werr := r.limiter.wait(r.ctx, n); werr != nil && err == nil {
+		err = werr
+	}
+	return n, err
+}
+
+func (r *limitedReader) Close() error {
+	r.once.Do(r.limiter.release)
+	return r.ReadCloser.Close()
+}
+
+type limitedReadSeeker struct {
+	*limitedReader
+	seeker io.Seeker
+}
+
+func (r *limitedReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	return r.seeker.Seek(offset, whence)
+}
@@ -0,0 +1,91 @@
+package remotes
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+func TestLimiterConcurrency(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		active  int
+		maximum int
+	)
+	fetcher := NewLimiter(2, 0).Fetcher(FetcherFunc(func(ctx context.Context, desc ocispec.Descriptor) (io.ReadCloser, error) {
+		mu.Lock()
+		active++
+		if active > maximum {
+			maximum = active
+		}
+		mu.Unlock()
+		return &closer{
+			Reader: bytes.NewReader([]byte("content")),
+			close: func() {
+				mu.Lock()
+				active--
+				mu.Unlock()
+			},
+		}, nil
+	}))
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rc, err := fetcher.Fetch(ctx, ocispec.Descriptor{})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+			ioutil.ReadAll(rc)
+			rc.Close()
+		}()
+	}
+	wg.Wait()
+	if maximum != 2 {
+		t.Fatalf("expected at most 2 concurrent fetches, got %d", maximum)
+	}
+}
+
+func TestLimiterRate(t *testing.T) {
+	fetcher := NewLimiter(0, 100000).Fetcher(FetcherFunc(func(ctx context.Context, desc ocispec.Descriptor) (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(make([]byte, 30000))), nil
+	}))
+	rc, err := fetcher.Fetch(context.Background(), ocispec.Descriptor{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	if _, ok := rc.(io.Seeker); ok {
+		t.Fatal("expected an unseekable reader to stay unseekable")
+	}
+
+	start := time.Now()
+	if _, err := ioutil.ReadAll(rc); err != nil {
+		t.Fatal(err)
+	}
+	// the first 10000 bytes are read without waiting
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Fatalf("expected reading 30000 bytes at 100000 bytes/s to be throttled, took %v", elapsed)
+	}
+}
+
+type closer struct {
+	io.Reader
+	close func()
+}
+
+func (c *closer) Close() error {
+	c.close()
+	return nil
+}
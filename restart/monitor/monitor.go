@@ -0,0 +1,279 @@
+package monitor
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	containersapi "github.com/containerd/containerd/api/services/containers/v1"
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	tasksapi "github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/containerd/containerd/api/types/task"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/restart"
+	"github.com/containerd/containerd/typeurl"
+	protobuf "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.InternalPlugin,
+		ID:   "restart",
+		Requires: []plugin.PluginType{
+			plugin.GRPCPlugin,
+		},
+		Config: &Config{
+			BackoffBase: "100ms",
+			BackoffMax:  "1m",
+		},
+		Init: New,
+	})
+}
+
+// Config for the restart monitor
+type Config struct {
+	// BackoffBase is the delay before a task is restarted, doubled for each
+	// consecutive restart of the same task
+	BackoffBase string `toml:"backoff_base"`
+	// BackoffMax is the maximum delay before a task is restarted. A task
+	// that ran for longer than this before exiting is restarted after the
+	// base delay again.
+	BackoffMax string `toml:"backoff_max"`
+	// DefaultPolicy is the restart policy of the containers without one
+	// recorded, "no" when empty
+	DefaultPolicy string `toml:"default_policy"`
+}
+
+// settings are the parsed configuration of the monitor
+type settings struct {
+	backoffBase   time.Duration
+	backoffMax    time.Duration
+	defaultPolicy string
+}
+
+func parseConfig(cfg *Config) (settings, error) {
+	base, err := time.ParseDuration(cfg.BackoffBase)
+	if err != nil {
+		return settings{}, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid backoff_base %q", cfg.BackoffBase)
+	}
+	max, err := time.ParseDuration(cfg.BackoffMax)
+	if err != nil {
+		return settings{}, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid backoff_max %q", cfg.BackoffMax)
+	}
+	if _, err := restart.Parse(cfg.DefaultPolicy); err != nil {
+		return settings{}, err
+	}
+	return settings{
+		backoffBase:   base,
+		backoffMax:    max,
+		defaultPolicy: cfg.DefaultPolicy,
+	}, nil
+}
+
+// New returns a monitor that restarts tasks when they exit according to the
+// restart policy recorded on their container
+func New(ic *plugin.InitContext) (interface{}, error) {
+	settings, err := parseConfig(ic.Config.(*Config))
+	if err != nil {
+		return nil, err
+	}
+	services, err := ic.GetAll(plugin.GRPCPlugin)
+	if err != nil {
+		return nil, err
+	}
+	tasks, ok := services["tasks"].(tasksapi.TasksServer)
+	if !ok {
+		return nil, fmt.Errorf("tasks service is required by the restart monitor")
+	}
+	containers, ok := services["containers"].(containersapi.ContainersServer)
+	if !ok {
+		return nil, fmt.Errorf("containers service is required by the restart monitor")
+	}
+	m := &Monitor{
+		tasks:      tasks,
+		containers: containers,
+		settings:   settings,
+		restarts:   make(map[string]*restarts),
+	}
+	go m.run(ic.Context, ic.Events)
+	return m, nil
+}
+
+// Monitor restarts tasks as they exit
+type Monitor struct {
+	tasks      tasksapi.TasksServer
+	containers containersapi.ContainersServer
+
+	mu       sync.Mutex
+	settings settings
+	restarts map[string]*restarts
+}
+
+// restarts tracks the consecutive restarts of a task to compute its backoff
+type restarts struct {
+	attempts int
+	started  time.Time
+}
+
+func (m *Monitor) run(ctx context.Context, exchange *events.Exchange) {
+	eventq, errq := exchange.Subscribe(ctx, `topic=="/tasks/exit"`)
+	for {
+		select {
+		case ev := <-eventq:
+			v, err := typeurl.UnmarshalAny(ev.Event)
+			if err != nil {
+				log.G(ctx).WithError(err).Error("failed to unmarshal task exit")
+				continue
+			}
+			e, ok := v.(*eventsapi.TaskExit)
+			// only the exit of a task's init process ends the task
+			if !ok || e.ID != e.ContainerID {
+				continue
+			}
+			m.handle(namespaces.WithNamespace(ctx, ev.Namespace), e)
+		case err := <-errq:
+			if err != nil {
+				log.G(ctx).WithError(err).Error("restart monitor subscription")
+			}
+			return
+		}
+	}
+}
+
+// handle schedules a restart of the exited task if its policy requires one
+func (m *Monitor) handle(ctx context.Context, e *eventsapi.TaskExit) {
+	logger := log.G(ctx).WithField("id", e.ContainerID)
+	r, err := m.containers.Get(ctx, &containersapi.GetContainerRequest{ID: e.ContainerID})
+	if err != nil {
+		if !errdefs.IsNotFound(errdefs.FromGRPC(err)) {
+			logger.WithError(err).Error("failed to get container of exited task")
+		}
+		return
+	}
+	labels := r.Container.Labels
+	if _, ok := labels[restart.StoppedLabel]; ok {
+		m.forget(ctx, e.ContainerID)
+		return
+	}
+	name, ok := labels[restart.PolicyLabel]
+	if !ok {
+		m.mu.Lock()
+		name = m.settings.defaultPolicy
+		m.mu.Unlock()
+	}
+	policy, err := restart.Parse(name)
+	if err != nil {
+		logger.WithError(err).Warn("ignoring restart policy")
+		return
+	}
+	count, _ := strconv.Atoi(labels[restart.CountLabel])
+	if !policy.Restart(e.ExitStatus, count) {
+		m.forget(ctx, e.ContainerID)
+		return
+	}
+	delay := m.backoff(ctx, e.ContainerID, e.ExitedAt)
+	logger.WithField("policy", policy).Debugf("restarting task in %s", delay)
+	go func() {
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+		if err := m.restart(ctx, e.ContainerID, count+1); err != nil {
+			logger.WithError(err).Error("failed to restart task")
+		}
+	}()
+}
+
+// backoff returns the delay before the task is restarted
+func (m *Monitor) backoff(ctx context.Context, id string, exitedAt time.Time) time.Duration {
+	namespace, _ := namespaces.Namespace(ctx)
+	key := path.Join(namespace, id)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.restarts[key]
+	if !ok || exitedAt.Sub(r.started) > m.settings.backoffMax {
+		r = &restarts{}
+		m.restarts[key] = r
+	}
+	delay := m.settings.backoffBase << uint(r.attempts)
+	if delay > m.settings.backoffMax || delay <= 0 {
+		delay = m.settings.backoffMax
+	} else {
+		r.attempts++
+	}
+	r.started = time.Now().Add(delay)
+	return delay
+}
+
+// restart replaces the stopped task of the container with a new one created
+// with the same rootfs and options and records the restart count, unless a
+// client stopped the task during the backoff
+func (m *Monitor) restart(ctx context.Context, id string, count int) error {
+	c, err := m.containers.Get(ctx, &containersapi.GetContainerRequest{ID: id})
+	if err != nil {
+		if errdefs.IsNotFound(errdefs.FromGRPC(err)) {
+			m.forget(ctx, id)
+			return nil
+		}
+		return err
+	}
+	if _, ok := c.Container.Labels[restart.StoppedLabel]; ok {
+		m.forget(ctx, id)
+		return nil
+	}
+	r, err := m.tasks.Inspect(ctx, &tasksapi.InspectTaskRequest{ContainerID: id})
+	if err != nil {
+		// the client that created the task deleted it after it exited
+		if errdefs.IsNotFound(errdefs.FromGRPC(err)) {
+			m.forget(ctx, id)
+			return nil
+		}
+		return err
+	}
+	if r.Process.Status != task.StatusStopped {
+		return nil
+	}
+	if _, err := m.tasks.Delete(ctx, &tasksapi.DeleteTaskRequest{ContainerID: id}); err != nil {
+		return err
+	}
+	// the new task is not connected to the stdio of the previous one as
+	// the client that created it is no longer attached
+	if _, err := m.tasks.Create(ctx, &tasksapi.CreateTaskRequest{
+		ContainerID: id,
+		Rootfs:      r.Rootfs,
+		Options:     r.Options,
+	}); err != nil {
+		return err
+	}
+	if _, err := m.tasks.Start(ctx, &tasksapi.StartRequest{ContainerID: id}); err != nil {
+		return err
+	}
+	_, err = m.containers.Update(ctx, &containersapi.UpdateContainerRequest{
+		Container: containersapi.Container{
+			ID: id,
+			Labels: map[string]string{
+				restart.CountLabel: strconv.Itoa(count),
+			},
+		},
+		UpdateMask: &protobuf.FieldMask{
+			Paths: []string{"labels." + restart.CountLabel},
+		},
+	})
+	return err
+}
+
+func (m *Monitor) forget(ctx context.Context, id string) {
+	namespace, _ := namespaces.Namespace(ctx)
+	m.mu.Lock()
+	delete(m.restarts, path.Join(namespace, id))
+	m.mu.Unlock()
+}
@@ -0,0 +1,22 @@
+package monitor
+
+import (
+	"context"
+
+	"github.com/containerd/containerd/plugin"
+)
+
+var _ plugin.Reloader = &Monitor{}
+
+// Reload applies new backoff delays and default policy to the tasks exiting
+// from now on
+func (m *Monitor) Reload(ctx context.Context, config interface{}) ([]string, error) {
+	settings, err := parseConfig(config.(*Config))
+	if err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	m.settings = settings
+	m.mu.Unlock()
+	return nil, nil
+}
@@ -0,0 +1,105 @@
+// Package restart defines the restart policies that can be recorded on a
+// container to have its task restarted by the daemon when it exits.
+//
+// The policy is stored in the container's labels under PolicyLabel and the
+// number of times the task has been restarted under CountLabel. A task
+// stopped by a client is marked with StoppedLabel and is not restarted until
+// a new task is created for the container.
+package restart
+
+import (
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+)
+
+const (
+	// PolicyLabel is the container label holding the restart policy
+	PolicyLabel = "containerd.io/restart.policy"
+	// CountLabel is the container label holding the number of times the
+	// container's task has been restarted
+	CountLabel = "containerd.io/restart.count"
+	// StoppedLabel is the container label set when a client stopped the
+	// container's task, cleared when a new task is created
+	StoppedLabel = "containerd.io/restart.stopped"
+)
+
+const (
+	// No never restarts the task
+	No = "no"
+	// Always restarts the task whenever it exits
+	Always = "always"
+	// OnFailure restarts the task when it exits with a non-zero status
+	OnFailure = "on-failure"
+)
+
+// Policy describes when a task is restarted after it exits
+type Policy struct {
+	// Name is one of No, Always or OnFailure
+	Name string
+	// MaxRetries limits the number of restarts of the OnFailure policy,
+	// zero allows any number of restarts
+	MaxRetries int
+}
+
+// Parse parses a policy of the form "no", "always" or "on-failure[:max]".
+// An empty string is the No policy.
+func Parse(s string) (Policy, error) {
+	parts := strings.SplitN(s, ":", 2)
+	switch parts[0] {
+	case "", No:
+		if len(parts) > 1 {
+			break
+		}
+		return Policy{Name: No}, nil
+	case Always:
+		if len(parts) > 1 {
+			break
+		}
+		return Policy{Name: Always}, nil
+	case OnFailure:
+		p := Policy{Name: OnFailure}
+		if len(parts) > 1 {
+			max, err := strconv.Atoi(parts[1])
+			if err != nil || max < 0 {
+				return Policy{}, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid maximum retry count %q in restart policy", parts[1])
+			}
+			p.MaxRetries = max
+		}
+		return p, nil
+	}
+	return Policy{}, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid restart policy %q", s)
+}
+
+// String returns the policy in the form accepted by Parse
+func (p Policy) String() string {
+	if p.Name == OnFailure && p.MaxRetries > 0 {
+		return p.Name + ":" + strconv.Itoa(p.MaxRetries)
+	}
+	return p.Name
+}
+
+// Restart returns true if a task that exited with the provided status after
+// being restarted count times should be restarted
+func (p Policy) Restart(exitStatus uint32, count int) bool {
+	switch p.Name {
+	case Always:
+		return true
+	case OnFailure:
+		return exitStatus != 0 && (p.MaxRetries == 0 || count < p.MaxRetries)
+	}
+	return false
+}
+
+// Stops returns true if a client sending the signal to the init process of a
+// task means to stop the task, rather than to have it handle the signal
+func Stops(signal uint32) bool {
+	switch syscall.Signal(signal) {
+	case syscall.SIGKILL, syscall.SIGTERM, syscall.SIGINT:
+		return true
+	}
+	return false
+}
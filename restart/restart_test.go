@@ -0,0 +1,66 @@
+package restart
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+)
+
+func TestParse(t *testing.T) {
+	for s, expected := range map[string]Policy{
+		"":             {Name: No},
+		"no":           {Name: No},
+		"always":       {Name: Always},
+		"on-failure":   {Name: OnFailure},
+		"on-failure:3": {Name: OnFailure, MaxRetries: 3},
+	} {
+		p, err := Parse(s)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %v", s, err)
+		}
+		if p != expected {
+			t.Fatalf("expected %q to parse as %+v, got %+v", s, expected, p)
+		}
+	}
+	for _, s := range []string{"sometimes", "always:3", "no:1", "on-failure:", "on-failure:-1", "on-failure:x"} {
+		if _, err := Parse(s); !errdefs.IsInvalidArgument(err) {
+			t.Fatalf("expected invalid argument parsing %q, got %v", s, err)
+		}
+	}
+}
+
+func TestRestart(t *testing.T) {
+	for _, tc := range []struct {
+		policy     string
+		exitStatus uint32
+		count      int
+		restart    bool
+	}{
+		{"no", 1, 0, false},
+		{"always", 0, 10, true},
+		{"on-failure", 0, 0, false},
+		{"on-failure", 1, 10, true},
+		{"on-failure:2", 1, 1, true},
+		{"on-failure:2", 1, 2, false},
+	} {
+		p, err := Parse(tc.policy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r := p.Restart(tc.exitStatus, tc.count); r != tc.restart {
+			t.Fatalf("%s with exit status %d after %d restarts: expected restart %v, got %v", tc.policy, tc.exitStatus, tc.count, tc.restart, r)
+		}
+	}
+}
+
+func TestStops(t *testing.T) {
+	for _, sig := range []syscall.Signal{syscall.SIGKILL, syscall.SIGTERM, syscall.SIGINT} {
+		if !Stops(uint32(sig)) {
+			t.Errorf("expected %v to stop the task", sig)
+		}
+	}
+	if Stops(uint32(syscall.SIGHUP)) {
+		t.Error("expected SIGHUP not to stop the task")
+	}
+}
@@ -10,4 +10,5 @@ const (
 	TaskPausedEventTopic       = "/tasks/paused"
 	TaskResumedEventTopic      = "/tasks/resumed"
 	TaskCheckpointedEventTopic = "/tasks/checkpointed"
+	TaskStuckEventTopic        = "/tasks/stuck"
 )
@@ -0,0 +1,51 @@
+package runtime
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+)
+
+// ExitTranslator is implemented by runtimes whose tasks report the exit of
+// their workload with special exit statuses, such as VM runtimes reporting a
+// panic of the guest kernel or an OOM of the VM, so that the events and the
+// responses of the daemon report the exit of the workload
+type ExitTranslator interface {
+	// TranslateExit returns the exit status of the workload of the container
+	// with the id and the reason of its exit for the exit status reported by
+	// its task, the reason being empty when the status is not translated
+	TranslateExit(ctx context.Context, id string, status uint32) (uint32, string)
+}
+
+// ExitCode is the translation of an exit status reported by a task
+type ExitCode struct {
+	// Status is the exit status of the workload
+	Status uint32 `toml:"status"`
+	// Reason is the reason of the exit, such as "GuestPanic" or "OOMKilled"
+	Reason string `toml:"reason"`
+}
+
+// ExitCodes translates the exit statuses reported by tasks, keyed by their
+// decimal value so that they can be configured in TOML
+type ExitCodes map[string]ExitCode
+
+// Validate returns an error if a key of the exit codes is not an exit status
+func (c ExitCodes) Validate() error {
+	for k := range c {
+		if _, err := strconv.ParseUint(k, 10, 32); err != nil {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "exit code %q is not an exit status", k)
+		}
+	}
+	return nil
+}
+
+// Translate returns the translation of the exit status, or the status with
+// an empty reason when it is not translated
+func (c ExitCodes) Translate(status uint32) (uint32, string) {
+	if code, ok := c[strconv.FormatUint(uint64(status), 10)]; ok {
+		return code.Status, code.Reason
+	}
+	return status, ""
+}
@@ -0,0 +1,25 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+)
+
+func TestExitCodes(t *testing.T) {
+	codes := ExitCodes{
+		"255": {Status: 1, Reason: "GuestPanic"},
+	}
+	if err := codes.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if status, reason := codes.Translate(255); status != 1 || reason != "GuestPanic" {
+		t.Fatalf("expected 1 GuestPanic, got %d %q", status, reason)
+	}
+	if status, reason := codes.Translate(2); status != 2 || reason != "" {
+		t.Fatalf("expected 2 to be kept, got %d %q", status, reason)
+	}
+	if err := (ExitCodes{"panic": {}}).Validate(); !errdefs.IsInvalidArgument(err) {
+		t.Fatalf("expected an invalid argument error, got %v", err)
+	}
+}
@@ -0,0 +1,13 @@
+package runtime
+
+import "context"
+
+// ForceDeleter is implemented by runtimes that can delete tasks that are
+// still running or whose runtime state cannot be deleted cleanly, such as a
+// task whose shim died or whose state dir is partially corrupt
+type ForceDeleter interface {
+	// ForceDelete kills the processes of the task and removes it from the
+	// runtime, purging what is left of its state even when the runtime
+	// fails to delete it
+	ForceDelete(context.Context, Task) (*Exit, error)
+}
@@ -26,6 +26,9 @@ type CreateOpts struct {
 	Checkpoint string
 	// Options for the runtime and container
 	Options *types.Any
+	// Runtime is the name of the runtime of the container, which selects
+	// the shim binary of runtimes implementing ShimRuntime
+	Runtime string
 }
 
 type Exit struct {
@@ -49,3 +52,14 @@ type Runtime interface {
 	// Delete removes the task in the runtime.
 	Delete(context.Context, Task) (*Exit, error)
 }
+
+// ShimRuntime is implemented by runtimes that run tasks in external shim
+// binaries resolved from the runtime name of their container, such as
+// "io.containerd.runc.v1", so that runtimes can be added without linking
+// them into the daemon.
+type ShimRuntime interface {
+	Runtime
+	// Resolves returns true if the runtime can create tasks for containers
+	// with the named runtime
+	Resolves(name string) bool
+}
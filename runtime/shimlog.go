@@ -0,0 +1,11 @@
+package runtime
+
+import "context"
+
+// ShimLogger is implemented by runtimes whose shims write their output to a
+// log per task while running in debug mode
+type ShimLogger interface {
+	// ShimLog returns at most the last max bytes of the shim log of the
+	// task and whether the beginning of the log was left out
+	ShimLog(ctx context.Context, t Task, max int64) ([]byte, bool, error)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/containerd/containerd/mount"
 	"github.com/gogo/protobuf/types"
 )
 
@@ -12,6 +13,12 @@ type TaskInfo struct {
 	Runtime   string
 	Spec      []byte
 	Namespace string
+	// Bundle is the path to the task's bundle on the host, if any
+	Bundle string
+	// Rootfs holds the mounts the task was created with
+	Rootfs []mount.Mount
+	// Options holds the runtime specific options the task was created with
+	Options *types.Any
 }
 
 type Process interface {
@@ -39,8 +46,8 @@ type Task interface {
 	Resume(context.Context) error
 	// Exec adds a process into the container
 	Exec(context.Context, string, ExecOpts) (Process, error)
-	// Pids returns all pids
-	Pids(context.Context) ([]uint32, error)
+	// Pids returns all pids along with the exec process that started them
+	Pids(context.Context) ([]ProcessInfo, error)
 	// Checkpoint checkpoints a container to an image with live system data
 	Checkpoint(context.Context, string, *types.Any) error
 	// DeleteProcess deletes a specific exec process via its id
@@ -51,11 +58,47 @@ type Task interface {
 	Process(context.Context, string) (Process, error)
 }
 
+// ProcessUpdate holds the changes to the spec of a process that is not
+// started, the args and working directory being kept when empty
+type ProcessUpdate struct {
+	// Env is added to the environment of the process, replacing the
+	// variables with the same names
+	Env  []string
+	Args []string
+	Cwd  string
+}
+
+// ProcessUpdater is implemented by the exec processes of runtimes that hold
+// the spec of a process until it is started
+type ProcessUpdater interface {
+	// UpdateProcess amends the spec of the process, failing with
+	// errdefs.ErrFailedPrecondition once it is started
+	UpdateProcess(context.Context, ProcessUpdate) error
+}
+
+// ParentCheckpointer is implemented by the tasks of runtimes that checkpoint
+// incrementally, dumping only the memory changed since a parent checkpoint
+type ParentCheckpointer interface {
+	// CheckpointWithParent checkpoints the task to the path like Checkpoint,
+	// relative to the images of the parent checkpoint at the parent path,
+	// which is relative to the path
+	CheckpointWithParent(ctx context.Context, path, parent string, options *types.Any) error
+}
+
 type ExecOpts struct {
 	Spec *types.Any
 	IO   IO
 }
 
+// ProcessInfo holds a pid within a task
+type ProcessInfo struct {
+	// Pid is the system pid
+	Pid uint32
+	// ExecID is the id of the exec process that started the pid, empty
+	// if the pid belongs to the init process or is unknown
+	ExecID string
+}
+
 type ConsoleSize struct {
 	Width  uint32
 	Height uint32
@@ -70,6 +113,9 @@ const (
 	DeletedStatus
 	PausedStatus
 	PausingStatus
+	// BrokenStatus is the status of a task that could not be restored from
+	// its state, which can only be deleted
+	BrokenStatus
 )
 
 type State struct {
@@ -83,8 +129,11 @@ type State struct {
 	// ExitedAt is the time at which the process exited
 	// Only valid if the Status is Stopped
 	ExitedAt time.Time
-	Stdin    string
-	Stdout   string
-	Stderr   string
-	Terminal bool
+	// StartedAt is the time at which the process started, it distinguishes
+	// the process from a later one that reused its pid
+	StartedAt time.Time
+	Stdin     string
+	Stdout    string
+	Stderr    string
+	Terminal  bool
 }
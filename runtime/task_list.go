@@ -2,15 +2,18 @@ package runtime
 
 import (
 	"context"
-	"errors"
 	"sync"
 
+	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/namespaces"
+	"github.com/pkg/errors"
 )
 
+// The errors of the task list are of the errdefs classes so that they are
+// mapped to the matching grpc codes
 var (
-	ErrTaskNotExists     = errors.New("task does not exist")
-	ErrTaskAlreadyExists = errors.New("task already exists")
+	ErrTaskNotExists     = errors.Wrap(errdefs.ErrNotFound, "task does not exist")
+	ErrTaskAlreadyExists = errors.Wrap(errdefs.ErrAlreadyExists, "task already exists")
 )
 
 func NewTaskList() *TaskList {
@@ -58,6 +61,19 @@ func (l *TaskList) GetAll(ctx context.Context) ([]Task, error) {
 	return o, nil
 }
 
+// All returns the tasks of every namespace
+func (l *TaskList) All() []Task {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var o []Task
+	for _, tasks := range l.tasks {
+		for _, t := range tasks {
+			o = append(o, t)
+		}
+	}
+	return o
+}
+
 func (l *TaskList) Add(ctx context.Context, t Task) error {
 	namespace, err := namespaces.NamespaceRequired(ctx)
 	if err != nil {
@@ -0,0 +1,71 @@
+package sandboxes
+
+import (
+	"context"
+	"time"
+)
+
+// Sandbox is a group of containers sharing the network, IPC and optionally
+// PID namespaces of the sandbox and its cgroup parent, such as the
+// containers of a pod.
+type Sandbox struct {
+	// ID uniquely identifies the sandbox in a namespace.
+	//
+	// This property is required and cannot be changed after creation.
+	ID string
+
+	// Labels provide metadata extension for a sandbox.
+	//
+	// These are optional and fully mutable.
+	Labels map[string]string
+
+	// CgroupParent is the parent cgroup of the cgroups of the containers of
+	// the sandbox.
+	//
+	// This property is optional but immutable.
+	CgroupParent string
+
+	// Network is the name of the CNI network the sandbox is added to, the
+	// sandbox has no network other than its loopback interface when empty.
+	//
+	// This property is optional but immutable.
+	Network string
+
+	// SharePID has the containers of the sandbox share the PID namespace of
+	// the first task created in the sandbox.
+	//
+	// This property is optional but immutable.
+	SharePID bool
+
+	// Namespaces are the paths of the namespaces of the sandbox joined by
+	// its containers, by type such as "network".
+	//
+	// They are set by the sandboxes service.
+	Namespaces map[string]string
+
+	// CreatedAt is the time at which the sandbox was created.
+	CreatedAt time.Time
+
+	// UpdatedAt is the time at which the sandbox was updated.
+	UpdatedAt time.Time
+}
+
+// Store interacts with the underlying storage backend for sandboxes.
+type Store interface {
+	Get(ctx context.Context, id string) (Sandbox, error)
+
+	// List returns sandboxes that match one or more of the provided filters.
+	List(ctx context.Context, filters ...string) ([]Sandbox, error)
+
+	Create(ctx context.Context, sandbox Sandbox) (Sandbox, error)
+
+	// Update the sandbox with the provided sandbox object. ID must be set.
+	//
+	// The labels and namespaces may be updated, as a whole or individually
+	// with the "labels.<key>" and "namespaces.<type>" fieldpaths.
+	Update(ctx context.Context, sandbox Sandbox, fieldpaths ...string) (Sandbox, error)
+
+	// Delete the sandbox with the id, failing with a failed precondition
+	// error while containers are in it.
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"github.com/containerd/containerd/authz"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// authorize returns an error when an authorization plugin denies the call
+// of the method with the request, nil for streaming methods
+func (s *Server) authorize(ctx context.Context, method string, req interface{}) error {
+	if len(s.authorizers) == 0 {
+		return nil
+	}
+	namespace, _ := namespaces.Namespace(ctx)
+	r := &authz.Request{
+		Method:    method,
+		Namespace: namespace,
+		Message:   req,
+		Peer:      authz.PeerFromContext(ctx),
+	}
+	for _, a := range s.authorizers {
+		if err := a.Authorize(ctx, r); err != nil {
+			log.G(ctx).WithError(err).WithField("method", method).Debug("call denied")
+			if !errdefs.IsPermissionDenied(err) {
+				err = errors.Wrap(errdefs.ErrPermissionDenied, err.Error())
+			}
+			return errdefs.ToGRPC(err)
+		}
+	}
+	return nil
+}
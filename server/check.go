@@ -0,0 +1,194 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/plugin"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// CheckReport is the result of the consistency check of the daemon, run
+// instead of serving so that a node can be validated before it is used
+type CheckReport struct {
+	// OK is true when the configuration of all plugins is valid and all
+	// checks passed
+	OK      bool           `json:"ok"`
+	Plugins []PluginReport `json:"plugins"`
+	Checks  []CheckItem    `json:"checks"`
+}
+
+// PluginReport is the result of decoding the configuration of a plugin,
+// skipped when the plugin is disabled
+type PluginReport struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Skipped bool   `json:"skipped,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// CheckItem is the result of verifying an item of the state of the daemon
+type CheckItem struct {
+	Plugin string `json:"plugin"`
+	Name   string `json:"name"`
+	Error  string `json:"error,omitempty"`
+}
+
+// checkLockTimeout bounds the wait for the lock of the metadata database,
+// held by a daemon serving with the same root
+var checkLockTimeout = 5 * time.Second
+
+// Check reports the plugins whose configuration is invalid, the
+// inconsistencies of the metadata and those found by the plugins verifying
+// their state, such as the shims of the tasks that do not respond. The
+// plugins are not initialized and the metadata and state are only read, so
+// that a node can be checked without changing its state.
+func Check(ctx context.Context, config *Config) (*CheckReport, error) {
+	if config.Root == "" {
+		return nil, errors.New("root must be specified")
+	}
+	if config.State == "" {
+		return nil, errors.New("state must be specified")
+	}
+	plugins, err := loadPlugins(config)
+	if err != nil {
+		return nil, err
+	}
+	report := &CheckReport{
+		OK: true,
+	}
+	add := func(id string, result plugin.CheckResult) {
+		item := CheckItem{
+			Plugin: id,
+			Name:   result.Name,
+		}
+		if result.Err != nil {
+			item.Error = result.Err.Error()
+			report.OK = false
+		}
+		report.Checks = append(report.Checks, item)
+	}
+	snapshotters := make(map[string]bool)
+	for _, p := range plugins {
+		id := p.URI()
+		r := PluginReport{
+			ID:   p.ID,
+			Type: string(p.Type),
+		}
+		if config.Disabled(p.ID, id) {
+			r.Skipped = true
+			report.Plugins = append(report.Plugins, r)
+			continue
+		}
+		var (
+			pluginConfig interface{}
+			err          error
+		)
+		if p.Config != nil {
+			pluginConfig, err = config.Decode(p.ID, copyConfig(p.Config))
+		} else if _, ok := config.Plugins[p.ID]; ok {
+			err = errors.Errorf("plugin %q does not accept configuration", id)
+		}
+		if err != nil {
+			r.Error = err.Error()
+			report.OK = false
+			report.Plugins = append(report.Plugins, r)
+			continue
+		}
+		report.Plugins = append(report.Plugins, r)
+		if p.Type == plugin.SnapshotPlugin {
+			snapshotters[p.ID] = true
+		}
+		if p.Check == nil {
+			continue
+		}
+		ic := plugin.NewContext(ctx, nil, config.Root, config.State, id)
+		ic.Config = pluginConfig
+		ic.Address = config.GRPC.Address
+		for _, result := range p.Check(ic) {
+			add(id, result)
+		}
+	}
+	for _, result := range checkDatabase(ctx, config, snapshotters) {
+		add("bolt", result)
+	}
+	return report, nil
+}
+
+// checkDatabase opens the metadata database read-only to check it, a node
+// without a database has no metadata to check
+func checkDatabase(ctx context.Context, config *Config, snapshotters map[string]bool) []plugin.CheckResult {
+	path := filepath.Join(config.Root, string(plugin.MetadataPlugin)+".bolt", "meta.db")
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	}
+	db, err := bolt.Open(path, 0644, &bolt.Options{
+		ReadOnly: true,
+		Timeout:  checkLockTimeout,
+	})
+	if err != nil {
+		if err == bolt.ErrTimeout {
+			err = errors.New("database is locked by a running daemon")
+		}
+		return []plugin.CheckResult{{
+			Name: "database",
+			Err:  errors.Wrap(err, "failed to open database"),
+		}}
+	}
+	defer db.Close()
+	return checkMetadata(ctx, db, snapshotters)
+}
+
+// checkMetadata verifies the consistency of the database and that the
+// containers in each namespace can be read and use a loaded snapshotter
+func checkMetadata(ctx context.Context, db *bolt.DB, snapshotters map[string]bool) []plugin.CheckResult {
+	var results []plugin.CheckResult
+	if err := db.View(func(tx *bolt.Tx) error {
+		var errs []string
+		for err := range tx.Check() {
+			errs = append(errs, err.Error())
+		}
+		result := plugin.CheckResult{
+			Name: "database",
+		}
+		if len(errs) > 0 {
+			result.Err = errors.Errorf("%d inconsistencies found, first: %s", len(errs), errs[0])
+		}
+		results = append(results, result)
+
+		nss, err := metadata.NewNamespaceStore(tx).List(ctx)
+		if err != nil {
+			return err
+		}
+		store := metadata.NewContainerStore(tx)
+		for _, ns := range nss {
+			result := plugin.CheckResult{
+				Name: fmt.Sprintf("namespace %s", ns),
+			}
+			containers, err := store.List(namespaces.WithNamespace(ctx, ns))
+			if err != nil {
+				result.Err = errors.Wrap(err, "failed to read containers")
+			}
+			for _, c := range containers {
+				if c.Snapshotter != "" && !snapshotters[c.Snapshotter] {
+					result.Err = errors.Errorf("container %s uses snapshotter %q which is not loaded", c.ID, c.Snapshotter)
+					break
+				}
+			}
+			results = append(results, result)
+		}
+		return nil
+	}); err != nil {
+		results = append(results, plugin.CheckResult{
+			Name: "namespaces",
+			Err:  errors.Wrap(err, "failed to read namespaces"),
+		})
+	}
+	return results
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/net/context"
+)
+
+func TestCheckMetadata(t *testing.T) {
+	dir, err := ioutil.TempDir("", "containerd-check-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	db, err := bolt.Open(filepath.Join(dir, "meta.db"), 0644, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	ctx := namespaces.WithNamespace(context.Background(), "testing")
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := metadata.NewContainerStore(tx).Create(ctx, containers.Container{
+			ID:          "test",
+			Spec:        &types.Any{TypeUrl: "test"},
+			Snapshotter: "overlayfs",
+			Runtime: containers.RuntimeInfo{
+				Name: "testruntime",
+			},
+		})
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	results := checkMetadata(context.Background(), db, map[string]bool{"overlayfs": true})
+	if len(results) != 2 {
+		t.Fatalf("expected the database and a namespace to be checked, got %+v", results)
+	}
+	for _, r := range results {
+		if r.Err != nil {
+			t.Fatalf("unexpected error checking %s: %v", r.Name, r.Err)
+		}
+	}
+
+	results = checkMetadata(context.Background(), db, map[string]bool{"btrfs": true})
+	if r := results[1]; r.Name != "namespace testing" || r.Err == nil || !strings.Contains(r.Err.Error(), `snapshotter "overlayfs"`) {
+		t.Fatalf("expected the missing snapshotter to be reported, got %+v", r)
+	}
+}
+
+func TestCheckReadOnly(t *testing.T) {
+	dir, err := ioutil.TempDir("", "containerd-check-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	config := &Config{
+		Root:  filepath.Join(dir, "root"),
+		State: filepath.Join(dir, "state"),
+	}
+	report, err := Check(context.Background(), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.OK {
+		t.Fatalf("expected an empty node to be consistent, got %+v", report)
+	}
+	for _, path := range []string{config.Root, config.State} {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Fatalf("expected %s not to be created by the check: %v", path, err)
+		}
+	}
+
+	path := filepath.Join(config.Root, "io.containerd.metadata.v1.bolt", "meta.db")
+	if err := os.MkdirAll(filepath.Dir(path), 0711); err != nil {
+		t.Fatal(err)
+	}
+	db, err := bolt.Open(path, 0644, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	defer func(timeout time.Duration) {
+		checkLockTimeout = timeout
+	}(checkLockTimeout)
+	checkLockTimeout = 10 * time.Millisecond
+	results := checkDatabase(context.Background(), config, nil)
+	if len(results) != 1 || results[0].Err == nil || !strings.Contains(results[0].Err.Error(), "locked") {
+		t.Fatalf("expected the database of a running daemon to be reported as locked, got %+v", results)
+	}
+}
@@ -3,8 +3,11 @@ package server
 import (
 	"bytes"
 	"io"
+	"os"
+	"strconv"
 
 	"github.com/BurntSushi/toml"
+	"github.com/pkg/errors"
 )
 
 // Config provides containerd configuration data for the server
@@ -19,8 +22,17 @@ type Config struct {
 	Debug Debug `toml:"debug"`
 	// Metrics and monitoring settings
 	Metrics MetricsConfig `toml:"metrics"`
+	// Events settings
+	Events EventsConfig `toml:"events"`
+	// Shutdown settings
+	Shutdown ShutdownConfig `toml:"shutdown"`
 	// Plugins provides plugin specific configuration for the initialization of a plugin
 	Plugins map[string]toml.Primitive `toml:"plugins"`
+	// PluginDir is the directory external plugins are loaded from, defaults
+	// to the plugins directory under the root
+	PluginDir string `toml:"plugin_dir"`
+	// DisabledPlugins are the ids or URIs of plugins that are not loaded
+	DisabledPlugins []string `toml:"disabled_plugins"`
 	// Enable containerd as a subreaper
 	Subreaper bool `toml:"subreaper"`
 	// OOMScore adjust the containerd's oom score
@@ -33,6 +45,60 @@ type GRPCConfig struct {
 	Address string `toml:"address"`
 	Uid     int    `toml:"uid"`
 	Gid     int    `toml:"gid"`
+	// Mode is the octal file mode of the socket, "0660" when empty
+	Mode string `toml:"mode"`
+	// DeprecationWarnings sends the deprecated surfaces of the API used by
+	// a call to the client in the response headers
+	DeprecationWarnings bool `toml:"deprecation_warnings"`
+	// TCP configures a TCP listener of the GRPC API for remote management
+	TCP TCPConfig `toml:"tcp"`
+	// Record records the unary calls of the GRPC API to a trace
+	Record RecordConfig `toml:"record"`
+}
+
+// RecordConfig records the unary calls of the GRPC API to a trace, which can
+// be replayed against another build of the daemon with ctr replay
+type RecordConfig struct {
+	// Path is the file the calls are appended to, recording is disabled
+	// when it is empty
+	Path string `toml:"path"`
+	// Methods limits the recording to the methods with the prefixes, such
+	// as "/containerd.services.tasks.v1.Tasks/"
+	Methods []string `toml:"methods"`
+	// Raw records the calls and their errors as is instead of anonymizing
+	// their identifiers, labels, stdio and environment
+	Raw bool `toml:"raw"`
+}
+
+// DefaultSocketMode is the file mode of the GRPC socket when none is
+// configured, granting access to the owner and the group of the socket
+const DefaultSocketMode os.FileMode = 0660
+
+// SocketMode returns the file mode of the socket
+func (c GRPCConfig) SocketMode() (os.FileMode, error) {
+	if c.Mode == "" {
+		return DefaultSocketMode, nil
+	}
+	mode, err := strconv.ParseUint(c.Mode, 8, 32)
+	if err != nil || os.FileMode(mode)&^os.ModePerm != 0 {
+		return 0, errors.Errorf("invalid socket mode %q, it must be octal permissions such as \"0660\"", c.Mode)
+	}
+	return os.FileMode(mode), nil
+}
+
+// TCPConfig configures the TCP listener of the GRPC API, which is served
+// over TLS
+type TCPConfig struct {
+	// Enabled serves the GRPC API on the TCP address
+	Enabled bool   `toml:"enabled"`
+	Address string `toml:"address"`
+	// TLSCert and TLSKey are the paths of the certificate and key of the
+	// server
+	TLSCert string `toml:"tls_cert"`
+	TLSKey  string `toml:"tls_key"`
+	// TLSCA is the path of the CA certificates verifying the certificates
+	// clients are required to present
+	TLSCA string `toml:"tls_ca"`
 }
 
 type Debug struct {
@@ -40,12 +106,33 @@ type Debug struct {
 	Uid     int    `toml:"uid"`
 	Gid     int    `toml:"gid"`
 	Level   string `toml:"level"`
+	// BlockProfileRate is the rate of the block profile, in nanoseconds
+	// blocked per sample, disabled when zero
+	BlockProfileRate int `toml:"block_profile_rate"`
+	// MutexProfileFraction is the fraction of the mutex contention events
+	// reported in the mutex profile, disabled when zero
+	MutexProfileFraction int `toml:"mutex_profile_fraction"`
 }
 
 type MetricsConfig struct {
 	Address string `toml:"address"`
 }
 
+type EventsConfig struct {
+	// Clock is the source of event timestamps, "wall" or "monotonic"
+	Clock string `toml:"clock"`
+}
+
+type ShutdownConfig struct {
+	// StopTasks stops the running tasks when containerd is terminated,
+	// instead of leaving them running for the next containerd to restore
+	StopTasks bool `toml:"stop_tasks"`
+	// Timeout is the grace period tasks are given to exit after SIGTERM
+	// before they are killed, for example "10s". A container's
+	// StopTimeoutLabel overrides it.
+	Timeout string `toml:"timeout"`
+}
+
 // Decode unmarshals a plugin specific configuration by plugin id
 func (c *Config) Decode(id string, v interface{}) (interface{}, error) {
 	data, ok := c.Plugins[id]
@@ -53,11 +140,26 @@ func (c *Config) Decode(id string, v interface{}) (interface{}, error) {
 		return v, nil
 	}
 	if err := c.md.PrimitiveDecode(data, v); err != nil {
-		return nil, err
+		return nil, errors.Wrapf(err, "invalid configuration for plugin %q", id)
+	}
+	for _, key := range c.md.Undecoded() {
+		if len(key) > 2 && key[0] == "plugins" && key[1] == id {
+			return nil, errors.Errorf("unknown configuration key %q for plugin %q", key.String(), id)
+		}
 	}
 	return v, nil
 }
 
+// Disabled returns true if the plugin with the id or uri is disabled
+func (c *Config) Disabled(id, uri string) bool {
+	for _, d := range c.DisabledPlugins {
+		if d == id || d == uri {
+			return true
+		}
+	}
+	return false
+}
+
 // WriteTo marshals the config to the provided writer
 func (c *Config) WriteTo(w io.Writer) (int64, error) {
 	buf := bytes.NewBuffer(nil)
@@ -77,6 +179,12 @@ func LoadConfig(path string, v *Config) error {
 	if err != nil {
 		return err
 	}
+	// plugin sections are validated when they are decoded for their plugin
+	for _, key := range md.Undecoded() {
+		if key[0] != "plugins" {
+			return errors.Errorf("unknown configuration key %q in %s", key.String(), path)
+		}
+	}
 	v.md = md
 	return nil
 
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeConfig(t *testing.T, data string) (string, func()) {
+	dir, err := ioutil.TempDir("", "containerd-config-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "config.toml")
+	if err := ioutil.WriteFile(path, []byte(data), 0600); err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return path, func() { os.RemoveAll(dir) }
+}
+
+func TestLoadConfigUnknownKey(t *testing.T) {
+	path, cleanup := writeConfig(t, `
+root = "/var/lib/containerd"
+[grpc]
+  adress = "/run/containerd/containerd.sock"
+`)
+	defer cleanup()
+	if err := LoadConfig(path, &Config{}); err == nil {
+		t.Fatal("expected unknown key to be an error")
+	}
+}
+
+func TestDecodePluginConfig(t *testing.T) {
+	path, cleanup := writeConfig(t, `
+disabled_plugins = ["btrfs"]
+[plugins.test]
+  name = "value"
+[plugins.typo]
+  nmae = "value"
+`)
+	defer cleanup()
+	var config Config
+	if err := LoadConfig(path, &config); err != nil {
+		t.Fatal(err)
+	}
+	type pluginConfig struct {
+		Name string `toml:"name"`
+	}
+	v, err := config.Decode("test", &pluginConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := v.(*pluginConfig).Name; name != "value" {
+		t.Fatalf("expected name to be decoded, got %q", name)
+	}
+	if _, err := config.Decode("typo", &pluginConfig{}); err == nil {
+		t.Fatal("expected unknown plugin key to be an error")
+	}
+	if !config.Disabled("btrfs", "io.containerd.snapshotter.v1.btrfs") {
+		t.Fatal("expected btrfs to be disabled")
+	}
+}
+
+func TestSocketMode(t *testing.T) {
+	for mode, expected := range map[string]os.FileMode{
+		"":     DefaultSocketMode,
+		"0600": 0600,
+		"660":  0660,
+	} {
+		m, err := GRPCConfig{Mode: mode}.SocketMode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m != expected {
+			t.Errorf("expected mode %q to be %o, got %o", mode, expected, m)
+		}
+	}
+	for _, mode := range []string{"rw", "0999", "10660"} {
+		if _, err := (GRPCConfig{Mode: mode}).SocketMode(); err == nil {
+			t.Errorf("expected mode %q to be invalid", mode)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"time"
+
+	"github.com/containerd/containerd/authz"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/credentials"
+)
+
+// handshakeTimeout bounds the TLS handshake of the clients of the TCP
+// listener, so that a client that never completes it does not hold its
+// connection
+const handshakeTimeout = 10 * time.Second
+
+// newTLSConfig returns the TLS configuration of the TCP listener, which
+// always requires clients to present a certificate signed by the CA
+func newTLSConfig(config TCPConfig) (*tls.Config, error) {
+	if config.TLSCert == "" || config.TLSKey == "" {
+		return nil, errors.New("tls_cert and tls_key are required to serve GRPC over TCP")
+	}
+	if config.TLSCA == "" {
+		return nil, errors.New("tls_ca is required to authenticate the clients of GRPC over TCP")
+	}
+	cert, err := tls.LoadX509KeyPair(config.TLSCert, config.TLSKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load the TLS certificate")
+	}
+	data, err := ioutil.ReadFile(config.TLSCA)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the TLS CA")
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errors.Errorf("no certificate found in %s", config.TLSCA)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}, nil
+}
+
+// transportCredentials secures the connections of the GRPC server by
+// listener: connections accepted on unix sockets are used as is, identified
+// by the credentials of the peer, while TCP connections are served over TLS
+type transportCredentials struct {
+	tls *tls.Config
+}
+
+func (c *transportCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	if conn.LocalAddr().Network() == "unix" {
+		// the credentials of the client are available to authorize its calls
+		// when the platform supports them
+		if uc, ok := conn.(*net.UnixConn); ok {
+			if creds, err := authz.UnixPeer(uc); err == nil {
+				return conn, creds, nil
+			}
+		}
+		return conn, nil, nil
+	}
+	if c.tls == nil {
+		conn.Close()
+		return nil, nil, errors.Errorf("no TLS configuration to serve %s", conn.LocalAddr())
+	}
+	conn.SetDeadline(time.Now().Add(handshakeTimeout))
+	tc := tls.Server(conn, c.tls)
+	if err := tc.Handshake(); err != nil {
+		tc.Close()
+		return nil, nil, err
+	}
+	conn.SetDeadline(time.Time{})
+	return tc, credentials.TLSInfo{State: tc.ConnectionState()}, nil
+}
+
+func (c *transportCredentials) ClientHandshake(context.Context, string, net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("server credentials cannot be used by clients")
+}
+
+func (c *transportCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{
+		SecurityProtocol: "tls",
+		SecurityVersion:  "1.2",
+	}
+}
+
+func (c *transportCredentials) Clone() credentials.TransportCredentials {
+	return &transportCredentials{tls: c.tls}
+}
+
+func (c *transportCredentials) OverrideServerName(string) error {
+	return nil
+}
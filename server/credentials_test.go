@@ -0,0 +1,122 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+)
+
+func TestNewTLSConfig(t *testing.T) {
+	if _, err := newTLSConfig(TCPConfig{Enabled: true}); err == nil {
+		t.Fatal("expected an error without a certificate")
+	}
+	dir, err := ioutil.TempDir("", "containerd-tls-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	config := writeCert(t, dir)
+	withoutCA := config
+	withoutCA.TLSCA = ""
+	if _, err := newTLSConfig(withoutCA); err == nil {
+		t.Fatal("expected an error without a CA authenticating clients")
+	}
+	c, err := newTLSConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.ClientAuth != tls.RequireAndVerifyClientCert {
+		t.Fatalf("expected client certificates to be required, got %v", c.ClientAuth)
+	}
+
+	leaf, err := x509.ParseCertificate(c.Certificates[0].Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server, client := net.Pipe()
+	defer client.Close()
+	creds := &transportCredentials{tls: c}
+	done := make(chan error, 1)
+	go func() {
+		pool := x509.NewCertPool()
+		pool.AddCert(leaf)
+		tc := tls.Client(client, &tls.Config{
+			ServerName:   "localhost",
+			RootCAs:      pool,
+			Certificates: c.Certificates,
+		})
+		done <- tc.Handshake()
+	}()
+	conn, info, err := creds.ServerHandshake(server)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	tlsInfo, ok := info.(credentials.TLSInfo)
+	if !ok {
+		t.Fatalf("expected TLS info, got %T", info)
+	}
+	if len(tlsInfo.State.PeerCertificates) != 1 {
+		t.Fatalf("expected the client certificate, got %d certificates", len(tlsInfo.State.PeerCertificates))
+	}
+}
+
+// writeCert writes a self signed certificate used as the certificate of the
+// server, of the client and as the CA
+func writeCert(t *testing.T, dir string) TCPConfig {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := TCPConfig{
+		Enabled: true,
+		TLSCert: filepath.Join(dir, "cert.pem"),
+		TLSKey:  filepath.Join(dir, "key.pem"),
+		TLSCA:   filepath.Join(dir, "cert.pem"),
+	}
+	for path, block := range map[string]*pem.Block{
+		config.TLSCert: {Type: "CERTIFICATE", Bytes: der},
+		config.TLSKey:  {Type: "EC PRIVATE KEY", Bytes: keyDER},
+	} {
+		if err := ioutil.WriteFile(path, pem.EncodeToMemory(block), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return config
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"expvar"
+	"runtime"
+	"runtime/pprof"
+	"time"
+)
+
+var startTime = time.Now()
+
+func init() {
+	expvar.Publish("runtime", expvar.Func(runtimeStats))
+}
+
+// runtimeStats returns the stats of the Go runtime of the daemon, served
+// with the memstats on /debug/vars
+func runtimeStats() interface{} {
+	return map[string]interface{}{
+		"goroutines":     runtime.NumGoroutine(),
+		"threads":        pprof.Lookup("threadcreate").Count(),
+		"cgo_calls":      runtime.NumCgoCall(),
+		"gomaxprocs":     runtime.GOMAXPROCS(0),
+		"go_version":     runtime.Version(),
+		"uptime_seconds": time.Since(startTime).Seconds(),
+	}
+}
+
+// applyProfiling enables the block and mutex profiles of the debug socket
+// with the rates of the config, they are disabled by default as they slow
+// down the daemon
+func applyProfiling(config Debug) {
+	runtime.SetBlockProfileRate(config.BlockProfileRate)
+	runtime.SetMutexProfileFraction(config.MutexProfileFraction)
+}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/containerd/containerd/deprecation"
+	"github.com/containerd/containerd/log"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// deprecationHeader is the response header listing the deprecated surfaces
+// used by the call, when deprecation warnings are enabled
+const deprecationHeader = "containerd-deprecation"
+
+// observeDeprecations records the deprecated surfaces used by the call,
+// warning once for each surface
+func (s *Server) observeDeprecations(ctx context.Context, method string, req interface{}) {
+	used := deprecation.Observe(method, req)
+	if len(used) == 0 {
+		return
+	}
+	var warnings []string
+	for _, u := range used {
+		if u.Count == 1 {
+			log.G(ctx).WithField("deprecation", u.ID).WithField("method", method).Warnf("deprecated API used: %s", u.Message)
+		}
+		warnings = append(warnings, fmt.Sprintf("%s: %s", u.ID, u.Message))
+	}
+	if s.deprecationWarnings {
+		if err := grpc.SetHeader(ctx, metadata.MD{deprecationHeader: warnings}); err != nil {
+			log.G(ctx).WithError(err).Debug("failed to send deprecation warnings")
+		}
+	}
+}
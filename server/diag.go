@@ -0,0 +1,306 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime/pprof"
+	"sync"
+	"time"
+
+	"github.com/boltdb/bolt"
+	diagapi "github.com/containerd/containerd/api/services/diag/v1"
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/api/types/task"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/streaming"
+	"github.com/containerd/containerd/typeurl"
+	"github.com/containerd/containerd/version"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+// historySize is the number of the most recent events and log entries kept
+// for the support bundles
+const historySize = 1000
+
+// ring keeps the most recent items added to it
+type ring struct {
+	mu    sync.Mutex
+	items []interface{}
+	next  int
+	full  bool
+}
+
+func newRing(size int) *ring {
+	return &ring{
+		items: make([]interface{}, size),
+	}
+}
+
+func (r *ring) add(v interface{}) {
+	r.mu.Lock()
+	r.items[r.next] = v
+	r.next = (r.next + 1) % len(r.items)
+	if r.next == 0 {
+		r.full = true
+	}
+	r.mu.Unlock()
+}
+
+// all returns the items from the oldest to the most recent
+func (r *ring) all() []interface{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.full {
+		return append([]interface{}(nil), r.items[:r.next]...)
+	}
+	return append(append([]interface{}(nil), r.items[r.next:]...), r.items[:r.next]...)
+}
+
+// logHook keeps the most recent log entries of the daemon
+type logHook struct {
+	entries *ring
+}
+
+func (h *logHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *logHook) Fire(entry *logrus.Entry) error {
+	line, err := entry.String()
+	if err != nil {
+		return err
+	}
+	h.entries.add(line)
+	return nil
+}
+
+// recordEvents keeps the most recent events published on the exchange
+// until the context is canceled
+func recordEvents(ctx context.Context, exchange *events.Exchange, r *ring) {
+	eventq, errq := exchange.Subscribe(ctx)
+	for {
+		select {
+		case e := <-eventq:
+			r.add(e)
+		case <-errq:
+			return
+		}
+	}
+}
+
+// diagService serves the support bundles of the server
+type diagService struct {
+	s *Server
+}
+
+func (d *diagService) Bundle(_ *diagapi.BundleRequest, ss diagapi.Diag_BundleServer) error {
+	w := streaming.NewWriter(func(p []byte) error {
+		return ss.Send(&diagapi.BundleResponse{Data: p})
+	})
+	if err := d.s.writeBundle(ss.Context(), w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// writeBundle writes the support bundle as a gzipped tarball. A file that
+// cannot be collected is replaced by its error so that the rest of the
+// bundle is still useful.
+func (s *Server) writeBundle(ctx context.Context, w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+	for _, f := range []struct {
+		name  string
+		write func(io.Writer) error
+	}{
+		{"version.txt", func(w io.Writer) error {
+			_, err := fmt.Fprintf(w, "%s %s %s\n", version.Package, version.Version, version.Revision)
+			return err
+		}},
+		{"config.toml", func(w io.Writer) error {
+			// plugin sections are not included as they may hold credentials
+			_, err := s.currentConfig().WriteTo(w)
+			return err
+		}},
+		{"plugins.json", s.writePlugins},
+		{"events.json", s.writeEvents},
+		{"daemon.log", s.writeLogs},
+		{"goroutines.txt", func(w io.Writer) error {
+			return pprof.Lookup("goroutine").WriteTo(w, 2)
+		}},
+		{"containers.json", func(w io.Writer) error {
+			return s.writeContainers(ctx, w)
+		}},
+	} {
+		var b bytes.Buffer
+		name := f.name
+		if err := f.write(&b); err != nil {
+			log.G(ctx).WithError(err).WithField("file", name).Warn("failed to collect support bundle file")
+			b.Reset()
+			fmt.Fprintf(&b, "%v\n", err)
+			name += ".error"
+		}
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    0644,
+			Size:    int64(b.Len()),
+			ModTime: now,
+		}); err != nil {
+			return err
+		}
+		if _, err := b.WriteTo(tw); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func (s *Server) writePlugins(w io.Writer) error {
+	type pluginStatus struct {
+		ID      string            `json:"id"`
+		Type    string            `json:"type"`
+		Exports map[string]string `json:"exports,omitempty"`
+		Error   string            `json:"error,omitempty"`
+	}
+	var out []pluginStatus
+	for _, p := range s.plugins.All() {
+		ps := pluginStatus{
+			ID:      p.Registration.ID,
+			Type:    string(p.Registration.Type),
+			Exports: p.Meta.Exports,
+		}
+		if p.Err != nil {
+			ps.Error = p.Err.Error()
+		}
+		out = append(out, ps)
+	}
+	return writeJSON(w, out)
+}
+
+func (s *Server) writeEvents(w io.Writer) error {
+	type event struct {
+		Timestamp time.Time   `json:"timestamp"`
+		Namespace string      `json:"namespace"`
+		Topic     string      `json:"topic"`
+		Event     interface{} `json:"event,omitempty"`
+	}
+	var out []event
+	for _, v := range s.recentEvents.all() {
+		e := v.(*eventsapi.Envelope)
+		ev := event{
+			Timestamp: e.Timestamp,
+			Namespace: e.Namespace,
+			Topic:     e.Topic,
+		}
+		if e.Event != nil {
+			if decoded, err := typeurl.UnmarshalAny(e.Event); err == nil {
+				ev.Event = decoded
+			} else {
+				ev.Event = e.Event.TypeUrl
+			}
+		}
+		out = append(out, ev)
+	}
+	return writeJSON(w, out)
+}
+
+func (s *Server) writeLogs(w io.Writer) error {
+	for _, line := range s.recentLogs.all() {
+		if _, err := io.WriteString(w, line.(string)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeContainers writes a summary of the containers of every namespace and
+// of the state of their tasks
+func (s *Server) writeContainers(ctx context.Context, w io.Writer) error {
+	type taskSummary struct {
+		Pid        uint32    `json:"pid"`
+		Status     string    `json:"status"`
+		ExitStatus uint32    `json:"exit_status,omitempty"`
+		ExitedAt   time.Time `json:"exited_at,omitempty"`
+	}
+	type containerSummary struct {
+		Namespace   string            `json:"namespace"`
+		ID          string            `json:"id"`
+		Image       string            `json:"image,omitempty"`
+		Runtime     string            `json:"runtime"`
+		Snapshotter string            `json:"snapshotter,omitempty"`
+		Labels      map[string]string `json:"labels,omitempty"`
+		CreatedAt   time.Time         `json:"created_at"`
+		Task        *taskSummary      `json:"task,omitempty"`
+	}
+	if s.db == nil {
+		return fmt.Errorf("metadata is not loaded")
+	}
+	var out []containerSummary
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		nss, err := metadata.NewNamespaceStore(tx).List(ctx)
+		if err != nil {
+			return err
+		}
+		store := metadata.NewContainerStore(tx)
+		for _, ns := range nss {
+			nctx := namespaces.WithNamespace(ctx, ns)
+			var cs []containers.Container
+			if cs, err = store.List(nctx); err != nil {
+				return err
+			}
+			tasks := make(map[string]*task.Process)
+			if s.tasks != nil {
+				ps, err := s.listTasks(nctx)
+				if err != nil {
+					log.G(ctx).WithError(err).WithField("namespace", ns).Warn("failed to list tasks for support bundle")
+				}
+				for _, p := range ps {
+					tasks[p.ID] = p
+				}
+			}
+			for _, c := range cs {
+				summary := containerSummary{
+					Namespace:   ns,
+					ID:          c.ID,
+					Image:       c.Image,
+					Runtime:     c.Runtime.Name,
+					Snapshotter: c.Snapshotter,
+					Labels:      c.Labels,
+					CreatedAt:   c.CreatedAt,
+				}
+				if p, ok := tasks[c.ID]; ok {
+					summary.Task = &taskSummary{
+						Pid:        p.Pid,
+						Status:     p.Status.String(),
+						ExitStatus: p.ExitStatus,
+						ExitedAt:   p.ExitedAt,
+					}
+				}
+				out = append(out, summary)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return writeJSON(w, out)
+}
+
+func writeJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
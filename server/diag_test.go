@@ -0,0 +1,87 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/plugin"
+	"golang.org/x/net/context"
+)
+
+func TestRing(t *testing.T) {
+	r := newRing(3)
+	for i := 0; i < 2; i++ {
+		r.add(i)
+	}
+	if all := r.all(); !reflect.DeepEqual(all, []interface{}{0, 1}) {
+		t.Fatalf("unexpected items %v", all)
+	}
+	for i := 2; i < 5; i++ {
+		r.add(i)
+	}
+	if all := r.all(); !reflect.DeepEqual(all, []interface{}{2, 3, 4}) {
+		t.Fatalf("expected the most recent items, got %v", all)
+	}
+}
+
+func TestWriteBundle(t *testing.T) {
+	s := &Server{
+		plugins:      plugin.NewSet(),
+		config:       &Config{Root: "/var/lib/containerd"},
+		recentEvents: newRing(historySize),
+		recentLogs:   newRing(historySize),
+	}
+	s.recentEvents.add(&eventsapi.Envelope{Namespace: "default", Topic: "/tasks/exit"})
+	s.recentLogs.add("level=info msg=\"starting containerd\"\n")
+
+	var b bytes.Buffer
+	if err := s.writeBundle(context.Background(), &b); err != nil {
+		t.Fatal(err)
+	}
+	gz, err := gzip.NewReader(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string]string)
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[hdr.Name] = string(data)
+	}
+	for _, name := range []string{
+		"version.txt",
+		"config.toml",
+		"plugins.json",
+		"events.json",
+		"daemon.log",
+		"goroutines.txt",
+		// the metadata is not loaded
+		"containers.json.error",
+	} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("expected %s in the bundle, got %v", name, files)
+		}
+	}
+	if !bytes.Contains([]byte(files["events.json"]), []byte(`"/tasks/exit"`)) {
+		t.Errorf("expected the recent event, got %s", files["events.json"])
+	}
+	if files["daemon.log"] != "level=info msg=\"starting containerd\"\n" {
+		t.Errorf("expected the recent log, got %q", files["daemon.log"])
+	}
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd/log"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+// containersService prefixes the methods of the containers service, whose
+// requests name the container by its ID
+const containersService = "/containerd.services.containers.v1.Containers/"
+
+// logRequest logs the call of the method at the debug level with the
+// container the request is about, its duration and its error
+func logRequest(ctx context.Context, method string, req interface{}, d time.Duration, err error) {
+	fields := logrus.Fields{
+		"method":   method,
+		"duration": d,
+	}
+	if id := containerID(method, req); id != "" {
+		fields["container"] = id
+	}
+	entry := log.G(ctx).WithFields(fields)
+	if err != nil {
+		entry = entry.WithError(err)
+	}
+	entry.Debug("request")
+}
+
+// containerID returns the ID of the container the request is about, empty
+// when it is not about a container
+func containerID(method string, req interface{}) string {
+	v := reflect.Indirect(reflect.ValueOf(req))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	if f := v.FieldByName("ContainerID"); f.Kind() == reflect.String {
+		return f.String()
+	}
+	if !strings.HasPrefix(method, containersService) {
+		return ""
+	}
+	if c := reflect.Indirect(v.FieldByName("Container")); c.Kind() == reflect.Struct {
+		v = c
+	}
+	if f := v.FieldByName("ID"); f.Kind() == reflect.String {
+		return f.String()
+	}
+	return ""
+}
@@ -0,0 +1,27 @@
+package server
+
+import (
+	"testing"
+
+	containers "github.com/containerd/containerd/api/services/containers/v1"
+	tasks "github.com/containerd/containerd/api/services/tasks/v1"
+	version "github.com/containerd/containerd/api/services/version/v1"
+)
+
+func TestContainerID(t *testing.T) {
+	for _, tc := range []struct {
+		method string
+		req    interface{}
+		id     string
+	}{
+		{"/containerd.services.tasks.v1.Tasks/Create", &tasks.CreateTaskRequest{ContainerID: "c1"}, "c1"},
+		{"/containerd.services.containers.v1.Containers/Get", &containers.GetContainerRequest{ID: "c2"}, "c2"},
+		{"/containerd.services.containers.v1.Containers/Create", &containers.CreateContainerRequest{Container: containers.Container{ID: "c3"}}, "c3"},
+		{"/containerd.services.version.v1.Version/Version", &version.VersionResponse{Version: "v1"}, ""},
+		{"/containerd.services.tasks.v1.Tasks/Create", nil, ""},
+	} {
+		if id := containerID(tc.method, tc.req); id != tc.id {
+			t.Errorf("%s: expected container %q, got %q", tc.method, tc.id, id)
+		}
+	}
+}
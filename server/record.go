@@ -0,0 +1,37 @@
+package server
+
+import (
+	"crypto/rand"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/containerd/containerd/record"
+	"github.com/pkg/errors"
+)
+
+// newRecorder returns the recorder of the calls configured by the config,
+// nil when recording is disabled, and the file of its trace
+func newRecorder(config RecordConfig) (*record.Recorder, io.Closer, error) {
+	if config.Path == "" {
+		return nil, nil, nil
+	}
+	var anonymizer *record.Anonymizer
+	if !config.Raw {
+		// values are hashed with a key of the run of the daemon, so that
+		// they cannot be recovered by hashing guesses
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, nil, err
+		}
+		anonymizer = record.NewAnonymizer(key)
+	}
+	if err := os.MkdirAll(filepath.Dir(config.Path), 0700); err != nil {
+		return nil, nil, err
+	}
+	f, err := os.OpenFile(config.Path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to open the trace of the calls")
+	}
+	return record.NewRecorder(f, anonymizer, config.Methods), f, nil
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"runtime/debug"
+
+	"github.com/containerd/containerd/log"
+	metrics "github.com/docker/go-metrics"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+var panics metrics.LabeledCounter
+
+func init() {
+	ns := metrics.NewNamespace("containerd", "grpc", nil)
+	panics = ns.NewLabeledCounter("panics", "The number of panics recovered while handling requests", "method")
+	metrics.Register(ns)
+}
+
+// recoverUnary returns the handler with its panics, such as those of a
+// buggy runtime plugin, turned into an internal error of the request instead
+// of crashing the daemon and the management of every other container
+func recoverUnary(method string, handler grpc.UnaryHandler) grpc.UnaryHandler {
+	return func(ctx context.Context, req interface{}) (_ interface{}, err error) {
+		defer recoverPanic(ctx, method, req, &err)
+		return handler(ctx, req)
+	}
+}
+
+// recoverStream returns the stream handler with its panics turned into an
+// internal error of the stream
+func recoverStream(method string, handler grpc.StreamHandler) grpc.StreamHandler {
+	return func(srv interface{}, ss grpc.ServerStream) (err error) {
+		defer recoverPanic(ss.Context(), method, nil, &err)
+		return handler(srv, ss)
+	}
+}
+
+// recoverPanic must be deferred by the handler of the request
+func recoverPanic(ctx context.Context, method string, req interface{}, err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	panics.WithValues(method).Inc()
+	fields := logrus.Fields{
+		"method": method,
+		"panic":  r,
+		"stack":  string(debug.Stack()),
+	}
+	if id := containerID(method, req); id != "" {
+		fields["container"] = id
+	}
+	log.G(ctx).WithFields(fields).Error("recovered panic while handling request")
+	*err = grpc.Errorf(codes.Internal, "panic while handling %s: %v", method, r)
+}
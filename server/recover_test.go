@@ -0,0 +1,32 @@
+package server
+
+import (
+	"testing"
+
+	tasks "github.com/containerd/containerd/api/services/tasks/v1"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRecoverUnary(t *testing.T) {
+	handler := recoverUnary("/containerd.services.tasks.v1.Tasks/Start", func(ctx context.Context, req interface{}) (interface{}, error) {
+		var m map[string]string
+		m["nil"] = "map"
+		return nil, nil
+	})
+	resp, err := handler(context.Background(), &tasks.StartRequest{ContainerID: "c1"})
+	if resp != nil {
+		t.Fatalf("expected no response, got %v", resp)
+	}
+	if code := grpc.Code(err); code != codes.Internal {
+		t.Fatalf("expected an internal error, got %v", err)
+	}
+
+	handler = recoverUnary("/containerd.services.tasks.v1.Tasks/Start", func(ctx context.Context, req interface{}) (interface{}, error) {
+		return req, nil
+	})
+	if _, err := handler(context.Background(), &tasks.StartRequest{}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
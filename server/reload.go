@@ -0,0 +1,237 @@
+package server
+
+import (
+	"reflect"
+	"sort"
+
+	daemonapi "github.com/containerd/containerd/api/services/daemon/v1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/plugin"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+// ReloadReport lists the settings that changed when the configuration was
+// reloaded, by their toml name such as "debug.level" or "plugins.images"
+type ReloadReport struct {
+	// Applied are the settings applied to the running daemon
+	Applied []string
+	// RestartRequired are the settings only applied by a restart
+	RestartRequired []string
+	// Failed are the settings whose new value was refused, the previous
+	// value being kept
+	Failed map[string]error
+}
+
+// reloader is a plugin whose configuration can be reloaded, with the
+// defaults of its configuration the new configuration is decoded over
+type reloader struct {
+	plugin.Reloader
+	defaults interface{}
+}
+
+// SetConfigLoader sets the function loading the configuration of the daemon
+// again when it is reloaded
+func (s *Server) SetConfigLoader(load func() (*Config, error)) {
+	s.reloadMu.Lock()
+	s.loadConfig = load
+	s.reloadMu.Unlock()
+}
+
+// Reload loads the configuration again and applies the settings that
+// changed and can be applied while the daemon runs: the log level and the
+// sections of the plugins implementing plugin.Reloader. The running
+// containers are not affected.
+func (s *Server) Reload(ctx context.Context) (*ReloadReport, error) {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+	if s.loadConfig == nil {
+		return nil, errors.Wrap(errdefs.ErrNotImplemented, "the configuration of the daemon cannot be reloaded")
+	}
+	config, err := s.loadConfig()
+	if err != nil {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "failed to load the configuration: %v", err)
+	}
+	var (
+		old    = s.currentConfig()
+		report = &ReloadReport{
+			Failed: make(map[string]error),
+		}
+	)
+	for _, name := range changedSettings(old, config) {
+		if name != "debug.level" {
+			report.RestartRequired = append(report.RestartRequired, name)
+			continue
+		}
+		if err := setLogLevel(config.Debug.Level); err != nil {
+			report.Failed[name] = err
+			continue
+		}
+		report.Applied = append(report.Applied, name)
+	}
+	for _, id := range pluginIDs(old, config) {
+		name := "plugins." + id
+		r, ok := s.reloaders[id]
+		if !ok {
+			if !samePluginSettings(old, config, id) {
+				report.RestartRequired = append(report.RestartRequired, name)
+			}
+			continue
+		}
+		c, err := config.Decode(id, copyConfig(r.defaults))
+		if err != nil {
+			report.Failed[name] = err
+			continue
+		}
+		if oc, err := old.Decode(id, copyConfig(r.defaults)); err == nil && reflect.DeepEqual(oc, c) {
+			continue
+		}
+		restart, err := r.Reload(ctx, c)
+		if err != nil {
+			report.Failed[name] = err
+			continue
+		}
+		report.Applied = append(report.Applied, name)
+		for _, setting := range restart {
+			report.RestartRequired = append(report.RestartRequired, name+"."+setting)
+		}
+	}
+	// the refused settings are applied again by the next reload
+	if len(report.Failed) == 0 {
+		s.configMu.Lock()
+		s.config = config
+		s.configMu.Unlock()
+	}
+	log.G(ctx).WithFields(logrus.Fields{
+		"applied":          report.Applied,
+		"restart_required": report.RestartRequired,
+	}).Info("configuration reloaded")
+	for name, err := range report.Failed {
+		log.G(ctx).WithError(err).WithField("setting", name).Error("failed to reload setting")
+	}
+	return report, nil
+}
+
+func (s *Server) currentConfig() *Config {
+	s.configMu.Lock()
+	defer s.configMu.Unlock()
+	return s.config
+}
+
+// changedSettings returns the names of the settings of the daemon, outside
+// of the sections of the plugins, that differ between the configurations
+func changedSettings(old, new *Config) []string {
+	var (
+		changed []string
+		ov      = reflect.ValueOf(old).Elem()
+		nv      = reflect.ValueOf(new).Elem()
+		t       = ov.Type()
+	)
+	for i := 0; i < t.NumField(); i++ {
+		name := t.Field(i).Tag.Get("toml")
+		switch name {
+		case "", "plugins":
+			continue
+		case "debug":
+			od, nd := old.Debug, new.Debug
+			if od.Level != nd.Level {
+				changed = append(changed, "debug.level")
+			}
+			od.Level, nd.Level = "", ""
+			if od != nd {
+				changed = append(changed, name)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(ov.Field(i).Interface(), nv.Field(i).Interface()) {
+			changed = append(changed, name)
+		}
+	}
+	return changed
+}
+
+// pluginIDs returns the sorted ids of the plugin sections of either
+// configuration
+func pluginIDs(old, new *Config) []string {
+	seen := make(map[string]struct{})
+	var ids []string
+	for _, c := range []*Config{old, new} {
+		for id := range c.Plugins {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				ids = append(ids, id)
+			}
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// samePluginSettings returns true when the section of the plugin has the
+// same settings in both configurations
+func samePluginSettings(old, new *Config, id string) bool {
+	settings := func(c *Config) (map[string]interface{}, bool) {
+		p, ok := c.Plugins[id]
+		if !ok {
+			return nil, true
+		}
+		m := make(map[string]interface{})
+		return m, c.md.PrimitiveDecode(p, &m) == nil
+	}
+	o, ok := settings(old)
+	if !ok {
+		return false
+	}
+	n, ok := settings(new)
+	return ok && reflect.DeepEqual(o, n)
+}
+
+// copyConfig returns a copy of the configuration of a plugin, a pointer to a
+// struct, so that a configuration can be decoded over its defaults again
+func copyConfig(v interface{}) interface{} {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return v
+	}
+	c := reflect.New(rv.Elem().Type())
+	c.Elem().Set(rv.Elem())
+	return c.Interface()
+}
+
+// setLogLevel sets the level of the logs of the daemon, info when empty
+func setLogLevel(level string) error {
+	if level == "" {
+		level = "info"
+	}
+	lvl, err := logrus.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	logrus.SetLevel(lvl)
+	return nil
+}
+
+// daemonService reloads the configuration of the server
+type daemonService struct {
+	s *Server
+}
+
+func (d *daemonService) Reload(ctx context.Context, _ *daemonapi.ReloadRequest) (*daemonapi.ReloadResponse, error) {
+	report, err := d.s.Reload(ctx)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	resp := &daemonapi.ReloadResponse{
+		Applied:         report.Applied,
+		RestartRequired: report.RestartRequired,
+	}
+	if len(report.Failed) > 0 {
+		resp.Failed = make(map[string]string)
+		for name, err := range report.Failed {
+			resp.Failed[name] = err.Error()
+		}
+	}
+	return resp, nil
+}
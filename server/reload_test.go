@@ -0,0 +1,125 @@
+package server
+
+import (
+	gocontext "context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+type testReloaderConfig struct {
+	Name    string `toml:"name"`
+	Restart string `toml:"restart"`
+}
+
+type testReloader struct {
+	configs []testReloaderConfig
+}
+
+func (r *testReloader) Reload(ctx gocontext.Context, config interface{}) ([]string, error) {
+	c := config.(*testReloaderConfig)
+	if c.Name == "invalid" {
+		return nil, errors.New("invalid name")
+	}
+	r.configs = append(r.configs, *c)
+	if c.Restart != "" {
+		return []string{c.Restart}, nil
+	}
+	return nil, nil
+}
+
+func TestReload(t *testing.T) {
+	defer logrus.SetLevel(logrus.GetLevel())
+	load := func(data string) *Config {
+		path, cleanup := writeConfig(t, data)
+		defer cleanup()
+		var config Config
+		if err := LoadConfig(path, &config); err != nil {
+			t.Fatal(err)
+		}
+		return &config
+	}
+	var (
+		r = &testReloader{}
+		s = &Server{
+			config: load(`
+root = "/var/lib/containerd"
+[plugins.test]
+  name = "first"
+[plugins.other]
+  size = 1
+[plugins.same]
+  size = 1
+`),
+			reloaders: map[string]reloader{
+				"test": {Reloader: r, defaults: &testReloaderConfig{Restart: ""}},
+			},
+		}
+		next *Config
+	)
+	if _, err := s.Reload(context.Background()); err == nil {
+		t.Fatal("expected an error without a config loader")
+	}
+	s.SetConfigLoader(func() (*Config, error) { return next, nil })
+
+	next = load(`
+root = "/srv/containerd"
+[debug]
+  level = "debug"
+[plugins.test]
+  name = "second"
+  restart = "cache"
+[plugins.other]
+  size = 2
+[plugins.same]
+  size = 1
+`)
+	report, err := s.Reload(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"debug.level", "plugins.test"}; !reflect.DeepEqual(report.Applied, expected) {
+		t.Errorf("expected %v to be applied but got %v", expected, report.Applied)
+	}
+	if expected := []string{"root", "plugins.other", "plugins.test.cache"}; !reflect.DeepEqual(report.RestartRequired, expected) {
+		t.Errorf("expected %v to require a restart but got %v", expected, report.RestartRequired)
+	}
+	if len(report.Failed) != 0 {
+		t.Errorf("expected no failures but got %v", report.Failed)
+	}
+	if logrus.GetLevel() != logrus.DebugLevel {
+		t.Errorf("expected the debug level to be applied but got %s", logrus.GetLevel())
+	}
+	if s.currentConfig() != next {
+		t.Error("expected the reloaded configuration to be kept")
+	}
+
+	// refused settings are kept and retried by the next reload
+	current := next
+	next = load(`
+root = "/srv/containerd"
+[debug]
+  level = "debug"
+[plugins.test]
+  name = "invalid"
+[plugins.other]
+  size = 2
+[plugins.same]
+  size = 1
+`)
+	if report, err = s.Reload(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := report.Failed["plugins.test"]; !ok || len(report.Applied) != 0 || len(report.RestartRequired) != 0 {
+		t.Errorf("expected only plugins.test to fail but got %+v", report)
+	}
+	if s.currentConfig() != current {
+		t.Error("expected the previous configuration to be kept")
+	}
+	if expected := []testReloaderConfig{{Name: "second", Restart: "cache"}}; !reflect.DeepEqual(r.configs, expected) {
+		t.Errorf("expected the plugin to be reloaded with %v but got %v", expected, r.configs)
+	}
+}
@@ -3,31 +3,56 @@ package server
 import (
 	"errors"
 	"expvar"
+	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/http/pprof"
 	"os"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/boltdb/bolt"
+	chaosapi "github.com/containerd/containerd/api/services/chaos/v1"
+	checkpointsapi "github.com/containerd/containerd/api/services/checkpoints/v1"
 	containers "github.com/containerd/containerd/api/services/containers/v1"
 	content "github.com/containerd/containerd/api/services/content/v1"
+	criapi "github.com/containerd/containerd/api/services/cri/v1alpha1"
+	daemonapi "github.com/containerd/containerd/api/services/daemon/v1"
+	diagapi "github.com/containerd/containerd/api/services/diag/v1"
 	diff "github.com/containerd/containerd/api/services/diff/v1"
+	dnsapi "github.com/containerd/containerd/api/services/dns/v1"
 	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	fifosapi "github.com/containerd/containerd/api/services/fifos/v1"
 	images "github.com/containerd/containerd/api/services/images/v1"
+	introspectionapi "github.com/containerd/containerd/api/services/introspection/v1"
+	metadataapi "github.com/containerd/containerd/api/services/metadata/v1"
+	migrationapi "github.com/containerd/containerd/api/services/migration/v1"
 	namespaces "github.com/containerd/containerd/api/services/namespaces/v1"
+	profilesapi "github.com/containerd/containerd/api/services/profiles/v1"
+	sandboxesapi "github.com/containerd/containerd/api/services/sandboxes/v1"
 	snapshot "github.com/containerd/containerd/api/services/snapshot/v1"
+	specapi "github.com/containerd/containerd/api/services/spec/v1"
+	statsapi "github.com/containerd/containerd/api/services/stats/v1"
+	stdioapi "github.com/containerd/containerd/api/services/stdio/v1"
 	tasks "github.com/containerd/containerd/api/services/tasks/v1"
 	version "github.com/containerd/containerd/api/services/version/v1"
+	"github.com/containerd/containerd/authz"
 	"github.com/containerd/containerd/content/local"
+	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/events"
 	"github.com/containerd/containerd/log"
 	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/record"
 	metrics "github.com/docker/go-metrics"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"golang.org/x/net/trace"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
@@ -48,24 +73,59 @@ func New(ctx context.Context, config *Config) (*Server, error) {
 	if err := apply(ctx, config); err != nil {
 		return nil, err
 	}
+	applyProfiling(config.Debug)
+	clock, err := events.NewClock(config.Events.Clock, filepath.Join(config.Root, "epoch"))
+	if err != nil {
+		return nil, err
+	}
 	plugins, err := loadPlugins(config)
 	if err != nil {
 		return nil, err
 	}
-	rpc := grpc.NewServer(
-		grpc.UnaryInterceptor(interceptor),
-		grpc.StreamInterceptor(grpc_prometheus.StreamServerInterceptor),
-	)
+	// plugins are given a context that is canceled when the server is
+	// stopped so that their background routines exit before they are closed
+	ctx, cancel := context.WithCancel(ctx)
 	var (
 		services []plugin.Service
 		s        = &Server{
-			rpc:    rpc,
-			events: events.NewExchange(),
+			events:   events.NewExchange(events.WithClock(clock)),
+			plugins:  plugin.NewSet(),
+			cancel:   cancel,
+			shutdown: config.Shutdown,
+
+			deprecationWarnings: config.GRPC.DeprecationWarnings,
+			config:              config,
+			recentEvents:        newRing(historySize),
+			recentLogs:          newRing(historySize),
+			reloaders:           make(map[string]reloader),
 		}
 		initialized = make(map[plugin.PluginType]map[string]interface{})
 	)
+	// keep the recent events and logs for the support bundles
+	logrus.AddHook(&logHook{entries: s.recentLogs})
+	go recordEvents(ctx, s.events, s.recentEvents)
+	creds := &transportCredentials{}
+	if config.GRPC.TCP.Enabled {
+		if creds.tls, err = newTLSConfig(config.GRPC.TCP); err != nil {
+			return nil, err
+		}
+	}
+	if s.recorder, s.trace, err = newRecorder(config.GRPC.Record); err != nil {
+		return nil, err
+	}
+	rpc := grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.UnaryInterceptor(s.interceptor),
+		grpc.StreamInterceptor(s.streamInterceptor),
+	)
+	s.rpc = rpc
+	warnUnknownPlugins(ctx, config, plugins)
 	for _, p := range plugins {
 		id := p.URI()
+		if config.Disabled(p.ID, id) {
+			log.G(ctx).WithField("type", p.Type).Infof("skip loading disabled plugin %q...", id)
+			continue
+		}
 		log.G(ctx).WithField("type", p.Type).Infof("loading plugin %q...", id)
 
 		initContext := plugin.NewContext(
@@ -77,16 +137,28 @@ func New(ctx context.Context, config *Config) (*Server, error) {
 		)
 		initContext.Events = s.events
 		initContext.Address = config.GRPC.Address
+		initContext.Plugins = s.plugins
 
 		// load the plugin specific configuration if it is provided
+		var defaults interface{}
 		if p.Config != nil {
+			defaults = copyConfig(p.Config)
 			pluginConfig, err := config.Decode(p.ID, p.Config)
 			if err != nil {
+				s.Stop()
 				return nil, err
 			}
 			initContext.Config = pluginConfig
+		} else if _, ok := config.Plugins[p.ID]; ok {
+			s.Stop()
+			return nil, fmt.Errorf("plugin %q does not accept configuration", id)
 		}
 		instance, err := p.Init(initContext)
+		s.plugins.Add(&plugin.Plugin{
+			Registration: p,
+			Meta:         initContext.Meta,
+			Err:          err,
+		})
 		if err != nil {
 			if plugin.IsSkipPlugin(err) {
 				log.G(ctx).WithField("type", p.Type).Infof("skip loading plugin %q...", id)
@@ -107,20 +179,88 @@ func New(ctx context.Context, config *Config) (*Server, error) {
 		if service, ok := instance.(plugin.Service); ok {
 			services = append(services, service)
 		}
+		if c, ok := instance.(io.Closer); ok {
+			s.closers = append(s.closers, closer{id: id, Closer: c})
+		}
+		if f, ok := instance.(plugin.Flusher); ok {
+			s.flushers = append(s.flushers, flusher{id: id, Flusher: f})
+		}
+		if r, ok := instance.(plugin.Reloader); ok && defaults != nil {
+			s.reloaders[p.ID] = reloader{Reloader: r, defaults: defaults}
+		}
 	}
+	for id, instance := range initialized[plugin.AuthzPlugin] {
+		a, ok := instance.(authz.Authorizer)
+		if !ok {
+			s.Stop()
+			return nil, fmt.Errorf("authorization plugin %q is not an authorizer", id)
+		}
+		s.authorizers = append(s.authorizers, a)
+	}
+	s.tasks, _ = initialized[plugin.GRPCPlugin]["tasks"].(tasks.TasksServer)
+	s.db, _ = initialized[plugin.MetadataPlugin]["bolt"].(*bolt.DB)
+	s.health, _ = initialized[plugin.GRPCPlugin]["healthcheck"].(healthService)
 	// register services after all plugins have been initialized
 	for _, service := range services {
 		if err := service.Register(rpc); err != nil {
+			s.Stop()
 			return nil, err
 		}
 	}
+	diagapi.RegisterDiagServer(rpc, &diagService{s: s})
+	daemonapi.RegisterDaemonServer(rpc, &daemonService{s: s})
 	return s, nil
 }
 
 // Server is the containerd main daemon
 type Server struct {
-	rpc    *grpc.Server
-	events *events.Exchange
+	rpc     *grpc.Server
+	events  *events.Exchange
+	plugins *plugin.Set
+	cancel  func()
+	closers []closer
+	// flushers deliver the data they hold when the daemon shuts down
+	flushers []flusher
+
+	shutdown ShutdownConfig
+	tasks    tasks.TasksServer
+	db       *bolt.DB
+	// draining is set once the tasks are being stopped for shutdown
+	draining int32
+	// deprecationWarnings sends the deprecations used by calls to clients
+	deprecationWarnings bool
+	// authorizers allow or deny each call
+	authorizers []authz.Authorizer
+	// health reports the daemon as not serving once it shuts down
+	health healthService
+	// config is included in the support bundles and is replaced when it
+	// is reloaded
+	configMu sync.Mutex
+	config   *Config
+	// reloadMu serializes the reloads of the configuration
+	reloadMu   sync.Mutex
+	loadConfig func() (*Config, error)
+	// reloaders are the plugins whose configuration can be reloaded, by id
+	reloaders map[string]reloader
+	// recentEvents and recentLogs are included in the support bundles
+	recentEvents *ring
+	recentLogs   *ring
+	// recorder records the calls to the trace, if configured
+	recorder *record.Recorder
+	trace    io.Closer
+}
+
+// closer is an initialized plugin that releases its resources on shutdown
+type closer struct {
+	id string
+	io.Closer
+}
+
+// flusher is an initialized plugin that delivers the data it holds on
+// shutdown
+type flusher struct {
+	id string
+	plugin.Flusher
 }
 
 // ServeGRPC provides the containerd grpc APIs on the provided listener
@@ -150,17 +290,63 @@ func (s *Server) ServeDebug(l net.Listener) error {
 	m.Handle("/debug/pprof/profile", http.HandlerFunc(pprof.Profile))
 	m.Handle("/debug/pprof/symbol", http.HandlerFunc(pprof.Symbol))
 	m.Handle("/debug/pprof/trace", http.HandlerFunc(pprof.Trace))
+	// the traces of the GRPC requests, access to the debug socket is
+	// restricted by its permissions
+	m.Handle("/debug/requests", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		trace.Render(w, r, true)
+	}))
+	m.Handle("/debug/events", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		trace.RenderEvents(w, r, true)
+	}))
 	return http.Serve(l, m)
 }
 
-// Stop gracefully stops the containerd server
+// Stop gracefully stops the containerd server and closes its plugins
 func (s *Server) Stop() {
+	s.setServing(false)
 	s.rpc.GracefulStop()
+	s.cancel()
+	// close plugins in the reverse order of their initialization so that each
+	// plugin is closed before the plugins it requires
+	for i := len(s.closers) - 1; i >= 0; i-- {
+		c := s.closers[i]
+		log.L.WithField("id", c.id).Debug("closing plugin")
+		if err := c.Close(); err != nil {
+			log.L.WithError(err).WithField("id", c.id).Error("failed to close plugin")
+		}
+	}
+	if s.trace != nil {
+		s.trace.Close()
+	}
+}
+
+// warnUnknownPlugins logs the plugin sections and disabled plugins of the
+// config that do not name a plugin of this build
+func warnUnknownPlugins(ctx context.Context, config *Config, plugins []*plugin.Registration) {
+	known := make(map[string]struct{})
+	for _, p := range plugins {
+		known[p.ID] = struct{}{}
+		known[p.URI()] = struct{}{}
+	}
+	for id := range config.Plugins {
+		if _, ok := known[id]; !ok {
+			log.G(ctx).Warnf("ignoring configuration of unknown plugin %q", id)
+		}
+	}
+	for _, id := range config.DisabledPlugins {
+		if _, ok := known[id]; !ok {
+			log.G(ctx).Warnf("unknown plugin %q cannot be disabled", id)
+		}
+	}
 }
 
 func loadPlugins(config *Config) ([]*plugin.Registration, error) {
 	// load all plugins into containerd
-	if err := plugin.Load(filepath.Join(config.Root, "plugins")); err != nil {
+	path := config.PluginDir
+	if path == "" {
+		path = filepath.Join(config.Root, "plugins")
+	}
+	if err := plugin.Load(path); err != nil {
 		return nil, err
 	}
 	// load additional plugins that don't automatically register themselves
@@ -186,13 +372,37 @@ func loadPlugins(config *Config) ([]*plugin.Registration, error) {
 	return plugin.Graph(), nil
 }
 
-func interceptor(
+func (s *Server) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	// the requests of streams are not available, streaming methods are
+	// authorized and tracked by method only
+	if err := s.authorize(ss.Context(), info.FullMethod, nil); err != nil {
+		return err
+	}
+	s.observeDeprecations(ss.Context(), info.FullMethod, nil)
+	start := time.Now()
+	err := grpc_prometheus.StreamServerInterceptor(srv, ss, info, recoverStream(info.FullMethod, handler))
+	logRequest(ss.Context(), info.FullMethod, nil, time.Since(start), err)
+	return err
+}
+
+func (s *Server) interceptor(
 	ctx context.Context,
 	req interface{},
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
 ) (interface{}, error) {
+	if s.isDraining() && startsProcess(info.FullMethod) {
+		return nil, grpc.Errorf(codes.Unavailable, "containerd is shutting down")
+	}
+	if err := s.authorize(ctx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	if len(s.authorizers) > 0 {
+		// the calls made on behalf of the call are authorized as their own
+		ctx = authz.WithAuthorize(ctx, s.authorize)
+	}
 	ctx = log.WithModule(ctx, "containerd")
+	s.observeDeprecations(ctx, info.FullMethod, req)
 	switch info.Server.(type) {
 	case tasks.TasksServer:
 		ctx = log.WithModule(ctx, "tasks")
@@ -214,8 +424,48 @@ func interceptor(
 		ctx = log.WithModule(ctx, "namespaces")
 	case eventsapi.EventsServer:
 		ctx = log.WithModule(ctx, "events")
+	case chaosapi.ChaosServer:
+		ctx = log.WithModule(ctx, "chaos")
+	case dnsapi.DNSServer:
+		ctx = log.WithModule(ctx, "dns")
+	case statsapi.StatsServer:
+		ctx = log.WithModule(ctx, "stats")
+	case stdioapi.StdioServer:
+		ctx = log.WithModule(ctx, "stdio")
+	case criapi.RuntimeServiceServer, criapi.ImageServiceServer:
+		ctx = log.WithModule(ctx, "cri")
+	case daemonapi.DaemonServer:
+		ctx = log.WithModule(ctx, "daemon")
+	case fifosapi.FifosServer:
+		ctx = log.WithModule(ctx, "fifos")
+	case profilesapi.ProfilesServer:
+		ctx = log.WithModule(ctx, "profiles")
+	case checkpointsapi.CheckpointsServer:
+		ctx = log.WithModule(ctx, "checkpoints")
+	case sandboxesapi.SandboxesServer:
+		ctx = log.WithModule(ctx, "sandboxes")
+	case specapi.SpecServer:
+		ctx = log.WithModule(ctx, "spec")
+	case diagapi.DiagServer:
+		ctx = log.WithModule(ctx, "diag")
+	case introspectionapi.IntrospectionServer:
+		ctx = log.WithModule(ctx, "introspection")
+	case metadataapi.MetadataServer:
+		ctx = log.WithModule(ctx, "metadata")
+	case migrationapi.MigrationServer:
+		ctx = log.WithModule(ctx, "migration")
 	default:
 		log.G(ctx).Warnf("unknown GRPC server type: %#v\n", info.Server)
 	}
-	return grpc_prometheus.UnaryServerInterceptor(ctx, req, info, handler)
+	start := time.Now()
+	resp, err := grpc_prometheus.UnaryServerInterceptor(ctx, req, info, recoverUnary(info.FullMethod, handler))
+	logRequest(ctx, info.FullMethod, req, time.Since(start), err)
+	if s.recorder != nil {
+		if rerr := s.recorder.Record(ctx, info.FullMethod, req, resp, time.Since(start), err); rerr != nil {
+			log.G(ctx).WithError(rerr).Warn("failed to record call")
+		}
+	}
+	// attach remediation hints to errors caused by known failures of the
+	// runtime and the kernel
+	return resp, errdefs.WithHint(err)
 }
@@ -0,0 +1,294 @@
+package server
+
+import (
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/boltdb/bolt"
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	tasks "github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/containerd/containerd/api/types/task"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/typeurl"
+	"golang.org/x/net/context"
+)
+
+const (
+	// StopTimeoutLabel overrides the grace period of the container's task
+	// when it is stopped for shutdown, for example "30s"
+	StopTimeoutLabel = "containerd.io/stop.timeout"
+
+	defaultStopTimeout = 10 * time.Second
+	// killTimeout is how long tasks are waited for after SIGKILL
+	killTimeout = 5 * time.Second
+)
+
+// Shutdown stops the server. New tasks and processes are refused and, when
+// the config asks for the tasks to be stopped, every running task is sent
+// SIGTERM, and SIGKILL once its grace period expires, so that the exits of
+// the tasks are published to subscribers. Otherwise the tasks are left
+// running to be restored. The calls in flight are then given the timeout of
+// the config to complete and the events held by the plugins are flushed
+// before the server is stopped.
+func (s *Server) Shutdown(ctx context.Context) {
+	// load balancers and liveness probes stop sending calls while the
+	// tasks are stopped
+	s.setServing(false)
+	atomic.StoreInt32(&s.draining, 1)
+	timeout := defaultStopTimeout
+	if s.shutdown.Timeout != "" {
+		d, err := time.ParseDuration(s.shutdown.Timeout)
+		if err != nil {
+			log.G(ctx).WithError(err).Errorf("invalid shutdown timeout, using %s", timeout)
+		} else {
+			timeout = d
+		}
+	}
+	if s.shutdown.StopTasks && s.tasks != nil && s.db != nil {
+		// stop the background routines of the plugins first so that the
+		// stopped tasks are not restarted
+		s.cancel()
+		if err := s.stopTasks(ctx, timeout); err != nil {
+			log.G(ctx).WithError(err).Error("failed to stop tasks")
+		}
+	}
+	s.drain(ctx, timeout)
+	s.flush(ctx, timeout)
+	s.Stop()
+}
+
+// drain waits for the calls in flight to complete, closing the connections
+// of those still running after the timeout, such as subscriptions
+func (s *Server) drain(ctx context.Context, timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		s.rpc.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		log.G(ctx).Warnf("calls still in flight after %s, closing their connections", timeout)
+		s.rpc.Stop()
+		<-done
+	}
+}
+
+// flush has the plugins deliver the events they hold, within the timeout
+func (s *Server) flush(ctx context.Context, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for _, f := range s.flushers {
+		if err := f.Flush(ctx); err != nil {
+			log.G(ctx).WithError(err).WithField("id", f.id).Error("failed to flush plugin")
+		}
+	}
+}
+
+// healthService is the health service of the daemon
+type healthService interface {
+	SetServing(bool)
+}
+
+func (s *Server) setServing(serving bool) {
+	if s.health != nil {
+		s.health.SetServing(serving)
+	}
+}
+
+func (s *Server) isDraining() bool {
+	return atomic.LoadInt32(&s.draining) == 1
+}
+
+// startsProcess returns true if the method starts a new task or process
+func startsProcess(method string) bool {
+	switch method {
+	case "/containerd.services.tasks.v1.Tasks/Create",
+		"/containerd.services.tasks.v1.Tasks/Start",
+		"/containerd.services.tasks.v1.Tasks/Exec",
+		"/containerd.services.tasks.v1.Tasks/BatchCreate",
+		"/containerd.services.tasks.v1.Tasks/BatchStart":
+		return true
+	}
+	return false
+}
+
+// stopTasks stops the running tasks of all namespaces and waits for them
+// to exit, each within the grace period of its container or the timeout
+func (s *Server) stopTasks(ctx context.Context, timeout time.Duration) error {
+	// subscribe before signaling so that no exit is missed
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	exits := newExitWaiter()
+	eventq, _ := s.events.Subscribe(ctx, `topic=="/tasks/exit"`)
+	go exits.watch(ctx, eventq)
+
+	var nss []string
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		nss, err = metadata.NewNamespaceStore(tx).List(ctx)
+		return err
+	}); err != nil {
+		return err
+	}
+	var wg sync.WaitGroup
+	for _, ns := range nss {
+		nctx := namespaces.WithNamespace(ctx, ns)
+		running, err := s.runningTasks(nctx)
+		if err != nil {
+			log.G(ctx).WithError(err).WithField("namespace", ns).Error("failed to list tasks")
+			continue
+		}
+		for _, id := range running {
+			wg.Add(1)
+			go func(ctx context.Context, ns, id string) {
+				defer wg.Done()
+				s.stopTask(ctx, id, s.stopTimeout(ctx, id, timeout), exits.wait(ns, id))
+			}(nctx, ns, id)
+		}
+	}
+	wg.Wait()
+	return nil
+}
+
+// runningTasks returns the ids of the tasks of the namespace that have not
+// exited
+func (s *Server) runningTasks(ctx context.Context) ([]string, error) {
+	ps, err := s.listTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, t := range ps {
+		if t.Status != task.StatusStopped {
+			ids = append(ids, t.ID)
+		}
+	}
+	return ids, nil
+}
+
+// listTasks returns the tasks of the namespace
+func (s *Server) listTasks(ctx context.Context) ([]*task.Process, error) {
+	var (
+		ps    []*task.Process
+		token string
+	)
+	for {
+		r, err := s.tasks.List(ctx, &tasks.ListTasksRequest{PageToken: token})
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, r.Tasks...)
+		if r.NextPageToken == "" {
+			return ps, nil
+		}
+		token = r.NextPageToken
+	}
+}
+
+// stopTimeout returns the grace period of the container's task
+func (s *Server) stopTimeout(ctx context.Context, id string, timeout time.Duration) time.Duration {
+	var label string
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		c, err := metadata.NewContainerStore(tx).Get(ctx, id)
+		label = c.Labels[StopTimeoutLabel]
+		return err
+	}); err != nil || label == "" {
+		return timeout
+	}
+	d, err := time.ParseDuration(label)
+	if err != nil {
+		log.G(ctx).WithError(err).WithField("id", id).Warnf("invalid %s label", StopTimeoutLabel)
+		return timeout
+	}
+	return d
+}
+
+// stopTask sends SIGTERM to the task and SIGKILL if it has not exited
+// within the timeout
+func (s *Server) stopTask(ctx context.Context, id string, timeout time.Duration, exited <-chan struct{}) {
+	logger := log.G(ctx).WithField("id", id)
+	logger.Infof("stopping task, waiting %s before it is killed", timeout)
+	if _, err := s.tasks.Kill(ctx, &tasks.KillRequest{
+		ContainerID: id,
+		Signal:      uint32(syscall.SIGTERM),
+		All:         true,
+	}); err != nil {
+		logger.WithError(err).Error("failed to signal task")
+	}
+	select {
+	case <-exited:
+		return
+	case <-time.After(timeout):
+	}
+	logger.Warn("task did not exit in time, killing it")
+	if _, err := s.tasks.Kill(ctx, &tasks.KillRequest{
+		ContainerID: id,
+		Signal:      uint32(syscall.SIGKILL),
+		All:         true,
+	}); err != nil {
+		logger.WithError(err).Error("failed to kill task")
+	}
+	select {
+	case <-exited:
+	case <-time.After(killTimeout):
+		logger.Error("task did not exit after it was killed")
+	}
+}
+
+// exitWaiter notifies the exits of tasks published on the exchange
+type exitWaiter struct {
+	mu      sync.Mutex
+	waiters map[string]chan struct{}
+}
+
+func newExitWaiter() *exitWaiter {
+	return &exitWaiter{
+		waiters: make(map[string]chan struct{}),
+	}
+}
+
+// wait returns a channel closed when the task exits
+func (w *exitWaiter) wait(ns, id string) chan struct{} {
+	key := ns + "/" + id
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	ch, ok := w.waiters[key]
+	if !ok {
+		ch = make(chan struct{})
+		w.waiters[key] = ch
+	}
+	return ch
+}
+
+func (w *exitWaiter) watch(ctx context.Context, eventq <-chan *eventsapi.Envelope) {
+	for {
+		var ev *eventsapi.Envelope
+		select {
+		case ev = <-eventq:
+		case <-ctx.Done():
+			return
+		}
+		v, err := typeurl.UnmarshalAny(ev.Event)
+		if err != nil {
+			continue
+		}
+		// only the exit of a task's init process ends the task
+		e, ok := v.(*eventsapi.TaskExit)
+		if !ok || e.ID != e.ContainerID {
+			continue
+		}
+		ch := w.wait(ev.Namespace, e.ContainerID)
+		w.mu.Lock()
+		select {
+		case <-ch:
+		default:
+			close(ch)
+		}
+		w.mu.Unlock()
+	}
+}
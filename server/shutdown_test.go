@@ -0,0 +1,62 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/typeurl"
+	"golang.org/x/net/context"
+)
+
+func TestExitWaiter(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	envelope := func(ns string, e *eventsapi.TaskExit) *eventsapi.Envelope {
+		any, err := typeurl.MarshalAny(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &eventsapi.Envelope{Namespace: ns, Topic: "/tasks/exit", Event: any}
+	}
+
+	w := newExitWaiter()
+	eventq := make(chan *eventsapi.Envelope)
+	go w.watch(ctx, eventq)
+
+	exited := w.wait("default", "test")
+	// the exit of an exec'd process or of a task in another namespace does
+	// not end the task
+	eventq <- envelope("default", &eventsapi.TaskExit{ContainerID: "test", ID: "exec"})
+	eventq <- envelope("other", &eventsapi.TaskExit{ContainerID: "test", ID: "test"})
+	select {
+	case <-exited:
+		t.Fatal("task should not have exited")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	eventq <- envelope("default", &eventsapi.TaskExit{ContainerID: "test", ID: "test"})
+	select {
+	case <-exited:
+	case <-time.After(time.Second):
+		t.Fatal("task exit not received")
+	}
+	// a duplicate exit does not close the channel twice
+	eventq <- envelope("default", &eventsapi.TaskExit{ContainerID: "test", ID: "test"})
+}
+
+func TestStartsProcess(t *testing.T) {
+	for method, expected := range map[string]bool{
+		"/containerd.services.tasks.v1.Tasks/Create":      true,
+		"/containerd.services.tasks.v1.Tasks/Exec":        true,
+		"/containerd.services.tasks.v1.Tasks/BatchCreate": true,
+		"/containerd.services.tasks.v1.Tasks/Kill":        false,
+		"/containerd.services.tasks.v1.Tasks/Delete":      false,
+		"/containerd.services.tasks.v1.Tasks/BatchDelete": false,
+	} {
+		if startsProcess(method) != expected {
+			t.Errorf("%s: expected %v", method, expected)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package checkpoints
+
+import (
+	api "github.com/containerd/containerd/api/services/checkpoints/v1"
+	"github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/checkpoints"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+func checkpointsToProto(checkpoints []checkpoints.Checkpoint) []api.Checkpoint {
+	var checkpointspb []api.Checkpoint
+
+	for _, checkpoint := range checkpoints {
+		checkpointspb = append(checkpointspb, checkpointToProto(&checkpoint))
+	}
+
+	return checkpointspb
+}
+
+func checkpointToProto(checkpoint *checkpoints.Checkpoint) api.Checkpoint {
+	return api.Checkpoint{
+		Name:   checkpoint.Name,
+		Labels: checkpoint.Labels,
+		Target: types.Descriptor{
+			MediaType: checkpoint.Target.MediaType,
+			Digest:    checkpoint.Target.Digest,
+			Size_:     checkpoint.Target.Size,
+		},
+		ContainerID: checkpoint.ContainerID,
+		Parent:      checkpoint.Parent,
+		CreatedAt:   checkpoint.CreatedAt,
+	}
+}
+
+func checkpointFromProto(checkpointpb *api.Checkpoint) checkpoints.Checkpoint {
+	return checkpoints.Checkpoint{
+		Name:   checkpointpb.Name,
+		Labels: checkpointpb.Labels,
+		Target: ocispec.Descriptor{
+			MediaType: checkpointpb.Target.MediaType,
+			Digest:    checkpointpb.Target.Digest,
+			Size:      checkpointpb.Target.Size_,
+		},
+		ContainerID: checkpointpb.ContainerID,
+		Parent:      checkpointpb.Parent,
+	}
+}
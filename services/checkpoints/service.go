@@ -0,0 +1,261 @@
+package checkpoints
+
+import (
+	"encoding/json"
+
+	"github.com/boltdb/bolt"
+	api "github.com/containerd/containerd/api/services/checkpoints/v1"
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/checkpoints"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/plugin"
+	"github.com/golang/protobuf/ptypes/empty"
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.GRPCPlugin,
+		ID:   "checkpoints",
+		Requires: []plugin.PluginType{
+			plugin.MetadataPlugin,
+			plugin.ContentPlugin,
+		},
+		Init: func(ic *plugin.InitContext) (interface{}, error) {
+			m, err := ic.Get(plugin.MetadataPlugin)
+			if err != nil {
+				return nil, err
+			}
+			c, err := ic.Get(plugin.ContentPlugin)
+			if err != nil {
+				return nil, err
+			}
+			db := m.(*bolt.DB)
+			return &Service{
+				db:        db,
+				content:   metadata.NewContentStore(db, c.(content.Store)),
+				publisher: ic.Events,
+			}, nil
+		},
+	})
+}
+
+// Service records the checkpoints of containers stored in the content store
+type Service struct {
+	db        *bolt.DB
+	content   content.Store
+	publisher events.Publisher
+}
+
+var _ api.CheckpointsServer = &Service{}
+
+func (s *Service) Register(server *grpc.Server) error {
+	api.RegisterCheckpointsServer(server, s)
+	return nil
+}
+
+func (s *Service) Get(ctx context.Context, req *api.GetCheckpointRequest) (*api.GetCheckpointResponse, error) {
+	var resp api.GetCheckpointResponse
+
+	return &resp, errdefs.ToGRPC(s.db.View(func(tx *bolt.Tx) error {
+		checkpoint, err := metadata.NewCheckpointStore(tx).Get(ctx, req.Name)
+		if err != nil {
+			return err
+		}
+		resp.Checkpoint = checkpointToProto(&checkpoint)
+		return nil
+	}))
+}
+
+func (s *Service) List(ctx context.Context, req *api.ListCheckpointsRequest) (*api.ListCheckpointsResponse, error) {
+	var resp api.ListCheckpointsResponse
+
+	return &resp, errdefs.ToGRPC(s.db.View(func(tx *bolt.Tx) error {
+		checkpoints, err := metadata.NewCheckpointStore(tx).List(ctx, req.Filters...)
+		if err != nil {
+			return err
+		}
+		resp.Checkpoints = checkpointsToProto(checkpoints)
+		return nil
+	}))
+}
+
+// Create records the checkpoint once its index is found in the content store
+func (s *Service) Create(ctx context.Context, req *api.CreateCheckpointRequest) (*api.CreateCheckpointResponse, error) {
+	checkpoint := checkpointFromProto(&req.Checkpoint)
+	metadata.GCLock.RLock()
+	defer metadata.GCLock.RUnlock()
+	if checkpoint.Target.Digest != "" {
+		if _, err := s.content.Info(ctx, checkpoint.Target.Digest); err != nil {
+			return nil, errdefs.ToGRPC(errors.Wrapf(err, "checkpoint %q target", checkpoint.Name))
+		}
+	}
+
+	var resp api.CreateCheckpointResponse
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		created, err := metadata.NewCheckpointStore(tx).Create(ctx, checkpoint)
+		if err != nil {
+			return err
+		}
+		resp.Checkpoint = checkpointToProto(&created)
+		return nil
+	}); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+
+	if err := s.publisher.Publish(ctx, "/checkpoints/create", &eventsapi.CheckpointCreate{
+		Name:        resp.Checkpoint.Name,
+		ContainerID: resp.Checkpoint.ContainerID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Delete removes the checkpoint record, then the blobs of the checkpoint
+// that no remaining checkpoint references. The GC lock is held throughout so
+// that no checkpoint referencing the blobs is created before they are
+// removed.
+func (s *Service) Delete(ctx context.Context, req *api.DeleteCheckpointRequest) (*empty.Empty, error) {
+	var (
+		checkpoint checkpoints.Checkpoint
+		remaining  []checkpoints.Checkpoint
+	)
+	metadata.GCLock.Lock()
+	defer metadata.GCLock.Unlock()
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		store := metadata.NewCheckpointStore(tx)
+		var err error
+		if checkpoint, err = store.Get(ctx, req.Name); err != nil {
+			return err
+		}
+		if remaining, err = store.List(ctx); err != nil {
+			return err
+		}
+		if err := checkParent(checkpoint, remaining); err != nil {
+			return err
+		}
+		if err := store.Delete(ctx, req.Name); err != nil {
+			return err
+		}
+		remaining, err = store.List(ctx)
+		return err
+	}); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+
+	if err := s.removeBlobs(ctx, checkpoint, remaining); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+
+	if err := s.publisher.Publish(ctx, "/checkpoints/delete", &eventsapi.CheckpointDelete{
+		Name: req.Name,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// checkParent returns an error if the checkpoint is the parent of another
+// checkpoint and no other record has its target, as the images of a
+// checkpoint taken relative to a parent link to the images of the parent
+func checkParent(checkpoint checkpoints.Checkpoint, all []checkpoints.Checkpoint) error {
+	var child string
+	for _, c := range all {
+		if c.Name == checkpoint.Name {
+			continue
+		}
+		if c.Target.Digest == checkpoint.Target.Digest {
+			return nil
+		}
+		if c.Parent == checkpoint.Target.Digest.String() {
+			child = c.Name
+		}
+	}
+	if child != "" {
+		return errors.Wrapf(errdefs.ErrFailedPrecondition, "checkpoint %q is the parent of checkpoint %q", checkpoint.Name, child)
+	}
+	return nil
+}
+
+// removeBlobs deletes the index of the checkpoint and the blobs it owns, the
+// runtime checkpoint, spec and rootfs diff, from the content store unless
+// one of the remaining checkpoints references them. Image manifests are
+// left to the images.
+func (s *Service) removeBlobs(ctx context.Context, checkpoint checkpoints.Checkpoint, remaining []checkpoints.Checkpoint) error {
+	referenced := make(map[digest.Digest]struct{})
+	for _, c := range remaining {
+		referenced[c.Target.Digest] = struct{}{}
+		index, err := s.readIndex(ctx, c.Target.Digest)
+		if err != nil {
+			if errdefs.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		for _, m := range index.Manifests {
+			referenced[m.Digest] = struct{}{}
+		}
+	}
+	if _, ok := referenced[checkpoint.Target.Digest]; ok {
+		return nil
+	}
+
+	index, err := s.readIndex(ctx, checkpoint.Target.Digest)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	blobs := []digest.Digest{checkpoint.Target.Digest}
+	for _, m := range index.Manifests {
+		if _, ok := referenced[m.Digest]; ok || !owned(m.MediaType) {
+			continue
+		}
+		blobs = append(blobs, m.Digest)
+	}
+	for _, dgst := range blobs {
+		if err := s.content.Delete(ctx, dgst); err != nil && !errdefs.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to delete checkpoint blob %s", dgst)
+		}
+		log.G(ctx).WithField("checkpoint", checkpoint.Name).Debugf("deleted checkpoint blob %s", dgst)
+	}
+	return nil
+}
+
+func (s *Service) readIndex(ctx context.Context, dgst digest.Digest) (*ocispec.Index, error) {
+	p, err := content.ReadBlob(ctx, s.content, dgst)
+	if err != nil {
+		return nil, err
+	}
+	var index ocispec.Index
+	if err := json.Unmarshal(p, &index); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode checkpoint index %s", dgst)
+	}
+	return &index, nil
+}
+
+// owned returns true for the media types of the blobs written for a
+// checkpoint, rather than those of the image of the container
+func owned(mediaType string) bool {
+	switch mediaType {
+	case images.MediaTypeContainerd1Checkpoint,
+		images.MediaTypeContainerd1CheckpointConfig,
+		images.MediaTypeContainerd1CheckpointPreDump,
+		ocispec.MediaTypeImageLayer:
+		return true
+	}
+	return false
+}
@@ -26,7 +26,12 @@ func containerToProto(container *containers.Container) api.Container {
 		},
 		Spec:        container.Spec,
 		Snapshotter: container.Snapshotter,
+		SandboxID:   container.SandboxID,
 		RootFS:      container.RootFS,
+		CreatedAt:   container.CreatedAt,
+		UpdatedAt:   container.UpdatedAt,
+		Revision:    container.Revision,
+		Extensions:  container.Extensions,
 	}
 }
 
@@ -45,6 +50,11 @@ func containerFromProto(containerpb *api.Container) containers.Container {
 		Runtime:     runtime,
 		Spec:        containerpb.Spec,
 		Snapshotter: containerpb.Snapshotter,
+		SandboxID:   containerpb.SandboxID,
 		RootFS:      containerpb.RootFS,
+		CreatedAt:   containerpb.CreatedAt,
+		UpdatedAt:   containerpb.UpdatedAt,
+		Revision:    containerpb.Revision,
+		Extensions:  containerpb.Extensions,
 	}
 }
@@ -1,15 +1,23 @@
 package containers
 
 import (
+	"sort"
+
 	"github.com/boltdb/bolt"
+	"github.com/containerd/containerd/admission"
 	api "github.com/containerd/containerd/api/services/containers/v1"
 	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/api/types"
 	"github.com/containerd/containerd/containers"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/events"
 	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/runtime"
+	"github.com/gogo/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -21,25 +29,65 @@ func init() {
 		Type: plugin.GRPCPlugin,
 		ID:   "containers",
 		Requires: []plugin.PluginType{
+			plugin.RuntimePlugin,
 			plugin.MetadataPlugin,
+			plugin.AdmissionPlugin,
 		},
 		Init: func(ic *plugin.InitContext) (interface{}, error) {
 			m, err := ic.Get(plugin.MetadataPlugin)
 			if err != nil {
 				return nil, err
 			}
-			return NewService(m.(*bolt.DB), ic.Events), nil
+			rt, err := ic.GetAll(plugin.RuntimePlugin)
+			if err != nil {
+				return nil, err
+			}
+			var runtimes []runtime.Runtime
+			for _, r := range rt {
+				runtimes = append(runtimes, r.(runtime.Runtime))
+			}
+			// the admission controllers are optional so that all
+			// containers are admitted when none is loaded
+			rawControllers, _ := ic.GetAll(plugin.AdmissionPlugin)
+			controllers, err := sortedControllers(rawControllers)
+			if err != nil {
+				return nil, err
+			}
+			return NewService(m.(*bolt.DB), ic.Events, runtimes, controllers...), nil
 		},
 	})
 }
 
 type Service struct {
-	db        *bolt.DB
-	publisher events.Publisher
+	db          *bolt.DB
+	publisher   events.Publisher
+	runtimes    []runtime.Runtime
+	controllers []admission.Controller
 }
 
-func NewService(db *bolt.DB, publisher events.Publisher) api.ContainersServer {
-	return &Service{db: db, publisher: publisher}
+// NewService returns the containers service, admitting the containers
+// created or updated with the controllers
+func NewService(db *bolt.DB, publisher events.Publisher, runtimes []runtime.Runtime, controllers ...admission.Controller) api.ContainersServer {
+	return &Service{db: db, publisher: publisher, runtimes: runtimes, controllers: controllers}
+}
+
+// sortedControllers returns the admission controllers in the order of their
+// plugin ids
+func sortedControllers(plugins map[string]interface{}) ([]admission.Controller, error) {
+	ids := make([]string, 0, len(plugins))
+	for id := range plugins {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	var out []admission.Controller
+	for _, id := range ids {
+		c, ok := plugins[id].(admission.Controller)
+		if !ok {
+			return nil, errors.Errorf("admission plugin %q is not an admission controller", id)
+		}
+		out = append(out, c)
+	}
+	return out, nil
 }
 
 func (s *Service) Register(server *grpc.Server) error {
@@ -50,7 +98,7 @@ func (s *Service) Register(server *grpc.Server) error {
 func (s *Service) Get(ctx context.Context, req *api.GetContainerRequest) (*api.GetContainerResponse, error) {
 	var resp api.GetContainerResponse
 
-	return &resp, errdefs.ToGRPC(s.withStoreView(ctx, func(ctx context.Context, store containers.Store) error {
+	return &resp, containerError(s.withStoreView(ctx, func(ctx context.Context, store containers.Store) error {
 		container, err := store.Get(ctx, req.ID)
 		if err != nil {
 			return err
@@ -59,7 +107,7 @@ func (s *Service) Get(ctx context.Context, req *api.GetContainerRequest) (*api.G
 		resp.Container = containerpb
 
 		return nil
-	}))
+	}), req.ID)
 }
 
 func (s *Service) List(ctx context.Context, req *api.ListContainersRequest) (*api.ListContainersResponse, error) {
@@ -81,6 +129,9 @@ func (s *Service) Create(ctx context.Context, req *api.CreateContainerRequest) (
 
 	if err := s.withStoreUpdate(ctx, func(ctx context.Context, store containers.Store) error {
 		container := containerFromProto(&req.Container)
+		if err := s.admit(ctx, store, &container); err != nil {
+			return err
+		}
 
 		created, err := store.Create(ctx, container)
 		if err != nil {
@@ -91,7 +142,7 @@ func (s *Service) Create(ctx context.Context, req *api.CreateContainerRequest) (
 
 		return nil
 	}); err != nil {
-		return &resp, errdefs.ToGRPC(err)
+		return &resp, containerError(err, req.Container.ID)
 	}
 	if err := s.publisher.Publish(ctx, "/containers/create", &eventsapi.ContainerCreate{
 		ID:    resp.Container.ID,
@@ -124,7 +175,22 @@ func (s *Service) Update(ctx context.Context, req *api.UpdateContainerRequest) (
 			}
 		}
 
-		updated, err := store.Update(ctx, container)
+		if updatesSpec(fieldpaths) {
+			current, err := store.Get(ctx, container.ID)
+			if err != nil {
+				return err
+			}
+			if !proto.Equal(current.Spec, container.Spec) {
+				if s.hasTask(ctx, container.ID) {
+					return errors.Wrapf(errdefs.ErrFailedPrecondition, "cannot update spec of container %q with a task", container.ID)
+				}
+				if err := s.admit(ctx, store, &container); err != nil {
+					return err
+				}
+			}
+		}
+
+		updated, err := store.Update(ctx, container, fieldpaths...)
 		if err != nil {
 			return err
 		}
@@ -132,7 +198,7 @@ func (s *Service) Update(ctx context.Context, req *api.UpdateContainerRequest) (
 		resp.Container = containerToProto(&updated)
 		return nil
 	}); err != nil {
-		return &resp, errdefs.ToGRPC(err)
+		return &resp, containerError(err, req.Container.ID)
 	}
 
 	if err := s.publisher.Publish(ctx, "/containers/update", &eventsapi.ContainerUpdate{
@@ -151,7 +217,7 @@ func (s *Service) Delete(ctx context.Context, req *api.DeleteContainerRequest) (
 	if err := s.withStoreUpdate(ctx, func(ctx context.Context, store containers.Store) error {
 		return store.Delete(ctx, req.ID)
 	}); err != nil {
-		return &empty.Empty{}, errdefs.ToGRPC(err)
+		return &empty.Empty{}, containerError(err, req.ID)
 	}
 
 	if err := s.publisher.Publish(ctx, "/containers/delete", &eventsapi.ContainerDelete{
@@ -163,6 +229,70 @@ func (s *Service) Delete(ctx context.Context, req *api.DeleteContainerRequest) (
 	return &empty.Empty{}, nil
 }
 
+// containerError maps an error about the container to a grpc error detailing
+// its id
+func containerError(err error, id string) error {
+	return errdefs.ToGRPC(errdefs.WithDetail(err, types.ErrorDetail{ContainerID: id}))
+}
+
+// admit returns an error when an admission controller rejects the container
+// created or updated in the transaction of the store, which sees the other
+// containers of the namespace as they are committed
+func (s *Service) admit(ctx context.Context, store containers.Store, container *containers.Container) error {
+	if len(s.controllers) == 0 {
+		return nil
+	}
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return err
+	}
+	all, err := store.List(ctx)
+	if err != nil {
+		return err
+	}
+	others := all[:0]
+	for _, c := range all {
+		if c.ID != container.ID {
+			others = append(others, c)
+		}
+	}
+	r := &admission.Request{
+		Namespace:  namespace,
+		Container:  container,
+		Containers: others,
+	}
+	for _, c := range s.controllers {
+		if err := c.Admit(ctx, r); err != nil {
+			return errors.Wrapf(err, "container %s not admitted", container.ID)
+		}
+	}
+	return nil
+}
+
+// hasTask returns true if a runtime has a task for the container
+func (s *Service) hasTask(ctx context.Context, id string) bool {
+	for _, r := range s.runtimes {
+		if _, err := r.Get(ctx, id); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// updatesSpec returns true if an update with the field paths may change the
+// container's spec
+func updatesSpec(fieldpaths []string) bool {
+	if len(fieldpaths) == 0 {
+		return true
+	}
+	for _, path := range fieldpaths {
+		if path == "spec" {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Service) withStore(ctx context.Context, fn func(ctx context.Context, store containers.Store) error) func(tx *bolt.Tx) error {
 	return func(tx *bolt.Tx) error { return fn(ctx, metadata.NewContainerStore(tx)) }
 }
@@ -0,0 +1,610 @@
+package cri
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/containerd/containerd"
+	api "github.com/containerd/containerd/api/services/cri/v1alpha1"
+	dnsapi "github.com/containerd/containerd/api/services/dns/v1"
+	statsapi "github.com/containerd/containerd/api/services/stats/v1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/namespaces"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// SandboxLabel is set on the containers of the CRI service to the id of
+	// their pod sandbox
+	SandboxLabel = "containerd.io/cri.sandbox"
+
+	// unknownExitCode is the exit code of containers whose exit status was
+	// lost
+	unknownExitCode = 255
+	// startErrorExitCode is the exit code of containers whose task failed to
+	// be created or started
+	startErrorExitCode = 128
+)
+
+// CreateContainer creates the container in the pod with a new snapshot of
+// its image. Its task is created when it is started.
+func (s *Service) CreateContainer(ctx context.Context, req *api.CreateContainerRequest) (_ *api.CreateContainerResponse, err error) {
+	config := req.Config
+	if config == nil || config.Metadata == nil || config.Image == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "container metadata and image must be set")
+	}
+	sandbox, err := s.store.sandbox(req.PodSandboxID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if sandbox.State != api.PodSandboxState_SANDBOX_READY {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "pod sandbox %s is not ready", sandbox.ID)
+	}
+	ctx, client, err := s.withClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	image, err := s.resolveImage(ctx, client, config.Image.Image)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	r := containerRecord{
+		ID:        generateID(),
+		SandboxID: sandbox.ID,
+		Config:    config,
+		ImageRef:  image.id.String(),
+		State:     api.ContainerState_CONTAINER_CREATED,
+		CreatedAt: nowNano(),
+	}
+	if config.LogPath != "" && sandbox.Config.LogDirectory != "" {
+		r.LogPath = filepath.Join(sandbox.Config.LogDirectory, config.LogPath)
+	}
+	if err := s.store.addContainer(r); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	defer func() {
+		if err != nil {
+			if derr := s.store.deleteContainer(r.ID); derr != nil {
+				log.G(ctx).WithError(derr).WithField("id", r.ID).Warn("cri: failed to remove container record")
+			}
+		}
+	}()
+	i, err := client.GetImage(ctx, image.name)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	spec, err := containerSpec(r.ID, sandbox.Config, config, image.config.Config, func(user string) containerd.SpecOpts {
+		return containerd.WithUserName(ctx, r.ID, i, s.config.Snapshotter, user)
+	})
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	opts := []containerd.NewContainerOpts{
+		containerd.WithContainerLabels(map[string]string{
+			SandboxLabel: sandbox.ID,
+		}),
+		containerd.WithImage(i),
+		containerd.WithSnapshotter(s.config.Snapshotter),
+		containerd.WithNewSnapshot(r.ID, i),
+		containerd.WithSpec(spec),
+	}
+	if s.config.Runtime != "" {
+		opts = append(opts, containerd.WithRuntime(s.config.Runtime))
+	}
+	if !namespaceOptions(sandbox.Config).HostNetwork {
+		opts = append(opts, containerd.WithSandbox(sandbox.ID))
+	}
+	if dns := sandbox.Config.DnsConfig; dns != nil {
+		opts = append(opts, containerd.WithDNS(dnsapi.DNSConfig{
+			Nameservers: dns.Servers,
+			Search:      dns.Searches,
+			Options:     dns.Options,
+		}))
+	}
+	if _, err := client.NewContainer(ctx, r.ID, opts...); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &api.CreateContainerResponse{ContainerID: r.ID}, nil
+}
+
+// StartContainer creates and starts the task of the container, logging its
+// output to the log path of the container and keeping its stdio to be
+// attached
+func (s *Service) StartContainer(ctx context.Context, req *api.StartContainerRequest) (_ *api.StartContainerResponse, err error) {
+	r, err := s.store.container(req.ContainerID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if r.State != api.ContainerState_CONTAINER_CREATED {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "container %s is not in the created state", r.ID)
+	}
+	sandbox, err := s.store.sandbox(r.SandboxID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if sandbox.State != api.PodSandboxState_SANDBOX_READY {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "pod sandbox %s is not ready", sandbox.ID)
+	}
+	ctx, client, err := s.withClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	container, err := client.LoadContainer(ctx, r.ID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	exited := make(chan struct{})
+	defer func() {
+		if err != nil {
+			s.recordExit(r.ID, startErrorExitCode, nowNano(), "StartError", err.Error())
+			close(exited)
+		}
+	}()
+	s.mu.Lock()
+	s.exits[r.ID] = exited
+	s.mu.Unlock()
+
+	if r.LogPath != "" {
+		if err := os.MkdirAll(filepath.Dir(r.LogPath), 0755); err != nil {
+			return nil, errdefs.ToGRPC(err)
+		}
+	}
+	cio := newContainerIO(r.Config)
+	task, err := container.NewTask(ctx, cio.create(r.Config, r.LogPath))
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	defer func() {
+		if err != nil {
+			if _, derr := task.Delete(ctx, containerd.WithProcessKill); derr != nil {
+				log.G(ctx).WithError(derr).WithField("id", r.ID).Warn("cri: failed to delete task")
+			}
+		}
+	}()
+	// the exit is watched beyond the request
+	wctx := namespaces.WithNamespace(context.Background(), s.config.Namespace)
+	statusC, err := task.Wait(wctx)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if err := task.Start(ctx); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if err := s.store.updateContainer(r.ID, func(r *containerRecord) {
+		r.State = api.ContainerState_CONTAINER_RUNNING
+		r.StartedAt = nowNano()
+	}); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	s.mu.Lock()
+	s.ios[r.ID] = cio
+	s.mu.Unlock()
+	go s.watch(wctx, r.ID, task, statusC, exited)
+	return &api.StartContainerResponse{}, nil
+}
+
+// watch records the exit of the task of the container and deletes the task
+func (s *Service) watch(ctx context.Context, id string, task containerd.Task, statusC <-chan containerd.ExitStatus, exited chan struct{}) {
+	defer close(exited)
+	status := <-statusC
+	code, exitedAt, err := status.Result()
+	var (
+		exitCode = int32(code)
+		reason   = status.Reason()
+		message  string
+	)
+	switch {
+	case err != nil:
+		exitCode, exitedAt, reason, message = unknownExitCode, time.Now(), "Unknown", err.Error()
+	case reason != "":
+	case code == 0:
+		reason = "Completed"
+	default:
+		reason = "Error"
+	}
+	if _, err := task.Delete(ctx); err != nil && !errdefs.IsNotFound(err) {
+		log.G(ctx).WithError(err).WithField("id", id).Warn("cri: failed to delete exited task")
+	}
+	s.recordExit(id, exitCode, exitedAt.UnixNano(), reason, message)
+	s.mu.Lock()
+	delete(s.ios, id)
+	s.mu.Unlock()
+}
+
+// recordExit records the container exited, unless it was removed
+func (s *Service) recordExit(id string, code int32, finishedAt int64, reason, message string) {
+	if err := s.store.updateContainer(id, func(r *containerRecord) {
+		r.State = api.ContainerState_CONTAINER_EXITED
+		r.ExitCode = code
+		r.FinishedAt = finishedAt
+		r.Reason = reason
+		r.Message = message
+	}); err != nil && !errdefs.IsNotFound(err) {
+		log.L.WithError(err).WithField("id", id).Error("cri: failed to record exit of container")
+	}
+}
+
+// StopContainer sends SIGTERM to the task of the container and SIGKILL once
+// the timeout, in seconds, elapsed
+func (s *Service) StopContainer(ctx context.Context, req *api.StopContainerRequest) (*api.StopContainerResponse, error) {
+	if _, err := s.store.container(req.ContainerID); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	ctx, client, err := s.withClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.stopContainer(ctx, client, req.ContainerID, time.Duration(req.Timeout)*time.Second); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &api.StopContainerResponse{}, nil
+}
+
+func (s *Service) stopContainer(ctx context.Context, client *containerd.Client, id string, timeout time.Duration) error {
+	r, err := s.store.container(id)
+	if err != nil {
+		return err
+	}
+	if r.State != api.ContainerState_CONTAINER_RUNNING {
+		return nil
+	}
+	s.mu.Lock()
+	exited := s.exits[id]
+	s.mu.Unlock()
+	container, err := client.LoadContainer(ctx, id)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	task, err := container.Task(ctx, nil)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if timeout > 0 {
+		if err := task.Kill(ctx, syscall.SIGTERM); err != nil && !errdefs.IsNotFound(err) {
+			return err
+		}
+		select {
+		case <-exited:
+			return nil
+		case <-time.After(timeout):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if err := task.Kill(ctx, syscall.SIGKILL); err != nil && !errdefs.IsNotFound(err) {
+		return err
+	}
+	select {
+	case <-exited:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// deleteContainer deletes the container and its snapshot from containerd
+func deleteContainer(ctx context.Context, client *containerd.Client, id string) error {
+	container, err := client.LoadContainer(ctx, id)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	// the task of a container whose start failed half way
+	if task, err := container.Task(ctx, nil); err == nil {
+		if _, err := task.Delete(ctx, containerd.WithProcessKill); err != nil && !errdefs.IsNotFound(err) {
+			return err
+		}
+	}
+	return container.Delete(ctx, containerd.WithSnapshotCleanup)
+}
+
+// RemoveContainer kills the task of the container and removes it
+func (s *Service) RemoveContainer(ctx context.Context, req *api.RemoveContainerRequest) (*api.RemoveContainerResponse, error) {
+	if _, err := s.store.container(req.ContainerID); err != nil {
+		if errdefs.IsNotFound(err) {
+			return &api.RemoveContainerResponse{}, nil
+		}
+		return nil, errdefs.ToGRPC(err)
+	}
+	ctx, client, err := s.withClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.stopContainer(ctx, client, req.ContainerID, 0); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if err := deleteContainer(ctx, client, req.ContainerID); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if err := s.store.deleteContainer(req.ContainerID); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	s.mu.Lock()
+	delete(s.exits, req.ContainerID)
+	s.mu.Unlock()
+	return &api.RemoveContainerResponse{}, nil
+}
+
+// ListContainers returns the containers matching the filter
+func (s *Service) ListContainers(ctx context.Context, req *api.ListContainersRequest) (*api.ListContainersResponse, error) {
+	var (
+		resp   api.ListContainersResponse
+		filter = req.Filter
+	)
+	for _, r := range s.store.listContainers() {
+		if filter != nil {
+			if filter.ID != "" && !strings.HasPrefix(r.ID, filter.ID) {
+				continue
+			}
+			if filter.State != nil && filter.State.State != r.State {
+				continue
+			}
+			if filter.PodSandboxID != "" && !strings.HasPrefix(r.SandboxID, filter.PodSandboxID) {
+				continue
+			}
+			if !matchLabels(filter.LabelSelector, r.Config.Labels) {
+				continue
+			}
+		}
+		resp.Containers = append(resp.Containers, &api.Container{
+			ID:           r.ID,
+			PodSandboxID: r.SandboxID,
+			Metadata:     r.Config.Metadata,
+			Image:        r.Config.Image,
+			ImageRef:     r.ImageRef,
+			State:        r.State,
+			CreatedAt:    r.CreatedAt,
+			Labels:       r.Config.Labels,
+			Annotations:  r.Config.Annotations,
+		})
+	}
+	return &resp, nil
+}
+
+// ContainerStatus returns the status of the container
+func (s *Service) ContainerStatus(ctx context.Context, req *api.ContainerStatusRequest) (*api.ContainerStatusResponse, error) {
+	r, err := s.store.container(req.ContainerID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &api.ContainerStatusResponse{
+		Status: &api.ContainerStatus{
+			ID:          r.ID,
+			Metadata:    r.Config.Metadata,
+			State:       r.State,
+			CreatedAt:   r.CreatedAt,
+			StartedAt:   r.StartedAt,
+			FinishedAt:  r.FinishedAt,
+			ExitCode:    r.ExitCode,
+			Image:       r.Config.Image,
+			ImageRef:    r.ImageRef,
+			Reason:      r.Reason,
+			Message:     r.Message,
+			Labels:      r.Config.Labels,
+			Annotations: r.Config.Annotations,
+			Mounts:      r.Config.Mounts,
+			LogPath:     r.LogPath,
+		},
+	}, nil
+}
+
+// UpdateContainerResources updates the resources of the running task of the
+// container and records them for its status
+func (s *Service) UpdateContainerResources(ctx context.Context, req *api.UpdateContainerResourcesRequest) (*api.UpdateContainerResourcesResponse, error) {
+	r, err := s.store.container(req.ContainerID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if req.Linux == nil {
+		return &api.UpdateContainerResourcesResponse{}, nil
+	}
+	ctx, client, err := s.withClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.State == api.ContainerState_CONTAINER_RUNNING {
+		task, err := loadTask(ctx, client, r.ID)
+		if err != nil {
+			return nil, errdefs.ToGRPC(err)
+		}
+		if err := task.Update(ctx, containerd.WithResources(linuxResources(req.Linux))); err != nil {
+			return nil, errdefs.ToGRPC(err)
+		}
+	}
+	if err := s.store.updateContainer(r.ID, func(r *containerRecord) {
+		if r.Config.Linux == nil {
+			r.Config.Linux = &api.LinuxContainerConfig{}
+		}
+		r.Config.Linux.Resources = req.Linux
+	}); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &api.UpdateContainerResourcesResponse{}, nil
+}
+
+func loadTask(ctx context.Context, client *containerd.Client, id string) (containerd.Task, error) {
+	container, err := client.LoadContainer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return container.Task(ctx, nil)
+}
+
+// ExecSync runs the command in the running container with the process
+// configuration of the container, returning its output once it exits
+func (s *Service) ExecSync(ctx context.Context, req *api.ExecSyncRequest) (*api.ExecSyncResponse, error) {
+	r, err := s.store.container(req.ContainerID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if r.State != api.ContainerState_CONTAINER_RUNNING {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "container %s is not running", r.ID)
+	}
+	if len(req.Cmd) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "command must be set")
+	}
+	ctx, client, err := s.withClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	container, err := client.LoadContainer(ctx, r.ID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	spec, err := container.Spec()
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	task, err := container.Task(ctx, nil)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	pspec := *spec.Process
+	pspec.Args = req.Cmd
+	pspec.Terminal = false
+
+	var stdout, stderr bytes.Buffer
+	process, err := task.Exec(ctx, generateID(), &pspec, containerd.NewIO(strings.NewReader(""), &stdout, &stderr))
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	defer func() {
+		if _, err := process.Delete(ctx, containerd.WithProcessKill); err != nil && !errdefs.IsNotFound(err) {
+			log.G(ctx).WithError(err).WithField("id", r.ID).Warn("cri: failed to delete exec process")
+		}
+	}()
+	statusC, err := process.Wait(ctx)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if err := process.Start(ctx); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	var timeout <-chan time.Time
+	if req.Timeout > 0 {
+		timer := time.NewTimer(time.Duration(req.Timeout) * time.Second)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	var status containerd.ExitStatus
+	select {
+	case status = <-statusC:
+	case <-timeout:
+		process.Kill(ctx, syscall.SIGKILL)
+		<-statusC
+		return nil, grpc.Errorf(codes.DeadlineExceeded, "command %v timed out after %ds", req.Cmd, req.Timeout)
+	}
+	code, _, err := status.Result()
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	process.IO().Wait()
+	return &api.ExecSyncResponse{
+		Stdout:   stdout.Bytes(),
+		Stderr:   stderr.Bytes(),
+		ExitCode: int32(code),
+	}, nil
+}
+
+// ContainerStats returns the usage of the task of the container
+func (s *Service) ContainerStats(ctx context.Context, req *api.ContainerStatsRequest) (*api.ContainerStatsResponse, error) {
+	r, err := s.store.container(req.ContainerID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	ctx, client, err := s.withClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := containerStats(ctx, client, r)
+	if err != nil {
+		return nil, err
+	}
+	return &api.ContainerStatsResponse{Stats: stats}, nil
+}
+
+// ListContainerStats returns the usage of the running containers matching
+// the filter
+func (s *Service) ListContainerStats(ctx context.Context, req *api.ListContainerStatsRequest) (*api.ListContainerStatsResponse, error) {
+	ctx, client, err := s.withClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		resp   api.ListContainerStatsResponse
+		filter = req.Filter
+	)
+	for _, r := range s.store.listContainers() {
+		if r.State != api.ContainerState_CONTAINER_RUNNING {
+			continue
+		}
+		if filter != nil {
+			if filter.ID != "" && !strings.HasPrefix(r.ID, filter.ID) {
+				continue
+			}
+			if filter.PodSandboxID != "" && !strings.HasPrefix(r.SandboxID, filter.PodSandboxID) {
+				continue
+			}
+			if !matchLabels(filter.LabelSelector, r.Config.Labels) {
+				continue
+			}
+		}
+		stats, err := containerStats(ctx, client, r)
+		if err != nil {
+			return nil, err
+		}
+		resp.Stats = append(resp.Stats, stats)
+	}
+	return &resp, nil
+}
+
+// containerStats returns the usage of the container from the samples of
+// the stats service, the working set being the memory usage without the
+// page cache
+func containerStats(ctx context.Context, client *containerd.Client, r containerRecord) (*api.ContainerStats, error) {
+	resp, err := client.StatsService().Aggregate(ctx, &statsapi.AggregateRequest{
+		Filters: []string{"id==" + r.ID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		usage      = resp.Usage
+		workingSet uint64
+		timestamp  = nowNano()
+	)
+	if usage.MemoryUsage > usage.MemoryCache {
+		workingSet = usage.MemoryUsage - usage.MemoryCache
+	}
+	return &api.ContainerStats{
+		Attributes: &api.ContainerAttributes{
+			ID:          r.ID,
+			Metadata:    r.Config.Metadata,
+			Labels:      r.Config.Labels,
+			Annotations: r.Config.Annotations,
+		},
+		Cpu: &api.CpuUsage{
+			Timestamp:            timestamp,
+			UsageCoreNanoSeconds: &api.UInt64Value{Value: usage.CPUUsageTotal},
+		},
+		Memory: &api.MemoryUsage{
+			Timestamp:       timestamp,
+			WorkingSetBytes: &api.UInt64Value{Value: workingSet},
+		},
+	}, nil
+}
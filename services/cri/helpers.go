@@ -0,0 +1,72 @@
+package cri
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	api "github.com/containerd/containerd/api/services/cri/v1alpha1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/reference"
+	"github.com/pkg/errors"
+)
+
+// generateID returns a random id of pod sandboxes and containers
+func generateID() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// sandboxName returns the unique name of a pod sandbox
+func sandboxName(md *api.PodSandboxMetadata) string {
+	if md == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s_%s_%s_%d", md.Name, md.Namespace, md.Uid, md.Attempt)
+}
+
+// containerName returns the name of a container, unique in its sandbox
+func containerName(md *api.ContainerMetadata) string {
+	if md == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s_%d", md.Name, md.Attempt)
+}
+
+// matchLabels returns whether the labels have all the labels of the
+// selector
+func matchLabels(selector, labels map[string]string) bool {
+	for k, v := range selector {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeImage returns the fully qualified reference of an image the
+// kubelet names as the docker CLI does, such as "nginx" for
+// "docker.io/library/nginx:latest"
+func normalizeImage(ref string) (string, error) {
+	if ref == "" {
+		return "", errors.Wrap(errdefs.ErrInvalidArgument, "image must be set")
+	}
+	name := ref
+	if i := strings.IndexByte(name, '/'); i < 0 {
+		name = "docker.io/library/" + name
+	} else if host := name[:i]; !strings.ContainsAny(host, ".:") && host != "localhost" {
+		name = "docker.io/" + name
+	}
+	// a tag follows the last component of the path and a digest the name
+	if !strings.Contains(name, "@") && !strings.Contains(name[strings.LastIndexByte(name, '/'):], ":") {
+		name += ":latest"
+	}
+	if _, err := reference.Parse(name); err != nil {
+		return "", errors.Wrapf(errdefs.ErrInvalidArgument, "invalid image %q: %v", ref, err)
+	}
+	return name, nil
+}
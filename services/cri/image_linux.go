@@ -0,0 +1,313 @@
+package cri
+
+import (
+	gocontext "context"
+	"encoding/base64"
+	"encoding/json"
+	"golang.org/x/net/context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd"
+	api "github.com/containerd/containerd/api/services/cri/v1alpha1"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/containerd/containerd/snapshot"
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// criImage is an image of the kubelet, identified by the digest of its config
+// and grouping the image records of the names of the image
+type criImage struct {
+	id      digest.Digest
+	name    string
+	names   []string
+	records []images.Image
+	config  ocispec.Image
+	size    int64
+}
+
+// listImages returns the images of the records grouped by their config
+func listImages(ctx context.Context, client *containerd.Client) ([]*criImage, error) {
+	records, err := client.ImageService().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		provider = client.ContentStore()
+		ids      = make(map[digest.Digest]*criImage)
+		out      []*criImage
+	)
+	for _, r := range records {
+		desc, err := r.Config(ctx, provider)
+		if err != nil {
+			// the content of images being pulled may be missing
+			continue
+		}
+		if i, ok := ids[desc.Digest]; ok {
+			i.names = append(i.names, r.Name)
+			i.records = append(i.records, r)
+			continue
+		}
+		data, err := content.ReadBlob(ctx, provider, desc.Digest)
+		if err != nil {
+			continue
+		}
+		i := &criImage{
+			id:      desc.Digest,
+			name:    r.Name,
+			names:   []string{r.Name},
+			records: []images.Image{r},
+		}
+		if err := json.Unmarshal(data, &i.config); err != nil {
+			continue
+		}
+		if i.size, err = r.Size(ctx, provider); err != nil {
+			continue
+		}
+		ids[desc.Digest] = i
+		out = append(out, i)
+	}
+	return out, nil
+}
+
+// resolveImage returns the image with the id, or with the name once
+// normalized
+func (s *Service) resolveImage(ctx context.Context, client *containerd.Client, ref string) (*criImage, error) {
+	if ref == "" {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, "image must be set")
+	}
+	name := ref
+	if _, err := digest.Parse(ref); err != nil {
+		if name, err = normalizeImage(ref); err != nil {
+			return nil, err
+		}
+	}
+	all, err := listImages(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range all {
+		if i.id.String() == name {
+			return i, nil
+		}
+		for _, n := range i.names {
+			if n == name {
+				return i, nil
+			}
+		}
+	}
+	return nil, errors.Wrapf(errdefs.ErrNotFound, "image %s", ref)
+}
+
+func imageToProto(i *criImage) *api.Image {
+	out := &api.Image{
+		ID:    i.id.String(),
+		Size_: uint64(i.size),
+	}
+	for _, r := range i.records {
+		if strings.Contains(r.Name, "@") {
+			out.RepoDigests = append(out.RepoDigests, r.Name)
+			continue
+		}
+		out.RepoTags = append(out.RepoTags, r.Name)
+		repo := r.Name[:strings.LastIndexByte(r.Name, ':')]
+		out.RepoDigests = append(out.RepoDigests, repo+"@"+r.Target.Digest.String())
+	}
+	user := i.config.Config.User
+	if j := strings.IndexByte(user, ':'); j >= 0 {
+		user = user[:j]
+	}
+	if uid, err := strconv.ParseInt(user, 10, 64); err == nil {
+		out.Uid = &api.Int64Value{Value: uid}
+	} else {
+		out.Username = user
+	}
+	return out
+}
+
+func (s *Service) ListImages(ctx context.Context, req *api.ListImagesRequest) (*api.ListImagesResponse, error) {
+	ctx, client, err := s.withClient(ctx)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if f := req.Filter; f != nil && f.Image != nil && f.Image.Image != "" {
+		i, err := s.resolveImage(ctx, client, f.Image.Image)
+		if err != nil {
+			if errdefs.IsNotFound(err) {
+				return &api.ListImagesResponse{}, nil
+			}
+			return nil, errdefs.ToGRPC(err)
+		}
+		return &api.ListImagesResponse{
+			Images: []*api.Image{imageToProto(i)},
+		}, nil
+	}
+	all, err := listImages(ctx, client)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	var resp api.ListImagesResponse
+	for _, i := range all {
+		resp.Images = append(resp.Images, imageToProto(i))
+	}
+	return &resp, nil
+}
+
+// ImageStatus returns a nil image when the image does not exist, as the
+// kubelet expects
+func (s *Service) ImageStatus(ctx context.Context, req *api.ImageStatusRequest) (*api.ImageStatusResponse, error) {
+	if req.Image == nil {
+		return nil, errdefs.ToGRPC(errors.Wrap(errdefs.ErrInvalidArgument, "image must be set"))
+	}
+	ctx, client, err := s.withClient(ctx)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	i, err := s.resolveImage(ctx, client, req.Image.Image)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return &api.ImageStatusResponse{}, nil
+		}
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &api.ImageStatusResponse{
+		Image: imageToProto(i),
+	}, nil
+}
+
+// credentials returns the credentials of the auth config for the resolver
+func credentials(auth *api.AuthConfig) func(string) (string, string, error) {
+	return func(host string) (string, string, error) {
+		if auth == nil {
+			return "", "", nil
+		}
+		if auth.ServerAddress != "" && !strings.Contains(auth.ServerAddress, host) {
+			return "", "", nil
+		}
+		switch {
+		case auth.Username != "":
+			return auth.Username, auth.Password, nil
+		case auth.Auth != "":
+			data, err := base64.StdEncoding.DecodeString(auth.Auth)
+			if err != nil {
+				return "", "", errors.Wrapf(errdefs.ErrInvalidArgument, "invalid auth: %v", err)
+			}
+			parts := strings.SplitN(string(data), ":", 2)
+			if len(parts) != 2 {
+				return "", "", errors.Wrap(errdefs.ErrInvalidArgument, "invalid auth")
+			}
+			return parts[0], parts[1], nil
+		case auth.IdentityToken != "":
+			return "", auth.IdentityToken, nil
+		}
+		return "", "", nil
+	}
+}
+
+func (s *Service) PullImage(ctx context.Context, req *api.PullImageRequest) (*api.PullImageResponse, error) {
+	if req.Image == nil {
+		return nil, errdefs.ToGRPC(errors.Wrap(errdefs.ErrInvalidArgument, "image must be set"))
+	}
+	name, err := normalizeImage(req.Image.Image)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	ctx, client, err := s.withClient(ctx)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	resolver := docker.NewResolver(docker.ResolverOptions{
+		Credentials: credentials(req.Auth),
+	})
+	if _, err := client.Pull(ctx, name,
+		containerd.WithPullUnpack,
+		containerd.WithPullSnapshotter(s.config.Snapshotter),
+		containerd.WithResolver(resolver),
+	); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	i, err := s.resolveImage(ctx, client, name)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &api.PullImageResponse{
+		ImageRef: i.id.String(),
+	}, nil
+}
+
+// RemoveImage removes all the names of the image and succeeds when it does
+// not exist
+func (s *Service) RemoveImage(ctx context.Context, req *api.RemoveImageRequest) (*api.RemoveImageResponse, error) {
+	if req.Image == nil {
+		return nil, errdefs.ToGRPC(errors.Wrap(errdefs.ErrInvalidArgument, "image must be set"))
+	}
+	ctx, client, err := s.withClient(ctx)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	i, err := s.resolveImage(ctx, client, req.Image.Image)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return &api.RemoveImageResponse{}, nil
+		}
+		return nil, errdefs.ToGRPC(err)
+	}
+	store := client.ImageService()
+	for _, name := range i.names {
+		if err := store.Delete(ctx, name); err != nil && !errdefs.IsNotFound(err) {
+			return nil, errdefs.ToGRPC(err)
+		}
+	}
+	return &api.RemoveImageResponse{}, nil
+}
+
+// ImageFsInfo returns the usage of the committed snapshots of the
+// snapshotter, which hold the unpacked images
+func (s *Service) ImageFsInfo(ctx context.Context, req *api.ImageFsInfoRequest) (*api.ImageFsInfoResponse, error) {
+	ctx, client, err := s.withClient(ctx)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	var (
+		usage       snapshot.Usage
+		snapshotter = client.SnapshotService(s.config.Snapshotter)
+	)
+	if err := snapshotter.Walk(ctx, func(ctx gocontext.Context, info snapshot.Info) error {
+		if info.Kind != snapshot.KindCommitted {
+			return nil
+		}
+		u, err := snapshotter.Usage(ctx, info.Name)
+		if err != nil {
+			if errdefs.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		usage.Add(u)
+		return nil
+	}); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &api.ImageFsInfoResponse{
+		ImageFilesystems: []*api.FilesystemUsage{
+			{
+				Timestamp: time.Now().UnixNano(),
+				StorageID: &api.StorageIdentifier{
+					Uuid: s.config.Snapshotter,
+				},
+				UsedBytes: &api.UInt64Value{
+					Value: uint64(usage.Size),
+				},
+				InodesUsed: &api.UInt64Value{
+					Value: uint64(usage.Inodes),
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,194 @@
+package cri
+
+import (
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/containerd/containerd"
+	api "github.com/containerd/containerd/api/services/cri/v1alpha1"
+	"github.com/containerd/containerd/logfile"
+)
+
+// attachBuffer is the number of chunks of output held for an attached client
+// before it is disconnected for falling behind
+const attachBuffer = 256
+
+// containerIO holds the stdio of the task of a container, logging its output,
+// broadcasting it to the attached clients and writing their input to its
+// stdin
+type containerIO struct {
+	terminal  bool
+	stdinOnce bool
+
+	stdinMu sync.Mutex
+	stdin   *io.PipeWriter
+
+	stdout *broadcaster
+	stderr *broadcaster
+	// done is closed once the output of the task is fully copied
+	done chan struct{}
+}
+
+func newContainerIO(config *api.ContainerConfig) *containerIO {
+	return &containerIO{
+		terminal:  config.Tty,
+		stdinOnce: config.StdinOnce,
+		stdout:    newBroadcaster(),
+		stderr:    newBroadcaster(),
+		done:      make(chan struct{}),
+	}
+}
+
+// create returns the IOCreation of the task, with a stdin when the container
+// has one and the output appended to the log file at logPath, if any
+func (c *containerIO) create(config *api.ContainerConfig, logPath string) containerd.IOCreation {
+	return func(id string) (containerd.IO, error) {
+		var (
+			stdin  io.Reader = strings.NewReader("")
+			stdout io.Writer = c.stdout
+			stderr io.Writer = c.stderr
+			file   *logfile.File
+		)
+		if config.Stdin {
+			r, w := io.Pipe()
+			stdin, c.stdin = r, w
+		}
+		if logPath != "" {
+			var err error
+			if file, err = logfile.Open(logPath, 0); err != nil {
+				return nil, err
+			}
+			stdout = io.MultiWriter(file.Stream("stdout"), c.stdout)
+			stderr = io.MultiWriter(file.Stream("stderr"), c.stderr)
+		}
+		i, err := containerd.NewIOWithTerminal(stdin, stdout, stderr, config.Tty)(id)
+		if err != nil {
+			if file != nil {
+				file.Close()
+			}
+			return nil, err
+		}
+		go func() {
+			i.Wait()
+			close(c.done)
+			c.stdout.close()
+			c.stderr.close()
+		}()
+		return &taskIO{IO: i, file: file}, nil
+	}
+}
+
+// write writes the input of an attached client to the stdin of the task
+func (c *containerIO) write(p []byte) error {
+	c.stdinMu.Lock()
+	defer c.stdinMu.Unlock()
+	if c.stdin == nil {
+		return nil
+	}
+	_, err := c.stdin.Write(p)
+	return err
+}
+
+// closeStdin closes the stdin of the task
+func (c *containerIO) closeStdin() {
+	c.stdinMu.Lock()
+	defer c.stdinMu.Unlock()
+	if c.stdin != nil {
+		c.stdin.Close()
+		c.stdin = nil
+	}
+}
+
+// taskIO logs the last incomplete lines of the output of the task once it is
+// copied and closes the log file with the io
+type taskIO struct {
+	containerd.IO
+	file *logfile.File
+}
+
+func (t *taskIO) Wait() {
+	t.IO.Wait()
+	if t.file != nil {
+		t.file.Flush()
+	}
+}
+
+func (t *taskIO) Close() error {
+	err := t.IO.Close()
+	if t.file != nil {
+		if ferr := t.file.Close(); err == nil {
+			err = ferr
+		}
+	}
+	return err
+}
+
+// broadcaster writes the output of a task to the attached clients, each
+// through a buffer of its own so that a client that falls behind neither
+// blocks the task nor the other clients, but is disconnected
+type broadcaster struct {
+	mu      sync.Mutex
+	clients map[chan []byte]struct{}
+	closed  bool
+}
+
+func newBroadcaster() *broadcaster {
+	return &broadcaster{
+		clients: make(map[chan []byte]struct{}),
+	}
+}
+
+func (b *broadcaster) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.clients) == 0 {
+		return len(p), nil
+	}
+	data := make([]byte, len(p))
+	copy(data, p)
+	for c := range b.clients {
+		select {
+		case c <- data:
+		default:
+			delete(b.clients, c)
+			close(c)
+		}
+	}
+	return len(p), nil
+}
+
+// add returns the channel of the output for a new client, which is closed
+// once the output ends or the client falls behind
+func (b *broadcaster) add() chan []byte {
+	c := make(chan []byte, attachBuffer)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		close(c)
+		return c
+	}
+	b.clients[c] = struct{}{}
+	return c
+}
+
+// remove closes the channel of the client, if it is still attached
+func (b *broadcaster) remove(c chan []byte) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.clients[c]; ok {
+		delete(b.clients, c)
+		close(c)
+	}
+}
+
+// close ends the output of the clients
+func (b *broadcaster) close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for c := range b.clients {
+		delete(b.clients, c)
+		close(c)
+	}
+}
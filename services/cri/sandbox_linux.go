@@ -0,0 +1,222 @@
+package cri
+
+import (
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd"
+	api "github.com/containerd/containerd/api/services/cri/v1alpha1"
+	sandboxesapi "github.com/containerd/containerd/api/services/sandboxes/v1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/network"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func nowNano() int64 {
+	return time.Now().UnixNano()
+}
+
+// namespaceOptions returns the namespace options of the pod
+func namespaceOptions(config *api.PodSandboxConfig) *api.NamespaceOption {
+	if config.Linux == nil || config.Linux.SecurityContext == nil || config.Linux.SecurityContext.NamespaceOptions == nil {
+		return &api.NamespaceOption{}
+	}
+	return config.Linux.SecurityContext.NamespaceOptions
+}
+
+// cgroupParent returns the cgroup parent of the containers of the pod
+func cgroupParent(config *api.PodSandboxConfig) string {
+	if config.Linux == nil {
+		return ""
+	}
+	return config.Linux.CgroupParent
+}
+
+// RunPodSandbox creates a sandbox of containerd holding the network and IPC
+// namespaces of the pod, unless the pod uses the network of the host, in
+// which case its containers only share its cgroup parent
+func (s *Service) RunPodSandbox(ctx context.Context, req *api.RunPodSandboxRequest) (_ *api.RunPodSandboxResponse, err error) {
+	config := req.Config
+	if config == nil || config.Metadata == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "pod sandbox metadata must be set")
+	}
+	options := namespaceOptions(config)
+	if options.HostIpc && !options.HostNetwork {
+		return nil, grpc.Errorf(codes.InvalidArgument, "the IPC namespace of the host requires the network of the host")
+	}
+	ctx, client, err := s.withClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r := sandboxRecord{
+		ID:        generateID(),
+		Config:    config,
+		State:     api.PodSandboxState_SANDBOX_READY,
+		CreatedAt: nowNano(),
+	}
+	if err := s.store.addSandbox(r); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	defer func() {
+		if err != nil {
+			if derr := s.store.deleteSandbox(r.ID); derr != nil {
+				log.G(ctx).WithError(derr).WithField("id", r.ID).Warn("cri: failed to remove pod sandbox record")
+			}
+		}
+	}()
+	if options.HostNetwork {
+		return &api.RunPodSandboxResponse{PodSandboxID: r.ID}, nil
+	}
+	resp, err := client.SandboxService().Create(ctx, &sandboxesapi.CreateSandboxRequest{
+		Sandbox: sandboxesapi.Sandbox{
+			ID:           r.ID,
+			Labels:       config.Labels,
+			Network:      s.config.Network,
+			CgroupParent: cgroupParent(config),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	ip := strings.Split(resp.Sandbox.Labels[network.IPsLabel], ",")[0]
+	if i := strings.IndexByte(ip, '/'); i >= 0 {
+		ip = ip[:i]
+	}
+	if err := s.store.updateSandbox(r.ID, func(r *sandboxRecord) {
+		r.IP = ip
+	}); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &api.RunPodSandboxResponse{PodSandboxID: r.ID}, nil
+}
+
+// StopPodSandbox kills the tasks of the containers of the pod and removes
+// its namespaces, which requires its containers to be deleted from
+// containerd. Their records are kept until they are removed.
+func (s *Service) StopPodSandbox(ctx context.Context, req *api.StopPodSandboxRequest) (*api.StopPodSandboxResponse, error) {
+	r, err := s.store.sandbox(req.PodSandboxID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	ctx, client, err := s.withClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.stopSandbox(ctx, client, r); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &api.StopPodSandboxResponse{}, nil
+}
+
+func (s *Service) stopSandbox(ctx context.Context, client *containerd.Client, r sandboxRecord) error {
+	for _, c := range s.store.listContainers() {
+		if c.SandboxID != r.ID {
+			continue
+		}
+		if err := s.stopContainer(ctx, client, c.ID, 0); err != nil {
+			return err
+		}
+		if err := deleteContainer(ctx, client, c.ID); err != nil {
+			return err
+		}
+	}
+	if !namespaceOptions(r.Config).HostNetwork {
+		if _, err := client.SandboxService().Delete(ctx, &sandboxesapi.DeleteSandboxRequest{
+			ID: r.ID,
+		}); err != nil && !errdefs.IsNotFound(errdefs.FromGRPC(err)) {
+			return errdefs.FromGRPC(err)
+		}
+	}
+	return s.store.updateSandbox(r.ID, func(r *sandboxRecord) {
+		r.State = api.PodSandboxState_SANDBOX_NOTREADY
+	})
+}
+
+// RemovePodSandbox stops the pod and removes it with its containers
+func (s *Service) RemovePodSandbox(ctx context.Context, req *api.RemovePodSandboxRequest) (*api.RemovePodSandboxResponse, error) {
+	r, err := s.store.sandbox(req.PodSandboxID)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return &api.RemovePodSandboxResponse{}, nil
+		}
+		return nil, errdefs.ToGRPC(err)
+	}
+	ctx, client, err := s.withClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.stopSandbox(ctx, client, r); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	for _, c := range s.store.listContainers() {
+		if c.SandboxID == r.ID {
+			if err := s.store.deleteContainer(c.ID); err != nil {
+				return nil, errdefs.ToGRPC(err)
+			}
+		}
+	}
+	if err := s.store.deleteSandbox(r.ID); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &api.RemovePodSandboxResponse{}, nil
+}
+
+// PodSandboxStatus returns the status of the pod
+func (s *Service) PodSandboxStatus(ctx context.Context, req *api.PodSandboxStatusRequest) (*api.PodSandboxStatusResponse, error) {
+	r, err := s.store.sandbox(req.PodSandboxID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	options := namespaceOptions(r.Config)
+	return &api.PodSandboxStatusResponse{
+		Status: &api.PodSandboxStatus{
+			ID:        r.ID,
+			Metadata:  r.Config.Metadata,
+			State:     r.State,
+			CreatedAt: r.CreatedAt,
+			Network: &api.PodSandboxNetworkStatus{
+				Ip: r.IP,
+			},
+			Linux: &api.LinuxPodSandboxStatus{
+				Namespaces: &api.Namespace{
+					Options: options,
+				},
+			},
+			Labels:      r.Config.Labels,
+			Annotations: r.Config.Annotations,
+		},
+	}, nil
+}
+
+// ListPodSandbox returns the pods matching the filter
+func (s *Service) ListPodSandbox(ctx context.Context, req *api.ListPodSandboxRequest) (*api.ListPodSandboxResponse, error) {
+	var (
+		resp   api.ListPodSandboxResponse
+		filter = req.Filter
+	)
+	for _, r := range s.store.listSandboxes() {
+		if filter != nil {
+			if filter.ID != "" && !strings.HasPrefix(r.ID, filter.ID) {
+				continue
+			}
+			if filter.State != nil && filter.State.State != r.State {
+				continue
+			}
+			if !matchLabels(filter.LabelSelector, r.Config.Labels) {
+				continue
+			}
+		}
+		resp.Items = append(resp.Items, &api.PodSandbox{
+			ID:          r.ID,
+			Metadata:    r.Config.Metadata,
+			State:       r.State,
+			CreatedAt:   r.CreatedAt,
+			Labels:      r.Config.Labels,
+			Annotations: r.Config.Annotations,
+		})
+	}
+	return &resp, nil
+}
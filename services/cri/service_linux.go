@@ -0,0 +1,268 @@
+package cri
+
+import (
+	"sync"
+
+	"github.com/containerd/containerd"
+	api "github.com/containerd/containerd/api/services/cri/v1alpha1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/version"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// runtimeName is the name of the runtime reported to the kubelet
+	runtimeName = "containerd"
+	// runtimeAPIVersion is the version of the CRI implemented by the service
+	runtimeAPIVersion = "v1alpha1"
+	// kubeAPIVersion is the version of the runtime API of the kubelet
+	kubeAPIVersion = "0.1.0"
+)
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.GRPCPlugin,
+		ID:   "cri",
+		Config: &Config{
+			Namespace:     "k8s.io",
+			Snapshotter:   containerd.DefaultSnapshotter,
+			Network:       "default",
+			StreamAddress: "127.0.0.1:10010",
+		},
+		Init: New,
+	})
+}
+
+// Config of the CRI service
+type Config struct {
+	// Namespace is the containerd namespace of the pods and images of the
+	// kubelet
+	Namespace string `toml:"namespace"`
+	// Snapshotter unpacks the images and holds the root filesystems of the
+	// containers
+	Snapshotter string `toml:"snapshotter"`
+	// Runtime runs the containers, the default runtime of the platform
+	// when empty
+	Runtime string `toml:"runtime"`
+	// Network is the CNI network of the pods that do not use the network of
+	// the host, "default" for the first network configured
+	Network string `toml:"network"`
+	// StreamAddress is the TCP address of the server of the exec, attach
+	// and port forward streams, which are disabled when it is empty
+	StreamAddress string `toml:"stream_address"`
+}
+
+// New returns the CRI service of the configuration, which calls the
+// services of the daemon through its socket once it serves
+func New(ic *plugin.InitContext) (interface{}, error) {
+	if ic.Address == "" {
+		return nil, plugin.SkipPlugin
+	}
+	store, err := newStore(ic.Root)
+	if err != nil {
+		return nil, err
+	}
+	s := &Service{
+		config:  *ic.Config.(*Config),
+		address: ic.Address,
+		store:   store,
+		exits:   make(map[string]chan struct{}),
+		ios:     make(map[string]*containerIO),
+	}
+	if s.config.StreamAddress != "" {
+		if err := s.serveStreams(s.config.StreamAddress); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Service implements the runtime and image services of the CRI on top of
+// the containers, tasks, sandboxes and images of containerd, so that the
+// kubelet talks to containerd directly
+type Service struct {
+	config  Config
+	address string
+	store   *store
+	streams *streamServer
+
+	mu     sync.Mutex
+	client *containerd.Client
+	// exits are closed as the tasks of the containers exit
+	exits map[string]chan struct{}
+	// ios are the stdio of the tasks started by the service, which can be
+	// attached
+	ios map[string]*containerIO
+}
+
+var (
+	_ api.RuntimeServiceServer = &Service{}
+	_ api.ImageServiceServer   = &Service{}
+)
+
+func (s *Service) Register(server *grpc.Server) error {
+	api.RegisterRuntimeServiceServer(server, s)
+	api.RegisterImageServiceServer(server, s)
+	return nil
+}
+
+// withClient returns the context in the namespace of the service and the
+// client of the daemon, connecting it and recovering the containers running
+// before a restart on the first call
+func (s *Service) withClient(ctx context.Context) (context.Context, *containerd.Client, error) {
+	ctx = namespaces.WithNamespace(ctx, s.config.Namespace)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.client != nil {
+		return ctx, s.client, nil
+	}
+	client, err := containerd.New(s.address, containerd.WithDefaultNamespace(s.config.Namespace))
+	if err != nil {
+		return nil, nil, grpc.Errorf(codes.Unavailable, "failed to connect to containerd: %v", err)
+	}
+	s.client = client
+	s.recover(client)
+	return ctx, client, nil
+}
+
+// recover watches the tasks of the containers recorded running, recording
+// those that exited while the daemon was down. It is called with s.mu held.
+func (s *Service) recover(client *containerd.Client) {
+	ctx := namespaces.WithNamespace(context.Background(), s.config.Namespace)
+	for _, r := range s.store.listContainers() {
+		if r.State != api.ContainerState_CONTAINER_RUNNING {
+			continue
+		}
+		exited := make(chan struct{})
+		s.exits[r.ID] = exited
+		container, err := client.LoadContainer(ctx, r.ID)
+		if err == nil {
+			var task containerd.Task
+			if task, err = container.Task(ctx, nil); err == nil {
+				var statusC <-chan containerd.ExitStatus
+				if statusC, err = task.Wait(ctx); err == nil {
+					go s.watch(ctx, r.ID, task, statusC, exited)
+					continue
+				}
+			}
+		}
+		log.G(ctx).WithError(err).WithField("id", r.ID).Warn("cri: task of running container is gone")
+		s.recordExit(r.ID, unknownExitCode, nowNano(), "Unknown", "the task was lost while containerd was down")
+		close(exited)
+	}
+}
+
+// Version returns the version of containerd and of the CRI it implements
+func (s *Service) Version(ctx context.Context, req *api.VersionRequest) (*api.VersionResponse, error) {
+	return &api.VersionResponse{
+		Version:           kubeAPIVersion,
+		RuntimeName:       runtimeName,
+		RuntimeVersion:    version.Version,
+		RuntimeApiVersion: runtimeAPIVersion,
+	}, nil
+}
+
+// Status reports the runtime ready once the daemon serves, and the network
+// ready as the networks of pods are set up by the sandboxes service
+func (s *Service) Status(ctx context.Context, req *api.StatusRequest) (*api.StatusResponse, error) {
+	runtimeReady := &api.RuntimeCondition{
+		Type:   "RuntimeReady",
+		Status: true,
+	}
+	ctx, client, err := s.withClient(ctx)
+	if err == nil {
+		_, err = client.IsServing(ctx)
+	}
+	if err != nil {
+		runtimeReady.Status = false
+		runtimeReady.Reason = "ContainerdUnavailable"
+		runtimeReady.Message = err.Error()
+	}
+	return &api.StatusResponse{
+		Status: &api.RuntimeStatus{
+			Conditions: []*api.RuntimeCondition{
+				runtimeReady,
+				{
+					Type:   "NetworkReady",
+					Status: true,
+				},
+			},
+		},
+	}, nil
+}
+
+// UpdateRuntimeConfig accepts the pod CIDR of the node, which is configured
+// in the CNI networks of the host rather than by the kubelet
+func (s *Service) UpdateRuntimeConfig(ctx context.Context, req *api.UpdateRuntimeConfigRequest) (*api.UpdateRuntimeConfigResponse, error) {
+	return &api.UpdateRuntimeConfigResponse{}, nil
+}
+
+// Exec returns the url of the stream running the command in the container
+func (s *Service) Exec(ctx context.Context, req *api.ExecRequest) (*api.ExecResponse, error) {
+	r, err := s.store.container(req.ContainerID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if r.State != api.ContainerState_CONTAINER_RUNNING {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "container %s is not running", r.ID)
+	}
+	if len(req.Cmd) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "command must be set")
+	}
+	// the output of a terminal is all on stdout
+	if req.Tty {
+		req.Stderr = false
+	}
+	url, err := s.streamURL("exec", req)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &api.ExecResponse{Url: url}, nil
+}
+
+// Attach returns the url of the stream attached to the stdio of the task of
+// the container
+func (s *Service) Attach(ctx context.Context, req *api.AttachRequest) (*api.AttachResponse, error) {
+	r, err := s.store.container(req.ContainerID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if r.State != api.ContainerState_CONTAINER_RUNNING {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "container %s is not running", r.ID)
+	}
+	s.mu.Lock()
+	_, ok := s.ios[r.ID]
+	s.mu.Unlock()
+	if !ok {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "container %s was started before containerd restarted and cannot be attached", r.ID)
+	}
+	if req.Tty {
+		req.Stderr = false
+	}
+	url, err := s.streamURL("attach", req)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &api.AttachResponse{Url: url}, nil
+}
+
+// PortForward returns the url of the stream forwarding the ports of the pod
+func (s *Service) PortForward(ctx context.Context, req *api.PortForwardRequest) (*api.PortForwardResponse, error) {
+	sandbox, err := s.store.sandbox(req.PodSandboxID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if sandbox.State != api.PodSandboxState_SANDBOX_READY {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "pod sandbox %s is not ready", sandbox.ID)
+	}
+	url, err := s.streamURL("portforward", req)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &api.PortForwardResponse{Url: url}, nil
+}
@@ -0,0 +1,538 @@
+package cri
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	spdyVersion = 3
+
+	spdySynStream    = 1
+	spdySynReply     = 2
+	spdyRstStream    = 3
+	spdyPing         = 6
+	spdyGoAway       = 7
+	spdyHeaders      = 8
+	spdyWindowUpdate = 9
+
+	spdyFlagFin = 0x01
+
+	spdyStatusProtocolError    = 1
+	spdyStatusFlowControlError = 7
+
+	// spdyMaxControlFrame bounds the control frames read from clients
+	spdyMaxControlFrame = 1 << 16
+	// spdyMaxHeader bounds the names and values of headers
+	spdyMaxHeader = 1 << 16
+	// spdyMaxDataFrame is the size of the data frames written
+	spdyMaxDataFrame = 32 << 10
+	// spdyMaxBuffered bounds the data of a stream received and not read yet,
+	// as clients that respect the flow control of SPDY/3.1 do not send more
+	// than the initial window of 64KB
+	spdyMaxBuffered = 1 << 20
+	// spdyAcceptBacklog is the number of streams opened by the client and
+	// not handled yet
+	spdyAcceptBacklog = 16
+
+	spdyUpgrade               = "SPDY/3.1"
+	spdyProtocolVersionHeader = "X-Stream-Protocol-Version"
+	// spdyStreamTypeHeader names the kind of the streams of the client
+	spdyStreamTypeHeader = "streamtype"
+)
+
+// spdyHeaderDictionary primes the compression of the header blocks of
+// SPDY/3
+var spdyHeaderDictionary = func() []byte {
+	var b bytes.Buffer
+	for _, name := range []string{
+		"options", "head", "post", "put", "delete", "trace", "accept",
+		"accept-charset", "accept-encoding", "accept-language",
+		"accept-ranges", "age", "allow", "authorization", "cache-control",
+		"connection", "content-base", "content-encoding",
+		"content-language", "content-length", "content-location",
+		"content-md5", "content-range", "content-type", "date", "etag",
+		"expect", "expires", "from", "host", "if-match",
+		"if-modified-since", "if-none-match", "if-range",
+		"if-unmodified-since", "last-modified", "location", "max-forwards",
+		"pragma", "proxy-authenticate", "proxy-authorization", "range",
+		"referer", "retry-after", "server", "te", "trailer",
+		"transfer-encoding", "upgrade", "user-agent", "vary", "via",
+		"warning", "www-authenticate", "method", "get", "status", "200 OK",
+		"version", "HTTP/1.1", "url", "public", "set-cookie", "keep-alive",
+		"origin",
+	} {
+		binary.Write(&b, binary.BigEndian, uint32(len(name)))
+		b.WriteString(name)
+	}
+	b.WriteString("100101201202205206300302303304305306307402405406407408409410411412413414415416417502504505" +
+		"203 Non-Authoritative Information204 No Content301 Moved Permanently400 Bad Request401 Unauthorized" +
+		"403 Forbidden404 Not Found500 Internal Server Error501 Not Implemented503 Service Unavailable" +
+		"Jan Feb Mar Apr May Jun Jul Aug Sept Oct Nov Dec 00:00:00 Mon, Tue, Wed, Thu, Fri, Sat, Sun, GMT" +
+		"chunked,text/html,image/png,image/jpg,image/gif,application/xml,application/xhtml+xml,text/plain," +
+		"text/javascript,publicprivatemax-age=gzip,deflate,sdchcharset=utf-8charset=iso-8859-1,utf-,*,enq=0.")
+	return b.Bytes()
+}()
+
+// isSPDYRequest returns whether the request upgrades its connection to SPDY
+func isSPDYRequest(r *http.Request) bool {
+	return headerContains(r.Header, "Connection", "upgrade") && strings.EqualFold(r.Header.Get("Upgrade"), spdyUpgrade)
+}
+
+// spdyConn is the server side of a SPDY/3.1 connection, whose streams are
+// all opened by the client as with the streaming API of the kubelet
+type spdyConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+	// protocol is the protocol agreed with the client, empty when the
+	// client offered none
+	protocol string
+
+	// header decompresses the header blocks of the client, which share a
+	// single zlib stream
+	header      io.ReadCloser
+	headerBlock headerBlockReader
+
+	wmu    sync.Mutex
+	hbuf   bytes.Buffer
+	hw     *zlib.Writer
+	closed bool
+
+	mu      sync.Mutex
+	streams map[uint32]*spdyStream
+	// accepted receives the streams opened by the client
+	accepted chan *spdyStream
+	// done is closed once the client closed the connection
+	done chan struct{}
+}
+
+// upgradeSPDY accepts the SPDY connection of the request with the first of
+// the protocols offered by the client that is supported
+func upgradeSPDY(w http.ResponseWriter, r *http.Request, protocols []string) (*spdyConn, error) {
+	protocol, ok := negotiate(r.Header[http.CanonicalHeaderKey(spdyProtocolVersionHeader)], protocols)
+	if !ok {
+		return nil, errors.Errorf("none of the stream protocols %q is supported", r.Header[http.CanonicalHeaderKey(spdyProtocolVersionHeader)])
+	}
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		return nil, errors.New("the connection cannot be upgraded")
+	}
+	conn, rw, err := hj.Hijack()
+	if err != nil {
+		return nil, err
+	}
+	resp := "HTTP/1.1 101 Switching Protocols\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Upgrade: " + spdyUpgrade + "\r\n"
+	if protocol != "" {
+		resp += spdyProtocolVersionHeader + ": " + protocol + "\r\n"
+	}
+	if _, err := conn.Write([]byte(resp + "\r\n")); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	c := &spdyConn{
+		conn:     conn,
+		r:        rw.Reader,
+		protocol: protocol,
+		streams:  make(map[uint32]*spdyStream),
+		accepted: make(chan *spdyStream, spdyAcceptBacklog),
+		done:     make(chan struct{}),
+	}
+	if c.hw, err = zlib.NewWriterLevelDict(&c.hbuf, zlib.BestCompression, spdyHeaderDictionary); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	go c.serve()
+	return c, nil
+}
+
+// negotiate returns the first of the offered protocols that is supported,
+// an empty protocol when none was offered
+func negotiate(offered, protocols []string) (string, bool) {
+	var values []string
+	for _, v := range offered {
+		for _, p := range strings.Split(v, ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				values = append(values, p)
+			}
+		}
+	}
+	if len(values) == 0 {
+		return "", true
+	}
+	for _, p := range values {
+		for _, s := range protocols {
+			if p == s {
+				return p, true
+			}
+		}
+	}
+	return "", false
+}
+
+// serve reads the frames of the client until it closes the connection
+func (c *spdyConn) serve() {
+	defer func() {
+		c.mu.Lock()
+		for _, s := range c.streams {
+			s.finish()
+		}
+		c.mu.Unlock()
+		close(c.done)
+	}()
+	for {
+		var h [8]byte
+		if _, err := io.ReadFull(c.r, h[:]); err != nil {
+			return
+		}
+		length := int(h[5])<<16 | int(h[6])<<8 | int(h[7])
+		flags := h[4]
+		if h[0]&0x80 == 0 {
+			if length > spdyMaxBuffered {
+				return
+			}
+			data := make([]byte, length)
+			if _, err := io.ReadFull(c.r, data); err != nil {
+				return
+			}
+			c.receive(binary.BigEndian.Uint32(h[0:4])&0x7fffffff, flags, data)
+			continue
+		}
+		if length > spdyMaxControlFrame {
+			return
+		}
+		payload := make([]byte, length)
+		if _, err := io.ReadFull(c.r, payload); err != nil {
+			return
+		}
+		if version := binary.BigEndian.Uint16(h[0:2]) & 0x7fff; version != spdyVersion {
+			return
+		}
+		if err := c.control(binary.BigEndian.Uint16(h[2:4]), flags, payload); err != nil {
+			return
+		}
+	}
+}
+
+// control handles a control frame, returning an error when the connection
+// must be closed
+func (c *spdyConn) control(typ uint16, flags byte, payload []byte) error {
+	switch typ {
+	case spdySynStream:
+		if len(payload) < 10 {
+			return errors.New("short SYN_STREAM frame")
+		}
+		id := binary.BigEndian.Uint32(payload[0:4]) & 0x7fffffff
+		headers, err := c.readHeaders(payload[10:])
+		if err != nil {
+			return err
+		}
+		s := &spdyStream{
+			conn:    c,
+			id:      id,
+			headers: headers,
+		}
+		s.cond = sync.NewCond(&s.mu)
+		if flags&spdyFlagFin != 0 {
+			s.eof = true
+		}
+		c.mu.Lock()
+		c.streams[id] = s
+		c.mu.Unlock()
+		if err := c.reply(id); err != nil {
+			return err
+		}
+		select {
+		case c.accepted <- s:
+		default:
+			// the session does not expect more streams
+			c.reset(id, spdyStatusProtocolError)
+		}
+	case spdySynReply, spdyHeaders:
+		// the headers are decompressed to keep the zlib stream in sync
+		if len(payload) < 4 {
+			return errors.New("short headers frame")
+		}
+		if _, err := c.readHeaders(payload[4:]); err != nil {
+			return err
+		}
+	case spdyRstStream:
+		if len(payload) < 4 {
+			return errors.New("short RST_STREAM frame")
+		}
+		if s := c.stream(binary.BigEndian.Uint32(payload[0:4]) & 0x7fffffff); s != nil {
+			s.finish()
+		}
+	case spdyPing:
+		return c.writeControl(spdyPing, 0, payload)
+	case spdyGoAway:
+		return io.EOF
+	}
+	return nil
+}
+
+// receive buffers the data of a stream until it is read
+func (c *spdyConn) receive(id uint32, flags byte, data []byte) {
+	s := c.stream(id)
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.eof {
+		return
+	}
+	if s.buf.Len()+len(data) > spdyMaxBuffered {
+		s.eof = true
+		s.cond.Broadcast()
+		c.reset(id, spdyStatusFlowControlError)
+		return
+	}
+	s.buf.Write(data)
+	if flags&spdyFlagFin != 0 {
+		s.eof = true
+	}
+	s.cond.Broadcast()
+}
+
+func (c *spdyConn) stream(id uint32) *spdyStream {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.streams[id]
+}
+
+// readHeaders decompresses the header block
+func (c *spdyConn) readHeaders(block []byte) (http.Header, error) {
+	c.headerBlock.buf.Write(block)
+	if c.header == nil {
+		var err error
+		if c.header, err = zlib.NewReaderDict(&c.headerBlock, spdyHeaderDictionary); err != nil {
+			return nil, errors.Wrap(err, "invalid header block")
+		}
+	}
+	var n uint32
+	if err := binary.Read(c.header, binary.BigEndian, &n); err != nil {
+		return nil, errors.Wrap(err, "invalid header block")
+	}
+	headers := make(http.Header)
+	for i := uint32(0); i < n; i++ {
+		name, err := c.readHeaderString()
+		if err != nil {
+			return nil, err
+		}
+		value, err := c.readHeaderString()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range strings.Split(value, "\x00") {
+			headers.Add(name, v)
+		}
+	}
+	return headers, nil
+}
+
+func (c *spdyConn) readHeaderString() (string, error) {
+	var n uint32
+	if err := binary.Read(c.header, binary.BigEndian, &n); err != nil {
+		return "", errors.Wrap(err, "invalid header block")
+	}
+	if n > spdyMaxHeader {
+		return "", errors.New("header too large")
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(c.header, b); err != nil {
+		return "", errors.Wrap(err, "invalid header block")
+	}
+	return string(b), nil
+}
+
+// reply accepts the stream with an empty header block
+func (c *spdyConn) reply(id uint32) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	c.hbuf.Reset()
+	if _, err := c.hw.Write([]byte{0, 0, 0, 0}); err != nil {
+		return err
+	}
+	if err := c.hw.Flush(); err != nil {
+		return err
+	}
+	payload := make([]byte, 4, 4+c.hbuf.Len())
+	binary.BigEndian.PutUint32(payload, id)
+	return c.write(controlHeader(spdySynReply, 0, append(payload, c.hbuf.Bytes()...)))
+}
+
+func (c *spdyConn) reset(id, status uint32) error {
+	payload := make([]byte, 8)
+	binary.BigEndian.PutUint32(payload[0:4], id)
+	binary.BigEndian.PutUint32(payload[4:8], status)
+	return c.writeControl(spdyRstStream, 0, payload)
+}
+
+// windowUpdate grants the client the window of the data read from the
+// stream, on the stream and the connection
+func (c *spdyConn) windowUpdate(id uint32, n int) {
+	for _, sid := range []uint32{id, 0} {
+		payload := make([]byte, 8)
+		binary.BigEndian.PutUint32(payload[0:4], sid)
+		binary.BigEndian.PutUint32(payload[4:8], uint32(n))
+		c.writeControl(spdyWindowUpdate, 0, payload)
+	}
+}
+
+func (c *spdyConn) writeControl(typ uint16, flags byte, payload []byte) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	return c.write(controlHeader(typ, flags, payload))
+}
+
+func (c *spdyConn) writeData(id uint32, flags byte, data []byte) error {
+	frame := make([]byte, 8+len(data))
+	binary.BigEndian.PutUint32(frame[0:4], id&0x7fffffff)
+	frame[4] = flags
+	putLength(frame[5:8], len(data))
+	copy(frame[8:], data)
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	return c.write(frame)
+}
+
+// write writes the frame, with c.wmu held
+func (c *spdyConn) write(frame []byte) error {
+	if c.closed {
+		return errors.New("spdy connection is closed")
+	}
+	_, err := c.conn.Write(frame)
+	return err
+}
+
+func controlHeader(typ uint16, flags byte, payload []byte) []byte {
+	frame := make([]byte, 8+len(payload))
+	binary.BigEndian.PutUint16(frame[0:2], 0x8000|spdyVersion)
+	binary.BigEndian.PutUint16(frame[2:4], typ)
+	frame[4] = flags
+	putLength(frame[5:8], len(payload))
+	copy(frame[8:], payload)
+	return frame
+}
+
+func putLength(b []byte, n int) {
+	b[0], b[1], b[2] = byte(n>>16), byte(n>>8), byte(n)
+}
+
+// Close tells the client the session is over and closes the connection once
+// the client closed it, or after the timeout, so that the client reads the
+// end of the streams
+func (c *spdyConn) Close() error {
+	payload := make([]byte, 8)
+	c.mu.Lock()
+	var last uint32
+	for id := range c.streams {
+		if id > last {
+			last = id
+		}
+	}
+	c.mu.Unlock()
+	binary.BigEndian.PutUint32(payload[0:4], last)
+	binary.BigEndian.PutUint32(payload[4:8], 0)
+	c.writeControl(spdyGoAway, 0, payload)
+	waitClosed(c.done)
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	return c.conn.Close()
+}
+
+// headerBlockReader queues the header blocks of the frames for the
+// decompressor, which reads it byte by byte and so never past the data
+// needed. The end of a block the headers did not need, such as the marker of
+// its flush, is kept for the next one.
+type headerBlockReader struct {
+	buf bytes.Buffer
+}
+
+func (h *headerBlockReader) Read(p []byte) (int, error) {
+	return h.buf.Read(p)
+}
+
+func (h *headerBlockReader) ReadByte() (byte, error) {
+	return h.buf.ReadByte()
+}
+
+// spdyStream is a stream opened by the client
+type spdyStream struct {
+	conn    *spdyConn
+	id      uint32
+	headers http.Header
+
+	mu   sync.Mutex
+	cond *sync.Cond
+	buf  bytes.Buffer
+	eof  bool
+	// closed is set once the server ended its side of the stream
+	closed bool
+}
+
+// Read reads the data sent by the client, returning io.EOF once the client
+// ended its side of the stream
+func (s *spdyStream) Read(p []byte) (int, error) {
+	s.mu.Lock()
+	for s.buf.Len() == 0 && !s.eof {
+		s.cond.Wait()
+	}
+	if s.buf.Len() == 0 {
+		s.mu.Unlock()
+		return 0, io.EOF
+	}
+	n, _ := s.buf.Read(p)
+	s.mu.Unlock()
+	s.conn.windowUpdate(s.id, n)
+	return n, nil
+}
+
+func (s *spdyStream) Write(p []byte) (int, error) {
+	for written := 0; written < len(p); {
+		n := len(p) - written
+		if n > spdyMaxDataFrame {
+			n = spdyMaxDataFrame
+		}
+		if err := s.conn.writeData(s.id, 0, p[written:written+n]); err != nil {
+			return written, err
+		}
+		written += n
+	}
+	return len(p), nil
+}
+
+// Close ends the side of the stream of the server
+func (s *spdyStream) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	s.mu.Unlock()
+	return s.conn.writeData(s.id, spdyFlagFin, nil)
+}
+
+// finish ends the side of the stream of the client
+func (s *spdyStream) finish() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.eof = true
+	s.cond.Broadcast()
+}
@@ -0,0 +1,161 @@
+package cri
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSPDYHeaderDictionary(t *testing.T) {
+	if n := len(spdyHeaderDictionary); n != 1423 {
+		t.Fatalf("expected the dictionary of SPDY/3 to be 1423 bytes, got %d", n)
+	}
+}
+
+// spdyClient writes frames as a client does, all its header blocks sharing
+// a zlib stream
+type spdyClient struct {
+	t    *testing.T
+	conn net.Conn
+	r    *bufio.Reader
+	hbuf bytes.Buffer
+	hw   *zlib.Writer
+}
+
+func dialSPDY(t *testing.T, server *httptest.Server, protocol string) *spdyClient {
+	conn, err := net.Dial("tcp", server.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest("POST", server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "SPDY/3.1")
+	req.Header.Set(spdyProtocolVersionHeader, protocol)
+	if err := req.Write(conn); err != nil {
+		t.Fatal(err)
+	}
+	r := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(r, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("expected the connection to be upgraded, got %s", resp.Status)
+	}
+	if p := resp.Header.Get(spdyProtocolVersionHeader); p != protocol {
+		t.Fatalf("expected protocol %q, got %q", protocol, p)
+	}
+	c := &spdyClient{t: t, conn: conn, r: r}
+	if c.hw, err = zlib.NewWriterLevelDict(&c.hbuf, zlib.BestCompression, spdyHeaderDictionary); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func (c *spdyClient) synStream(id uint32, headers map[string]string) {
+	c.hbuf.Reset()
+	binary.Write(c.hw, binary.BigEndian, uint32(len(headers)))
+	for name, value := range headers {
+		binary.Write(c.hw, binary.BigEndian, uint32(len(name)))
+		c.hw.Write([]byte(name))
+		binary.Write(c.hw, binary.BigEndian, uint32(len(value)))
+		c.hw.Write([]byte(value))
+	}
+	if err := c.hw.Flush(); err != nil {
+		c.t.Fatal(err)
+	}
+	payload := make([]byte, 10)
+	binary.BigEndian.PutUint32(payload[0:4], id)
+	c.write(controlHeader(spdySynStream, 0, append(payload, c.hbuf.Bytes()...)))
+}
+
+func (c *spdyClient) data(id uint32, flags byte, data []byte) {
+	frame := make([]byte, 8)
+	binary.BigEndian.PutUint32(frame[0:4], id)
+	frame[4] = flags
+	putLength(frame[5:8], len(data))
+	c.write(append(frame, data...))
+}
+
+func (c *spdyClient) write(frame []byte) {
+	if _, err := c.conn.Write(frame); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+// readData returns the data of the stream, skipping the control frames of
+// the server, until the server ends its side of the stream
+func (c *spdyClient) readData(id uint32) []byte {
+	var data []byte
+	for {
+		var h [8]byte
+		if _, err := io.ReadFull(c.r, h[:]); err != nil {
+			c.t.Fatal(err)
+		}
+		payload := make([]byte, int(h[5])<<16|int(h[6])<<8|int(h[7]))
+		if _, err := io.ReadFull(c.r, payload); err != nil {
+			c.t.Fatal(err)
+		}
+		if h[0]&0x80 != 0 || binary.BigEndian.Uint32(h[0:4]) != id {
+			continue
+		}
+		data = append(data, payload...)
+		if h[4]&spdyFlagFin != 0 {
+			return data
+		}
+	}
+}
+
+func TestSPDYStreams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isSPDYRequest(r) {
+			http.Error(w, "not a SPDY request", http.StatusBadRequest)
+			return
+		}
+		conn, err := upgradeSPDY(w, r, []string{"v4.channel.k8s.io"})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer conn.Close()
+		streams := make(map[string]*spdyStream)
+		for len(streams) < 2 {
+			s := <-conn.accepted
+			streams[s.headers.Get(spdyStreamTypeHeader)] = s
+		}
+		if streams["error"] == nil || streams["stdin"] == nil {
+			t.Errorf("unexpected streams %v", streams)
+			return
+		}
+		input, err := ioutil.ReadAll(streams["stdin"])
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		streams["error"].Write(append([]byte("output: "), input...))
+		streams["error"].Close()
+	}))
+	defer server.Close()
+
+	c := dialSPDY(t, server, "v4.channel.k8s.io")
+	defer c.conn.Close()
+	// the header block of the second stream is only valid in the zlib
+	// stream of the first one
+	c.synStream(1, map[string]string{"streamtype": "error"})
+	c.synStream(3, map[string]string{"streamtype": "stdin"})
+	c.data(3, 0, []byte("in"))
+	c.data(3, spdyFlagFin, []byte("put"))
+	if data := c.readData(1); string(data) != "output: input" {
+		t.Fatalf("unexpected data on the error stream %q", data)
+	}
+}
@@ -0,0 +1,427 @@
+package cri
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/containerd/containerd"
+	api "github.com/containerd/containerd/api/services/cri/v1alpha1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/oci"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// allCapabilities are the capabilities of privileged containers
+var allCapabilities = []string{
+	"CAP_CHOWN",
+	"CAP_DAC_OVERRIDE",
+	"CAP_DAC_READ_SEARCH",
+	"CAP_FOWNER",
+	"CAP_FSETID",
+	"CAP_KILL",
+	"CAP_SETGID",
+	"CAP_SETUID",
+	"CAP_SETPCAP",
+	"CAP_LINUX_IMMUTABLE",
+	"CAP_NET_BIND_SERVICE",
+	"CAP_NET_BROADCAST",
+	"CAP_NET_ADMIN",
+	"CAP_NET_RAW",
+	"CAP_IPC_LOCK",
+	"CAP_IPC_OWNER",
+	"CAP_SYS_MODULE",
+	"CAP_SYS_RAWIO",
+	"CAP_SYS_CHROOT",
+	"CAP_SYS_PTRACE",
+	"CAP_SYS_PACCT",
+	"CAP_SYS_ADMIN",
+	"CAP_SYS_BOOT",
+	"CAP_SYS_NICE",
+	"CAP_SYS_RESOURCE",
+	"CAP_SYS_TIME",
+	"CAP_SYS_TTY_CONFIG",
+	"CAP_MKNOD",
+	"CAP_LEASE",
+	"CAP_AUDIT_WRITE",
+	"CAP_AUDIT_CONTROL",
+	"CAP_SETFCAP",
+	"CAP_MAC_OVERRIDE",
+	"CAP_MAC_ADMIN",
+	"CAP_SYSLOG",
+	"CAP_WAKE_ALARM",
+	"CAP_BLOCK_SUSPEND",
+	"CAP_AUDIT_READ",
+}
+
+// containerSpec returns the spec of the container of the pod from the
+// configuration of the container and of its image, the user names being
+// resolved by withUser
+func containerSpec(id string, sandbox *api.PodSandboxConfig, config *api.ContainerConfig, image ocispec.ImageConfig, withUser func(user string) containerd.SpecOpts) (*specs.Spec, error) {
+	var (
+		options = namespaceOptions(sandbox)
+		opts    []containerd.SpecOpts
+	)
+	// the user of the image is replaced by the user name of the kubelet,
+	// and only its group is kept when the kubelet sets the uid
+	user := image.User
+	image.User = ""
+	opts = append(opts, containerd.SpecOpts(oci.WithImageConfig(image)))
+	if sc := securityContext(config); user != "" && (sc == nil || sc.RunAsUsername == "") {
+		opts = append(opts, func(s *specs.Spec) error {
+			if err := withUser(user)(s); err != nil && (sc == nil || sc.RunAsUser == nil) {
+				return err
+			}
+			return nil
+		})
+	}
+
+	args := append(config.Command, config.Args...)
+	if len(config.Command) == 0 {
+		args = append(image.Entrypoint, image.Cmd...)
+		if len(config.Args) > 0 {
+			args = append(image.Entrypoint, config.Args...)
+		}
+	}
+	if len(args) == 0 {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, "no command specified by the container or its image")
+	}
+	opts = append(opts, containerd.SpecOpts(oci.WithArgs(args)))
+	if config.WorkingDir != "" {
+		opts = append(opts, func(s *specs.Spec) error {
+			s.Process.Cwd = config.WorkingDir
+			return nil
+		})
+	}
+	var env []string
+	for _, kv := range config.Envs {
+		env = append(env, kv.Key+"="+kv.Value)
+	}
+	opts = append(opts, containerd.SpecOpts(oci.WithEnv(env)))
+	if sandbox.Hostname != "" {
+		opts = append(opts, containerd.WithHostname(sandbox.Hostname))
+	}
+	if config.Tty {
+		opts = append(opts, containerd.WithTTY)
+	}
+
+	if options.HostNetwork {
+		opts = append(opts, containerd.WithHostNetwork)
+		if parent := cgroupParent(sandbox); parent != "" {
+			// containers of pods in sandboxes get the parent from the
+			// sandbox
+			opts = append(opts, containerd.WithCgroup(filepath.Join(parent, id)))
+		}
+	}
+	if options.HostIpc {
+		opts = append(opts, containerd.WithHostIPC)
+	}
+	if options.HostPid {
+		opts = append(opts, containerd.WithHostPID)
+	}
+	if sandbox.Linux != nil && len(sandbox.Linux.Sysctls) > 0 {
+		opts = append(opts, func(s *specs.Spec) error {
+			if s.Linux.Sysctl == nil {
+				s.Linux.Sysctl = make(map[string]string)
+			}
+			for k, v := range sandbox.Linux.Sysctls {
+				s.Linux.Sysctl[k] = v
+			}
+			return nil
+		})
+	}
+
+	mounts, err := containerMounts(config.Mounts)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, containerd.SpecOpts(oci.WithMounts(mounts)), withPropagation(config.Mounts))
+	devices, err := containerDevices(config.Devices)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, withDevices(devices, config.Devices))
+	if config.Linux != nil {
+		if r := config.Linux.Resources; r != nil {
+			opts = append(opts, withResources(r))
+		}
+		if sc := config.Linux.SecurityContext; sc != nil {
+			opts = append(opts, withSecurityContext(sc, withUser))
+		}
+	}
+	return containerd.GenerateSpec(opts...)
+}
+
+func securityContext(config *api.ContainerConfig) *api.LinuxContainerSecurityContext {
+	if config.Linux == nil {
+		return nil
+	}
+	return config.Linux.SecurityContext
+}
+
+// containerMounts returns the bind mounts of the volumes of the container,
+// creating the directories missing on the host as the docker daemon does
+func containerMounts(mounts []*api.Mount) ([]specs.Mount, error) {
+	var out []specs.Mount
+	for _, m := range mounts {
+		if _, err := os.Stat(m.HostPath); os.IsNotExist(err) {
+			if err := os.MkdirAll(m.HostPath, 0755); err != nil {
+				return nil, err
+			}
+		}
+		options := []string{"rbind", "rw"}
+		if m.Readonly {
+			options[1] = "ro"
+		}
+		switch m.Propagation {
+		case api.MountPropagation_PROPAGATION_HOST_TO_CONTAINER:
+			options = append(options, "rslave")
+		case api.MountPropagation_PROPAGATION_BIDIRECTIONAL:
+			options = append(options, "rshared")
+		default:
+			options = append(options, "rprivate")
+		}
+		out = append(out, specs.Mount{
+			Destination: m.ContainerPath,
+			Source:      m.HostPath,
+			Type:        "bind",
+			Options:     options,
+		})
+	}
+	return out, nil
+}
+
+// withPropagation makes the root of the container shared or slave for the
+// propagation of its mounts to take effect
+func withPropagation(mounts []*api.Mount) containerd.SpecOpts {
+	return func(s *specs.Spec) error {
+		for _, m := range mounts {
+			switch m.Propagation {
+			case api.MountPropagation_PROPAGATION_BIDIRECTIONAL:
+				s.Linux.RootfsPropagation = "rshared"
+			case api.MountPropagation_PROPAGATION_HOST_TO_CONTAINER:
+				if s.Linux.RootfsPropagation != "rshared" {
+					s.Linux.RootfsPropagation = "rslave"
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// containerDevices returns the devices of the host at the host paths of the
+// devices of the container
+func containerDevices(devices []*api.Device) ([]specs.LinuxDevice, error) {
+	var out []specs.LinuxDevice
+	for _, d := range devices {
+		var st unix.Stat_t
+		if err := unix.Stat(d.HostPath, &st); err != nil {
+			return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "device %s: %v", d.HostPath, err)
+		}
+		var typ string
+		switch st.Mode & unix.S_IFMT {
+		case unix.S_IFCHR:
+			typ = "c"
+		case unix.S_IFBLK:
+			typ = "b"
+		default:
+			return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "%s is not a device", d.HostPath)
+		}
+		mode := os.FileMode(st.Mode &^ unix.S_IFMT)
+		out = append(out, specs.LinuxDevice{
+			Path:     d.ContainerPath,
+			Type:     typ,
+			Major:    int64(unix.Major(uint64(st.Rdev))),
+			Minor:    int64(unix.Minor(uint64(st.Rdev))),
+			FileMode: &mode,
+			UID:      &st.Uid,
+			GID:      &st.Gid,
+		})
+	}
+	return out, nil
+}
+
+// withDevices adds the devices to the container and allows them in its
+// device cgroup with the permissions of their configuration
+func withDevices(devices []specs.LinuxDevice, configs []*api.Device) containerd.SpecOpts {
+	return func(s *specs.Spec) error {
+		if s.Linux.Resources == nil {
+			s.Linux.Resources = &specs.LinuxResources{}
+		}
+		for i, d := range devices {
+			access := configs[i].Permissions
+			if access == "" {
+				access = "rwm"
+			}
+			major, minor := d.Major, d.Minor
+			s.Linux.Devices = append(s.Linux.Devices, d)
+			s.Linux.Resources.Devices = append(s.Linux.Resources.Devices, specs.LinuxDeviceCgroup{
+				Allow:  true,
+				Type:   d.Type,
+				Major:  &major,
+				Minor:  &minor,
+				Access: access,
+			})
+		}
+		return nil
+	}
+}
+
+// linuxResources returns the resources of the spec for the resources of a
+// container
+func linuxResources(r *api.LinuxContainerResources) *specs.LinuxResources {
+	var out specs.LinuxResources
+	cpu := &specs.LinuxCPU{
+		Cpus: r.CpusetCpus,
+		Mems: r.CpusetMems,
+	}
+	if r.CpuPeriod > 0 {
+		period := uint64(r.CpuPeriod)
+		cpu.Period = &period
+	}
+	if r.CpuQuota > 0 {
+		quota := r.CpuQuota
+		cpu.Quota = &quota
+	}
+	if r.CpuShares > 0 {
+		shares := uint64(r.CpuShares)
+		cpu.Shares = &shares
+	}
+	out.CPU = cpu
+	if r.MemoryLimitInBytes > 0 {
+		limit := r.MemoryLimitInBytes
+		out.Memory = &specs.LinuxMemory{
+			Limit: &limit,
+		}
+	}
+	return &out
+}
+
+func withResources(r *api.LinuxContainerResources) containerd.SpecOpts {
+	return func(s *specs.Spec) error {
+		resources := linuxResources(r)
+		if s.Linux.Resources == nil {
+			s.Linux.Resources = &specs.LinuxResources{}
+		}
+		s.Linux.Resources.CPU = resources.CPU
+		s.Linux.Resources.Memory = resources.Memory
+		if r.OomScoreAdj != 0 {
+			adj := int(r.OomScoreAdj)
+			s.Process.OOMScoreAdj = &adj
+		}
+		return nil
+	}
+}
+
+// capabilityName returns the name of a capability in the spec, the kubelet
+// naming them without the CAP_ prefix
+func capabilityName(c string) string {
+	c = strings.ToUpper(c)
+	if !strings.HasPrefix(c, "CAP_") {
+		c = "CAP_" + c
+	}
+	return c
+}
+
+// adjustCapabilities adds and drops the capabilities, "ALL" dropping all
+// the capabilities not added
+func adjustCapabilities(caps []string, add, drop []string) []string {
+	dropped := make(map[string]bool)
+	for _, c := range drop {
+		if strings.ToUpper(c) == "ALL" {
+			caps = nil
+			continue
+		}
+		dropped[capabilityName(c)] = true
+	}
+	var out []string
+	seen := make(map[string]bool)
+	for _, c := range caps {
+		if !dropped[c] && !seen[c] {
+			out = append(out, c)
+			seen[c] = true
+		}
+	}
+	for _, c := range add {
+		if strings.ToUpper(c) == "ALL" {
+			return append([]string(nil), allCapabilities...)
+		}
+		if c = capabilityName(c); !seen[c] {
+			out = append(out, c)
+			seen[c] = true
+		}
+	}
+	return out
+}
+
+func withSecurityContext(sc *api.LinuxContainerSecurityContext, withUser func(user string) containerd.SpecOpts) containerd.SpecOpts {
+	return func(s *specs.Spec) error {
+		if sc.Privileged {
+			s.Process.Capabilities = &specs.LinuxCapabilities{
+				Bounding:    allCapabilities,
+				Effective:   allCapabilities,
+				Inheritable: allCapabilities,
+				Permitted:   allCapabilities,
+			}
+			s.Linux.MaskedPaths = nil
+			s.Linux.ReadonlyPaths = nil
+			if s.Linux.Resources == nil {
+				s.Linux.Resources = &specs.LinuxResources{}
+			}
+			s.Linux.Resources.Devices = []specs.LinuxDeviceCgroup{
+				{
+					Allow:  true,
+					Access: "rwm",
+				},
+			}
+		} else if c := sc.Capabilities; c != nil && s.Process.Capabilities != nil {
+			caps := s.Process.Capabilities
+			caps.Bounding = adjustCapabilities(caps.Bounding, c.AddCapabilities, c.DropCapabilities)
+			caps.Effective = adjustCapabilities(caps.Effective, c.AddCapabilities, c.DropCapabilities)
+			caps.Inheritable = adjustCapabilities(caps.Inheritable, c.AddCapabilities, c.DropCapabilities)
+			caps.Permitted = adjustCapabilities(caps.Permitted, c.AddCapabilities, c.DropCapabilities)
+		}
+		if sc.RunAsUser != nil {
+			s.Process.User.UID = uint32(sc.RunAsUser.Value)
+		} else if sc.RunAsUsername != "" {
+			if err := withUser(sc.RunAsUsername)(s); err != nil {
+				return err
+			}
+		}
+		for _, g := range sc.SupplementalGroups {
+			s.Process.User.AdditionalGids = append(s.Process.User.AdditionalGids, uint32(g))
+		}
+		if sc.ReadonlyRootfs {
+			s.Root.Readonly = true
+		}
+		s.Process.NoNewPrivileges = sc.NoNewPrivs
+		if o := sc.SelinuxOptions; o != nil && o.User != "" && o.Role != "" && o.Type != "" && o.Level != "" {
+			s.Process.SelinuxLabel = fmt.Sprintf("%s:%s:%s:%s", o.User, o.Role, o.Type, o.Level)
+		}
+		// the default profiles of the runtime are those of the spec, as no
+		// other profiles are loaded by containerd
+		if p := sc.ApparmorProfile; strings.HasPrefix(p, "localhost/") {
+			s.Process.ApparmorProfile = strings.TrimPrefix(p, "localhost/")
+		}
+		if p := sc.SeccompProfilePath; strings.HasPrefix(p, "localhost/") {
+			data, err := ioutil.ReadFile(strings.TrimPrefix(p, "localhost/"))
+			if err != nil {
+				return errors.Wrapf(errdefs.ErrInvalidArgument, "seccomp profile %s: %v", p, err)
+			}
+			var profile specs.LinuxSeccomp
+			if err := json.Unmarshal(data, &profile); err != nil {
+				return errors.Wrapf(errdefs.ErrInvalidArgument, "seccomp profile %s: %v", p, err)
+			}
+			s.Linux.Seccomp = &profile
+		} else if p == "unconfined" {
+			s.Linux.Seccomp = nil
+		}
+		return nil
+	}
+}
@@ -0,0 +1,239 @@
+package cri
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	api "github.com/containerd/containerd/api/services/cri/v1alpha1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+)
+
+// sandboxRecord is the state of a pod sandbox kept by the service
+type sandboxRecord struct {
+	ID        string                `json:"id"`
+	Config    *api.PodSandboxConfig `json:"config"`
+	State     api.PodSandboxState   `json:"state"`
+	IP        string                `json:"ip,omitempty"`
+	CreatedAt int64                 `json:"created_at"`
+}
+
+// containerRecord is the state of a container kept by the service
+type containerRecord struct {
+	ID         string               `json:"id"`
+	SandboxID  string               `json:"sandbox_id"`
+	Config     *api.ContainerConfig `json:"config"`
+	ImageRef   string               `json:"image_ref"`
+	LogPath    string               `json:"log_path,omitempty"`
+	State      api.ContainerState   `json:"state"`
+	CreatedAt  int64                `json:"created_at"`
+	StartedAt  int64                `json:"started_at,omitempty"`
+	FinishedAt int64                `json:"finished_at,omitempty"`
+	ExitCode   int32                `json:"exit_code,omitempty"`
+	Reason     string               `json:"reason,omitempty"`
+	Message    string               `json:"message,omitempty"`
+}
+
+// store keeps the records of the pod sandboxes and containers in memory and
+// as json files in its root, as the kubelet refers to them by the metadata
+// of the CRI, which has no place in the objects of containerd
+type store struct {
+	mu         sync.Mutex
+	root       string
+	sandboxes  map[string]sandboxRecord
+	containers map[string]containerRecord
+}
+
+// newStore returns the store of the records in the root, loading those
+// written before
+func newStore(root string) (*store, error) {
+	s := &store{
+		root:       root,
+		sandboxes:  make(map[string]sandboxRecord),
+		containers: make(map[string]containerRecord),
+	}
+	for _, kind := range []string{"sandboxes", "containers"} {
+		dir := filepath.Join(root, kind)
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, err
+		}
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			if !strings.HasSuffix(f.Name(), ".json") {
+				continue
+			}
+			data, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+			if err != nil {
+				return nil, err
+			}
+			switch kind {
+			case "sandboxes":
+				var r sandboxRecord
+				if err := json.Unmarshal(data, &r); err != nil {
+					return nil, errors.Wrapf(err, "failed to load sandbox %s", f.Name())
+				}
+				s.sandboxes[r.ID] = r
+			case "containers":
+				var r containerRecord
+				if err := json.Unmarshal(data, &r); err != nil {
+					return nil, errors.Wrapf(err, "failed to load container %s", f.Name())
+				}
+				s.containers[r.ID] = r
+			}
+		}
+	}
+	return s, nil
+}
+
+func (s *store) write(kind, id string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(s.root, kind, id+".json")
+	if err := ioutil.WriteFile(path+".tmp", data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+func (s *store) remove(kind, id string) error {
+	if err := os.Remove(filepath.Join(s.root, kind, id+".json")); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *store) sandbox(id string) (sandboxRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.sandboxes[id]
+	if !ok {
+		return r, errors.Wrapf(errdefs.ErrNotFound, "pod sandbox %q", id)
+	}
+	return r, nil
+}
+
+func (s *store) listSandboxes() []sandboxRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records := make([]sandboxRecord, 0, len(s.sandboxes))
+	for _, r := range s.sandboxes {
+		records = append(records, r)
+	}
+	return records
+}
+
+// addSandbox adds the record, failing when a sandbox of the same name exists
+func (s *store) addSandbox(r sandboxRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := sandboxName(r.Config.Metadata)
+	for _, o := range s.sandboxes {
+		if sandboxName(o.Config.Metadata) == name {
+			return errors.Wrapf(errdefs.ErrAlreadyExists, "pod sandbox name %q is reserved by %s", name, o.ID)
+		}
+	}
+	if err := s.write("sandboxes", r.ID, r); err != nil {
+		return err
+	}
+	s.sandboxes[r.ID] = r
+	return nil
+}
+
+// updateSandbox applies fn to the record of the sandbox and writes it
+func (s *store) updateSandbox(id string, fn func(*sandboxRecord)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.sandboxes[id]
+	if !ok {
+		return errors.Wrapf(errdefs.ErrNotFound, "pod sandbox %q", id)
+	}
+	fn(&r)
+	if err := s.write("sandboxes", id, r); err != nil {
+		return err
+	}
+	s.sandboxes[id] = r
+	return nil
+}
+
+func (s *store) deleteSandbox(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.remove("sandboxes", id); err != nil {
+		return err
+	}
+	delete(s.sandboxes, id)
+	return nil
+}
+
+func (s *store) container(id string) (containerRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.containers[id]
+	if !ok {
+		return r, errors.Wrapf(errdefs.ErrNotFound, "container %q", id)
+	}
+	return r, nil
+}
+
+func (s *store) listContainers() []containerRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records := make([]containerRecord, 0, len(s.containers))
+	for _, r := range s.containers {
+		records = append(records, r)
+	}
+	return records
+}
+
+// addContainer adds the record, failing when a container of the same name
+// exists in the sandbox
+func (s *store) addContainer(r containerRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := containerName(r.Config.Metadata)
+	for _, o := range s.containers {
+		if o.SandboxID == r.SandboxID && containerName(o.Config.Metadata) == name {
+			return errors.Wrapf(errdefs.ErrAlreadyExists, "container name %q is reserved by %s", name, o.ID)
+		}
+	}
+	if err := s.write("containers", r.ID, r); err != nil {
+		return err
+	}
+	s.containers[r.ID] = r
+	return nil
+}
+
+// updateContainer applies fn to the record of the container and writes it
+func (s *store) updateContainer(id string, fn func(*containerRecord)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.containers[id]
+	if !ok {
+		return errors.Wrapf(errdefs.ErrNotFound, "container %q", id)
+	}
+	fn(&r)
+	if err := s.write("containers", id, r); err != nil {
+		return err
+	}
+	s.containers[id] = r
+	return nil
+}
+
+func (s *store) deleteContainer(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.remove("containers", id); err != nil {
+		return err
+	}
+	delete(s.containers, id)
+	return nil
+}
@@ -0,0 +1,76 @@
+package cri
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	api "github.com/containerd/containerd/api/services/cri/v1alpha1"
+	"github.com/containerd/containerd/errdefs"
+)
+
+func TestNormalizeImage(t *testing.T) {
+	for ref, expected := range map[string]string{
+		"busybox":                        "docker.io/library/busybox:latest",
+		"busybox:1.27":                   "docker.io/library/busybox:1.27",
+		"library/busybox":                "docker.io/library/busybox:latest",
+		"gcr.io/google_containers/pause": "gcr.io/google_containers/pause:latest",
+		"localhost:5000/pause":           "localhost:5000/pause:latest",
+		"localhost:5000/pause:3.0":       "localhost:5000/pause:3.0",
+	} {
+		name, err := normalizeImage(ref)
+		if err != nil {
+			t.Fatalf("%s: %v", ref, err)
+		}
+		if name != expected {
+			t.Errorf("%s: expected %s but received %s", ref, expected, name)
+		}
+	}
+	if _, err := normalizeImage(""); !errdefs.IsInvalidArgument(err) {
+		t.Errorf("expected invalid argument for an empty image but received %v", err)
+	}
+}
+
+func TestStoreReload(t *testing.T) {
+	root, err := ioutil.TempDir("", "cri-store-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	s, err := newStore(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &api.PodSandboxConfig{
+		Metadata: &api.PodSandboxMetadata{Name: "web", Namespace: "default", Uid: "1"},
+	}
+	if err := s.addSandbox(sandboxRecord{ID: "a", Config: config}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.addSandbox(sandboxRecord{ID: "b", Config: config}); !errdefs.IsAlreadyExists(err) {
+		t.Fatalf("expected the name of the sandbox to be reserved but received %v", err)
+	}
+	if err := s.updateSandbox("a", func(r *sandboxRecord) {
+		r.State = api.PodSandboxState_SANDBOX_READY
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if s, err = newStore(root); err != nil {
+		t.Fatal(err)
+	}
+	r, err := s.sandbox("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.State != api.PodSandboxState_SANDBOX_READY {
+		t.Errorf("expected the sandbox to be ready but received %s", r.State)
+	}
+	if err := s.deleteSandbox("a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.addSandbox(sandboxRecord{ID: "b", Config: config}); err != nil {
+		t.Fatalf("expected the name of the deleted sandbox to be released but received %v", err)
+	}
+}
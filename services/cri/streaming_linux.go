@@ -0,0 +1,850 @@
+package cri
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/containerd/containerd"
+	api "github.com/containerd/containerd/api/services/cri/v1alpha1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/log"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+const (
+	// streamTimeout is how long the url returned for a stream is valid
+	streamTimeout = time.Minute
+	// portForwardTimeout bounds the time to connect to a forwarded port
+	portForwardTimeout = 10 * time.Second
+	// streamCreationTimeout bounds the time a SPDY client is given to open
+	// the streams of a session
+	streamCreationTimeout = 30 * time.Second
+	// maxPortForwardStreams bounds the SPDY streams of forwarded ports
+	// waiting for the other stream of their pair
+	maxPortForwardStreams = 64
+
+	stdinChannel  = 0
+	stdoutChannel = 1
+	stderrChannel = 2
+	errorChannel  = 3
+	resizeChannel = 4
+	// closeChannel carries the channel of a stream closed by the client in
+	// the v5 protocol
+	closeChannel = 255
+)
+
+var (
+	// remoteCommandProtocols are the websocket protocols of the exec and
+	// attach streams
+	remoteCommandProtocols = []string{
+		"v5.channel.k8s.io",
+		"v4.channel.k8s.io",
+		"v4.base64.channel.k8s.io",
+		"channel.k8s.io",
+		"base64.channel.k8s.io",
+	}
+	// portForwardProtocols are the websocket protocols of the port forward
+	// streams
+	portForwardProtocols = []string{
+		"v4.channel.k8s.io",
+		"v4.base64.channel.k8s.io",
+	}
+	// spdyRemoteCommandProtocols are the SPDY protocols of the exec and
+	// attach streams
+	spdyRemoteCommandProtocols = []string{
+		"v4.channel.k8s.io",
+		"v3.channel.k8s.io",
+		"v2.channel.k8s.io",
+		"channel.k8s.io",
+	}
+	// spdyPortForwardProtocols are the SPDY protocols of the port forward
+	// streams
+	spdyPortForwardProtocols = []string{
+		"portforward.k8s.io",
+	}
+)
+
+// streamRequest is a request of the kubelet waiting for its stream
+type streamRequest struct {
+	request interface{}
+	expires time.Time
+}
+
+// streamServer serves the exec, attach and port forward streams of the
+// requests of the kubelet on the urls returned for them
+type streamServer struct {
+	// base is the url of the server
+	base string
+
+	mu       sync.Mutex
+	requests map[string]streamRequest
+}
+
+// serveStreams serves the streams of the service on the address
+func (s *Service) serveStreams(address string) error {
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return errors.Wrap(err, "failed to listen for streams")
+	}
+	host, port, err := net.SplitHostPort(l.Addr().String())
+	if err != nil {
+		l.Close()
+		return err
+	}
+	// the kubelet proxies the streams from the node by default
+	if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+		host = "127.0.0.1"
+	}
+	s.streams = &streamServer{
+		base:     "http://" + net.JoinHostPort(host, port),
+		requests: make(map[string]streamRequest),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/exec/", s.serveStream)
+	mux.HandleFunc("/attach/", s.serveStream)
+	mux.HandleFunc("/portforward/", s.serveStream)
+	go func() {
+		if err := http.Serve(l, mux); err != nil {
+			log.L.WithError(err).Error("cri: stream server stopped")
+		}
+	}()
+	return nil
+}
+
+// streamURL returns the single use url of the stream of the request
+func (s *Service) streamURL(kind string, request interface{}) (string, error) {
+	if s.streams == nil {
+		return "", errors.Wrap(errdefs.ErrUnavailable, "streams are disabled as stream_address is not set")
+	}
+	token := generateID()
+	ss := s.streams
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	now := time.Now()
+	for t, r := range ss.requests {
+		if now.After(r.expires) {
+			delete(ss.requests, t)
+		}
+	}
+	ss.requests[token] = streamRequest{
+		request: request,
+		expires: now.Add(streamTimeout),
+	}
+	return ss.base + "/" + kind + "/" + token, nil
+}
+
+// takeRequest returns the request of the token, which can only be taken once
+func (ss *streamServer) takeRequest(token string) (interface{}, bool) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	r, ok := ss.requests[token]
+	if !ok {
+		return nil, false
+	}
+	delete(ss.requests, token)
+	if time.Now().After(r.expires) {
+		return nil, false
+	}
+	return r.request, true
+}
+
+func (s *Service) serveStream(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if len(parts) != 2 {
+		http.NotFound(w, r)
+		return
+	}
+	request, ok := s.streams.takeRequest(parts[1])
+	if !ok {
+		http.Error(w, "unknown or expired stream", http.StatusNotFound)
+		return
+	}
+	var stdin, stdout, stderr, tty bool
+	switch req := request.(type) {
+	case *api.ExecRequest:
+		ok = parts[0] == "exec"
+		stdin, stdout, stderr, tty = req.Stdin, req.Stdout, req.Stderr, req.Tty
+	case *api.AttachRequest:
+		ok = parts[0] == "attach"
+		stdin, stdout, stderr, tty = req.Stdin, req.Stdout, req.Stderr, req.Tty
+	case *api.PortForwardRequest:
+		ok = parts[0] == "portforward"
+	}
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	if req, ok := request.(*api.PortForwardRequest); ok {
+		s.servePortForward(w, r, req)
+		return
+	}
+	var sess *streamSession
+	if isSPDYRequest(r) {
+		conn, err := upgradeSPDY(w, r, spdyRemoteCommandProtocols)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if sess, err = newSPDYSession(conn, stdin, stdout, stderr, tty); err != nil {
+			log.L.WithError(err).Debug("cri: failed to open the streams of a session")
+			conn.Close()
+			return
+		}
+	} else {
+		conn, err := upgrade(w, r, remoteCommandProtocols)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		sess = newWebsocketSession(conn, stdin, stdout, stderr)
+	}
+	defer sess.Close()
+	switch req := request.(type) {
+	case *api.ExecRequest:
+		sess.writeStatus(s.exec(sess, req))
+	case *api.AttachRequest:
+		sess.writeStatus(0, s.attach(sess, req))
+	}
+}
+
+// streamSession holds the streams of an exec or attach session, over
+// websocket or SPDY
+type streamSession struct {
+	// protocol is the protocol agreed with the client
+	protocol string
+	// stdin, stdout and stderr are the streams requested by the client, nil
+	// otherwise
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	// resize receives the terminal sizes of the client and is closed once it
+	// sends no more
+	resize <-chan terminalSize
+	// done is closed once the client left
+	done <-chan struct{}
+
+	status func([]byte) error
+	close  func() error
+}
+
+// writeStatus writes the exit status of the command, or the error of the
+// session, to the error stream
+func (s *streamSession) writeStatus(code uint32, err error) {
+	if err := s.status(statusMessage(s.protocol, code, err)); err != nil {
+		log.L.WithError(err).Debug("cri: failed to write the status of a session")
+	}
+}
+
+// Close ends the streams of the session and closes its connection
+func (s *streamSession) Close() error {
+	return s.close()
+}
+
+// newWebsocketSession returns the session of the websocket connection,
+// whose messages are read until the client leaves
+func newWebsocketSession(conn *wsConn, stdin, stdout, stderr bool) *streamSession {
+	var (
+		resize = make(chan terminalSize, 1)
+		done   = make(chan struct{})
+		sess   = &streamSession{
+			protocol: conn.protocol,
+			resize:   resize,
+			done:     done,
+			status: func(p []byte) error {
+				if len(p) == 0 {
+					return nil
+				}
+				return conn.WriteMessage(errorChannel, p)
+			},
+		}
+		stdinR *io.PipeReader
+		stdinW *io.PipeWriter
+	)
+	if stdin {
+		stdinR, stdinW = io.Pipe()
+		sess.stdin = stdinR
+	}
+	if stdout {
+		sess.stdout = channelWriter{conn: conn, channel: stdoutChannel}
+	}
+	if stderr {
+		sess.stderr = channelWriter{conn: conn, channel: stderrChannel}
+	}
+	sess.close = func() error {
+		// unblocks the reads of the client waiting for its input to be read
+		if stdinR != nil {
+			stdinR.Close()
+		}
+		return conn.Close()
+	}
+	go func() {
+		defer func() {
+			if stdinW != nil {
+				stdinW.Close()
+			}
+			close(resize)
+			close(done)
+		}()
+		for {
+			channel, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			switch channel {
+			case stdinChannel:
+				if stdinW != nil && len(data) > 0 {
+					if _, err := stdinW.Write(data); err != nil {
+						stdinW = nil
+					}
+				}
+			case resizeChannel:
+				var size terminalSize
+				if err := json.Unmarshal(data, &size); err != nil {
+					log.L.WithError(err).Debug("cri: invalid terminal size")
+					continue
+				}
+				sendSize(resize, size)
+			case closeChannel:
+				if len(data) > 0 && data[0] == stdinChannel && stdinW != nil {
+					stdinW.Close()
+					stdinW = nil
+				}
+			}
+		}
+	}()
+	return sess
+}
+
+// newSPDYSession returns the session of the SPDY connection once the client
+// opened the streams requested, each stream being named by its streamtype
+// header
+func newSPDYSession(conn *spdyConn, stdin, stdout, stderr, tty bool) (*streamSession, error) {
+	expected := map[string]bool{
+		"error":  true,
+		"stdin":  stdin,
+		"stdout": stdout,
+		"stderr": stderr,
+		// the resize stream came with the v3 protocol
+		"resize": tty && (strings.HasPrefix(conn.protocol, "v3.") || strings.HasPrefix(conn.protocol, "v4.")),
+	}
+	streams := make(map[string]*spdyStream)
+	complete := func() bool {
+		for typ, ok := range expected {
+			if ok && streams[typ] == nil {
+				return false
+			}
+		}
+		return true
+	}
+	timer := time.NewTimer(streamCreationTimeout)
+	defer timer.Stop()
+	for !complete() {
+		select {
+		case st := <-conn.accepted:
+			typ := st.headers.Get(spdyStreamTypeHeader)
+			if _, ok := expected[typ]; !ok || streams[typ] != nil {
+				conn.reset(st.id, spdyStatusProtocolError)
+				continue
+			}
+			streams[typ] = st
+		case <-conn.done:
+			return nil, errors.New("the client left before opening its streams")
+		case <-timer.C:
+			return nil, errors.New("timed out waiting for the streams of the client")
+		}
+	}
+	var (
+		resize = make(chan terminalSize, 1)
+		errs   = streams["error"]
+		sess   = &streamSession{
+			protocol: conn.protocol,
+			resize:   resize,
+			done:     conn.done,
+			status: func(p []byte) error {
+				if len(p) > 0 {
+					if _, err := errs.Write(p); err != nil {
+						return err
+					}
+				}
+				return errs.Close()
+			},
+		}
+	)
+	if stdin {
+		sess.stdin = streams["stdin"]
+	}
+	if stdout {
+		sess.stdout = streams["stdout"]
+	}
+	if stderr {
+		sess.stderr = streams["stderr"]
+	}
+	sess.close = func() error {
+		for _, st := range streams {
+			st.Close()
+		}
+		return conn.Close()
+	}
+	go func() {
+		defer close(resize)
+		st, ok := streams["resize"]
+		if !ok {
+			<-conn.done
+			return
+		}
+		d := json.NewDecoder(st)
+		for {
+			var size terminalSize
+			if err := d.Decode(&size); err != nil {
+				return
+			}
+			sendSize(resize, size)
+		}
+	}()
+	return sess, nil
+}
+
+// sendSize passes the terminal size on, unless the previous one was not
+// applied yet
+func sendSize(resize chan<- terminalSize, size terminalSize) {
+	select {
+	case resize <- size:
+	default:
+	}
+}
+
+// exec runs the command of the request in the container with the streams of
+// the session as stdio, returning its exit status
+func (s *Service) exec(sess *streamSession, req *api.ExecRequest) (uint32, error) {
+	ctx, client, err := s.withClient(context.Background())
+	if err != nil {
+		return 0, err
+	}
+	container, err := client.LoadContainer(ctx, req.ContainerID)
+	if err != nil {
+		return 0, err
+	}
+	spec, err := container.Spec()
+	if err != nil {
+		return 0, err
+	}
+	task, err := container.Task(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	pspec := *spec.Process
+	pspec.Args = req.Cmd
+	pspec.Terminal = req.Tty
+
+	var (
+		stdin  io.Reader = strings.NewReader("")
+		stdout io.Writer = ioutil.Discard
+		stderr io.Writer = ioutil.Discard
+	)
+	if sess.stdin != nil {
+		stdin = sess.stdin
+	}
+	if sess.stdout != nil {
+		stdout = sess.stdout
+	}
+	if sess.stderr != nil {
+		stderr = sess.stderr
+	}
+	process, err := task.Exec(ctx, generateID(), &pspec, containerd.NewIOWithTerminal(stdin, stdout, stderr, req.Tty))
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if _, err := process.Delete(ctx, containerd.WithProcessKill); err != nil && !errdefs.IsNotFound(err) {
+			log.G(ctx).WithError(err).WithField("id", req.ContainerID).Warn("cri: failed to delete exec process")
+		}
+	}()
+	statusC, err := process.Wait(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := process.Start(ctx); err != nil {
+		return 0, err
+	}
+	go func() {
+		for size := range sess.resize {
+			if err := process.Resize(ctx, uint32(size.Width), uint32(size.Height)); err != nil {
+				log.G(ctx).WithError(err).Debug("cri: failed to resize terminal")
+			}
+		}
+	}()
+	select {
+	case status := <-statusC:
+		code, _, err := status.Result()
+		if err != nil {
+			return 0, err
+		}
+		process.IO().Wait()
+		return code, nil
+	case <-sess.done:
+		// the process is killed as the client left
+		return 0, errors.New("the client left before the command exited")
+	}
+}
+
+// attach streams the output of the task of the container to the session and
+// its input to the stdin of the task, until the client leaves or the output
+// of the task ends
+func (s *Service) attach(sess *streamSession, req *api.AttachRequest) error {
+	s.mu.Lock()
+	cio, ok := s.ios[req.ContainerID]
+	s.mu.Unlock()
+	if !ok {
+		return errors.Wrapf(errdefs.ErrFailedPrecondition, "the output of container %s cannot be attached", req.ContainerID)
+	}
+	type output struct {
+		b *broadcaster
+		c chan []byte
+		w io.Writer
+	}
+	var outputs []output
+	if sess.stdout != nil {
+		outputs = append(outputs, output{b: cio.stdout, c: cio.stdout.add(), w: sess.stdout})
+	}
+	if sess.stderr != nil && !cio.terminal {
+		outputs = append(outputs, output{b: cio.stderr, c: cio.stderr.add(), w: sess.stderr})
+	}
+	var (
+		ended   = make(chan struct{})
+		once    sync.Once
+		end     = func() { once.Do(func() { close(ended) }) }
+		wg      sync.WaitGroup
+		dropped int32
+	)
+	for _, o := range outputs {
+		wg.Add(1)
+		go func(o output) {
+			defer wg.Done()
+			for data := range o.c {
+				if _, err := o.w.Write(data); err != nil {
+					end()
+				}
+			}
+			select {
+			case <-cio.done:
+			case <-ended:
+			default:
+				// the client fell behind the output of the task
+				atomic.StoreInt32(&dropped, 1)
+				end()
+			}
+		}(o)
+	}
+	if sess.stdin != nil {
+		go func() {
+			stdin := &attachStdin{cio: cio}
+			io.Copy(stdin, sess.stdin)
+			stdin.Close()
+		}()
+	}
+	go func() {
+		for size := range sess.resize {
+			if err := s.resize(req.ContainerID, uint32(size.Width), uint32(size.Height)); err != nil {
+				log.L.WithError(err).Debug("cri: failed to resize terminal")
+			}
+		}
+	}()
+	select {
+	case <-cio.done:
+	case <-ended:
+	case <-sess.done:
+	}
+	for _, o := range outputs {
+		o.b.remove(o.c)
+	}
+	wg.Wait()
+	if atomic.LoadInt32(&dropped) != 0 {
+		return errors.Errorf("disconnected as the client fell behind the output of container %s", req.ContainerID)
+	}
+	return nil
+}
+
+// resize resizes the terminal of the task of the container
+func (s *Service) resize(id string, w, h uint32) error {
+	ctx, client, err := s.withClient(context.Background())
+	if err != nil {
+		return err
+	}
+	container, err := client.LoadContainer(ctx, id)
+	if err != nil {
+		return err
+	}
+	task, err := container.Task(ctx, nil)
+	if err != nil {
+		return err
+	}
+	return task.Resize(ctx, w, h)
+}
+
+// attachStdin writes the input of an attached client to the stdin of the
+// task, closing it when the client leaves if the container only reads the
+// stdin of its first client
+type attachStdin struct {
+	cio *containerIO
+}
+
+func (a *attachStdin) Write(p []byte) (int, error) {
+	if err := a.cio.write(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (a *attachStdin) Close() error {
+	if a.cio.stdinOnce {
+		a.cio.closeStdin()
+	}
+	return nil
+}
+
+// terminalSize is a message of the resize stream
+type terminalSize struct {
+	Width  uint16
+	Height uint16
+}
+
+// streamStatus is the status of a command written to the error stream, a
+// Status of the Kubernetes API in the v4 and v5 protocols
+type streamStatus struct {
+	Metadata struct{}             `json:"metadata"`
+	Status   string               `json:"status"`
+	Message  string               `json:"message,omitempty"`
+	Reason   string               `json:"reason,omitempty"`
+	Details  *streamStatusDetails `json:"details,omitempty"`
+}
+
+type streamStatusDetails struct {
+	Causes []streamStatusCause `json:"causes,omitempty"`
+}
+
+type streamStatusCause struct {
+	Type    string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// statusMessage returns the message of the error stream for the exit status
+// of the command, or the error of the session, in the protocol
+func statusMessage(protocol string, code uint32, err error) []byte {
+	var message string
+	switch {
+	case err != nil:
+		message = err.Error()
+	case code != 0:
+		message = fmt.Sprintf("command terminated with non-zero exit code: %d", code)
+	}
+	if !strings.HasPrefix(protocol, "v4.") && !strings.HasPrefix(protocol, "v5.") {
+		// earlier protocols only carry the errors of the session
+		return []byte(message)
+	}
+	status := streamStatus{
+		Status: "Success",
+	}
+	switch {
+	case err != nil:
+		status.Status, status.Message, status.Reason = "Failure", message, "InternalError"
+	case code != 0:
+		status.Status, status.Message, status.Reason = "Failure", message, "NonZeroExitCode"
+		status.Details = &streamStatusDetails{
+			Causes: []streamStatusCause{
+				{
+					Type:    "ExitCode",
+					Message: strconv.FormatUint(uint64(code), 10),
+				},
+			},
+		}
+	}
+	p, err := json.Marshal(status)
+	if err != nil {
+		return []byte(message)
+	}
+	return p
+}
+
+// servePortForward forwards the ports of the request over the connection of
+// the client
+func (s *Service) servePortForward(w http.ResponseWriter, r *http.Request, req *api.PortForwardRequest) {
+	if isSPDYRequest(r) {
+		conn, err := upgradeSPDY(w, r, spdyPortForwardProtocols)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer conn.Close()
+		s.portForwardSPDY(conn, req)
+		return
+	}
+	conn, err := upgrade(w, r, portForwardProtocols)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer conn.Close()
+	s.portForward(conn, r, req)
+}
+
+// portForwardHost returns the host the ports of the pod are connected on
+func (s *Service) portForwardHost(id string) (string, error) {
+	sandbox, err := s.store.sandbox(id)
+	if err != nil {
+		return "", err
+	}
+	// pods in the network of the host listen on the node
+	if sandbox.IP == "" {
+		return "127.0.0.1", nil
+	}
+	return sandbox.IP, nil
+}
+
+// portForward forwards the data of each port of the request to the port of
+// the pod, the data and errors of the n-th port being carried by the
+// channels 2n and 2n+1. The first message of both channels is the port.
+func (s *Service) portForward(conn *wsConn, r *http.Request, req *api.PortForwardRequest) {
+	ports := req.Port
+	if values := r.URL.Query()["port"]; len(values) > 0 {
+		ports = nil
+		for _, v := range values {
+			port, err := strconv.ParseUint(v, 10, 16)
+			if err != nil || port == 0 {
+				return
+			}
+			ports = append(ports, int32(port))
+		}
+	}
+	// the channels of the ports are numbered on a byte
+	if len(ports) == 0 || len(ports) > 127 {
+		return
+	}
+	host, err := s.portForwardHost(req.PodSandboxID)
+	if err != nil {
+		return
+	}
+	conns := make([]net.Conn, len(ports))
+	defer func() {
+		for _, c := range conns {
+			if c != nil {
+				c.Close()
+			}
+		}
+	}()
+	for i, port := range ports {
+		var (
+			dataChannel = byte(2 * i)
+			errChannel  = byte(2*i + 1)
+			p           = make([]byte, 2)
+		)
+		binary.LittleEndian.PutUint16(p, uint16(port))
+		if conn.WriteMessage(dataChannel, p) != nil || conn.WriteMessage(errChannel, p) != nil {
+			return
+		}
+		c, err := net.DialTimeout("tcp", net.JoinHostPort(host, strconv.Itoa(int(port))), portForwardTimeout)
+		if err != nil {
+			conn.WriteMessage(errChannel, []byte(fmt.Sprintf("failed to connect to port %d of pod %s: %v", port, req.PodSandboxID, err)))
+			continue
+		}
+		conns[i] = c
+		go io.Copy(channelWriter{conn: conn, channel: dataChannel}, c)
+	}
+	for {
+		channel, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		i := int(channel / 2)
+		if channel%2 != 0 || i >= len(conns) || conns[i] == nil {
+			continue
+		}
+		if _, err := conns[i].Write(data); err != nil {
+			conn.WriteMessage(channel+1, []byte(fmt.Sprintf("failed to forward to port %d of pod %s: %v", ports[i], req.PodSandboxID, err)))
+		}
+	}
+}
+
+// portForwardSPDY forwards each connection of the client to the port of the
+// pod named by the port header of its streams, the client opening a data and
+// an error stream with the same requestid header for each connection
+func (s *Service) portForwardSPDY(conn *spdyConn, req *api.PortForwardRequest) {
+	host, err := s.portForwardHost(req.PodSandboxID)
+	if err != nil {
+		return
+	}
+	type pair struct {
+		data *spdyStream
+		errs *spdyStream
+	}
+	pairs := make(map[string]*pair)
+	for {
+		var st *spdyStream
+		select {
+		case st = <-conn.accepted:
+		case <-conn.done:
+			return
+		}
+		id := st.headers.Get("requestid")
+		p, ok := pairs[id]
+		if !ok {
+			if len(pairs) >= maxPortForwardStreams {
+				conn.reset(st.id, spdyStatusProtocolError)
+				continue
+			}
+			p = &pair{}
+			pairs[id] = p
+		}
+		switch typ := st.headers.Get(spdyStreamTypeHeader); {
+		case typ == "data" && p.data == nil:
+			p.data = st
+		case typ == "error" && p.errs == nil:
+			p.errs = st
+		default:
+			conn.reset(st.id, spdyStatusProtocolError)
+			continue
+		}
+		if p.data != nil && p.errs != nil {
+			delete(pairs, id)
+			go forwardPort(host, req.PodSandboxID, p.data, p.errs)
+		}
+	}
+}
+
+// forwardPort copies the data of the stream to the port of the pod and back
+// until either side ends, writing the errors to the error stream
+func forwardPort(host, id string, data, errs *spdyStream) {
+	defer errs.Close()
+	defer data.Close()
+	port, err := strconv.ParseUint(data.headers.Get("port"), 10, 16)
+	if err != nil || port == 0 {
+		fmt.Fprintf(errs, "invalid port %q", data.headers.Get("port"))
+		return
+	}
+	c, err := net.DialTimeout("tcp", net.JoinHostPort(host, strconv.FormatUint(port, 10)), portForwardTimeout)
+	if err != nil {
+		fmt.Fprintf(errs, "failed to connect to port %d of pod %s: %v", port, id, err)
+		return
+	}
+	defer c.Close()
+	done := make(chan struct{}, 2)
+	go func() {
+		io.Copy(c, data)
+		done <- struct{}{}
+	}()
+	go func() {
+		io.Copy(data, c)
+		done <- struct{}{}
+	}()
+	<-done
+}
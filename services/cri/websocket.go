@@ -0,0 +1,309 @@
+package cri
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// websocketGUID is appended to the key of the client to accept a
+	// websocket connection
+	websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+	// maxMessageSize bounds the messages read from clients
+	maxMessageSize = 1 << 20
+	// closeTimeout bounds the time the client is given to close a connection
+	closeTimeout = 5 * time.Second
+
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xa
+)
+
+// wsConn is the server side of a websocket connection carrying the streams
+// of the streaming API of the kubelet, each message being prefixed with the
+// channel of its stream
+type wsConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+	// protocol is the subprotocol agreed with the client, empty when the
+	// client offered none
+	protocol string
+	// base64 is set for the protocols sending the messages as base64 text,
+	// prefixed with the channel as a digit
+	base64 bool
+
+	// done is closed once the client closed the connection
+	done     chan struct{}
+	doneOnce sync.Once
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// upgrade accepts the websocket connection of the request with the first of
+// the protocols offered by the client that is supported
+func upgrade(w http.ResponseWriter, r *http.Request, protocols []string) (*wsConn, error) {
+	if r.Method != http.MethodGet || !headerContains(r.Header, "Connection", "upgrade") || !headerContains(r.Header, "Upgrade", "websocket") {
+		return nil, errors.New("not a websocket upgrade request")
+	}
+	if v := r.Header.Get("Sec-WebSocket-Version"); v != "13" {
+		return nil, errors.Errorf("unsupported websocket version %q", v)
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if key == "" {
+		return nil, errors.New("websocket key is missing")
+	}
+	protocol, ok := selectProtocol(r.Header, protocols)
+	if !ok {
+		return nil, errors.Errorf("none of the websocket protocols %q is supported", r.Header["Sec-Websocket-Protocol"])
+	}
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		return nil, errors.New("the connection cannot be upgraded")
+	}
+	conn, rw, err := hj.Hijack()
+	if err != nil {
+		return nil, err
+	}
+	h := sha1.New()
+	h.Write([]byte(key + websocketGUID))
+	resp := "HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + base64.StdEncoding.EncodeToString(h.Sum(nil)) + "\r\n"
+	if protocol != "" {
+		resp += "Sec-WebSocket-Protocol: " + protocol + "\r\n"
+	}
+	if _, err := conn.Write([]byte(resp + "\r\n")); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &wsConn{
+		conn:     conn,
+		r:        rw.Reader,
+		protocol: protocol,
+		base64:   strings.Contains(protocol, "base64."),
+		done:     make(chan struct{}),
+	}, nil
+}
+
+// headerContains returns whether the comma separated values of the header
+// contain the token, ignoring case
+func headerContains(h http.Header, name, token string) bool {
+	for _, v := range h[http.CanonicalHeaderKey(name)] {
+		for _, t := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// selectProtocol returns the first protocol offered by the client that is
+// supported, an empty protocol when the client offered none
+func selectProtocol(h http.Header, protocols []string) (string, bool) {
+	var offered []string
+	for _, v := range h[http.CanonicalHeaderKey("Sec-WebSocket-Protocol")] {
+		for _, p := range strings.Split(v, ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				offered = append(offered, p)
+			}
+		}
+	}
+	if len(offered) == 0 {
+		return "", true
+	}
+	for _, p := range offered {
+		for _, s := range protocols {
+			if p == s {
+				return p, true
+			}
+		}
+	}
+	return "", false
+}
+
+// ReadMessage returns the channel and data of the next message of the
+// client, answering its pings. It returns io.EOF once the client closed the
+// connection.
+func (c *wsConn) ReadMessage() (_ byte, _ []byte, err error) {
+	defer func() {
+		if err != nil {
+			c.doneOnce.Do(func() { close(c.done) })
+		}
+	}()
+	var message []byte
+	for {
+		fin, op, payload, err := c.readFrame()
+		if err != nil {
+			return 0, nil, err
+		}
+		switch op {
+		case opPing:
+			if err := c.writeFrame(opPong, payload); err != nil {
+				return 0, nil, err
+			}
+			continue
+		case opPong:
+			continue
+		case opClose:
+			return 0, nil, io.EOF
+		case opText, opBinary, opContinuation:
+		default:
+			return 0, nil, errors.Errorf("unknown websocket opcode %d", op)
+		}
+		if message = append(message, payload...); len(message) > maxMessageSize {
+			return 0, nil, errors.Errorf("websocket message larger than %d bytes", maxMessageSize)
+		}
+		if !fin {
+			continue
+		}
+		// messages without a channel carry nothing
+		if len(message) == 0 {
+			continue
+		}
+		channel, data := message[0], message[1:]
+		if c.base64 {
+			channel -= '0'
+			if data, err = base64.StdEncoding.DecodeString(string(data)); err != nil {
+				return 0, nil, errors.Wrap(err, "invalid base64 websocket message")
+			}
+		}
+		return channel, data, nil
+	}
+}
+
+func (c *wsConn) readFrame() (bool, byte, []byte, error) {
+	var h [2]byte
+	if _, err := io.ReadFull(c.r, h[:]); err != nil {
+		return false, 0, nil, err
+	}
+	var (
+		fin = h[0]&0x80 != 0
+		op  = h[0] & 0x0f
+		n   = uint64(h[1] & 0x7f)
+	)
+	// the frames of clients are always masked
+	if h[1]&0x80 == 0 {
+		return false, 0, nil, errors.New("unmasked websocket frame")
+	}
+	switch n {
+	case 126:
+		var b [2]byte
+		if _, err := io.ReadFull(c.r, b[:]); err != nil {
+			return false, 0, nil, err
+		}
+		n = uint64(binary.BigEndian.Uint16(b[:]))
+	case 127:
+		var b [8]byte
+		if _, err := io.ReadFull(c.r, b[:]); err != nil {
+			return false, 0, nil, err
+		}
+		n = binary.BigEndian.Uint64(b[:])
+	}
+	if n > maxMessageSize {
+		return false, 0, nil, errors.Errorf("websocket frame larger than %d bytes", maxMessageSize)
+	}
+	var mask [4]byte
+	if _, err := io.ReadFull(c.r, mask[:]); err != nil {
+		return false, 0, nil, err
+	}
+	payload := make([]byte, n)
+	if _, err := io.ReadFull(c.r, payload); err != nil {
+		return false, 0, nil, err
+	}
+	for i := range payload {
+		payload[i] ^= mask[i%4]
+	}
+	return fin, op, payload, nil
+}
+
+// WriteMessage writes the data as a message of the channel
+func (c *wsConn) WriteMessage(channel byte, data []byte) error {
+	if c.base64 {
+		message := make([]byte, 1+base64.StdEncoding.EncodedLen(len(data)))
+		message[0] = '0' + channel
+		base64.StdEncoding.Encode(message[1:], data)
+		return c.writeFrame(opText, message)
+	}
+	message := make([]byte, 1+len(data))
+	message[0] = channel
+	copy(message[1:], data)
+	return c.writeFrame(opBinary, message)
+}
+
+func (c *wsConn) writeFrame(op byte, payload []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return errors.New("websocket connection is closed")
+	}
+	frame := []byte{0x80 | op, 0}
+	switch n := len(payload); {
+	case n < 126:
+		frame[1] = byte(n)
+	case n <= 0xffff:
+		frame[1] = 126
+		frame = append(frame, byte(n>>8), byte(n))
+	default:
+		frame[1] = 127
+		var b [8]byte
+		binary.BigEndian.PutUint64(b[:], uint64(n))
+		frame = append(frame, b[:]...)
+	}
+	_, err := c.conn.Write(append(frame, payload...))
+	return err
+}
+
+// Close closes the connection with a normal closure once the client
+// answered it, or after the timeout, so that the client reads the end of the
+// streams
+func (c *wsConn) Close() error {
+	c.writeFrame(opClose, []byte{0x03, 0xe8})
+	waitClosed(c.done)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	return c.conn.Close()
+}
+
+// channelWriter writes the data written to it as messages of a channel
+type channelWriter struct {
+	conn    *wsConn
+	channel byte
+}
+
+func (w channelWriter) Write(p []byte) (int, error) {
+	if err := w.conn.WriteMessage(w.channel, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// waitClosed waits for the client to close the connection, until the
+// timeout
+func waitClosed(done <-chan struct{}) {
+	timer := time.NewTimer(closeTimeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+	case <-timer.C:
+	}
+}
@@ -0,0 +1,128 @@
+// +build linux
+
+package cri
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// dialWebsocket opens a websocket connection to the server with the protocol
+func dialWebsocket(t *testing.T, server *httptest.Server, protocol string) (net.Conn, *bufio.Reader) {
+	conn, err := net.Dial("tcp", server.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest("GET", server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+	req.Header.Set("Sec-WebSocket-Protocol", protocol)
+	if err := req.Write(conn); err != nil {
+		t.Fatal(err)
+	}
+	r := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(r, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("expected the connection to be upgraded, got %s", resp.Status)
+	}
+	if accept := resp.Header.Get("Sec-WebSocket-Accept"); accept != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" {
+		t.Fatalf("unexpected accept key %q", accept)
+	}
+	if p := resp.Header.Get("Sec-WebSocket-Protocol"); p != protocol {
+		t.Fatalf("expected protocol %q, got %q", protocol, p)
+	}
+	return conn, r
+}
+
+// writeClientFrame writes a masked frame as a client does
+func writeClientFrame(t *testing.T, w io.Writer, op byte, payload []byte) {
+	mask := [4]byte{1, 2, 3, 4}
+	frame := []byte{0x80 | op, 0x80 | byte(len(payload))}
+	frame = append(frame, mask[:]...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+	if _, err := w.Write(frame); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// readServerFrame reads an unmasked frame of the server
+func readServerFrame(t *testing.T, r io.Reader) (byte, []byte) {
+	var h [2]byte
+	if _, err := io.ReadFull(r, h[:]); err != nil {
+		t.Fatal(err)
+	}
+	payload := make([]byte, h[1]&0x7f)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		t.Fatal(err)
+	}
+	return h[0] & 0x0f, payload
+}
+
+func TestWebsocketChannels(t *testing.T) {
+	for _, protocol := range []string{"v4.channel.k8s.io", "v4.base64.channel.k8s.io"} {
+		messages := make(chan []byte, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			conn, err := upgrade(w, r, remoteCommandProtocols)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			defer conn.Close()
+			channel, data, err := conn.ReadMessage()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			messages <- append([]byte{channel}, data...)
+			conn.WriteMessage(stdoutChannel, []byte("output"))
+		}))
+		conn, r := dialWebsocket(t, server, protocol)
+
+		text := protocol == "v4.base64.channel.k8s.io"
+		writeClientFrame(t, conn, opPing, []byte("ping"))
+		if op, payload := readServerFrame(t, r); op != opPong || string(payload) != "ping" {
+			t.Fatalf("%s: expected the ping to be answered, got %d %q", protocol, op, payload)
+		}
+		if text {
+			writeClientFrame(t, conn, opText, []byte("0aW5wdXQ="))
+		} else {
+			writeClientFrame(t, conn, opBinary, []byte("\x00input"))
+		}
+		if m := <-messages; !bytes.Equal(m, []byte("\x00input")) {
+			t.Fatalf("%s: expected the input on the stdin channel, got %q", protocol, m)
+		}
+		op, payload := readServerFrame(t, r)
+		if text {
+			if op != opText || string(payload) != "1"+encodeBase64("output") {
+				t.Fatalf("%s: unexpected output frame %d %q", protocol, op, payload)
+			}
+		} else if op != opBinary || string(payload) != "\x01output" {
+			t.Fatalf("%s: unexpected output frame %d %q", protocol, op, payload)
+		}
+		if op, _ := readServerFrame(t, r); op != opClose {
+			t.Fatalf("%s: expected the connection to be closed, got %d", protocol, op)
+		}
+		conn.Close()
+		server.Close()
+	}
+}
+
+func encodeBase64(s string) string {
+	return base64.StdEncoding.EncodeToString([]byte(s))
+}
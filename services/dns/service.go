@@ -0,0 +1,275 @@
+package dns
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	api "github.com/containerd/containerd/api/services/dns/v1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/identifiers"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/plugin"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+const (
+	hostsFile      = "hosts"
+	resolvConfFile = "resolv.conf"
+	configFile     = "config.pb"
+)
+
+var _ api.DNSServer = &Service{}
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.GRPCPlugin,
+		ID:   "dns",
+		Init: func(ic *plugin.InitContext) (interface{}, error) {
+			if err := os.MkdirAll(ic.State, 0711); err != nil {
+				return nil, err
+			}
+			s := &Service{
+				root: ic.State,
+			}
+			go s.watch(ic.Context, ic.Events)
+			return s, nil
+		},
+	})
+}
+
+// Service writes the hosts and resolv.conf files of containers in its state
+// directory
+type Service struct {
+	root string
+	mu   sync.Mutex
+}
+
+func (s *Service) Register(server *grpc.Server) error {
+	api.RegisterDNSServer(server, s)
+	return nil
+}
+
+func (s *Service) Update(ctx context.Context, r *api.UpdateDNSRequest) (*api.UpdateDNSResponse, error) {
+	if err := Validate(&r.Config); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	dir, err := s.dir(ctx, r.ContainerID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	data, err := r.Config.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.MkdirAll(dir, 0711); err != nil {
+		return nil, err
+	}
+	for name, content := range map[string][]byte{
+		hostsFile:      Hosts(&r.Config),
+		resolvConfFile: ResolvConf(&r.Config),
+		configFile:     data,
+	} {
+		if err := rewrite(filepath.Join(dir, name), content); err != nil {
+			return nil, errors.Wrapf(err, "failed to write %s", name)
+		}
+	}
+	return &api.UpdateDNSResponse{
+		HostsPath:      filepath.Join(dir, hostsFile),
+		ResolvConfPath: filepath.Join(dir, resolvConfFile),
+	}, nil
+}
+
+func (s *Service) Get(ctx context.Context, r *api.GetDNSRequest) (*api.GetDNSResponse, error) {
+	dir, err := s.dir(ctx, r.ContainerID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	s.mu.Lock()
+	data, err := ioutil.ReadFile(filepath.Join(dir, configFile))
+	s.mu.Unlock()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errdefs.ToGRPCf(errdefs.ErrNotFound, "dns of container %s", r.ContainerID)
+		}
+		return nil, err
+	}
+	resp := &api.GetDNSResponse{
+		HostsPath:      filepath.Join(dir, hostsFile),
+		ResolvConfPath: filepath.Join(dir, resolvConfFile),
+	}
+	if err := resp.Config.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *Service) Delete(ctx context.Context, r *api.DeleteDNSRequest) (*empty.Empty, error) {
+	dir, err := s.dir(ctx, r.ContainerID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := os.Stat(dir); err != nil {
+		if os.IsNotExist(err) {
+			return nil, errdefs.ToGRPCf(errdefs.ErrNotFound, "dns of container %s", r.ContainerID)
+		}
+		return nil, err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// dir returns the directory holding the files of the container
+func (s *Service) dir(ctx context.Context, id string) (string, error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return "", err
+	}
+	if err := identifiers.Validate(id); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, namespace, id), nil
+}
+
+// watch removes the files of containers as they are deleted
+func (s *Service) watch(ctx context.Context, exchange *events.Exchange) {
+	eventq, errq := exchange.Subscribe(ctx, `topic=="/containers/delete"`)
+	for {
+		select {
+		case ev := <-eventq:
+			id, ok := ev.Field([]string{"event", "id"})
+			if !ok {
+				continue
+			}
+			s.mu.Lock()
+			if err := os.RemoveAll(filepath.Join(s.root, ev.Namespace, id)); err != nil {
+				log.G(ctx).WithError(err).WithField("id", id).Warn("failed to remove dns files")
+			}
+			s.mu.Unlock()
+		case err := <-errq:
+			if err != nil {
+				log.G(ctx).WithError(err).Error("dns container subscription")
+			}
+			return
+		}
+	}
+}
+
+// rewrite replaces the content of the file in place, as a bind mount of the
+// file keeps showing the old file when it is replaced by a rename. The new
+// content is written before the file is truncated to its length so that
+// readers never see an empty file.
+func rewrite(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteAt(data, 0); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Truncate(int64(len(data))); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Validate returns an error when the configuration cannot be written to the
+// files, such as addresses that are not IPs or names with whitespace
+func Validate(c *api.DNSConfig) error {
+	for _, h := range c.Hosts {
+		if !validIP(h.IP, false) {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "invalid host ip %q", h.IP)
+		}
+		if len(h.Hostnames) == 0 {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "host %s has no hostnames", h.IP)
+		}
+		if err := validateNames("hostname", h.Hostnames); err != nil {
+			return err
+		}
+	}
+	for _, n := range c.Nameservers {
+		if !validIP(n, true) {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "invalid nameserver %q", n)
+		}
+	}
+	if err := validateNames("search domain", c.Search); err != nil {
+		return err
+	}
+	return validateNames("option", c.Options)
+}
+
+// validIP returns true for an IPv4 or IPv6 address. IPv6 addresses may have
+// a zone when it is allowed, as for the link-local address of a nameserver.
+func validIP(s string, zone bool) bool {
+	if i := strings.IndexByte(s, '%'); i >= 0 {
+		if !zone || i == len(s)-1 {
+			return false
+		}
+		ip := net.ParseIP(s[:i])
+		return ip != nil && ip.To4() == nil && ip.IsLinkLocalUnicast()
+	}
+	return net.ParseIP(s) != nil
+}
+
+func validateNames(kind string, names []string) error {
+	for _, n := range names {
+		if n == "" || strings.ContainsAny(n, " \t\r\n#") {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "invalid %s %q", kind, n)
+		}
+	}
+	return nil
+}
+
+// Hosts returns the content of the hosts file of the configuration
+func Hosts(c *api.DNSConfig) []byte {
+	var b bytes.Buffer
+	b.WriteString("127.0.0.1\tlocalhost\n")
+	b.WriteString("::1\tlocalhost ip6-localhost ip6-loopback\n")
+	b.WriteString("fe00::0\tip6-localnet\n")
+	b.WriteString("ff00::0\tip6-mcastprefix\n")
+	b.WriteString("ff02::1\tip6-allnodes\n")
+	b.WriteString("ff02::2\tip6-allrouters\n")
+	for _, h := range c.Hosts {
+		// a host may have entries for both an IPv4 and an IPv6 address,
+		// written in their canonical form
+		fmt.Fprintf(&b, "%s\t%s\n", net.ParseIP(h.IP), strings.Join(h.Hostnames, " "))
+	}
+	return b.Bytes()
+}
+
+// ResolvConf returns the content of the resolv.conf file of the configuration
+func ResolvConf(c *api.DNSConfig) []byte {
+	var b bytes.Buffer
+	for _, n := range c.Nameservers {
+		fmt.Fprintf(&b, "nameserver %s\n", n)
+	}
+	if len(c.Search) > 0 {
+		fmt.Fprintf(&b, "search %s\n", strings.Join(c.Search, " "))
+	}
+	if len(c.Options) > 0 {
+		fmt.Fprintf(&b, "options %s\n", strings.Join(c.Options, " "))
+	}
+	return b.Bytes()
+}
@@ -0,0 +1,124 @@
+package dns
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	api "github.com/containerd/containerd/api/services/dns/v1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/namespaces"
+	"golang.org/x/net/context"
+)
+
+func TestUpdate(t *testing.T) {
+	root, err := ioutil.TempDir("", "dns-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	s := &Service{root: root}
+	ctx := namespaces.WithNamespace(context.Background(), "test")
+
+	resp, err := s.Update(ctx, &api.UpdateDNSRequest{
+		ContainerID: "c1",
+		Config: api.DNSConfig{
+			Hosts: []api.HostEntry{
+				{IP: "10.0.0.2", Hostnames: []string{"db", "db.local"}},
+			},
+			Nameservers: []string{"10.0.0.1"},
+			Search:      []string{"local"},
+			Options:     []string{"ndots:2"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.HostsPath != filepath.Join(root, "test", "c1", "hosts") {
+		t.Fatalf("unexpected hosts path %s", resp.HostsPath)
+	}
+	// keep the file open as a bind mount would, it must see the update
+	f, err := os.Open(resp.ResolvConfPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if _, err := s.Update(ctx, &api.UpdateDNSRequest{
+		ContainerID: "c1",
+		Config: api.DNSConfig{
+			Nameservers: []string{"10.0.0.3"},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "nameserver 10.0.0.3\n" {
+		t.Fatalf("unexpected resolv.conf %q", data)
+	}
+	hosts, err := ioutil.ReadFile(resp.HostsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "127.0.0.1\tlocalhost\n::1\tlocalhost ip6-localhost ip6-loopback\nfe00::0\tip6-localnet\nff00::0\tip6-mcastprefix\nff02::1\tip6-allnodes\nff02::2\tip6-allrouters\n"; string(hosts) != expected {
+		t.Fatalf("unexpected hosts %q", hosts)
+	}
+	get, err := s.Get(ctx, &api.GetDNSRequest{ContainerID: "c1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(get.Config.Nameservers) != 1 || get.Config.Nameservers[0] != "10.0.0.3" {
+		t.Fatalf("unexpected config %+v", get.Config)
+	}
+	if _, err := s.Delete(ctx, &api.DeleteDNSRequest{ContainerID: "c1"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Get(ctx, &api.GetDNSRequest{ContainerID: "c1"}); !errdefs.IsNotFound(errdefs.FromGRPC(err)) {
+		t.Fatalf("expected not found after delete, got %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, c := range []api.DNSConfig{
+		{Hosts: []api.HostEntry{{IP: "db", Hostnames: []string{"db"}}}},
+		{Hosts: []api.HostEntry{{IP: "10.0.0.2"}}},
+		{Hosts: []api.HostEntry{{IP: "10.0.0.2", Hostnames: []string{"db local"}}}},
+		{Hosts: []api.HostEntry{{IP: "fe80::1%eth0", Hostnames: []string{"db"}}}},
+		{Nameservers: []string{"dns.local"}},
+		{Nameservers: []string{"2001:db8::1%eth0"}},
+		{Nameservers: []string{"fe80::1%"}},
+		{Search: []string{""}},
+		{Options: []string{"ndots:2\nnameserver 1.2.3.4"}},
+	} {
+		if err := Validate(&c); !errdefs.IsInvalidArgument(err) {
+			t.Errorf("expected %+v to be invalid, got %v", c, err)
+		}
+	}
+}
+
+func TestDualStack(t *testing.T) {
+	c := &api.DNSConfig{
+		Hosts: []api.HostEntry{
+			{IP: "10.0.0.2", Hostnames: []string{"db"}},
+			{IP: "2001:DB8::0002", Hostnames: []string{"db"}},
+		},
+		Nameservers: []string{"2001:db8::53", "fe80::1%eth0"},
+	}
+	if err := Validate(c); err != nil {
+		t.Fatal(err)
+	}
+	hosts := string(Hosts(c))
+	for _, entry := range []string{"10.0.0.2\tdb\n", "2001:db8::2\tdb\n", "ff02::1\tip6-allnodes\n"} {
+		if !strings.Contains(hosts, entry) {
+			t.Errorf("expected hosts to contain %q, got %q", entry, hosts)
+		}
+	}
+	if resolv, expected := string(ResolvConf(c)), "nameserver 2001:db8::53\nnameserver fe80::1%eth0\n"; resolv != expected {
+		t.Errorf("expected resolv.conf %q, got %q", expected, resolv)
+	}
+}
@@ -0,0 +1,189 @@
+package events
+
+import (
+	"sync"
+	"time"
+
+	api "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/namespaces"
+	"golang.org/x/net/context"
+)
+
+// durable is a subscription that retains events until they are acknowledged
+// so that they can be redelivered to a subscriber that reconnects
+type durable struct {
+	key    durableKey
+	cancel func()
+
+	mu       sync.Mutex
+	pending  []*api.Envelope
+	next     uint64
+	notify   chan struct{}
+	attached int
+	expire   *time.Timer
+}
+
+// durableKey identifies a durable subscription, its name being scoped to the
+// namespace of its subscriber so that the names of namespaces do not collide
+type durableKey struct {
+	namespace string
+	name      string
+}
+
+func newDurableKey(ctx context.Context, name string) durableKey {
+	ns, _ := namespaces.Namespace(ctx)
+	return durableKey{
+		namespace: ns,
+		name:      name,
+	}
+}
+
+func (k durableKey) String() string {
+	if k.namespace == "" {
+		return k.name
+	}
+	return k.namespace + "/" + k.name
+}
+
+func newDurable(key durableKey, exchange *events.Exchange, filters []string, maxPending int) *durable {
+	ctx, cancel := context.WithCancel(context.Background())
+	d := &durable{
+		key:    key,
+		cancel: cancel,
+		next:   1,
+		notify: make(chan struct{}),
+	}
+	eventq, errq := exchange.Subscribe(ctx, filters...)
+	go func() {
+		for {
+			select {
+			case ev := <-eventq:
+				d.add(ev, maxPending)
+			case err := <-errq:
+				if err != nil {
+					log.G(ctx).WithError(err).WithField("durable", key).Error("durable subscription error")
+				}
+				return
+			}
+		}
+	}()
+	return d
+}
+
+// add assigns the next sequence number to the event and retains it until it is
+// acknowledged, dropping the oldest events once maxPending is reached
+func (d *durable) add(ev *api.Envelope, maxPending int) {
+	e := *ev
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	e.Sequence = d.next
+	d.next++
+	d.pending = append(d.pending, &e)
+	if maxPending > 0 && len(d.pending) > maxPending {
+		dropped := len(d.pending) - maxPending
+		log.L.WithField("durable", d.key).Warnf("dropping %d unacknowledged events", dropped)
+		d.pending = d.pending[dropped:]
+	}
+	close(d.notify)
+	d.notify = make(chan struct{})
+}
+
+// since returns the retained events with a sequence of at least seq and a
+// channel that is closed when more events are added
+func (d *durable) since(seq uint64) ([]*api.Envelope, <-chan struct{}) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var out []*api.Envelope
+	for _, e := range d.pending {
+		if e.Sequence >= seq {
+			out = append(out, e)
+		}
+	}
+	return out, d.notify
+}
+
+// ack releases the retained events up to and including seq
+func (d *durable) ack(seq uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	i := 0
+	for ; i < len(d.pending); i++ {
+		if d.pending[i].Sequence > seq {
+			break
+		}
+	}
+	d.pending = d.pending[i:]
+}
+
+func (d *durable) attach() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.attached++
+	if d.expire != nil {
+		d.expire.Stop()
+		d.expire = nil
+	}
+}
+
+// detach marks a subscriber as gone, calling fn once no subscriber has been
+// attached for the retention period
+func (d *durable) detach(retention time.Duration, fn func()) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.attached--
+	if d.attached == 0 && retention > 0 {
+		d.expire = time.AfterFunc(retention, fn)
+	}
+}
+
+// durable returns the durable subscription for the provided key, creating it
+// if it does not exist
+func (s *Service) durable(key durableKey, filters []string) *durable {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d, ok := s.durables[key]
+	if !ok {
+		d = newDurable(key, s.events, filters, s.config.MaxPending)
+		s.durables[key] = d
+	}
+	d.attach()
+	return d
+}
+
+func (s *Service) removeDurable(d *durable) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d.mu.Lock()
+	attached := d.attached
+	d.mu.Unlock()
+	if attached > 0 || s.durables[d.key] != d {
+		return
+	}
+	delete(s.durables, d.key)
+	d.cancel()
+}
+
+func (s *Service) subscribeDurable(ctx context.Context, req *api.SubscribeRequest, srv api.Events_SubscribeServer) error {
+	d := s.durable(newDurableKey(ctx, req.Durable), req.Filters)
+	defer d.detach(s.retention, func() {
+		s.removeDurable(d)
+	})
+
+	var seq uint64
+	for {
+		envelopes, notify := d.since(seq)
+		for _, e := range envelopes {
+			if err := srv.Send(e); err != nil {
+				return err
+			}
+			seq = e.Sequence + 1
+		}
+		select {
+		case <-notify:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
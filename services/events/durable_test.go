@@ -0,0 +1,94 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/namespaces"
+	"golang.org/x/net/context"
+)
+
+func TestDurableAck(t *testing.T) {
+	var (
+		ctx      = namespaces.WithNamespace(context.Background(), t.Name())
+		exchange = events.NewExchange()
+		s        = &Service{
+			events:   exchange,
+			config:   Config{MaxPending: 2},
+			durables: make(map[durableKey]*durable),
+		}
+		d = s.durable(newDurableKey(ctx, "test"), nil)
+	)
+	defer d.cancel()
+
+	for _, id := range []string{"asdf", "qwer", "zxcv"} {
+		if err := exchange.Publish(ctx, "/test", &api.ContainerCreate{ID: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pending := waitPending(t, d, 2)
+	// the oldest event is dropped once max pending is reached
+	if pending[0].Sequence != 2 || pending[1].Sequence != 3 {
+		t.Fatalf("expected sequences 2 and 3 to be retained, got %d and %d", pending[0].Sequence, pending[1].Sequence)
+	}
+
+	if _, err := s.Ack(ctx, &api.AckRequest{Durable: "test", Sequence: 2}); err != nil {
+		t.Fatal(err)
+	}
+	pending, _ = d.since(0)
+	if len(pending) != 1 || pending[0].Sequence != 3 {
+		t.Fatalf("expected only sequence 3 to be retained after ack, got %v", pending)
+	}
+
+	if _, err := s.Ack(ctx, &api.AckRequest{Durable: "unknown"}); err == nil {
+		t.Fatal("expected ack of unknown durable subscription to fail")
+	}
+}
+
+func TestDurableNamespaces(t *testing.T) {
+	s, err := NewServiceWithConfig(events.NewExchange(), &Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := s.(*Service)
+	if svc.retention != defaultRetention {
+		t.Fatalf("expected the default retention, got %s", svc.retention)
+	}
+	var (
+		ctx1 = namespaces.WithNamespace(context.Background(), "one")
+		ctx2 = namespaces.WithNamespace(context.Background(), "two")
+		d1   = svc.durable(newDurableKey(ctx1, "test"), nil)
+		d2   = svc.durable(newDurableKey(ctx2, "test"), nil)
+	)
+	defer d1.cancel()
+	defer d2.cancel()
+	if d1 == d2 {
+		t.Fatal("expected durable subscriptions of the same name in different namespaces to be distinct")
+	}
+	if _, err := svc.Ack(ctx1, &api.AckRequest{Durable: "test"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.Ack(namespaces.WithNamespace(context.Background(), "three"), &api.AckRequest{Durable: "test"}); err == nil {
+		t.Fatal("expected ack of a durable subscription of another namespace to fail")
+	}
+	if _, err := NewServiceWithConfig(events.NewExchange(), &Config{Retention: "0s"}); err == nil {
+		t.Fatal("expected a zero retention to be rejected")
+	}
+}
+
+func waitPending(t *testing.T, d *durable, n int) []*api.Envelope {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		pending, notify := d.since(0)
+		if len(pending) >= n && pending[len(pending)-1].Sequence > uint64(n) {
+			return pending
+		}
+		select {
+		case <-notify:
+		case <-time.After(time.Until(deadline)):
+			t.Fatal("timeout waiting for events")
+		}
+	}
+}
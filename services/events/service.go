@@ -1,6 +1,9 @@
 package events
 
 import (
+	"sync"
+	"time"
+
 	api "github.com/containerd/containerd/api/services/events/v1"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/events"
@@ -15,18 +18,81 @@ func init() {
 	plugin.Register(&plugin.Registration{
 		Type: plugin.GRPCPlugin,
 		ID:   "events",
+		Config: &Config{
+			MaxPending: 1024,
+			Retention:  "5m",
+			Buffer:     1024,
+			Overflow:   string(events.DropPolicy),
+		},
 		Init: func(ic *plugin.InitContext) (interface{}, error) {
-			return NewService(ic.Events), nil
+			return NewServiceWithConfig(ic.Events, ic.Config.(*Config))
 		},
 	})
 }
 
+// Config for the events service
+type Config struct {
+	// MaxPending is the number of unacknowledged events retained for each
+	// durable subscription, older events are dropped once it is reached
+	MaxPending int `toml:"max_pending,omitempty"`
+	// Retention is how long a durable subscription is kept once its last
+	// subscriber disconnects, for example "5m", the default
+	Retention string `toml:"retention,omitempty"`
+	// Buffer is the number of events held for each subscriber that is not
+	// durable, unbounded when 0
+	Buffer int `toml:"buffer,omitempty"`
+	// Overflow is what is done with the events published while the buffer
+	// of a subscriber is full, "drop" or "disconnect"
+	Overflow string `toml:"overflow,omitempty"`
+}
+
 type Service struct {
 	events *events.Exchange
+
+	config    Config
+	retention time.Duration
+	mu        sync.Mutex
+	durables  map[durableKey]*durable
 }
 
+// defaultRetention is how long durable subscriptions are kept without
+// subscribers when the configuration sets no retention, so that the
+// subscriptions of clients that are gone are eventually removed
+const defaultRetention = 5 * time.Minute
+
 func NewService(events *events.Exchange) api.EventsServer {
-	return &Service{events: events}
+	return &Service{
+		events:    events,
+		retention: defaultRetention,
+		durables:  make(map[durableKey]*durable),
+	}
+}
+
+// NewServiceWithConfig returns an events service with durable subscriptions
+// configured from the provided config
+func NewServiceWithConfig(exchange *events.Exchange, config *Config) (api.EventsServer, error) {
+	retention := defaultRetention
+	if config.Retention != "" {
+		d, err := time.ParseDuration(config.Retention)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid retention %q", config.Retention)
+		}
+		if d <= 0 {
+			return nil, errors.Errorf("retention %q must be positive", config.Retention)
+		}
+		retention = d
+	}
+	if config.Buffer > 0 {
+		if err := events.OverflowPolicy(config.Overflow).Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return &Service{
+		events:    exchange,
+		config:    *config,
+		retention: retention,
+		durables:  make(map[durableKey]*durable),
+	}, nil
 }
 
 func (s *Service) Register(server *grpc.Server) error {
@@ -54,7 +120,22 @@ func (s *Service) Subscribe(req *api.SubscribeRequest, srv api.Events_SubscribeS
 	ctx, cancel := context.WithCancel(srv.Context())
 	defer cancel()
 
-	eventq, errq := s.events.Subscribe(ctx, req.Filters...)
+	if req.Durable != "" {
+		if err := s.subscribeDurable(ctx, req, srv); err != nil {
+			return errors.Wrapf(err, "failed sending event to subscriber")
+		}
+		return nil
+	}
+
+	var (
+		eventq <-chan *api.Envelope
+		errq   <-chan error
+	)
+	if s.config.Buffer > 0 {
+		eventq, errq = s.events.SubscribeBuffered(ctx, s.config.Buffer, events.OverflowPolicy(s.config.Overflow), req.Filters...)
+	} else {
+		eventq, errq = s.events.Subscribe(ctx, req.Filters...)
+	}
 	for {
 		select {
 		case ev := <-eventq:
@@ -63,10 +144,21 @@ func (s *Service) Subscribe(req *api.SubscribeRequest, srv api.Events_SubscribeS
 			}
 		case err := <-errq:
 			if err != nil {
-				return errors.Wrapf(err, "subscription error")
+				return errdefs.ToGRPC(errors.Wrapf(err, "subscription error"))
 			}
 
 			return nil
 		}
 	}
 }
+
+func (s *Service) Ack(ctx context.Context, r *api.AckRequest) (*empty.Empty, error) {
+	s.mu.Lock()
+	d, ok := s.durables[newDurableKey(ctx, r.Durable)]
+	s.mu.Unlock()
+	if !ok {
+		return nil, errdefs.ToGRPCf(errdefs.ErrNotFound, "durable subscription %q", r.Durable)
+	}
+	d.ack(r.Sequence)
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,100 @@
+// +build !windows
+
+package fifos
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	api "github.com/containerd/containerd/api/services/fifos/v1"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/oci"
+	"github.com/containerd/containerd/typeurl"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+var names = [3]string{"stdin", "stdout", "stderr"}
+
+// createSet creates the fifos of a set in dir, owned with their directory by
+// uid and gid. The set is created in a hidden directory renamed to dir once
+// complete so that no half created set is ever listed.
+func createSet(dir string, stdin bool, uid, gid uint32) (_ *api.FifoSet, err error) {
+	tmp, err := ioutil.TempDir(filepath.Dir(dir), "."+filepath.Base(dir)+"-")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			os.RemoveAll(tmp)
+		}
+	}()
+	for i, name := range names {
+		if i == 0 && !stdin {
+			continue
+		}
+		path := filepath.Join(tmp, name)
+		if err := unix.Mkfifo(path, 0600); err != nil {
+			return nil, errors.Wrapf(err, "create %s fifo", name)
+		}
+		if err := os.Lchown(path, int(uid), int(gid)); err != nil {
+			return nil, err
+		}
+	}
+	if err := os.Lchown(tmp, int(uid), int(gid)); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, dir); err != nil {
+		return nil, err
+	}
+	return readSet(dir)
+}
+
+// readSet returns the paths of the fifos of the set in dir and their owner
+func readSet(dir string) (*api.FifoSet, error) {
+	fi, err := os.Lstat(dir)
+	if err != nil {
+		return nil, err
+	}
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return nil, errors.Errorf("no owner for %s", dir)
+	}
+	var paths [3]string
+	for i, name := range names {
+		path := filepath.Join(dir, name)
+		if _, err := os.Lstat(path); err != nil {
+			if i == 0 && os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		paths[i] = path
+	}
+	return &api.FifoSet{
+		Stdin:  paths[0],
+		Stdout: paths[1],
+		Stderr: paths[2],
+		UID:    st.Uid,
+		GID:    st.Gid,
+	}, nil
+}
+
+// rootIDs returns the host uid and gid of the root of the user namespace of
+// the container, which owns the fifos of containers with a user namespace.
+// The ids are zero for the containers without one.
+func rootIDs(container *containers.Container) (uint32, uint32, error) {
+	v, err := typeurl.UnmarshalAny(container.Spec)
+	if err != nil {
+		return 0, 0, err
+	}
+	s, ok := v.(*specs.Spec)
+	if !ok {
+		return 0, 0, nil
+	}
+	uid, gid, _, err := oci.RootIDs(s)
+	return uid, gid, err
+}
@@ -0,0 +1,298 @@
+// +build !windows
+
+package fifos
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/boltdb/bolt"
+	api "github.com/containerd/containerd/api/services/fifos/v1"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/identifiers"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/plugin"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+var _ api.FifosServer = &Service{}
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.GRPCPlugin,
+		ID:   "fifos",
+		Requires: []plugin.PluginType{
+			plugin.MetadataPlugin,
+		},
+		Init: func(ic *plugin.InitContext) (interface{}, error) {
+			m, err := ic.Get(plugin.MetadataPlugin)
+			if err != nil {
+				return nil, err
+			}
+			if err := os.MkdirAll(ic.State, 0711); err != nil {
+				return nil, err
+			}
+			s := New(ic.State, m.(*bolt.DB))
+			if err := s.prune(ic.Context); err != nil {
+				return nil, err
+			}
+			go s.watch(ic.Context, ic.Events)
+			return s, nil
+		},
+	})
+}
+
+// New returns a fifos service creating the fifos of the containers of the
+// database under root
+func New(root string, db *bolt.DB) *Service {
+	return &Service{
+		root: root,
+		db:   db,
+	}
+}
+
+// Service allocates the fifos of the stdio of processes under its root, in
+// a directory per namespace and container
+type Service struct {
+	root string
+	db   *bolt.DB
+
+	// mu orders the creation of sets with the removal of the sets of
+	// deleted containers
+	mu sync.Mutex
+}
+
+func (s *Service) Register(server *grpc.Server) error {
+	api.RegisterFifosServer(server, s)
+	return nil
+}
+
+func (s *Service) Create(ctx context.Context, r *api.CreateFifosRequest) (*api.CreateFifosResponse, error) {
+	id := r.ID
+	if id == "" {
+		id = r.ContainerID
+	}
+	dir, err := s.dir(ctx, r.ContainerID, id)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	container, err := s.getContainer(ctx, r.ContainerID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	uid, gid := r.UID, r.GID
+	if uid == 0 && gid == 0 {
+		if uid, gid, err = rootIDs(container); err != nil {
+			return nil, errdefs.ToGRPC(err)
+		}
+	}
+	if _, err := os.Lstat(dir); err == nil {
+		return nil, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrAlreadyExists, "fifos %s of container %s", id, r.ContainerID))
+	}
+	// the directory of the container is traversed by the owners of its sets
+	if err := os.MkdirAll(filepath.Dir(dir), 0711); err != nil {
+		return nil, err
+	}
+	set, err := createSet(dir, r.Stdin, uid, gid)
+	if err != nil {
+		return nil, err
+	}
+	set.ContainerID = r.ContainerID
+	set.ID = id
+	return &api.CreateFifosResponse{
+		Fifos: set,
+	}, nil
+}
+
+func (s *Service) List(ctx context.Context, r *api.ListFifosRequest) (*api.ListFifosResponse, error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	containerIDs := []string{r.ContainerID}
+	if r.ContainerID == "" {
+		if containerIDs, err = readDirNames(filepath.Join(s.root, namespace)); err != nil {
+			return nil, err
+		}
+	} else if err := identifiers.Validate(r.ContainerID); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	var resp api.ListFifosResponse
+	for _, containerID := range containerIDs {
+		root := filepath.Join(s.root, namespace, containerID)
+		ids, err := readDirNames(root)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			set, err := readSet(filepath.Join(root, id))
+			if err != nil {
+				if os.IsNotExist(err) {
+					continue
+				}
+				return nil, err
+			}
+			set.ContainerID = containerID
+			set.ID = id
+			resp.Fifos = append(resp.Fifos, *set)
+		}
+	}
+	return &resp, nil
+}
+
+func (s *Service) Delete(ctx context.Context, r *api.DeleteFifosRequest) (*empty.Empty, error) {
+	dir, err := s.dir(ctx, r.ContainerID, r.ID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := os.Lstat(dir); err != nil {
+		if os.IsNotExist(err) {
+			return nil, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrNotFound, "fifos %s of container %s", r.ID, r.ContainerID))
+		}
+		return nil, err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// dir returns the directory of the set of fifos of the container in the
+// namespace of the context
+func (s *Service) dir(ctx context.Context, containerID, id string) (string, error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return "", err
+	}
+	if err := identifiers.Validate(containerID); err != nil {
+		return "", errors.Wrap(err, "container id")
+	}
+	if err := identifiers.Validate(id); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, namespace, containerID, id), nil
+}
+
+func (s *Service) getContainer(ctx context.Context, id string) (*containers.Container, error) {
+	var container containers.Container
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		container, err = metadata.NewContainerStore(tx).Get(ctx, id)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return &container, nil
+}
+
+// prune removes the fifos of the containers deleted while the daemon was
+// not running, along with the sets left half created
+func (s *Service) prune(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	nss, err := readDirNames(s.root)
+	if err != nil {
+		return err
+	}
+	for _, namespace := range nss {
+		nctx := namespaces.WithNamespace(ctx, namespace)
+		ids, err := readDirNames(filepath.Join(s.root, namespace))
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			dir := filepath.Join(s.root, namespace, id)
+			if _, err := s.getContainer(nctx, id); err != nil {
+				if !errdefs.IsNotFound(err) {
+					return err
+				}
+				log.G(ctx).WithField("id", id).WithField("namespace", namespace).Info("removing fifos of deleted container")
+				if err := os.RemoveAll(dir); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := removeTemp(dir); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// watch removes the fifos of containers as they are deleted
+func (s *Service) watch(ctx context.Context, exchange *events.Exchange) {
+	eventq, errq := exchange.Subscribe(ctx, `topic=="/containers/delete"`)
+	for {
+		select {
+		case ev := <-eventq:
+			id, ok := ev.Field([]string{"event", "id"})
+			if !ok || identifiers.Validate(id) != nil {
+				continue
+			}
+			s.mu.Lock()
+			err := os.RemoveAll(filepath.Join(s.root, ev.Namespace, id))
+			s.mu.Unlock()
+			if err != nil {
+				log.G(ctx).WithError(err).WithField("id", id).Warn("failed to remove container fifos")
+			}
+		case err := <-errq:
+			if err != nil {
+				log.G(ctx).WithError(err).Error("fifos container subscription")
+			}
+			return
+		}
+	}
+}
+
+// readDirNames returns the sorted names of the directory, without the
+// hidden ones, and no names when it does not exist
+func readDirNames(dir string) ([]string, error) {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var names []string
+	for _, fi := range fis {
+		if fi.IsDir() && !strings.HasPrefix(fi.Name(), ".") {
+			names = append(names, fi.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// removeTemp removes the hidden directories of the sets that were being
+// created in the directory of a container
+func removeTemp(dir string) error {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, fi := range fis {
+		if strings.HasPrefix(fi.Name(), ".") {
+			if err := os.RemoveAll(filepath.Join(dir, fi.Name())); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+// +build !windows
+
+package fifos
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	api "github.com/containerd/containerd/api/services/fifos/v1"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/typeurl"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/net/context"
+)
+
+func init() {
+	typeurl.Register(&specs.Spec{}, "opencontainers/runtime-spec", "v1", "Spec")
+}
+
+func TestFifos(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("the fifos are owned by the root of the user namespace")
+	}
+	dir, err := ioutil.TempDir("", "fifos-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	db, err := bolt.Open(filepath.Join(dir, "metadata.db"), 0660, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	ctx := namespaces.WithNamespace(context.Background(), "testing")
+	createContainer(ctx, t, db, "redis", nil)
+	createContainer(ctx, t, db, "userns", &specs.Linux{
+		Namespaces:  []specs.LinuxNamespace{{Type: specs.UserNamespace}},
+		UIDMappings: []specs.LinuxIDMapping{{ContainerID: 0, HostID: 100000, Size: 65536}},
+		GIDMappings: []specs.LinuxIDMapping{{ContainerID: 0, HostID: 200000, Size: 65536}},
+	})
+
+	root := filepath.Join(dir, "state")
+	s := New(root, db)
+	resp, err := s.Create(ctx, &api.CreateFifosRequest{ContainerID: "redis", Stdin: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{resp.Fifos.Stdin, resp.Fifos.Stdout, resp.Fifos.Stderr} {
+		fi, err := os.Lstat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fi.Mode()&os.ModeNamedPipe == 0 {
+			t.Fatalf("%s is not a fifo", path)
+		}
+	}
+	if _, err := s.Create(ctx, &api.CreateFifosRequest{ContainerID: "redis"}); !errdefs.IsAlreadyExists(errdefs.FromGRPC(err)) {
+		t.Fatalf("expected an already exists error but got %v", err)
+	}
+	if _, err := s.Create(ctx, &api.CreateFifosRequest{ContainerID: "missing"}); !errdefs.IsNotFound(errdefs.FromGRPC(err)) {
+		t.Fatalf("expected a not found error but got %v", err)
+	}
+	resp, err = s.Create(ctx, &api.CreateFifosRequest{ContainerID: "userns", ID: "exec"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Fifos.Stdin != "" {
+		t.Fatalf("expected no stdin fifo but got %s", resp.Fifos.Stdin)
+	}
+	if resp.Fifos.UID != 100000 || resp.Fifos.GID != 200000 {
+		t.Fatalf("expected the fifos to be owned by 100000:200000 but got %d:%d", resp.Fifos.UID, resp.Fifos.GID)
+	}
+
+	list, err := s.List(ctx, &api.ListFifosRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Fifos) != 2 || list.Fifos[0].ContainerID != "redis" || list.Fifos[1].ID != "exec" {
+		t.Fatalf("unexpected fifos %v", list.Fifos)
+	}
+
+	// the fifos of containers deleted while the daemon is not running and
+	// the sets left half created are removed on startup
+	if err := ioutil.WriteFile(filepath.Join(root, "testing", "userns", ".exec-1"), nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		return metadata.NewContainerStore(tx).Delete(ctx, "redis")
+	}); err != nil {
+		t.Fatal(err)
+	}
+	s = New(root, db)
+	if err := s.prune(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"redis", "userns/.exec-1"} {
+		if _, err := os.Lstat(filepath.Join(root, "testing", path)); !os.IsNotExist(err) {
+			t.Fatalf("expected %s to be removed but got %v", path, err)
+		}
+	}
+
+	if _, err := s.Delete(ctx, &api.DeleteFifosRequest{ContainerID: "userns", ID: "exec"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Delete(ctx, &api.DeleteFifosRequest{ContainerID: "userns", ID: "exec"}); !errdefs.IsNotFound(errdefs.FromGRPC(err)) {
+		t.Fatalf("expected a not found error but got %v", err)
+	}
+}
+
+func createContainer(ctx context.Context, t *testing.T, db *bolt.DB, id string, linux *specs.Linux) {
+	spec, err := typeurl.MarshalAny(&specs.Spec{Linux: linux})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := metadata.NewContainerStore(tx).Create(ctx, containers.Container{
+			ID:      id,
+			Runtime: containers.RuntimeInfo{Name: "testing"},
+			Spec:    spec,
+		})
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -30,3 +30,13 @@ func (s *Service) Register(server *grpc.Server) error {
 	grpc_health_v1.RegisterHealthServer(server, s.serve)
 	return nil
 }
+
+// SetServing sets the status reported for the daemon, which is serving
+// until it shuts down
+func (s *Service) SetServing(serving bool) {
+	status := grpc_health_v1.HealthCheckResponse_SERVING
+	if !serving {
+		status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	s.serve.SetServingStatus("", status)
+}
@@ -0,0 +1,35 @@
+package images
+
+import (
+	"context"
+	"net"
+	"time"
+
+	credentialsapi "github.com/containerd/containerd/api/services/credentials/v1"
+	"github.com/containerd/containerd/distribution"
+	"github.com/containerd/containerd/errdefs"
+	"google.golang.org/grpc"
+)
+
+// credentialHelper returns a helper that gets registry credentials from the
+// credentials service listening on the unix socket at address, and the
+// connection to the service
+func credentialHelper(address string) (distribution.CredentialHelper, *grpc.ClientConn, error) {
+	conn, err := grpc.Dial(address,
+		grpc.WithInsecure(),
+		grpc.WithDialer(func(address string, timeout time.Duration) (net.Conn, error) {
+			return net.DialTimeout("unix", address, timeout)
+		}),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	client := credentialsapi.NewCredentialsClient(conn)
+	return func(ctx context.Context, host string) (string, string, error) {
+		resp, err := client.Get(ctx, &credentialsapi.GetCredentialsRequest{Host: host})
+		if err != nil {
+			return "", "", errdefs.FromGRPC(err)
+		}
+		return resp.Username, resp.Secret, nil
+	}, conn, nil
+}
@@ -0,0 +1,86 @@
+package images
+
+import (
+	imagesapi "github.com/containerd/containerd/api/services/images/v1"
+	"github.com/containerd/containerd/distribution"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/reference"
+	"github.com/containerd/containerd/streaming"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) Export(req *imagesapi.ExportImageRequest, ss imagesapi.Images_ExportServer) error {
+	ctx := ss.Context()
+	if req.Name == "" {
+		return status.Errorf(codes.InvalidArgument, "Name required")
+	}
+	if s.content == nil {
+		return status.Errorf(codes.Unimplemented, "export requires a content store")
+	}
+	image, err := s.Get(ctx, &imagesapi.GetImageRequest{Name: req.Name})
+	if err != nil {
+		return err
+	}
+	desc := imageFromProto(image.Image).Target
+	// annotate the index entry with the tag or digest of the image so that
+	// it can be found again on import
+	if refspec, err := reference.Parse(req.Name); err == nil && refspec.Object != "" {
+		annotations := map[string]string{}
+		for k, v := range desc.Annotations {
+			annotations[k] = v
+		}
+		annotations[ocispec.AnnotationRefName] = refspec.Object
+		desc.Annotations = annotations
+	}
+	w := streaming.NewWriter(func(p []byte) error {
+		return ss.Send(&imagesapi.ExportImageResponse{Data: p})
+	})
+	if err := distribution.ExportOCI(ctx, s.content, desc, w); err != nil {
+		return errdefs.ToGRPC(err)
+	}
+	return w.Flush()
+}
+
+func (s *Service) Import(ss imagesapi.Images_ImportServer) error {
+	ctx := ss.Context()
+	if s.content == nil {
+		return status.Errorf(codes.Unimplemented, "import requires a content store")
+	}
+	req, err := ss.Recv()
+	if err != nil {
+		return err
+	}
+	if req.Name == "" {
+		return status.Errorf(codes.InvalidArgument, "Name required")
+	}
+	refObject := req.RefObject
+	if refObject == "" {
+		refspec, err := reference.Parse(req.Name)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid name %q: %v", req.Name, err)
+		}
+		refObject = refspec.Object
+	}
+	r := streaming.NewReader(req.Data, func() ([]byte, error) {
+		req, err := ss.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return req.Data, nil
+	})
+	desc, err := distribution.ImportOCI(ctx, s.content, r, refObject)
+	if err != nil {
+		return errdefs.ToGRPC(err)
+	}
+	imagepb, err := s.record(ctx, images.Image{
+		Name:   req.Name,
+		Target: desc,
+	})
+	if err != nil {
+		return err
+	}
+	return ss.SendAndClose(&imagesapi.ImportImageResponse{Image: imagepb})
+}
@@ -0,0 +1,248 @@
+package images
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/boltdb/bolt"
+	imagesapi "github.com/containerd/containerd/api/services/images/v1"
+	"github.com/containerd/containerd/checkpoints"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/snapshot"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/identity"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// describe sets the size and platforms of the image from the content store,
+// leaving them unset when the content of the image cannot be read
+func (s *Service) describe(ctx context.Context, imagepb *imagesapi.Image) {
+	if s.content == nil {
+		return
+	}
+	image := imageFromProto(imagepb)
+	size, err := image.Size(ctx, s.content)
+	if err != nil {
+		log.G(ctx).WithError(err).WithField("image", image.Name).Debug("failed to compute image size")
+	} else {
+		imagepb.Size_ = size
+	}
+	platforms, err := images.Platforms(ctx, s.content, image.Target)
+	if err != nil {
+		log.G(ctx).WithError(err).WithField("image", image.Name).Debug("failed to read image platforms")
+	} else {
+		imagepb.Platforms = platforms
+	}
+}
+
+// gc removes the content of a deleted image that none of the remaining
+// images and checkpoints of the namespace reference, then the snapshots its
+// layers were unpacked into, from the top layer down, until one is still
+// used by another image or is the parent of another snapshot, such as the
+// root filesystem of a container. The GC lock is held throughout so that no
+// image or checkpoint referencing the content is created before it is
+// removed.
+func (s *Service) gc(ctx context.Context, image images.Image) error {
+	metadata.GCLock.Lock()
+	defer metadata.GCLock.Unlock()
+
+	var (
+		remaining    []images.Image
+		checkpointed []checkpoints.Checkpoint
+	)
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		if remaining, err = metadata.NewImageStore(tx).List(ctx); err != nil {
+			return err
+		}
+		checkpointed, err = metadata.NewCheckpointStore(tx).List(ctx)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// the images of checkpointed containers are restored from the manifests
+	// referenced by the checkpoint index
+	roots := make([]ocispec.Descriptor, 0, len(remaining))
+	for _, i := range remaining {
+		roots = append(roots, i.Target)
+	}
+	for _, c := range checkpointed {
+		manifests, err := s.checkpointImages(ctx, c.Target)
+		if err != nil {
+			return err
+		}
+		roots = append(roots, manifests...)
+	}
+
+	var (
+		referenced = make(map[digest.Digest]struct{})
+		chains     = make(map[digest.Digest]struct{})
+		// snapshots are only removed when the layers of every remaining
+		// image are known
+		pruneSnapshots = true
+	)
+	for _, root := range roots {
+		if err := s.walk(ctx, root, func(desc ocispec.Descriptor) {
+			referenced[desc.Digest] = struct{}{}
+		}); err != nil {
+			return err
+		}
+		rootChains, err := s.chains(ctx, root)
+		if err != nil {
+			log.G(ctx).WithError(err).Warnf("failed to resolve the layers of %s, keeping snapshots", root.Digest)
+			pruneSnapshots = false
+			continue
+		}
+		for _, chain := range rootChains {
+			for _, chainID := range chain {
+				chains[chainID] = struct{}{}
+			}
+		}
+	}
+	if _, ok := referenced[image.Target.Digest]; ok {
+		return nil
+	}
+
+	// the layers must be resolved before the manifest and config go
+	imageChains, err := s.chains(ctx, image.Target)
+	if err != nil {
+		log.G(ctx).WithError(err).WithField("image", image.Name).Warn("failed to resolve image layers, keeping snapshots")
+		pruneSnapshots = false
+	}
+
+	var blobs []digest.Digest
+	if err := s.walk(ctx, image.Target, func(desc ocispec.Descriptor) {
+		if _, ok := referenced[desc.Digest]; !ok {
+			referenced[desc.Digest] = struct{}{}
+			blobs = append(blobs, desc.Digest)
+		}
+	}); err != nil {
+		return err
+	}
+	for _, dgst := range blobs {
+		if err := s.content.Delete(ctx, dgst); err != nil && !errdefs.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to delete image blob %s", dgst)
+		}
+		log.G(ctx).WithField("image", image.Name).Debugf("deleted image blob %s", dgst)
+	}
+
+	if !pruneSnapshots {
+		return nil
+	}
+	for name, sn := range s.snapshotters {
+		for _, chain := range imageChains {
+			if err := removeChain(ctx, sn, chain, chains); err != nil {
+				log.G(ctx).WithError(err).WithField("image", image.Name).Warnf("failed to remove image snapshots from %s", name)
+			}
+		}
+	}
+	return nil
+}
+
+// removeChain removes the snapshots of the chain from the top layer down,
+// stopping at the first one referenced or with children
+func removeChain(ctx context.Context, sn snapshot.Snapshotter, chainIDs []digest.Digest, referenced map[digest.Digest]struct{}) error {
+	parents := make(map[string]int)
+	if err := sn.Walk(ctx, func(ctx context.Context, info snapshot.Info) error {
+		if info.Parent != "" {
+			parents[info.Parent]++
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for i := len(chainIDs) - 1; i >= 0; i-- {
+		key := chainIDs[i].String()
+		if _, ok := referenced[chainIDs[i]]; ok || parents[key] > 0 {
+			return nil
+		}
+		if err := sn.Remove(ctx, key); err != nil {
+			if errdefs.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		log.G(ctx).Debugf("removed image snapshot %s", key)
+		if i > 0 {
+			parents[chainIDs[i-1].String()]--
+		}
+	}
+	return nil
+}
+
+// walk calls fn with the target and every manifest, config and layer it
+// references, skipping the children of manifests missing from the content
+// store
+func (s *Service) walk(ctx context.Context, target ocispec.Descriptor, fn func(ocispec.Descriptor)) error {
+	children := images.ChildrenHandler(s.content)
+	return images.Walk(ctx, images.HandlerFunc(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		fn(desc)
+		descs, err := children(ctx, desc)
+		if err != nil && errdefs.IsNotFound(err) {
+			return nil, nil
+		}
+		return descs, err
+	}), target)
+}
+
+// chains returns the chain ids of the layers of each manifest of the image
+// in the content store, which are the keys of the snapshots they are
+// unpacked into
+func (s *Service) chains(ctx context.Context, target ocispec.Descriptor) ([][]digest.Digest, error) {
+	var manifests []ocispec.Descriptor
+	if err := s.walk(ctx, target, func(desc ocispec.Descriptor) {
+		switch desc.MediaType {
+		case images.MediaTypeDockerSchema2Manifest, ocispec.MediaTypeImageManifest:
+			manifests = append(manifests, desc)
+		}
+	}); err != nil {
+		return nil, err
+	}
+	var chains [][]digest.Digest
+	for _, m := range manifests {
+		config, err := images.Config(ctx, s.content, m)
+		if err == nil {
+			var diffIDs []digest.Digest
+			if diffIDs, err = images.RootFS(ctx, s.content, config); err == nil {
+				chains = append(chains, identity.ChainIDs(diffIDs))
+				continue
+			}
+		}
+		// the manifests of other platforms are not fetched
+		if !errdefs.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return chains, nil
+}
+
+// checkpointImages returns the image manifests referenced by the index of a
+// checkpoint
+func (s *Service) checkpointImages(ctx context.Context, target ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+	p, err := content.ReadBlob(ctx, s.content, target.Digest)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var index ocispec.Index
+	if err := json.Unmarshal(p, &index); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode checkpoint index %s", target.Digest)
+	}
+	var manifests []ocispec.Descriptor
+	for _, m := range index.Manifests {
+		switch m.MediaType {
+		case images.MediaTypeDockerSchema2Manifest, ocispec.MediaTypeImageManifest,
+			images.MediaTypeDockerSchema2ManifestList, ocispec.MediaTypeImageIndex:
+			manifests = append(manifests, m)
+		}
+	}
+	return manifests, nil
+}
@@ -34,6 +34,7 @@ func imageToProto(image *images.Image) imagesapi.Image {
 		Target:    descToProto(&image.Target),
 		CreatedAt: image.CreatedAt,
 		UpdatedAt: image.UpdatedAt,
+		Revision:  image.Revision,
 	}
 }
 
@@ -44,6 +45,7 @@ func imageFromProto(imagepb *imagesapi.Image) images.Image {
 		Target:    descFromProto(&imagepb.Target),
 		CreatedAt: imagepb.CreatedAt,
 		UpdatedAt: imagepb.UpdatedAt,
+		Revision:  imagepb.Revision,
 	}
 }
 
@@ -0,0 +1,112 @@
+package images
+
+import (
+	"strings"
+
+	"github.com/boltdb/bolt"
+	imagesapi "github.com/containerd/containerd/api/services/images/v1"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/metadata"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// Search returns the images whose name or target digest starts with the
+// prefix and whose content includes the digest, when they are set
+func (s *Service) Search(ctx context.Context, req *imagesapi.SearchImagesRequest) (*imagesapi.SearchImagesResponse, error) {
+	if req.Digest == "" && req.Prefix == "" {
+		return nil, errdefs.ToGRPC(errors.Wrap(errdefs.ErrInvalidArgument, "digest or prefix required"))
+	}
+	if req.Digest != "" {
+		if err := req.Digest.Validate(); err != nil {
+			return nil, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrInvalidArgument, "invalid digest %q: %v", req.Digest, err))
+		}
+	}
+	var candidates []images.Image
+	if err := s.withStoreView(ctx, func(ctx context.Context, store images.Store) error {
+		var err error
+		candidates, err = store.List(ctx, req.Filters...)
+		return err
+	}); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	var matches []images.Image
+	for _, image := range candidates {
+		if req.Prefix != "" && !strings.HasPrefix(image.Name, req.Prefix) && !strings.HasPrefix(image.Target.Digest.String(), req.Prefix) {
+			continue
+		}
+		if req.Digest == "" || image.Target.Digest == req.Digest {
+			matches = append(matches, image)
+			continue
+		}
+		// only the targets can be matched without a content store
+		if s.content == nil {
+			continue
+		}
+		found, err := images.Contains(ctx, s.content, image.Target, req.Digest)
+		if err != nil {
+			log.G(ctx).WithError(err).WithField("image", image.Name).Warn("failed to search image content")
+			continue
+		}
+		if found {
+			matches = append(matches, image)
+		}
+	}
+	return &imagesapi.SearchImagesResponse{
+		Images: imagesToProto(matches),
+	}, nil
+}
+
+func (s *Service) ContainerImage(ctx context.Context, req *imagesapi.ContainerImageRequest) (*imagesapi.ContainerImageResponse, error) {
+	if req.ContainerID == "" {
+		return nil, errdefs.ToGRPC(errors.Wrap(errdefs.ErrInvalidArgument, "container id required"))
+	}
+	var (
+		container containers.Container
+		resp      imagesapi.ContainerImageResponse
+	)
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		if container, err = metadata.NewContainerStore(tx).Get(ctx, req.ContainerID); err != nil {
+			return err
+		}
+		if container.Image == "" {
+			return nil
+		}
+		image, err := metadata.NewImageStore(tx).Get(ctx, container.Image)
+		if err != nil {
+			if errdefs.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		imagepb := imageToProto(&image)
+		resp.Image = &imagepb
+		return nil
+	}); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	resp.Name = container.Image
+	resp.Snapshotter = container.Snapshotter
+	if container.RootFS == "" {
+		return &resp, nil
+	}
+	sn, ok := s.snapshotters[container.Snapshotter]
+	if !ok {
+		return nil, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrFailedPrecondition, "snapshotter not loaded: %s", container.Snapshotter))
+	}
+	// follow the parents of the snapshot of the container down to the
+	// bottom layer
+	for key := container.RootFS; key != ""; {
+		info, err := sn.Stat(ctx, key)
+		if err != nil {
+			return nil, errdefs.ToGRPC(err)
+		}
+		resp.Snapshots = append(resp.Snapshots, info.Name)
+		key = info.Parent
+	}
+	return &resp, nil
+}
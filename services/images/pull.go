@@ -0,0 +1,100 @@
+package images
+
+import (
+	imagesapi "github.com/containerd/containerd/api/services/images/v1"
+	"github.com/containerd/containerd/distribution"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/platforms"
+	protobuf "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) Pull(ctx context.Context, req *imagesapi.PullImageRequest) (*imagesapi.PullImageResponse, error) {
+	if req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Name required")
+	}
+	if s.content == nil {
+		return nil, status.Errorf(codes.Unimplemented, "pull requires a content store")
+	}
+	if req.Snapshotter != "" {
+		if _, ok := s.snapshotters[req.Snapshotter]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "snapshotter not loaded: %s", req.Snapshotter)
+		}
+		if s.differ == nil {
+			return nil, status.Errorf(codes.Unimplemented, "unpack requires a differ")
+		}
+	}
+	if req.Platform != "" {
+		if _, err := platforms.Parse(req.Platform); err != nil {
+			return nil, errdefs.ToGRPC(err)
+		}
+	}
+	log.G(ctx).WithField("ref", req.Name).Debug("pulling image")
+
+	image, err := s.fetch(ctx, req.Name, req.Platform, req.PlainHTTP)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+
+	imagepb, err := s.record(ctx, image)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Snapshotter != "" {
+		if err := distribution.Unpack(ctx, s.content, imageFromProto(&imagepb), s.snapshotters[req.Snapshotter], s.differ); err != nil {
+			return nil, errdefs.ToGRPC(err)
+		}
+	}
+
+	return &imagesapi.PullImageResponse{Image: imagepb}, nil
+}
+
+// fetch fetches the image for the platform from the first of the mirrors of
+// its registry that has it, falling back to the registry itself
+func (s *Service) fetch(ctx context.Context, name, platform string, plainHTTP bool) (images.Image, error) {
+	remotes, err := s.registries().Remotes(ctx, name, plainHTTP, "", "")
+	if err != nil {
+		return images.Image{}, errors.Wrap(errdefs.ErrInvalidArgument, err.Error())
+	}
+	for _, remote := range remotes[:len(remotes)-1] {
+		image, err := distribution.Fetch(ctx, s.content, s.limiter.Resolver(remote.Resolver), remote.Ref, platform, true)
+		if err == nil {
+			image.Name = name
+			return image, nil
+		}
+		log.G(ctx).WithError(err).WithField("ref", remote.Ref).Warn("failed to pull image from mirror")
+	}
+	remote := remotes[len(remotes)-1]
+	return distribution.Fetch(ctx, s.content, s.limiter.Resolver(remote.Resolver), remote.Ref, platform, true)
+}
+
+// record creates or updates the image record through the Update and Create
+// methods of the service so that the image events are published
+func (s *Service) record(ctx context.Context, image images.Image) (imagesapi.Image, error) {
+	imagepb := imageToProto(&image)
+	updated, err := s.Update(ctx, &imagesapi.UpdateImageRequest{
+		Image: imagepb,
+		UpdateMask: &protobuf.FieldMask{
+			Paths: []string{"target", "labels." + images.PlatformLabel},
+		},
+	})
+	if err == nil {
+		return updated.Image, nil
+	}
+	if !errdefs.IsNotFound(errdefs.FromGRPC(err)) {
+		return imagesapi.Image{}, err
+	}
+	created, err := s.Create(ctx, &imagesapi.CreateImageRequest{
+		Image: imagepb,
+	})
+	if err != nil {
+		return imagesapi.Image{}, err
+	}
+	return created.Image, nil
+}
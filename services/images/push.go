@@ -0,0 +1,39 @@
+package images
+
+import (
+	imagesapi "github.com/containerd/containerd/api/services/images/v1"
+	"github.com/containerd/containerd/distribution"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/log"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) Push(ctx context.Context, req *imagesapi.PushImageRequest) (*empty.Empty, error) {
+	if req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Name required")
+	}
+	if s.content == nil {
+		return nil, status.Errorf(codes.Unimplemented, "push requires a content store")
+	}
+	image, err := s.Get(ctx, &imagesapi.GetImageRequest{Name: req.Name})
+	if err != nil {
+		return nil, err
+	}
+	ref := req.Ref
+	if ref == "" {
+		ref = req.Name
+	}
+	log.G(ctx).WithField("image", req.Name).WithField("ref", ref).Debug("pushing image")
+
+	resolver, err := s.registries().Resolver(ctx, ref, req.PlainHTTP, req.Username, req.Secret)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ref %q: %v", ref, err)
+	}
+	if err := distribution.Push(ctx, s.content, resolver, ref, imageFromProto(image.Image).Target); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &empty.Empty{}, nil
+}
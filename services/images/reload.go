@@ -0,0 +1,38 @@
+package images
+
+import (
+	"context"
+
+	"github.com/containerd/containerd/plugin"
+)
+
+var _ plugin.Reloader = &Service{}
+
+// Reload replaces the registries and the credential helper used by the pulls
+// and pushes started from now on. The download limits are shared by the
+// running pulls and only change on restart.
+func (s *Service) Reload(ctx context.Context, config interface{}) ([]string, error) {
+	cfg := config.(*Config)
+	hosts, conn, err := newHosts(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var restart []string
+	if cfg.MaxConcurrentDownloads != s.config.MaxConcurrentDownloads {
+		restart = append(restart, "max_concurrent_downloads")
+	}
+	if cfg.MaxDownloadRate != s.config.MaxDownloadRate {
+		restart = append(restart, "max_download_rate")
+	}
+	s.config.Registry = cfg.Registry
+	s.config.CredentialHelper = cfg.CredentialHelper
+	s.hosts = hosts
+	// the requests of the pulls still using the previous helper fail
+	if s.helperConn != nil {
+		s.helperConn.Close()
+	}
+	s.helperConn = conn
+	return restart, nil
+}
@@ -1,14 +1,20 @@
 package images
 
 import (
+	"sync"
+
 	"github.com/boltdb/bolt"
 	eventsapi "github.com/containerd/containerd/api/services/events/v1"
 	imagesapi "github.com/containerd/containerd/api/services/images/v1"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/distribution"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/events"
 	"github.com/containerd/containerd/images"
 	"github.com/containerd/containerd/metadata"
 	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/snapshot"
 	"github.com/golang/protobuf/ptypes/empty"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -22,20 +28,106 @@ func init() {
 		ID:   "images",
 		Requires: []plugin.PluginType{
 			plugin.MetadataPlugin,
+			plugin.ContentPlugin,
+			plugin.SnapshotPlugin,
+			plugin.DiffPlugin,
+		},
+		Config: &Config{
+			MaxConcurrentDownloads: 3,
 		},
 		Init: func(ic *plugin.InitContext) (interface{}, error) {
 			m, err := ic.Get(plugin.MetadataPlugin)
 			if err != nil {
 				return nil, err
 			}
-			return NewService(m.(*bolt.DB), ic.Events), nil
+			cfg := ic.Config.(*Config)
+			hosts, conn, err := newHosts(cfg)
+			if err != nil {
+				return nil, err
+			}
+			db := m.(*bolt.DB)
+			s := &Service{
+				db:           db,
+				publisher:    ic.Events,
+				config:       *cfg,
+				hosts:        hosts,
+				helperConn:   conn,
+				limiter:      remotes.NewLimiter(cfg.MaxConcurrentDownloads, cfg.MaxDownloadRate),
+				snapshotters: make(map[string]snapshot.Snapshotter),
+			}
+			// pulling is unavailable without a content store, unpacking
+			// without a differ
+			if c, err := ic.Get(plugin.ContentPlugin); err == nil {
+				s.content = metadata.NewContentStore(db, c.(content.Store))
+			}
+			if d, err := ic.Get(plugin.DiffPlugin); err == nil {
+				s.differ = d.(plugin.Differ)
+			}
+			if sns, err := ic.GetAll(plugin.SnapshotPlugin); err == nil {
+				for name, sn := range sns {
+					s.snapshotters[name] = metadata.NewSnapshotter(db, name, sn.(snapshot.Snapshotter))
+				}
+			}
+			return s, nil
 		},
 	})
 }
 
+// Config for the images service
+type Config struct {
+	// Registry configures the access to registries by host name, such as
+	// "docker.io"
+	Registry map[string]distribution.HostConfig `toml:"registry"`
+	// CredentialHelper is the unix socket address of a credentials service
+	// queried for the credentials of registries that have none configured
+	CredentialHelper string `toml:"credential_helper"`
+	// MaxConcurrentDownloads is the number of blobs downloaded at a time
+	// across the pulls of the daemon, unlimited when 0
+	MaxConcurrentDownloads int `toml:"max_concurrent_downloads"`
+	// MaxDownloadRate caps the bytes per second downloaded across the pulls
+	// of the daemon, unlimited when 0
+	MaxDownloadRate int64 `toml:"max_download_rate"`
+}
+
+// newHosts returns the registries of the configuration and the connection
+// to its credential helper, if any
+func newHosts(cfg *Config) (*distribution.Hosts, *grpc.ClientConn, error) {
+	var (
+		helper distribution.CredentialHelper
+		conn   *grpc.ClientConn
+		err    error
+	)
+	if cfg.CredentialHelper != "" {
+		if helper, conn, err = credentialHelper(cfg.CredentialHelper); err != nil {
+			return nil, nil, err
+		}
+	}
+	hosts, err := distribution.NewHosts(cfg.Registry, helper)
+	if err != nil {
+		if conn != nil {
+			conn.Close()
+		}
+		return nil, nil, err
+	}
+	return hosts, conn, nil
+}
+
 type Service struct {
 	db        *bolt.DB
 	publisher events.Publisher
+	limiter   *remotes.Limiter
+
+	// mu protects the configuration and the registries, replaced when the
+	// configuration is reloaded
+	mu         sync.Mutex
+	config     Config
+	hosts      *distribution.Hosts
+	helperConn *grpc.ClientConn
+
+	// content, differ and snapshotters are used to pull images
+	content      content.Store
+	differ       plugin.Differ
+	snapshotters map[string]snapshot.Snapshotter
 }
 
 func NewService(db *bolt.DB, publisher events.Publisher) imagesapi.ImagesServer {
@@ -45,6 +137,13 @@ func NewService(db *bolt.DB, publisher events.Publisher) imagesapi.ImagesServer
 	}
 }
 
+// registries returns the registries images are pulled from and pushed to
+func (s *Service) registries() *distribution.Hosts {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.hosts
+}
+
 func (s *Service) Register(server *grpc.Server) error {
 	imagesapi.RegisterImagesServer(server, s)
 	return nil
@@ -53,7 +152,7 @@ func (s *Service) Register(server *grpc.Server) error {
 func (s *Service) Get(ctx context.Context, req *imagesapi.GetImageRequest) (*imagesapi.GetImageResponse, error) {
 	var resp imagesapi.GetImageResponse
 
-	return &resp, errdefs.ToGRPC(s.withStoreView(ctx, func(ctx context.Context, store images.Store) error {
+	if err := s.withStoreView(ctx, func(ctx context.Context, store images.Store) error {
 		image, err := store.Get(ctx, req.Name)
 		if err != nil {
 			return err
@@ -61,13 +160,17 @@ func (s *Service) Get(ctx context.Context, req *imagesapi.GetImageRequest) (*ima
 		imagepb := imageToProto(&image)
 		resp.Image = &imagepb
 		return nil
-	}))
+	}); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	s.describe(ctx, resp.Image)
+	return &resp, nil
 }
 
 func (s *Service) List(ctx context.Context, req *imagesapi.ListImagesRequest) (*imagesapi.ListImagesResponse, error) {
 	var resp imagesapi.ListImagesResponse
 
-	return &resp, errdefs.ToGRPC(s.withStoreView(ctx, func(ctx context.Context, store images.Store) error {
+	if err := s.withStoreView(ctx, func(ctx context.Context, store images.Store) error {
 		images, err := store.List(ctx, req.Filters...)
 		if err != nil {
 			return err
@@ -75,7 +178,13 @@ func (s *Service) List(ctx context.Context, req *imagesapi.ListImagesRequest) (*
 
 		resp.Images = imagesToProto(images)
 		return nil
-	}))
+	}); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	for i := range resp.Images {
+		s.describe(ctx, &resp.Images[i])
+	}
+	return &resp, nil
 }
 
 func (s *Service) Create(ctx context.Context, req *imagesapi.CreateImageRequest) (*imagesapi.CreateImageResponse, error) {
@@ -87,6 +196,8 @@ func (s *Service) Create(ctx context.Context, req *imagesapi.CreateImageRequest)
 		image = imageFromProto(&req.Image)
 		resp  imagesapi.CreateImageResponse
 	)
+	metadata.GCLock.RLock()
+	defer metadata.GCLock.RUnlock()
 	if err := s.withStoreUpdate(ctx, func(ctx context.Context, store images.Store) error {
 		created, err := store.Create(ctx, image)
 		if err != nil {
@@ -119,6 +230,8 @@ func (s *Service) Update(ctx context.Context, req *imagesapi.UpdateImageRequest)
 		image = imageFromProto(&req.Image)
 		resp  imagesapi.UpdateImageResponse
 	)
+	metadata.GCLock.RLock()
+	defer metadata.GCLock.RUnlock()
 	if err := s.withStoreUpdate(ctx, func(ctx context.Context, store images.Store) error {
 		var fieldpaths []string
 		if req.UpdateMask != nil && len(req.UpdateMask.Paths) > 0 {
@@ -149,7 +262,15 @@ func (s *Service) Update(ctx context.Context, req *imagesapi.UpdateImageRequest)
 }
 
 func (s *Service) Delete(ctx context.Context, req *imagesapi.DeleteImageRequest) (*empty.Empty, error) {
+	if req.GC && s.content == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "content store not loaded")
+	}
+	var image images.Image
 	if err := s.withStoreUpdate(ctx, func(ctx context.Context, store images.Store) error {
+		var err error
+		if image, err = store.Get(ctx, req.Name); err != nil {
+			return errdefs.ToGRPC(err)
+		}
 		return errdefs.ToGRPC(store.Delete(ctx, req.Name))
 	}); err != nil {
 		return nil, err
@@ -161,6 +282,12 @@ func (s *Service) Delete(ctx context.Context, req *imagesapi.DeleteImageRequest)
 		return nil, err
 	}
 
+	if req.GC {
+		if err := s.gc(ctx, image); err != nil {
+			return nil, errdefs.ToGRPC(err)
+		}
+	}
+
 	return &empty.Empty{}, nil
 }
 
@@ -0,0 +1,107 @@
+package introspection
+
+import (
+	"strings"
+
+	api "github.com/containerd/containerd/api/services/introspection/v1"
+	"github.com/containerd/containerd/deprecation"
+	"github.com/containerd/containerd/filters"
+	"github.com/containerd/containerd/plugin"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+var _ api.IntrospectionServer = &Service{}
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.GRPCPlugin,
+		ID:   "introspection",
+		Init: New,
+	})
+}
+
+func New(ic *plugin.InitContext) (interface{}, error) {
+	return &Service{
+		plugins: ic.Plugins,
+	}, nil
+}
+
+type Service struct {
+	plugins *plugin.Set
+}
+
+func (s *Service) Register(server *grpc.Server) error {
+	api.RegisterIntrospectionServer(server, s)
+	return nil
+}
+
+func (s *Service) Plugins(ctx context.Context, r *api.PluginsRequest) (*api.PluginsResponse, error) {
+	filter, err := filters.Parse(r.Filter)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid filter %q: %v", r.Filter, err)
+	}
+	resp := &api.PluginsResponse{}
+	for _, p := range s.plugins.All() {
+		if !filter.Match(adapt(p)) {
+			continue
+		}
+		resp.Plugins = append(resp.Plugins, pluginToProto(p))
+	}
+	return resp, nil
+}
+
+func (s *Service) Deprecations(ctx context.Context, r *api.DeprecationsRequest) (*api.DeprecationsResponse, error) {
+	resp := &api.DeprecationsResponse{}
+	for _, u := range deprecation.Usages() {
+		resp.Deprecations = append(resp.Deprecations, &api.Deprecation{
+			ID:       u.ID,
+			Method:   u.Method,
+			Field:    u.Field,
+			Message:  u.Message,
+			Count:    u.Count,
+			LastUsed: u.LastUsed,
+		})
+	}
+	return resp, nil
+}
+
+func adapt(p *plugin.Plugin) filters.Adaptor {
+	return filters.AdapterFunc(func(fieldpath []string) (string, bool) {
+		if len(fieldpath) == 0 {
+			return "", false
+		}
+		switch fieldpath[0] {
+		case "type":
+			return string(p.Registration.Type), true
+		case "id":
+			return p.Registration.ID, true
+		case "exports":
+			if len(fieldpath) < 2 {
+				return "", false
+			}
+			value, ok := p.Meta.Exports[strings.Join(fieldpath[1:], ".")]
+			return value, ok
+		}
+		return "", false
+	})
+}
+
+func pluginToProto(p *plugin.Plugin) *api.Plugin {
+	var requires []string
+	for _, t := range p.Registration.Requires {
+		requires = append(requires, string(t))
+	}
+	pp := &api.Plugin{
+		Type:         string(p.Registration.Type),
+		ID:           p.Registration.ID,
+		Requires:     requires,
+		Exports:      p.Meta.Exports,
+		Capabilities: p.Meta.Capabilities,
+	}
+	if p.Err != nil {
+		pp.InitError = p.Err.Error()
+	}
+	return pp
+}
@@ -0,0 +1,60 @@
+package metadata
+
+import (
+	"github.com/boltdb/bolt"
+	api "github.com/containerd/containerd/api/services/metadata/v1"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/streaming"
+	"google.golang.org/grpc"
+)
+
+var _ api.MetadataServer = &Service{}
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.GRPCPlugin,
+		ID:   "metadata",
+		Requires: []plugin.PluginType{
+			plugin.MetadataPlugin,
+		},
+		Init: New,
+	})
+}
+
+func New(ic *plugin.InitContext) (interface{}, error) {
+	m, err := ic.Get(plugin.MetadataPlugin)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		db: m.(*bolt.DB),
+	}, nil
+}
+
+type Service struct {
+	db *bolt.DB
+}
+
+func (s *Service) Register(server *grpc.Server) error {
+	api.RegisterMetadataServer(server, s)
+	return nil
+}
+
+func (s *Service) Export(_ *api.ExportMetadataRequest, ss api.Metadata_ExportServer) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		if err := ss.Send(&api.ExportMetadataResponse{
+			Schema: metadata.SchemaVersion,
+			Length: tx.Size(),
+		}); err != nil {
+			return err
+		}
+		w := streaming.NewWriter(func(p []byte) error {
+			return ss.Send(&api.ExportMetadataResponse{Data: p})
+		})
+		if _, err := tx.WriteTo(w); err != nil {
+			return err
+		}
+		return w.Flush()
+	})
+}
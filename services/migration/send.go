@@ -0,0 +1,36 @@
+package migration
+
+import (
+	"context"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// send copies the blob of the descriptor and its children to the remote
+// store, skipping the blobs already in the remote store, and returns the
+// number and total size of the blobs copied
+func send(ctx context.Context, from content.Provider, to content.Store, desc ocispec.Descriptor) (blobs, size int64, err error) {
+	copyHandler := images.HandlerFunc(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		if _, err := to.Info(ctx, desc.Digest); err == nil {
+			return nil, nil
+		} else if !errdefs.IsNotFound(err) {
+			return nil, err
+		}
+		ra, err := from.ReaderAt(ctx, desc.Digest)
+		if err != nil {
+			return nil, err
+		}
+		defer ra.Close()
+		if err := content.WriteBlob(ctx, to, desc.Digest.String(), content.NewReader(ra), ra.Size(), desc.Digest); err != nil {
+			return nil, err
+		}
+		blobs++
+		size += ra.Size()
+		return nil, nil
+	})
+	err = images.Walk(ctx, images.Handlers(copyHandler, images.ChildrenHandler(from)), desc)
+	return blobs, size, err
+}
@@ -0,0 +1,77 @@
+package migration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/content/local"
+	"github.com/containerd/containerd/images"
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+func writeBlob(ctx context.Context, t *testing.T, cs content.Store, mediaType string, p []byte) ocispec.Descriptor {
+	desc := ocispec.Descriptor{
+		MediaType: mediaType,
+		Digest:    digest.FromBytes(p),
+		Size:      int64(len(p)),
+	}
+	if err := content.WriteBlob(ctx, cs, desc.Digest.String(), bytes.NewReader(p), desc.Size, desc.Digest); err != nil {
+		t.Fatal(err)
+	}
+	return desc
+}
+
+func TestSend(t *testing.T) {
+	root, err := ioutil.TempDir("", "migration-send-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	from, err := local.NewStore(filepath.Join(root, "from"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	to, err := local.NewStore(filepath.Join(root, "to"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	checkpoint := writeBlob(ctx, t, from, images.MediaTypeContainerd1Checkpoint, []byte("checkpoint"))
+	spec := writeBlob(ctx, t, from, images.MediaTypeContainerd1CheckpointConfig, []byte("spec"))
+	p, err := json.Marshal(ocispec.Index{
+		Manifests: []ocispec.Descriptor{checkpoint, spec},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	index := writeBlob(ctx, t, from, ocispec.MediaTypeImageIndex, p)
+	// the remote store already has the spec
+	writeBlob(ctx, t, to, images.MediaTypeContainerd1CheckpointConfig, []byte("spec"))
+
+	blobs, size, err := send(ctx, from, to, index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blobs != 2 || size != index.Size+checkpoint.Size {
+		t.Fatalf("expected the index and checkpoint to be sent, got %d blobs of %d bytes", blobs, size)
+	}
+	for _, desc := range []ocispec.Descriptor{index, checkpoint, spec} {
+		if _, err := to.Info(ctx, desc.Digest); err != nil {
+			t.Errorf("expected %s in the remote store: %v", desc.MediaType, err)
+		}
+	}
+	if blobs, _, err = send(ctx, from, to, index); err != nil {
+		t.Fatal(err)
+	}
+	if blobs != 0 {
+		t.Fatalf("expected no blob to be sent again, got %d", blobs)
+	}
+}
@@ -0,0 +1,166 @@
+package migration
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/boltdb/bolt"
+	contentapi "github.com/containerd/containerd/api/services/content/v1"
+	api "github.com/containerd/containerd/api/services/migration/v1"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/plugin"
+	contentservice "github.com/containerd/containerd/services/content"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// dialTimeout bounds the time to connect to the remote daemon
+const dialTimeout = 30 * time.Second
+
+var _ api.MigrationServer = &Service{}
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.GRPCPlugin,
+		ID:   "migration",
+		Requires: []plugin.PluginType{
+			plugin.MetadataPlugin,
+			plugin.ContentPlugin,
+		},
+		Config: &Config{},
+		Init:   New,
+	})
+}
+
+// Config of the migration service
+type Config struct {
+	// TLSCert and TLSKey are the paths of the certificate and key presented
+	// to remote daemons reached over TCP, and TLSCA the path of the CA
+	// certificates verifying their certificates. The system CAs are used
+	// when TLSCA is empty.
+	TLSCert string `toml:"tls_cert"`
+	TLSKey  string `toml:"tls_key"`
+	TLSCA   string `toml:"tls_ca"`
+}
+
+// New returns the migration service
+func New(ic *plugin.InitContext) (interface{}, error) {
+	m, err := ic.Get(plugin.MetadataPlugin)
+	if err != nil {
+		return nil, err
+	}
+	c, err := ic.Get(plugin.ContentPlugin)
+	if err != nil {
+		return nil, err
+	}
+	config, err := tlsConfig(ic.Config.(*Config))
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		store: metadata.NewContentStore(m.(*bolt.DB), c.(content.Store)),
+		tls:   config,
+	}, nil
+}
+
+// Service streams content to remote daemons
+type Service struct {
+	store content.Store
+	tls   *tls.Config
+}
+
+func (s *Service) Register(server *grpc.Server) error {
+	api.RegisterMigrationServer(server, s)
+	return nil
+}
+
+func (s *Service) Send(ctx context.Context, r *api.SendRequest) (*api.SendResponse, error) {
+	if r.Address == "" {
+		return nil, errdefs.ToGRPCf(errdefs.ErrInvalidArgument, "remote address is required")
+	}
+	if err := r.Target.Digest.Validate(); err != nil {
+		return nil, errdefs.ToGRPCf(errdefs.ErrInvalidArgument, "target: %v", err)
+	}
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	conn, err := s.dial(ctx, r.Address)
+	if err != nil {
+		return nil, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrUnavailable, "failed to dial %q: %v", r.Address, err))
+	}
+	defer conn.Close()
+	// the blobs are written to the same namespace of the remote daemon
+	remote := contentservice.NewStoreFromClient(contentapi.NewContentClient(conn))
+	blobs, size, err := send(namespaces.WithNamespace(ctx, namespace), s.store, remote, ocispec.Descriptor{
+		MediaType: r.Target.MediaType,
+		Digest:    r.Target.Digest,
+		Size:      r.Target.Size_,
+	})
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	resp := api.SendResponse{
+		Blobs: blobs,
+		Size_: size,
+	}
+	log.G(ctx).WithField("address", r.Address).WithField("blobs", resp.Blobs).Debug("sent content to remote daemon")
+	return &resp, nil
+}
+
+// dial connects to the remote daemon, over TLS for TCP addresses
+func (s *Service) dial(ctx context.Context, address string) (*grpc.ClientConn, error) {
+	opts := []grpc.DialOption{
+		grpc.WithBlock(),
+		grpc.WithTimeout(dialTimeout),
+		grpc.FailOnNonTempDialError(true),
+	}
+	if strings.HasPrefix(address, "tcp://") {
+		address = strings.TrimPrefix(address, "tcp://")
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(s.tls)))
+	} else {
+		address = strings.TrimPrefix(address, "unix://")
+		opts = append(opts, grpc.WithInsecure(), grpc.WithDialer(func(address string, timeout time.Duration) (net.Conn, error) {
+			return net.DialTimeout("unix", address, timeout)
+		}))
+	}
+	return grpc.DialContext(ctx, address, opts...)
+}
+
+// tlsConfig returns the TLS configuration connecting to remote daemons over
+// TCP
+func tlsConfig(config *Config) (*tls.Config, error) {
+	c := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if config.TLSCA != "" {
+		data, err := ioutil.ReadFile(config.TLSCA)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read the TLS CA")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, errors.Errorf("no certificate found in %s", config.TLSCA)
+		}
+		c.RootCAs = pool
+	}
+	if config.TLSCert != "" || config.TLSKey != "" {
+		pair, err := tls.LoadX509KeyPair(config.TLSCert, config.TLSKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load the TLS certificate")
+		}
+		c.Certificates = []tls.Certificate{pair}
+	}
+	return c, nil
+}
@@ -0,0 +1,116 @@
+package profiles
+
+import (
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	api "github.com/containerd/containerd/api/services/profiles/v1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/profiles"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.GRPCPlugin,
+		ID:   "profiles",
+		Requires: []plugin.PluginType{
+			plugin.HookPlugin,
+		},
+		Init: func(ic *plugin.InitContext) (interface{}, error) {
+			hooks, err := ic.GetAll(plugin.HookPlugin)
+			if err != nil {
+				return nil, err
+			}
+			h, ok := hooks["security-profiles"].(*profiles.Hook)
+			if !ok {
+				return nil, errors.New("security-profiles hook not loaded")
+			}
+			return &Service{
+				store:     h.Store,
+				publisher: ic.Events,
+			}, nil
+		},
+	})
+}
+
+// Service registers the profiles referenced by the labels of containers
+type Service struct {
+	store     *profiles.Store
+	publisher events.Publisher
+}
+
+var _ api.ProfilesServer = &Service{}
+
+func (s *Service) Register(server *grpc.Server) error {
+	api.RegisterProfilesServer(server, s)
+	return nil
+}
+
+func (s *Service) Get(ctx context.Context, req *api.GetProfileRequest) (*api.GetProfileResponse, error) {
+	p, err := s.store.Get(profiles.Kind(req.Kind), req.Name)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &api.GetProfileResponse{
+		Profile: profileToProto(p),
+	}, nil
+}
+
+func (s *Service) List(ctx context.Context, req *api.ListProfilesRequest) (*api.ListProfilesResponse, error) {
+	ps, err := s.store.List(profiles.Kind(req.Kind))
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	var resp api.ListProfilesResponse
+	for _, p := range ps {
+		resp.Profiles = append(resp.Profiles, profileToProto(p))
+	}
+	return &resp, nil
+}
+
+func (s *Service) Put(ctx context.Context, req *api.PutProfileRequest) (*api.PutProfileResponse, error) {
+	p, err := s.store.Register(profiles.Profile{
+		Kind: profiles.Kind(req.Profile.Kind),
+		Name: req.Profile.Name,
+		Data: req.Profile.Data,
+	})
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if err := s.publisher.Publish(ctx, "/profiles/register", &eventsapi.ProfileRegister{
+		Kind: req.Profile.Kind,
+		Name: req.Profile.Name,
+	}); err != nil {
+		return nil, err
+	}
+	return &api.PutProfileResponse{
+		Profile: profileToProto(p),
+	}, nil
+}
+
+func (s *Service) Delete(ctx context.Context, req *api.DeleteProfileRequest) (*empty.Empty, error) {
+	if err := s.store.Delete(profiles.Kind(req.Kind), req.Name); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if err := s.publisher.Publish(ctx, "/profiles/delete", &eventsapi.ProfileDelete{
+		Kind: req.Kind,
+		Name: req.Name,
+	}); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func profileToProto(p profiles.Profile) api.Profile {
+	return api.Profile{
+		Kind:      string(p.Kind),
+		Name:      p.Name,
+		Data:      p.Data,
+		Config:    p.Config,
+		CreatedAt: p.CreatedAt,
+	}
+}
@@ -0,0 +1,39 @@
+package sandboxes
+
+import (
+	api "github.com/containerd/containerd/api/services/sandboxes/v1"
+	"github.com/containerd/containerd/sandboxes"
+)
+
+func sandboxesToProto(sandboxes []sandboxes.Sandbox) []api.Sandbox {
+	var sandboxespb []api.Sandbox
+
+	for _, sandbox := range sandboxes {
+		sandboxespb = append(sandboxespb, sandboxToProto(&sandbox))
+	}
+
+	return sandboxespb
+}
+
+func sandboxToProto(sandbox *sandboxes.Sandbox) api.Sandbox {
+	return api.Sandbox{
+		ID:           sandbox.ID,
+		Labels:       sandbox.Labels,
+		CgroupParent: sandbox.CgroupParent,
+		Network:      sandbox.Network,
+		SharePid:     sandbox.SharePID,
+		Namespaces:   sandbox.Namespaces,
+		CreatedAt:    sandbox.CreatedAt,
+		UpdatedAt:    sandbox.UpdatedAt,
+	}
+}
+
+func sandboxFromProto(sandboxpb *api.Sandbox) sandboxes.Sandbox {
+	return sandboxes.Sandbox{
+		ID:           sandboxpb.ID,
+		Labels:       sandboxpb.Labels,
+		CgroupParent: sandboxpb.CgroupParent,
+		Network:      sandboxpb.Network,
+		SharePID:     sandboxpb.SharePid,
+	}
+}
@@ -0,0 +1,222 @@
+package sandboxes
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/boltdb/bolt"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/hooks"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/sandboxes"
+	"github.com/containerd/containerd/sys"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+const (
+	// PIDOwnerLabel is set on a sandbox sharing its PID namespace to the id
+	// of the container whose task created the namespace
+	PIDOwnerLabel = "containerd.io/sandbox.pid"
+	// PIDProcessLabel is set on a sandbox sharing its PID namespace to the
+	// pid and start time of the task that created the namespace, as
+	// <pid>:<start time>
+	PIDProcessLabel = "containerd.io/sandbox.pid.process"
+)
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.HookPlugin,
+		ID:   "sandbox",
+		Requires: []plugin.PluginType{
+			plugin.MetadataPlugin,
+		},
+		Init: func(ic *plugin.InitContext) (interface{}, error) {
+			if err := os.MkdirAll(ic.State, 0711); err != nil {
+				return nil, err
+			}
+			m, err := ic.Get(plugin.MetadataPlugin)
+			if err != nil {
+				return nil, err
+			}
+			return &Hook{
+				db:    m.(*bolt.DB),
+				state: ic.State,
+			}, nil
+		},
+	})
+}
+
+// Hook has the tasks of the containers of sandboxes join the namespaces of
+// their sandbox and be created under its cgroup parent
+type Hook struct {
+	db *bolt.DB
+	// state holds the PID namespaces shared in sandboxes
+	state string
+}
+
+// Invoke adjusts the containers of sandboxes at the pre-create point and
+// records the PID namespace shared in the sandbox at the post-create point
+func (h *Hook) Invoke(ctx context.Context, r *hooks.Request) (*hooks.Adjustment, error) {
+	if r.SandboxID == "" {
+		return nil, nil
+	}
+	switch r.Point {
+	case hooks.PreCreate:
+		return h.join(ctx, r)
+	case hooks.PostCreate:
+		return nil, h.sharePID(ctx, r)
+	case hooks.PostDelete:
+		return nil, h.releasePID(ctx, r)
+	}
+	return nil, nil
+}
+
+func (h *Hook) join(ctx context.Context, r *hooks.Request) (*hooks.Adjustment, error) {
+	var sandbox sandboxes.Sandbox
+	if err := h.db.View(func(tx *bolt.Tx) error {
+		var err error
+		sandbox, err = metadata.NewSandboxStore(tx).Get(ctx, r.SandboxID)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	if sandbox.Namespaces[string(specs.PIDNamespace)] != "" {
+		if err := checkPIDOwner(&sandbox); err != nil {
+			return nil, err
+		}
+	}
+	var a hooks.Adjustment
+	for _, t := range []specs.LinuxNamespaceType{
+		specs.NetworkNamespace,
+		specs.IPCNamespace,
+		specs.PIDNamespace,
+	} {
+		if path := sandbox.Namespaces[string(t)]; path != "" {
+			a.Namespaces = append(a.Namespaces, specs.LinuxNamespace{
+				Type: t,
+				Path: path,
+			})
+		}
+	}
+	if sandbox.CgroupParent != "" {
+		a.CgroupsPath = filepath.Join(sandbox.CgroupParent, r.ContainerID)
+	}
+	return &a, nil
+}
+
+// checkPIDOwner returns an error once the task that created the PID
+// namespace of the sandbox exited, as no process can join a PID namespace
+// whose init is gone
+func checkPIDOwner(sandbox *sandboxes.Sandbox) error {
+	process, ok := sandbox.Labels[PIDProcessLabel]
+	if !ok {
+		return nil
+	}
+	var (
+		pid     int
+		started uint64
+	)
+	if _, err := fmt.Sscanf(process, "%d:%d", &pid, &started); err != nil {
+		return errors.Wrapf(errdefs.ErrFailedPrecondition, "invalid pid process label %q of sandbox %s", process, sandbox.ID)
+	}
+	if s, err := sys.ProcessStartTime(pid); err != nil || s != started {
+		return errors.Wrapf(errdefs.ErrFailedPrecondition, "the task of container %s sharing the pid namespace of sandbox %s exited", sandbox.Labels[PIDOwnerLabel], sandbox.ID)
+	}
+	return nil
+}
+
+// sharePID records the PID namespace of the first task created in a sandbox
+// sharing its PID namespace so that the next tasks join it. The namespace is
+// bind mounted under the state of the hook, so that a process reusing the
+// pid of the task is never joined, and the task is recorded so that joins
+// fail once it exited.
+func (h *Hook) sharePID(ctx context.Context, r *hooks.Request) error {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return err
+	}
+	var (
+		path  = filepath.Join(h.state, namespace, r.SandboxID, "pid")
+		bound bool
+	)
+	err = h.db.Update(func(tx *bolt.Tx) error {
+		store := metadata.NewSandboxStore(tx)
+		sandbox, err := store.Get(ctx, r.SandboxID)
+		if err != nil {
+			return err
+		}
+		if !sandbox.SharePID || sandbox.Namespaces[string(specs.PIDNamespace)] != "" {
+			return nil
+		}
+		started, err := sys.ProcessStartTime(int(r.Pid))
+		if err != nil {
+			return errors.Wrapf(err, "failed to read the start time of the task of container %s", r.ContainerID)
+		}
+		// a namespace left by a daemon stopped before recording it
+		if err := sys.RemoveNamespace(path); err != nil {
+			return err
+		}
+		if err := sys.BindNamespace(fmt.Sprintf("/proc/%d/ns/pid", r.Pid), path); err != nil {
+			return err
+		}
+		bound = true
+		// the namespace bound is that of the task only if its pid was not
+		// reused meanwhile
+		if s, err := sys.ProcessStartTime(int(r.Pid)); err != nil || s != started {
+			return errors.Wrapf(errdefs.ErrFailedPrecondition, "the task of container %s exited before its pid namespace was shared", r.ContainerID)
+		}
+		_, err = store.Update(ctx, sandboxes.Sandbox{
+			ID: r.SandboxID,
+			Labels: map[string]string{
+				PIDOwnerLabel:   r.ContainerID,
+				PIDProcessLabel: fmt.Sprintf("%d:%d", r.Pid, started),
+			},
+			Namespaces: map[string]string{
+				string(specs.PIDNamespace): path,
+			},
+		}, "labels."+PIDOwnerLabel, "labels."+PIDProcessLabel, "namespaces."+string(specs.PIDNamespace))
+		return err
+	})
+	if err != nil && bound {
+		sys.RemoveNamespace(path)
+	}
+	return err
+}
+
+// releasePID removes the PID namespace of the sandbox once the container
+// that created it is deleted, so that the next task creates a new one
+func (h *Hook) releasePID(ctx context.Context, r *hooks.Request) error {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return err
+	}
+	var released bool
+	if err := h.db.Update(func(tx *bolt.Tx) error {
+		store := metadata.NewSandboxStore(tx)
+		sandbox, err := store.Get(ctx, r.SandboxID)
+		if err != nil {
+			return err
+		}
+		if sandbox.Labels[PIDOwnerLabel] != r.ContainerID {
+			return nil
+		}
+		released = true
+		_, err = store.Update(ctx, sandboxes.Sandbox{
+			ID: r.SandboxID,
+		}, "labels."+PIDOwnerLabel, "labels."+PIDProcessLabel, "namespaces."+string(specs.PIDNamespace))
+		return err
+	}); err != nil || !released {
+		return err
+	}
+	path := filepath.Join(h.state, namespace, r.SandboxID, "pid")
+	if err := sys.RemoveNamespace(path); err != nil {
+		return err
+	}
+	os.Remove(filepath.Dir(path))
+	return nil
+}
@@ -0,0 +1,141 @@
+package sandboxes
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/hooks"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/sandboxes"
+	"github.com/containerd/containerd/testutil"
+	"github.com/gogo/protobuf/types"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/net/context"
+)
+
+func TestHookSharesPID(t *testing.T) {
+	testutil.RequiresRoot(t)
+	dir, err := ioutil.TempDir("", "sandbox-hook-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	db, err := bolt.Open(filepath.Join(dir, "meta.db"), 0644, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	spec := &types.Any{TypeUrl: "spec", Value: []byte("{}")}
+	ctx := namespaces.WithNamespace(context.Background(), "testing")
+	if err := db.Update(func(tx *bolt.Tx) error {
+		if _, err := metadata.NewSandboxStore(tx).Create(ctx, sandboxes.Sandbox{
+			ID:           "pod",
+			CgroupParent: "/pods/pod",
+			SharePID:     true,
+			Namespaces: map[string]string{
+				string(specs.NetworkNamespace): "/run/pod/net",
+			},
+		}); err != nil {
+			return err
+		}
+		for _, id := range []string{"first", "second"} {
+			if _, err := metadata.NewContainerStore(tx).Create(ctx, containers.Container{
+				ID:        id,
+				Runtime:   containers.RuntimeInfo{Name: "testing"},
+				Spec:      spec,
+				SandboxID: "pod",
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	h := &Hook{db: db, state: filepath.Join(dir, "state")}
+	a, err := h.Invoke(ctx, &hooks.Request{Point: hooks.PreCreate, ContainerID: "first", SandboxID: "pod"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a.Namespaces) != 1 || a.Namespaces[0].Path != "/run/pod/net" {
+		t.Fatalf("unexpected namespaces %v", a.Namespaces)
+	}
+	if a.CgroupsPath != "/pods/pod/first" {
+		t.Fatalf("unexpected cgroups path %q", a.CgroupsPath)
+	}
+	if _, err := h.Invoke(ctx, &hooks.Request{Point: hooks.PostCreate, ContainerID: "first", SandboxID: "pod", Pid: uint32(os.Getpid())}); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err = h.Invoke(ctx, &hooks.Request{Point: hooks.PreCreate, ContainerID: "second", SandboxID: "pod"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a.Namespaces) != 2 || a.Namespaces[1].Type != specs.PIDNamespace || a.Namespaces[1].Path != filepath.Join(dir, "state", "testing", "pod", "pid") {
+		t.Fatalf("unexpected namespaces %v", a.Namespaces)
+	}
+
+	// only the container that created the pid namespace releases it
+	for _, id := range []string{"second", "first"} {
+		if _, err := h.Invoke(ctx, &hooks.Request{Point: hooks.PostDelete, ContainerID: id, SandboxID: "pod"}); err != nil {
+			t.Fatal(err)
+		}
+		var sandbox sandboxes.Sandbox
+		if err := db.View(func(tx *bolt.Tx) error {
+			sandbox, err = metadata.NewSandboxStore(tx).Get(ctx, "pod")
+			return err
+		}); err != nil {
+			t.Fatal(err)
+		}
+		_, shared := sandbox.Namespaces[string(specs.PIDNamespace)]
+		if shared != (id == "second") {
+			t.Fatalf("unexpected namespaces %v after deleting %s", sandbox.Namespaces, id)
+		}
+	}
+}
+
+func TestHookRejectsExitedPIDOwner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sandbox-hook-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	db, err := bolt.Open(filepath.Join(dir, "meta.db"), 0644, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	ctx := namespaces.WithNamespace(context.Background(), "testing")
+	if err := db.Update(func(tx *bolt.Tx) error {
+		// the start time of the process of the pid differs from the one of
+		// the task that created the namespace
+		_, err := metadata.NewSandboxStore(tx).Create(ctx, sandboxes.Sandbox{
+			ID:       "pod",
+			SharePID: true,
+			Labels: map[string]string{
+				PIDOwnerLabel:   "first",
+				PIDProcessLabel: fmt.Sprintf("%d:1", os.Getpid()),
+			},
+			Namespaces: map[string]string{
+				string(specs.PIDNamespace): filepath.Join(dir, "pid"),
+			},
+		})
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+	h := &Hook{db: db, state: filepath.Join(dir, "state")}
+	if _, err := h.Invoke(ctx, &hooks.Request{Point: hooks.PreCreate, ContainerID: "second", SandboxID: "pod"}); !errdefs.IsFailedPrecondition(err) {
+		t.Fatalf("expected joining the pid namespace of an exited task to fail, got %v", err)
+	}
+}
@@ -0,0 +1,281 @@
+package sandboxes
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/boltdb/bolt"
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	api "github.com/containerd/containerd/api/services/sandboxes/v1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/identifiers"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/network"
+	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/sandboxes"
+	"github.com/containerd/containerd/sys"
+	"github.com/golang/protobuf/ptypes/empty"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc"
+)
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.GRPCPlugin,
+		ID:   "sandboxes",
+		Requires: []plugin.PluginType{
+			plugin.MetadataPlugin,
+		},
+		Config: &Config{
+			CNI: network.Config{
+				ConfDirs: []string{"/etc/cni/net.d"},
+				BinDirs:  []string{"/opt/cni/bin"},
+				IfName:   "eth0",
+			},
+		},
+		Init: func(ic *plugin.InitContext) (interface{}, error) {
+			config := ic.Config.(*Config)
+			if err := os.MkdirAll(ic.State, 0711); err != nil {
+				return nil, err
+			}
+			m, err := ic.Get(plugin.MetadataPlugin)
+			if err != nil {
+				return nil, err
+			}
+			return &Service{
+				db:        m.(*bolt.DB),
+				publisher: ic.Events,
+				state:     ic.State,
+				confDirs:  config.CNI.ConfDirs,
+				cni: &network.CNI{
+					BinDirs: config.CNI.BinDirs,
+					IfName:  config.CNI.IfName,
+				},
+			}, nil
+		},
+	})
+}
+
+// Config of the sandboxes service
+type Config struct {
+	// CNI configures the networks the sandboxes are added to
+	CNI network.Config `toml:"cni"`
+}
+
+// Service creates sandboxes with the network and IPC namespaces joined by
+// their containers
+type Service struct {
+	db        *bolt.DB
+	publisher events.Publisher
+	state     string
+	confDirs  []string
+	cni       *network.CNI
+}
+
+var _ api.SandboxesServer = &Service{}
+
+func (s *Service) Register(server *grpc.Server) error {
+	api.RegisterSandboxesServer(server, s)
+	return nil
+}
+
+func (s *Service) Get(ctx context.Context, req *api.GetSandboxRequest) (*api.GetSandboxResponse, error) {
+	var resp api.GetSandboxResponse
+
+	return &resp, errdefs.ToGRPC(s.db.View(func(tx *bolt.Tx) error {
+		sandbox, err := metadata.NewSandboxStore(tx).Get(ctx, req.ID)
+		if err != nil {
+			return err
+		}
+		resp.Sandbox = sandboxToProto(&sandbox)
+		return nil
+	}))
+}
+
+func (s *Service) List(ctx context.Context, req *api.ListSandboxesRequest) (*api.ListSandboxesResponse, error) {
+	var resp api.ListSandboxesResponse
+
+	return &resp, errdefs.ToGRPC(s.db.View(func(tx *bolt.Tx) error {
+		sandboxes, err := metadata.NewSandboxStore(tx).List(ctx, req.Filters...)
+		if err != nil {
+			return err
+		}
+		resp.Sandboxes = sandboxesToProto(sandboxes)
+		return nil
+	}))
+}
+
+// Create creates the namespaces of the sandbox, adds it to its network and
+// stores it
+func (s *Service) Create(ctx context.Context, req *api.CreateSandboxRequest) (*api.CreateSandboxResponse, error) {
+	sandbox := sandboxFromProto(&req.Sandbox)
+	if err := identifiers.Validate(sandbox.ID); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		_, err := metadata.NewSandboxStore(tx).Get(ctx, sandbox.ID)
+		if err == nil {
+			return errors.Wrapf(errdefs.ErrAlreadyExists, "sandbox %q", sandbox.ID)
+		}
+		if errdefs.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	var n *network.Network
+	if sandbox.Network != "" {
+		if n, err = network.FindNetwork(s.confDirs, sandbox.Network); err != nil {
+			return nil, errdefs.ToGRPC(err)
+		}
+	}
+	path := filepath.Join(s.state, namespace, sandbox.ID)
+	// the namespaces of a sandbox whose creation was interrupted
+	if _, err := os.Stat(path); err == nil {
+		s.teardown(ctx, n, sandbox.ID, path)
+	}
+	sandbox.Namespaces, err = s.setup(ctx, n, &sandbox, path)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+
+	var resp api.CreateSandboxResponse
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		created, err := metadata.NewSandboxStore(tx).Create(ctx, sandbox)
+		if err != nil {
+			return err
+		}
+		resp.Sandbox = sandboxToProto(&created)
+		return nil
+	}); err != nil {
+		if terr := s.teardown(ctx, n, sandbox.ID, path); terr != nil {
+			log.G(ctx).WithError(terr).WithField("id", sandbox.ID).Warn("failed to remove sandbox namespaces")
+		}
+		return nil, errdefs.ToGRPC(err)
+	}
+	if err := s.publisher.Publish(ctx, "/sandboxes/create", &eventsapi.SandboxCreate{
+		ID:      sandbox.ID,
+		Network: sandbox.Network,
+	}); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// setup creates the network and IPC namespaces of the sandbox in the
+// directory and adds the sandbox to the network n, if any
+func (s *Service) setup(ctx context.Context, n *network.Network, sandbox *sandboxes.Sandbox, path string) (_ map[string]string, err error) {
+	var (
+		netns = filepath.Join(path, "net")
+		ipcns = filepath.Join(path, "ipc")
+	)
+	defer func() {
+		if err != nil {
+			os.RemoveAll(path)
+		}
+	}()
+	if err := sys.NewNamespace(netns, unix.CLONE_NEWNET); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			sys.RemoveNamespace(netns)
+		}
+	}()
+	if err := sys.NewNamespace(ipcns, unix.CLONE_NEWIPC); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			sys.RemoveNamespace(ipcns)
+		}
+	}()
+	if n != nil {
+		result, err := s.cni.Add(ctx, n, sandbox.ID, netns)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to add sandbox to network %s", n.Name)
+		}
+		if sandbox.Labels == nil {
+			sandbox.Labels = make(map[string]string)
+		}
+		sandbox.Labels[network.IPsLabel] = strings.Join(result.Addresses(), ",")
+	}
+	return map[string]string{
+		string(specs.NetworkNamespace): netns,
+		string(specs.IPCNamespace):     ipcns,
+	}, nil
+}
+
+// teardown removes the sandbox from the network n, if any, and removes its
+// namespaces
+func (s *Service) teardown(ctx context.Context, n *network.Network, id, path string) error {
+	netns := filepath.Join(path, "net")
+	if n != nil {
+		if _, err := os.Stat(netns); err == nil {
+			if err := s.cni.Del(ctx, n, id, netns); err != nil {
+				return errors.Wrapf(err, "failed to remove sandbox from network %s", n.Name)
+			}
+		}
+	}
+	for _, ns := range []string{netns, filepath.Join(path, "ipc")} {
+		if err := sys.RemoveNamespace(ns); err != nil {
+			return err
+		}
+	}
+	return os.RemoveAll(path)
+}
+
+// Delete removes the sandbox from its network, removes its namespaces and
+// deletes it, failing while containers are in it
+func (s *Service) Delete(ctx context.Context, req *api.DeleteSandboxRequest) (*empty.Empty, error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	var sandbox sandboxes.Sandbox
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		sandbox, err = metadata.NewSandboxStore(tx).Get(ctx, req.ID)
+		return err
+	}); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	// the sandbox is deleted first so that containers are not created in it
+	// while its namespaces are removed
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		return metadata.NewSandboxStore(tx).Delete(ctx, req.ID)
+	}); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	var n *network.Network
+	if sandbox.Network != "" {
+		n, err = network.FindNetwork(s.confDirs, sandbox.Network)
+		switch {
+		case err == nil:
+		case errdefs.IsNotFound(err):
+			log.G(ctx).WithField("id", req.ID).Warnf("cni network %s was removed, removing the sandbox namespaces only", sandbox.Network)
+		default:
+			return nil, errdefs.ToGRPC(err)
+		}
+	}
+	if err := s.teardown(ctx, n, req.ID, filepath.Join(s.state, namespace, req.ID)); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if err := s.publisher.Publish(ctx, "/sandboxes/delete", &eventsapi.SandboxDelete{
+		ID: req.ID,
+	}); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
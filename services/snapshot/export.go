@@ -0,0 +1,150 @@
+package snapshot
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	snapshotapi "github.com/containerd/containerd/api/services/snapshot/v1"
+	"github.com/containerd/containerd/archive"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/snapshot"
+	"github.com/containerd/containerd/streaming"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+func (s *service) Export(er *snapshotapi.ExportSnapshotRequest, ss snapshotapi.Snapshots_ExportServer) error {
+	ctx := ss.Context()
+	log.G(ctx).WithField("key", er.Key).Debugf("Exporting snapshot")
+	sn, err := s.getSnapshotter(er.Snapshotter)
+	if err != nil {
+		return err
+	}
+	info, err := sn.Stat(ctx, er.Key)
+	if err != nil {
+		return errdefs.ToGRPC(err)
+	}
+	pinfo := fromInfo(info)
+	if err := ss.Send(&snapshotapi.ExportSnapshotResponse{Info: &pinfo}); err != nil {
+		return err
+	}
+	w := streaming.NewWriter(func(p []byte) error {
+		return ss.Send(&snapshotapi.ExportSnapshotResponse{Data: p})
+	})
+	err = withSnapshot(ctx, sn, info.Parent, func(lower string) error {
+		return withSnapshot(ctx, sn, info.Name, func(upper string) error {
+			return archive.WriteDiff(ctx, w, lower, upper)
+		})
+	})
+	if err != nil {
+		return errdefs.ToGRPC(err)
+	}
+	return w.Flush()
+}
+
+func (s *service) Import(ss snapshotapi.Snapshots_ImportServer) error {
+	ctx := ss.Context()
+	req, err := ss.Recv()
+	if err != nil {
+		return err
+	}
+	log.G(ctx).WithField("parent", req.Parent).WithField("name", req.Name).Debugf("Importing snapshot")
+	if req.Name == "" {
+		return errdefs.ToGRPCf(errdefs.ErrInvalidArgument, "name required")
+	}
+	sn, err := s.getSnapshotter(req.Snapshotter)
+	if err != nil {
+		return err
+	}
+	if _, err := sn.Stat(ctx, req.Name); err == nil {
+		return errdefs.ToGRPCf(errdefs.ErrAlreadyExists, "snapshot %q", req.Name)
+	}
+	if req.Parent != "" {
+		if _, err := sn.Stat(ctx, req.Parent); err != nil {
+			return errdefs.ToGRPCf(err, "parent %q", req.Parent)
+		}
+	}
+	key := fmt.Sprintf("import-%d-%s", time.Now().UnixNano(), req.Name)
+	if _, err := sn.Prepare(ctx, key, req.Parent); err != nil {
+		return errdefs.ToGRPC(err)
+	}
+	r := streaming.NewReader(req.Data, func() ([]byte, error) {
+		req, err := ss.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return req.Data, nil
+	})
+	if err := withSnapshot(ctx, sn, key, func(root string) error {
+		_, err := archive.Apply(ctx, root, r)
+		return err
+	}); err != nil {
+		if rerr := sn.Remove(ctx, key); rerr != nil {
+			log.G(ctx).WithError(rerr).WithField("key", key).Warn("failed to remove snapshot of failed import")
+		}
+		return errdefs.ToGRPC(err)
+	}
+	var opts []snapshot.Opt
+	if req.Labels != nil {
+		opts = append(opts, snapshot.WithLabels(req.Labels))
+	}
+	if err := sn.Commit(ctx, req.Name, key, opts...); err != nil {
+		if rerr := sn.Remove(ctx, key); rerr != nil {
+			log.G(ctx).WithError(rerr).WithField("key", key).Warn("failed to remove snapshot of failed import")
+		}
+		return errdefs.ToGRPC(err)
+	}
+	if err := s.publisher.Publish(ctx, "/snapshot/commit", &eventsapi.SnapshotCommit{
+		Key:  key,
+		Name: req.Name,
+	}); err != nil {
+		return err
+	}
+	info, err := sn.Stat(ctx, req.Name)
+	if err != nil {
+		return errdefs.ToGRPC(err)
+	}
+	return ss.SendAndClose(&snapshotapi.ImportSnapshotResponse{Info: fromInfo(info)})
+}
+
+// withSnapshot mounts the snapshot on a temporary directory and calls fn
+// with it. Committed snapshots are mounted through a view. An empty key
+// calls fn with an empty directory.
+func withSnapshot(ctx context.Context, sn snapshot.Snapshotter, key string, fn func(root string) error) error {
+	root, err := ioutil.TempDir("", "snapshot-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(root)
+	if key == "" {
+		return fn(root)
+	}
+	info, err := sn.Stat(ctx, key)
+	if err != nil {
+		return err
+	}
+	var mounts []mount.Mount
+	if info.Kind == snapshot.KindCommitted {
+		view := fmt.Sprintf("export-%d-%s", time.Now().UnixNano(), key)
+		if mounts, err = sn.View(ctx, view, key); err != nil {
+			return err
+		}
+		defer func() {
+			if err := sn.Remove(ctx, view); err != nil {
+				log.G(ctx).WithError(err).WithField("key", view).Warn("failed to remove export view")
+			}
+		}()
+	} else if mounts, err = sn.Mounts(ctx, key); err != nil {
+		return err
+	}
+	if err := mount.MountAll(mounts, root); err != nil {
+		return errors.Wrapf(err, "failed to mount snapshot %q", key)
+	}
+	defer mount.Unmount(root, 0)
+	return fn(root)
+}
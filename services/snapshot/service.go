@@ -2,11 +2,13 @@ package snapshot
 
 import (
 	gocontext "context"
+	"time"
 
 	"github.com/boltdb/bolt"
 	eventsapi "github.com/containerd/containerd/api/services/events/v1"
 	snapshotapi "github.com/containerd/containerd/api/services/snapshot/v1"
 	"github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/containers"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/events"
 	"github.com/containerd/containerd/log"
@@ -28,13 +30,28 @@ func init() {
 			plugin.SnapshotPlugin,
 			plugin.MetadataPlugin,
 		},
+		Config: &Config{
+			PrepareCacheTTL: "5m",
+		},
 		Init: newService,
 	})
 }
 
+// Config for the snapshots service
+type Config struct {
+	// PrepareCacheSize is the number of active snapshots, per namespace and
+	// snapshotter, that are prepared ahead of time on recently used parents.
+	// Zero disables the cache.
+	PrepareCacheSize int `toml:"prepare_cache_size,omitempty"`
+	// PrepareCacheTTL is how long a cached snapshot is kept before being
+	// removed if it is not claimed, for example "5m"
+	PrepareCacheTTL string `toml:"prepare_cache_ttl,omitempty"`
+}
+
 var empty = &protoempty.Empty{}
 
 type service struct {
+	db           *bolt.DB
 	snapshotters map[string]snapshot.Snapshotter
 	publisher    events.Publisher
 }
@@ -48,9 +65,21 @@ func newService(ic *plugin.InitContext) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	var (
+		cfg  = ic.Config.(*Config)
+		opts []metadata.SnapshotterOpt
+	)
+	if cfg.PrepareCacheSize > 0 {
+		ttl, err := time.ParseDuration(cfg.PrepareCacheTTL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid prepare_cache_ttl %q", cfg.PrepareCacheTTL)
+		}
+		opts = append(opts, metadata.WithPrepareCache(cfg.PrepareCacheSize, ttl))
+	}
 	snapshotters := make(map[string]snapshot.Snapshotter)
 	for name, sn := range rawSnapshotters {
-		snapshotters[name] = metadata.NewSnapshotter(md.(*bolt.DB), name, sn.(snapshot.Snapshotter))
+		snapshotters[name] = metadata.NewSnapshotter(md.(*bolt.DB), name, sn.(snapshot.Snapshotter), opts...)
+		go metadata.ReapPrepared(ic.Context, snapshotters[name])
 	}
 
 	if len(snapshotters) == 0 {
@@ -58,6 +87,7 @@ func newService(ic *plugin.InitContext) (interface{}, error) {
 	}
 
 	return &service{
+		db:           md.(*bolt.DB),
 		snapshotters: snapshotters,
 		publisher:    ic.Events,
 	}, nil
@@ -265,8 +295,63 @@ func (s *service) Usage(ctx context.Context, ur *snapshotapi.UsageRequest) (*sna
 	if err != nil {
 		return nil, errdefs.ToGRPC(err)
 	}
+	limit, err := sizeLimit(ctx, sn, ur.Key)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
 
-	return fromUsage(usage), nil
+	resp := fromUsage(usage)
+	resp.SizeLimit = limit
+	return resp, nil
+}
+
+func (s *service) ContainerUsage(ctx context.Context, ur *snapshotapi.ContainerUsageRequest) (*snapshotapi.ContainerUsageResponse, error) {
+	var list []containers.Container
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		list, err = metadata.NewContainerStore(tx).List(ctx, ur.Filters...)
+		return err
+	}); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+
+	var resp snapshotapi.ContainerUsageResponse
+	for _, c := range list {
+		sn, ok := s.snapshotters[c.Snapshotter]
+		if c.RootFS == "" || !ok {
+			continue
+		}
+		usage, err := sn.Usage(ctx, c.RootFS)
+		if err != nil {
+			// the rootfs may be removed before the container
+			if errdefs.IsNotFound(err) {
+				continue
+			}
+			return nil, errdefs.ToGRPC(errors.Wrapf(err, "container %q", c.ID))
+		}
+		limit, err := sizeLimit(ctx, sn, c.RootFS)
+		if err != nil && !errdefs.IsNotFound(err) {
+			return nil, errdefs.ToGRPC(errors.Wrapf(err, "container %q", c.ID))
+		}
+		resp.Usages = append(resp.Usages, snapshotapi.ContainerUsage{
+			ContainerID: c.ID,
+			Snapshotter: c.Snapshotter,
+			Key:         c.RootFS,
+			Size_:       usage.Size,
+			Inodes:      usage.Inodes,
+			SizeLimit:   limit,
+		})
+	}
+	return &resp, nil
+}
+
+// sizeLimit returns the size limit set in the labels of the snapshot
+func sizeLimit(ctx context.Context, sn snapshot.Snapshotter, key string) (int64, error) {
+	info, err := sn.Stat(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	return snapshot.SizeLimit(info)
 }
 
 func fromKind(kind snapshot.Kind) snapshotapi.Kind {
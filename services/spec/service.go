@@ -0,0 +1,181 @@
+// +build !windows
+
+package spec
+
+import (
+	"encoding/json"
+
+	"github.com/boltdb/bolt"
+	api "github.com/containerd/containerd/api/services/spec/v1"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/oci"
+	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/typeurl"
+	"github.com/opencontainers/image-spec/specs-go/v1"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	// registers the types of the runtime spec
+	_ "github.com/containerd/containerd/runtime"
+)
+
+var _ api.SpecServer = &Service{}
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.GRPCPlugin,
+		ID:   "spec",
+		Requires: []plugin.PluginType{
+			plugin.MetadataPlugin,
+			plugin.ContentPlugin,
+		},
+		Init: func(ic *plugin.InitContext) (interface{}, error) {
+			m, err := ic.Get(plugin.MetadataPlugin)
+			if err != nil {
+				return nil, err
+			}
+			s := &Service{
+				db: m.(*bolt.DB),
+			}
+			// specs are generated without images when there is no content
+			// store
+			if c, err := ic.Get(plugin.ContentPlugin); err == nil {
+				s.content = metadata.NewContentStore(s.db, c.(content.Store))
+			}
+			return s, nil
+		},
+	})
+}
+
+// Service generates specs from the images of the namespaces
+type Service struct {
+	db      *bolt.DB
+	content content.Store
+}
+
+func (s *Service) Register(server *grpc.Server) error {
+	api.RegisterSpecServer(server, s)
+	return nil
+}
+
+func (s *Service) Generate(ctx context.Context, r *api.GenerateSpecRequest) (*api.GenerateSpecResponse, error) {
+	var opts []oci.Opt
+	if r.Image != "" {
+		config, err := s.imageConfig(ctx, r.Image)
+		if err != nil {
+			return nil, errdefs.ToGRPC(err)
+		}
+		opts = append(opts, oci.WithImageConfig(config))
+	}
+	if len(r.Env) > 0 {
+		opts = append(opts, oci.WithEnv(r.Env))
+	}
+	if len(r.Args) > 0 {
+		opts = append(opts, oci.WithArgs(r.Args))
+	}
+	if len(r.Mounts) > 0 {
+		mounts := make([]specs.Mount, 0, len(r.Mounts))
+		for _, m := range r.Mounts {
+			mounts = append(mounts, specs.Mount{
+				Destination: m.Destination,
+				Type:        m.Type,
+				Source:      m.Source,
+				Options:     m.Options,
+			})
+		}
+		opts = append(opts, oci.WithMounts(mounts))
+	}
+	if r.User != "" {
+		opts = append(opts, oci.WithUser(r.User))
+	}
+	if len(r.Capabilities) > 0 {
+		opts = append(opts, oci.WithCapabilities(r.Capabilities))
+	}
+	if len(r.Seccomp) > 0 {
+		var profile specs.LinuxSeccomp
+		if err := json.Unmarshal(r.Seccomp, &profile); err != nil {
+			return nil, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrInvalidArgument, "invalid seccomp profile: %v", err))
+		}
+		opts = append(opts, oci.WithSeccomp(&profile))
+	}
+	opts = append(opts, func(spec *specs.Spec) error {
+		if r.Terminal {
+			spec.Process.Terminal = true
+			spec.Process.Env = append(spec.Process.Env, "TERM=xterm")
+		}
+		if r.Cwd != "" {
+			spec.Process.Cwd = r.Cwd
+		}
+		spec.Hostname = r.Hostname
+		return nil
+	})
+	spec, err := oci.Generate(opts...)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if err := oci.Validate(spec); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	any, err := typeurl.MarshalAny(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GenerateSpecResponse{Spec: any}, nil
+}
+
+func (s *Service) Validate(ctx context.Context, r *api.ValidateSpecRequest) (*api.ValidateSpecResponse, error) {
+	if r.Spec == nil {
+		return nil, errdefs.ToGRPC(errors.Wrap(errdefs.ErrInvalidArgument, "spec is required"))
+	}
+	v, err := typeurl.UnmarshalAny(r.Spec)
+	if err != nil {
+		return nil, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrInvalidArgument, "invalid spec: %v", err))
+	}
+	spec, ok := v.(*specs.Spec)
+	if !ok {
+		return nil, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrInvalidArgument, "%s is not a runtime spec", r.Spec.TypeUrl))
+	}
+	if err := oci.Validate(spec); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &api.ValidateSpecResponse{}, nil
+}
+
+// imageConfig returns the runtime configuration of the image of the
+// namespace of the context
+func (s *Service) imageConfig(ctx context.Context, name string) (v1.ImageConfig, error) {
+	if s.content == nil {
+		return v1.ImageConfig{}, errors.Wrap(errdefs.ErrUnavailable, "no content store to read images from")
+	}
+	var image images.Image
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		image, err = metadata.NewImageStore(tx).Get(ctx, name)
+		return err
+	}); err != nil {
+		return v1.ImageConfig{}, err
+	}
+	desc, err := image.Config(ctx, s.content)
+	if err != nil {
+		return v1.ImageConfig{}, err
+	}
+	switch desc.MediaType {
+	case v1.MediaTypeImageConfig, images.MediaTypeDockerSchema2Config:
+	default:
+		return v1.ImageConfig{}, errors.Wrapf(errdefs.ErrNotImplemented, "unknown image config media type %s", desc.MediaType)
+	}
+	p, err := content.ReadBlob(ctx, s.content, desc.Digest)
+	if err != nil {
+		return v1.ImageConfig{}, err
+	}
+	var config v1.Image
+	if err := json.Unmarshal(p, &config); err != nil {
+		return v1.ImageConfig{}, err
+	}
+	return config.Config, nil
+}
@@ -0,0 +1,234 @@
+// +build !windows
+
+package stdio
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	api "github.com/containerd/containerd/api/services/stdio/v1"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/logging"
+	"github.com/containerd/fifo"
+	"golang.org/x/net/context"
+)
+
+const (
+	// bufferSize is the size of the chunks of output sent to the clients
+	bufferSize = 32 << 10
+	// clientBuffer is the number of chunks of output held for an attached
+	// client before it is disconnected for falling behind
+	clientBuffer = 256
+)
+
+// fifoSet holds the fifos of the stdio of a process and broadcasts its
+// output to the attached clients
+type fifoSet struct {
+	dir    string
+	paths  [3]string
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	stdinMu sync.Mutex
+	stdin   io.WriteCloser
+	outputs []io.ReadCloser
+
+	// driver logs the output, through a stream for each output
+	driver  logging.Driver
+	streams []*logging.Stream
+
+	mu       sync.Mutex
+	clients  map[*client]struct{}
+	finished bool
+	// logged is closed once the output is fully logged
+	logged chan struct{}
+}
+
+// client is an attached client
+type client struct {
+	output chan *api.AttachResponse
+	// done is closed when the client stops sending requests
+	done chan struct{}
+	// dropped is set when the output is closed because the client fell
+	// behind, rather than because the output of the process ended
+	dropped bool
+}
+
+func newFifoSet(dir string, stdin, terminal bool, driver logging.Driver, maxLogLine int) (_ *fifoSet, err error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &fifoSet{
+		dir:     dir,
+		cancel:  cancel,
+		clients: make(map[*client]struct{}),
+		driver:  driver,
+		logged:  make(chan struct{}),
+	}
+	defer func() {
+		if err != nil {
+			s.close()
+		}
+	}()
+	if stdin {
+		s.paths[0] = filepath.Join(dir, "stdin")
+		if s.stdin, err = fifo.OpenFifo(ctx, s.paths[0], syscall.O_WRONLY|syscall.O_CREAT|syscall.O_NONBLOCK, 0700); err != nil {
+			return nil, err
+		}
+	}
+	streams := []api.Stream{api.StreamStdout, api.StreamStderr}
+	for i, name := range []string{"stdout", "stderr"} {
+		s.paths[i+1] = filepath.Join(dir, name)
+		f, err := fifo.OpenFifo(ctx, s.paths[i+1], syscall.O_RDONLY|syscall.O_CREAT|syscall.O_NONBLOCK, 0700)
+		if err != nil {
+			return nil, err
+		}
+		s.outputs = append(s.outputs, f)
+		// the output of a terminal is all written to stdout
+		if terminal && streams[i] == api.StreamStderr {
+			continue
+		}
+		var ls *logging.Stream
+		if driver != nil {
+			ls = logging.NewStream(driver, name, maxLogLine)
+			s.streams = append(s.streams, ls)
+		}
+		s.wg.Add(1)
+		go s.copy(streams[i], f, ls)
+	}
+	go s.finish()
+	return s, nil
+}
+
+// copy broadcasts the output read from the fifo to the attached clients
+// and logs it to the log stream, if any
+func (s *fifoSet) copy(stream api.Stream, r io.Reader, ls *logging.Stream) {
+	defer s.wg.Done()
+	buf := make([]byte, bufferSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 && ls != nil {
+			if _, lerr := ls.Write(buf[:n]); lerr != nil {
+				log.L.WithError(lerr).WithField("dir", s.dir).Warn("failed to log output, disabling the log driver")
+				ls = nil
+			}
+		}
+		if n > 0 {
+			data := make([]byte, n)
+			copy(data, buf[:n])
+			s.broadcast(&api.AttachResponse{
+				Stream: stream,
+				Data:   data,
+			})
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// broadcast sends the response to every attached client, through a buffer
+// of its own so that a client that falls behind neither blocks the process,
+// its logs nor the other clients, but is disconnected
+func (s *fifoSet) broadcast(resp *api.AttachResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for c := range s.clients {
+		select {
+		case c.output <- resp:
+		default:
+			delete(s.clients, c)
+			c.dropped = true
+			close(c.output)
+		}
+	}
+}
+
+// finish ends the output of the clients once the output of the process is
+// fully copied
+func (s *fifoSet) finish() {
+	s.wg.Wait()
+	if s.driver != nil {
+		for _, ls := range s.streams {
+			ls.Flush()
+		}
+		if err := s.driver.Close(); err != nil {
+			log.L.WithError(err).WithField("dir", s.dir).Warn("failed to close log driver")
+		}
+	}
+	close(s.logged)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.finished = true
+	for c := range s.clients {
+		close(c.output)
+	}
+}
+
+func (s *fifoSet) attach() *client {
+	c := &client{
+		output: make(chan *api.AttachResponse, clientBuffer),
+		done:   make(chan struct{}),
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.finished {
+		close(c.output)
+		return c
+	}
+	s.clients[c] = struct{}{}
+	return c
+}
+
+func (s *fifoSet) detach(c *client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.clients, c)
+}
+
+// isDropped returns whether the client was disconnected for falling behind
+func (s *fifoSet) isDropped(c *client) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return c.dropped
+}
+
+// write writes the stdin of the request to the process, closing its stdin
+// when requested
+func (s *fifoSet) write(r *api.AttachRequest) error {
+	s.stdinMu.Lock()
+	defer s.stdinMu.Unlock()
+	if s.stdin == nil {
+		return nil
+	}
+	if len(r.Stdin) > 0 {
+		if _, err := s.stdin.Write(r.Stdin); err != nil {
+			return err
+		}
+	}
+	if r.CloseStdin {
+		err := s.stdin.Close()
+		s.stdin = nil
+		return err
+	}
+	return nil
+}
+
+// close closes the fifos and removes them
+func (s *fifoSet) close() error {
+	s.cancel()
+	s.stdinMu.Lock()
+	if s.stdin != nil {
+		s.stdin.Close()
+		s.stdin = nil
+	}
+	s.stdinMu.Unlock()
+	for _, f := range s.outputs {
+		f.Close()
+	}
+	return os.RemoveAll(s.dir)
+}
@@ -0,0 +1,192 @@
+// +build !windows
+
+package stdio
+
+import (
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	api "github.com/containerd/containerd/api/services/stdio/v1"
+	"github.com/containerd/containerd/logging"
+)
+
+func TestFifoSet(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stdio-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	set, err := newFifoSet(dir, true, false, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer set.close()
+	c := set.attach()
+	defer set.detach(c)
+
+	// stand in for the process of the fifos
+	stdin, err := os.OpenFile(set.paths[0], os.O_RDONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stdin.Close()
+	stdout, err := os.OpenFile(set.paths[1], os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stderr, err := os.OpenFile(set.paths[2], os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := set.write(&api.AttachRequest{Stdin: []byte("input"), CloseStdin: true}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadAll(stdin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "input" {
+		t.Fatalf("expected stdin %q, got %q", "input", data)
+	}
+
+	if _, err := stdout.Write([]byte("output")); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case resp := <-c.output:
+		if resp.Stream != api.StreamStdout || string(resp.Data) != "output" {
+			t.Fatalf("unexpected response %v %q", resp.Stream, resp.Data)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("output not received")
+	}
+
+	// the output of the client ends with the output of the process
+	stdout.Close()
+	stderr.Close()
+	select {
+	case _, ok := <-c.output:
+		if ok {
+			t.Fatal("expected the output to be closed")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("output not closed")
+	}
+	if _, ok := <-set.attach().output; ok {
+		t.Fatal("expected the output of a late client to be closed")
+	}
+}
+
+func TestFifoSetDropsSlowClient(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stdio-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	set, err := newFifoSet(dir, false, false, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer set.close()
+	slow, fast := set.attach(), set.attach()
+	defer set.detach(slow)
+	defer set.detach(fast)
+
+	// the responses are broadcast directly as the fifos would split the
+	// output in chunks of any size
+	for i := 0; i <= clientBuffer; i++ {
+		set.broadcast(&api.AttachResponse{Stream: api.StreamStdout, Data: []byte("output")})
+		if _, ok := <-fast.output; !ok {
+			t.Fatalf("expected the fast client to receive response %d", i)
+		}
+	}
+	if !set.isDropped(slow) {
+		t.Fatal("expected the slow client to be dropped")
+	}
+	for i := 0; i < clientBuffer; i++ {
+		if _, ok := <-slow.output; !ok {
+			t.Fatalf("expected the buffered output of the slow client, got %d responses", i)
+		}
+	}
+	if _, ok := <-slow.output; ok {
+		t.Fatal("expected the output of the slow client to be closed")
+	}
+	if set.isDropped(fast) {
+		t.Fatal("expected the fast client to stay attached")
+	}
+}
+
+type recordingDriver struct {
+	mu     sync.Mutex
+	lines  []string
+	closed bool
+}
+
+func (d *recordingDriver) Log(m *logging.Message) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.lines = append(d.lines, m.Stream+" "+string(m.Line))
+	return nil
+}
+
+func (d *recordingDriver) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.closed = true
+	return nil
+}
+
+func TestFifoSetLogDriver(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stdio-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	driver := &recordingDriver{}
+	set, err := newFifoSet(dir, false, false, driver, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer set.close()
+
+	// the output is logged without any attached client
+	for i, data := range []string{"out\npartial", "err\n"} {
+		f, err := os.OpenFile(set.paths[i+1], os.O_WRONLY, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write([]byte(data)); err != nil {
+			t.Fatal(err)
+		}
+		f.Close()
+	}
+	c := set.attach()
+	for done := false; !done; {
+		select {
+		case _, ok := <-c.output:
+			done = !ok
+		case <-time.After(5 * time.Second):
+			t.Fatal("output not finished")
+		}
+	}
+
+	driver.mu.Lock()
+	defer driver.mu.Unlock()
+	sort.Strings(driver.lines)
+	expected := []string{"stderr err", "stdout out", "stdout partial"}
+	if strings.Join(driver.lines, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected %v logged, got %v", expected, driver.lines)
+	}
+	if !driver.closed {
+		t.Fatal("expected the driver to be closed")
+	}
+}
@@ -0,0 +1,334 @@
+// +build !windows
+
+package stdio
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	api "github.com/containerd/containerd/api/services/stdio/v1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/identifiers"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/logging"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/plugin"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+var _ api.StdioServer = &Service{}
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.GRPCPlugin,
+		ID:   "stdio",
+		Init: func(ic *plugin.InitContext) (interface{}, error) {
+			// the fifos of a previous daemon have no reader anymore
+			if err := os.RemoveAll(ic.State); err != nil {
+				return nil, err
+			}
+			if err := os.MkdirAll(ic.State, 0711); err != nil {
+				return nil, err
+			}
+			if err := os.MkdirAll(ic.Root, 0711); err != nil {
+				return nil, err
+			}
+			s := New(ic.Root, ic.State)
+			go s.watch(ic.Context, ic.Events)
+			return s, nil
+		},
+	})
+}
+
+// New returns a stdio service creating fifos under state and keeping the
+// files of log drivers under root
+func New(root, state string) *Service {
+	return &Service{
+		root:  root,
+		state: state,
+		sets:  make(map[string]*fifoSet),
+	}
+}
+
+// Service proxies the stdio of processes through fifos in its state
+// directory
+type Service struct {
+	root  string
+	state string
+
+	mu   sync.Mutex
+	sets map[string]*fifoSet
+}
+
+func (s *Service) Register(server *grpc.Server) error {
+	api.RegisterStdioServer(server, s)
+	return nil
+}
+
+func (s *Service) Create(ctx context.Context, r *api.CreateStdioRequest) (*api.CreateStdioResponse, error) {
+	key, dir, err := s.key(ctx, r.ID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.sets[key]; ok {
+		return nil, errdefs.ToGRPC(errors.Wrapf(errdefs.ErrAlreadyExists, "stdio %s", r.ID))
+	}
+	var driver logging.Driver
+	if r.LogDriver != "" {
+		namespace, _ := namespaces.Namespace(ctx)
+		if driver, err = logging.New(r.LogDriver, logging.Info{
+			Namespace: namespace,
+			ID:        r.ID,
+			Root:      filepath.Join(s.root, key),
+			Options:   r.LogOptions,
+		}); err != nil {
+			return nil, errdefs.ToGRPC(err)
+		}
+		if err := saveLogConfig(filepath.Join(s.root, key), logConfig{
+			Driver:  r.LogDriver,
+			Options: r.LogOptions,
+		}); err != nil {
+			driver.Close()
+			return nil, err
+		}
+	}
+	set, err := newFifoSet(dir, r.Stdin, r.Terminal, driver, int(r.MaxLogLine))
+	if err != nil {
+		if driver != nil {
+			driver.Close()
+		}
+		return nil, err
+	}
+	s.sets[key] = set
+	return &api.CreateStdioResponse{
+		Stdin:  set.paths[0],
+		Stdout: set.paths[1],
+		Stderr: set.paths[2],
+	}, nil
+}
+
+func (s *Service) Attach(ss api.Stdio_AttachServer) error {
+	ctx := ss.Context()
+	r, err := ss.Recv()
+	if err != nil {
+		return err
+	}
+	set, err := s.get(ctx, r.ID)
+	if err != nil {
+		return errdefs.ToGRPC(err)
+	}
+	c := set.attach()
+	defer set.detach(c)
+	// acknowledge the attach so that the client starts the process knowing
+	// that it receives all of its output
+	if err := ss.Send(&api.AttachResponse{}); err != nil {
+		return err
+	}
+	go func() {
+		defer close(c.done)
+		for {
+			if err := set.write(r); err != nil {
+				log.G(ctx).WithError(err).WithField("id", r.ID).Warn("failed to write stdin")
+				return
+			}
+			if r, err = ss.Recv(); err != nil {
+				return
+			}
+		}
+	}()
+	for {
+		select {
+		case resp, ok := <-c.output:
+			if !ok {
+				if set.isDropped(c) {
+					return grpc.Errorf(codes.ResourceExhausted, "client of %s fell behind its output", r.ID)
+				}
+				return nil
+			}
+			if err := ss.Send(resp); err != nil {
+				return err
+			}
+		case <-c.done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *Service) Delete(ctx context.Context, r *api.DeleteStdioRequest) (*empty.Empty, error) {
+	key, _, err := s.key(ctx, r.ID)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if err := s.remove(key); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *Service) Logs(r *api.LogsRequest, ss api.Stdio_LogsServer) error {
+	ctx := ss.Context()
+	key, _, err := s.key(ctx, r.ID)
+	if err != nil {
+		return errdefs.ToGRPC(err)
+	}
+	root := filepath.Join(s.root, key)
+	lc, err := loadLogConfig(root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return errdefs.ToGRPC(errors.Wrapf(errdefs.ErrNotFound, "no logs for %s", r.ID))
+		}
+		return err
+	}
+	namespace, _ := namespaces.Namespace(ctx)
+	reader, err := logging.NewReader(lc.Driver, logging.Info{
+		Namespace: namespace,
+		ID:        r.ID,
+		Root:      root,
+		Options:   lc.Options,
+	})
+	if err != nil {
+		return errdefs.ToGRPC(err)
+	}
+	config := logging.ReadConfig{
+		Tail:   int(r.Tail),
+		Follow: r.Follow,
+	}
+	if r.Since != nil {
+		config.Since = *r.Since
+	}
+	done := s.logged(key)
+	return errdefs.ToGRPC(reader.ReadLogs(ctx, config, done, func(m *logging.Message) error {
+		stream := api.StreamStdout
+		if m.Stream == "stderr" {
+			stream = api.StreamStderr
+		}
+		data := m.Line
+		if !m.Partial {
+			data = append(data, '\n')
+		}
+		return ss.Send(&api.LogsResponse{
+			Stream:    stream,
+			Data:      data,
+			Timestamp: m.Timestamp,
+		})
+	}))
+}
+
+// logged returns a channel closed once the output of the set of the key is
+// logged, closed already when there is no such set
+func (s *Service) logged(key string) <-chan struct{} {
+	s.mu.Lock()
+	set, ok := s.sets[key]
+	s.mu.Unlock()
+	if !ok {
+		done := make(chan struct{})
+		close(done)
+		return done
+	}
+	return set.logged
+}
+
+// logConfigFile records the log driver of a container in the directory of
+// its logs, so that they can be read back after its fifos are removed
+const logConfigFile = "log-config.json"
+
+type logConfig struct {
+	Driver  string            `json:"driver"`
+	Options map[string]string `json:"options,omitempty"`
+}
+
+func saveLogConfig(root string, lc logConfig) error {
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(lc)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(root, logConfigFile), data, 0600)
+}
+
+func loadLogConfig(root string) (logConfig, error) {
+	var lc logConfig
+	data, err := ioutil.ReadFile(filepath.Join(root, logConfigFile))
+	if err != nil {
+		return lc, err
+	}
+	return lc, json.Unmarshal(data, &lc)
+}
+
+// key returns the key and the directory of the set of fifos with the id in
+// the namespace of the context
+func (s *Service) key(ctx context.Context, id string) (string, string, error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	if err := identifiers.Validate(id); err != nil {
+		return "", "", err
+	}
+	return filepath.Join(namespace, id), filepath.Join(s.state, namespace, id), nil
+}
+
+func (s *Service) get(ctx context.Context, id string) (*fifoSet, error) {
+	key, _, err := s.key(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	set, ok := s.sets[key]
+	if !ok {
+		return nil, errors.Wrapf(errdefs.ErrNotFound, "stdio %s", id)
+	}
+	return set, nil
+}
+
+func (s *Service) remove(key string) error {
+	s.mu.Lock()
+	set, ok := s.sets[key]
+	delete(s.sets, key)
+	s.mu.Unlock()
+	if !ok {
+		return errors.Wrapf(errdefs.ErrNotFound, "stdio %s", key)
+	}
+	return set.close()
+}
+
+// watch removes the fifos and the logs of containers as they are deleted
+func (s *Service) watch(ctx context.Context, exchange *events.Exchange) {
+	eventq, errq := exchange.Subscribe(ctx, `topic=="/containers/delete"`)
+	for {
+		select {
+		case ev := <-eventq:
+			id, ok := ev.Field([]string{"event", "id"})
+			if !ok {
+				continue
+			}
+			key := filepath.Join(ev.Namespace, id)
+			if err := s.remove(key); err != nil && !errdefs.IsNotFound(err) {
+				log.G(ctx).WithError(err).WithField("id", id).Warn("failed to remove stdio fifos")
+			}
+			if err := os.RemoveAll(filepath.Join(s.root, key)); err != nil {
+				log.G(ctx).WithError(err).WithField("id", id).Warn("failed to remove container logs")
+			}
+		case err := <-errq:
+			if err != nil {
+				log.G(ctx).WithError(err).Error("stdio container subscription")
+			}
+			return
+		}
+	}
+}
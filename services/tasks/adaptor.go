@@ -0,0 +1,84 @@
+package tasks
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/containerd/containerd/api/types/task"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/filters"
+	"github.com/containerd/containerd/runtime"
+	"golang.org/x/net/context"
+)
+
+// taskAdaptor resolves the fields of a task for filtering. The task state and
+// the labels of its container are only looked up when a filter requires them.
+type taskAdaptor struct {
+	ctx       context.Context
+	s         *Service
+	namespace string
+	runtime   string
+	task      runtime.Task
+
+	process   *task.Process
+	container *containers.Container
+	err       error
+}
+
+func (a *taskAdaptor) Field(fieldpath []string) (string, bool) {
+	if len(fieldpath) == 0 {
+		return "", false
+	}
+	switch fieldpath[0] {
+	case "id":
+		return a.task.ID(), true
+	case "runtime":
+		return a.runtime, len(a.runtime) > 0
+	case "status":
+		p := a.state()
+		if p == nil {
+			return "", false
+		}
+		return strings.ToLower(p.Status.String()), true
+	case "pid":
+		p := a.state()
+		if p == nil {
+			return "", false
+		}
+		return strconv.FormatUint(uint64(p.Pid), 10), true
+	case "labels":
+		if len(fieldpath) < 2 {
+			return "", false
+		}
+		if a.container == nil {
+			c, err := a.s.getContainer(a.ctx, a.task.ID())
+			if err != nil {
+				return "", false
+			}
+			a.container = c
+		}
+		value, ok := a.container.Labels[strings.Join(fieldpath[1:], ".")]
+		return value, ok
+	}
+	return "", false
+}
+
+// state returns the task's process information from the state cache,
+// querying the runtime on a cache miss
+func (a *taskAdaptor) state() *task.Process {
+	if a.process != nil || a.err != nil {
+		return a.process
+	}
+	p, gen := a.s.states.get(a.namespace, a.task.ID())
+	if p == nil {
+		if p, a.err = processFromContainerd(a.ctx, a.task); a.err != nil {
+			return nil
+		}
+		a.s.translateProcess(a.ctx, a.task, p)
+		a.s.states.put(a.namespace, a.task.ID(), p, gen)
+	}
+	a.process = p
+	return p
+}
+
+var _ filters.Adaptor = &taskAdaptor{}
@@ -0,0 +1,133 @@
+package tasks
+
+import (
+	"container/heap"
+	"sync"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+// admission limits the number of task creations and process starts that can
+// be in flight at once. When the limit is reached callers are queued and
+// admitted in priority order, highest first, so that critical containers are
+// brought up before best-effort workloads during mass restarts.
+type admission struct {
+	mu      sync.Mutex
+	limit   int
+	active  int
+	seq     uint64
+	waiters waiterQueue
+}
+
+func newAdmission(limit int) *admission {
+	return &admission{
+		limit: limit,
+	}
+}
+
+// acquire blocks until the caller is admitted or the context is done. The
+// returned function must be called to release the slot. A limit of zero or
+// less disables admission control.
+func (a *admission) acquire(ctx context.Context, priority int32) (func(), error) {
+	if a.limit <= 0 {
+		return func() {}, nil
+	}
+	a.mu.Lock()
+	if a.active < a.limit && len(a.waiters) == 0 {
+		a.active++
+		a.mu.Unlock()
+		return a.release, nil
+	}
+	w := &waiter{
+		priority: priority,
+		seq:      a.seq,
+		ready:    make(chan struct{}),
+	}
+	a.seq++
+	heap.Push(&a.waiters, w)
+	a.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return a.release, nil
+	case <-ctx.Done():
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		select {
+		case <-w.ready:
+			// admitted while the context was being cancelled, pass the
+			// slot on to the next waiter
+			a.next()
+		default:
+			heap.Remove(&a.waiters, w.index)
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// waitCode returns the code of a call whose wait ended with the error of its
+// context, so that a deadline is not reported as a cancellation
+func waitCode(err error) codes.Code {
+	if err == context.DeadlineExceeded {
+		return codes.DeadlineExceeded
+	}
+	return codes.Canceled
+}
+
+func (a *admission) release() {
+	a.mu.Lock()
+	a.next()
+	a.mu.Unlock()
+}
+
+// next hands the caller's slot to the highest priority waiter, if any.
+// The lock must be held.
+func (a *admission) next() {
+	if len(a.waiters) == 0 {
+		a.active--
+		return
+	}
+	w := heap.Pop(&a.waiters).(*waiter)
+	close(w.ready)
+}
+
+type waiter struct {
+	priority int32
+	seq      uint64
+	index    int
+	ready    chan struct{}
+}
+
+type waiterQueue []*waiter
+
+func (q waiterQueue) Len() int {
+	return len(q)
+}
+
+func (q waiterQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority > q[j].priority
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q waiterQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *waiterQueue) Push(x interface{}) {
+	w := x.(*waiter)
+	w.index = len(*q)
+	*q = append(*q, w)
+}
+
+func (q *waiterQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	w := old[n-1]
+	*q = old[:n-1]
+	return w
+}
@@ -0,0 +1,104 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+func TestAdmissionUnlimited(t *testing.T) {
+	a := newAdmission(0)
+	for i := 0; i < 10; i++ {
+		if _, err := a.acquire(context.Background(), 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestAdmissionPriorityOrder(t *testing.T) {
+	a := newAdmission(1)
+	release, err := a.acquire(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		order      = make(chan int32, 3)
+		priorities = []int32{1, 10, 5}
+	)
+	for i, p := range priorities {
+		go func(p int32) {
+			r, err := a.acquire(context.Background(), p)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			order <- p
+			r()
+		}(p)
+		waitQueued(t, a, i+1)
+	}
+	release()
+	for _, expected := range []int32{10, 5, 1} {
+		if p := <-order; p != expected {
+			t.Fatalf("expected priority %d to be admitted but got %d", expected, p)
+		}
+	}
+}
+
+func TestAdmissionCancel(t *testing.T) {
+	a := newAdmission(1)
+	release, err := a.acquire(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	errC := make(chan error, 1)
+	go func() {
+		_, err := a.acquire(ctx, 0)
+		errC <- err
+	}()
+	waitQueued(t, a, 1)
+	cancel()
+	if err := <-errC; err != context.Canceled {
+		t.Fatalf("expected context.Canceled but got %v", err)
+	}
+	release()
+	if _, err := a.acquire(context.Background(), 0); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAdmissionDeadline(t *testing.T) {
+	a := newAdmission(1)
+	release, err := a.acquire(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = a.acquire(ctx, 0)
+	if code := waitCode(err); code != codes.DeadlineExceeded {
+		t.Fatalf("expected DeadlineExceeded but got %v", code)
+	}
+	if code := waitCode(context.Canceled); code != codes.Canceled {
+		t.Fatalf("expected Canceled but got %v", code)
+	}
+}
+
+// waitQueued waits until at least n callers are queued
+func waitQueued(t *testing.T, a *admission, n int) {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		a.mu.Lock()
+		queued := len(a.waiters)
+		a.mu.Unlock()
+		if queued >= n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("timeout waiting for queued request")
+}
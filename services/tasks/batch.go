@@ -0,0 +1,147 @@
+package tasks
+
+import (
+	"sync"
+
+	api "github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/containerd/containerd/authz"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/log"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// batchConcurrency is the number of items of a batch handled at once, task
+// creations and starts are further limited by the admission of the service
+const batchConcurrency = 16
+
+// the methods the items of batches are authorized as
+const (
+	createMethod = "/containerd.services.tasks.v1.Tasks/Create"
+	startMethod  = "/containerd.services.tasks.v1.Tasks/Start"
+	deleteMethod = "/containerd.services.tasks.v1.Tasks/Delete"
+)
+
+// BatchCreate creates the tasks of the request concurrently, starting each
+// when requested
+func (s *Service) BatchCreate(ctx context.Context, r *api.BatchCreateTasksRequest) (*api.BatchTasksResponse, error) {
+	results := runBatch(ctx, len(r.Tasks), func(ctx context.Context, i int, result *api.BatchTaskResult) error {
+		req := r.Tasks[i]
+		result.ContainerID = req.ContainerID
+		if err := authz.Authorize(ctx, createMethod, req); err != nil {
+			return err
+		}
+		if r.Start {
+			// the task is not created when it could not be started
+			if err := authz.Authorize(ctx, startMethod, &api.StartRequest{
+				ContainerID: req.ContainerID,
+				Priority:    req.Priority,
+			}); err != nil {
+				return err
+			}
+		}
+		created, err := s.Create(ctx, req)
+		if err != nil {
+			return err
+		}
+		result.Pid = created.Pid
+		if !r.Start {
+			return nil
+		}
+		started, err := s.Start(ctx, &api.StartRequest{
+			ContainerID: req.ContainerID,
+			Priority:    req.Priority,
+		})
+		if err != nil {
+			// delete the task so that a retry of the batch can create it again
+			if _, derr := s.Delete(ctx, &api.DeleteTaskRequest{ContainerID: req.ContainerID}); derr != nil {
+				log.G(ctx).WithError(derr).WithField("id", req.ContainerID).Error("failed to delete task after failed start")
+			}
+			return err
+		}
+		result.Pid = started.Pid
+		return nil
+	})
+	return &api.BatchTasksResponse{Results: results}, nil
+}
+
+// BatchStart starts the processes of the request concurrently
+func (s *Service) BatchStart(ctx context.Context, r *api.BatchStartRequest) (*api.BatchTasksResponse, error) {
+	results := runBatch(ctx, len(r.Processes), func(ctx context.Context, i int, result *api.BatchTaskResult) error {
+		req := r.Processes[i]
+		result.ContainerID, result.ExecID = req.ContainerID, req.ExecID
+		if err := authz.Authorize(ctx, startMethod, req); err != nil {
+			return err
+		}
+		started, err := s.Start(ctx, req)
+		if err != nil {
+			return err
+		}
+		result.Pid = started.Pid
+		return nil
+	})
+	return &api.BatchTasksResponse{Results: results}, nil
+}
+
+// BatchDelete deletes the tasks of the request concurrently
+func (s *Service) BatchDelete(ctx context.Context, r *api.BatchDeleteTasksRequest) (*api.BatchTasksResponse, error) {
+	results := runBatch(ctx, len(r.Tasks), func(ctx context.Context, i int, result *api.BatchTaskResult) error {
+		req := r.Tasks[i]
+		result.ContainerID = req.ContainerID
+		if err := authz.Authorize(ctx, deleteMethod, req); err != nil {
+			return err
+		}
+		deleted, err := s.Delete(ctx, req)
+		if err != nil {
+			return err
+		}
+		result.Pid = deleted.Pid
+		result.ExitStatus = deleted.ExitStatus
+		result.ExitedAt = deleted.ExitedAt
+		result.ExitReason = deleted.ExitReason
+		return nil
+	})
+	return &api.BatchTasksResponse{Results: results}, nil
+}
+
+// runBatch calls fn for the n items of a batch with at most batchConcurrency
+// calls at once and returns their results in order, with the error of each
+// call recorded as its GRPC code and message
+func runBatch(ctx context.Context, n int, fn func(context.Context, int, *api.BatchTaskResult) error) []*api.BatchTaskResult {
+	var (
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, batchConcurrency)
+		results = make([]*api.BatchTaskResult, n)
+	)
+	for i := range results {
+		results[i] = &api.BatchTaskResult{}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := callBatch(ctx, i, results[i], fn); err != nil {
+				err = errdefs.ToGRPC(err)
+				results[i].Code = uint32(grpc.Code(err))
+				results[i].Error = grpc.ErrorDesc(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	return results
+}
+
+// callBatch calls fn for an item, returning an internal error if it panics
+// as the recovery of the server does not cover the goroutines of a batch
+func callBatch(ctx context.Context, i int, result *api.BatchTaskResult, fn func(context.Context, int, *api.BatchTaskResult) error) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			log.G(ctx).WithField("id", result.ContainerID).Errorf("panic in batch item: %v", v)
+			err = grpc.Errorf(codes.Internal, "panic: %v", v)
+		}
+	}()
+	return fn(ctx, i, result)
+}
@@ -0,0 +1,82 @@
+package tasks
+
+import (
+	"sync/atomic"
+	"testing"
+
+	api "github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/containerd/containerd/authz"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRunBatch(t *testing.T) {
+	results := runBatch(context.Background(), 3, func(ctx context.Context, i int, r *api.BatchTaskResult) error {
+		r.Pid = uint32(i + 1)
+		switch i {
+		case 1:
+			return errors.Wrap(errdefs.ErrNotFound, "container 1")
+		case 2:
+			panic("boom")
+		}
+		return nil
+	})
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	for i, r := range results {
+		if r.Pid != uint32(i+1) {
+			t.Errorf("result %d has pid %d", i, r.Pid)
+		}
+	}
+	if results[0].Code != 0 || results[0].Error != "" {
+		t.Errorf("unexpected error for result 0: %v %q", results[0].Code, results[0].Error)
+	}
+	if codes.Code(results[1].Code) != codes.NotFound {
+		t.Errorf("expected not found for result 1, got %v", codes.Code(results[1].Code))
+	}
+	if codes.Code(results[2].Code) != codes.Internal {
+		t.Errorf("expected internal error for result 2, got %v", codes.Code(results[2].Code))
+	}
+}
+
+func TestRunBatchConcurrency(t *testing.T) {
+	var running, max int32
+	runBatch(context.Background(), batchConcurrency*4, func(ctx context.Context, i int, r *api.BatchTaskResult) error {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+		atomic.AddInt32(&running, -1)
+		return nil
+	})
+	if max > batchConcurrency {
+		t.Fatalf("expected at most %d concurrent calls, got %d", batchConcurrency, max)
+	}
+}
+
+func TestBatchAuthorizesItems(t *testing.T) {
+	var methods []string
+	ctx := authz.WithAuthorize(context.Background(), func(ctx context.Context, method string, req interface{}) error {
+		methods = append(methods, method)
+		return errors.Wrap(errdefs.ErrPermissionDenied, method)
+	})
+	s := &Service{}
+	resp, err := s.BatchDelete(ctx, &api.BatchDeleteTasksRequest{
+		Tasks: []*api.DeleteTaskRequest{{ContainerID: "web"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if codes.Code(resp.Results[0].Code) != codes.PermissionDenied {
+		t.Errorf("expected the item to be denied, got %v %q", codes.Code(resp.Results[0].Code), resp.Results[0].Error)
+	}
+	if len(methods) != 1 || methods[0] != deleteMethod {
+		t.Errorf("expected the item to be authorized as a delete, got %v", methods)
+	}
+}
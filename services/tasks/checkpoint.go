@@ -0,0 +1,108 @@
+package tasks
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/containerd/containerd/archive"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/linux/runcopts"
+	"github.com/containerd/containerd/typeurl"
+	"github.com/gogo/protobuf/types"
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// parentLink is the link criu creates in the images of a checkpoint taken
+// relative to a parent checkpoint, pointing to the images of the parent
+const parentLink = "parent"
+
+// applyCheckpoint extracts the checkpoint blob into a directory named after
+// its digest in dir and returns the path of the directory. The parents the
+// checkpoint is linked to are extracted next to it, so that the link of each
+// checkpoint resolves to the images of its parent.
+func applyCheckpoint(ctx context.Context, store content.Provider, dir string, dgst digest.Digest) (string, error) {
+	var path string
+	for {
+		if err := dgst.Validate(); err != nil {
+			return "", errors.Wrapf(errdefs.ErrInvalidArgument, "checkpoint %q: %v", dgst, err)
+		}
+		target := filepath.Join(dir, dgst.Hex())
+		if path == "" {
+			path = target
+		}
+		if _, err := os.Stat(target); err == nil {
+			// the checkpoints link back to one already extracted
+			return "", errors.Wrapf(errdefs.ErrInvalidArgument, "checkpoint %s is its own parent", dgst)
+		}
+		if err := os.Mkdir(target, 0700); err != nil {
+			return "", err
+		}
+		ra, err := store.ReaderAt(ctx, dgst)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to read checkpoint %s", dgst)
+		}
+		_, err = archive.Apply(ctx, target, content.NewReader(ra))
+		ra.Close()
+		if err != nil {
+			return "", err
+		}
+		link, err := os.Readlink(filepath.Join(target, parentLink))
+		if err != nil {
+			if os.IsNotExist(err) {
+				return path, nil
+			}
+			return "", err
+		}
+		dgst = digest.NewDigestFromHex(string(digest.SHA256), filepath.Base(link))
+		// only links to a sibling are followed, so that criu does not read
+		// images from outside of dir
+		if link != parentPath(dgst) {
+			return "", errors.Wrapf(errdefs.ErrInvalidArgument, "checkpoint links to parent %q", link)
+		}
+	}
+}
+
+// parentPath returns the path of the parent checkpoint, relative to the
+// images of the checkpoint
+func parentPath(parent digest.Digest) string {
+	return filepath.Join("..", parent.Hex())
+}
+
+// checkpointOf returns the digest of the checkpoint images in the checkpoint
+// index, which holds either a checkpoint or a pre-dump
+func checkpointOf(ctx context.Context, store content.Provider, index digest.Digest) (digest.Digest, error) {
+	p, err := content.ReadBlob(ctx, store, index)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read parent checkpoint %s", index)
+	}
+	var idx ocispec.Index
+	if err := json.Unmarshal(p, &idx); err != nil {
+		return "", errors.Wrapf(errdefs.ErrInvalidArgument, "failed to decode parent checkpoint %s: %v", index, err)
+	}
+	for _, m := range idx.Manifests {
+		switch m.MediaType {
+		case images.MediaTypeContainerd1Checkpoint, images.MediaTypeContainerd1CheckpointPreDump:
+			return m.Digest, nil
+		}
+	}
+	return "", errors.Wrapf(errdefs.ErrNotFound, "no checkpoint in parent checkpoint %s", index)
+}
+
+// isPreDump returns true if the checkpoint options request a pre-dump
+func isPreDump(options *types.Any) bool {
+	if options == nil {
+		return false
+	}
+	v, err := typeurl.UnmarshalAny(options)
+	if err != nil {
+		return false
+	}
+	opts, ok := v.(*runcopts.CheckpointOptions)
+	return ok && opts.PreDump
+}
@@ -0,0 +1,105 @@
+package tasks
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containerd/containerd/archive"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/content/local"
+	"github.com/containerd/containerd/errdefs"
+	digest "github.com/opencontainers/go-digest"
+	"golang.org/x/net/context"
+)
+
+// writeCheckpoint stores a checkpoint holding the file and linking to the
+// parent, if any
+func writeCheckpoint(ctx context.Context, t *testing.T, cs content.Store, name, parent string) digest.Digest {
+	dir, err := ioutil.TempDir("", "checkpoint-images-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(name), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if parent != "" {
+		if err := os.Symlink(parent, filepath.Join(dir, parentLink)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tar := archive.Diff(ctx, "", dir)
+	defer tar.Close()
+	p, err := ioutil.ReadAll(tar)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dgst := digest.FromBytes(p)
+	if err := content.WriteBlob(ctx, cs, name, bytes.NewReader(p), int64(len(p)), dgst); err != nil {
+		t.Fatal(err)
+	}
+	return dgst
+}
+
+func TestApplyCheckpoint(t *testing.T) {
+	root, err := ioutil.TempDir("", "apply-checkpoint-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	cs, err := local.NewStore(filepath.Join(root, "content"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	first := writeCheckpoint(ctx, t, cs, "pages-1.img", "")
+	second := writeCheckpoint(ctx, t, cs, "pages-2.img", parentPath(first))
+	last := writeCheckpoint(ctx, t, cs, "pages-3.img", parentPath(second))
+
+	dir := filepath.Join(root, "restore")
+	if err := os.Mkdir(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	path, err := applyCheckpoint(ctx, cs, dir, last)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the images of each checkpoint are reached through the parent links
+	for _, name := range []string{
+		"pages-3.img",
+		filepath.Join(parentLink, "pages-2.img"),
+		filepath.Join(parentLink, parentLink, "pages-1.img"),
+	} {
+		if _, err := os.Stat(filepath.Join(path, name)); err != nil {
+			t.Errorf("expected %s in the applied checkpoint: %v", name, err)
+		}
+	}
+}
+
+func TestApplyCheckpointOutsideParent(t *testing.T) {
+	root, err := ioutil.TempDir("", "apply-checkpoint-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	cs, err := local.NewStore(filepath.Join(root, "content"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	first := writeCheckpoint(ctx, t, cs, "pages-1.img", "")
+	last := writeCheckpoint(ctx, t, cs, "pages-2.img", filepath.Join("..", "..", first.Hex()))
+
+	dir := filepath.Join(root, "restore")
+	if err := os.Mkdir(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := applyCheckpoint(ctx, cs, dir, last); !errdefs.IsInvalidArgument(err) {
+		t.Fatalf("expected invalid argument for a parent outside of the directory, got %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package tasks
+
+import (
+	"context"
+
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/api/types/task"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/containerd/typeurl"
+)
+
+// translateExit translates the exit status reported by the task of the
+// container with the translator of its runtime, if any
+func translateExit(ctx context.Context, rt runtime.Runtime, id string, status uint32) (uint32, string) {
+	if t, ok := rt.(runtime.ExitTranslator); ok {
+		return t.TranslateExit(ctx, id, status)
+	}
+	return status, ""
+}
+
+// translateProcess translates the exit status of a stopped process of the
+// task
+func (s *Service) translateProcess(ctx context.Context, t runtime.Task, p *task.Process) {
+	if p.Status != task.StatusStopped {
+		return
+	}
+	rt, err := s.getRuntime(t.Info().Runtime)
+	if err != nil {
+		return
+	}
+	p.ExitStatus, p.ExitReason = translateExit(ctx, rt, t.ID(), p.ExitStatus)
+}
+
+// translateExitEvent translates the exit status of the exit and delete
+// events of tasks, which shims and runtimes publish with the status reported
+// by the task
+func (s *Service) translateExitEvent(ctx context.Context, envelope *eventsapi.Envelope) error {
+	v, err := typeurl.UnmarshalAny(envelope.Event)
+	if err != nil {
+		return err
+	}
+	var (
+		id     string
+		status *uint32
+		reason *string
+	)
+	switch e := v.(type) {
+	case *eventsapi.TaskExit:
+		id, status, reason = e.ContainerID, &e.ExitStatus, &e.ExitReason
+	case *eventsapi.TaskDelete:
+		id, status, reason = e.ContainerID, &e.ExitStatus, &e.ExitReason
+	default:
+		return nil
+	}
+	ctx = namespaces.WithNamespace(ctx, envelope.Namespace)
+	container, err := s.getContainer(ctx, id)
+	if err != nil {
+		return err
+	}
+	rt, err := s.getRuntime(container.Runtime.Name)
+	if err != nil {
+		return err
+	}
+	translated, r := translateExit(ctx, rt, id, *status)
+	if translated == *status && r == "" {
+		return nil
+	}
+	*status, *reason = translated, r
+	envelope.Event, err = typeurl.MarshalAny(v)
+	return err
+}
@@ -0,0 +1,157 @@
+package tasks
+
+import (
+	"sort"
+
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/hooks"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/containerd/typeurl"
+	"github.com/gogo/protobuf/types"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// sortedHooks returns the hook plugins in the order of their ids
+func sortedHooks(plugins map[string]interface{}) ([]hooks.Hook, error) {
+	ids := make([]string, 0, len(plugins))
+	for id := range plugins {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	var out []hooks.Hook
+	for _, id := range ids {
+		h, ok := plugins[id].(hooks.Hook)
+		if !ok {
+			return nil, errors.Errorf("hook plugin %q is not a hook", id)
+		}
+		out = append(out, h)
+	}
+	return out, nil
+}
+
+// hookRequest returns the request of the hooks for the container at the
+// point
+func hookRequest(point hooks.Point, container *containers.Container) (*hooks.Request, error) {
+	r := &hooks.Request{
+		Point:       point,
+		ContainerID: container.ID,
+		Runtime:     container.Runtime.Name,
+		Labels:      container.Labels,
+		SandboxID:   container.SandboxID,
+	}
+	if container.Spec == nil {
+		return r, nil
+	}
+	v, err := typeurl.UnmarshalAny(container.Spec)
+	if err != nil {
+		return nil, err
+	}
+	spec, ok := v.(*specs.Spec)
+	if !ok {
+		return nil, errors.Errorf("spec of container %s is not a runtime spec", container.ID)
+	}
+	r.Spec = spec
+	return r, nil
+}
+
+// preCreate invokes the hooks before the creation of the task of the
+// container, returning the spec adjusted by them. An error of a hook vetoes
+// the creation.
+func (s *Service) preCreate(ctx context.Context, r *hooks.Request) (*types.Any, error) {
+	for _, h := range s.hooks {
+		a, err := h.Invoke(ctx, r)
+		if err != nil {
+			return nil, errors.Wrap(err, "pre-create hook vetoed the creation")
+		}
+		if a == nil || r.Spec == nil {
+			continue
+		}
+		if err := a.Apply(r.Spec); err != nil {
+			return nil, err
+		}
+	}
+	return typeurl.MarshalAny(r.Spec)
+}
+
+// preStart invokes the hooks before the start of the task, updating its
+// resources with the resources of their adjustments. An error of a hook
+// vetoes the start.
+func (s *Service) preStart(ctx context.Context, r *hooks.Request, t runtime.Task) error {
+	var resources *specs.LinuxResources
+	for _, h := range s.hooks {
+		a, err := h.Invoke(ctx, r)
+		if err != nil {
+			return errors.Wrap(err, "pre-start hook vetoed the start")
+		}
+		if a == nil || a.Resources == nil {
+			continue
+		}
+		if resources == nil {
+			resources = &specs.LinuxResources{}
+		}
+		hooks.MergeResources(resources, a.Resources)
+	}
+	if resources == nil {
+		return nil
+	}
+	any, err := typeurl.MarshalAny(resources)
+	if err != nil {
+		return err
+	}
+	return t.Update(ctx, any)
+}
+
+// notifyHooks invokes the hooks at a point after which the container cannot
+// be adjusted or vetoed, logging their errors
+func (s *Service) notifyHooks(ctx context.Context, r *hooks.Request) {
+	for _, h := range s.hooks {
+		if _, err := h.Invoke(ctx, r); err != nil {
+			log.G(ctx).WithError(err).WithField("id", r.ContainerID).Warnf("%s hook failed", r.Point)
+		}
+	}
+}
+
+// watchStops invokes the hooks at the post-stop point as the tasks exit
+func (s *Service) watchStops(ctx context.Context, exchange *events.Exchange) {
+	eventq, errq := exchange.Subscribe(ctx, `topic=="`+runtime.TaskExitEventTopic+`"`)
+	for {
+		select {
+		case ev := <-eventq:
+			v, err := typeurl.UnmarshalAny(ev.Event)
+			if err != nil {
+				continue
+			}
+			e, ok := v.(*eventsapi.TaskExit)
+			if !ok || e.ID != e.ContainerID {
+				continue
+			}
+			go s.postStop(namespaces.WithNamespace(ctx, ev.Namespace), e)
+		case err := <-errq:
+			if err != nil {
+				log.G(ctx).WithError(err).Error("post-stop hooks subscription")
+			}
+			return
+		}
+	}
+}
+
+func (s *Service) postStop(ctx context.Context, e *eventsapi.TaskExit) {
+	container, err := s.getContainer(ctx, e.ContainerID)
+	if err != nil {
+		log.G(ctx).WithError(err).WithField("id", e.ContainerID).Warn("failed to get container for post-stop hooks")
+		return
+	}
+	r, err := hookRequest(hooks.PostStop, container)
+	if err != nil {
+		log.G(ctx).WithError(err).WithField("id", e.ContainerID).Warn("failed to get spec for post-stop hooks")
+		return
+	}
+	r.Pid, r.ExitStatus = e.Pid, e.ExitStatus
+	s.notifyHooks(ctx, r)
+}
@@ -0,0 +1,65 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/hooks"
+	"github.com/containerd/containerd/typeurl"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+type hookFunc func(context.Context, *hooks.Request) (*hooks.Adjustment, error)
+
+func (f hookFunc) Invoke(ctx context.Context, r *hooks.Request) (*hooks.Adjustment, error) {
+	return f(ctx, r)
+}
+
+func TestPreCreate(t *testing.T) {
+	var seen []string
+	s := &Service{
+		hooks: []hooks.Hook{
+			hookFunc(func(ctx context.Context, r *hooks.Request) (*hooks.Adjustment, error) {
+				return &hooks.Adjustment{Env: []string{"A=1"}}, nil
+			}),
+			hookFunc(func(ctx context.Context, r *hooks.Request) (*hooks.Adjustment, error) {
+				seen = r.Spec.Process.Env
+				return &hooks.Adjustment{Env: []string{"B=2"}}, nil
+			}),
+		},
+	}
+	r := &hooks.Request{
+		Point: hooks.PreCreate,
+		Spec:  &specs.Spec{Process: &specs.Process{}},
+	}
+	any, err := s.preCreate(context.Background(), r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(seen) != 1 || seen[0] != "A=1" {
+		t.Fatalf("second hook did not see the adjustment of the first: %v", seen)
+	}
+	v, err := typeurl.UnmarshalAny(any)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env := v.(*specs.Spec).Process.Env; len(env) != 2 || env[1] != "B=2" {
+		t.Fatalf("unexpected env %v", env)
+	}
+}
+
+func TestPreCreateVeto(t *testing.T) {
+	s := &Service{
+		hooks: []hooks.Hook{
+			hookFunc(func(ctx context.Context, r *hooks.Request) (*hooks.Adjustment, error) {
+				return nil, errors.Wrap(errdefs.ErrPermissionDenied, "policy")
+			}),
+		},
+	}
+	_, err := s.preCreate(context.Background(), &hooks.Request{Point: hooks.PreCreate, Spec: &specs.Spec{}})
+	if !errdefs.IsPermissionDenied(err) {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+}
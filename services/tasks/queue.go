@@ -0,0 +1,82 @@
+package tasks
+
+import (
+	"path"
+	"sync"
+
+	"golang.org/x/net/context"
+)
+
+// defaultMaxConcurrentOperations bounds the operations running at once when
+// the configuration sets no bound
+const defaultMaxConcurrentOperations = 64
+
+// taskQueue serializes the operations changing the task of each container,
+// running them in the order they were queued, while a bounded pool of
+// workers runs the operations of different containers in parallel
+type taskQueue struct {
+	mu sync.Mutex
+	// tails holds the last operation queued on each container
+	tails   map[string]*queuedOp
+	workers chan struct{}
+}
+
+type queuedOp struct {
+	done chan struct{}
+}
+
+func newTaskQueue(workers int) *taskQueue {
+	if workers <= 0 {
+		workers = defaultMaxConcurrentOperations
+	}
+	return &taskQueue{
+		tails:   make(map[string]*queuedOp),
+		workers: make(chan struct{}, workers),
+	}
+}
+
+// acquire blocks until the operations queued before the caller's on the
+// container are done and a worker is free, or the context is done. The
+// returned function must be called once the operation is done.
+func (q *taskQueue) acquire(ctx context.Context, namespace, id string) (func(), error) {
+	key := path.Join(namespace, id)
+	op := &queuedOp{
+		done: make(chan struct{}),
+	}
+	q.mu.Lock()
+	prev := q.tails[key]
+	q.tails[key] = op
+	q.mu.Unlock()
+
+	finish := func() {
+		q.mu.Lock()
+		if q.tails[key] == op {
+			delete(q.tails, key)
+		}
+		q.mu.Unlock()
+		close(op.done)
+	}
+	if prev != nil {
+		select {
+		case <-prev.done:
+		case <-ctx.Done():
+			// the operation keeps its place until those before it are
+			// done so that the ones queued after it still wait for them
+			go func() {
+				<-prev.done
+				finish()
+			}()
+			return nil, ctx.Err()
+		}
+	}
+	select {
+	case q.workers <- struct{}{}:
+	case <-ctx.Done():
+		finish()
+		return nil, ctx.Err()
+	}
+	return func() {
+		<-q.workers
+		finish()
+	}, nil
+}
@@ -0,0 +1,135 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+func TestTaskQueueOrder(t *testing.T) {
+	q := newTaskQueue(0)
+	release, err := q.acquire(context.Background(), "default", "redis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		order = make(chan int, 3)
+		last  = tail(q, "default/redis")
+	)
+	for i := 0; i < 3; i++ {
+		go func(i int) {
+			r, err := q.acquire(context.Background(), "default", "redis")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			order <- i
+			r()
+		}(i)
+		last = waitTail(t, q, "default/redis", last)
+	}
+	// operations on other containers are not held up
+	other, err := q.acquire(context.Background(), "default", "nginx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other()
+	select {
+	case i := <-order:
+		t.Fatalf("operation %d ran before the previous one was released", i)
+	default:
+	}
+	release()
+	for expected := 0; expected < 3; expected++ {
+		if i := <-order; i != expected {
+			t.Fatalf("expected operation %d to run but got %d", expected, i)
+		}
+	}
+}
+
+func TestTaskQueueWorkers(t *testing.T) {
+	q := newTaskQueue(1)
+	release, err := q.acquire(context.Background(), "default", "redis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := q.acquire(ctx, "default", "nginx"); err != context.DeadlineExceeded {
+		t.Fatalf("expected the operation to wait for a worker but got %v", err)
+	}
+	release()
+	other, err := q.acquire(context.Background(), "default", "nginx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other()
+}
+
+func TestTaskQueueCancel(t *testing.T) {
+	q := newTaskQueue(0)
+	release, err := q.acquire(context.Background(), "default", "redis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	var (
+		errC = make(chan error, 1)
+		last = tail(q, "default/redis")
+	)
+	go func() {
+		_, err := q.acquire(ctx, "default", "redis")
+		errC <- err
+	}()
+	last = waitTail(t, q, "default/redis", last)
+	acquired := make(chan func())
+	go func() {
+		r, err := q.acquire(context.Background(), "default", "redis")
+		if err != nil {
+			t.Error(err)
+		}
+		acquired <- r
+	}()
+	waitTail(t, q, "default/redis", last)
+	cancel()
+	if err := <-errC; err != context.Canceled {
+		t.Fatalf("expected context.Canceled but got %v", err)
+	}
+	// the cancelled operation keeps the next one behind the first
+	select {
+	case <-acquired:
+		t.Fatal("operation ran before the previous one was released")
+	case <-time.After(50 * time.Millisecond):
+	}
+	release()
+	(<-acquired)()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.tails) != 0 {
+		t.Fatalf("expected no queued operations but got %d", len(q.tails))
+	}
+}
+
+// waitTail waits until an operation is queued on the container after the
+// given one, returning the new last operation
+func waitTail(t *testing.T, q *taskQueue, key string, last *queuedOp) *queuedOp {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		q.mu.Lock()
+		tail := q.tails[key]
+		q.mu.Unlock()
+		if tail != last {
+			return tail
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("timed out waiting for an operation to be queued")
+	return nil
+}
+
+func tail(q *taskQueue, key string) *queuedOp {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.tails[key]
+}
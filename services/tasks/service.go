@@ -7,6 +7,8 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+	"syscall"
 
 	"github.com/boltdb/bolt"
 	api "github.com/containerd/containerd/api/services/tasks/v1"
@@ -17,12 +19,19 @@ import (
 	"github.com/containerd/containerd/content"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/filters"
+	"github.com/containerd/containerd/hooks"
 	"github.com/containerd/containerd/images"
 	"github.com/containerd/containerd/log"
 	"github.com/containerd/containerd/metadata"
 	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/restart"
 	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/containerd/snapshot"
+	"github.com/containerd/containerd/tracing"
+	metrics "github.com/docker/go-metrics"
 	google_protobuf "github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
@@ -43,11 +52,34 @@ func init() {
 			plugin.RuntimePlugin,
 			plugin.MetadataPlugin,
 			plugin.ContentPlugin,
+			plugin.SnapshotPlugin,
+			plugin.HookPlugin,
+			plugin.DiffPlugin,
 		},
-		Init: New,
+		Config: &Config{},
+		Init:   New,
 	})
 }
 
+// Config for the tasks service
+type Config struct {
+	// MaxConcurrentStarts limits the number of task creations and process
+	// starts handled at once, queueing the rest by request priority.
+	// Zero disables the limit.
+	MaxConcurrentStarts int `toml:"max_concurrent_starts,omitempty"`
+	// MaxConcurrentOperations bounds the number of operations on tasks
+	// handled at once across all containers. The operations on the task of
+	// a single container are always handled one at a time, in order.
+	// Zero uses a default of 64.
+	MaxConcurrentOperations int `toml:"max_concurrent_operations,omitempty"`
+	// CheckpointDir is the directory where checkpoint images are written
+	// before they are stored in the content store. Defaults to a directory
+	// under the plugin's root.
+	CheckpointDir string `toml:"checkpoint_dir,omitempty"`
+	// Watchdog reports tasks stuck creating, starting or deleting
+	Watchdog WatchdogConfig `toml:"watchdog"`
+}
+
 func New(ic *plugin.InitContext) (interface{}, error) {
 	rt, err := ic.GetAll(plugin.RuntimePlugin)
 	if err != nil {
@@ -61,18 +93,70 @@ func New(ic *plugin.InitContext) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	rawSnapshotters, err := ic.GetAll(plugin.SnapshotPlugin)
+	if err != nil {
+		return nil, err
+	}
+	// the hooks are optional so that none are invoked when no hook plugin
+	// is loaded
+	rawHooks, _ := ic.GetAll(plugin.HookPlugin)
+	hs, err := sortedHooks(rawHooks)
+	if err != nil {
+		return nil, err
+	}
+	cfg := ic.Config.(*Config)
+	checkpointDir := cfg.CheckpointDir
+	if checkpointDir == "" {
+		checkpointDir = filepath.Join(ic.Root, "checkpoints")
+	}
+	if err := os.MkdirAll(checkpointDir, 0700); err != nil {
+		return nil, err
+	}
 	cs := metadata.NewContentStore(m.(*bolt.DB), ct.(content.Store))
 	runtimes := make(map[string]runtime.Runtime)
 	for _, rr := range rt {
 		r := rr.(runtime.Runtime)
 		runtimes[r.ID()] = r
 	}
-	return &Service{
-		runtimes:  runtimes,
-		db:        m.(*bolt.DB),
-		store:     cs,
-		publisher: ic.Events,
-	}, nil
+	snapshotters := make(map[string]snapshot.Snapshotter)
+	for name, sn := range rawSnapshotters {
+		snapshotters[name] = metadata.NewSnapshotter(m.(*bolt.DB), name, sn.(snapshot.Snapshotter))
+	}
+	ns := metrics.NewNamespace("containerd", "tasks", nil)
+	w, err := newWatchdog(cfg.Watchdog, ic.Events,
+		ns.NewLabeledCounter("stuck", "The number of tasks stuck in a transition by transition", "state"))
+	if err != nil {
+		return nil, err
+	}
+	metrics.Register(ns)
+	states := newStateCache()
+	go states.watch(ic.Context, ic.Events)
+	s := &Service{
+		runtimes:      runtimes,
+		db:            m.(*bolt.DB),
+		store:         cs,
+		publisher:     ic.Events,
+		admission:     newAdmission(cfg.MaxConcurrentStarts),
+		queue:         newTaskQueue(cfg.MaxConcurrentOperations),
+		shared:        newSharedNamespaces(filepath.Join(ic.State, "namespaces")),
+		states:        states,
+		watchdog:      w,
+		checkpointDir: checkpointDir,
+		snapshotters:  snapshotters,
+		hooks:         hs,
+	}
+	// containers can only be snapshotted when a differ is loaded
+	if d, err := ic.Get(plugin.DiffPlugin); err == nil {
+		s.differ = d.(plugin.Differ)
+	}
+	if len(hs) > 0 {
+		go s.watchStops(ic.Context, ic.Events)
+	}
+	// report the exits of the workloads of runtimes translating the exit
+	// statuses of their tasks
+	ic.Events.Transform(runtime.TaskExitEventTopic, s.translateExitEvent)
+	ic.Events.Transform(runtime.TaskDeleteEventTopic, s.translateExitEvent)
+	return s, nil
 }
 
 type Service struct {
@@ -80,6 +164,20 @@ type Service struct {
 	db        *bolt.DB
 	store     content.Store
 	publisher events.Publisher
+	admission *admission
+	queue     *taskQueue
+	// shared keeps the namespaces joined by tasks of other containers
+	shared   *sharedNamespaces
+	states   *stateCache
+	watchdog *watchdog
+	// checkpointDir holds checkpoint images while they are being written
+	checkpointDir string
+	snapshotters  map[string]snapshot.Snapshotter
+	// hooks are invoked in order at the points of the lifecycle of
+	// containers
+	hooks []hooks.Hook
+	// differ commits the writable layers of containers to snapshots
+	differ plugin.Differ
 }
 
 func (s *Service) Register(server *grpc.Server) error {
@@ -88,26 +186,34 @@ func (s *Service) Register(server *grpc.Server) error {
 }
 
 func (s *Service) Create(ctx context.Context, r *api.CreateTaskRequest) (*api.CreateTaskResponse, error) {
-	var (
-		checkpointPath string
-		err            error
-	)
+	dequeue, err := s.serialize(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	defer dequeue()
+	release, err := s.admission.acquire(ctx, r.Priority)
+	if err != nil {
+		return nil, grpc.Errorf(waitCode(err), "task create not admitted: %v", err)
+	}
+	defer release()
+	defer s.invalidate(ctx, r.ContainerID)
+
+	if err := validateCreateRequest(r); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	var checkpointPath string
 	if r.Checkpoint != nil {
-		checkpointPath, err = ioutil.TempDir("", "ctrd-checkpoint")
-		if err != nil {
-			return nil, err
-		}
 		if r.Checkpoint.MediaType != images.MediaTypeContainerd1Checkpoint {
 			return nil, fmt.Errorf("unsupported checkpoint type %q", r.Checkpoint.MediaType)
 		}
-		reader, err := s.store.ReaderAt(ctx, r.Checkpoint.Digest)
+		dir, err := ioutil.TempDir("", "ctrd-checkpoint")
 		if err != nil {
 			return nil, err
 		}
-		_, err = archive.Apply(ctx, checkpointPath, content.NewReader(reader))
-		reader.Close()
-		if err != nil {
-			return nil, err
+		// the images are only read while the task is restored
+		defer os.RemoveAll(dir)
+		if checkpointPath, err = applyCheckpoint(ctx, s.store, dir, r.Checkpoint.Digest); err != nil {
+			return nil, errdefs.ToGRPC(err)
 		}
 	}
 
@@ -133,18 +239,65 @@ func (s *Service) Create(ctx context.Context, r *api.CreateTaskRequest) (*api.Cr
 			Options: m.Options,
 		})
 	}
+	// resolve the mounts of the container's snapshot when the client did
+	// not provide the rootfs
+	if len(opts.Rootfs) == 0 && container.RootFS != "" {
+		if opts.Rootfs, err = s.rootfsMounts(ctx, container); err != nil {
+			return nil, errdefs.ToGRPC(err)
+		}
+	}
+	if err := validateCreate(opts.Spec, opts.Rootfs); err != nil {
+		return nil, errdefs.ToGRPC(errdefs.WithDetail(err, types.ErrorDetail{ContainerID: container.ID}))
+	}
 	runtime, err := s.getRuntime(container.Runtime.Name)
 	if err != nil {
-		return nil, err
+		return nil, errdefs.ToGRPC(errdefs.WithDetail(err, types.ErrorDetail{ContainerID: container.ID}))
+	}
+	opts.Runtime = container.Runtime.Name
+	if len(s.hooks) > 0 {
+		hr, err := hookRequest(hooks.PreCreate, container)
+		if err != nil {
+			return nil, errdefs.ToGRPC(err)
+		}
+		hr.Rootfs = opts.Rootfs
+		if opts.Spec, err = s.preCreate(ctx, hr); err != nil {
+			return nil, errdefs.ToGRPC(err)
+		}
+	}
+	if len(r.JoinNamespaces) > 0 {
+		if opts.Spec, err = s.joinNamespaces(ctx, r, opts.Spec); err != nil {
+			return nil, errdefs.ToGRPC(errdefs.WithDetail(err, types.ErrorDetail{ContainerID: container.ID}))
+		}
 	}
-	c, err := runtime.Create(ctx, r.ContainerID, opts)
+	ctx, done := s.watchdog.watch(ctx, creating, r.ContainerID, "", nil)
+	defer done()
+	span, sctx := tracing.StartSpan(ctx, "runtime.create")
+	c, err := runtime.Create(sctx, r.ContainerID, opts)
+	span.Finish(err)
 	if err != nil {
-		return nil, errors.Wrap(err, "runtime create failed")
+		if len(r.JoinNamespaces) > 0 {
+			s.releaseNamespaces(ctx, r.ContainerID)
+		}
+		return nil, errdefs.ToGRPC(errdefs.WithDetail(errors.Wrap(err, "runtime create failed"), types.ErrorDetail{
+			ContainerID: container.ID,
+			Runtime:     container.Runtime.Name,
+		}))
+	}
+	if _, ok := container.Labels[restart.StoppedLabel]; ok {
+		if err := s.setStopped(ctx, r.ContainerID, false); err != nil {
+			log.G(ctx).WithError(err).Error("failed to clear the stop of the previous task")
+		}
 	}
 	state, err := c.State(ctx)
 	if err != nil {
 		log.G(ctx).Error(err)
 	}
+	if len(s.hooks) > 0 {
+		if hr, err := hookRequest(hooks.PostCreate, container); err == nil {
+			hr.Rootfs, hr.Pid = opts.Rootfs, state.Pid
+			s.notifyHooks(ctx, hr)
+		}
+	}
 
 	return &api.CreateTaskResponse{
 		ContainerID: r.ContainerID,
@@ -153,22 +306,51 @@ func (s *Service) Create(ctx context.Context, r *api.CreateTaskRequest) (*api.Cr
 }
 
 func (s *Service) Start(ctx context.Context, r *api.StartRequest) (*api.StartResponse, error) {
-	t, err := s.getTask(ctx, r.ContainerID)
+	dequeue, err := s.serialize(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	defer dequeue()
+	release, err := s.admission.acquire(ctx, r.Priority)
+	if err != nil {
+		return nil, grpc.Errorf(waitCode(err), "process start not admitted: %v", err)
+	}
+	defer release()
+	defer s.invalidate(ctx, r.ContainerID)
+
+	container, err := s.getContainer(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.getTaskFromContainer(ctx, container)
 	if err != nil {
 		return nil, err
 	}
 	p := runtime.Process(t)
 	if r.ExecID != "" {
 		if p, err = t.Process(ctx, r.ExecID); err != nil {
-			return nil, err
+			return nil, taskError(err, t, r.ExecID)
+		}
+	} else if len(s.hooks) > 0 {
+		hr, err := hookRequest(hooks.PreStart, container)
+		if err != nil {
+			return nil, errdefs.ToGRPC(err)
+		}
+		if state, err := t.State(ctx); err == nil {
+			hr.Pid = state.Pid
+		}
+		if err := s.preStart(ctx, hr, t); err != nil {
+			return nil, errdefs.ToGRPC(err)
 		}
 	}
+	ctx, done := s.watchdog.watch(ctx, starting, r.ContainerID, r.ExecID, t)
+	defer done()
 	if err := p.Start(ctx); err != nil {
-		return nil, err
+		return nil, taskError(err, t, r.ExecID)
 	}
 	state, err := p.State(ctx)
 	if err != nil {
-		return nil, err
+		return nil, taskError(err, t, r.ExecID)
 	}
 	return &api.StartResponse{
 		Pid: state.Pid,
@@ -176,39 +358,86 @@ func (s *Service) Start(ctx context.Context, r *api.StartRequest) (*api.StartRes
 }
 
 func (s *Service) Delete(ctx context.Context, r *api.DeleteTaskRequest) (*api.DeleteResponse, error) {
-	t, err := s.getTask(ctx, r.ContainerID)
+	dequeue, err := s.serialize(ctx, r.ContainerID)
 	if err != nil {
 		return nil, err
 	}
-	runtime, err := s.getRuntime(t.Info().Runtime)
+	defer dequeue()
+	defer s.invalidate(ctx, r.ContainerID)
+
+	container, err := s.getContainer(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.getTaskFromContainer(ctx, container)
 	if err != nil {
 		return nil, err
 	}
-	exit, err := runtime.Delete(ctx, t)
+	runtime, err := s.getRuntime(t.Info().Runtime)
 	if err != nil {
 		return nil, err
 	}
+	ctx, done := s.watchdog.watch(ctx, deleting, r.ContainerID, "", t)
+	defer done()
+	exit, err := s.deleteTask(ctx, runtime, t, r.Force)
+	if err != nil {
+		return nil, taskError(err, t, "")
+	}
+	s.releaseNamespaces(ctx, r.ContainerID)
+	if len(s.hooks) > 0 {
+		if hr, err := hookRequest(hooks.PostDelete, container); err == nil {
+			hr.Pid, hr.ExitStatus = exit.Pid, exit.Status
+			s.notifyHooks(ctx, hr)
+		}
+	}
+	status, reason := translateExit(ctx, runtime, r.ContainerID, exit.Status)
 	return &api.DeleteResponse{
-		ExitStatus: exit.Status,
+		ExitStatus: status,
 		ExitedAt:   exit.Timestamp,
 		Pid:        exit.Pid,
+		ExitReason: reason,
 	}, nil
 }
 
+// deleteTask deletes the task, killing its processes first when forced.
+// Runtimes that can purge the state of tasks they fail to delete do so.
+func (s *Service) deleteTask(ctx context.Context, r runtime.Runtime, t runtime.Task, force bool) (*runtime.Exit, error) {
+	if !force {
+		return r.Delete(ctx, t)
+	}
+	if fd, ok := r.(runtime.ForceDeleter); ok {
+		return fd.ForceDelete(ctx, t)
+	}
+	if err := t.Kill(ctx, uint32(syscall.SIGKILL), true); err != nil && !errdefs.IsNotFound(err) {
+		log.G(ctx).WithError(err).WithField("id", t.ID()).Warn("failed to kill task")
+	}
+	return r.Delete(ctx, t)
+}
+
 func (s *Service) DeleteProcess(ctx context.Context, r *api.DeleteProcessRequest) (*api.DeleteResponse, error) {
+	dequeue, err := s.serialize(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	defer dequeue()
 	t, err := s.getTask(ctx, r.ContainerID)
 	if err != nil {
 		return nil, err
 	}
 	exit, err := t.DeleteProcess(ctx, r.ExecID)
 	if err != nil {
-		return nil, err
+		return nil, taskError(err, t, r.ExecID)
+	}
+	status, reason := exit.Status, ""
+	if runtime, err := s.getRuntime(t.Info().Runtime); err == nil {
+		status, reason = translateExit(ctx, runtime, r.ContainerID, exit.Status)
 	}
 	return &api.DeleteResponse{
 		ID:         r.ExecID,
-		ExitStatus: exit.Status,
+		ExitStatus: status,
 		ExitedAt:   exit.Timestamp,
 		Pid:        exit.Pid,
+		ExitReason: reason,
 	}, nil
 }
 
@@ -229,6 +458,8 @@ func processFromContainerd(ctx context.Context, p runtime.Process) (*task.Proces
 		status = task.StatusPaused
 	case runtime.PausingStatus:
 		status = task.StatusPausing
+	case runtime.BrokenStatus:
+		status = task.StatusBroken
 	default:
 		log.G(ctx).WithField("status", state.Status).Warn("unknown status")
 	}
@@ -242,6 +473,7 @@ func processFromContainerd(ctx context.Context, p runtime.Process) (*task.Proces
 		Terminal:   state.Terminal,
 		ExitStatus: state.ExitStatus,
 		ExitedAt:   state.ExitedAt,
+		StartedAt:  state.StartedAt,
 	}, nil
 }
 
@@ -253,56 +485,107 @@ func (s *Service) Get(ctx context.Context, r *api.GetRequest) (*api.GetResponse,
 	p := runtime.Process(task)
 	if r.ExecID != "" {
 		if p, err = task.Process(ctx, r.ExecID); err != nil {
-			return nil, err
+			return nil, taskError(err, task, r.ExecID)
 		}
 	}
 	t, err := processFromContainerd(ctx, p)
 	if err != nil {
-		return nil, err
+		return nil, taskError(err, task, r.ExecID)
 	}
+	s.translateProcess(ctx, task, t)
 	return &api.GetResponse{
 		Process: t,
 	}, nil
 }
 
 func (s *Service) List(ctx context.Context, r *api.ListTasksRequest) (*api.ListTasksResponse, error) {
-	resp := &api.ListTasksResponse{}
-	for _, r := range s.runtimes {
-		tasks, err := r.Tasks(ctx)
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	filter, err := filters.Parse(r.Filter)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid filter %q: %v", r.Filter, err)
+	}
+	var candidates []*taskAdaptor
+	for name, rt := range s.runtimes {
+		tasks, err := rt.Tasks(ctx)
 		if err != nil {
 			return nil, err
 		}
 		for _, t := range tasks {
-			tt, err := processFromContainerd(ctx, t)
-			if err != nil {
-				return nil, err
+			// tasks are ordered by id so the last id returned can be used
+			// as the token for the next page
+			if r.PageToken != "" && t.ID() <= r.PageToken {
+				continue
 			}
-			resp.Tasks = append(resp.Tasks, tt)
+			candidates = append(candidates, &taskAdaptor{
+				ctx:       ctx,
+				s:         s,
+				namespace: namespace,
+				runtime:   name,
+				task:      t,
+			})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].task.ID() < candidates[j].task.ID()
+	})
+	candidates = s.dedupe(ctx, candidates)
+	resp := &api.ListTasksResponse{}
+	for i, c := range candidates {
+		if !filter.Match(c) {
+			continue
+		}
+		p := c.state()
+		if c.err != nil {
+			return nil, c.err
+		}
+		resp.Tasks = append(resp.Tasks, p)
+		if r.PageSize > 0 && uint32(len(resp.Tasks)) == r.PageSize {
+			if i < len(candidates)-1 {
+				resp.NextPageToken = c.task.ID()
+			}
+			break
 		}
 	}
 	return resp, nil
 }
 
 func (s *Service) Pause(ctx context.Context, r *api.PauseTaskRequest) (*google_protobuf.Empty, error) {
+	dequeue, err := s.serialize(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	defer dequeue()
+	defer s.invalidate(ctx, r.ContainerID)
+
 	t, err := s.getTask(ctx, r.ContainerID)
 	if err != nil {
 		return nil, err
 	}
 	err = t.Pause(ctx)
 	if err != nil {
-		return nil, err
+		return nil, taskError(err, t, "")
 	}
 	return empty, nil
 }
 
 func (s *Service) Resume(ctx context.Context, r *api.ResumeTaskRequest) (*google_protobuf.Empty, error) {
+	dequeue, err := s.serialize(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	defer dequeue()
+	defer s.invalidate(ctx, r.ContainerID)
+
 	t, err := s.getTask(ctx, r.ContainerID)
 	if err != nil {
 		return nil, err
 	}
 	err = t.Resume(ctx)
 	if err != nil {
-		return nil, err
+		return nil, taskError(err, t, "")
 	}
 	return empty, nil
 }
@@ -315,11 +598,17 @@ func (s *Service) Kill(ctx context.Context, r *api.KillRequest) (*google_protobu
 	p := runtime.Process(t)
 	if r.ExecID != "" {
 		if p, err = t.Process(ctx, r.ExecID); err != nil {
-			return nil, err
+			return nil, taskError(err, t, r.ExecID)
+		}
+	} else if restart.Stops(r.Signal) {
+		// recorded before the signal is sent so that the restart monitor
+		// sees it once the task exits
+		if err := s.setStopped(ctx, r.ContainerID, true); err != nil {
+			return nil, errdefs.ToGRPC(err)
 		}
 	}
 	if err := p.Kill(ctx, r.Signal, r.All); err != nil {
-		return nil, errdefs.ToGRPC(err)
+		return nil, taskError(err, t, r.ExecID)
 	}
 	return empty, nil
 }
@@ -329,12 +618,24 @@ func (s *Service) ListPids(ctx context.Context, r *api.ListPidsRequest) (*api.Li
 	if err != nil {
 		return nil, err
 	}
-	pids, err := t.Pids(ctx)
+	processList, err := t.Pids(ctx)
 	if err != nil {
-		return nil, err
+		return nil, taskError(err, t, "")
+	}
+	var (
+		pids      []uint32
+		processes []*task.ProcessInfo
+	)
+	for _, p := range processList {
+		pids = append(pids, p.Pid)
+		processes = append(processes, &task.ProcessInfo{
+			Pid:    p.Pid,
+			ExecID: p.ExecID,
+		})
 	}
 	return &api.ListPidsResponse{
-		Pids: pids,
+		Pids:      pids,
+		Processes: processes,
 	}, nil
 }
 
@@ -342,6 +643,11 @@ func (s *Service) Exec(ctx context.Context, r *api.ExecProcessRequest) (*google_
 	if r.ExecID == "" {
 		return nil, grpc.Errorf(codes.InvalidArgument, "exec id cannot be empty")
 	}
+	dequeue, err := s.serialize(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	defer dequeue()
 	t, err := s.getTask(ctx, r.ContainerID)
 	if err != nil {
 		return nil, err
@@ -355,7 +661,7 @@ func (s *Service) Exec(ctx context.Context, r *api.ExecProcessRequest) (*google_
 			Terminal: r.Terminal,
 		},
 	}); err != nil {
-		return nil, err
+		return nil, taskError(err, t, r.ExecID)
 	}
 	return empty, nil
 }
@@ -368,14 +674,14 @@ func (s *Service) ResizePty(ctx context.Context, r *api.ResizePtyRequest) (*goog
 	p := runtime.Process(t)
 	if r.ExecID != "" {
 		if p, err = t.Process(ctx, r.ExecID); err != nil {
-			return nil, err
+			return nil, taskError(err, t, r.ExecID)
 		}
 	}
 	if err := p.ResizePty(ctx, runtime.ConsoleSize{
 		Width:  r.Width,
 		Height: r.Height,
 	}); err != nil {
-		return nil, err
+		return nil, taskError(err, t, r.ExecID)
 	}
 	return empty, nil
 }
@@ -388,18 +694,23 @@ func (s *Service) CloseIO(ctx context.Context, r *api.CloseIORequest) (*google_p
 	p := runtime.Process(t)
 	if r.ExecID != "" {
 		if p, err = t.Process(ctx, r.ExecID); err != nil {
-			return nil, err
+			return nil, taskError(err, t, r.ExecID)
 		}
 	}
 	if r.Stdin {
 		if err := p.CloseIO(ctx); err != nil {
-			return nil, err
+			return nil, taskError(err, t, r.ExecID)
 		}
 	}
 	return empty, nil
 }
 
 func (s *Service) Checkpoint(ctx context.Context, r *api.CheckpointTaskRequest) (*api.CheckpointTaskResponse, error) {
+	dequeue, err := s.serialize(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	defer dequeue()
 	container, err := s.getContainer(ctx, r.ContainerID)
 	if err != nil {
 		return nil, err
@@ -408,17 +719,42 @@ func (s *Service) Checkpoint(ctx context.Context, r *api.CheckpointTaskRequest)
 	if err != nil {
 		return nil, err
 	}
-	image, err := ioutil.TempDir("", "ctd-checkpoint")
+	dir, err := ioutil.TempDir(s.checkpointDir, "ctd-checkpoint")
 	if err != nil {
 		return nil, err
 	}
-	defer os.RemoveAll(image)
-	if err := t.Checkpoint(ctx, image, r.Options); err != nil {
+	defer os.RemoveAll(dir)
+	image := filepath.Join(dir, "checkpoint")
+	if err := os.Mkdir(image, 0700); err != nil {
 		return nil, err
 	}
+	if r.ParentCheckpoint != "" {
+		pc, ok := t.(runtime.ParentCheckpointer)
+		if !ok {
+			return nil, grpc.Errorf(codes.Unimplemented, "runtime %s does not checkpoint relative to a parent", container.Runtime.Name)
+		}
+		parent, err := checkpointOf(ctx, s.store, r.ParentCheckpoint)
+		if err != nil {
+			return nil, errdefs.ToGRPC(err)
+		}
+		// the images of the parent are extracted next to the image, where
+		// the checkpoint links to them
+		if _, err := applyCheckpoint(ctx, s.store, dir, parent); err != nil {
+			return nil, errdefs.ToGRPC(err)
+		}
+		if err := pc.CheckpointWithParent(ctx, image, parentPath(parent), r.Options); err != nil {
+			return nil, taskError(err, t, "")
+		}
+	} else if err := t.Checkpoint(ctx, image, r.Options); err != nil {
+		return nil, taskError(err, t, "")
+	}
+	mediaType := images.MediaTypeContainerd1Checkpoint
+	if isPreDump(r.Options) {
+		mediaType = images.MediaTypeContainerd1CheckpointPreDump
+	}
 	// write checkpoint to the content store
 	tar := archive.Diff(ctx, "", image)
-	cp, err := s.writeContent(ctx, images.MediaTypeContainerd1Checkpoint, image, tar)
+	cp, err := s.writeContent(ctx, mediaType, image, tar)
 	// close tar first after write
 	if err := tar.Close(); err != nil {
 		return nil, err
@@ -445,16 +781,59 @@ func (s *Service) Checkpoint(ctx context.Context, r *api.CheckpointTaskRequest)
 }
 
 func (s *Service) Update(ctx context.Context, r *api.UpdateTaskRequest) (*google_protobuf.Empty, error) {
+	dequeue, err := s.serialize(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	defer dequeue()
 	t, err := s.getTask(ctx, r.ContainerID)
 	if err != nil {
 		return nil, err
 	}
 	if err := t.Update(ctx, r.Resources); err != nil {
-		return nil, err
+		return nil, taskError(err, t, "")
 	}
 	return empty, nil
 }
 
+// defaultShimLogBytes bounds the shim log returned when the request sets no
+// bound
+const defaultShimLogBytes = 1 << 20
+
+func (s *Service) ShimLog(ctx context.Context, r *api.ShimLogRequest) (*api.ShimLogResponse, error) {
+	if r.MaxBytes < 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "max bytes must not be negative")
+	}
+	container, err := s.getContainer(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.getTaskFromContainer(ctx, container)
+	if err != nil {
+		return nil, err
+	}
+	rt, err := s.getRuntime(container.Runtime.Name)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	sl, ok := rt.(runtime.ShimLogger)
+	if !ok {
+		return nil, grpc.Errorf(codes.Unimplemented, "runtime %s does not log shims", container.Runtime.Name)
+	}
+	max := r.MaxBytes
+	if max == 0 {
+		max = defaultShimLogBytes
+	}
+	data, truncated, err := sl.ShimLog(ctx, t, max)
+	if err != nil {
+		return nil, taskError(err, t, "")
+	}
+	return &api.ShimLogResponse{
+		Data:      data,
+		Truncated: truncated,
+	}, nil
+}
+
 func (s *Service) writeContent(ctx context.Context, mediaType, ref string, r io.Reader) (*types.Descriptor, error) {
 	writer, err := s.store.Writer(ctx, ref, 0, "")
 	if err != nil {
@@ -475,6 +854,104 @@ func (s *Service) writeContent(ctx context.Context, mediaType, ref string, r io.
 	}, nil
 }
 
+func (s *Service) Inspect(ctx context.Context, r *api.InspectTaskRequest) (*api.InspectTaskResponse, error) {
+	container, err := s.getContainer(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.getTask(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	p, err := processFromContainerd(ctx, t)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	s.translateProcess(ctx, t, p)
+	info := t.Info()
+	resp := &api.InspectTaskResponse{
+		Process:   p,
+		Runtime:   info.Runtime,
+		Bundle:    info.Bundle,
+		Spec:      container.Spec,
+		Options:   info.Options,
+		Labels:    container.Labels,
+		Image:     container.Image,
+		CreatedAt: container.CreatedAt,
+		UpdatedAt: container.UpdatedAt,
+	}
+	pids, err := t.Pids(ctx)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	seen := make(map[string]struct{})
+	for _, pid := range pids {
+		if pid.ExecID == "" {
+			continue
+		}
+		if _, ok := seen[pid.ExecID]; ok {
+			continue
+		}
+		seen[pid.ExecID] = struct{}{}
+		ep, err := s.execProcess(ctx, t, pid.ExecID)
+		if err != nil {
+			// the process may have been deleted since the pids were listed
+			if errdefs.IsNotFound(err) {
+				continue
+			}
+			return nil, errdefs.ToGRPC(err)
+		}
+		resp.Processes = append(resp.Processes, ep)
+	}
+	sort.Slice(resp.Processes, func(i, j int) bool {
+		return resp.Processes[i].ID < resp.Processes[j].ID
+	})
+	for _, m := range info.Rootfs {
+		resp.Rootfs = append(resp.Rootfs, &types.Mount{
+			Type:    m.Type,
+			Source:  m.Source,
+			Options: m.Options,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Service) execProcess(ctx context.Context, t runtime.Task, id string) (*task.Process, error) {
+	p, err := t.Process(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	process, err := processFromContainerd(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	s.translateProcess(ctx, t, process)
+	return process, nil
+}
+
+// serialize waits for the operations queued before the caller's on the task
+// of the container, returning the function to call once the caller's is done.
+// Signals are not queued so that a task can be killed while an operation on
+// it is stuck.
+func (s *Service) serialize(ctx context.Context, id string) (func(), error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	release, err := s.queue.acquire(ctx, namespace, id)
+	if err != nil {
+		return nil, grpc.Errorf(waitCode(err), "task operation not queued: %v", err)
+	}
+	return release, nil
+}
+
+// invalidate drops the cached state of the task after a call that changes it
+func (s *Service) invalidate(ctx context.Context, id string) {
+	if namespace, err := namespaces.NamespaceRequired(ctx); err == nil {
+		s.states.invalidate(namespace, id)
+	}
+}
+
 func (s *Service) getContainer(ctx context.Context, id string) (*containers.Container, error) {
 	var container containers.Container
 	if err := s.db.View(func(tx *bolt.Tx) error {
@@ -483,11 +960,77 @@ func (s *Service) getContainer(ctx context.Context, id string) (*containers.Cont
 		container, err = store.Get(ctx, id)
 		return err
 	}); err != nil {
-		return nil, errdefs.ToGRPC(err)
+		return nil, errdefs.ToGRPC(errdefs.WithDetail(err, types.ErrorDetail{ContainerID: id}))
 	}
 	return &container, nil
 }
 
+// setStopped records on the container whether its task was stopped by a
+// client, so that the restart monitor does not restart it
+func (s *Service) setStopped(ctx context.Context, id string, stopped bool) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		store := metadata.NewContainerStore(tx)
+		container, err := store.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if _, ok := container.Labels[restart.StoppedLabel]; ok == stopped {
+			return nil
+		}
+		if stopped {
+			if container.Labels == nil {
+				container.Labels = make(map[string]string)
+			}
+			container.Labels[restart.StoppedLabel] = "true"
+		} else {
+			delete(container.Labels, restart.StoppedLabel)
+		}
+		_, err = store.Update(ctx, container, "labels")
+		return err
+	})
+}
+
+// dedupe keeps a single candidate for a task listed by more than one
+// runtime, as happens when a runtime wraps another, preferring the runtime
+// recorded on the task's container. The candidates must be sorted by id.
+func (s *Service) dedupe(ctx context.Context, candidates []*taskAdaptor) []*taskAdaptor {
+	var deduped []*taskAdaptor
+	for _, c := range candidates {
+		n := len(deduped)
+		if n == 0 || deduped[n-1].task.ID() != c.task.ID() {
+			deduped = append(deduped, c)
+			continue
+		}
+		if container, err := s.getContainer(ctx, c.task.ID()); err == nil && container.Runtime.Name == c.runtime {
+			deduped[n-1] = c
+		}
+	}
+	return deduped
+}
+
+// rootfsMounts returns the mounts of the container's rootfs snapshot
+func (s *Service) rootfsMounts(ctx context.Context, container *containers.Container) ([]mount.Mount, error) {
+	if container.Snapshotter == "" {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "unable to resolve rootfs mounts without snapshotter on container")
+	}
+	sn, ok := s.snapshotters[container.Snapshotter]
+	if !ok {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "snapshotter not loaded: %s", container.Snapshotter)
+	}
+	return sn.Mounts(ctx, container.RootFS)
+}
+
+// taskError maps an error of the task to a grpc error detailing the
+// container, exec process and runtime it is about
+func taskError(err error, t runtime.Task, execID string) error {
+	info := t.Info()
+	return errdefs.ToGRPC(errdefs.WithDetail(err, types.ErrorDetail{
+		ContainerID: info.ID,
+		ExecID:      execID,
+		Runtime:     info.Runtime,
+	}))
+}
+
 func (s *Service) getTask(ctx context.Context, id string) (runtime.Task, error) {
 	container, err := s.getContainer(ctx, id)
 	if err != nil {
@@ -497,21 +1040,31 @@ func (s *Service) getTask(ctx context.Context, id string) (runtime.Task, error)
 }
 
 func (s *Service) getTaskFromContainer(ctx context.Context, container *containers.Container) (runtime.Task, error) {
+	detail := types.ErrorDetail{
+		ContainerID: container.ID,
+		Runtime:     container.Runtime.Name,
+	}
 	runtime, err := s.getRuntime(container.Runtime.Name)
 	if err != nil {
-		return nil, errdefs.ToGRPCf(err, "runtime for task %s", container.Runtime.Name)
+		return nil, errdefs.ToGRPC(errdefs.WithDetail(errors.Wrapf(err, "runtime for task %s", container.Runtime.Name), detail))
 	}
 	t, err := runtime.Get(ctx, container.ID)
 	if err != nil {
-		return nil, grpc.Errorf(codes.NotFound, "task %v not found", container.ID)
+		return nil, errdefs.ToGRPC(errdefs.WithDetail(errors.Wrapf(errdefs.ErrNotFound, "task %v not found", container.ID), detail))
 	}
 	return t, nil
 }
 
+// getRuntime returns the runtime registered with the name or, failing that,
+// a runtime running the named runtime in an external shim
 func (s *Service) getRuntime(name string) (runtime.Runtime, error) {
-	runtime, ok := s.runtimes[name]
-	if !ok {
-		return nil, grpc.Errorf(codes.NotFound, "unknown runtime %q", name)
+	if r, ok := s.runtimes[name]; ok {
+		return r, nil
+	}
+	for _, r := range s.runtimes {
+		if sr, ok := r.(runtime.ShimRuntime); ok && sr.Resolves(name) {
+			return sr, nil
+		}
 	}
-	return runtime, nil
+	return nil, errdefs.ToGRPC(errdefs.WithDetail(errors.Wrapf(errdefs.ErrNotFound, "unknown runtime %q", name), types.ErrorDetail{Runtime: name}))
 }
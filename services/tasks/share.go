@@ -0,0 +1,121 @@
+package tasks
+
+import (
+	"sort"
+	"sync"
+
+	api "github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/hooks"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/containerd/typeurl"
+	"github.com/gogo/protobuf/types"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// nsFiles are the names of the namespace files of /proc by the types of
+// the namespaces that tasks can join
+var nsFiles = map[specs.LinuxNamespaceType]string{
+	specs.NetworkNamespace: "net",
+	specs.IPCNamespace:     "ipc",
+	specs.PIDNamespace:     "pid",
+	specs.UTSNamespace:     "uts",
+}
+
+// sharedNamespaces keeps the namespaces of containers joined by the tasks of
+// other containers bound at <root>/<namespace>/<container>/<type>, along
+// with an empty file per task joining them under sharers/, so that they are
+// released once the last task joining them is deleted, across restarts of
+// the daemon
+type sharedNamespaces struct {
+	root string
+	mu   sync.Mutex
+}
+
+func newSharedNamespaces(root string) *sharedNamespaces {
+	return &sharedNamespaces{
+		root: root,
+	}
+}
+
+// sortedTypes returns the namespace types of the request in order
+func sortedTypes(join map[string]string) []string {
+	types := make([]string, 0, len(join))
+	for t := range join {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// joinNamespaces returns the spec of the task adjusted to join the
+// namespaces of the containers of the request
+func (s *Service) joinNamespaces(ctx context.Context, r *api.CreateTaskRequest, spec *types.Any) (*types.Any, error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return nil, err
+	}
+	joined, err := s.shared.join(namespace, r.ContainerID, r.JoinNamespaces, func(id string) (uint32, error) {
+		return s.taskPid(ctx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	adjusted, err := withNamespaces(spec, joined)
+	if err != nil {
+		s.releaseNamespaces(ctx, r.ContainerID)
+		return nil, err
+	}
+	return adjusted, nil
+}
+
+// releaseNamespaces releases the namespaces joined by the task of the
+// container
+func (s *Service) releaseNamespaces(ctx context.Context, id string) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return
+	}
+	if err := s.shared.release(namespace, id); err != nil {
+		log.G(ctx).WithError(err).WithField("id", id).Warn("failed to release shared namespaces")
+	}
+}
+
+// taskPid returns the pid of the task of the container, which must have
+// been created and not have exited for its namespaces to be joined
+func (s *Service) taskPid(ctx context.Context, id string) (uint32, error) {
+	t, err := s.getTask(ctx, id)
+	if err != nil {
+		return 0, errdefs.FromGRPC(err)
+	}
+	state, err := t.State(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if state.Pid == 0 || state.Status == runtime.StoppedStatus {
+		return 0, errors.Wrapf(errdefs.ErrFailedPrecondition, "task of container %s is not running", id)
+	}
+	return state.Pid, nil
+}
+
+func withNamespaces(spec *types.Any, joined []specs.LinuxNamespace) (*types.Any, error) {
+	v, err := typeurl.UnmarshalAny(spec)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := v.(*specs.Spec)
+	if !ok {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "%s is not a runtime spec", spec.TypeUrl)
+	}
+	a := hooks.Adjustment{
+		Namespaces: joined,
+	}
+	if err := a.Apply(s); err != nil {
+		return nil, err
+	}
+	return typeurl.MarshalAny(s)
+}
@@ -0,0 +1,87 @@
+package tasks
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/containerd/containerd/sys"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// join records the task of the container id as joining the namespaces of
+// the containers in targets, by type, binding the namespaces not bound yet
+// from the task of their container found with pid
+func (n *sharedNamespaces) join(namespace, id string, targets map[string]string, pid func(string) (uint32, error)) (_ []specs.LinuxNamespace, err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	defer func() {
+		if err != nil {
+			n.releaseLocked(namespace, id)
+		}
+	}()
+	var joined []specs.LinuxNamespace
+	for _, t := range sortedTypes(targets) {
+		var (
+			dir  = filepath.Join(n.root, namespace, targets[t])
+			path = filepath.Join(dir, t)
+		)
+		if err := os.MkdirAll(filepath.Join(dir, "sharers"), 0711); err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, "sharers", id), nil, 0600); err != nil {
+			return nil, err
+		}
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			p, err := pid(targets[t])
+			if err != nil {
+				return nil, err
+			}
+			source := fmt.Sprintf("/proc/%d/ns/%s", p, nsFiles[specs.LinuxNamespaceType(t)])
+			if err := sys.BindNamespace(source, path); err != nil {
+				return nil, err
+			}
+		} else if err != nil {
+			return nil, err
+		}
+		joined = append(joined, specs.LinuxNamespace{
+			Type: specs.LinuxNamespaceType(t),
+			Path: path,
+		})
+	}
+	return joined, nil
+}
+
+// release removes the task of the container id from the tasks joining
+// namespaces, unbinding the namespaces no other task joins
+func (n *sharedNamespaces) release(namespace, id string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.releaseLocked(namespace, id)
+}
+
+func (n *sharedNamespaces) releaseLocked(namespace, id string) error {
+	sharers, err := filepath.Glob(filepath.Join(n.root, namespace, "*", "sharers", id))
+	if err != nil {
+		return err
+	}
+	for _, sharer := range sharers {
+		if err := os.Remove(sharer); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		dir := filepath.Dir(filepath.Dir(sharer))
+		if remaining, err := ioutil.ReadDir(filepath.Dir(sharer)); err != nil || len(remaining) > 0 {
+			continue
+		}
+		for t := range nsFiles {
+			if err := sys.RemoveNamespace(filepath.Join(dir, string(t))); err != nil {
+				return err
+			}
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
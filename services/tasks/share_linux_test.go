@@ -0,0 +1,77 @@
+package tasks
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+func TestSharedNamespacesRelease(t *testing.T) {
+	root, err := ioutil.TempDir("", "shared-namespaces-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	// the namespace is already bound, as it is once a first task joins it
+	var (
+		n    = newSharedNamespaces(root)
+		dir  = filepath.Join(root, "default", "redis")
+		path = filepath.Join(dir, string(specs.NetworkNamespace))
+	)
+	if err := os.MkdirAll(dir, 0711); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, nil, 0444); err != nil {
+		t.Fatal(err)
+	}
+	noPid := func(id string) (uint32, error) {
+		return 0, errors.Errorf("unexpected lookup of the task of %s", id)
+	}
+	targets := map[string]string{string(specs.NetworkNamespace): "redis"}
+	for _, id := range []string{"web", "api"} {
+		joined, err := n.join("default", id, targets, noPid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(joined) != 1 || joined[0].Type != specs.NetworkNamespace || joined[0].Path != path {
+			t.Fatalf("unexpected namespaces joined %+v", joined)
+		}
+	}
+
+	if err := n.release("default", "web"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("expected the namespace to be kept for api: %v", err)
+	}
+	if err := n.release("default", "api"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Fatalf("expected the namespaces of redis to be released: %v", err)
+	}
+}
+
+func TestSharedNamespacesJoinFailure(t *testing.T) {
+	root, err := ioutil.TempDir("", "shared-namespaces-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	n := newSharedNamespaces(root)
+	_, err = n.join("default", "web", map[string]string{string(specs.IPCNamespace): "redis"}, func(id string) (uint32, error) {
+		return 0, errors.New("no task")
+	})
+	if err == nil {
+		t.Fatal("expected the join to fail without a task to join")
+	}
+	if _, err := os.Stat(filepath.Join(root, "default", "redis")); !os.IsNotExist(err) {
+		t.Fatalf("expected nothing to be kept for a failed join: %v", err)
+	}
+}
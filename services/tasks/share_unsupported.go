@@ -0,0 +1,17 @@
+// +build !linux
+
+package tasks
+
+import (
+	"github.com/containerd/containerd/errdefs"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+func (n *sharedNamespaces) join(namespace, id string, targets map[string]string, pid func(string) (uint32, error)) ([]specs.LinuxNamespace, error) {
+	return nil, errors.Wrap(errdefs.ErrNotImplemented, "namespaces cannot be joined on this platform")
+}
+
+func (n *sharedNamespaces) release(namespace, id string) error {
+	return nil
+}
@@ -0,0 +1,143 @@
+package tasks
+
+import (
+	"fmt"
+	"time"
+
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	api "github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/containerd/snapshot"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// Snapshot commits the writable layer of the rootfs of the container to a
+// new snapshot. A running task is paused while the layer is diffed from its
+// parent, so that the files the task writes are captured as of a single
+// point in time, and resumed before the diff is applied to the new snapshot.
+func (s *Service) Snapshot(ctx context.Context, r *api.SnapshotTaskRequest) (*api.SnapshotTaskResponse, error) {
+	dequeue, err := s.serialize(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	defer dequeue()
+	defer s.invalidate(ctx, r.ContainerID)
+
+	if err := validateSnapshotTaskRequest(r); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	if s.differ == nil {
+		return nil, errdefs.ToGRPCf(errdefs.ErrFailedPrecondition, "no differ loaded to snapshot containers")
+	}
+	container, err := s.getContainer(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	detail := types.ErrorDetail{ContainerID: container.ID}
+	if container.RootFS == "" {
+		return nil, errdefs.ToGRPC(errdefs.WithDetail(errors.Wrap(errdefs.ErrFailedPrecondition, "container has no rootfs snapshot"), detail))
+	}
+	sn, ok := s.snapshotters[container.Snapshotter]
+	if !ok {
+		return nil, errdefs.ToGRPC(errdefs.WithDetail(errors.Wrapf(errdefs.ErrFailedPrecondition, "snapshotter not loaded: %s", container.Snapshotter), detail))
+	}
+	if _, err := sn.Stat(ctx, r.Name); err == nil {
+		return nil, errdefs.ToGRPCf(errdefs.ErrAlreadyExists, "snapshot %q", r.Name)
+	}
+	info, err := sn.Stat(ctx, container.RootFS)
+	if err != nil {
+		return nil, errdefs.ToGRPC(errdefs.WithDetail(err, detail))
+	}
+	if info.Kind != snapshot.KindActive {
+		return nil, errdefs.ToGRPC(errdefs.WithDetail(errors.Wrapf(errdefs.ErrFailedPrecondition, "rootfs %s is not writable", container.RootFS), detail))
+	}
+	layer, err := s.frozenDiff(ctx, container.ID, sn, container.RootFS, info.Parent)
+	if err != nil {
+		return nil, errdefs.ToGRPC(errdefs.WithDetail(err, detail))
+	}
+	if err := s.commitLayer(ctx, sn, r.Name, info.Parent, layer, r.Labels); err != nil {
+		return nil, errdefs.ToGRPC(errdefs.WithDetail(err, detail))
+	}
+	return &api.SnapshotTaskResponse{
+		Snapshotter: container.Snapshotter,
+		Name:        r.Name,
+		Parent:      info.Parent,
+		Layer: &types.Descriptor{
+			MediaType: layer.MediaType,
+			Digest:    layer.Digest,
+			Size_:     layer.Size,
+		},
+	}, nil
+}
+
+// frozenDiff diffs the active snapshot from its parent with the task of the
+// container paused if it is running
+func (s *Service) frozenDiff(ctx context.Context, id string, sn snapshot.Snapshotter, key, parent string) (layer ocispec.Descriptor, err error) {
+	// the view of the parent is unique to the snapshot so that containers
+	// sharing a parent are snapshotted concurrently
+	lowerKey := fmt.Sprintf("snapshot-%s-%d-parent-view", id, time.Now().UnixNano())
+	lower, err := sn.View(ctx, lowerKey, parent)
+	if err != nil {
+		return layer, err
+	}
+	defer sn.Remove(ctx, lowerKey)
+	upper, err := sn.Mounts(ctx, key)
+	if err != nil {
+		return layer, err
+	}
+	if t, err := s.getTask(ctx, id); err == nil {
+		if state, err := t.State(ctx); err == nil && state.Status == runtime.RunningStatus {
+			if err := t.Pause(ctx); err != nil {
+				return layer, errors.Wrap(err, "failed to freeze task")
+			}
+			defer func() {
+				if rerr := t.Resume(ctx); rerr != nil {
+					log.G(ctx).WithError(rerr).WithField("id", id).Error("failed to resume task after snapshot")
+					if err == nil {
+						err = errors.Wrap(rerr, "failed to resume task")
+					}
+				}
+			}()
+		}
+	}
+	layer, err = s.differ.DiffMounts(ctx, lower, upper, ocispec.MediaTypeImageLayerGzip, lowerKey)
+	if err != nil {
+		return layer, errors.Wrap(err, "failed to diff rootfs")
+	}
+	return layer, nil
+}
+
+// commitLayer applies the layer to a new snapshot of the parent committed
+// under name
+func (s *Service) commitLayer(ctx context.Context, sn snapshot.Snapshotter, name, parent string, layer ocispec.Descriptor, labels map[string]string) error {
+	key := fmt.Sprintf("snapshot-%d-%s", time.Now().UnixNano(), name)
+	mounts, err := sn.Prepare(ctx, key, parent)
+	if err != nil {
+		return err
+	}
+	if _, err := s.differ.Apply(ctx, layer, mounts); err != nil {
+		if rerr := sn.Remove(ctx, key); rerr != nil {
+			log.G(ctx).WithError(rerr).WithField("key", key).Warn("failed to remove snapshot of failed commit")
+		}
+		return errors.Wrap(err, "failed to apply layer")
+	}
+	var opts []snapshot.Opt
+	if labels != nil {
+		opts = append(opts, snapshot.WithLabels(labels))
+	}
+	if err := sn.Commit(ctx, name, key, opts...); err != nil {
+		if rerr := sn.Remove(ctx, key); rerr != nil {
+			log.G(ctx).WithError(rerr).WithField("key", key).Warn("failed to remove snapshot of failed commit")
+		}
+		return err
+	}
+	return s.publisher.Publish(ctx, "/snapshot/commit", &eventsapi.SnapshotCommit{
+		Key:  key,
+		Name: name,
+	})
+}
@@ -0,0 +1,72 @@
+package tasks
+
+import (
+	"path"
+	"sync"
+
+	"github.com/containerd/containerd/api/types/task"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/log"
+	"golang.org/x/net/context"
+)
+
+// stateCache holds the last known state of tasks so that listing tasks does
+// not require a round trip to the runtime for each one. Entries are
+// invalidated by task events and by the service's own state changing calls.
+type stateCache struct {
+	mu     sync.Mutex
+	gen    uint64
+	states map[string]*task.Process
+}
+
+func newStateCache() *stateCache {
+	return &stateCache{
+		states: make(map[string]*task.Process),
+	}
+}
+
+// get returns the cached state for the task, if any, along with the current
+// generation of the cache to be passed to put
+func (c *stateCache) get(namespace, id string) (*task.Process, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.states[path.Join(namespace, id)], c.gen
+}
+
+// put caches the state for the task unless the cache has been invalidated
+// since gen was returned by get
+func (c *stateCache) put(namespace, id string, p *task.Process, gen uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if gen != c.gen {
+		return
+	}
+	c.states[path.Join(namespace, id)] = p
+}
+
+func (c *stateCache) invalidate(namespace, id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	delete(c.states, path.Join(namespace, id))
+}
+
+// watch invalidates cached states as task events are published
+func (c *stateCache) watch(ctx context.Context, exchange *events.Exchange) {
+	eventq, errq := exchange.Subscribe(ctx, `topic~="^/tasks/"`)
+	for {
+		select {
+		case ev := <-eventq:
+			id, ok := ev.Field([]string{"event", "container_id"})
+			if !ok {
+				continue
+			}
+			c.invalidate(ev.Namespace, id)
+		case err := <-errq:
+			if err != nil {
+				log.G(ctx).WithError(err).Error("task state cache subscription")
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/api/types/task"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/namespaces"
+	"golang.org/x/net/context"
+)
+
+func TestStateCachePutAfterInvalidate(t *testing.T) {
+	c := newStateCache()
+	_, gen := c.get("default", "test")
+	c.invalidate("default", "test")
+	c.put("default", "test", &task.Process{ID: "test"}, gen)
+	if p, _ := c.get("default", "test"); p != nil {
+		t.Fatal("expected stale state not to be cached")
+	}
+}
+
+func TestStateCacheWatch(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(namespaces.WithNamespace(context.Background(), "default"))
+		exchange    = events.NewExchange()
+		c           = newStateCache()
+	)
+	defer cancel()
+	go c.watch(ctx, exchange)
+
+	_, gen := c.get("default", "test")
+	c.put("default", "test", &task.Process{ID: "test"}, gen)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		// the subscription may not be established yet, keep publishing
+		if err := exchange.Publish(ctx, "/tasks/exit", &eventsapi.TaskExit{ContainerID: "test"}); err != nil {
+			t.Fatal(err)
+		}
+		if p, _ := c.get("default", "test"); p == nil {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("timeout waiting for task state to be invalidated")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
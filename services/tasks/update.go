@@ -0,0 +1,137 @@
+package tasks
+
+import (
+	"github.com/boltdb/bolt"
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	api "github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/metadata"
+	"github.com/containerd/containerd/oci"
+	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/containerd/typeurl"
+	protobuf "github.com/gogo/protobuf/types"
+	google_protobuf "github.com/golang/protobuf/ptypes/empty"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// UpdateProcess amends the spec of a process before it is started, so that
+// the details only known once a container is created, such as its allocated
+// ports, are set just before it starts. The process of a container is
+// updated in the spec of the container until its task is created, after
+// which the runtime has set it up, and exec processes are updated by their
+// runtime until they are started.
+func (s *Service) UpdateProcess(ctx context.Context, r *api.UpdateProcessRequest) (*google_protobuf.Empty, error) {
+	dequeue, err := s.serialize(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	defer dequeue()
+	if err := validateUpdateProcessRequest(r); err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+	container, err := s.getContainer(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.getTaskFromContainer(ctx, container)
+	if r.ExecID == "" {
+		if err == nil {
+			return nil, taskError(errors.Wrapf(errdefs.ErrFailedPrecondition, "task %s is created, its process can only be updated before", container.ID), t, "")
+		}
+		if err := s.updateContainerProcess(ctx, container, r); err != nil {
+			return nil, errdefs.ToGRPC(errdefs.WithDetail(err, types.ErrorDetail{ContainerID: container.ID}))
+		}
+		return empty, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	p, err := t.Process(ctx, r.ExecID)
+	if err != nil {
+		return nil, taskError(err, t, r.ExecID)
+	}
+	u, ok := p.(runtime.ProcessUpdater)
+	if !ok {
+		return nil, taskError(errors.Wrapf(errdefs.ErrNotImplemented, "runtime %s cannot update exec processes", t.Info().Runtime), t, r.ExecID)
+	}
+	if err := u.UpdateProcess(ctx, runtime.ProcessUpdate{
+		Env:  r.Env,
+		Args: r.Args,
+		Cwd:  r.Cwd,
+	}); err != nil {
+		return nil, taskError(err, t, r.ExecID)
+	}
+	return empty, nil
+}
+
+// updateContainerProcess amends the spec of the container, which has no
+// task
+func (s *Service) updateContainerProcess(ctx context.Context, container *containers.Container, r *api.UpdateProcessRequest) error {
+	spec, err := updateSpec(container.Spec, r)
+	if err != nil {
+		return err
+	}
+	if err := validateCreate(spec, nil); err != nil {
+		return err
+	}
+	container.Spec = spec
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		_, err := metadata.NewContainerStore(tx).Update(ctx, *container, "spec")
+		return err
+	}); err != nil {
+		return err
+	}
+	return s.publisher.Publish(ctx, "/containers/update", &eventsapi.ContainerUpdate{
+		ID:     container.ID,
+		Image:  container.Image,
+		Labels: container.Labels,
+		RootFS: container.RootFS,
+	})
+}
+
+// updateSpec returns the spec with the process and mounts of the request
+func updateSpec(any *protobuf.Any, r *api.UpdateProcessRequest) (*protobuf.Any, error) {
+	if any == nil {
+		return nil, invalidField("spec", errors.New("container has no spec"))
+	}
+	v, err := typeurl.UnmarshalAny(any)
+	if err != nil {
+		return nil, invalidField("spec", err)
+	}
+	spec, ok := v.(*specs.Spec)
+	if !ok || spec.Process == nil {
+		return nil, invalidField("spec", errors.Errorf("%s is not a runtime spec with a process", any.TypeUrl))
+	}
+	var opts []oci.Opt
+	if len(r.Env) > 0 {
+		opts = append(opts, oci.WithEnv(r.Env))
+	}
+	if len(r.Args) > 0 {
+		opts = append(opts, oci.WithArgs(r.Args))
+	}
+	if r.Cwd != "" {
+		opts = append(opts, oci.WithCwd(r.Cwd))
+	}
+	if len(r.Mounts) > 0 {
+		var mounts []specs.Mount
+		for _, m := range r.Mounts {
+			mounts = append(mounts, specs.Mount{
+				Destination: m.Target,
+				Type:        m.Type,
+				Source:      m.Source,
+				Options:     m.Options,
+			})
+		}
+		opts = append(opts, oci.WithMounts(mounts))
+	}
+	for _, o := range opts {
+		if err := o(spec); err != nil {
+			return nil, err
+		}
+	}
+	return typeurl.MarshalAny(spec)
+}
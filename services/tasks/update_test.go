@@ -0,0 +1,66 @@
+package tasks
+
+import (
+	"reflect"
+	"testing"
+
+	api "github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/typeurl"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestUpdateSpec(t *testing.T) {
+	any, err := typeurl.MarshalAny(&specs.Spec{
+		Version: specs.Version,
+		Root:    &specs.Root{Path: "rootfs"},
+		Process: &specs.Process{
+			Args: []string{"nginx"},
+			Cwd:  "/",
+			Env:  []string{"PATH=/bin", "PORT=80"},
+		},
+		Mounts: []specs.Mount{
+			{Destination: "/proc", Type: "proc", Source: "proc"},
+			{Destination: "/tmp", Type: "tmpfs", Source: "tmpfs"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated, err := updateSpec(any, &api.UpdateProcessRequest{
+		ContainerID: "web",
+		Env:         []string{"PORT=8080", "HOST=0.0.0.0"},
+		Cwd:         "/srv",
+		Mounts: []*types.Mount{
+			{Type: "tmpfs", Source: "tmpfs", Target: "/tmp", Options: []string{"size=64m"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := typeurl.UnmarshalAny(updated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := v.(*specs.Spec)
+	if expected := []string{"nginx"}; !reflect.DeepEqual(spec.Process.Args, expected) {
+		t.Errorf("expected the args to be kept but got %v", spec.Process.Args)
+	}
+	if expected := []string{"PATH=/bin", "PORT=8080", "HOST=0.0.0.0"}; !reflect.DeepEqual(spec.Process.Env, expected) {
+		t.Errorf("expected env %v but got %v", expected, spec.Process.Env)
+	}
+	if spec.Process.Cwd != "/srv" {
+		t.Errorf("expected cwd /srv but got %s", spec.Process.Cwd)
+	}
+	expected := []specs.Mount{
+		{Destination: "/proc", Type: "proc", Source: "proc"},
+		{Destination: "/tmp", Type: "tmpfs", Source: "tmpfs", Options: []string{"size=64m"}},
+	}
+	if !reflect.DeepEqual(spec.Mounts, expected) {
+		t.Errorf("expected mounts %v but got %v", expected, spec.Mounts)
+	}
+
+	if _, err := updateSpec(nil, &api.UpdateProcessRequest{ContainerID: "web", Cwd: "/"}); err == nil {
+		t.Error("expected an error for a container without spec")
+	}
+}
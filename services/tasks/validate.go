@@ -0,0 +1,211 @@
+package tasks
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	api "github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/identifiers"
+	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/oci"
+	"github.com/containerd/containerd/typeurl"
+	"github.com/gogo/protobuf/types"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// validateCreateRequest checks the id and stdio of a task creation before
+// anything is done for it
+func validateCreateRequest(r *api.CreateTaskRequest) error {
+	if err := identifiers.Validate(r.ContainerID); err != nil {
+		return invalidField("container_id", err)
+	}
+	for t, id := range r.JoinNamespaces {
+		field := fmt.Sprintf("join_namespaces[%s]", t)
+		if _, ok := nsFiles[specs.LinuxNamespaceType(t)]; !ok {
+			return invalidField(field, errors.Errorf("namespace type %q cannot be joined", t))
+		}
+		if err := identifiers.Validate(id); err != nil {
+			return invalidField(field, err)
+		}
+		if id == r.ContainerID {
+			return invalidField(field, errors.New("a task cannot join its own namespaces"))
+		}
+	}
+	for _, f := range []struct {
+		name  string
+		value string
+	}{
+		{"stdin", r.Stdin},
+		{"stdout", r.Stdout},
+		{"stderr", r.Stderr},
+	} {
+		if err := validateStdio(f.value); err != nil {
+			return invalidField(f.name, err)
+		}
+	}
+	return nil
+}
+
+// validateUpdateProcessRequest checks the changes of a process update, the
+// updated spec being checked once they are applied when it is known
+func validateUpdateProcessRequest(r *api.UpdateProcessRequest) error {
+	if err := identifiers.Validate(r.ContainerID); err != nil {
+		return invalidField("container_id", err)
+	}
+	if len(r.Env) == 0 && len(r.Args) == 0 && r.Cwd == "" && len(r.Mounts) == 0 {
+		return errors.Wrap(errdefs.ErrInvalidArgument, "the update changes nothing")
+	}
+	for i, e := range r.Env {
+		if !strings.Contains(e, "=") || strings.HasPrefix(e, "=") {
+			return invalidField(fmt.Sprintf("env[%d]", i), errors.Errorf("%q is not KEY=VALUE", e))
+		}
+	}
+	if r.Cwd != "" && !filepath.IsAbs(r.Cwd) {
+		return invalidField("cwd", errors.Errorf("%q is not absolute", r.Cwd))
+	}
+	if r.ExecID != "" && len(r.Mounts) > 0 {
+		return invalidField("mounts", errors.New("mounts can only be added to the process of the container"))
+	}
+	for i, m := range r.Mounts {
+		field := fmt.Sprintf("mounts[%d]", i)
+		if !filepath.IsAbs(m.Target) {
+			return invalidField(field, errors.Errorf("target %q is not absolute", m.Target))
+		}
+		if err := validateMount(m.Type, m.Source, m.Options); err != nil {
+			return invalidField(field, err)
+		}
+	}
+	return nil
+}
+
+// validateSnapshotTaskRequest checks the container and the name of the
+// snapshot its writable layer is committed to
+func validateSnapshotTaskRequest(r *api.SnapshotTaskRequest) error {
+	if err := identifiers.Validate(r.ContainerID); err != nil {
+		return invalidField("container_id", err)
+	}
+	if r.Name == "" {
+		return invalidField("name", errors.New("snapshot name is required"))
+	}
+	for k := range r.Labels {
+		if k == "" {
+			return invalidField("labels", errors.New("label keys cannot be empty"))
+		}
+	}
+	return nil
+}
+
+// validateStdio checks that a stdio is empty, the absolute path of a fifo or
+// a URI with an absolute path for the schemes naming files. The schemes
+// themselves are left to the runtime.
+func validateStdio(s string) error {
+	if s == "" {
+		return nil
+	}
+	if !strings.Contains(s, "://") {
+		if !filepath.IsAbs(s) {
+			return errors.Errorf("%q is not an absolute path", s)
+		}
+		return nil
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+	switch u.Scheme {
+	case "fifo", "file", "binary", "serial":
+		if u.Host != "" || !filepath.IsAbs(u.Path) {
+			return errors.Errorf("%q requires an absolute path", s)
+		}
+	}
+	return nil
+}
+
+// validateCreate checks the spec of the container and the mounts of the task
+// so that they are reported to the client rather than by the runtime
+func validateCreate(spec *types.Any, rootfs []mount.Mount) error {
+	if spec == nil {
+		return invalidField("spec", errors.New("container has no spec"))
+	}
+	v, err := typeurl.UnmarshalAny(spec)
+	if err != nil {
+		return invalidField("spec", err)
+	}
+	s, ok := v.(*specs.Spec)
+	if !ok {
+		return invalidField("spec", errors.Errorf("%s is not a runtime spec", spec.TypeUrl))
+	}
+	// windows specs have no root nor mount types and are checked by the
+	// compute service
+	if s.Windows == nil {
+		if err := oci.Validate(s); err != nil {
+			return invalidField("spec", err)
+		}
+		for i, m := range s.Mounts {
+			if err := validateMount(m.Type, m.Source, m.Options); err != nil {
+				return invalidField(fmt.Sprintf("spec.mounts[%d]", i), err)
+			}
+		}
+	}
+	for i, m := range rootfs {
+		if m.Type == "" {
+			return invalidField(fmt.Sprintf("rootfs[%d].type", i), errors.New("mount type is required"))
+		}
+		// the sources of VM mounts are only known to the guest
+		if m.IsVMOnly() {
+			continue
+		}
+		if err := validateMount(m.Type, m.Source, m.Options); err != nil {
+			return invalidField(fmt.Sprintf("rootfs[%d]", i), err)
+		}
+	}
+	return nil
+}
+
+// validateMount checks that bind mounts have the absolute path of an
+// existing file or directory as source and that other mounts have a type
+func validateMount(typ, source string, options []string) error {
+	if !isBind(typ, options) {
+		if typ == "" {
+			return errors.New("type is required for mounts other than binds")
+		}
+		return nil
+	}
+	if !filepath.IsAbs(source) {
+		return errors.Errorf("bind source %q is not absolute", source)
+	}
+	if _, err := os.Stat(source); err != nil {
+		if os.IsNotExist(err) {
+			return errors.Errorf("bind source %s does not exist", source)
+		}
+		return err
+	}
+	return nil
+}
+
+func isBind(typ string, options []string) bool {
+	if typ == "bind" {
+		return true
+	}
+	for _, o := range options {
+		if o == "bind" || o == "rbind" {
+			return true
+		}
+	}
+	return false
+}
+
+// invalidField returns an invalid argument error naming the field of the
+// request or container the error is about
+func invalidField(field string, err error) error {
+	msg := err.Error()
+	if errdefs.IsInvalidArgument(err) {
+		msg = strings.TrimSuffix(msg, ": "+errdefs.ErrInvalidArgument.Error())
+	}
+	return errors.Wrapf(errdefs.ErrInvalidArgument, "%s: %s", field, msg)
+}
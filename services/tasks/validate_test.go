@@ -0,0 +1,158 @@
+package tasks
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	api "github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/typeurl"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestValidateCreateRequest(t *testing.T) {
+	for _, c := range []struct {
+		r     api.CreateTaskRequest
+		field string
+	}{
+		{api.CreateTaskRequest{ContainerID: "redis", Stdout: "/run/redis/stdout"}, ""},
+		{api.CreateTaskRequest{ContainerID: "redis", Stdout: "binary:///usr/bin/logger?arg=1"}, ""},
+		{api.CreateTaskRequest{ContainerID: "redis", Stdout: "null://"}, ""},
+		{api.CreateTaskRequest{ContainerID: ""}, "container_id"},
+		{api.CreateTaskRequest{ContainerID: "../redis"}, "container_id"},
+		{api.CreateTaskRequest{ContainerID: strings.Repeat("a", 100)}, "container_id"},
+		{api.CreateTaskRequest{ContainerID: "redis", Stdin: "stdin"}, "stdin"},
+		{api.CreateTaskRequest{ContainerID: "redis", Stderr: "file://relative"}, "stderr"},
+		{api.CreateTaskRequest{ContainerID: "web", JoinNamespaces: map[string]string{"network": "redis", "pid": "redis"}}, ""},
+		{api.CreateTaskRequest{ContainerID: "web", JoinNamespaces: map[string]string{"mount": "redis"}}, "join_namespaces[mount]"},
+		{api.CreateTaskRequest{ContainerID: "web", JoinNamespaces: map[string]string{"ipc": "web"}}, "join_namespaces[ipc]"},
+		{api.CreateTaskRequest{ContainerID: "web", JoinNamespaces: map[string]string{"uts": "../redis"}}, "join_namespaces[uts]"},
+	} {
+		err := validateCreateRequest(&c.r)
+		checkField(t, err, c.field)
+	}
+}
+
+func TestValidateCreate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "validate-create-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, c := range []struct {
+		mounts []specs.Mount
+		rootfs []mount.Mount
+		field  string
+	}{
+		{
+			mounts: []specs.Mount{{Destination: "/data", Source: dir, Options: []string{"rbind"}}},
+			rootfs: []mount.Mount{{Type: "bind", Source: dir, Options: []string{"rbind"}}},
+		},
+		{
+			mounts: []specs.Mount{{Destination: "/data", Source: dir + "/missing", Options: []string{"rbind"}}},
+			field:  "spec.mounts[0]",
+		},
+		{
+			mounts: []specs.Mount{{Destination: "/data", Source: "data", Type: "bind"}},
+			field:  "spec.mounts[0]",
+		},
+		{
+			mounts: []specs.Mount{{Destination: "/tmp", Source: "tmpfs"}},
+			field:  "spec.mounts[0]",
+		},
+		{
+			rootfs: []mount.Mount{{Source: dir}},
+			field:  "rootfs[0].type",
+		},
+		{
+			rootfs: []mount.Mount{{Type: "bind", Source: dir + "/missing", Options: []string{"rbind"}}},
+			field:  "rootfs[0]",
+		},
+	} {
+		spec := &specs.Spec{
+			Version: specs.Version,
+			Root:    &specs.Root{Path: "rootfs"},
+			Process: &specs.Process{Args: []string{"sh"}, Cwd: "/"},
+			Mounts:  c.mounts,
+		}
+		any, err := typeurl.MarshalAny(spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkField(t, validateCreate(any, c.rootfs), c.field)
+	}
+
+	// the spec itself is checked
+	any, err := typeurl.MarshalAny(&specs.Spec{Version: specs.Version})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkField(t, validateCreate(any, nil), "spec")
+	checkField(t, validateCreate(nil, nil), "spec")
+}
+
+func TestValidateUpdateProcessRequest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "validate-update-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, c := range []struct {
+		r     api.UpdateProcessRequest
+		field string
+	}{
+		{api.UpdateProcessRequest{ContainerID: "web", Env: []string{"PORT=8080"}, Cwd: "/srv"}, ""},
+		{api.UpdateProcessRequest{ContainerID: "web", ExecID: "probe", Args: []string{"true"}}, ""},
+		{api.UpdateProcessRequest{ContainerID: "web", Mounts: []*types.Mount{{Type: "bind", Source: dir, Target: "/data", Options: []string{"rbind"}}}}, ""},
+		{api.UpdateProcessRequest{ContainerID: "", Cwd: "/"}, "container_id"},
+		{api.UpdateProcessRequest{ContainerID: "web", Env: []string{"PORT"}}, "env[0]"},
+		{api.UpdateProcessRequest{ContainerID: "web", Env: []string{"A=1", "=1"}}, "env[1]"},
+		{api.UpdateProcessRequest{ContainerID: "web", Cwd: "srv"}, "cwd"},
+		{api.UpdateProcessRequest{ContainerID: "web", ExecID: "probe", Mounts: []*types.Mount{{Type: "tmpfs", Source: "tmpfs", Target: "/tmp"}}}, "mounts"},
+		{api.UpdateProcessRequest{ContainerID: "web", Mounts: []*types.Mount{{Type: "tmpfs", Source: "tmpfs", Target: "tmp"}}}, "mounts[0]"},
+		{api.UpdateProcessRequest{ContainerID: "web", Mounts: []*types.Mount{{Type: "bind", Source: dir + "/missing", Target: "/data"}}}, "mounts[0]"},
+	} {
+		checkField(t, validateUpdateProcessRequest(&c.r), c.field)
+	}
+	if err := validateUpdateProcessRequest(&api.UpdateProcessRequest{ContainerID: "web"}); !errdefs.IsInvalidArgument(err) {
+		t.Errorf("expected an empty update to be invalid but got %v", err)
+	}
+}
+
+func TestValidateSnapshotTaskRequest(t *testing.T) {
+	for _, c := range []struct {
+		r     api.SnapshotTaskRequest
+		field string
+	}{
+		{api.SnapshotTaskRequest{ContainerID: "redis", Name: "redis-backup"}, ""},
+		{api.SnapshotTaskRequest{ContainerID: "redis", Name: "redis-backup", Labels: map[string]string{"backup": "daily"}}, ""},
+		{api.SnapshotTaskRequest{Name: "redis-backup"}, "container_id"},
+		{api.SnapshotTaskRequest{ContainerID: "../redis", Name: "redis-backup"}, "container_id"},
+		{api.SnapshotTaskRequest{ContainerID: "redis"}, "name"},
+		{api.SnapshotTaskRequest{ContainerID: "redis", Name: "redis-backup", Labels: map[string]string{"": "daily"}}, "labels"},
+	} {
+		checkField(t, validateSnapshotTaskRequest(&c.r), c.field)
+	}
+}
+
+// checkField checks that the error is about the field, or that there is no
+// error when the field is empty
+func checkField(t *testing.T, err error, field string) {
+	if field == "" {
+		if err != nil {
+			t.Errorf("expected no error but got %v", err)
+		}
+		return
+	}
+	if !errdefs.IsInvalidArgument(err) {
+		t.Errorf("expected an invalid argument error for %s but got %v", field, err)
+		return
+	}
+	if !strings.HasPrefix(err.Error(), field+": ") {
+		t.Errorf("expected an error about %s but got %v", field, err)
+	}
+}
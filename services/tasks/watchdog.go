@@ -0,0 +1,124 @@
+package tasks
+
+import (
+	"time"
+
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/runtime"
+	metrics "github.com/docker/go-metrics"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// transitions watched by the watchdog
+const (
+	creating = "creating"
+	starting = "starting"
+	deleting = "deleting"
+)
+
+// killShimTimeout bounds the request for the pid of the shim of a stuck task
+const killShimTimeout = 10 * time.Second
+
+// WatchdogConfig sets how long tasks may be creating, starting or deleting
+// before they are reported as stuck. A transition is not watched when its
+// timeout is empty.
+type WatchdogConfig struct {
+	CreateTimeout string `toml:"create_timeout,omitempty"`
+	StartTimeout  string `toml:"start_timeout,omitempty"`
+	DeleteTimeout string `toml:"delete_timeout,omitempty"`
+	// ForceCleanup cancels stuck transitions and kills the shim of the task
+	// when the runtime supports it
+	ForceCleanup bool `toml:"force_cleanup,omitempty"`
+}
+
+// watchdog reports the tasks stuck in a transition with an event and a
+// metric, as they are otherwise only found in goroutine dumps
+type watchdog struct {
+	timeouts  map[string]time.Duration
+	force     bool
+	publisher events.Publisher
+	stuck     metrics.LabeledCounter
+}
+
+func newWatchdog(config WatchdogConfig, publisher events.Publisher, stuck metrics.LabeledCounter) (*watchdog, error) {
+	w := &watchdog{
+		timeouts:  make(map[string]time.Duration),
+		force:     config.ForceCleanup,
+		publisher: publisher,
+		stuck:     stuck,
+	}
+	for state, timeout := range map[string]string{
+		creating: config.CreateTimeout,
+		starting: config.StartTimeout,
+		deleting: config.DeleteTimeout,
+	} {
+		if timeout == "" {
+			continue
+		}
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s timeout %q", state, timeout)
+		}
+		w.timeouts[state] = d
+	}
+	return w, nil
+}
+
+// watch watches the transition of the process of the container, the task
+// being nil while it is created. The returned context is canceled when a
+// stuck transition is forced and the returned function ends the transition.
+func (w *watchdog) watch(ctx context.Context, state, id, execID string, t runtime.Task) (context.Context, func()) {
+	timeout, ok := w.timeouts[state]
+	if !ok {
+		return ctx, func() {}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	started := time.Now()
+	timer := time.AfterFunc(timeout, func() {
+		w.report(ctx, state, id, execID, started, t, cancel)
+	})
+	return ctx, func() {
+		timer.Stop()
+		cancel()
+	}
+}
+
+func (w *watchdog) report(ctx context.Context, state, id, execID string, started time.Time, t runtime.Task, cancel func()) {
+	logger := log.G(ctx).WithFields(map[string]interface{}{
+		"id":    id,
+		"exec":  execID,
+		"state": state,
+	})
+	logger.Warnf("task stuck %s for %s", state, time.Since(started))
+	w.stuck.WithValues(state).Inc()
+	// the request may end while the task is reported
+	namespace, _ := namespaces.Namespace(ctx)
+	bg := namespaces.WithNamespace(context.Background(), namespace)
+	if w.force {
+		cancel()
+		// only the whole task is cleaned up, an exec process stuck starting
+		// is left to the request being canceled
+		if k, ok := t.(interface {
+			KillShim(context.Context) error
+		}); ok && execID == "" {
+			kctx, kcancel := context.WithTimeout(bg, killShimTimeout)
+			if err := k.KillShim(kctx); err != nil {
+				logger.WithError(err).Error("failed to kill the shim of the stuck task")
+			}
+			kcancel()
+		}
+	}
+	if err := w.publisher.Publish(bg, runtime.TaskStuckEventTopic, &eventsapi.TaskStuck{
+		ContainerID: id,
+		ExecID:      execID,
+		State:       state,
+		StartedAt:   started,
+		Forced:      w.force,
+	}); err != nil {
+		logger.WithError(err).Error("failed to publish task stuck event")
+	}
+}
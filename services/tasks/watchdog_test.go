@@ -0,0 +1,72 @@
+package tasks
+
+import (
+	gocontext "context"
+	"testing"
+	"time"
+
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/namespaces"
+	metrics "github.com/docker/go-metrics"
+	"golang.org/x/net/context"
+)
+
+type publisherFunc func(topic string, event events.Event)
+
+func (fn publisherFunc) Publish(ctx gocontext.Context, topic string, event events.Event) error {
+	fn(topic, event)
+	return nil
+}
+
+func TestWatchdog(t *testing.T) {
+	stuck := make(chan *eventsapi.TaskStuck, 1)
+	w, err := newWatchdog(WatchdogConfig{
+		CreateTimeout: "10ms",
+		ForceCleanup:  true,
+	}, publisherFunc(func(topic string, event events.Event) {
+		stuck <- event.(*eventsapi.TaskStuck)
+	}), metrics.NewNamespace("test", "watchdog", nil).NewLabeledCounter("stuck", "", "state"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := namespaces.WithNamespace(context.Background(), "test")
+
+	// transitions without a timeout are not watched
+	wctx, done := w.watch(ctx, starting, "test", "", nil)
+	if wctx != ctx {
+		t.Fatal("expected the context of an unwatched transition to be unchanged")
+	}
+	done()
+
+	// transitions ending before the timeout are not reported
+	_, done = w.watch(ctx, creating, "test", "", nil)
+	done()
+	select {
+	case e := <-stuck:
+		t.Fatalf("unexpected stuck event %v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	wctx, done = w.watch(ctx, creating, "test", "", nil)
+	defer done()
+	select {
+	case e := <-stuck:
+		if e.ContainerID != "test" || e.State != creating || !e.Forced {
+			t.Fatalf("unexpected stuck event %v", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a stuck event")
+	}
+	select {
+	case <-wctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the stuck transition to be canceled")
+	}
+}
+
+func TestWatchdogInvalidTimeout(t *testing.T) {
+	if _, err := newWatchdog(WatchdogConfig{DeleteTimeout: "soon"}, nil, nil); err == nil {
+		t.Fatal("expected an invalid timeout to be an error")
+	}
+}
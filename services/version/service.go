@@ -1,8 +1,11 @@
 package version
 
 import (
+	"sort"
+
 	api "github.com/containerd/containerd/api/services/version/v1"
 	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/runtime"
 	ctrdversion "github.com/containerd/containerd/version"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	"golang.org/x/net/context"
@@ -15,15 +18,28 @@ func init() {
 	plugin.Register(&plugin.Registration{
 		Type: plugin.GRPCPlugin,
 		ID:   "version",
+		Requires: []plugin.PluginType{
+			plugin.RuntimePlugin,
+		},
 		Init: New,
 	})
 }
 
 func New(ic *plugin.InitContext) (interface{}, error) {
-	return &Service{}, nil
+	s := &Service{}
+	// the daemon serves without runtimes, for example for image management
+	runtimes, _ := ic.GetAll(plugin.RuntimePlugin)
+	for _, r := range runtimes {
+		if r, ok := r.(runtime.Runtime); ok {
+			s.runtimes = append(s.runtimes, r.ID())
+		}
+	}
+	sort.Strings(s.runtimes)
+	return s, nil
 }
 
 type Service struct {
+	runtimes []string
 }
 
 func (s *Service) Register(server *grpc.Server) error {
@@ -33,7 +49,9 @@ func (s *Service) Register(server *grpc.Server) error {
 
 func (s *Service) Version(ctx context.Context, _ *empty.Empty) (*api.VersionResponse, error) {
 	return &api.VersionResponse{
-		Version:  ctrdversion.Version,
-		Revision: ctrdversion.Revision,
+		Version:    ctrdversion.Version,
+		Revision:   ctrdversion.Revision,
+		Runtimes:   s.runtimes,
+		APIVersion: ctrdversion.APIVersion,
 	}, nil
 }
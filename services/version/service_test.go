@@ -0,0 +1,44 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/runtime"
+	ctrdversion "github.com/containerd/containerd/version"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context"
+)
+
+type testRuntime struct {
+	runtime.Runtime
+	id string
+}
+
+func (r *testRuntime) ID() string {
+	return r.id
+}
+
+func TestVersion(t *testing.T) {
+	ctx := context.Background()
+	ic := plugin.NewContext(ctx, map[plugin.PluginType]map[string]interface{}{
+		plugin.RuntimePlugin: {
+			"wasm":  &testRuntime{id: "io.containerd.runtime.v1.wasm"},
+			"linux": &testRuntime{id: "io.containerd.runtime.v1.linux"},
+		},
+	}, "", "", "version")
+	s, err := New(ic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := s.(*Service).Version(ctx, &empty.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.APIVersion != ctrdversion.APIVersion {
+		t.Fatalf("expected api version %s, got %s", ctrdversion.APIVersion, v.APIVersion)
+	}
+	if len(v.Runtimes) != 2 || v.Runtimes[0] != "io.containerd.runtime.v1.linux" || v.Runtimes[1] != "io.containerd.runtime.v1.wasm" {
+		t.Fatalf("unexpected runtimes %v", v.Runtimes)
+	}
+}
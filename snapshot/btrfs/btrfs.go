@@ -149,6 +149,9 @@ func (b *snapshotter) Walk(ctx context.Context, fn func(context.Context, snapsho
 }
 
 func (b *snapshotter) Prepare(ctx context.Context, key, parent string, opts ...snapshot.Opt) ([]mount.Mount, error) {
+	if _, err := snapshot.CheckSizeLimit(false, opts); err != nil {
+		return nil, err
+	}
 	return b.makeSnapshot(ctx, snapshot.KindActive, key, parent, opts)
 }
 
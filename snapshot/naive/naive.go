@@ -113,6 +113,9 @@ func (o *snapshotter) Usage(ctx context.Context, key string) (snapshot.Usage, er
 }
 
 func (o *snapshotter) Prepare(ctx context.Context, key, parent string, opts ...snapshot.Opt) ([]mount.Mount, error) {
+	if _, err := snapshot.CheckSizeLimit(false, opts); err != nil {
+		return nil, err
+	}
 	return o.createSnapshot(ctx, snapshot.KindActive, key, parent, opts)
 }
 
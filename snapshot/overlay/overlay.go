@@ -9,9 +9,11 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"syscall"
 
 	"github.com/containerd/containerd/fs"
+	"github.com/containerd/containerd/fs/quota"
 	"github.com/containerd/containerd/log"
 	"github.com/containerd/containerd/mount"
 	"github.com/containerd/containerd/plugin"
@@ -22,17 +24,74 @@ import (
 
 func init() {
 	plugin.Register(&plugin.Registration{
-		Type: plugin.SnapshotPlugin,
-		ID:   "overlayfs",
+		Type:   plugin.SnapshotPlugin,
+		ID:     "overlayfs",
+		Config: &Config{},
 		Init: func(ic *plugin.InitContext) (interface{}, error) {
-			return NewSnapshotter(ic.Root)
+			var (
+				opts   []Opt
+				config = ic.Config.(*Config)
+			)
+			if config.SharedLower {
+				opts = append(opts, WithSharedLower)
+			}
+			if config.MountProgram != "" {
+				opts = append(opts, WithMountProgram(config.MountProgram))
+			}
+			return NewSnapshotter(ic.Root, opts...)
 		},
 	})
 }
 
+// Config for the overlayfs snapshotter
+type Config struct {
+	// SharedLower mounts the layers of a parent once, read-only, and uses
+	// that mount as the single lower directory of all active snapshots of
+	// the parent
+	SharedLower bool `toml:"shared_lower"`
+	// MountProgram is the name of a FUSE implementation of overlay in the
+	// PATH, such as fuse-overlayfs, mounting the snapshots where the kernel
+	// does not allow overlay mounts, such as in a user namespace
+	MountProgram string `toml:"mount_program"`
+}
+
+// Opt configures the overlayfs snapshotter
+type Opt func(*snapshotter)
+
+// WithSharedLower makes active snapshots of a parent with several layers
+// share a single read-only overlay mount of the parent's layers as their
+// lower directory. The mount is made by the snapshotter when the first
+// active snapshot of the parent is prepared and removed with the last one.
+//
+// Hosts running many containers of the same image then keep a single
+// stack of the image layers, while each container only materializes the
+// files it copies up into its upper directory, as reported by Usage.
+func WithSharedLower(o *snapshotter) {
+	o.sharedLower = true
+}
+
+// WithMountProgram mounts the overlays of the snapshots with the FUSE
+// program of the name instead of the overlay filesystem of the kernel
+func WithMountProgram(name string) Opt {
+	return func(o *snapshotter) {
+		o.mountType = "fuse3." + name
+	}
+}
+
 type snapshotter struct {
-	root string
-	ms   *storage.MetaStore
+	root        string
+	ms          *storage.MetaStore
+	sharedLower bool
+	// mountType is the type of the overlay mounts
+	mountType string
+
+	// sharedMu serializes the creation of snapshots with the removal of
+	// shared lower mounts that are no longer used
+	sharedMu sync.Mutex
+
+	// quota sets the project quotas of the active snapshots, nil when the
+	// backing filesystem has no project quotas
+	quota *quota.Control
 }
 
 type activeSnapshot struct {
@@ -45,7 +104,7 @@ type activeSnapshot struct {
 // NewSnapshotter returns a Snapshotter which uses overlayfs. The overlayfs
 // diffs are stored under the provided root. A metadata file is stored under
 // the root.
-func NewSnapshotter(root string) (snapshot.Snapshotter, error) {
+func NewSnapshotter(root string, opts ...Opt) (snapshot.Snapshotter, error) {
 	if err := os.MkdirAll(root, 0700); err != nil {
 		return nil, err
 	}
@@ -64,11 +123,26 @@ func NewSnapshotter(root string) (snapshot.Snapshotter, error) {
 	if err := os.Mkdir(filepath.Join(root, "snapshots"), 0700); err != nil && !os.IsExist(err) {
 		return nil, err
 	}
+	q, err := quota.NewControl(filepath.Join(root, "snapshots"))
+	if err != nil {
+		log.L.WithError(err).Debug("overlayfs snapshots cannot be limited in size")
+	}
 
-	return &snapshotter{
-		root: root,
-		ms:   ms,
-	}, nil
+	o := &snapshotter{
+		root:      root,
+		ms:        ms,
+		mountType: "overlay",
+		quota:     q,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.sharedLower {
+		if err := os.Mkdir(filepath.Join(root, "shared"), 0700); err != nil && !os.IsExist(err) {
+			return nil, err
+		}
+	}
+	return o, nil
 }
 
 // Stat returns the info for an active or committed snapshot by name or
@@ -111,8 +185,9 @@ func (o *snapshotter) Update(ctx context.Context, info snapshot.Info, fieldpaths
 
 // Usage returns the resources taken by the snapshot identified by key.
 //
-// For active snapshots, this will scan the usage of the overlay "diff" (aka
-// "upper") directory and may take some time.
+// For active snapshots, this will read the project quota of the snapshot
+// when the backing filesystem has project quotas, or scan the usage of the
+// overlay "diff" (aka "upper") directory, which may take some time.
 //
 // For committed snapshots, the value is returned from the metadata database.
 func (o *snapshotter) Usage(ctx context.Context, key string) (snapshot.Usage, error) {
@@ -124,23 +199,36 @@ func (o *snapshotter) Usage(ctx context.Context, key string) (snapshot.Usage, er
 	if err != nil {
 		return snapshot.Usage{}, err
 	}
-
-	upperPath := o.upperPath(id)
 	t.Rollback() // transaction no longer needed at this point.
 
 	if info.Kind == snapshot.KindActive {
-		du, err := fs.DiskUsage(upperPath)
-		if err != nil {
-			// TODO(stevvooe): Consider not reporting an error in this case.
-			return snapshot.Usage{}, err
-		}
-
-		usage = snapshot.Usage(du)
+		return o.activeUsage(ctx, id)
 	}
 
 	return usage, nil
 }
 
+// activeUsage returns the usage of the active snapshot with the id, from its
+// project quota if it has one
+func (o *snapshotter) activeUsage(ctx context.Context, id string) (snapshot.Usage, error) {
+	if o.quota != nil {
+		q, err := o.quota.GetQuota(filepath.Join(o.root, "snapshots", id))
+		if err == nil {
+			return snapshot.Usage{
+				Size:   q.Size,
+				Inodes: q.Inodes,
+			}, nil
+		}
+		log.G(ctx).WithError(err).WithField("id", id).Debug("scanning the usage of a snapshot without quota")
+	}
+	du, err := fs.DiskUsage(o.upperPath(id))
+	if err != nil {
+		// TODO(stevvooe): Consider not reporting an error in this case.
+		return snapshot.Usage{}, err
+	}
+	return snapshot.Usage(du), nil
+}
+
 func (o *snapshotter) Prepare(ctx context.Context, key, parent string, opts ...snapshot.Opt) ([]mount.Mount, error) {
 	return o.createSnapshot(ctx, snapshot.KindActive, key, parent, opts)
 }
@@ -154,6 +242,10 @@ func (o *snapshotter) View(ctx context.Context, key, parent string, opts ...snap
 //
 // This can be used to recover mounts after calling View or Prepare.
 func (o *snapshotter) Mounts(ctx context.Context, key string) ([]mount.Mount, error) {
+	if o.sharedLower {
+		o.sharedMu.Lock()
+		defer o.sharedMu.Unlock()
+	}
 	ctx, t, err := o.ms.TransactionContext(ctx, false)
 	if err != nil {
 		return nil, err
@@ -163,7 +255,7 @@ func (o *snapshotter) Mounts(ctx context.Context, key string) ([]mount.Mount, er
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get active mount")
 	}
-	return o.mounts(s), nil
+	return o.mounts(s)
 }
 
 func (o *snapshotter) Commit(ctx context.Context, name, key string, opts ...snapshot.Opt) error {
@@ -181,20 +273,28 @@ func (o *snapshotter) Commit(ctx context.Context, name, key string, opts ...snap
 	}()
 
 	// grab the existing id
-	id, _, _, err := storage.GetInfo(ctx, key)
+	id, info, _, err := storage.GetInfo(ctx, key)
+	if err != nil {
+		return err
+	}
+	release, err := o.sharedLowerOf(ctx, key, info)
 	if err != nil {
 		return err
 	}
 
-	usage, err := fs.DiskUsage(o.upperPath(id))
+	usage, err := o.activeUsage(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	if _, err = storage.CommitActive(ctx, key, name, snapshot.Usage(usage), opts...); err != nil {
+	if _, err = storage.CommitActive(ctx, key, name, usage, opts...); err != nil {
 		return errors.Wrap(err, "failed to commit snapshot")
 	}
-	return t.Commit()
+	if err := t.Commit(); err != nil {
+		return err
+	}
+	release()
+	return nil
 }
 
 // Remove abandons the transaction identified by key. All resources
@@ -212,6 +312,14 @@ func (o *snapshotter) Remove(ctx context.Context, key string) (err error) {
 		}
 	}()
 
+	_, info, _, err := storage.GetInfo(ctx, key)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove")
+	}
+	release, err := o.sharedLowerOf(ctx, key, info)
+	if err != nil {
+		return err
+	}
 	id, _, err := storage.Remove(ctx, key)
 	if err != nil {
 		return errors.Wrap(err, "failed to remove")
@@ -236,6 +344,7 @@ func (o *snapshotter) Remove(ctx context.Context, key string) (err error) {
 		// Must be cleaned up, any "rm-*" could be removed if no active transactions
 		log.G(ctx).WithError(err).WithField("path", renamed).Warnf("Failed to remove root filesystem")
 	}
+	release()
 
 	return nil
 }
@@ -255,6 +364,15 @@ func (o *snapshotter) createSnapshot(ctx context.Context, kind snapshot.Kind, ke
 		path        string
 		snapshotDir = filepath.Join(o.root, "snapshots")
 	)
+	// size limits are enforced with project quotas
+	limit, err := snapshot.CheckSizeLimit(o.quota != nil, opts)
+	if err != nil {
+		return nil, err
+	}
+	if o.sharedLower {
+		o.sharedMu.Lock()
+		defer o.sharedMu.Unlock()
+	}
 
 	td, err := ioutil.TempDir(snapshotDir, "new-")
 	if err != nil {
@@ -275,6 +393,14 @@ func (o *snapshotter) createSnapshot(ctx context.Context, kind snapshot.Kind, ke
 		}
 	}()
 
+	// the directories of an active snapshot inherit its project, accounting
+	// for and limiting the files written to the snapshot
+	if kind == snapshot.KindActive && o.quota != nil {
+		if err = o.quota.SetQuota(td, limit); err != nil {
+			return nil, err
+		}
+	}
+
 	fs := filepath.Join(td, "fs")
 	if err = os.MkdirAll(fs, 0755); err != nil {
 		return nil, err
@@ -331,10 +457,10 @@ func (o *snapshotter) createSnapshot(ctx context.Context, kind snapshot.Kind, ke
 		return nil, errors.Wrap(err, "commit failed")
 	}
 
-	return o.mounts(s), nil
+	return o.mounts(s)
 }
 
-func (o *snapshotter) mounts(s storage.Snapshot) []mount.Mount {
+func (o *snapshotter) mounts(s storage.Snapshot) ([]mount.Mount, error) {
 	if len(s.ParentIDs) == 0 {
 		// if we only have one layer/no parents then just return a bind mount as overlay
 		// will not work
@@ -352,7 +478,7 @@ func (o *snapshotter) mounts(s storage.Snapshot) []mount.Mount {
 					"rbind",
 				},
 			},
-		}
+		}, nil
 	}
 	var options []string
 
@@ -371,23 +497,144 @@ func (o *snapshotter) mounts(s storage.Snapshot) []mount.Mount {
 					"rbind",
 				},
 			},
-		}
+		}, nil
 	}
 
-	parentPaths := make([]string, len(s.ParentIDs))
-	for i := range s.ParentIDs {
-		parentPaths[i] = o.upperPath(s.ParentIDs[i])
+	lower := o.lowerdir(s.ParentIDs)
+	// an overlay of a single layer would not spare any mount
+	if o.sharedLower && len(s.ParentIDs) > 1 {
+		shared, err := o.mountSharedLower(s.ParentIDs)
+		if err != nil {
+			return nil, err
+		}
+		if s.Kind != snapshot.KindActive {
+			return []mount.Mount{
+				{
+					Source: shared,
+					Type:   "bind",
+					Options: []string{
+						"ro",
+						"rbind",
+					},
+				},
+			}, nil
+		}
+		lower = shared
 	}
 
-	options = append(options, fmt.Sprintf("lowerdir=%s", strings.Join(parentPaths, ":")))
+	options = append(options, fmt.Sprintf("lowerdir=%s", lower))
 	return []mount.Mount{
 		{
-			Type:    "overlay",
+			Type:    o.mountType,
 			Source:  "overlay",
 			Options: options,
 		},
+	}, nil
+
+}
+
+// lowerdir returns the overlay lowerdir option value for the parents
+func (o *snapshotter) lowerdir(parentIDs []string) string {
+	parentPaths := make([]string, len(parentIDs))
+	for i := range parentIDs {
+		parentPaths[i] = o.upperPath(parentIDs[i])
+	}
+	return strings.Join(parentPaths, ":")
+}
+
+// mountSharedLower mounts the layers of the parents read-only on the shared
+// lower directory of the first parent, if they are not mounted already, and
+// returns the directory
+func (o *snapshotter) mountSharedLower(parentIDs []string) (string, error) {
+	target := o.sharedLowerPath(parentIDs[0])
+	mounted, err := isMountPoint(target)
+	if err != nil || mounted {
+		return target, err
+	}
+	if err := os.MkdirAll(target, 0700); err != nil {
+		return "", err
+	}
+	m := mount.Mount{
+		Type:    o.mountType,
+		Source:  "overlay",
+		Options: []string{fmt.Sprintf("lowerdir=%s", o.lowerdir(parentIDs))},
 	}
+	if err := m.Mount(target); err != nil {
+		return "", errors.Wrapf(err, "failed to mount shared lower %s", target)
+	}
+	return target, nil
+}
 
+// sharedLowerOf returns a function releasing the shared lower mount of the
+// snapshot, to be called once the snapshot no longer uses it. It does
+// nothing unless the snapshot uses a shared lower mount.
+func (o *snapshotter) sharedLowerOf(ctx context.Context, key string, info snapshot.Info) (func(), error) {
+	if !o.sharedLower || info.Kind == snapshot.KindCommitted {
+		return func() {}, nil
+	}
+	s, err := storage.GetSnapshot(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.ParentIDs) < 2 {
+		return func() {}, nil
+	}
+	return func() {
+		if err := o.releaseSharedLower(ctx, info.Parent, s.ParentIDs[0]); err != nil {
+			log.G(ctx).WithError(err).WithField("parent", info.Parent).Warn("failed to release shared lower")
+		}
+	}, nil
+}
+
+// releaseSharedLower unmounts the shared lower of the parent if no active
+// snapshot or view of the parent remains
+func (o *snapshotter) releaseSharedLower(ctx context.Context, parent, parentID string) error {
+	o.sharedMu.Lock()
+	defer o.sharedMu.Unlock()
+	ctx, t, err := o.ms.TransactionContext(ctx, false)
+	if err != nil {
+		return err
+	}
+	inUse := false
+	err = storage.WalkInfo(ctx, func(ctx context.Context, info snapshot.Info) error {
+		if info.Parent == parent && info.Kind != snapshot.KindCommitted {
+			inUse = true
+		}
+		return nil
+	})
+	t.Rollback()
+	if err != nil || inUse {
+		return err
+	}
+	target := o.sharedLowerPath(parentID)
+	mounted, err := isMountPoint(target)
+	if err != nil {
+		return err
+	}
+	if mounted {
+		if err := mount.Unmount(target, 0); err != nil {
+			return err
+		}
+	}
+	return os.RemoveAll(target)
+}
+
+func (o *snapshotter) sharedLowerPath(id string) string {
+	return filepath.Join(o.root, "shared", id)
+}
+
+// isMountPoint returns true if a filesystem is mounted on the directory
+func isMountPoint(dir string) (bool, error) {
+	mounts, err := mount.Self()
+	if err != nil {
+		return false, err
+	}
+	for _, m := range mounts {
+		if m.Mountpoint == dir {
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
 func (o *snapshotter) upperPath(id string) string {
@@ -11,6 +11,7 @@ import (
 	"syscall"
 	"testing"
 
+	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/mount"
 	"github.com/containerd/containerd/snapshot"
 	"github.com/containerd/containerd/snapshot/storage"
@@ -32,6 +33,52 @@ func TestOverlay(t *testing.T) {
 	testsuite.SnapshotterSuite(t, "Overlay", newSnapshotter)
 }
 
+func TestOverlaySharedLower(t *testing.T) {
+	testutil.RequiresRoot(t)
+	testsuite.SnapshotterSuite(t, "OverlaySharedLower", func(ctx context.Context, root string) (snapshot.Snapshotter, func(), error) {
+		snapshotter, err := NewSnapshotter(root, WithSharedLower)
+		if err != nil {
+			return nil, nil, err
+		}
+		// the shared lowers of snapshots left by a test are still mounted
+		return snapshotter, func() {
+			dirs, _ := ioutil.ReadDir(filepath.Join(root, "shared"))
+			for _, d := range dirs {
+				testutil.Unmount(t, filepath.Join(root, "shared", d.Name()))
+			}
+		}, nil
+	})
+}
+
+func TestOverlaySizeLimit(t *testing.T) {
+	ctx := context.TODO()
+	root, err := ioutil.TempDir("", "overlay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	o, _, err := newSnapshotter(ctx, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// without project quotas, snapshots cannot be limited
+	o.(*snapshotter).quota = nil
+	if _, err := o.Prepare(ctx, "limited", "", snapshot.WithSizeLimit(1<<20)); !errdefs.IsNotImplemented(err) {
+		t.Fatalf("expected not implemented preparing a limited snapshot, got %v", err)
+	}
+	if _, err := o.Prepare(ctx, "invalid", "", snapshot.WithLabels(map[string]string{
+		snapshot.SizeLimitLabel: "1g",
+	})); !errdefs.IsInvalidArgument(err) {
+		t.Fatalf("expected invalid argument for an invalid limit, got %v", err)
+	}
+	if _, err := o.Prepare(ctx, "unlimited", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := o.Usage(ctx, "unlimited"); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestOverlayMounts(t *testing.T) {
 	ctx := context.TODO()
 	root, err := ioutil.TempDir("", "overlay")
@@ -150,6 +197,57 @@ func TestOverlayOverlayMount(t *testing.T) {
 	}
 }
 
+func TestOverlaySharedLowerMount(t *testing.T) {
+	testutil.RequiresRoot(t)
+	ctx := context.TODO()
+	root, err := ioutil.TempDir("", "overlay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	o, err := NewSnapshotter(root, WithSharedLower)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the shared lower needs a parent of two layers
+	if _, err := o.Prepare(ctx, "/tmp/layer1", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.Commit(ctx, "layer1", "/tmp/layer1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := o.Prepare(ctx, "/tmp/layer2", "layer1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.Commit(ctx, "layer2", "/tmp/layer2"); err != nil {
+		t.Fatal(err)
+	}
+	var shared string
+	for _, key := range []string{"/tmp/container1", "/tmp/container2"} {
+		mounts, err := o.Prepare(ctx, key, "layer2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		parent := filepath.Dir(getParents(ctx, o, root, key)[0])
+		shared = filepath.Join(root, "shared", filepath.Base(parent))
+		if expected := "lowerdir=" + shared; mounts[0].Options[2] != expected {
+			t.Fatalf("expected %q but received %q", expected, mounts[0].Options[2])
+		}
+	}
+	for i, key := range []string{"/tmp/container1", "/tmp/container2"} {
+		if err := o.Remove(ctx, key); err != nil {
+			t.Fatal(err)
+		}
+		mounted, err := isMountPoint(shared)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := i == 0; mounted != expected {
+			t.Fatalf("expected shared lower mounted to be %v after removing %s", expected, key)
+		}
+	}
+}
+
 func getBasePath(ctx context.Context, sn snapshot.Snapshotter, root, key string) string {
 	o := sn.(*snapshotter)
 	ctx, t, err := o.ms.TransactionContext(ctx, false)
@@ -2,9 +2,12 @@ package snapshot
 
 import (
 	"context"
+	"strconv"
 	"time"
 
+	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/mount"
+	"github.com/pkg/errors"
 )
 
 // Kind identifies the kind of snapshot.
@@ -259,6 +262,11 @@ type Snapshotter interface {
 	Walk(ctx context.Context, fn func(context.Context, Info) error) error
 }
 
+// SizeLimitLabel holds the number of bytes an active snapshot may use, as
+// set with WithSizeLimit. Snapshotters that cannot limit the size of a
+// snapshot fail to prepare snapshots with the label.
+const SizeLimitLabel = "containerd.io/snapshot.size-limit"
+
 // Opt allows setting mutable snapshot properties on creation
 type Opt func(info *Info) error
 
@@ -269,3 +277,52 @@ func WithLabels(labels map[string]string) Opt {
 		return nil
 	}
 }
+
+// WithSizeLimit limits the disk space used by the created active snapshot
+// to size bytes. It must follow WithLabels, which replaces the labels.
+func WithSizeLimit(size int64) Opt {
+	return func(info *Info) error {
+		if size <= 0 {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "invalid snapshot size limit %d", size)
+		}
+		if info.Labels == nil {
+			info.Labels = make(map[string]string)
+		}
+		info.Labels[SizeLimitLabel] = strconv.FormatInt(size, 10)
+		return nil
+	}
+}
+
+// SizeLimit returns the size limit of the snapshot set in its labels, 0 when
+// it has none
+func SizeLimit(info Info) (int64, error) {
+	v, ok := info.Labels[SizeLimitLabel]
+	if !ok {
+		return 0, nil
+	}
+	size, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || size <= 0 {
+		return 0, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid %s label %q", SizeLimitLabel, v)
+	}
+	return size, nil
+}
+
+// CheckSizeLimit returns the size limit set by the options of a created
+// snapshot, failing with a not implemented error when a limit is set and the
+// snapshotter does not support them
+func CheckSizeLimit(supported bool, opts []Opt) (int64, error) {
+	var info Info
+	for _, opt := range opts {
+		if err := opt(&info); err != nil {
+			return 0, err
+		}
+	}
+	size, err := SizeLimit(info)
+	if err != nil {
+		return 0, err
+	}
+	if size > 0 && !supported {
+		return 0, errors.Wrap(errdefs.ErrNotImplemented, "snapshot size limits are not supported by the snapshotter")
+	}
+	return size, nil
+}
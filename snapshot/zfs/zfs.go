@@ -0,0 +1,348 @@
+// +build linux,!no_zfs
+
+package zfs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/snapshot"
+	"github.com/containerd/containerd/snapshot/storage"
+	"github.com/pkg/errors"
+)
+
+const (
+	// snapshotSuffix is the name of the zfs snapshot taken of a dataset
+	// when it is committed, clones of committed snapshots are made from it
+	snapshotSuffix = "snapshot"
+)
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		ID:   "zfs",
+		Type: plugin.SnapshotPlugin,
+		Init: func(ic *plugin.InitContext) (interface{}, error) {
+			return NewSnapshotter(ic.Root)
+		},
+	})
+}
+
+type snapshotter struct {
+	dataset string // dataset mounted at the root
+	root    string
+	ms      *storage.MetaStore
+}
+
+// NewSnapshotter returns a Snapshotter using zfs. Uses the provided
+// root directory for snapshot metadata, snapshots are created as child
+// datasets of the dataset mounted at root.
+// root needs to be on a zfs filesystem.
+func NewSnapshotter(root string) (snapshot.Snapshotter, error) {
+	if _, err := exec.LookPath("zfs"); err != nil {
+		return nil, errors.Wrap(err, "zfs command is required by the zfs snapshotter")
+	}
+	// If directory does not exist, create it
+	if _, err := os.Stat(root); err != nil {
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+		if err := os.Mkdir(root, 0755); err != nil {
+			return nil, err
+		}
+	}
+
+	mnt, err := mount.Lookup(root)
+	if err != nil {
+		return nil, err
+	}
+	if mnt.FSType != "zfs" {
+		return nil, fmt.Errorf("path %s must be a zfs filesystem to be used with the zfs snapshotter", root)
+	}
+	ms, err := storage.NewMetaStore(filepath.Join(root, "metadata.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshotter{
+		dataset: mnt.Source,
+		root:    root,
+		ms:      ms,
+	}, nil
+}
+
+// Stat returns the info for an active or committed snapshot by name or
+// key.
+//
+// Should be used for parent resolution, existence checks and to discern
+// the kind of snapshot.
+func (z *snapshotter) Stat(ctx context.Context, key string) (snapshot.Info, error) {
+	ctx, t, err := z.ms.TransactionContext(ctx, false)
+	if err != nil {
+		return snapshot.Info{}, err
+	}
+	defer t.Rollback()
+	_, info, _, err := storage.GetInfo(ctx, key)
+	if err != nil {
+		return snapshot.Info{}, err
+	}
+
+	return info, nil
+}
+
+func (z *snapshotter) Update(ctx context.Context, info snapshot.Info, fieldpaths ...string) (snapshot.Info, error) {
+	ctx, t, err := z.ms.TransactionContext(ctx, true)
+	if err != nil {
+		return snapshot.Info{}, err
+	}
+
+	info, err = storage.UpdateInfo(ctx, info, fieldpaths...)
+	if err != nil {
+		t.Rollback()
+		return snapshot.Info{}, err
+	}
+
+	if err := t.Commit(); err != nil {
+		return snapshot.Info{}, err
+	}
+
+	return info, nil
+}
+
+// Usage retrieves the disk usage of the top-level snapshot.
+//
+// The size is the space referenced only by the snapshot's dataset, data
+// shared with its parent is not included.
+func (z *snapshotter) Usage(ctx context.Context, key string) (snapshot.Usage, error) {
+	ctx, t, err := z.ms.TransactionContext(ctx, false)
+	if err != nil {
+		return snapshot.Usage{}, err
+	}
+	id, info, usage, err := storage.GetInfo(ctx, key)
+	t.Rollback()
+	if err != nil {
+		return snapshot.Usage{}, err
+	}
+
+	if info.Kind == snapshot.KindActive {
+		out, err := zfs("get", "-H", "-p", "-o", "value", "used", z.datasetName(id))
+		if err != nil {
+			return snapshot.Usage{}, err
+		}
+		used, err := strconv.ParseInt(strings.TrimSpace(out), 10, 64)
+		if err != nil {
+			return snapshot.Usage{}, errors.Wrap(err, "invalid used property")
+		}
+		usage = snapshot.Usage{
+			Size: used,
+		}
+	}
+
+	return usage, nil
+}
+
+// Walk the committed snapshots.
+func (z *snapshotter) Walk(ctx context.Context, fn func(context.Context, snapshot.Info) error) error {
+	ctx, t, err := z.ms.TransactionContext(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer t.Rollback()
+	return storage.WalkInfo(ctx, fn)
+}
+
+func (z *snapshotter) Prepare(ctx context.Context, key, parent string, opts ...snapshot.Opt) ([]mount.Mount, error) {
+	if _, err := snapshot.CheckSizeLimit(false, opts); err != nil {
+		return nil, err
+	}
+	return z.makeSnapshot(ctx, snapshot.KindActive, key, parent, opts)
+}
+
+func (z *snapshotter) View(ctx context.Context, key, parent string, opts ...snapshot.Opt) ([]mount.Mount, error) {
+	return z.makeSnapshot(ctx, snapshot.KindView, key, parent, opts)
+}
+
+func (z *snapshotter) makeSnapshot(ctx context.Context, kind snapshot.Kind, key, parent string, opts []snapshot.Opt) (_ []mount.Mount, err error) {
+	ctx, t, err := z.ms.TransactionContext(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil && t != nil {
+			if rerr := t.Rollback(); rerr != nil {
+				log.G(ctx).WithError(rerr).Warn("Failure rolling back transaction")
+			}
+		}
+	}()
+
+	s, err := storage.CreateSnapshot(ctx, kind, key, parent, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	target := z.datasetName(s.ID)
+	if len(s.ParentIDs) == 0 {
+		// zfs create pool/root/id
+		if _, err = zfs("create", target); err != nil {
+			return nil, err
+		}
+	} else {
+		// zfs clone pool/root/parent@snapshot pool/root/id
+		if _, err = zfs("clone", z.snapshotName(s.ParentIDs[0]), target); err != nil {
+			return nil, err
+		}
+	}
+	err = t.Commit()
+	t = nil
+	if err != nil {
+		if _, derr := zfs("destroy", target); derr != nil {
+			log.G(ctx).WithError(derr).WithField("dataset", target).Error("Failed to destroy dataset")
+		}
+		return nil, err
+	}
+
+	return z.mounts(s), nil
+}
+
+func (z *snapshotter) mounts(s storage.Snapshot) []mount.Mount {
+	var options []string
+	if s.Kind != snapshot.KindActive {
+		options = append(options, "ro")
+	}
+	return []mount.Mount{
+		{
+			Type:    "zfs",
+			Source:  z.datasetName(s.ID),
+			Options: options,
+		},
+	}
+}
+
+func (z *snapshotter) Commit(ctx context.Context, name, key string, opts ...snapshot.Opt) (err error) {
+	ctx, t, err := z.ms.TransactionContext(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil && t != nil {
+			if rerr := t.Rollback(); rerr != nil {
+				log.G(ctx).WithError(rerr).Warn("Failure rolling back transaction")
+			}
+		}
+	}()
+
+	id, _, _, err := storage.GetInfo(ctx, key)
+	if err != nil {
+		return err
+	}
+	out, err := zfs("get", "-H", "-p", "-o", "value", "used", z.datasetName(id))
+	if err != nil {
+		return err
+	}
+	used, err := strconv.ParseInt(strings.TrimSpace(out), 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid used property")
+	}
+
+	if _, err := storage.CommitActive(ctx, key, name, snapshot.Usage{Size: used}, opts...); err != nil {
+		return errors.Wrap(err, "failed to commit")
+	}
+
+	// zfs snapshot pool/root/id@snapshot
+	target := z.snapshotName(id)
+	if _, err := zfs("snapshot", target); err != nil {
+		return err
+	}
+
+	err = t.Commit()
+	t = nil
+	if err != nil {
+		if _, derr := zfs("destroy", target); derr != nil {
+			log.G(ctx).WithError(derr).WithField("snapshot", target).Error("Failed to destroy snapshot")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Mounts returns the mounts for the transaction identified by key. Can be
+// called on an read-write or readonly transaction.
+//
+// This can be used to recover mounts after calling View or Prepare.
+func (z *snapshotter) Mounts(ctx context.Context, key string) ([]mount.Mount, error) {
+	ctx, t, err := z.ms.TransactionContext(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	s, err := storage.GetSnapshot(ctx, key)
+	t.Rollback()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get active snapshot")
+	}
+
+	return z.mounts(s), nil
+}
+
+// Remove abandons the transaction identified by key. All resources
+// associated with the key will be removed.
+func (z *snapshotter) Remove(ctx context.Context, key string) (err error) {
+	ctx, t, err := z.ms.TransactionContext(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil && t != nil {
+			if rerr := t.Rollback(); rerr != nil {
+				log.G(ctx).WithError(rerr).Warn("Failure rolling back transaction")
+			}
+		}
+	}()
+
+	id, _, err := storage.Remove(ctx, key)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove snapshot")
+	}
+
+	// the dataset, with the zfs snapshot of a committed snapshot, is
+	// destroyed before the metadata is committed so that a failure leaves
+	// the snapshot in place to be removed again. A dataset that does not
+	// exist was destroyed by a removal that failed to commit.
+	if _, err = zfs("destroy", "-r", z.datasetName(id)); err != nil {
+		if !strings.Contains(err.Error(), "does not exist") {
+			return errors.Wrapf(err, "failed to destroy dataset of snapshot %s", key)
+		}
+	}
+
+	err = t.Commit()
+	t = nil
+	return err
+}
+
+func (z *snapshotter) datasetName(id string) string {
+	return z.dataset + "/" + id
+}
+
+func (z *snapshotter) snapshotName(id string) string {
+	return z.datasetName(id) + "@" + snapshotSuffix
+}
+
+// zfs runs the zfs command with the provided arguments and returns its output
+func zfs(args ...string) (string, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("zfs", args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", errors.Wrapf(err, "zfs %s: %s", strings.Join(args, " "), strings.TrimSpace(stderr.String()))
+	}
+	return string(out), nil
+}
@@ -0,0 +1,48 @@
+// +build linux,!no_zfs
+
+package zfs
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/containerd/containerd/snapshot"
+	"github.com/containerd/containerd/snapshot/testsuite"
+	"github.com/containerd/containerd/testutil"
+)
+
+func newTestSnapshotter(t *testing.T) func(context.Context, string) (snapshot.Snapshotter, func(), error) {
+	return func(ctx context.Context, root string) (snapshot.Snapshotter, func(), error) {
+		deviceName, cleanupDevice := testutil.NewLoopback(t, 100<<20) // 100 MB
+
+		pool := fmt.Sprintf("containerd-test-%s", filepath.Base(root))
+		if out, err := exec.Command("zpool", "create", "-m", root, pool, deviceName).CombinedOutput(); err != nil {
+			cleanupDevice()
+			// not fatal
+			t.Skipf("could not create zfs pool on %s: %v (out: %q)", deviceName, err, string(out))
+		}
+
+		snapshotter, err := NewSnapshotter(root)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return snapshotter, func() {
+			if out, err := exec.Command("zpool", "destroy", "-f", pool).CombinedOutput(); err != nil {
+				t.Errorf("could not destroy zfs pool %s: %v (out: %q)", pool, err, string(out))
+			}
+			cleanupDevice()
+		}, nil
+	}
+}
+
+func TestZFS(t *testing.T) {
+	testutil.RequiresRoot(t)
+	if _, err := exec.LookPath("zpool"); err != nil {
+		t.Skip("zpool command is not available")
+	}
+	testsuite.SnapshotterSuite(t, "ZFS", newTestSnapshotter(t))
+}
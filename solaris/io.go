@@ -0,0 +1,79 @@
+// +build solaris
+
+package solaris
+
+import (
+	"context"
+	"os"
+	"syscall"
+
+	"github.com/containerd/containerd/runtime"
+)
+
+// stdio holds the ends of the fifos of a process passed to its zlogin
+type stdio struct {
+	stdin, stdout, stderr *os.File
+}
+
+// openStdio opens the fifos of the io, the stdio without a fifo being left
+// to /dev/null
+func openStdio(ctx context.Context, io runtime.IO) (_ *stdio, err error) {
+	s := &stdio{}
+	defer func() {
+		if err != nil {
+			s.Close()
+		}
+	}()
+	if s.stdin, err = openFifo(ctx, io.Stdin, syscall.O_RDONLY); err != nil {
+		return nil, err
+	}
+	if s.stdout, err = openFifo(ctx, io.Stdout, syscall.O_WRONLY); err != nil {
+		return nil, err
+	}
+	if s.stderr, err = openFifo(ctx, io.Stderr, syscall.O_WRONLY); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Close closes the ends of the fifos of the daemon, once they are inherited
+// by zlogin
+func (s *stdio) Close() error {
+	for _, f := range []*os.File{s.stdin, s.stdout, s.stderr} {
+		if f != nil {
+			f.Close()
+		}
+	}
+	return nil
+}
+
+// openFifo opens the fifo at the path, waiting for the client to open its
+// other end until the context is done
+func openFifo(ctx context.Context, path string, flag int) (*os.File, error) {
+	if path == "" {
+		return nil, nil
+	}
+	type result struct {
+		f   *os.File
+		err error
+	}
+	c := make(chan result, 1)
+	go func() {
+		f, err := os.OpenFile(path, flag, 0)
+		c <- result{f, err}
+	}()
+	select {
+	case r := <-c:
+		return r.f, r.err
+	case <-ctx.Done():
+		// opening both ends of the fifo does not block and unblocks the
+		// pending open
+		if f, err := os.OpenFile(path, syscall.O_RDWR, 0); err == nil {
+			f.Close()
+		}
+		if r := <-c; r.f != nil {
+			r.f.Close()
+		}
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,206 @@
+// +build solaris
+
+package solaris
+
+import (
+	"context"
+	"os/exec"
+	"sync"
+	"syscall"
+	"time"
+
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/runtime"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+var _ = (runtime.Process)(&process{})
+
+// process is a process of a zone, run by a zlogin of the global zone whose
+// pid is the pid of the process
+type process struct {
+	id   string
+	task *task
+	spec *specs.Process
+	io   runtime.IO
+
+	mu        sync.Mutex
+	cmd       *exec.Cmd
+	pid       uint32
+	startedAt time.Time
+
+	exitCh     chan struct{}
+	exitStatus uint32
+	exitedAt   time.Time
+}
+
+func newProcess(t *task, id string, spec *specs.Process, io runtime.IO) *process {
+	return &process{
+		id:     id,
+		task:   t,
+		spec:   spec,
+		io:     io,
+		exitCh: make(chan struct{}),
+	}
+}
+
+func (p *process) ID() string {
+	return p.id
+}
+
+func (p *process) State(ctx context.Context) (runtime.State, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return runtime.State{
+		Status:     p.status(),
+		Pid:        p.pid,
+		ExitStatus: p.exitStatus,
+		ExitedAt:   p.exitedAt,
+		StartedAt:  p.startedAt,
+		Stdin:      p.io.Stdin,
+		Stdout:     p.io.Stdout,
+		Stderr:     p.io.Stderr,
+		Terminal:   p.io.Terminal,
+	}, nil
+}
+
+// status must be called with the lock held
+func (p *process) status() runtime.Status {
+	select {
+	case <-p.exitCh:
+		return runtime.StoppedStatus
+	default:
+	}
+	if p.cmd == nil {
+		return runtime.CreatedStatus
+	}
+	return runtime.RunningStatus
+}
+
+// Kill sends the signal to the zlogin of the process, which relays it to
+// the process in the zone. The signal is sent to all the processes of the
+// zone when all is set for the init process.
+func (p *process) Kill(ctx context.Context, sig uint32, all bool) error {
+	p.mu.Lock()
+	status := p.status()
+	p.mu.Unlock()
+	switch status {
+	case runtime.CreatedStatus:
+		return errors.Wrapf(errdefs.ErrFailedPrecondition, "process %s is not started", p.id)
+	case runtime.StoppedStatus:
+		return errors.Wrapf(errdefs.ErrNotFound, "process %s is stopped", p.id)
+	}
+	if all && p.id == p.task.id {
+		if err := p.task.zone.signal(ctx, syscall.Signal(sig)); err != nil {
+			return err
+		}
+	}
+	if err := syscall.Kill(int(p.pid), syscall.Signal(sig)); err != nil && err != syscall.ESRCH {
+		return err
+	}
+	return nil
+}
+
+func (p *process) ResizePty(ctx context.Context, size runtime.ConsoleSize) error {
+	return errors.Wrap(errdefs.ErrNotImplemented, "zones do not support terminals")
+}
+
+// CloseIO has nothing to close, the stdin of the process is only held by
+// its zlogin once it is started, so that it is closed by the client
+func (p *process) CloseIO(ctx context.Context) error {
+	return nil
+}
+
+func (p *process) Start(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.cmd != nil {
+		return errors.Wrapf(errdefs.ErrFailedPrecondition, "process %s is already started", p.id)
+	}
+	args, err := zloginArgs(p.task.zone.name, p.spec)
+	if err != nil {
+		return err
+	}
+	stdio, err := openStdio(ctx, p.io)
+	if err != nil {
+		return err
+	}
+	// zlogin inherits the fifos, the ends of the daemon are closed once it
+	// is started
+	defer stdio.Close()
+	cmd := exec.Command(zloginPath, args...)
+	if stdio.stdin != nil {
+		cmd.Stdin = stdio.stdin
+	}
+	if stdio.stdout != nil {
+		cmd.Stdout = stdio.stdout
+	}
+	if stdio.stderr != nil {
+		cmd.Stderr = stdio.stderr
+	}
+	if err := cmd.Start(); err != nil {
+		return errors.Wrapf(err, "start process %s", p.id)
+	}
+	p.cmd = cmd
+	p.pid = uint32(cmd.Process.Pid)
+	p.startedAt = time.Now().UTC()
+	go p.wait()
+	return nil
+}
+
+// wait waits for the zlogin of the process to exit and publishes its exit.
+// The zone is halted when the init process exits so that the other
+// processes of the container exit with it.
+func (p *process) wait() {
+	p.cmd.Wait()
+	var status uint32
+	if ws, ok := p.cmd.ProcessState.Sys().(syscall.WaitStatus); ok {
+		if ws.Signaled() {
+			status = 128 + uint32(ws.Signal())
+		} else {
+			status = uint32(ws.ExitStatus())
+		}
+	}
+	p.mu.Lock()
+	p.exitStatus = status
+	p.exitedAt = time.Now().UTC()
+	close(p.exitCh)
+	p.mu.Unlock()
+
+	ctx := namespaces.WithNamespace(context.Background(), p.task.namespace)
+	p.task.publisher.Publish(ctx, runtime.TaskExitEventTopic, &eventsapi.TaskExit{
+		ContainerID: p.task.id,
+		ID:          p.id,
+		Pid:         p.pid,
+		ExitStatus:  status,
+		ExitedAt:    p.exitedAt,
+	})
+	if p.id == p.task.id {
+		if err := p.task.zone.halt(ctx); err != nil {
+			log.G(ctx).WithError(err).WithField("id", p.task.id).Warn("failed to halt zone")
+		}
+	}
+}
+
+// exit returns the exit of the process, or the exit of a process never
+// started when it was not
+func (p *process) exit() *runtime.Exit {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.status() != runtime.StoppedStatus {
+		return &runtime.Exit{
+			Pid:       p.pid,
+			Status:    255,
+			Timestamp: time.Now().UTC(),
+		}
+	}
+	return &runtime.Exit{
+		Pid:       p.pid,
+		Status:    p.exitStatus,
+		Timestamp: p.exitedAt,
+	}
+}
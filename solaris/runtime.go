@@ -0,0 +1,254 @@
+// +build solaris
+
+package solaris
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/containerd/typeurl"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	runtimeName  = "solaris"
+	defaultBrand = "solaris"
+)
+
+var (
+	pluginID = fmt.Sprintf("%s.%s", plugin.RuntimePlugin, runtimeName)
+
+	_ = (runtime.Runtime)(&Runtime{})
+)
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.RuntimePlugin,
+		ID:   runtimeName,
+		Config: &Config{
+			Brand: defaultBrand,
+		},
+		Init: New,
+	})
+}
+
+// Config options for the Solaris runtime
+type Config struct {
+	// Brand is the brand of the zones of the containers
+	Brand string `toml:"brand"`
+}
+
+// New returns a runtime running the tasks of containers in zones. The zones
+// of the tasks of a previous daemon are destroyed, tasks are not restored.
+func New(ic *plugin.InitContext) (interface{}, error) {
+	if err := os.MkdirAll(ic.Root, 0700); err != nil {
+		return nil, err
+	}
+	cfg := ic.Config.(*Config)
+	brand := cfg.Brand
+	if brand == "" {
+		brand = defaultBrand
+	}
+	r := &Runtime{
+		root:      ic.Root,
+		brand:     brand,
+		publisher: ic.Events,
+		tasks:     runtime.NewTaskList(),
+	}
+	r.cleanup(ic.Context)
+	return r, nil
+}
+
+// Runtime runs the task of each container in a zone whose root is the rootfs
+// of the container, the processes of the task being run in the zone with
+// zlogin
+type Runtime struct {
+	root      string
+	brand     string
+	publisher events.Publisher
+	tasks     *runtime.TaskList
+}
+
+func (r *Runtime) ID() string {
+	return pluginID
+}
+
+func (r *Runtime) Create(ctx context.Context, id string, opts runtime.CreateOpts) (_ runtime.Task, err error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return nil, err
+	}
+	v, err := typeurl.UnmarshalAny(opts.Spec)
+	if err != nil {
+		return nil, err
+	}
+	spec, ok := v.(*specs.Spec)
+	if !ok || spec.Process == nil {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, "zones require a runtime spec with a process")
+	}
+	if opts.IO.Terminal {
+		return nil, errors.Wrap(errdefs.ErrNotImplemented, "zones do not support terminals")
+	}
+	if opts.Checkpoint != "" {
+		return nil, errors.Wrap(errdefs.ErrNotImplemented, "zones cannot be restored from checkpoints")
+	}
+	if _, err := zloginArgs("", spec.Process); err != nil {
+		return nil, err
+	}
+	cfg, err := zoneConfig(filepath.Join(r.root, namespace, id, "zone"), r.brand, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := filepath.Join(r.root, namespace, id)
+	if err := os.MkdirAll(filepath.Dir(bundle), 0700); err != nil {
+		return nil, err
+	}
+	if err := os.Mkdir(bundle, 0700); err != nil {
+		if os.IsExist(err) {
+			return nil, errors.Wrapf(errdefs.ErrAlreadyExists, "task %s", id)
+		}
+		return nil, err
+	}
+	z := &zone{
+		name: zoneName(namespace, id),
+		path: filepath.Join(bundle, "zone"),
+	}
+	defer func() {
+		if err != nil {
+			r.remove(ctx, bundle, z)
+		}
+	}()
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(bundle, "config.json"), data, 0600); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(z.root(), 0700); err != nil {
+		return nil, err
+	}
+	if err := mount.MountAll(opts.Rootfs, z.root()); err != nil {
+		return nil, errors.Wrap(err, "mount rootfs")
+	}
+	if err := z.create(ctx, cfg); err != nil {
+		return nil, err
+	}
+	if err := z.boot(ctx); err != nil {
+		return nil, err
+	}
+
+	t := newTask(id, namespace, bundle, z, spec, opts, r.publisher)
+	if err := r.tasks.Add(ctx, t); err != nil {
+		return nil, err
+	}
+	var rootfs []*types.Mount
+	for _, m := range opts.Rootfs {
+		rootfs = append(rootfs, &types.Mount{
+			Type:    m.Type,
+			Source:  m.Source,
+			Options: m.Options,
+		})
+	}
+	r.publisher.Publish(ctx, runtime.TaskCreateEventTopic, &eventsapi.TaskCreate{
+		ContainerID: id,
+		Bundle:      bundle,
+		Rootfs:      rootfs,
+		IO: &eventsapi.TaskIO{
+			Stdin:    opts.IO.Stdin,
+			Stdout:   opts.IO.Stdout,
+			Stderr:   opts.IO.Stderr,
+			Terminal: opts.IO.Terminal,
+		},
+	})
+	return t, nil
+}
+
+func (r *Runtime) Get(ctx context.Context, id string) (runtime.Task, error) {
+	return r.tasks.Get(ctx, id)
+}
+
+func (r *Runtime) Tasks(ctx context.Context) ([]runtime.Task, error) {
+	return r.tasks.GetAll(ctx)
+}
+
+// Delete destroys the zone of a task whose init process is not running and
+// unmounts its rootfs
+func (r *Runtime) Delete(ctx context.Context, t runtime.Task) (*runtime.Exit, error) {
+	zt, ok := t.(*task)
+	if !ok {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, "not a zone task")
+	}
+	state, err := zt.State(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if state.Status == runtime.RunningStatus {
+		return nil, errors.Wrapf(errdefs.ErrFailedPrecondition, "task %s is running", zt.id)
+	}
+	if err := r.remove(ctx, zt.bundle, zt.zone); err != nil {
+		return nil, err
+	}
+	r.tasks.Delete(ctx, t)
+
+	exit := zt.init.exit()
+	r.publisher.Publish(ctx, runtime.TaskDeleteEventTopic, &eventsapi.TaskDelete{
+		ContainerID: zt.id,
+		Pid:         exit.Pid,
+		ExitStatus:  exit.Status,
+		ExitedAt:    exit.Timestamp,
+	})
+	return exit, nil
+}
+
+// remove destroys the zone, unmounts its root and removes the bundle
+func (r *Runtime) remove(ctx context.Context, bundle string, z *zone) error {
+	if err := z.destroy(ctx); err != nil {
+		return err
+	}
+	if err := mount.UnmountAll(z.root(), 0); err != nil {
+		return errors.Wrap(err, "unmount rootfs")
+	}
+	return os.RemoveAll(bundle)
+}
+
+// cleanup destroys the zones of the tasks of a previous daemon
+func (r *Runtime) cleanup(ctx context.Context) {
+	nss, err := ioutil.ReadDir(r.root)
+	if err != nil {
+		log.G(ctx).WithError(err).Warn("failed to read the tasks of the previous daemon")
+		return
+	}
+	for _, ns := range nss {
+		ids, err := ioutil.ReadDir(filepath.Join(r.root, ns.Name()))
+		if err != nil {
+			log.G(ctx).WithError(err).WithField("namespace", ns.Name()).Warn("failed to read the tasks of the previous daemon")
+			continue
+		}
+		for _, id := range ids {
+			bundle := filepath.Join(r.root, ns.Name(), id.Name())
+			z := &zone{
+				name: zoneName(ns.Name(), id.Name()),
+				path: filepath.Join(bundle, "zone"),
+			}
+			if err := r.remove(ctx, bundle, z); err != nil {
+				log.G(ctx).WithError(err).WithField("id", id.Name()).WithField("namespace", ns.Name()).Warn("failed to destroy the zone of a previous daemon")
+			}
+		}
+	}
+}
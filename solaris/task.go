@@ -0,0 +1,223 @@
+// +build solaris
+
+package solaris
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/containerd/typeurl"
+	"github.com/gogo/protobuf/types"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+var _ = (runtime.Task)(&task{})
+
+// task is a container running in a zone, its init process being the
+// process of its spec
+type task struct {
+	id        string
+	namespace string
+	bundle    string
+	zone      *zone
+	spec      *specs.Spec
+	rootfs    []mount.Mount
+	options   *types.Any
+	publisher events.Publisher
+
+	init *process
+
+	mu        sync.Mutex
+	processes map[string]*process
+}
+
+func newTask(id, namespace, bundle string, z *zone, spec *specs.Spec, opts runtime.CreateOpts, publisher events.Publisher) *task {
+	t := &task{
+		id:        id,
+		namespace: namespace,
+		bundle:    bundle,
+		zone:      z,
+		spec:      spec,
+		rootfs:    opts.Rootfs,
+		options:   opts.Options,
+		publisher: publisher,
+		processes: make(map[string]*process),
+	}
+	t.init = newProcess(t, id, spec.Process, opts.IO)
+	return t
+}
+
+func (t *task) ID() string {
+	return t.id
+}
+
+func (t *task) Info() runtime.TaskInfo {
+	spec, _ := json.Marshal(t.spec)
+	return runtime.TaskInfo{
+		ID:        t.id,
+		Runtime:   pluginID,
+		Spec:      spec,
+		Namespace: t.namespace,
+		Bundle:    t.bundle,
+		Rootfs:    t.rootfs,
+		Options:   t.options,
+	}
+}
+
+func (t *task) State(ctx context.Context) (runtime.State, error) {
+	return t.init.State(ctx)
+}
+
+func (t *task) Kill(ctx context.Context, sig uint32, all bool) error {
+	return t.init.Kill(ctx, sig, all)
+}
+
+func (t *task) ResizePty(ctx context.Context, size runtime.ConsoleSize) error {
+	return t.init.ResizePty(ctx, size)
+}
+
+func (t *task) CloseIO(ctx context.Context) error {
+	return t.init.CloseIO(ctx)
+}
+
+// Start starts the init process once the milestone of the spec, if any, is
+// online in the zone
+func (t *task) Start(ctx context.Context) error {
+	if t.spec.Solaris != nil && t.spec.Solaris.Milestone != "" {
+		if err := t.zone.waitMilestone(ctx, t.spec.Solaris.Milestone); err != nil {
+			return err
+		}
+	}
+	if err := t.init.Start(ctx); err != nil {
+		return err
+	}
+	state, _ := t.init.State(ctx)
+	t.publisher.Publish(ctx, runtime.TaskStartEventTopic, &eventsapi.TaskStart{
+		ContainerID: t.id,
+		Pid:         state.Pid,
+	})
+	return nil
+}
+
+func (t *task) Pause(ctx context.Context) error {
+	return errors.Wrap(errdefs.ErrNotImplemented, "zones cannot be paused")
+}
+
+func (t *task) Resume(ctx context.Context) error {
+	return errors.Wrap(errdefs.ErrNotImplemented, "zones cannot be paused")
+}
+
+func (t *task) Exec(ctx context.Context, id string, opts runtime.ExecOpts) (runtime.Process, error) {
+	if opts.IO.Terminal {
+		return nil, errors.Wrap(errdefs.ErrNotImplemented, "zones do not support terminals")
+	}
+	if state, _ := t.init.State(ctx); state.Status != runtime.RunningStatus {
+		return nil, errors.Wrapf(errdefs.ErrFailedPrecondition, "task %s is not running", t.id)
+	}
+	v, err := typeurl.UnmarshalAny(opts.Spec)
+	if err != nil {
+		return nil, err
+	}
+	spec, ok := v.(*specs.Process)
+	if !ok {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "%s is not a process spec", opts.Spec.TypeUrl)
+	}
+	if spec.Cwd == "" {
+		spec.Cwd = t.spec.Process.Cwd
+	}
+	t.mu.Lock()
+	if _, ok := t.processes[id]; ok || id == t.id {
+		t.mu.Unlock()
+		return nil, errors.Wrapf(errdefs.ErrAlreadyExists, "process %s", id)
+	}
+	p := newProcess(t, id, spec, opts.IO)
+	t.processes[id] = p
+	t.mu.Unlock()
+
+	t.publisher.Publish(ctx, runtime.TaskExecAddedEventTopic, &eventsapi.TaskExecAdded{
+		ContainerID: t.id,
+		ExecID:      id,
+	})
+	return p, nil
+}
+
+// Pids returns the pids of the zlogin of the processes of the task along
+// with the pids of the processes running in the zone
+func (t *task) Pids(ctx context.Context) ([]runtime.ProcessInfo, error) {
+	var infos []runtime.ProcessInfo
+	for _, p := range t.all() {
+		state, _ := p.State(ctx)
+		if state.Status != runtime.RunningStatus {
+			continue
+		}
+		info := runtime.ProcessInfo{Pid: state.Pid}
+		if p != t.init {
+			info.ExecID = p.id
+		}
+		infos = append(infos, info)
+	}
+	pids, err := t.zone.pids(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, pid := range pids {
+		infos = append(infos, runtime.ProcessInfo{Pid: pid})
+	}
+	return infos, nil
+}
+
+func (t *task) Checkpoint(ctx context.Context, path string, options *types.Any) error {
+	return errors.Wrap(errdefs.ErrNotImplemented, "zones cannot be checkpointed")
+}
+
+func (t *task) DeleteProcess(ctx context.Context, id string) (*runtime.Exit, error) {
+	if id == t.id {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, "cannot delete the init process")
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	p, ok := t.processes[id]
+	if !ok {
+		return nil, errors.Wrapf(errdefs.ErrNotFound, "process %s", id)
+	}
+	if state, _ := p.State(ctx); state.Status == runtime.RunningStatus {
+		return nil, errors.Wrapf(errdefs.ErrFailedPrecondition, "process %s is running", id)
+	}
+	delete(t.processes, id)
+	return p.exit(), nil
+}
+
+func (t *task) Update(ctx context.Context, resources *types.Any) error {
+	return errors.Wrap(errdefs.ErrNotImplemented, "the resources of zones cannot be updated")
+}
+
+func (t *task) Process(ctx context.Context, id string) (runtime.Process, error) {
+	if id == t.id {
+		return t.init, nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	p, ok := t.processes[id]
+	if !ok {
+		return nil, errors.Wrapf(errdefs.ErrNotFound, "process %s", id)
+	}
+	return p, nil
+}
+
+// all returns the init process and the exec processes of the task
+func (t *task) all() []*process {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ps := []*process{t.init}
+	for _, p := range t.processes {
+		ps = append(ps, p)
+	}
+	return ps
+}
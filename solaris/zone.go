@@ -0,0 +1,163 @@
+// +build solaris
+
+package solaris
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	zoneadmPath = "/usr/sbin/zoneadm"
+	zonecfgPath = "/usr/sbin/zonecfg"
+	zloginPath  = "/usr/sbin/zlogin"
+	pgrepPath   = "/usr/bin/pgrep"
+	pkillPath   = "/usr/bin/pkill"
+)
+
+// zone is the zone of a container, whose root is the rootfs of the
+// container mounted under its path
+type zone struct {
+	name string
+	path string
+}
+
+func (z *zone) root() string {
+	return filepath.Join(z.path, "root")
+}
+
+// create configures the zone with the zonecfg commands and attaches it to
+// the root mounted under its path, without the validation of the packages
+// of the root that an install would do
+func (z *zone) create(ctx context.Context, cfg string) error {
+	file := filepath.Join(filepath.Dir(z.path), "zone.cfg")
+	if err := ioutil.WriteFile(file, []byte(cfg), 0600); err != nil {
+		return err
+	}
+	if _, err := run(ctx, zonecfgPath, "-z", z.name, "-f", file); err != nil {
+		return err
+	}
+	_, err := run(ctx, zoneadmPath, "-z", z.name, "attach", "-F")
+	return err
+}
+
+func (z *zone) boot(ctx context.Context) error {
+	_, err := run(ctx, zoneadmPath, "-z", z.name, "boot")
+	return err
+}
+
+func (z *zone) halt(ctx context.Context) error {
+	_, err := run(ctx, zoneadmPath, "-z", z.name, "halt")
+	return err
+}
+
+// destroy halts, detaches and deletes the zone, leaving its root in place.
+// Zones that do not exist are already destroyed.
+func (z *zone) destroy(ctx context.Context) error {
+	if _, err := run(ctx, zoneadmPath, "-z", z.name, "list"); err != nil {
+		return nil
+	}
+	if err := z.halt(ctx); err != nil {
+		return err
+	}
+	if _, err := run(ctx, zoneadmPath, "-z", z.name, "detach"); err != nil {
+		return err
+	}
+	_, err := run(ctx, zonecfgPath, "-z", z.name, "delete", "-F")
+	return err
+}
+
+// waitMilestone waits for the SMF service of the zone to be online
+func (z *zone) waitMilestone(ctx context.Context, fmri string) error {
+	for {
+		out, err := run(ctx, zloginPath, z.name, "/usr/bin/svcs", "-H", "-o", "state", fmri)
+		if err == nil && strings.TrimSpace(string(out)) == "online" {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "milestone %s of zone %s", fmri, z.name)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// pids returns the pids of the processes running in the zone
+func (z *zone) pids(ctx context.Context) ([]uint32, error) {
+	out, err := run(ctx, pgrepPath, "-z", z.name)
+	if err != nil {
+		// pgrep exits with 1 when no process matches
+		if exitStatus(err) == 1 {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var pids []uint32
+	s := bufio.NewScanner(bytes.NewReader(out))
+	for s.Scan() {
+		pid, err := strconv.ParseUint(strings.TrimSpace(s.Text()), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		pids = append(pids, uint32(pid))
+	}
+	return pids, s.Err()
+}
+
+// signal sends the signal to all the processes of the zone
+func (z *zone) signal(ctx context.Context, sig syscall.Signal) error {
+	_, err := run(ctx, pkillPath, "-"+strconv.Itoa(int(sig)), "-z", z.name)
+	if exitStatus(err) == 1 {
+		return nil
+	}
+	return err
+}
+
+// run runs the command, returning its output or an error with the output of
+// the command
+func run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, &commandError{
+			err:    errors.Wrapf(err, "%s %s: %s", filepath.Base(name), strings.Join(args, " "), strings.TrimSpace(stderr.String())),
+			status: cmd.ProcessState,
+		}
+	}
+	return out, nil
+}
+
+type commandError struct {
+	err    error
+	status *os.ProcessState
+}
+
+func (e *commandError) Error() string {
+	return e.err.Error()
+}
+
+// exitStatus returns the exit status of the command of the error, -1 when
+// the command did not exit
+func exitStatus(err error) int {
+	e, ok := err.(*commandError)
+	if !ok || e.status == nil {
+		return -1
+	}
+	if ws, ok := e.status.Sys().(syscall.WaitStatus); ok && ws.Exited() {
+		return ws.ExitStatus()
+	}
+	return -1
+}
@@ -0,0 +1,122 @@
+package solaris
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/containerd/containerd/errdefs"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// zoneName returns the name of the zone of the container with the id in the
+// namespace. Zone names are shorter than container ids may be, so the name
+// is derived from a digest of the namespace and the id.
+func zoneName(namespace, id string) string {
+	d := sha256.Sum256([]byte(namespace + "/" + id))
+	return fmt.Sprintf("containerd-%x", d[:16])
+}
+
+// zoneConfig returns the zonecfg commands configuring the zone of a
+// container of the brand at the zonepath, after the Solaris section of its
+// spec
+func zoneConfig(zonepath, brand string, spec *specs.Spec) (string, error) {
+	c := &zonecfg{}
+	c.line("create -b")
+	c.set("zonepath", zonepath)
+	c.set("brand", brand)
+	c.set("autoboot", "false")
+	if s := spec.Solaris; s != nil {
+		c.set("limitpriv", s.LimitPriv)
+		c.set("max-shm-memory", s.MaxShmMemory)
+		if len(s.Anet) > 0 {
+			c.set("ip-type", "exclusive")
+		}
+		for _, a := range s.Anet {
+			c.line("add anet")
+			c.set("linkname", a.Linkname)
+			c.set("lower-link", a.Lowerlink)
+			c.set("allowed-address", a.Allowedaddr)
+			c.set("configure-allowed-address", a.Configallowedaddr)
+			c.set("defrouter", a.Defrouter)
+			c.set("link-protection", a.Linkprotection)
+			c.set("mac-address", a.Macaddress)
+			c.line("end")
+		}
+		if s.CappedCPU != nil && s.CappedCPU.Ncpus != "" {
+			c.line("add capped-cpu")
+			c.set("ncpus", s.CappedCPU.Ncpus)
+			c.line("end")
+		}
+		if m := s.CappedMemory; m != nil && (m.Physical != "" || m.Swap != "") {
+			c.line("add capped-memory")
+			c.set("physical", m.Physical)
+			c.set("swap", m.Swap)
+			c.line("end")
+		}
+	}
+	c.line("commit")
+	if c.err != nil {
+		return "", c.err
+	}
+	return c.buf.String(), nil
+}
+
+// zonecfg writes zonecfg commands, keeping the first invalid value
+type zonecfg struct {
+	buf bytes.Buffer
+	err error
+}
+
+func (c *zonecfg) line(l string) {
+	c.buf.WriteString(l)
+	c.buf.WriteByte('\n')
+}
+
+// set sets the property to the value, unless the value is empty
+func (c *zonecfg) set(property, value string) {
+	if value == "" {
+		return
+	}
+	if strings.ContainsAny(value, "\"\n") && c.err == nil {
+		c.err = errors.Wrapf(errdefs.ErrInvalidArgument, "invalid %s %q", property, value)
+		return
+	}
+	c.line(fmt.Sprintf("set %s=\"%s\"", property, value))
+}
+
+// zloginArgs returns the arguments of zlogin running the process in the
+// zone. zlogin runs its command line with the shell of the user, so the
+// command line changes to the working directory of the process and replaces
+// itself with the process, with exactly its environment.
+func zloginArgs(zone string, p *specs.Process) ([]string, error) {
+	if len(p.Args) == 0 {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, "process has no args")
+	}
+	user := p.User.Username
+	if user == "" {
+		if p.User.UID != 0 {
+			return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "zones run processes as users named in the zone, not uid %d", p.User.UID)
+		}
+		user = "root"
+	}
+	cwd := p.Cwd
+	if cwd == "" {
+		cwd = "/"
+	}
+	words := []string{"cd", shellQuote(cwd), "&&", "exec", "/usr/bin/env", "-i"}
+	for _, e := range p.Env {
+		words = append(words, shellQuote(e))
+	}
+	for _, a := range p.Args {
+		words = append(words, shellQuote(a))
+	}
+	return []string{"-l", user, zone, strings.Join(words, " ")}, nil
+}
+
+// shellQuote quotes the string as a single word of a shell command line
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
@@ -0,0 +1,83 @@
+package solaris
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestZoneName(t *testing.T) {
+	name := zoneName("default", strings.Repeat("a", 76))
+	if len(name) > 64 {
+		t.Fatalf("zone name %s is longer than 64 characters", name)
+	}
+	if name == zoneName("other", strings.Repeat("a", 76)) {
+		t.Fatal("expected containers of different namespaces to have different zones")
+	}
+}
+
+func TestZoneConfig(t *testing.T) {
+	cfg, err := zoneConfig("/var/lib/containerd/zone", "solaris", &specs.Spec{
+		Solaris: &specs.Solaris{
+			LimitPriv: "default",
+			Anet: []specs.SolarisAnet{
+				{Linkname: "net0", Lowerlink: "auto"},
+			},
+			CappedCPU:    &specs.SolarisCappedCPU{Ncpus: "2"},
+			CappedMemory: &specs.SolarisCappedMemory{Physical: "512m"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `create -b
+set zonepath="/var/lib/containerd/zone"
+set brand="solaris"
+set autoboot="false"
+set limitpriv="default"
+set ip-type="exclusive"
+add anet
+set linkname="net0"
+set lower-link="auto"
+end
+add capped-cpu
+set ncpus="2"
+end
+add capped-memory
+set physical="512m"
+end
+commit
+`
+	if cfg != expected {
+		t.Fatalf("expected config\n%s\nbut got\n%s", expected, cfg)
+	}
+
+	_, err = zoneConfig("/zone", "solaris", &specs.Spec{
+		Solaris: &specs.Solaris{LimitPriv: "default\ncommit"},
+	})
+	if !errdefs.IsInvalidArgument(err) {
+		t.Fatalf("expected an invalid argument error but got %v", err)
+	}
+}
+
+func TestZloginArgs(t *testing.T) {
+	args, err := zloginArgs("zone", &specs.Process{
+		Args: []string{"sh", "-c", "echo 'hello'"},
+		Env:  []string{"PATH=/usr/bin"},
+		Cwd:  "/home/user",
+		User: specs.User{Username: "user"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"-l", "user", "zone", `cd '/home/user' && exec /usr/bin/env -i 'PATH=/usr/bin' 'sh' '-c' 'echo '\''hello'\'''`}
+	if strings.Join(args, "|") != strings.Join(expected, "|") {
+		t.Fatalf("expected %q but got %q", expected, args)
+	}
+
+	if _, err := zloginArgs("zone", &specs.Process{Args: []string{"sh"}, User: specs.User{UID: 1000}}); !errdefs.IsInvalidArgument(err) {
+		t.Fatalf("expected an invalid argument error but got %v", err)
+	}
+}
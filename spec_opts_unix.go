@@ -7,17 +7,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
-	"strconv"
-	"strings"
 
 	"github.com/containerd/containerd/containers"
 	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/images"
 	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/oci"
 	"github.com/containerd/containerd/typeurl"
 	"github.com/opencontainers/image-spec/identity"
 	"github.com/opencontainers/image-spec/specs-go/v1"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
 )
 
 // WithTTY sets the information on the spec as well as the environment variables for
@@ -41,6 +42,78 @@ func WithHostNamespace(ns specs.LinuxNamespaceType) SpecOpts {
 	}
 }
 
+// WithHostNetwork runs the task in the host's network namespace with the
+// host's hosts and resolv.conf files
+func WithHostNetwork(s *specs.Spec) error {
+	for _, o := range []SpecOpts{
+		WithHostNamespace(specs.NetworkNamespace),
+		WithHostHostsFile,
+		WithHostResolvconf,
+	} {
+		if err := o(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithHostPID runs the task in the host's pid namespace, where it sees all
+// the processes of the host
+func WithHostPID(s *specs.Spec) error {
+	return WithHostNamespace(specs.PIDNamespace)(s)
+}
+
+// WithHostIPC runs the task in the host's ipc namespace
+func WithHostIPC(s *specs.Spec) error {
+	return WithHostNamespace(specs.IPCNamespace)(s)
+}
+
+// WithNoCgroupLimits removes the resource limits of the task, only the
+// device rules are kept
+func WithNoCgroupLimits(s *specs.Spec) error {
+	if s.Linux.Resources == nil {
+		return nil
+	}
+	s.Linux.Resources = &specs.LinuxResources{
+		Devices: s.Linux.Resources.Devices,
+	}
+	return nil
+}
+
+// WithDevices adds the devices to the container and allows them in its
+// device cgroup
+func WithDevices(devices ...specs.LinuxDevice) SpecOpts {
+	return func(s *specs.Spec) error {
+		if s.Linux.Resources == nil {
+			s.Linux.Resources = &specs.LinuxResources{}
+		}
+		for _, d := range devices {
+			major, minor := d.Major, d.Minor
+			s.Linux.Devices = append(s.Linux.Devices, d)
+			s.Linux.Resources.Devices = append(s.Linux.Resources.Devices, specs.LinuxDeviceCgroup{
+				Allow:  true,
+				Type:   d.Type,
+				Major:  &major,
+				Minor:  &minor,
+				Access: "rwm",
+			})
+		}
+		return nil
+	}
+}
+
+// WithDeviceRules appends the rules to the device cgroup of the container,
+// such as allowing the character devices of a major number
+func WithDeviceRules(rules ...specs.LinuxDeviceCgroup) SpecOpts {
+	return func(s *specs.Spec) error {
+		if s.Linux.Resources == nil {
+			s.Linux.Resources = &specs.LinuxResources{}
+		}
+		s.Linux.Resources.Devices = append(s.Linux.Resources.Devices, rules...)
+		return nil
+	}
+}
+
 // WithLinuxNamespace uses the passed in namespace for the spec. If a namespace of the same type already exists in the
 // spec, the existing namespace is replaced by the one provided.
 func WithLinuxNamespace(ns specs.LinuxNamespace) SpecOpts {
@@ -62,68 +135,37 @@ func WithLinuxNamespace(ns specs.LinuxNamespace) SpecOpts {
 // WithImageConfig configures the spec to from the configuration of an Image
 func WithImageConfig(ctx context.Context, i Image) SpecOpts {
 	return func(s *specs.Spec) error {
-		var (
-			image = i.(*image)
-			store = image.client.ContentStore()
-		)
-		ic, err := image.i.Config(ctx, store)
+		config, err := readImageConfig(ctx, i)
 		if err != nil {
 			return err
 		}
-		var (
-			ociimage v1.Image
-			config   v1.ImageConfig
-		)
-		switch ic.MediaType {
-		case v1.MediaTypeImageConfig, images.MediaTypeDockerSchema2Config:
-			p, err := content.ReadBlob(ctx, store, ic.Digest)
-			if err != nil {
-				return err
-			}
+		return oci.WithImageConfig(config)(s)
+	}
+}
 
-			if err := json.Unmarshal(p, &ociimage); err != nil {
-				return err
-			}
-			config = ociimage.Config
-		default:
-			return fmt.Errorf("unknown image config media type %s", ic.MediaType)
-		}
-		s.Process.Env = append(s.Process.Env, config.Env...)
-		var (
-			uid, gid uint32
-		)
-		cmd := config.Cmd
-		s.Process.Args = append(config.Entrypoint, cmd...)
-		if config.User != "" {
-			parts := strings.Split(config.User, ":")
-			switch len(parts) {
-			case 1:
-				v, err := strconv.ParseUint(parts[0], 0, 10)
-				if err != nil {
-					return err
-				}
-				uid, gid = uint32(v), uint32(v)
-			case 2:
-				v, err := strconv.ParseUint(parts[0], 0, 10)
-				if err != nil {
-					return err
-				}
-				uid = uint32(v)
-				if v, err = strconv.ParseUint(parts[1], 0, 10); err != nil {
-					return err
-				}
-				gid = uint32(v)
-			default:
-				return fmt.Errorf("invalid USER value %s", config.User)
-			}
+// readImageConfig returns the runtime configuration of the image
+func readImageConfig(ctx context.Context, i Image) (v1.ImageConfig, error) {
+	var (
+		image = i.(*image)
+		store = image.client.ContentStore()
+	)
+	ic, err := image.i.Config(ctx, store)
+	if err != nil {
+		return v1.ImageConfig{}, err
+	}
+	switch ic.MediaType {
+	case v1.MediaTypeImageConfig, images.MediaTypeDockerSchema2Config:
+		p, err := content.ReadBlob(ctx, store, ic.Digest)
+		if err != nil {
+			return v1.ImageConfig{}, err
 		}
-		s.Process.User.UID, s.Process.User.GID = uid, gid
-		cwd := config.WorkingDir
-		if cwd == "" {
-			cwd = "/"
+		var ociimage v1.Image
+		if err := json.Unmarshal(p, &ociimage); err != nil {
+			return v1.ImageConfig{}, err
 		}
-		s.Process.Cwd = cwd
-		return nil
+		return ociimage.Config, nil
+	default:
+		return v1.ImageConfig{}, fmt.Errorf("unknown image config media type %s", ic.MediaType)
 	}
 }
 
@@ -225,9 +267,48 @@ func WithUserNamespace(container, host, size uint32) SpecOpts {
 	}
 }
 
+// WithUserNamespaceMappings sets the uid and gid mappings of the user
+// namespace of the task, for uids and gids mapped to different ranges of the
+// host
+func WithUserNamespaceMappings(uidMappings, gidMappings []specs.LinuxIDMapping) SpecOpts {
+	return func(s *specs.Spec) error {
+		if len(uidMappings) == 0 || len(gidMappings) == 0 {
+			return errors.Wrap(errdefs.ErrInvalidArgument, "user namespace requires uid and gid mappings")
+		}
+		var hasUserns bool
+		for _, ns := range s.Linux.Namespaces {
+			if ns.Type == specs.UserNamespace {
+				hasUserns = true
+				break
+			}
+		}
+		if !hasUserns {
+			s.Linux.Namespaces = append(s.Linux.Namespaces, specs.LinuxNamespace{
+				Type: specs.UserNamespace,
+			})
+		}
+		s.Linux.UIDMappings = append(s.Linux.UIDMappings, uidMappings...)
+		s.Linux.GIDMappings = append(s.Linux.GIDMappings, gidMappings...)
+		return nil
+	}
+}
+
 // WithRemappedSnapshot creates a new snapshot and remaps the uid/gid for the
 // filesystem to be used by a container with user namespaces
 func WithRemappedSnapshot(id string, i Image, uid, gid uint32) NewContainerOpts {
+	return withRemappedSnapshotBase(id, i, uid, gid, false)
+}
+
+// WithRemappedSnapshotView is WithRemappedSnapshot with a read-only view of
+// the remapped filesystem
+func WithRemappedSnapshotView(id string, i Image, uid, gid uint32) NewContainerOpts {
+	return withRemappedSnapshotBase(id, i, uid, gid, true)
+}
+
+// withRemappedSnapshotBase prepares the snapshot, or view, of the container
+// from a committed snapshot of the layers of the image remapped to the uid
+// and gid, which is shared by the containers of the image with the same ids
+func withRemappedSnapshotBase(id string, i Image, uid, gid uint32, readonly bool) NewContainerOpts {
 	return func(ctx context.Context, client *Client, c *containers.Container) error {
 		diffIDs, err := i.(*image).i.RootFS(ctx, client.ContentStore())
 		if err != nil {
@@ -240,9 +321,13 @@ func WithRemappedSnapshot(id string, i Image, uid, gid uint32) NewContainerOpts
 			snapshotter = client.SnapshotService(c.Snapshotter)
 			parent      = identity.ChainID(diffIDs).String()
 			usernsID    = fmt.Sprintf("%s-%d-%d", parent, uid, gid)
+			prepare     = snapshotter.Prepare
 		)
+		if readonly {
+			prepare = snapshotter.View
+		}
 		if _, err := snapshotter.Stat(ctx, usernsID); err == nil {
-			if _, err := snapshotter.Prepare(ctx, id, usernsID); err != nil {
+			if _, err := prepare(ctx, id, usernsID); err != nil {
 				return err
 			}
 			c.RootFS = id
@@ -260,7 +345,7 @@ func WithRemappedSnapshot(id string, i Image, uid, gid uint32) NewContainerOpts
 		if err := snapshotter.Commit(ctx, usernsID, usernsID+"-remap"); err != nil {
 			return err
 		}
-		if _, err := snapshotter.Prepare(ctx, id, usernsID); err != nil {
+		if _, err := prepare(ctx, id, usernsID); err != nil {
 			return err
 		}
 		c.RootFS = id
@@ -5,6 +5,7 @@ package containerd
 import (
 	"testing"
 
+	"github.com/containerd/containerd/oci"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
@@ -20,7 +21,7 @@ func TestGenerateSpec(t *testing.T) {
 	}
 
 	// check for matching caps
-	defaults := defaltCaps()
+	defaults := oci.DefaultCapabilities()
 	for _, cl := range [][]string{
 		s.Process.Capabilities.Bounding,
 		s.Process.Capabilities.Permitted,
@@ -35,7 +36,7 @@ func TestGenerateSpec(t *testing.T) {
 	}
 
 	// check default namespaces
-	defaultNS := defaultNamespaces()
+	defaultNS := oci.DefaultNamespaces()
 	for i, ns := range s.Linux.Namespaces {
 		if defaultNS[i] != ns {
 			t.Errorf("ns at %d does not match set %q != %q", i, defaultNS[i], ns)
@@ -73,7 +74,7 @@ func TestWithLinuxNamespace(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	defaultNS := defaultNamespaces()
+	defaultNS := oci.DefaultNamespaces()
 	found := false
 	for i, ns := range s.Linux.Namespaces {
 		if ns == replacedNS && !found {
@@ -85,3 +86,37 @@ func TestWithLinuxNamespace(t *testing.T) {
 		}
 	}
 }
+
+func TestSpecWithSystemContainer(t *testing.T) {
+	t.Parallel()
+
+	limit := int64(1 << 20)
+	s, err := GenerateSpec(func(s *specs.Spec) error {
+		s.Linux.Resources.Memory = &specs.LinuxMemory{Limit: &limit}
+		return nil
+	}, WithHostNetwork, WithHostPID, WithHostIPC, WithNoCgroupLimits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ns := range s.Linux.Namespaces {
+		switch ns.Type {
+		case specs.NetworkNamespace, specs.PIDNamespace, specs.IPCNamespace:
+			t.Errorf("%s namespace not removed", ns.Type)
+		}
+	}
+	var hosts bool
+	for _, m := range s.Mounts {
+		if m.Destination == "/etc/hosts" && m.Source == "/etc/hosts" {
+			hosts = true
+		}
+	}
+	if !hosts {
+		t.Error("host /etc/hosts not mounted")
+	}
+	if s.Linux.Resources.Memory != nil {
+		t.Error("memory limit not removed")
+	}
+	if len(s.Linux.Resources.Devices) == 0 {
+		t.Error("device rules removed")
+	}
+}
@@ -0,0 +1,66 @@
+// Package streaming adapts the data carried by the messages of GRPC streams,
+// such as those exporting and importing images, to writers and readers
+package streaming
+
+import (
+	"bufio"
+	"io"
+)
+
+// ChunkSize is the size of the data sent in each message of a stream
+const ChunkSize = 1 << 20
+
+// NewWriter returns a writer sending the data written to it with send in
+// chunks of ChunkSize. The writer must be flushed once all the data is
+// written.
+func NewWriter(send func([]byte) error) *bufio.Writer {
+	return bufio.NewWriterSize(sendWriter(send), ChunkSize)
+}
+
+// sendWriter sends the data written to it in messages of at most ChunkSize,
+// as the buffered writer passes writes larger than its buffer through
+type sendWriter func([]byte) error
+
+func (w sendWriter) Write(p []byte) (int, error) {
+	var n int
+	for n < len(p) {
+		end := n + ChunkSize
+		if end > len(p) {
+			end = len(p)
+		}
+		if err := w(p[n:end]); err != nil {
+			return n, err
+		}
+		n = end
+	}
+	return n, nil
+}
+
+// NewReader returns a reader of the data of the messages received with
+// recv, starting with the data of the first message when the caller already
+// received it. Reading ends with the error of recv, io.EOF once the client
+// closes the stream.
+func NewReader(first []byte, recv func() ([]byte, error)) io.Reader {
+	return &recvReader{
+		recv: recv,
+		data: first,
+	}
+}
+
+type recvReader struct {
+	recv func() ([]byte, error)
+	data []byte
+}
+
+func (r *recvReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		data, err := r.recv()
+		if err != nil {
+			return 0, err
+		}
+		r.data = data
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
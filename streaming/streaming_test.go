@@ -0,0 +1,46 @@
+package streaming
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+func TestWriterReader(t *testing.T) {
+	data := bytes.Repeat([]byte("containerd"), ChunkSize/4)
+	var messages [][]byte
+	w := NewWriter(func(p []byte) error {
+		messages = append(messages, append([]byte(nil), p...))
+		return nil
+	})
+	if _, err := w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range messages {
+		if len(m) > ChunkSize {
+			t.Fatalf("expected messages of at most %d bytes, got %d", ChunkSize, len(m))
+		}
+	}
+
+	// the first message is passed to the reader, which receives the others
+	first, rest := messages[0], messages[1:]
+	r := NewReader(first, func() ([]byte, error) {
+		if len(rest) == 0 {
+			return nil, io.EOF
+		}
+		m := rest[0]
+		rest = rest[1:]
+		return m, nil
+	})
+	read, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(read, data) {
+		t.Fatalf("expected %d bytes to be read back, got %d", len(data), len(read))
+	}
+}
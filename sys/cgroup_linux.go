@@ -0,0 +1,79 @@
+package sys
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// CgroupUnifiedMountpoint is where the cgroup2 hierarchy is mounted on
+	// hosts running in unified mode
+	CgroupUnifiedMountpoint = "/sys/fs/cgroup"
+	// cgroup2SuperMagic is the filesystem type of the cgroup2 hierarchy
+	cgroup2SuperMagic = 0x63677270
+)
+
+var (
+	unifiedOnce sync.Once
+	unified     bool
+)
+
+// IsCgroupUnified returns whether the host runs the cgroup2 hierarchy only
+// rather than the cgroup v1 hierarchies. It is checked once, as the mode of
+// the cgroups is chosen when the host boots.
+func IsCgroupUnified() bool {
+	unifiedOnce.Do(func() {
+		var st unix.Statfs_t
+		if err := unix.Statfs(CgroupUnifiedMountpoint, &st); err != nil {
+			return
+		}
+		unified = st.Type == cgroup2SuperMagic
+	})
+	return unified
+}
+
+// CgroupUnifiedPath returns the path of the cgroup of the pid in the cgroup2
+// hierarchy
+func CgroupUnifiedPath(pid int) (string, error) {
+	f, err := os.Open(fmt.Sprintf("/proc/%d/cgroup", pid))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		if p := strings.TrimPrefix(s.Text(), "0::"); p != s.Text() {
+			return filepath.Join(CgroupUnifiedMountpoint, p), nil
+		}
+	}
+	if err := s.Err(); err != nil {
+		return "", err
+	}
+	return "", errors.Errorf("no cgroup2 cgroup for pid %d", pid)
+}
+
+// IsCgroupDelegated returns whether containerd can manage the cgroups of its
+// containers. It always can with real root, and in rootless mode only when
+// the cgroup2 cgroup it runs in was delegated to its user, such as with the
+// Delegate option of a systemd user unit.
+func IsCgroupDelegated() bool {
+	if !IsRootless() {
+		return true
+	}
+	if !IsCgroupUnified() {
+		return false
+	}
+	path, err := CgroupUnifiedPath(os.Getpid())
+	if err != nil {
+		return false
+	}
+	return unix.Access(filepath.Join(path, "cgroup.procs"), unix.W_OK) == nil &&
+		unix.Access(filepath.Join(path, "cgroup.subtree_control"), unix.W_OK) == nil
+}
@@ -0,0 +1,112 @@
+package sys
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// namespaceNames are the names of the namespace files of /proc by the clone
+// flags of the namespaces that can be kept without processes
+var namespaceNames = map[int]string{
+	unix.CLONE_NEWNET: "net",
+	unix.CLONE_NEWIPC: "ipc",
+	unix.CLONE_NEWUTS: "uts",
+}
+
+// NewNamespace creates a namespace of the clone flag, such as
+// unix.CLONE_NEWNET, and bind mounts it at the path so that it is kept
+// without processes
+func NewNamespace(path string, flag int) (err error) {
+	name, ok := namespaceNames[flag]
+	if !ok {
+		return errors.Errorf("namespace of flag %#x cannot be bind mounted", flag)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0711); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_RDONLY|os.O_CREATE|os.O_EXCL, 0444)
+	if err != nil {
+		return err
+	}
+	f.Close()
+	defer func() {
+		if err != nil {
+			os.Remove(path)
+		}
+	}()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		runtime.LockOSThread()
+		var restored bool
+		restored, err = unshareAt(path, name, flag)
+		wg.Done()
+		if !restored {
+			// the thread is kept locked to the goroutine, blocked forever,
+			// so that no other goroutine is scheduled in the new namespace
+			select {}
+		}
+		runtime.UnlockOSThread()
+	}()
+	wg.Wait()
+	return err
+}
+
+// unshareAt moves the locked thread of the caller into a new namespace,
+// bind mounts it at the path and moves the thread back into its original
+// namespace, returning whether the thread was restored
+func unshareAt(path, name string, flag int) (bool, error) {
+	ns := fmt.Sprintf("/proc/self/task/%d/ns/%s", unix.Gettid(), name)
+	origin, err := os.Open(ns)
+	if err != nil {
+		return true, errors.Wrapf(err, "failed to open the %s namespace of the thread", name)
+	}
+	defer origin.Close()
+	if err := unix.Unshare(flag); err != nil {
+		return true, errors.Wrapf(err, "failed to create %s namespace", name)
+	}
+	mountErr := unix.Mount(ns, path, "none", unix.MS_BIND, "")
+	if err := unix.Setns(int(origin.Fd()), flag); err != nil {
+		return false, errors.Wrapf(err, "failed to restore the %s namespace of the thread", name)
+	}
+	if mountErr != nil {
+		return true, errors.Wrapf(mountErr, "failed to bind mount %s namespace at %s", name, path)
+	}
+	return true, nil
+}
+
+// BindNamespace bind mounts the namespace file of a process, such as
+// /proc/42/ns/net, at the path so that the namespace is kept once the
+// processes in it exit
+func BindNamespace(source, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0711); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_RDONLY|os.O_CREATE|os.O_EXCL, 0444)
+	if err != nil {
+		return err
+	}
+	f.Close()
+	if err := unix.Mount(source, path, "none", unix.MS_BIND, ""); err != nil {
+		os.Remove(path)
+		return errors.Wrapf(err, "failed to bind mount namespace %s at %s", source, path)
+	}
+	return nil
+}
+
+// RemoveNamespace unmounts and removes the namespace at the path
+func RemoveNamespace(path string) error {
+	if err := unix.Unmount(path, unix.MNT_DETACH); err != nil && err != unix.EINVAL && err != unix.ENOENT {
+		return errors.Wrapf(err, "failed to unmount namespace at %s", path)
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
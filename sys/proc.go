@@ -4,7 +4,9 @@ package sys
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strconv"
 	"strings"
@@ -62,3 +64,25 @@ func GetSystemCPUUsage() (uint64, error) {
 	}
 	return 0, fmt.Errorf("bad stats format")
 }
+
+// ProcessStartTime returns the time the process started in clock ticks since
+// boot, as reported by /proc/<pid>/stat, which tells a process from a later
+// one reusing its pid
+func ProcessStartTime(pid int) (uint64, error) {
+	data, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return 0, err
+	}
+	// the command name may contain spaces and parentheses, the fields
+	// following it start after the last closing parenthesis
+	i := bytes.LastIndexByte(data, ')')
+	if i < 0 {
+		return 0, fmt.Errorf("invalid stat data for pid %d", pid)
+	}
+	fields := strings.Fields(string(data[i+1:]))
+	// starttime is the 22nd field, the state field being the 3rd
+	if len(fields) < 20 {
+		return 0, fmt.Errorf("invalid stat data for pid %d", pid)
+	}
+	return strconv.ParseUint(fields[19], 10, 64)
+}
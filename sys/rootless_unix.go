@@ -0,0 +1,16 @@
+// +build !windows
+
+package sys
+
+import (
+	"os"
+
+	"github.com/opencontainers/runc/libcontainer/system"
+)
+
+// IsRootless returns whether containerd runs without real root, either as an
+// unprivileged user or as root in a user namespace such as the one created
+// by RootlessKit, where operations that require real root are not permitted
+func IsRootless() bool {
+	return os.Geteuid() != 0 || system.RunningInUserNS()
+}
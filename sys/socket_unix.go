@@ -3,6 +3,7 @@
 package sys
 
 import (
+	"io/ioutil"
 	"net"
 	"os"
 	"path/filepath"
@@ -21,29 +22,65 @@ func CreateUnixSocket(path string) (net.Listener, error) {
 	return net.Listen("unix", path)
 }
 
-// GetLocalListener returns a listerner out of a unix socket.
-func GetLocalListener(path string, uid, gid int) (net.Listener, error) {
+// GetLocalListener returns a listerner out of a unix socket owned by uid and
+// gid with the mode. The socket is created in a private directory and only
+// moved to the path once its owner and mode are set, so that it is never
+// accessible to other users in between.
+func GetLocalListener(path string, uid, gid int, mode os.FileMode) (net.Listener, error) {
 	// Ensure parent directory is created
-	if err := mkdirAs(filepath.Dir(path), uid, gid); err != nil {
+	dir := filepath.Dir(path)
+	if err := mkdirAs(dir, uid, gid); err != nil {
 		return nil, err
 	}
 
-	l, err := CreateUnixSocket(path)
+	tmpDir, err := ioutil.TempDir(dir, ".sock")
 	if err != nil {
-		return l, err
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+	tmp := filepath.Join(tmpDir, "s")
+	l, err := net.Listen("unix", tmp)
+	if err != nil {
+		return nil, err
+	}
+	// the socket is unlinked at its final path once closed
+	l.(*net.UnixListener).SetUnlinkOnClose(false)
+
+	if err := os.Chown(tmp, uid, gid); err != nil {
+		l.Close()
+		return nil, err
 	}
 
-	if err := os.Chmod(path, 0660); err != nil {
+	if err := os.Chmod(tmp, mode); err != nil {
 		l.Close()
 		return nil, err
 	}
 
-	if err := os.Chown(path, uid, gid); err != nil {
+	if err := os.Rename(tmp, path); err != nil {
 		l.Close()
 		return nil, err
 	}
 
-	return l, nil
+	return &localListener{Listener: l, path: path}, nil
+}
+
+// localListener is a listener of a unix socket moved to path after it was
+// created
+type localListener struct {
+	net.Listener
+	path string
+}
+
+func (l *localListener) Addr() net.Addr {
+	return &net.UnixAddr{Name: l.path, Net: "unix"}
+}
+
+func (l *localListener) Close() error {
+	err := l.Listener.Close()
+	if rerr := os.Remove(l.path); rerr != nil && !os.IsNotExist(rerr) && err == nil {
+		err = rerr
+	}
+	return err
 }
 
 func mkdirAs(path string, uid, gid int) error {
@@ -0,0 +1,49 @@
+// +build !windows
+
+package sys
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetLocalListener(t *testing.T) {
+	dir, err := ioutil.TempDir("", "socket-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "containerd.sock")
+	l, err := GetLocalListener(path, os.Getuid(), os.Getgid(), 0660)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode()&os.ModeSocket == 0 || fi.Mode().Perm() != 0660 {
+		t.Fatalf("expected a socket with mode 0660, got %s", fi.Mode())
+	}
+	if addr := l.Addr().String(); addr != path {
+		t.Fatalf("expected the listener to be bound to %s, got %s", path, addr)
+	}
+	conn, err := net.Dial("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.Close()
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("expected the socket and its directory to be removed, got %d entries", len(entries))
+	}
+}
@@ -4,6 +4,7 @@ package sys
 
 import (
 	"net"
+	"os"
 
 	"github.com/Microsoft/go-winio"
 )
@@ -11,6 +12,6 @@ import (
 // GetLocalListener returns a Listernet out of a named pipe.
 // `path` must be of the form of `\\.\pipe\<pipename>`
 // (see https://msdn.microsoft.com/en-us/library/windows/desktop/aa365150)
-func GetLocalListener(path string, uid, gid int) (net.Listener, error) {
+func GetLocalListener(path string, uid, gid int, mode os.FileMode) (net.Listener, error) {
 	return winio.ListenPipe(path, nil)
 }
@@ -0,0 +1,171 @@
+package sys
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// VsockScheme prefixes the vsock addresses of the shims and the stdio
+	// of processes running in VMs
+	VsockScheme = "vsock://"
+	// VsockHostCID is the vsock context id of the host seen from a guest
+	VsockHostCID = 2
+	// vsockAnyCID listens on the port for any context id
+	vsockAnyCID = 0xFFFFFFFF
+)
+
+// ParseVsockAddress returns the context id and port of a vsock address of
+// the form vsock://[cid:]port, the context id being the host when it is not
+// set
+func ParseVsockAddress(address string) (uint32, uint32, error) {
+	if !strings.HasPrefix(address, VsockScheme) {
+		return 0, 0, errors.Errorf("%q is not a vsock address", address)
+	}
+	var (
+		cid   = uint64(VsockHostCID)
+		port  uint64
+		err   error
+		parts = strings.Split(strings.TrimPrefix(address, VsockScheme), ":")
+	)
+	switch len(parts) {
+	case 2:
+		if cid, err = strconv.ParseUint(parts[0], 10, 32); err != nil {
+			return 0, 0, errors.Errorf("invalid vsock context id in %q", address)
+		}
+		fallthrough
+	case 1:
+		if port, err = strconv.ParseUint(parts[len(parts)-1], 10, 32); err != nil {
+			return 0, 0, errors.Errorf("invalid vsock port in %q", address)
+		}
+	default:
+		return 0, 0, errors.Errorf("invalid vsock address %q", address)
+	}
+	return uint32(cid), uint32(port), nil
+}
+
+// DialVsock connects to the port of the vsock context id
+func DialVsock(cid, port uint32) (net.Conn, error) {
+	fd, err := unix.Socket(unix.AF_VSOCK, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "vsock socket")
+	}
+	if err := unix.Connect(fd, &unix.SockaddrVM{CID: cid, Port: port}); err != nil {
+		unix.Close(fd)
+		return nil, errors.Wrapf(err, "vsock connect %d:%d", cid, port)
+	}
+	return newVsockConn(fd, vsockAddr{cid: cid, port: port}), nil
+}
+
+// ListenVsock listens for connections on the vsock port
+func ListenVsock(port uint32) (net.Listener, error) {
+	fd, err := unix.Socket(unix.AF_VSOCK, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "vsock socket")
+	}
+	if err := unix.Bind(fd, &unix.SockaddrVM{CID: vsockAnyCID, Port: port}); err != nil {
+		unix.Close(fd)
+		return nil, errors.Wrapf(err, "vsock bind %d", port)
+	}
+	if err := unix.Listen(fd, unix.SOMAXCONN); err != nil {
+		unix.Close(fd)
+		return nil, errors.Wrapf(err, "vsock listen %d", port)
+	}
+	return &vsockListener{fd: fd, addr: vsockAddr{cid: vsockAnyCID, port: port}}, nil
+}
+
+type vsockAddr struct {
+	cid  uint32
+	port uint32
+}
+
+func (a vsockAddr) Network() string {
+	return "vsock"
+}
+
+func (a vsockAddr) String() string {
+	return fmt.Sprintf("%d:%d", a.cid, a.port)
+}
+
+type vsockListener struct {
+	fd   int
+	addr vsockAddr
+}
+
+func (l *vsockListener) Accept() (net.Conn, error) {
+	for {
+		fd, sa, err := unix.Accept4(l.fd, unix.SOCK_CLOEXEC)
+		if err == unix.EINTR {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		remote := vsockAddr{}
+		if vm, ok := sa.(*unix.SockaddrVM); ok {
+			remote = vsockAddr{cid: vm.CID, port: vm.Port}
+		}
+		return newVsockConn(fd, remote), nil
+	}
+}
+
+// Close shuts the socket down before closing it so that a blocked Accept
+// returns
+func (l *vsockListener) Close() error {
+	unix.Shutdown(l.fd, unix.SHUT_RDWR)
+	return unix.Close(l.fd)
+}
+
+func (l *vsockListener) Addr() net.Addr {
+	return l.addr
+}
+
+// vsockConn is a blocking connection over a vsock socket, as the net
+// package does not support vsock
+type vsockConn struct {
+	*os.File
+	remote vsockAddr
+}
+
+func newVsockConn(fd int, remote vsockAddr) *vsockConn {
+	return &vsockConn{
+		File:   os.NewFile(uintptr(fd), "vsock:"+remote.String()),
+		remote: remote,
+	}
+}
+
+// CloseWrite shuts down the writing side of the connection, so that the
+// peer reads the end of the stream
+func (c *vsockConn) CloseWrite() error {
+	return unix.Shutdown(int(c.Fd()), unix.SHUT_WR)
+}
+
+func (c *vsockConn) LocalAddr() net.Addr {
+	return vsockAddr{}
+}
+
+func (c *vsockConn) RemoteAddr() net.Addr {
+	return c.remote
+}
+
+// SetDeadline, SetReadDeadline and SetWriteDeadline are not supported by
+// the blocking socket and return nil so that the connection can be used by
+// the grpc transports
+func (c *vsockConn) SetDeadline(t time.Time) error {
+	return nil
+}
+
+func (c *vsockConn) SetReadDeadline(t time.Time) error {
+	return nil
+}
+
+func (c *vsockConn) SetWriteDeadline(t time.Time) error {
+	return nil
+}
@@ -0,0 +1,31 @@
+package sys
+
+import "testing"
+
+func TestParseVsockAddress(t *testing.T) {
+	for _, tc := range []struct {
+		address   string
+		cid, port uint32
+	}{
+		{"vsock://1024", VsockHostCID, 1024},
+		{"vsock://3:1025", 3, 1025},
+	} {
+		cid, port, err := ParseVsockAddress(tc.address)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.address, err)
+		}
+		if cid != tc.cid || port != tc.port {
+			t.Errorf("%s: expected %d:%d, got %d:%d", tc.address, tc.cid, tc.port, cid, port)
+		}
+	}
+	for _, address := range []string{
+		"unix:///run/containerd.sock",
+		"vsock://",
+		"vsock://host:1024",
+		"vsock://1:2:3",
+	} {
+		if _, _, err := ParseVsockAddress(address); err == nil {
+			t.Errorf("%s: expected an error", address)
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"syscall"
 	"time"
 
+	checkpointsapi "github.com/containerd/containerd/api/services/checkpoints/v1"
 	eventsapi "github.com/containerd/containerd/api/services/events/v1"
 	"github.com/containerd/containerd/api/services/tasks/v1"
 	"github.com/containerd/containerd/api/types"
@@ -41,6 +42,9 @@ type Status struct {
 	ExitStatus uint32
 	// ExitedTime is the time at which the process died
 	ExitTime time.Time
+	// ExitReason is the reason of the exit set by runtimes translating the
+	// exit statuses of their tasks
+	ExitReason string
 }
 
 type ProcessStatus string
@@ -77,6 +81,9 @@ func WithStdinCloser(r *IOCloseInfo) {
 
 // CheckpointTaskInfo allows specific checkpoint information to be set for the task
 type CheckpointTaskInfo struct {
+	// Name is the name the checkpoint is recorded with by the checkpoints
+	// service, the digest of the checkpoint index when empty
+	Name string
 	// ParentCheckpoint is the digest of a parent checkpoint
 	ParentCheckpoint digest.Digest
 	// Options hold runtime specific settings for checkpointing a task
@@ -95,6 +102,21 @@ type TaskInfo struct {
 	RootFS []mount.Mount
 	// Options hold runtime specific settings for task creation
 	Options interface{}
+	// Priority orders the task's create and start requests in the daemon's
+	// admission queue, higher values are admitted first
+	Priority int32
+	// JoinNamespaces are the ids of the containers whose namespaces the
+	// task joins, by namespace type
+	JoinNamespaces map[string]string
+}
+
+// ProcessInfo provides a system specific process id inside a task
+type ProcessInfo struct {
+	// Pid is the process id
+	Pid uint32
+	// ExecID is the id of the exec process that started the pid, empty if
+	// the pid belongs to the task's init process or is unknown
+	ExecID string
 }
 
 // Task is the executable object within containerd
@@ -109,6 +131,9 @@ type Task interface {
 	Exec(context.Context, string, *specs.Process, IOCreation) (Process, error)
 	// Pids returns a list of system specific process ids inside the task
 	Pids(context.Context) ([]uint32, error)
+	// Processes returns the process ids inside the task along with the id
+	// of the exec process that started them
+	Processes(context.Context) ([]ProcessInfo, error)
 	// Checkpoint serializes the runtime and memory information of a task into an
 	// OCI Index that can be push and pulled from a remote resource.
 	//
@@ -116,6 +141,13 @@ type Task interface {
 	Checkpoint(context.Context, ...CheckpointTaskOpts) (v1.Descriptor, error)
 	// Update modifies executing tasks with updated settings
 	Update(context.Context, ...UpdateTaskOpts) error
+	// Inspect returns the full record of the task, including its container's
+	// spec and labels, the options it was created with and its exec processes
+	Inspect(context.Context) (*tasks.InspectTaskResponse, error)
+	// ShimLog returns the end of the log written by the shim of the task
+	// while its runtime runs shims in debug mode, at most max bytes of it
+	// or 1MiB when max is zero
+	ShimLog(ctx context.Context, max int64) ([]byte, error)
 }
 
 var _ = (Task)(&task{})
@@ -124,12 +156,15 @@ type task struct {
 	client    *Client
 	container Container
 
-	io  IO
-	id  string
-	pid uint32
+	io       IO
+	id       string
+	pid      uint32
+	priority int32
 
 	mu       sync.Mutex
 	deferred *tasks.CreateTaskRequest
+	// forceDelete is set by WithForceDelete for the next delete of the task
+	forceDelete bool
 }
 
 // Pid returns the pid or process id for the task
@@ -155,6 +190,7 @@ func (t *task) Start(ctx context.Context) error {
 	}
 	_, err := t.client.TaskService().Start(ctx, &tasks.StartRequest{
 		ContainerID: t.id,
+		Priority:    t.priority,
 	})
 	if err != nil {
 		t.io.Close()
@@ -198,6 +234,7 @@ func (t *task) Status(ctx context.Context) (Status, error) {
 		Status:     ProcessStatus(strings.ToLower(r.Process.Status.String())),
 		ExitStatus: r.Process.ExitStatus,
 		ExitTime:   r.Process.ExitedAt,
+		ExitReason: r.Process.ExitReason,
 	}, nil
 }
 
@@ -225,7 +262,7 @@ func (t *task) Wait(ctx context.Context) (<-chan ExitStatus, error) {
 		}
 		if status.Status == Stopped {
 			cancel()
-			chStatus <- ExitStatus{code: status.ExitStatus, exitedAt: status.ExitTime}
+			chStatus <- ExitStatus{code: status.ExitStatus, exitedAt: status.ExitTime, reason: status.ExitReason}
 			return chStatus, nil
 		}
 	}
@@ -247,7 +284,7 @@ func (t *task) Wait(ctx context.Context) (<-chan ExitStatus, error) {
 				}
 				e := v.(*eventsapi.TaskExit)
 				if e.ContainerID == t.id && e.Pid == t.pid {
-					chStatus <- ExitStatus{code: e.ExitStatus, exitedAt: e.ExitedAt}
+					chStatus <- ExitStatus{code: e.ExitStatus, exitedAt: e.ExitedAt, reason: e.ExitReason}
 					return
 				}
 			}
@@ -267,6 +304,10 @@ func (t *task) Delete(ctx context.Context, opts ...ProcessDeleteOpts) (uint32, e
 			return UnknownExitStatus, err
 		}
 	}
+	t.mu.Lock()
+	force := t.forceDelete
+	t.forceDelete = false
+	t.mu.Unlock()
 	status, err := t.Status(ctx)
 	if err != nil && errdefs.IsNotFound(err) {
 		return UnknownExitStatus, err
@@ -280,7 +321,9 @@ func (t *task) Delete(ctx context.Context, opts ...ProcessDeleteOpts) (uint32, e
 		}
 		fallthrough
 	default:
-		return UnknownExitStatus, errors.Wrapf(errdefs.ErrFailedPrecondition, "task must be stopped before deletion: %s", status.Status)
+		if !force {
+			return UnknownExitStatus, errors.Wrapf(errdefs.ErrFailedPrecondition, "task must be stopped before deletion: %s", status.Status)
+		}
 	}
 	if t.io != nil {
 		t.io.Cancel()
@@ -289,6 +332,7 @@ func (t *task) Delete(ctx context.Context, opts ...ProcessDeleteOpts) (uint32, e
 	}
 	r, err := t.client.TaskService().Delete(ctx, &tasks.DeleteTaskRequest{
 		ContainerID: t.id,
+		Force:       force,
 	})
 	if err != nil {
 		return UnknownExitStatus, errdefs.FromGRPC(err)
@@ -342,6 +386,33 @@ func (t *task) Pids(ctx context.Context) ([]uint32, error) {
 	return response.Pids, nil
 }
 
+func (t *task) Processes(ctx context.Context) ([]ProcessInfo, error) {
+	response, err := t.client.TaskService().ListPids(ctx, &tasks.ListPidsRequest{
+		ContainerID: t.id,
+	})
+	if err != nil {
+		return nil, errdefs.FromGRPC(err)
+	}
+	var processList []ProcessInfo
+	for _, p := range response.Processes {
+		processList = append(processList, ProcessInfo{
+			Pid:    p.Pid,
+			ExecID: p.ExecID,
+		})
+	}
+	return processList, nil
+}
+
+func (t *task) Inspect(ctx context.Context) (*tasks.InspectTaskResponse, error) {
+	response, err := t.client.TaskService().Inspect(ctx, &tasks.InspectTaskRequest{
+		ContainerID: t.id,
+	})
+	if err != nil {
+		return nil, errdefs.FromGRPC(err)
+	}
+	return response, nil
+}
+
 func (t *task) CloseIO(ctx context.Context, opts ...IOCloserOpts) error {
 	r := &tasks.CloseIORequest{
 		ContainerID: t.id,
@@ -386,11 +457,15 @@ func (t *task) Checkpoint(ctx context.Context, opts ...CheckpointTaskOpts) (d v1
 		}
 		request.Options = any
 	}
-	// make sure we pause it and resume after all other filesystem operations are completed
-	if err := t.Pause(ctx); err != nil {
-		return d, err
+	preDump := isPreDump(&i)
+	// make sure we pause it and resume after all other filesystem operations are completed,
+	// unless only its memory is pre-dumped while it keeps running
+	if !preDump {
+		if err := t.Pause(ctx); err != nil {
+			return d, err
+		}
+		defer t.Resume(ctx)
 	}
-	defer t.Resume(ctx)
 	cr, err := t.client.ContainerService().Get(ctx, t.id)
 	if err != nil {
 		return d, err
@@ -399,15 +474,21 @@ func (t *task) Checkpoint(ctx context.Context, opts ...CheckpointTaskOpts) (d v1
 	if err := t.checkpointTask(ctx, &index, request); err != nil {
 		return d, err
 	}
-	if err := t.checkpointImage(ctx, &index, cr.Image); err != nil {
-		return d, err
-	}
-	if err := t.checkpointRWSnapshot(ctx, &index, cr.Snapshotter, cr.RootFS); err != nil {
-		return d, err
+	// the image and rootfs changes are only restored with the final checkpoint
+	if !preDump {
+		if err := t.checkpointImage(ctx, &index, cr.Image); err != nil {
+			return d, err
+		}
+		if err := t.checkpointRWSnapshot(ctx, &index, cr.Snapshotter, cr.RootFS); err != nil {
+			return d, err
+		}
 	}
 	index.Annotations = make(map[string]string)
 	index.Annotations["image.name"] = cr.Image
-	return t.writeIndex(ctx, &index)
+	if d, err = t.writeIndex(ctx, &index); err != nil {
+		return d, err
+	}
+	return d, t.recordCheckpoint(ctx, &i, d)
 }
 
 // UpdateTaskInfo allows updated specific settings to be changed on a task
@@ -440,6 +521,21 @@ func (t *task) Update(ctx context.Context, opts ...UpdateTaskOpts) error {
 	return errdefs.FromGRPC(err)
 }
 
+func (t *task) UpdateProcess(ctx context.Context, opts ...UpdateProcessOpts) error {
+	return updateProcess(ctx, t.client, t.id, "", opts)
+}
+
+func (t *task) ShimLog(ctx context.Context, max int64) ([]byte, error) {
+	r, err := t.client.TaskService().ShimLog(ctx, &tasks.ShimLogRequest{
+		ContainerID: t.id,
+		MaxBytes:    max,
+	})
+	if err != nil {
+		return nil, errdefs.FromGRPC(err)
+	}
+	return r.Data, nil
+}
+
 func (t *task) checkpointTask(ctx context.Context, index *v1.Index, request *tasks.CheckpointTaskRequest) error {
 	response, err := t.client.TaskService().Checkpoint(ctx, request)
 	if err != nil {
@@ -485,6 +581,37 @@ func (t *task) checkpointImage(ctx context.Context, index *v1.Index, image strin
 	return nil
 }
 
+// recordCheckpoint records the checkpoint index with the checkpoints service
+// so that the container can be restored from the checkpoint by name
+func (t *task) recordCheckpoint(ctx context.Context, info *CheckpointTaskInfo, desc v1.Descriptor) error {
+	checkpoint := checkpointsapi.Checkpoint{
+		Name: info.Name,
+		Target: types.Descriptor{
+			MediaType: desc.MediaType,
+			Digest:    desc.Digest,
+			Size_:     desc.Size,
+		},
+		ContainerID: t.id,
+	}
+	if checkpoint.Name == "" {
+		checkpoint.Name = desc.Digest.String()
+	}
+	if info.ParentCheckpoint != "" {
+		checkpoint.Parent = info.ParentCheckpoint.String()
+	}
+	_, err := t.client.CheckpointService().Create(ctx, &checkpointsapi.CreateCheckpointRequest{
+		Checkpoint: checkpoint,
+	})
+	if err = errdefs.FromGRPC(err); err != nil {
+		// an identical checkpoint is already recorded under its digest
+		if info.Name == "" && errdefs.IsAlreadyExists(err) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
 func (t *task) writeIndex(ctx context.Context, index *v1.Index) (v1.Descriptor, error) {
 	buf := bytes.NewBuffer(nil)
 	if err := json.NewEncoder(buf).Encode(index); err != nil {
@@ -7,6 +7,9 @@ import (
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/linux/runcopts"
 	"github.com/containerd/containerd/mount"
+	digest "github.com/opencontainers/go-digest"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
 )
 
 // NewTaskOpts allows the caller to set options on a new task
@@ -20,14 +23,145 @@ func WithRootFS(mounts []mount.Mount) NewTaskOpts {
 	}
 }
 
+// WithTaskPriority sets the priority used to order the task's create and start
+// requests when the daemon is under load
+func WithTaskPriority(priority int32) NewTaskOpts {
+	return func(ctx context.Context, c *Client, ti *TaskInfo) error {
+		ti.Priority = priority
+		return nil
+	}
+}
+
+// WithNamespaceOf has the task join the namespace of the type, network, ipc,
+// pid or uts, of the task of another container. The daemon keeps the
+// namespace until all the tasks joining it are deleted.
+func WithNamespaceOf(nsType specs.LinuxNamespaceType, containerID string) NewTaskOpts {
+	return func(ctx context.Context, c *Client, ti *TaskInfo) error {
+		if ti.JoinNamespaces == nil {
+			ti.JoinNamespaces = make(map[string]string)
+		}
+		ti.JoinNamespaces[string(nsType)] = containerID
+		return nil
+	}
+}
+
+// WithTimezone sets the timezone of the task, "host" for the timezone of
+// the host or a name such as "Europe/Paris". The daemon binds the timezone
+// data of the host into the container, for images that have none.
+func WithTimezone(timezone string) NewTaskOpts {
+	return func(ctx context.Context, c *Client, ti *TaskInfo) error {
+		createOptions(ti).Timezone = timezone
+		return nil
+	}
+}
+
+// WithLocale sets the LANG of the task, such as "en_US.UTF-8"
+func WithLocale(locale string) NewTaskOpts {
+	return func(ctx context.Context, c *Client, ti *TaskInfo) error {
+		createOptions(ti).Locale = locale
+		return nil
+	}
+}
+
+// WithCgroupParent creates the cgroup of the task under the parent when its
+// spec sets no absolute cgroup, a slice such as "machine.slice" with the
+// systemd cgroup driver
+func WithCgroupParent(parent string) NewTaskOpts {
+	return func(ctx context.Context, c *Client, ti *TaskInfo) error {
+		createOptions(ti).CgroupParent = parent
+		return nil
+	}
+}
+
+// WithSystemdCgroup manages the cgroup of the task as a transient systemd
+// scope delegated to the container. The cgroups path of its spec is either
+// empty or in the "slice:prefix:name" form.
+func WithSystemdCgroup(ctx context.Context, c *Client, ti *TaskInfo) error {
+	createOptions(ti).SystemdCgroup = true
+	return nil
+}
+
+// createOptions returns the runc create options set on ti, creating them
+// if none are set so that options can be combined
+func createOptions(ti *TaskInfo) *runcopts.CreateOptions {
+	if opts, ok := ti.Options.(*runcopts.CreateOptions); ok {
+		return opts
+	}
+	opts := &runcopts.CreateOptions{}
+	ti.Options = opts
+	return opts
+}
+
+// WithCheckpointName records the checkpoint with the name, rather than with
+// the digest of its index
+func WithCheckpointName(name string) CheckpointTaskOpts {
+	return func(r *CheckpointTaskInfo) error {
+		r.Name = name
+		return nil
+	}
+}
+
 // WithExit causes the task to exit after a successful checkpoint
 func WithExit(r *CheckpointTaskInfo) error {
-	r.Options = &runcopts.CheckpointOptions{
-		Exit: true,
-	}
+	checkpointOptions(r).Exit = true
+	return nil
+}
+
+// WithCheckpointOpenTCP allows the task to be checkpointed with established
+// tcp connections
+func WithCheckpointOpenTCP(r *CheckpointTaskInfo) error {
+	checkpointOptions(r).OpenTcp = true
+	return nil
+}
+
+// WithCheckpointExternalUnixSockets allows the task to be checkpointed with
+// connected unix sockets that have a peer outside of the task
+func WithCheckpointExternalUnixSockets(r *CheckpointTaskInfo) error {
+	checkpointOptions(r).ExternalUnixSockets = true
+	return nil
+}
+
+// WithCheckpointFileLocks includes the file locks held by the task in the
+// checkpoint
+func WithCheckpointFileLocks(r *CheckpointTaskInfo) error {
+	checkpointOptions(r).FileLocks = true
+	return nil
+}
+
+// WithPreDump dumps the memory of the task while it keeps running. The
+// pre-dump cannot be restored on its own; it is the parent of a later
+// checkpoint, which then only dumps the memory changed since the pre-dump.
+func WithPreDump(r *CheckpointTaskInfo) error {
+	checkpointOptions(r).PreDump = true
 	return nil
 }
 
+// WithParentCheckpoint checkpoints the task relative to the parent, the
+// digest of the index of a pre-dump or of a previous checkpoint of the task
+func WithParentCheckpoint(parent digest.Digest) CheckpointTaskOpts {
+	return func(r *CheckpointTaskInfo) error {
+		r.ParentCheckpoint = parent
+		return nil
+	}
+}
+
+// isPreDump returns true if the checkpoint options request a pre-dump
+func isPreDump(r *CheckpointTaskInfo) bool {
+	opts, ok := r.Options.(*runcopts.CheckpointOptions)
+	return ok && opts.PreDump
+}
+
+// checkpointOptions returns the runc checkpoint options set on r, creating
+// them if none are set so that options can be combined
+func checkpointOptions(r *CheckpointTaskInfo) *runcopts.CheckpointOptions {
+	if opts, ok := r.Options.(*runcopts.CheckpointOptions); ok {
+		return opts
+	}
+	opts := &runcopts.CheckpointOptions{}
+	r.Options = opts
+	return opts
+}
+
 // ProcessDeleteOpts allows the caller to set options for the deletion of a task
 type ProcessDeleteOpts func(context.Context, Process) error
 
@@ -51,3 +185,52 @@ func WithProcessKill(ctx context.Context, p Process) error {
 	<-s
 	return nil
 }
+
+// WithForceDelete deletes the task even if it is running or its runtime
+// fails to delete it, in which case the daemon kills its processes and
+// purges its mounts, cgroup and state
+func WithForceDelete(ctx context.Context, p Process) error {
+	t, ok := p.(*task)
+	if !ok {
+		return errors.Wrap(errdefs.ErrInvalidArgument, "only tasks can be force deleted")
+	}
+	t.mu.Lock()
+	t.forceDelete = true
+	t.mu.Unlock()
+	return nil
+}
+
+// WithPendingEnv adds the KEY=VALUE variables to the environment of a
+// process that is not started, replacing the variables with the same keys
+func WithPendingEnv(env []string) UpdateProcessOpts {
+	return func(ctx context.Context, c *Client, ui *UpdateProcessInfo) error {
+		ui.Env = append(ui.Env, env...)
+		return nil
+	}
+}
+
+// WithPendingArgs replaces the args of a process that is not started
+func WithPendingArgs(args ...string) UpdateProcessOpts {
+	return func(ctx context.Context, c *Client, ui *UpdateProcessInfo) error {
+		ui.Args = args
+		return nil
+	}
+}
+
+// WithPendingCwd replaces the working directory of a process that is not
+// started
+func WithPendingCwd(cwd string) UpdateProcessOpts {
+	return func(ctx context.Context, c *Client, ui *UpdateProcessInfo) error {
+		ui.Cwd = cwd
+		return nil
+	}
+}
+
+// WithPendingMounts adds the mounts to a container whose task is not
+// created, replacing the mounts with the same destinations
+func WithPendingMounts(mounts []specs.Mount) UpdateProcessOpts {
+	return func(ctx context.Context, c *Client, ui *UpdateProcessInfo) error {
+		ui.Mounts = append(ui.Mounts, mounts...)
+		return nil
+	}
+}
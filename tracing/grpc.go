@@ -0,0 +1,37 @@
+package tracing
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// GRPCHeader is the header of the GRPC calls made with the context of a span
+// carrying the path of the span, so that the spans started by the server for
+// the call, such as those of the shims, are nested in it
+const GRPCHeader = "containerd-span"
+
+func withGRPCSpanHeader(ctx context.Context, name string) context.Context {
+	header := metadata.Pairs(GRPCHeader, name)
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		md = header
+	} else {
+		// order ensures the latest is first in this list.
+		md = metadata.Join(header, md)
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// fromGRPCHeader returns the path of the span of the client of the call
+func fromGRPCHeader(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	values := md[GRPCHeader]
+	if len(values) == 0 {
+		return "", false
+	}
+	return values[0], true
+}
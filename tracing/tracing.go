@@ -0,0 +1,84 @@
+// Package tracing records the operations carried out for a request as spans
+// in the trace of the request.
+//
+// The GRPC server creates a trace for each request, viewed on the
+// /debug/requests page of the debug socket. Spans started with the context of
+// the request are recorded as events of its trace, named by the path of the
+// spans enclosing them, so that the time spent in a call can be attributed to
+// the runtime, the shim or the mounts. The path of a span is sent to the
+// servers of the GRPC calls made with its context, such as the shims, which
+// nest their spans in it. Finished spans are also logged at the debug level.
+package tracing
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/containerd/containerd/log"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/trace"
+)
+
+type spanKey struct{}
+
+// Span is an operation carried out for a request
+type Span struct {
+	ctx   context.Context
+	name  string
+	start time.Time
+}
+
+// StartSpan starts a span for the named operation, nested in the span of the
+// context if any, or in the span of the client of the GRPC call of the
+// context. The returned context carries the span so that spans started with
+// it, and by the servers of the GRPC calls made with it, are nested in it.
+func StartSpan(ctx context.Context, name string) (*Span, context.Context) {
+	if parent := SpanFromContext(ctx); parent != nil {
+		name = path.Join(parent.name, name)
+	} else if parent, ok := fromGRPCHeader(ctx); ok {
+		name = path.Join(parent, name)
+	}
+	s := &Span{
+		name:  name,
+		start: time.Now(),
+	}
+	if tr, ok := trace.FromContext(ctx); ok {
+		tr.LazyPrintf("%s: started", name)
+	}
+	ctx = withGRPCSpanHeader(context.WithValue(ctx, spanKey{}, s), name)
+	s.ctx = ctx
+	return s, ctx
+}
+
+// SpanFromContext returns the span of the context, nil if there is none
+func SpanFromContext(ctx context.Context) *Span {
+	s, _ := ctx.Value(spanKey{}).(*Span)
+	return s
+}
+
+// Name returns the path of the span, made of the names of the spans
+// enclosing it and its own
+func (s *Span) Name() string {
+	return s.name
+}
+
+// Finish records the end of the span with the error of the operation
+func (s *Span) Finish(err error) {
+	d := time.Since(s.start)
+	entry := log.G(s.ctx).WithFields(logrus.Fields{
+		"span":     s.name,
+		"duration": d,
+	})
+	tr, ok := trace.FromContext(s.ctx)
+	if err != nil {
+		entry = entry.WithError(err)
+		if ok {
+			tr.LazyPrintf("%s: %v: %v", s.name, d, err)
+			tr.SetError()
+		}
+	} else if ok {
+		tr.LazyPrintf("%s: %v", s.name, d)
+	}
+	entry.Debug("span finished")
+}
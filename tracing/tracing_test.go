@@ -0,0 +1,41 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestStartSpan(t *testing.T) {
+	ctx := context.Background()
+	if SpanFromContext(ctx) != nil {
+		t.Fatal("expected no span in an empty context")
+	}
+	create, ctx := StartSpan(ctx, "create")
+	mount, mctx := StartSpan(ctx, "mount")
+	if mount.Name() != "create/mount" {
+		t.Fatalf("expected nested span name, got %q", mount.Name())
+	}
+	if SpanFromContext(mctx) != mount {
+		t.Fatal("expected the context to carry the nested span")
+	}
+	if SpanFromContext(ctx) != create {
+		t.Fatal("expected the parent context to carry the parent span")
+	}
+	mount.Finish(nil)
+	create.Finish(nil)
+}
+
+func TestStartSpanFromGRPCCall(t *testing.T) {
+	_, ctx := StartSpan(context.Background(), "shim.create")
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		t.Fatal("expected the span to be sent with the calls of its context")
+	}
+	// the server of the call nests its spans in the span of the client
+	mount, _ := StartSpan(metadata.NewIncomingContext(context.Background(), md), "mount")
+	if mount.Name() != "shim.create/mount" {
+		t.Fatalf("expected the span to be nested in the span of the call, got %q", mount.Name())
+	}
+}
@@ -0,0 +1,145 @@
+// +build !windows
+
+package containerd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/fs"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// WithUserName sets the user of the process from a user or user:group, each
+// a name or an id, resolved in the /etc/passwd and /etc/group files of the
+// image unpacked in the snapshotter, or the default snapshotter if empty.
+//
+// The group is the primary group of the user when it is not set, or the uid
+// for a uid missing from /etc/passwd, and the groups listing the user are
+// its additional groups.
+func WithUserName(ctx context.Context, id string, i Image, snapshotter, user string) SpecOpts {
+	return func(s *specs.Spec) error {
+		parts := strings.Split(user, ":")
+		if len(parts) > 2 || parts[0] == "" {
+			return errors.Wrapf(errdefs.ErrInvalidArgument, "invalid user %q", user)
+		}
+		rootfs, cleanup, err := viewImage(ctx, fmt.Sprintf("%s-user-view", id), i, snapshotter)
+		if err != nil {
+			return errors.Wrap(err, "failed to mount image to resolve user")
+		}
+		defer cleanup()
+		passwd, err := readIDFile(rootfs, "/etc/passwd", 4)
+		if err != nil {
+			return err
+		}
+		var (
+			uid, gid uint32
+			name     string
+		)
+		if entry, ok := findID(passwd, parts[0]); ok {
+			name = entry[0]
+			if uid, err = parseID(entry[2]); err != nil {
+				return errors.Wrapf(err, "invalid uid of user %q in /etc/passwd", name)
+			}
+			if gid, err = parseID(entry[3]); err != nil {
+				return errors.Wrapf(err, "invalid gid of user %q in /etc/passwd", name)
+			}
+		} else if uid, err = parseID(parts[0]); err == nil {
+			gid = uid
+		} else {
+			return errors.Wrapf(errdefs.ErrNotFound, "user %q not found in /etc/passwd of the image", parts[0])
+		}
+		groups, err := readIDFile(rootfs, "/etc/group", 3)
+		if err != nil {
+			return err
+		}
+		if len(parts) == 2 {
+			if entry, ok := findID(groups, parts[1]); ok {
+				if gid, err = parseID(entry[2]); err != nil {
+					return errors.Wrapf(err, "invalid gid of group %q in /etc/group", entry[0])
+				}
+			} else if gid, err = parseID(parts[1]); err != nil {
+				return errors.Wrapf(errdefs.ErrNotFound, "group %q not found in /etc/group of the image", parts[1])
+			}
+		}
+		s.Process.User.UID, s.Process.User.GID = uid, gid
+		if name == "" {
+			return nil
+		}
+		for _, entry := range groups {
+			if len(entry) < 4 {
+				continue
+			}
+			for _, member := range strings.Split(entry[3], ",") {
+				if member != name {
+					continue
+				}
+				if g, err := parseID(entry[2]); err == nil && g != gid {
+					s.Process.User.AdditionalGids = append(s.Process.User.AdditionalGids, g)
+				}
+				break
+			}
+		}
+		return nil
+	}
+}
+
+// readIDFile returns the colon separated fields of the entries of a
+// /etc/passwd or /etc/group file of the rootfs with at least n fields, none
+// if it does not exist
+func readIDFile(rootfs, path string, n int) ([][]string, error) {
+	p, err := fs.RootPath(rootfs, path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	var entries [][]string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if fields := strings.Split(line, ":"); len(fields) >= n {
+			entries = append(entries, fields)
+		}
+	}
+	return entries, errors.Wrapf(scanner.Err(), "failed to read %s", path)
+}
+
+// findID returns the entry named by the name or, for an id, the first entry
+// with that id
+func findID(entries [][]string, nameOrID string) ([]string, bool) {
+	for _, entry := range entries {
+		if entry[0] == nameOrID {
+			return entry, true
+		}
+	}
+	if _, err := parseID(nameOrID); err != nil {
+		return nil, false
+	}
+	for _, entry := range entries {
+		if entry[2] == nameOrID {
+			return entry, true
+		}
+	}
+	return nil, false
+}
+
+func parseID(s string) (uint32, error) {
+	v, err := strconv.ParseUint(s, 10, 32)
+	return uint32(v), err
+}
@@ -10,4 +10,9 @@ var (
 	// Revision is filled with the VCS (e.g. git) revision being used to build
 	// the program at linking time.
 	Revision = ""
+
+	// APIVersion is the version of the GRPC API, as major.minor. The minor
+	// version is incremented when calls or fields are added to the API and
+	// the major version when they are removed or changed.
+	APIVersion = "1.0"
 )
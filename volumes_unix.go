@@ -0,0 +1,162 @@
+// +build !windows
+
+package containerd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/fs"
+	"github.com/containerd/containerd/oci"
+	"github.com/opencontainers/image-spec/identity"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// WithImageVolumes mounts a volume at each path the image declares as a
+// volume.
+//
+// Volumes are directories under root. The volume of a path is named by
+// names, or after the container id and the path if it is not named. A volume
+// that does not exist yet is created with a copy of the content at its path
+// in the image, read from the image unpacked in the snapshotter, or the
+// default snapshotter if empty, so that seed data shipped with the image is
+// available in it. A path missing from the image gets an empty volume with
+// mode 0755, owned by the root of the user namespace of the container, so
+// the option must follow the options setting the user namespace. Existing
+// volumes are mounted as they are.
+func WithImageVolumes(ctx context.Context, id string, i Image, snapshotter, root string, names map[string]string) SpecOpts {
+	return func(s *specs.Spec) error {
+		config, err := readImageConfig(ctx, i)
+		if err != nil {
+			return err
+		}
+		if len(config.Volumes) == 0 {
+			return nil
+		}
+		paths := make([]string, 0, len(config.Volumes))
+		for p := range config.Volumes {
+			paths = append(paths, p)
+		}
+		sort.Strings(paths)
+		if err := os.MkdirAll(root, 0711); err != nil {
+			return err
+		}
+		uid, gid, userns, err := oci.RootIDs(s)
+		if err != nil {
+			return err
+		}
+		var (
+			rootfs  string
+			cleanup = func() {}
+		)
+		defer func() { cleanup() }()
+		for _, p := range paths {
+			name, ok := names[p]
+			if !ok {
+				name = id + strings.Replace(filepath.Clean("/"+p), "/", "-", -1)
+			}
+			if name == "" || strings.ContainsAny(name, "/\\") || name == "." || name == ".." {
+				return errors.Wrapf(errdefs.ErrInvalidArgument, "invalid volume name %q for %s", name, p)
+			}
+			dir := filepath.Join(root, name)
+			if _, err := os.Stat(dir); err != nil {
+				if !os.IsNotExist(err) {
+					return err
+				}
+				if rootfs == "" {
+					if rootfs, cleanup, err = viewImage(ctx, fmt.Sprintf("%s-volumes-view", id), i, snapshotter); err != nil {
+						return errors.Wrap(err, "failed to mount image to copy volume content")
+					}
+				}
+				if err := initVolume(dir, rootfs, p, userns, uid, gid); err != nil {
+					return errors.Wrapf(err, "failed to initialize volume %s", name)
+				}
+			}
+			s.Mounts = append(s.Mounts, specs.Mount{
+				Destination: p,
+				Type:        "bind",
+				Source:      dir,
+				Options:     []string{"rbind", "rw"},
+			})
+		}
+		return nil
+	}
+}
+
+// initVolume creates the volume directory with a copy of the content at the
+// path in the rootfs, which keeps the mode and owner of the directory in the
+// image. Without a directory at the path, the volume is empty with mode 0755
+// and owned by uid and gid when the container has a user namespace. The copy
+// is made in a temporary directory that is renamed so that a partially
+// copied volume is never used.
+func initVolume(dir, rootfs, path string, userns bool, uid, gid uint32) error {
+	tmp, err := ioutil.TempDir(filepath.Dir(dir), "."+filepath.Base(dir))
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	src, err := fs.RootPath(rootfs, path)
+	if err != nil {
+		return err
+	}
+	if fi, err := os.Stat(src); err == nil && fi.IsDir() {
+		if err := fs.CopyDir(tmp, src); err != nil {
+			return err
+		}
+	} else if err != nil && !os.IsNotExist(err) {
+		return err
+	} else {
+		if err := os.Chmod(tmp, 0755); err != nil {
+			return err
+		}
+		if userns {
+			if err := os.Lchown(tmp, int(uid), int(gid)); err != nil {
+				return err
+			}
+		}
+	}
+	return os.Rename(tmp, dir)
+}
+
+// viewImage mounts a read-only view of the image unpacked in the snapshotter
+// under the key and returns its path and a function removing it
+func viewImage(ctx context.Context, key string, i Image, snapshotter string) (string, func(), error) {
+	diffIDs, err := i.RootFS(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	if snapshotter == "" {
+		snapshotter = DefaultSnapshotter
+	}
+	sn := i.(*image).client.SnapshotService(snapshotter)
+	mounts, err := sn.View(ctx, key, identity.ChainID(diffIDs).String())
+	if err != nil {
+		return "", nil, err
+	}
+	dir, err := ioutil.TempDir("", "ctd-volumes")
+	if err != nil {
+		sn.Remove(ctx, key)
+		return "", nil, err
+	}
+	for _, m := range mounts {
+		if err := m.Mount(dir); err != nil {
+			unix.Unmount(dir, 0)
+			os.RemoveAll(dir)
+			sn.Remove(ctx, key)
+			return "", nil, err
+		}
+	}
+	return dir, func() {
+		unix.Unmount(dir, 0)
+		os.RemoveAll(dir)
+		sn.Remove(ctx, key)
+	}, nil
+}
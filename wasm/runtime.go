@@ -0,0 +1,309 @@
+// +build linux
+
+package wasm
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/plugin"
+	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/containerd/typeurl"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+const runtimeName = "wasm"
+
+var (
+	pluginID = fmt.Sprintf("%s.%s", plugin.RuntimePlugin, runtimeName)
+)
+
+var _ = (runtime.Runtime)(&Runtime{})
+
+func init() {
+	plugin.Register(&plugin.Registration{
+		Type: plugin.RuntimePlugin,
+		ID:   runtimeName,
+		Init: New,
+		Config: &Config{
+			Engine: "wasmtime",
+		},
+	})
+}
+
+// Config for the wasm runtime
+type Config struct {
+	// Engine is the name or path of the WebAssembly engine binary executing
+	// the modules, wasmtime or wasmedge
+	Engine string `toml:"engine"`
+}
+
+// New returns a runtime executing the WebAssembly module named by the first
+// argument of the process of the container's spec, resolved in its rootfs
+func New(ic *plugin.InitContext) (interface{}, error) {
+	cfg := ic.Config.(*Config)
+	if _, err := engineArgs(cfg.Engine, "", "", nil, nil); err != nil {
+		return nil, err
+	}
+	// the runtime is only loaded on the hosts the engine is installed on
+	engine, err := exec.LookPath(cfg.Engine)
+	if err != nil {
+		log.G(ic.Context).WithError(err).Debugf("wasm engine %q not found", cfg.Engine)
+		return nil, plugin.SkipPlugin
+	}
+	if err := os.MkdirAll(ic.Root, 0700); err != nil {
+		return nil, errors.Wrapf(err, "could not create state directory at %s", ic.Root)
+	}
+	r := &Runtime{
+		root:      ic.Root,
+		engine:    engine,
+		publisher: ic.Events,
+		tasks:     runtime.NewTaskList(),
+	}
+	// the engine processes die with the daemon, only their bundles remain
+	r.cleanup(ic.Context)
+	ic.Meta.Exports["engine"] = engine
+	return r, nil
+}
+
+// Runtime runs the tasks of wasm containers as engine processes
+type Runtime struct {
+	root      string
+	engine    string
+	publisher events.Publisher
+	tasks     *runtime.TaskList
+}
+
+// ID of the runtime
+func (r *Runtime) ID() string {
+	return pluginID
+}
+
+// Create prepares the engine process of the task, which is executed when the
+// task is started
+func (r *Runtime) Create(ctx context.Context, id string, opts runtime.CreateOpts) (_ runtime.Task, err error) {
+	namespace, err := namespaces.NamespaceRequired(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Checkpoint != "" {
+		return nil, errors.Wrap(errdefs.ErrNotImplemented, "wasm tasks cannot be restored from a checkpoint")
+	}
+	if opts.IO.Terminal {
+		return nil, errors.Wrap(errdefs.ErrNotImplemented, "wasm tasks cannot have a terminal")
+	}
+	v, err := typeurl.UnmarshalAny(opts.Spec)
+	if err != nil {
+		return nil, err
+	}
+	spec, ok := v.(*specs.Spec)
+	if !ok || spec.Process == nil || len(spec.Process.Args) == 0 {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, "spec must have the module of the process as its first argument")
+	}
+	if spec.Linux != nil {
+		if limit := resourceLimit(spec.Linux.Resources); limit != "" {
+			return nil, errors.Wrapf(errdefs.ErrNotImplemented, "wasm tasks cannot have %s limits", limit)
+		}
+	}
+	bundle := filepath.Join(r.root, namespace, id)
+	if err := os.MkdirAll(filepath.Dir(bundle), 0700); err != nil {
+		return nil, err
+	}
+	if err := os.Mkdir(bundle, 0700); err != nil {
+		if os.IsExist(err) {
+			return nil, errors.Wrapf(errdefs.ErrAlreadyExists, "task %s", id)
+		}
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			r.removeBundle(ctx, bundle)
+		}
+	}()
+	rootfs := filepath.Join(bundle, "rootfs")
+	if err := os.Mkdir(rootfs, 0700); err != nil {
+		return nil, err
+	}
+	if len(opts.Rootfs) > 0 {
+		if err := mount.MountAll(opts.Rootfs, rootfs); err != nil {
+			return nil, errors.Wrap(err, "failed to mount rootfs")
+		}
+	} else if spec.Root != nil && spec.Root.Path != "" {
+		rootfs = spec.Root.Path
+	}
+	module := filepath.Join(rootfs, filepath.Clean("/"+spec.Process.Args[0]))
+	if _, err := os.Stat(module); err != nil {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "module %s not found in rootfs", spec.Process.Args[0])
+	}
+	args, err := engineArgs(r.engine, rootfs, module, spec.Process.Env, spec.Process.Args[1:])
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.Command(r.engine, args...)
+	cmd.Dir = rootfs
+	t, err := newTask(id, namespace, bundle, cmd, opts, r.publisher)
+	if err != nil {
+		return nil, err
+	}
+	// the engine runs as the user of the process
+	if u := spec.Process.User; u.UID != 0 || u.GID != 0 || len(u.AdditionalGids) > 0 {
+		cmd.SysProcAttr.Credential = &syscall.Credential{
+			Uid:    u.UID,
+			Gid:    u.GID,
+			Groups: u.AdditionalGids,
+		}
+	}
+	r.tasks.Add(ctx, t)
+	r.publisher.Publish(ctx, runtime.TaskCreateEventTopic, &eventsapi.TaskCreate{
+		ContainerID: id,
+		Bundle:      bundle,
+		Rootfs:      mountsToProto(opts.Rootfs),
+		IO: &eventsapi.TaskIO{
+			Stdin:  opts.IO.Stdin,
+			Stdout: opts.IO.Stdout,
+			Stderr: opts.IO.Stderr,
+		},
+	})
+	return t, nil
+}
+
+// Get returns the task of the container
+func (r *Runtime) Get(ctx context.Context, id string) (runtime.Task, error) {
+	return r.tasks.Get(ctx, id)
+}
+
+// Tasks returns the tasks of the namespace
+func (r *Runtime) Tasks(ctx context.Context) ([]runtime.Task, error) {
+	return r.tasks.GetAll(ctx)
+}
+
+// Delete removes a task that is not running along with its bundle
+func (r *Runtime) Delete(ctx context.Context, rt runtime.Task) (*runtime.Exit, error) {
+	t, ok := rt.(*Task)
+	if !ok {
+		return nil, errors.Wrap(errdefs.ErrInvalidArgument, "not a wasm task")
+	}
+	state, _ := t.State(ctx)
+	if state.Status == runtime.RunningStatus {
+		return nil, errors.Wrap(errdefs.ErrFailedPrecondition, "cannot delete a running task")
+	}
+	t.closeIO()
+	r.removeBundle(ctx, t.bundle)
+	r.tasks.Delete(ctx, t)
+	exit := &runtime.Exit{
+		Pid:       state.Pid,
+		Status:    state.ExitStatus,
+		Timestamp: state.ExitedAt,
+	}
+	r.publisher.Publish(ctx, runtime.TaskDeleteEventTopic, &eventsapi.TaskDelete{
+		ContainerID: t.id,
+		Pid:         exit.Pid,
+		ExitStatus:  exit.Status,
+		ExitedAt:    exit.Timestamp,
+	})
+	return exit, nil
+}
+
+// removeBundle unmounts the rootfs of the bundle and removes it
+func (r *Runtime) removeBundle(ctx context.Context, bundle string) {
+	if err := mount.UnmountAll(filepath.Join(bundle, "rootfs"), 0); err != nil && !os.IsNotExist(err) {
+		// never remove the files of a rootfs that is still mounted
+		log.G(ctx).WithError(err).WithField("bundle", bundle).Warn("failed to unmount rootfs")
+		return
+	}
+	if err := os.RemoveAll(bundle); err != nil {
+		log.G(ctx).WithError(err).WithField("bundle", bundle).Warn("failed to remove bundle")
+	}
+}
+
+// cleanup removes the bundles left behind by a previous daemon
+func (r *Runtime) cleanup(ctx context.Context) {
+	nss, err := ioutil.ReadDir(r.root)
+	if err != nil {
+		log.G(ctx).WithError(err).Warn("failed to read wasm state directory")
+		return
+	}
+	for _, ns := range nss {
+		if !ns.IsDir() {
+			continue
+		}
+		bundles, err := ioutil.ReadDir(filepath.Join(r.root, ns.Name()))
+		if err != nil {
+			log.G(ctx).WithError(err).WithField("namespace", ns.Name()).Warn("failed to read wasm bundles")
+			continue
+		}
+		for _, b := range bundles {
+			r.removeBundle(ctx, filepath.Join(r.root, ns.Name(), b.Name()))
+		}
+	}
+}
+
+func mountsToProto(mounts []mount.Mount) []*types.Mount {
+	var out []*types.Mount
+	for _, m := range mounts {
+		out = append(out, &types.Mount{
+			Type:    m.Type,
+			Source:  m.Source,
+			Options: m.Options,
+		})
+	}
+	return out
+}
+
+// resourceLimit returns the kind of the first limit set by the resources,
+// which are not applied as the engine processes do not run in cgroups of
+// their own. Device rules are ignored as modules have no access to devices.
+func resourceLimit(r *specs.LinuxResources) string {
+	switch {
+	case r == nil:
+		return ""
+	case r.Memory != nil:
+		return "memory"
+	case r.CPU != nil:
+		return "cpu"
+	case r.Pids != nil:
+		return "pids"
+	case r.BlockIO != nil:
+		return "blkio"
+	case len(r.HugepageLimits) > 0:
+		return "hugepage"
+	case r.Network != nil:
+		return "network"
+	}
+	return ""
+}
+
+// engineArgs returns the arguments of the engine to run the module with the
+// rootfs as its root directory
+func engineArgs(engine, rootfs, module string, env, args []string) ([]string, error) {
+	var out []string
+	switch name := filepath.Base(engine); {
+	case strings.HasPrefix(name, "wasmtime"):
+		out = []string{"run", "--dir=" + rootfs + "::/"}
+		for _, e := range env {
+			out = append(out, "--env", e)
+		}
+	case strings.HasPrefix(name, "wasmedge"):
+		out = []string{"--dir", "/:" + rootfs}
+		for _, e := range env {
+			out = append(out, "--env", e)
+		}
+	default:
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "unsupported wasm engine %q", engine)
+	}
+	return append(append(out, module), args...), nil
+}
@@ -0,0 +1,53 @@
+// +build linux
+
+package wasm
+
+import (
+	"reflect"
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestEngineArgs(t *testing.T) {
+	env := []string{"PATH=/bin", "A=b"}
+	args := []string{"--flag", "value"}
+	for engine, expected := range map[string][]string{
+		"wasmtime": {
+			"run", "--dir=/rootfs::/", "--env", "PATH=/bin", "--env", "A=b",
+			"/rootfs/app.wasm", "--flag", "value",
+		},
+		"/usr/local/bin/wasmedge": {
+			"--dir", "/:/rootfs", "--env", "PATH=/bin", "--env", "A=b",
+			"/rootfs/app.wasm", "--flag", "value",
+		},
+	} {
+		actual, err := engineArgs(engine, "/rootfs", "/rootfs/app.wasm", env, args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("%s: expected %v, got %v", engine, expected, actual)
+		}
+	}
+	if _, err := engineArgs("wasmer", "/rootfs", "/rootfs/app.wasm", nil, nil); err == nil {
+		t.Error("expected an unsupported engine to fail")
+	}
+}
+
+func TestResourceLimit(t *testing.T) {
+	limit := int64(1 << 20)
+	for _, tc := range []struct {
+		resources *specs.LinuxResources
+		expected  string
+	}{
+		{nil, ""},
+		{&specs.LinuxResources{Devices: []specs.LinuxDeviceCgroup{{Allow: false, Access: "rwm"}}}, ""},
+		{&specs.LinuxResources{Memory: &specs.LinuxMemory{Limit: &limit}}, "memory"},
+		{&specs.LinuxResources{Pids: &specs.LinuxPids{Limit: 10}}, "pids"},
+	} {
+		if actual := resourceLimit(tc.resources); actual != tc.expected {
+			t.Errorf("expected %q limit for %+v, got %q", tc.expected, tc.resources, actual)
+		}
+	}
+}
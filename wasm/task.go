@@ -0,0 +1,333 @@
+// +build linux
+
+package wasm
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	goruntime "runtime"
+	"sync"
+	"syscall"
+	"time"
+
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/containerd/log"
+	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/reaper"
+	"github.com/containerd/containerd/runtime"
+	"github.com/containerd/fifo"
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+)
+
+// Task is a wasm module executed by an engine process. Wasm tasks have a
+// single process and cannot be paused, checkpointed or exec'd into.
+type Task struct {
+	id        string
+	namespace string
+	bundle    string
+	rootfs    []mount.Mount
+	options   *types.Any
+	stdio     runtime.IO
+	publisher events.Publisher
+
+	mu         sync.Mutex
+	cmd        *exec.Cmd
+	status     runtime.Status
+	pid        uint32
+	startedAt  time.Time
+	exitStatus uint32
+	exitedAt   time.Time
+	// closers hold the fifos and the daemon's ends of the process pipes
+	closers []io.Closer
+	// pipes hold the process's ends of its stdio pipes, closed once started
+	pipes   []*os.File
+	stdin   io.WriteCloser
+	outputs []output
+}
+
+// output copies an output pipe of the engine process to its fifo
+type output struct {
+	fifo io.Writer
+	pipe *os.File
+}
+
+func newTask(id, namespace, bundle string, cmd *exec.Cmd, opts runtime.CreateOpts, publisher events.Publisher) (_ *Task, err error) {
+	t := &Task{
+		id:        id,
+		namespace: namespace,
+		bundle:    bundle,
+		rootfs:    opts.Rootfs,
+		options:   opts.Options,
+		stdio:     opts.IO,
+		publisher: publisher,
+		cmd:       cmd,
+		status:    runtime.CreatedStatus,
+	}
+	defer func() {
+		if err != nil {
+			t.closeIO()
+		}
+	}()
+	// the engine process is killed if the daemon dies as it would not be
+	// restored when the daemon starts again
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setpgid:   true,
+		Pdeathsig: syscall.SIGKILL,
+	}
+	// the fifos are opened in the background as the client may not have
+	// opened its ends yet
+	if opts.IO.Stdin != "" {
+		in, err := fifo.OpenFifo(context.Background(), opts.IO.Stdin, syscall.O_RDONLY|syscall.O_NONBLOCK, 0)
+		if err != nil {
+			return nil, err
+		}
+		t.closers = append(t.closers, in)
+		r, w, err := os.Pipe()
+		if err != nil {
+			return nil, err
+		}
+		t.pipes, t.closers = append(t.pipes, r), append(t.closers, w)
+		cmd.Stdin, t.stdin = r, w
+		go func() {
+			io.Copy(w, in)
+			w.Close()
+		}()
+	}
+	for path, dst := range map[string]*io.Writer{
+		opts.IO.Stdout: &cmd.Stdout,
+		opts.IO.Stderr: &cmd.Stderr,
+	} {
+		if path == "" {
+			continue
+		}
+		out, err := fifo.OpenFifo(context.Background(), path, syscall.O_WRONLY|syscall.O_NONBLOCK, 0)
+		if err != nil {
+			return nil, err
+		}
+		t.closers = append(t.closers, out)
+		r, w, err := os.Pipe()
+		if err != nil {
+			return nil, err
+		}
+		t.pipes, t.closers = append(t.pipes, w), append(t.closers, r)
+		t.outputs = append(t.outputs, output{fifo: out, pipe: r})
+		*dst = w
+	}
+	return t, nil
+}
+
+// ID of the task
+func (t *Task) ID() string {
+	return t.id
+}
+
+// Info returns the information of the task
+func (t *Task) Info() runtime.TaskInfo {
+	return runtime.TaskInfo{
+		ID:        t.id,
+		Runtime:   pluginID,
+		Namespace: t.namespace,
+		Bundle:    t.bundle,
+		Rootfs:    t.rootfs,
+		Options:   t.options,
+	}
+}
+
+// Start executes the engine process
+func (t *Task) Start(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.status != runtime.CreatedStatus {
+		return errors.Wrap(errdefs.ErrFailedPrecondition, "task already started")
+	}
+	exited, err := t.spawn()
+	if err != nil {
+		return errors.Wrap(err, "failed to start wasm engine")
+	}
+	for _, p := range t.pipes {
+		p.Close()
+	}
+	t.pipes = nil
+	var wg sync.WaitGroup
+	for _, o := range t.outputs {
+		wg.Add(1)
+		go func(o output) {
+			defer wg.Done()
+			io.Copy(o.fifo, o.pipe)
+		}(o)
+	}
+	t.status = runtime.RunningStatus
+	t.pid = uint32(t.cmd.Process.Pid)
+	t.startedAt = time.Now()
+	// the exit is published after the request that started the task
+	go t.wait(namespaces.WithNamespace(context.Background(), t.namespace), exited, &wg)
+	t.publisher.Publish(ctx, runtime.TaskStartEventTopic, &eventsapi.TaskStart{
+		ContainerID: t.id,
+		Pid:         t.pid,
+	})
+	return nil
+}
+
+// spawn starts the engine process from a goroutine locked to its thread
+// until the process exits, as the parent death signal of the process is
+// sent when the thread that started it exits. The exit status of the process
+// is sent on the returned channel.
+func (t *Task) spawn() (<-chan int, error) {
+	var (
+		started = make(chan error, 1)
+		exited  = make(chan int, 1)
+	)
+	go func() {
+		goruntime.LockOSThread()
+		defer goruntime.UnlockOSThread()
+		if err := reaper.Default.Start(t.cmd); err != nil {
+			started <- err
+			return
+		}
+		started <- nil
+		status, _ := reaper.Default.Wait(t.cmd)
+		exited <- status
+	}()
+	return exited, <-started
+}
+
+// wait records the exit of the engine process once its output is copied
+func (t *Task) wait(ctx context.Context, exited <-chan int, wg *sync.WaitGroup) {
+	status := <-exited
+	reaper.Default.Delete(t.cmd.Process.Pid)
+	wg.Wait()
+	t.exit(ctx, uint32(status))
+}
+
+func (t *Task) exit(ctx context.Context, status uint32) {
+	t.mu.Lock()
+	t.status = runtime.StoppedStatus
+	t.exitStatus = status
+	t.exitedAt = time.Now()
+	e := &eventsapi.TaskExit{
+		ContainerID: t.id,
+		ID:          t.id,
+		Pid:         t.pid,
+		ExitStatus:  t.exitStatus,
+		ExitedAt:    t.exitedAt,
+	}
+	t.mu.Unlock()
+	if err := t.publisher.Publish(ctx, runtime.TaskExitEventTopic, e); err != nil {
+		log.G(ctx).WithError(err).WithField("id", t.id).Error("failed to publish task exit")
+	}
+}
+
+// State returns the state of the task
+func (t *Task) State(ctx context.Context) (runtime.State, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return runtime.State{
+		Status:     t.status,
+		Pid:        t.pid,
+		ExitStatus: t.exitStatus,
+		ExitedAt:   t.exitedAt,
+		StartedAt:  t.startedAt,
+		Stdin:      t.stdio.Stdin,
+		Stdout:     t.stdio.Stdout,
+		Stderr:     t.stdio.Stderr,
+	}, nil
+}
+
+// Kill signals the engine process. A task that was not started is stopped
+// as if the process had been killed by the signal.
+func (t *Task) Kill(ctx context.Context, signal uint32, all bool) error {
+	t.mu.Lock()
+	switch t.status {
+	case runtime.CreatedStatus:
+		t.mu.Unlock()
+		t.exit(ctx, 128+signal)
+		return nil
+	case runtime.StoppedStatus:
+		t.mu.Unlock()
+		return errors.Wrap(errdefs.ErrNotFound, "process already finished")
+	}
+	pid := int(t.pid)
+	t.mu.Unlock()
+	if all {
+		// the engine runs in its own process group
+		pid = -pid
+	}
+	return syscall.Kill(pid, syscall.Signal(signal))
+}
+
+// CloseIO closes the stdin of the engine process
+func (t *Task) CloseIO(ctx context.Context) error {
+	if t.stdin == nil {
+		return nil
+	}
+	return t.stdin.Close()
+}
+
+// closeIO closes the fifos and pipes of the task
+func (t *Task) closeIO() {
+	for _, c := range t.closers {
+		c.Close()
+	}
+	for _, p := range t.pipes {
+		p.Close()
+	}
+}
+
+// ResizePty is not supported as wasm tasks have no terminal
+func (t *Task) ResizePty(ctx context.Context, size runtime.ConsoleSize) error {
+	return errors.Wrap(errdefs.ErrNotImplemented, "wasm tasks have no terminal")
+}
+
+// Pause is not supported by wasm tasks
+func (t *Task) Pause(ctx context.Context) error {
+	return errors.Wrap(errdefs.ErrNotImplemented, "wasm tasks cannot be paused")
+}
+
+// Resume is not supported by wasm tasks
+func (t *Task) Resume(ctx context.Context) error {
+	return errors.Wrap(errdefs.ErrNotImplemented, "wasm tasks cannot be resumed")
+}
+
+// Exec is not supported by wasm tasks
+func (t *Task) Exec(ctx context.Context, id string, opts runtime.ExecOpts) (runtime.Process, error) {
+	return nil, errors.Wrap(errdefs.ErrNotImplemented, "wasm tasks cannot exec processes")
+}
+
+// Pids returns the pid of the engine process
+func (t *Task) Pids(ctx context.Context) ([]runtime.ProcessInfo, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.status != runtime.RunningStatus {
+		return nil, nil
+	}
+	return []runtime.ProcessInfo{{Pid: t.pid}}, nil
+}
+
+// Checkpoint is not supported by wasm tasks
+func (t *Task) Checkpoint(ctx context.Context, path string, options *types.Any) error {
+	return errors.Wrap(errdefs.ErrNotImplemented, "wasm tasks cannot be checkpointed")
+}
+
+// DeleteProcess returns not found as wasm tasks have no exec processes
+func (t *Task) DeleteProcess(ctx context.Context, id string) (*runtime.Exit, error) {
+	return nil, errors.Wrapf(errdefs.ErrNotFound, "process %s", id)
+}
+
+// Update is not supported by wasm tasks
+func (t *Task) Update(ctx context.Context, resources *types.Any) error {
+	return errors.Wrap(errdefs.ErrNotImplemented, "wasm tasks cannot be updated")
+}
+
+// Process returns the task itself as its only process
+func (t *Task) Process(ctx context.Context, id string) (runtime.Process, error) {
+	if id != t.id {
+		return nil, errors.Wrapf(errdefs.ErrNotFound, "process %s", id)
+	}
+	return t, nil
+}
@@ -0,0 +1,28 @@
+// +build windows
+
+package windows
+
+import (
+	"context"
+)
+
+// exitReasons are the reasons of the NTSTATUS codes that Windows processes
+// exit with when they are terminated by the system rather than exiting on
+// their own
+var exitReasons = map[uint32]string{
+	0xC0000005: "AccessViolation",
+	0xC0000017: "NoMemory",
+	0xC000001D: "IllegalInstruction",
+	0xC0000094: "IntegerDivideByZero",
+	0xC00000FD: "StackOverflow",
+	0xC000013A: "ControlCExit",
+	0xC0000135: "DllNotFound",
+	0xC0000142: "DllInitFailed",
+	0xC0000409: "StackBufferOverrun",
+}
+
+// TranslateExit reports the reason of the exit statuses that are NTSTATUS
+// codes of the system, keeping the status as reported by HCS
+func (r *windowsRuntime) TranslateExit(ctx context.Context, id string, status uint32) (uint32, string) {
+	return status, exitReasons[status]
+}
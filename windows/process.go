@@ -33,6 +33,8 @@ type process struct {
 	conf     *hcsshim.ProcessConfig
 }
 
+var _ = (runtime.Process)(&process{})
+
 func (p *process) ID() string {
 	return p.id
 }
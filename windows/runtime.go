@@ -36,7 +36,10 @@ var (
 	pluginID = fmt.Sprintf("%s.%s", plugin.RuntimePlugin, runtimeName)
 )
 
-var _ = (runtime.Runtime)(&windowsRuntime{})
+var (
+	_ = (runtime.Runtime)(&windowsRuntime{})
+	_ = (runtime.ExitTranslator)(&windowsRuntime{})
+)
 
 func init() {
 	plugin.Register(&plugin.Registration{
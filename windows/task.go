@@ -18,6 +18,8 @@ import (
 	"github.com/pkg/errors"
 )
 
+var _ = (runtime.Task)(&task{})
+
 type task struct {
 	sync.Mutex
 
@@ -210,16 +212,21 @@ func (t *task) Exec(ctx context.Context, id string, opts runtime.ExecOpts) (runt
 	return p, nil
 }
 
-func (t *task) Pids(ctx context.Context) ([]uint32, error) {
+func (t *task) Pids(ctx context.Context) ([]runtime.ProcessInfo, error) {
 	t.Lock()
 	defer t.Unlock()
 
 	var (
-		pids = make([]uint32, len(t.processes))
+		pids = make([]runtime.ProcessInfo, len(t.processes))
 		idx  = 0
 	)
-	for _, p := range t.processes {
-		pids[idx] = p.Pid()
+	for id, p := range t.processes {
+		pids[idx] = runtime.ProcessInfo{
+			Pid: p.Pid(),
+		}
+		if id != t.id {
+			pids[idx].ExecID = id
+		}
 		idx++
 	}
 